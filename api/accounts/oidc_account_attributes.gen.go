@@ -17,6 +17,7 @@ type OidcAccountAttributes struct {
 	Email          string                 `json:"email,omitempty"`
 	TokenClaims    map[string]interface{} `json:"token_claims,omitempty"`
 	UserinfoClaims map[string]interface{} `json:"userinfo_claims,omitempty"`
+	Attributes     map[string]interface{} `json:"attributes,omitempty"`
 }
 
 func AttributesMapToOidcAccountAttributes(in map[string]interface{}) (*OidcAccountAttributes, error) {
@@ -5,6 +5,10 @@
 package authmethods
 
 type OidcAuthMethodAuthenticateStartResponse struct {
-	AuthUrl string `json:"auth_url,omitempty"`
-	TokenId string `json:"token_id,omitempty"`
+	AuthUrl                 string `json:"auth_url,omitempty"`
+	TokenId                 string `json:"token_id,omitempty"`
+	UserCode                string `json:"user_code,omitempty"`
+	VerificationUri         string `json:"verification_uri,omitempty"`
+	VerificationUriComplete string `json:"verification_uri_complete,omitempty"`
+	Interval                uint32 `json:"interval,omitempty"`
 }
@@ -127,6 +127,30 @@ func DefaultLdapAuthMethodAccountAttributeMaps() Option {
 	}
 }
 
+func WithSamlAuthMethodAccountAttributeMaps(inAccountAttributeMaps []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["account_attribute_maps"] = inAccountAttributeMaps
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSamlAuthMethodAccountAttributeMaps() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["account_attribute_maps"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodAccountClaimMaps(inAccountClaimMaps []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -223,6 +247,30 @@ func DefaultOidcAuthMethodApiUrlPrefix() Option {
 	}
 }
 
+func WithSamlAuthMethodApiUrlPrefix(inApiUrlPrefix string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["api_url_prefix"] = inApiUrlPrefix
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSamlAuthMethodApiUrlPrefix() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["api_url_prefix"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithAttributes(inAttributes map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["attributes"] = inAttributes
@@ -283,6 +331,54 @@ func DefaultLdapAuthMethodBindPassword() Option {
 	}
 }
 
+func WithJwtAuthMethodBoundAudiences(inBoundAudiences []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bound_audiences"] = inBoundAudiences
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodBoundAudiences() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bound_audiences"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithJwtAuthMethodBoundClaims(inBoundClaims map[string]interface{}) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bound_claims"] = inBoundClaims
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodBoundClaims() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bound_claims"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapAuthMethodCertificates(inCertificates []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -535,6 +631,30 @@ func DefaultOidcAuthMethodDryRun() Option {
 	}
 }
 
+func WithJwtAuthMethodEmailClaim(inEmailClaim string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["email_claim"] = inEmailClaim
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodEmailClaim() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["email_claim"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapAuthMethodEnableGroups(inEnableGroups bool) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -559,6 +679,30 @@ func DefaultLdapAuthMethodEnableGroups() Option {
 	}
 }
 
+func WithJwtAuthMethodFullNameClaim(inFullNameClaim string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["full_name_claim"] = inFullNameClaim
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodFullNameClaim() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["full_name_claim"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapAuthMethodGroupAttr(inGroupAttr string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -655,6 +799,30 @@ func DefaultOidcAuthMethodIdpCaCerts() Option {
 	}
 }
 
+func WithSamlAuthMethodIdpMetadataXml(inIdpMetadataXml string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["idp_metadata_xml"] = inIdpMetadataXml
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSamlAuthMethodIdpMetadataXml() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["idp_metadata_xml"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapAuthMethodInsecureTls(inInsecureTls bool) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -679,6 +847,30 @@ func DefaultLdapAuthMethodInsecureTls() Option {
 	}
 }
 
+func WithJwtAuthMethodIssuer(inIssuer string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["issuer"] = inIssuer
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodIssuer() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["issuer"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodIssuer(inIssuer string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -703,6 +895,78 @@ func DefaultOidcAuthMethodIssuer() Option {
 	}
 }
 
+func WithJwtAuthMethodJwksCaCerts(inJwksCaCerts []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["jwks_ca_certs"] = inJwksCaCerts
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodJwksCaCerts() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["jwks_ca_certs"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithJwtAuthMethodJwksUrl(inJwksUrl string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["jwks_url"] = inJwksUrl
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodJwksUrl() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["jwks_url"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithJwtAuthMethodJwtValidationPublicKeys(inJwtValidationPublicKeys []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["jwt_validation_public_keys"] = inJwtValidationPublicKeys
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodJwtValidationPublicKeys() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["jwt_validation_public_keys"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodMaxAge(inMaxAge uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -835,6 +1099,30 @@ func DefaultOidcAuthMethodPrompts() Option {
 	}
 }
 
+func WithJwtAuthMethodSigningAlgorithms(inSigningAlgorithms []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["signing_algorithms"] = inSigningAlgorithms
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodSigningAlgorithms() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["signing_algorithms"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodSigningAlgorithms(inSigningAlgorithms []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -859,6 +1147,30 @@ func DefaultOidcAuthMethodSigningAlgorithms() Option {
 	}
 }
 
+func WithSamlAuthMethodSpEntityId(inSpEntityId string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["sp_entity_id"] = inSpEntityId
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSamlAuthMethodSpEntityId() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["sp_entity_id"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapAuthMethodStartTls(inStartTls bool) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -883,6 +1195,30 @@ func DefaultLdapAuthMethodStartTls() Option {
 	}
 }
 
+func WithJwtAuthMethodState(inState string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["state"] = inState
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodState() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["state"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapAuthMethodState(inState string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -907,6 +1243,54 @@ func DefaultLdapAuthMethodState() Option {
 	}
 }
 
+func WithSamlAuthMethodState(inState string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["state"] = inState
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSamlAuthMethodState() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["state"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithJwtAuthMethodSubjectClaim(inSubjectClaim string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["subject_claim"] = inSubjectClaim
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodSubjectClaim() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["subject_claim"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapAuthMethodUpnDomain(inUpnDomain string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
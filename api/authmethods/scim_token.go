@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authmethods
+
+import (
+	"context"
+	"fmt"
+)
+
+type ScimTokenGenerateResult = ScimTokenReadResult
+
+type ScimTokenRevokeResult = ScimTokenDeleteResult
+
+// GenerateScimToken generates the bearer token a SCIM client uses to provision
+// users and groups into the auth method, replacing the auth method's current
+// token if it has one. The token value is only returned by this call.
+func (c *Client) GenerateScimToken(ctx context.Context, authMethodId string, opt ...Option) (*ScimTokenGenerateResult, error) {
+	if authMethodId == "" {
+		return nil, fmt.Errorf("empty authMethodId value passed into GenerateScimToken request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in GenerateScimToken request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("auth-methods/%s:generate-scim-token", authMethodId), map[string]any{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating GenerateScimToken request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during GenerateScimToken call: %w", err)
+	}
+
+	target := new(ScimTokenGenerateResult)
+	target.Item = new(ScimToken)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding GenerateScimToken response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// RevokeScimToken revokes the SCIM token of the auth method.
+func (c *Client) RevokeScimToken(ctx context.Context, authMethodId string, opt ...Option) (*ScimTokenRevokeResult, error) {
+	if authMethodId == "" {
+		return nil, fmt.Errorf("empty authMethodId value passed into RevokeScimToken request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in RevokeScimToken request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("auth-methods/%s:revoke-scim-token", authMethodId), map[string]any{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RevokeScimToken request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RevokeScimToken call: %w", err)
+	}
+
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RevokeScimToken response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target := &ScimTokenRevokeResult{
+		response: resp,
+	}
+	return target, nil
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/plugins"
 	"github.com/hashicorp/boundary/api/scopes"
 )
 
@@ -21,6 +22,8 @@ type CredentialStore struct {
 	Id                          string                 `json:"id,omitempty"`
 	ScopeId                     string                 `json:"scope_id,omitempty"`
 	Scope                       *scopes.ScopeInfo      `json:"scope,omitempty"`
+	PluginId                    string                 `json:"plugin_id,omitempty"`
+	Plugin                      *plugins.PluginInfo    `json:"plugin,omitempty"`
 	Name                        string                 `json:"name,omitempty"`
 	Description                 string                 `json:"description,omitempty"`
 	CreatedTime                 time.Time              `json:"created_time,omitempty"`
@@ -28,6 +31,8 @@ type CredentialStore struct {
 	Version                     uint32                 `json:"version,omitempty"`
 	Type                        string                 `json:"type,omitempty"`
 	Attributes                  map[string]interface{} `json:"attributes,omitempty"`
+	Secrets                     map[string]interface{} `json:"secrets,omitempty"`
+	SecretsHmac                 string                 `json:"secrets_hmac,omitempty"`
 	AuthorizedActions           []string               `json:"authorized_actions,omitempty"`
 	AuthorizedCollectionActions map[string][]string    `json:"authorized_collection_actions,omitempty"`
 
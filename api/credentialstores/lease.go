@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentialstores
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type CredentialLeaseReadResult struct {
+	Item     *CredentialLease
+	response *api.Response
+}
+
+func (n CredentialLeaseReadResult) GetItem() *CredentialLease {
+	return n.Item
+}
+
+func (n CredentialLeaseReadResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type CredentialLeaseListResult struct {
+	Items    []*CredentialLease
+	response *api.Response
+}
+
+func (n CredentialLeaseListResult) GetItems() []*CredentialLease {
+	return n.Items
+}
+
+func (n CredentialLeaseListResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// WithSessionId limits the leases returned by ListLeases to the ones issued
+// for the given session.
+func WithSessionId(sessionId string) Option {
+	return func(o *options) {
+		o.queryMap["session_id"] = sessionId
+	}
+}
+
+// ListLeases returns the Vault leases of the credentials issued by the Vault
+// credential store. Use WithSessionId to only return the leases issued for a
+// session.
+func (c *Client) ListLeases(ctx context.Context, credentialStoreId string, opt ...Option) (*CredentialLeaseListResult, error) {
+	if credentialStoreId == "" {
+		return nil, fmt.Errorf("empty credentialStoreId value passed into ListLeases request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("credential-stores/%s:list-leases", url.PathEscape(credentialStoreId)), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListLeases request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListLeases call: %w", err)
+	}
+
+	target := new(CredentialLeaseListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListLeases response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// RenewLease renews the Vault lease of the credential issued by the Vault
+// credential store.
+func (c *Client) RenewLease(ctx context.Context, credentialStoreId, credentialId string, opt ...Option) (*CredentialLeaseReadResult, error) {
+	return c.leaseAction(ctx, "RenewLease", "renew-lease", credentialStoreId, credentialId, opt...)
+}
+
+// RevokeLease revokes the Vault lease of the credential issued by the Vault
+// credential store.
+func (c *Client) RevokeLease(ctx context.Context, credentialStoreId, credentialId string, opt ...Option) (*CredentialLeaseReadResult, error) {
+	return c.leaseAction(ctx, "RevokeLease", "revoke-lease", credentialStoreId, credentialId, opt...)
+}
+
+func (c *Client) leaseAction(ctx context.Context, name, action, credentialStoreId, credentialId string, opt ...Option) (*CredentialLeaseReadResult, error) {
+	if credentialStoreId == "" {
+		return nil, fmt.Errorf("empty credentialStoreId value passed into %s request", name)
+	}
+	if credentialId == "" {
+		return nil, fmt.Errorf("empty credentialId value passed into %s request", name)
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["credential_id"] = credentialId
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("credential-stores/%s:%s", url.PathEscape(credentialStoreId), action), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s request: %w", name, err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during %s call: %w", name, err)
+	}
+
+	target := new(CredentialLeaseReadResult)
+	target.Item = new(CredentialLease)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s response: %w", name, err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -103,6 +103,18 @@ func WithRecursive(recurse bool) Option {
 	}
 }
 
+func WithPostgresCredentialStoreAddress(inAddress string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["address"] = inAddress
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultCredentialStoreAddress(inAddress string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -199,6 +211,30 @@ func DefaultVaultCredentialStoreClientCertificateKey() Option {
 	}
 }
 
+func WithPostgresCredentialStoreDatabaseName(inDatabaseName string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["database_name"] = inDatabaseName
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPostgresCredentialStoreDatabaseName() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["database_name"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithDescription(inDescription string) Option {
 	return func(o *options) {
 		o.postMap["description"] = inDescription
@@ -211,6 +247,54 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithSshCaCredentialStoreKeyBits(inKeyBits uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["key_bits"] = inKeyBits
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshCaCredentialStoreKeyBits() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["key_bits"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithSshCaCredentialStoreKeyType(inKeyType string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["key_type"] = inKeyType
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshCaCredentialStoreKeyType() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["key_type"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -247,6 +331,102 @@ func DefaultVaultCredentialStoreNamespace() Option {
 	}
 }
 
+func WithPostgresCredentialStorePassword(inPassword string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["password"] = inPassword
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPostgresCredentialStorePassword() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["password"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPluginId(inPluginId string) Option {
+	return func(o *options) {
+		o.postMap["plugin_id"] = inPluginId
+	}
+}
+
+func DefaultPluginId() Option {
+	return func(o *options) {
+		o.postMap["plugin_id"] = nil
+	}
+}
+
+func WithPostgresCredentialStorePort(inPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["port"] = inPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPostgresCredentialStorePort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithSecrets(inSecrets map[string]interface{}) Option {
+	return func(o *options) {
+		o.postMap["secrets"] = inSecrets
+	}
+}
+
+func DefaultSecrets() Option {
+	return func(o *options) {
+		o.postMap["secrets"] = nil
+	}
+}
+
+func WithPostgresCredentialStoreSslMode(inSslMode string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssl_mode"] = inSslMode
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPostgresCredentialStoreSslMode() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssl_mode"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultCredentialStoreTlsServerName(inTlsServerName string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -307,6 +487,30 @@ func WithVaultCredentialStoreToken(inToken string) Option {
 	}
 }
 
+func WithPostgresCredentialStoreUsername(inUsername string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["username"] = inUsername
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPostgresCredentialStoreUsername() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["username"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultCredentialStoreWorkerFilter(inWorkerFilter string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -35,6 +35,7 @@ type Host struct {
 	DnsNames          []string               `json:"dns_names,omitempty"`
 	ExternalId        string                 `json:"external_id,omitempty"`
 	ExternalName      string                 `json:"external_name,omitempty"`
+	Health            string                 `json:"health,omitempty"`
 	AuthorizedActions []string               `json:"authorized_actions,omitempty"`
 
 	response *api.Response
@@ -114,6 +114,18 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithJwtManagedGroupFilter(inFilter string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["filter"] = inFilter
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcManagedGroupFilter(inFilter string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -126,6 +138,18 @@ func WithOidcManagedGroupFilter(inFilter string) Option {
 	}
 }
 
+func WithSamlManagedGroupFilter(inFilter string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["filter"] = inFilter
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapManagedGroupGroupNames(inGroupNames []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
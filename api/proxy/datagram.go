@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// MaxDatagramSize is the largest UDP payload that can be relayed through a
+// session.
+const MaxDatagramSize = math.MaxUint16
+
+// WriteDatagram writes b to w as a single frame: a two byte big endian length
+// followed by the payload. UDP sessions use these frames to keep datagram
+// boundaries over the stream between the client and the worker.
+func WriteDatagram(w io.Writer, b []byte) error {
+	if len(b) > MaxDatagramSize {
+		return fmt.Errorf("datagram of %d bytes exceeds maximum size of %d bytes", len(b), MaxDatagramSize)
+	}
+	frame := make([]byte, 2+len(b))
+	binary.BigEndian.PutUint16(frame, uint16(len(b)))
+	copy(frame[2:], b)
+	_, err := w.Write(frame)
+	return err
+}
+
+// ReadDatagram reads a single frame written by WriteDatagram from r into buf
+// and returns the size of the datagram. buf must be able to hold
+// MaxDatagramSize bytes, or at least the largest datagram expected.
+func ReadDatagram(r io.Reader, buf []byte) (int, error) {
+	var size [2]byte
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		return 0, err
+	}
+	n := int(binary.BigEndian.Uint16(size[:]))
+	if n > len(buf) {
+		return 0, fmt.Errorf("datagram of %d bytes does not fit in buffer of %d bytes", n, len(buf))
+	}
+	if _, err := io.ReadFull(r, buf[:n]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, err
+	}
+	return n, nil
+}
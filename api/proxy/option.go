@@ -5,8 +5,10 @@ package proxy
 
 import (
 	"errors"
+	"fmt"
 	"net"
 	"net/netip"
+	"time"
 
 	"github.com/hashicorp/boundary/api/targets"
 )
@@ -35,6 +37,10 @@ type Options struct {
 	WithWorkerHost               string
 	WithSessionAuthorizationData *targets.SessionAuthorizationData
 	WithSkipSessionTeardown      bool
+	WithShadowId                 string
+	WithShadowToken              string
+	WithUdpFlowIdleTimeout       time.Duration
+	WithReverseAddress           string
 }
 
 // Option is a function that takes in an options struct and sets values or
@@ -43,7 +49,8 @@ type Option func(*Options) error
 
 func getDefaultOptions() *Options {
 	return &Options{
-		WithListenAddrPort: netip.MustParseAddrPort("127.0.0.1:0"),
+		WithListenAddrPort:     netip.MustParseAddrPort("127.0.0.1:0"),
+		WithUdpFlowIdleTimeout: DefaultUdpFlowIdleTimeout,
 	}
 }
 
@@ -118,3 +125,48 @@ func WithSkipSessionTeardown(with bool) Option {
 		return nil
 	}
 }
+
+// WithShadow can be used to attach to an existing connection of the session as
+// a shadow instead of opening new connections. The shadow id and token are
+// returned when a shadow of the session is created. A shadow never tears down
+// the session it is attached to.
+func WithShadow(shadowId, shadowToken string) Option {
+	return func(o *Options) error {
+		switch {
+		case shadowId == "":
+			return errors.New("empty shadow id passed to WithShadow")
+		case shadowToken == "":
+			return errors.New("empty shadow token passed to WithShadow")
+		}
+		o.WithShadowId = shadowId
+		o.WithShadowToken = shadowToken
+		return nil
+	}
+}
+
+// WithUdpFlowIdleTimeout can be used to override how long the flow of
+// datagrams from a client address to a UDP target is kept open once no
+// datagram has been sent in either direction. Each flow is a connection of the
+// session, so the flow is closed on the worker too.
+func WithUdpFlowIdleTimeout(with time.Duration) Option {
+	return func(o *Options) error {
+		if with <= 0 {
+			return errors.New("non-positive duration passed to WithUdpFlowIdleTimeout")
+		}
+		o.WithUdpFlowIdleTimeout = with
+		return nil
+	}
+}
+
+// WithReverseAddress specifies the address of the local service that the
+// connections made to the worker's listener of a reverse session are forwarded
+// to. It is required for reverse sessions and not allowed otherwise.
+func WithReverseAddress(with string) Option {
+	return func(o *Options) error {
+		if _, _, err := net.SplitHostPort(with); err != nil {
+			return fmt.Errorf("invalid address passed to WithReverseAddress: %w", err)
+		}
+		o.WithReverseAddress = with
+		return nil
+	}
+}
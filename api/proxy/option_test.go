@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/netip"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/stretchr/testify/assert"
@@ -86,4 +87,41 @@ func Test_GetOpts(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(opts.WithSkipSessionTeardown)
 	})
+	t.Run("with-shadow", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts()
+		require.NoError(t, err)
+		assert.Empty(opts.WithShadowId)
+		assert.Empty(opts.WithShadowToken)
+		opts, err = getOpts(WithShadow("ssd_1234567890", "token"))
+		require.NoError(t, err)
+		assert.Equal("ssd_1234567890", opts.WithShadowId)
+		assert.Equal("token", opts.WithShadowToken)
+		_, err = getOpts(WithShadow("", "token"))
+		require.Error(t, err)
+		_, err = getOpts(WithShadow("ssd_1234567890", ""))
+		require.Error(t, err)
+	})
+	t.Run("with-udp-flow-idle-timeout", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts()
+		require.NoError(t, err)
+		assert.Equal(DefaultUdpFlowIdleTimeout, opts.WithUdpFlowIdleTimeout)
+		opts, err = getOpts(WithUdpFlowIdleTimeout(5 * time.Second))
+		require.NoError(t, err)
+		assert.Equal(5*time.Second, opts.WithUdpFlowIdleTimeout)
+		_, err = getOpts(WithUdpFlowIdleTimeout(0))
+		require.Error(t, err)
+	})
+	t.Run("with-reverse-address", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts()
+		require.NoError(t, err)
+		assert.Empty(opts.WithReverseAddress)
+		opts, err = getOpts(WithReverseAddress("127.0.0.1:8080"))
+		require.NoError(t, err)
+		assert.Equal("127.0.0.1:8080", opts.WithReverseAddress)
+		_, err = getOpts(WithReverseAddress("127.0.0.1"))
+		require.Error(t, err)
+	})
 }
@@ -51,6 +51,10 @@ type ClientProxy struct {
 	connWg                  *sync.WaitGroup
 	started                 *atomic.Bool
 	skipSessionTeardown     bool
+	shadowId                string
+	shadowToken             string
+	udpFlowIdleTimeout      time.Duration
+	reverseAddress          string
 }
 
 // New creates a new client proxy. The given context should be cancelable; once
@@ -60,7 +64,8 @@ type ClientProxy struct {
 //
 // Supported options:
 //
-// * WithListenAddrPort - Specify a TCP address and port on which to listen
+// * WithListenAddrPort - Specify an address and port on which to listen. The
+// proxy listens on UDP for sessions to UDP targets and on TCP otherwise
 //
 // * WithListener - Specify a custom listener on which to accept connections;
 // overrides WithListenAddrPort if both are set
@@ -74,6 +79,16 @@ type ClientProxy struct {
 // * WithWorkerHost - If set, use this host name as the SNI host when making the
 // TLS connection to the worker
 //
+// * WithShadow - If set, attach to an existing connection of the session as a
+// shadow rather than opening new connections
+//
+// * WithUdpFlowIdleTimeout - Specify how long the flow of datagrams from a
+// client address is kept open when idle, for sessions to UDP targets
+//
+// * WithReverseAddress - Specify the address of the local service to forward
+// the connections made to the worker's listener to, for reverse sessions. The
+// proxy then listens on the worker instead of locally
+//
 // EXPERIMENTAL: While this API is not expected to change, it is new and
 // feedback from users may necessitate changes.
 func New(ctx context.Context, authzToken string, opt ...Option) (*ClientProxy, error) {
@@ -96,7 +111,11 @@ func New(ctx context.Context, authzToken string, opt ...Option) (*ClientProxy, e
 		listenAddrPort:          opts.WithListenAddrPort,
 		callerConnectionsLeftCh: opts.WithConnectionsLeftCh,
 		started:                 new(atomic.Bool),
-		skipSessionTeardown:     opts.WithSkipSessionTeardown,
+		skipSessionTeardown:     opts.WithSkipSessionTeardown || opts.WithShadowId != "",
+		shadowId:                opts.WithShadowId,
+		shadowToken:             opts.WithShadowToken,
+		udpFlowIdleTimeout:      opts.WithUdpFlowIdleTimeout,
+		reverseAddress:          opts.WithReverseAddress,
 	}
 
 	if opts.WithListener != nil {
@@ -120,6 +139,17 @@ func New(ctx context.Context, authzToken string, opt ...Option) (*ClientProxy, e
 		return nil, errors.New("no workers found in authorization data")
 	}
 
+	switch {
+	case !p.sessionAuthzData.Reverse || p.shadowId != "":
+		if p.reverseAddress != "" {
+			return nil, errors.New("reverse address given for a session which is not a reverse session")
+		}
+	case p.reverseAddress == "":
+		return nil, errors.New("reverse sessions require a reverse address")
+	case opts.WithListener != nil:
+		return nil, errors.New("a listener cannot be given for reverse sessions")
+	}
+
 	if opts.WithListener == nil {
 		if p.listenAddrPort.Port() == 0 {
 			p.listenAddrPort = netip.AddrPortFrom(p.listenAddrPort.Addr(), uint16(p.sessionAuthzData.DefaultClientPort))
@@ -176,15 +206,32 @@ func (p *ClientProxy) Start() (retErr error) {
 	defer p.cancel()
 
 	if p.listener.Load() == nil {
-		var err error
-		ln, err := net.ListenTCP("tcp", &net.TCPAddr{
-			IP:   p.listenAddrPort.Addr().AsSlice(),
-			Port: int(p.listenAddrPort.Port()),
-		})
-		if err != nil {
-			return fmt.Errorf("unable to start listening: %w", err)
+		switch {
+		case p.reverseAddress != "":
+			l, err := p.newReverseListener(p.reverseAddress)
+			if err != nil {
+				return fmt.Errorf("unable to start listening on worker: %w", err)
+			}
+			p.listener.Store(l)
+		case p.sessionAuthzData.Type == udpTargetType:
+			pc, err := net.ListenUDP("udp", &net.UDPAddr{
+				IP:   p.listenAddrPort.Addr().AsSlice(),
+				Port: int(p.listenAddrPort.Port()),
+			})
+			if err != nil {
+				return fmt.Errorf("unable to start listening: %w", err)
+			}
+			p.listener.Store(newUdpListener(pc, p.udpFlowIdleTimeout))
+		default:
+			ln, err := net.ListenTCP("tcp", &net.TCPAddr{
+				IP:   p.listenAddrPort.Addr().AsSlice(),
+				Port: int(p.listenAddrPort.Port()),
+			})
+			if err != nil {
+				return fmt.Errorf("unable to start listening: %w", err)
+			}
+			p.listener.Store(ln)
 		}
-		p.listener.Store(ln)
 	}
 
 	listenerCloseFunc := func() {
@@ -235,6 +282,11 @@ func (p *ClientProxy) Start() (retErr error) {
 			go func() {
 				defer listeningConn.Close()
 				defer p.connWg.Done()
+				if rc, ok := listeningConn.(*reverseConn); ok {
+					// The connection to the worker was made while accepting
+					copyConns(rc.workerConn, rc.Conn)
+					return
+				}
 				wsConn, err := p.getWsConn(p.ctx)
 				if err != nil {
 					fin <- fmt.Errorf("error from getWsConn: %w", err)
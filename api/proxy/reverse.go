@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	pb "github.com/hashicorp/boundary/sdk/pbs/proxy"
+	"github.com/hashicorp/boundary/sdk/wspb"
+	"github.com/hashicorp/go-secure-stdlib/temperror"
+	"nhooyr.io/websocket"
+)
+
+// ReverseConnectionAccepted is sent by the worker on a connection of a reverse
+// session once a connection has been made to the session's listener on the
+// worker. The data of that connection follows it.
+const ReverseConnectionAccepted byte = 1
+
+// reverseAddr is the address of the listener of a reverse session on the
+// worker.
+type reverseAddr string
+
+func (a reverseAddr) Network() string { return "tcp" }
+func (a reverseAddr) String() string  { return string(a) }
+
+// reverseListener is a net.Listener for reverse sessions. Each call to Accept
+// opens a connection of the session to the worker and waits for a connection
+// to be made to the worker's listener, which is then forwarded to the local
+// service. Its address is the address of the worker's listener.
+type reverseListener struct {
+	p         *ClientProxy
+	localAddr string
+	addr      reverseAddr
+	ctx       context.Context
+	cancel    context.CancelFunc
+	closeOnce sync.Once
+}
+
+// newReverseListener opens the listener of the session on the worker, which
+// forwards the connections made to it to localAddr.
+func (p *ClientProxy) newReverseListener(localAddr string) (*reverseListener, error) {
+	wsConn, err := p.getWsConn(p.ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer wsConn.Close(websocket.StatusNormalClosure, "done")
+
+	handshake := pb.ClientHandshake{
+		TofuToken: p.tofuToken,
+		Command:   pb.HANDSHAKECOMMAND_HANDSHAKECOMMAND_SESSION_REVERSE_LISTEN,
+	}
+	if err := wspb.Write(p.ctx, wsConn, &handshake); err != nil {
+		return nil, fmt.Errorf("error sending reverse listen handshake to worker: %w", err)
+	}
+	handshakeResult, err := p.readHandshakeResult(wsConn)
+	if err != nil {
+		return nil, err
+	}
+
+	addr := handshakeResult.GetReverseListenerAddress()
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reverse listener address %q from worker: %w", addr, err)
+	}
+	// Workers listening on all interfaces are reachable at the same host the
+	// client uses.
+	if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+		if workerHost, _, err := net.SplitHostPort(p.workerAddr); err == nil {
+			addr = net.JoinHostPort(workerHost, port)
+		}
+	}
+
+	l := &reverseListener{
+		p:         p,
+		localAddr: localAddr,
+		addr:      reverseAddr(addr),
+	}
+	l.ctx, l.cancel = context.WithCancel(p.ctx)
+	return l, nil
+}
+
+// Accept waits for a connection to the worker's listener and returns a
+// connection to the local service it is forwarded to.
+func (l *reverseListener) Accept() (net.Conn, error) {
+	wsConn, err := l.p.getWsConn(l.ctx)
+	if err != nil {
+		if l.ctx.Err() != nil {
+			return nil, net.ErrClosed
+		}
+		return nil, err
+	}
+	if err := wspb.Write(l.ctx, wsConn, &pb.ClientHandshake{TofuToken: l.p.tofuToken}); err != nil {
+		wsConn.Close(websocket.StatusInternalError, "")
+		return nil, fmt.Errorf("error sending handshake to worker: %w", err)
+	}
+	handshakeResult, err := l.p.readHandshakeResult(wsConn)
+	if err != nil {
+		return nil, err
+	}
+	if handshakeResult.GetConnectionsLeft() != -1 {
+		l.p.connsLeftCh <- handshakeResult.GetConnectionsLeft()
+	}
+
+	workerConn := websocket.NetConn(l.p.ctx, wsConn, websocket.MessageBinary)
+	var accepted [1]byte
+	if _, err := io.ReadFull(workerConn, accepted[:]); err != nil {
+		workerConn.Close()
+		if l.ctx.Err() != nil {
+			return nil, net.ErrClosed
+		}
+		return nil, fmt.Errorf("error waiting for a connection to the reverse listener: %w", err)
+	}
+	if accepted[0] != ReverseConnectionAccepted {
+		workerConn.Close()
+		return nil, fmt.Errorf("unexpected reverse connection message %d from worker", accepted[0])
+	}
+
+	localConn, err := net.Dial("tcp", l.localAddr)
+	if err != nil {
+		workerConn.Close()
+		// The local service may only be unavailable for now, so the
+		// connection is refused but the listener is kept open.
+		return nil, temperror.New(fmt.Errorf("error connecting to %s: %w", l.localAddr, err))
+	}
+	return &reverseConn{Conn: localConn, workerConn: workerConn}, nil
+}
+
+// Close stops waiting for connections. Connections already forwarded are not
+// affected.
+func (l *reverseListener) Close() error {
+	l.closeOnce.Do(l.cancel)
+	return nil
+}
+
+// Addr returns the address of the worker's listener.
+func (l *reverseListener) Addr() net.Addr {
+	return l.addr
+}
+
+// reverseConn is the connection to the local service returned by a
+// reverseListener, along with the connection to the worker it is forwarded
+// to.
+type reverseConn struct {
+	net.Conn
+	workerConn net.Conn
+}
+
+func (c *reverseConn) Close() error {
+	return errors.Join(c.Conn.Close(), c.workerConn.Close())
+}
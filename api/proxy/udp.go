@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// udpTargetType is the type of the targets whose sessions relay datagrams.
+const udpTargetType = "udp"
+
+// DefaultUdpFlowIdleTimeout is how long a UDP flow is kept open without
+// datagrams being sent in either direction, unless overridden with
+// WithUdpFlowIdleTimeout.
+const DefaultUdpFlowIdleTimeout = time.Minute
+
+// flowQueueSize is the number of datagrams buffered for a flow before new
+// datagrams are dropped, like they would be by a full socket buffer.
+const flowQueueSize = 64
+
+// udpListener is a net.Listener which accepts a udpFlow for each remote
+// address datagrams are received from on a net.PacketConn. This allows UDP
+// traffic to use the same proxying path as TCP connections, with every flow
+// being a connection of the session.
+type udpListener struct {
+	pc          net.PacketConn
+	idleTimeout time.Duration
+	accept      chan *udpFlow
+	closed      chan struct{}
+	closeOnce   sync.Once
+
+	mu    sync.Mutex
+	flows map[string]*udpFlow
+	err   error
+}
+
+func newUdpListener(pc net.PacketConn, idleTimeout time.Duration) *udpListener {
+	l := &udpListener{
+		pc:          pc,
+		idleTimeout: idleTimeout,
+		accept:      make(chan *udpFlow),
+		closed:      make(chan struct{}),
+		flows:       map[string]*udpFlow{},
+	}
+	go l.readLoop()
+	return l
+}
+
+func (l *udpListener) readLoop() {
+	buf := make([]byte, MaxDatagramSize)
+	for {
+		n, addr, err := l.pc.ReadFrom(buf)
+		if err != nil {
+			l.mu.Lock()
+			if l.err == nil {
+				l.err = err
+			}
+			l.mu.Unlock()
+			l.Close()
+			return
+		}
+		d := make([]byte, n)
+		copy(d, buf[:n])
+
+		l.mu.Lock()
+		f, ok := l.flows[addr.String()]
+		if !ok {
+			f = newUdpFlow(l, addr)
+			l.flows[addr.String()] = f
+		}
+		l.mu.Unlock()
+		if !ok {
+			select {
+			case l.accept <- f:
+			case <-l.closed:
+				return
+			}
+		}
+		f.deliver(d)
+	}
+}
+
+// Accept waits for datagrams from a new remote address and returns the flow
+// for it.
+func (l *udpListener) Accept() (net.Conn, error) {
+	select {
+	case f := <-l.accept:
+		return f, nil
+	case <-l.closed:
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if l.err != nil {
+			return nil, l.err
+		}
+		return nil, net.ErrClosed
+	}
+}
+
+// Close closes the packet conn and all of the flows.
+func (l *udpListener) Close() error {
+	var err error
+	l.closeOnce.Do(func() {
+		close(l.closed)
+		err = l.pc.Close()
+		l.mu.Lock()
+		flows := make([]*udpFlow, 0, len(l.flows))
+		for _, f := range l.flows {
+			flows = append(flows, f)
+		}
+		l.mu.Unlock()
+		for _, f := range flows {
+			f.Close()
+		}
+	})
+	return err
+}
+
+// Addr returns the local address of the packet conn.
+func (l *udpListener) Addr() net.Addr {
+	return l.pc.LocalAddr()
+}
+
+func (l *udpListener) remove(f *udpFlow) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.flows[f.addr.String()] == f {
+		delete(l.flows, f.addr.String())
+	}
+}
+
+// udpFlow is a net.Conn for the datagrams exchanged with a single remote
+// address. Reading returns the datagrams received from the remote address
+// framed with WriteDatagram, and the frames written are sent to the remote
+// address as datagrams. A flow closes itself once no datagram has been sent
+// in either direction for the idle timeout of the listener.
+type udpFlow struct {
+	l       *udpListener
+	addr    net.Addr
+	in      chan []byte
+	pending bytes.Buffer
+	pw      *io.PipeWriter
+	idle    *time.Timer
+
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newUdpFlow(l *udpListener, addr net.Addr) *udpFlow {
+	pr, pw := io.Pipe()
+	f := &udpFlow{
+		l:      l,
+		addr:   addr,
+		in:     make(chan []byte, flowQueueSize),
+		pw:     pw,
+		closed: make(chan struct{}),
+	}
+	if l.idleTimeout > 0 {
+		f.idle = time.AfterFunc(l.idleTimeout, func() { f.Close() })
+	}
+	go f.writeLoop(pr)
+	return f
+}
+
+func (f *udpFlow) deliver(d []byte) {
+	select {
+	case f.in <- d:
+	default:
+	}
+}
+
+func (f *udpFlow) touch() {
+	if f.idle != nil {
+		f.idle.Reset(f.l.idleTimeout)
+	}
+}
+
+// writeLoop sends the datagrams framed in the data written to the flow to the
+// remote address.
+func (f *udpFlow) writeLoop(pr *io.PipeReader) {
+	buf := make([]byte, MaxDatagramSize)
+	for {
+		n, err := ReadDatagram(pr, buf)
+		if err != nil {
+			pr.CloseWithError(err)
+			return
+		}
+		f.touch()
+		if _, err := f.l.pc.WriteTo(buf[:n], f.addr); err != nil {
+			pr.CloseWithError(err)
+			return
+		}
+	}
+}
+
+// Read reads the framed datagrams received from the remote address. It returns
+// io.EOF once the flow is closed.
+func (f *udpFlow) Read(b []byte) (int, error) {
+	if f.pending.Len() == 0 {
+		select {
+		case d := <-f.in:
+			f.touch()
+			if err := WriteDatagram(&f.pending, d); err != nil {
+				return 0, err
+			}
+		case <-f.closed:
+			return 0, io.EOF
+		}
+	}
+	return f.pending.Read(b)
+}
+
+// Write sends the datagrams framed in b to the remote address. Frames may be
+// split across calls to Write.
+func (f *udpFlow) Write(b []byte) (int, error) {
+	return f.pw.Write(b)
+}
+
+// Close closes the flow and removes it from the listener, so that further
+// datagrams from the remote address start a new flow.
+func (f *udpFlow) Close() error {
+	f.closeOnce.Do(func() {
+		close(f.closed)
+		if f.idle != nil {
+			f.idle.Stop()
+		}
+		f.pw.Close()
+		f.l.remove(f)
+	})
+	return nil
+}
+
+func (f *udpFlow) LocalAddr() net.Addr {
+	return f.l.pc.LocalAddr()
+}
+
+func (f *udpFlow) RemoteAddr() net.Addr {
+	return f.addr
+}
+
+func (f *udpFlow) SetDeadline(time.Time) error      { return nil }
+func (f *udpFlow) SetReadDeadline(time.Time) error  { return nil }
+func (f *udpFlow) SetWriteDeadline(time.Time) error { return nil }
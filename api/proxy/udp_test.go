@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatagram(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteDatagram(&buf, []byte("hello")))
+	require.NoError(t, WriteDatagram(&buf, nil))
+	require.Error(t, WriteDatagram(&buf, make([]byte, MaxDatagramSize+1)))
+
+	out := make([]byte, MaxDatagramSize)
+	n, err := ReadDatagram(&buf, out)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(out[:n]))
+	n, err = ReadDatagram(&buf, out)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	_, err = ReadDatagram(&buf, out)
+	assert.ErrorIs(t, err, io.EOF)
+
+	require.NoError(t, WriteDatagram(&buf, []byte("too long")))
+	_, err = ReadDatagram(&buf, make([]byte, 4))
+	assert.Error(t, err)
+
+	buf.Reset()
+	buf.Write([]byte{0, 5, 'h'})
+	_, err = ReadDatagram(&buf, out)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestUdpListener(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	l := newUdpListener(pc, 200*time.Millisecond)
+	t.Cleanup(func() { l.Close() })
+
+	client, err := net.Dial("udp", l.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+
+	_, err = client.Write([]byte("ping"))
+	require.NoError(t, err)
+	conn, err := l.Accept()
+	require.NoError(t, err)
+	assert.Equal(t, client.LocalAddr().String(), conn.RemoteAddr().String())
+
+	// Datagrams from the client are read as frames
+	buf := make([]byte, MaxDatagramSize)
+	n, err := ReadDatagram(conn, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(buf[:n]))
+
+	// Frames written to the flow are sent to the client, even when split
+	// across writes
+	var frame bytes.Buffer
+	require.NoError(t, WriteDatagram(&frame, []byte("pong")))
+	_, err = conn.Write(frame.Bytes()[:3])
+	require.NoError(t, err)
+	_, err = conn.Write(frame.Bytes()[3:])
+	require.NoError(t, err)
+	require.NoError(t, client.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, err = client.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "pong", string(buf[:n]))
+
+	// The flow is closed once idle and the next datagram starts a new flow
+	_, err = conn.Read(buf)
+	assert.ErrorIs(t, err, io.EOF)
+	_, err = client.Write([]byte("again"))
+	require.NoError(t, err)
+	conn2, err := l.Accept()
+	require.NoError(t, err)
+	assert.NotEqual(t, conn, conn2)
+	n, err = ReadDatagram(conn2, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "again", string(buf[:n]))
+
+	// Closing the listener closes its flows
+	require.NoError(t, l.Close())
+	_, err = conn2.Read(buf)
+	assert.ErrorIs(t, err, io.EOF)
+	_, err = l.Accept()
+	assert.ErrorIs(t, err, net.ErrClosed)
+}
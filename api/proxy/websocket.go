@@ -72,39 +72,66 @@ func (p *ClientProxy) sendSessionTeardown(ctx context.Context) error {
 
 func (p *ClientProxy) runTcpProxyV1(wsConn *websocket.Conn, listeningConn net.Conn) error {
 	handshake := pb.ClientHandshake{TofuToken: p.tofuToken}
+	if p.shadowId != "" {
+		handshake.Command = pb.HANDSHAKECOMMAND_HANDSHAKECOMMAND_SESSION_SHADOW
+		handshake.ShadowId = p.shadowId
+		handshake.ShadowToken = p.shadowToken
+	}
 	if err := wspb.Write(p.ctx, wsConn, &handshake); err != nil {
 		return fmt.Errorf("error sending handshake to worker: %w", err)
 	}
-	var handshakeResult pb.HandshakeResult
-	if err := wspb.Read(p.ctx, wsConn, &handshakeResult); err != nil {
+	handshakeResult, err := p.readHandshakeResult(wsConn)
+	if err != nil {
+		return err
+	}
+
+	if handshakeResult.GetConnectionsLeft() != -1 {
+		p.connsLeftCh <- handshakeResult.GetConnectionsLeft()
+	}
+
+	// Get a wrapped net.Conn so we can use io.Copy
+	netConn := websocket.NetConn(p.ctx, wsConn, websocket.MessageBinary)
+	copyConns(netConn, listeningConn)
+
+	return nil
+}
+
+// readHandshakeResult reads the result of the handshake sent on wsConn. When
+// the worker refuses the handshake, the returned error explains why and the
+// proxy is wound down as appropriate.
+func (p *ClientProxy) readHandshakeResult(wsConn *websocket.Conn) (*pb.HandshakeResult, error) {
+	handshakeResult := new(pb.HandshakeResult)
+	if err := wspb.Read(p.ctx, wsConn, handshakeResult); err != nil {
 		switch {
 		case strings.Contains(err.Error(), "unable to authorize connection"):
 			// There's no reason to think we'd be able to authorize any more
 			// connections after the first has failed. We don't cancel the
 			// context here as existing connections may be fine.
 			p.connsLeftCh <- 0
-			return errors.New("unable to authorize connection")
+			return nil, errors.New("unable to authorize connection")
 		}
 		switch {
+		case strings.Contains(err.Error(), "unable to authorize shadow"):
+			// The shadow is not approved or was not accepted, so there is
+			// no point in attaching again.
+			p.cancel()
+			return nil, errors.New("unable to authorize shadow")
 		case strings.Contains(err.Error(), "tofu token not allowed"):
 			// If our tofu token is not allowed something is wrong, and we
 			// should cancel anything we have going.
 			p.cancel()
-			return errors.New("session is already in use")
+			return nil, errors.New("session is already in use")
 		default:
 			// If we can't handshake we can't do anything, so quit out
 			p.cancel()
-			return fmt.Errorf("error reading handshake result: %w", err)
+			return nil, fmt.Errorf("error reading handshake result: %w", err)
 		}
 	}
+	return handshakeResult, nil
+}
 
-	if handshakeResult.GetConnectionsLeft() != -1 {
-		p.connsLeftCh <- handshakeResult.GetConnectionsLeft()
-	}
-
-	// Get a wrapped net.Conn so we can use io.Copy
-	netConn := websocket.NetConn(p.ctx, wsConn, websocket.MessageBinary)
-
+// copyConns copies data between the connections until either is closed.
+func copyConns(netConn, listeningConn net.Conn) {
 	localWg := new(sync.WaitGroup)
 	localWg.Add(2)
 	go func() {
@@ -120,6 +147,4 @@ func (p *ClientProxy) runTcpProxyV1(wsConn *websocket.Conn, listeningConn net.Co
 		netConn.Close()
 	}()
 	localWg.Wait()
-
-	return nil
 }
@@ -37,6 +37,7 @@ type Session struct {
 	TerminationReason string            `json:"termination_reason,omitempty"`
 	AuthorizedActions []string          `json:"authorized_actions,omitempty"`
 	Connections       []*Connection     `json:"connections,omitempty"`
+	Shadows           []*SessionShadow  `json:"shadows,omitempty"`
 
 	response *api.Response
 }
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// SessionShadowResult is the result of creating a shadow of a session.
+type SessionShadowResult struct {
+	Item     *SessionShadow
+	response *api.Response
+}
+
+func (n SessionShadowResult) GetItem() *SessionShadow {
+	return n.Item
+}
+
+func (n SessionShadowResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// Shadow creates a shadow of the session for the calling user. The returned
+// shadow contains the token and authorization token needed to attach to the
+// session's most recent connection. Read-write shadows must be approved
+// before they can attach.
+func (c *Client) Shadow(ctx context.Context, sessionId string, readWrite bool, opt ...Option) (*SessionShadowResult, error) {
+	if sessionId == "" {
+		return nil, fmt.Errorf("empty sessionId value passed into Shadow request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	opts.postMap["read_write"] = readWrite
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("sessions/%s:shadow", sessionId), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Shadow request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Shadow call: %w", err)
+	}
+
+	target := new(SessionShadowResult)
+	target.Item = new(SessionShadow)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Shadow response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// ApproveShadow approves a pending read-write shadow of the session.
+func (c *Client) ApproveShadow(ctx context.Context, sessionId, shadowId string, opt ...Option) (*SessionUpdateResult, error) {
+	if sessionId == "" {
+		return nil, fmt.Errorf("empty sessionId value passed into ApproveShadow request")
+	}
+	if shadowId == "" {
+		return nil, fmt.Errorf("empty shadowId value passed into ApproveShadow request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	opts.postMap["shadow_id"] = shadowId
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("sessions/%s:approve-shadow", sessionId), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ApproveShadow request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ApproveShadow call: %w", err)
+	}
+
+	target := new(SessionUpdateResult)
+	target.Item = new(Session)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ApproveShadow response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
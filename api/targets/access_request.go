@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package targets
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type AccessRequestReadResult struct {
+	Item     *AccessRequest
+	response *api.Response
+}
+
+func (n AccessRequestReadResult) GetItem() *AccessRequest {
+	return n.Item
+}
+
+func (n AccessRequestReadResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type AccessRequestListResult struct {
+	Items    []*AccessRequest
+	response *api.Response
+}
+
+func (n AccessRequestListResult) GetItems() []*AccessRequest {
+	return n.Items
+}
+
+func (n AccessRequestListResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// ListAccessRequests returns the access requests made by users to authorize
+// sessions to the target.
+func (c *Client) ListAccessRequests(ctx context.Context, targetId string, opt ...Option) (*AccessRequestListResult, error) {
+	if targetId == "" {
+		return nil, fmt.Errorf("empty targetId value passed into ListAccessRequests request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("targets/%s:approve", url.PathEscape(targetId)), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListAccessRequests request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListAccessRequests call: %w", err)
+	}
+
+	target := new(AccessRequestListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListAccessRequests response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// ApproveAccessRequest approves the pending access request for the target.
+// Use WithValidForSeconds to control how long the approval can be redeemed
+// for, or WithDeny to deny the access request instead.
+func (c *Client) ApproveAccessRequest(ctx context.Context, targetId, accessRequestId string, opt ...Option) (*AccessRequestReadResult, error) {
+	if targetId == "" {
+		return nil, fmt.Errorf("empty targetId value passed into ApproveAccessRequest request")
+	}
+	if accessRequestId == "" {
+		return nil, fmt.Errorf("empty accessRequestId value passed into ApproveAccessRequest request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["access_request_id"] = accessRequestId
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("targets/%s:approve", url.PathEscape(targetId)), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ApproveAccessRequest request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ApproveAccessRequest call: %w", err)
+	}
+
+	target := new(AccessRequestReadResult)
+	target.Item = new(AccessRequest)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ApproveAccessRequest response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
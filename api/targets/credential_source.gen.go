@@ -11,4 +11,5 @@ type CredentialSource struct {
 	CredentialStoreId string `json:"credential_store_id,omitempty"`
 	Type              string `json:"type,omitempty"`
 	CredentialType    string `json:"credential_type,omitempty"`
+	ExclusiveCheckout bool   `json:"exclusive_checkout,omitempty"`
 }
@@ -103,6 +103,12 @@ func WithRecursive(recurse bool) Option {
 	}
 }
 
+func WithAccessRequestId(inAccessRequestId string) Option {
+	return func(o *options) {
+		o.postMap["access_request_id"] = inAccessRequestId
+	}
+}
+
 func WithAddress(inAddress string) Option {
 	return func(o *options) {
 		o.postMap["address"] = inAddress
@@ -115,6 +121,18 @@ func DefaultAddress() Option {
 	}
 }
 
+func WithApprovalRequired(inApprovalRequired bool) Option {
+	return func(o *options) {
+		o.postMap["approval_required"] = inApprovalRequired
+	}
+}
+
+func DefaultApprovalRequired() Option {
+	return func(o *options) {
+		o.postMap["approval_required"] = nil
+	}
+}
+
 func WithAttributes(inAttributes map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["attributes"] = inAttributes
@@ -127,6 +145,30 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithBandwidthLimitDown(inBandwidthLimitDown int64) Option {
+	return func(o *options) {
+		o.postMap["bandwidth_limit_down"] = inBandwidthLimitDown
+	}
+}
+
+func DefaultBandwidthLimitDown() Option {
+	return func(o *options) {
+		o.postMap["bandwidth_limit_down"] = nil
+	}
+}
+
+func WithBandwidthLimitUp(inBandwidthLimitUp int64) Option {
+	return func(o *options) {
+		o.postMap["bandwidth_limit_up"] = inBandwidthLimitUp
+	}
+}
+
+func DefaultBandwidthLimitUp() Option {
+	return func(o *options) {
+		o.postMap["bandwidth_limit_up"] = nil
+	}
+}
+
 func WithBrokeredCredentialSourceIds(inBrokeredCredentialSourceIds []string) Option {
 	return func(o *options) {
 		o.postMap["brokered_credential_source_ids"] = inBrokeredCredentialSourceIds
@@ -187,6 +229,30 @@ func DefaultTcpTargetDefaultClientPort() Option {
 	}
 }
 
+func WithUdpTargetDefaultClientPort(inDefaultClientPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_client_port"] = inDefaultClientPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultUdpTargetDefaultClientPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_client_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSshTargetDefaultPort(inDefaultPort uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -235,6 +301,36 @@ func DefaultTcpTargetDefaultPort() Option {
 	}
 }
 
+func WithUdpTargetDefaultPort(inDefaultPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = inDefaultPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultUdpTargetDefaultPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithDeny(inDeny bool) Option {
+	return func(o *options) {
+		o.postMap["deny"] = inDeny
+	}
+}
+
 func WithDescription(inDescription string) Option {
 	return func(o *options) {
 		o.postMap["description"] = inDescription
@@ -271,12 +367,36 @@ func WithSshTargetEnableSessionRecording(inEnableSessionRecording bool) Option {
 	}
 }
 
+func WithExclusiveCheckoutCredentialSourceIds(inExclusiveCheckoutCredentialSourceIds []string) Option {
+	return func(o *options) {
+		o.postMap["exclusive_checkout_credential_source_ids"] = inExclusiveCheckoutCredentialSourceIds
+	}
+}
+
+func DefaultExclusiveCheckoutCredentialSourceIds() Option {
+	return func(o *options) {
+		o.postMap["exclusive_checkout_credential_source_ids"] = nil
+	}
+}
+
 func WithHostId(inHostId string) Option {
 	return func(o *options) {
 		o.postMap["host_id"] = inHostId
 	}
 }
 
+func WithIdleTimeout(inIdleTimeout uint32) Option {
+	return func(o *options) {
+		o.postMap["idle_timeout"] = inIdleTimeout
+	}
+}
+
+func DefaultIdleTimeout() Option {
+	return func(o *options) {
+		o.postMap["idle_timeout"] = nil
+	}
+}
+
 func WithIngressWorkerFilter(inIngressWorkerFilter string) Option {
 	return func(o *options) {
 		o.postMap["ingress_worker_filter"] = inIngressWorkerFilter
@@ -313,6 +433,18 @@ func DefaultName() Option {
 	}
 }
 
+func WithReason(inReason string) Option {
+	return func(o *options) {
+		o.postMap["reason"] = inReason
+	}
+}
+
+func WithReverse(inReverse bool) Option {
+	return func(o *options) {
+		o.postMap["reverse"] = inReverse
+	}
+}
+
 func WithScopeId(inScopeId string) Option {
 	return func(o *options) {
 		o.postMap["scope_id"] = inScopeId
@@ -325,6 +457,30 @@ func WithScopeName(inScopeName string) Option {
 	}
 }
 
+func WithSessionBandwidthLimitDown(inSessionBandwidthLimitDown int64) Option {
+	return func(o *options) {
+		o.postMap["session_bandwidth_limit_down"] = inSessionBandwidthLimitDown
+	}
+}
+
+func DefaultSessionBandwidthLimitDown() Option {
+	return func(o *options) {
+		o.postMap["session_bandwidth_limit_down"] = nil
+	}
+}
+
+func WithSessionBandwidthLimitUp(inSessionBandwidthLimitUp int64) Option {
+	return func(o *options) {
+		o.postMap["session_bandwidth_limit_up"] = inSessionBandwidthLimitUp
+	}
+}
+
+func DefaultSessionBandwidthLimitUp() Option {
+	return func(o *options) {
+		o.postMap["session_bandwidth_limit_up"] = nil
+	}
+}
+
 func WithSessionConnectionLimit(inSessionConnectionLimit int32) Option {
 	return func(o *options) {
 		o.postMap["session_connection_limit"] = inSessionConnectionLimit
@@ -337,6 +493,18 @@ func DefaultSessionConnectionLimit() Option {
 	}
 }
 
+func WithSessionConnectionRateLimit(inSessionConnectionRateLimit int32) Option {
+	return func(o *options) {
+		o.postMap["session_connection_rate_limit"] = inSessionConnectionRateLimit
+	}
+}
+
+func DefaultSessionConnectionRateLimit() Option {
+	return func(o *options) {
+		o.postMap["session_connection_rate_limit"] = nil
+	}
+}
+
 func WithSessionMaxSeconds(inSessionMaxSeconds uint32) Option {
 	return func(o *options) {
 		o.postMap["session_max_seconds"] = inSessionMaxSeconds
@@ -373,6 +541,12 @@ func DefaultSshTargetStorageBucketId() Option {
 	}
 }
 
+func WithValidForSeconds(inValidForSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["valid_for_seconds"] = inValidForSeconds
+	}
+}
+
 func WithWorkerFilter(inWorkerFilter string) Option {
 	return func(o *options) {
 		o.postMap["worker_filter"] = inWorkerFilter
@@ -384,3 +558,15 @@ func DefaultWorkerFilter() Option {
 		o.postMap["worker_filter"] = nil
 	}
 }
+
+func WithWorkerSelectionStrategy(inWorkerSelectionStrategy string) Option {
+	return func(o *options) {
+		o.postMap["worker_selection_strategy"] = inWorkerSelectionStrategy
+	}
+}
+
+func DefaultWorkerSelectionStrategy() Option {
+	return func(o *options) {
+		o.postMap["worker_selection_strategy"] = nil
+	}
+}
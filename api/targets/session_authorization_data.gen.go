@@ -11,18 +11,25 @@ import (
 )
 
 type SessionAuthorizationData struct {
-	SessionId         string            `json:"session_id,omitempty"`
-	TargetId          string            `json:"target_id,omitempty"`
-	Scope             *scopes.ScopeInfo `json:"scope,omitempty"`
-	CreatedTime       time.Time         `json:"created_time,omitempty"`
-	Type              string            `json:"type,omitempty"`
-	ConnectionLimit   int32             `json:"connection_limit,omitempty"`
-	EndpointPort      uint32            `json:"endpoint_port,omitempty"`
-	Expiration        time.Time         `json:"expiration,omitempty"`
-	Certificate       []byte            `json:"certificate,omitempty"`
-	PrivateKey        []byte            `json:"private_key,omitempty"`
-	HostId            string            `json:"host_id,omitempty"`
-	Endpoint          string            `json:"endpoint,omitempty"`
-	WorkerInfo        []*WorkerInfo     `json:"worker_info,omitempty"`
-	DefaultClientPort uint32            `json:"default_client_port,omitempty"`
+	SessionId                string            `json:"session_id,omitempty"`
+	TargetId                 string            `json:"target_id,omitempty"`
+	Scope                    *scopes.ScopeInfo `json:"scope,omitempty"`
+	CreatedTime              time.Time         `json:"created_time,omitempty"`
+	Type                     string            `json:"type,omitempty"`
+	ConnectionLimit          int32             `json:"connection_limit,omitempty"`
+	EndpointPort             uint32            `json:"endpoint_port,omitempty"`
+	Expiration               time.Time         `json:"expiration,omitempty"`
+	Certificate              []byte            `json:"certificate,omitempty"`
+	PrivateKey               []byte            `json:"private_key,omitempty"`
+	HostId                   string            `json:"host_id,omitempty"`
+	Endpoint                 string            `json:"endpoint,omitempty"`
+	WorkerInfo               []*WorkerInfo     `json:"worker_info,omitempty"`
+	DefaultClientPort        uint32            `json:"default_client_port,omitempty"`
+	TargetBandwidthLimitUp   int64             `json:"target_bandwidth_limit_up,string,omitempty"`
+	TargetBandwidthLimitDown int64             `json:"target_bandwidth_limit_down,string,omitempty"`
+	BandwidthLimitUp         int64             `json:"bandwidth_limit_up,string,omitempty"`
+	BandwidthLimitDown       int64             `json:"bandwidth_limit_down,string,omitempty"`
+	ConnectionRateLimit      int32             `json:"connection_rate_limit,omitempty"`
+	IdleTimeout              uint32            `json:"idle_timeout,omitempty"`
+	Reverse                  bool              `json:"reverse,omitempty"`
 }
@@ -41,6 +41,14 @@ type Target struct {
 	Attributes                             map[string]interface{} `json:"attributes,omitempty"`
 	AuthorizedActions                      []string               `json:"authorized_actions,omitempty"`
 	Address                                string                 `json:"address,omitempty"`
+	ApprovalRequired                       bool                   `json:"approval_required,omitempty"`
+	WorkerSelectionStrategy                string                 `json:"worker_selection_strategy,omitempty"`
+	BandwidthLimitUp                       int64                  `json:"bandwidth_limit_up,string,omitempty"`
+	BandwidthLimitDown                     int64                  `json:"bandwidth_limit_down,string,omitempty"`
+	SessionBandwidthLimitUp                int64                  `json:"session_bandwidth_limit_up,string,omitempty"`
+	SessionBandwidthLimitDown              int64                  `json:"session_bandwidth_limit_down,string,omitempty"`
+	SessionConnectionRateLimit             int32                  `json:"session_connection_rate_limit,omitempty"`
+	IdleTimeout                            uint32                 `json:"idle_timeout,omitempty"`
 
 	response *api.Response
 }
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type PermissionExplanationReadResult struct {
+	Item     *PermissionExplanation
+	response *api.Response
+}
+
+func (n PermissionExplanationReadResult) GetItem() *PermissionExplanation {
+	return n.Item
+}
+
+func (n PermissionExplanationReadResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// ExplainPermissions evaluates whether the user is allowed to perform the
+// action on the resource, returning the grants that allow it along with the
+// roles and principals they come from, or the reasons no grant matched.
+func (c *Client) ExplainPermissions(ctx context.Context, userId, resourceId, action string, opt ...Option) (*PermissionExplanationReadResult, error) {
+	if userId == "" {
+		return nil, fmt.Errorf("empty userId value passed into ExplainPermissions request")
+	}
+	if resourceId == "" {
+		return nil, fmt.Errorf("empty resourceId value passed into ExplainPermissions request")
+	}
+	if action == "" {
+		return nil, fmt.Errorf("empty action value passed into ExplainPermissions request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("users/%s:explain-permissions", url.PathEscape(userId)), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ExplainPermissions request: %w", err)
+	}
+
+	q := url.Values{}
+	q.Add("resource_id", resourceId)
+	q.Add("action", action)
+	for k, v := range opts.queryMap {
+		q.Add(k, v)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ExplainPermissions call: %w", err)
+	}
+
+	target := new(PermissionExplanationReadResult)
+	target.Item = new(PermissionExplanation)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ExplainPermissions response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/aliases"
+)
+
+type ResolvableAliasesListResult struct {
+	Items    []*aliases.Alias `json:"items,omitempty"`
+	response *api.Response
+}
+
+func (n ResolvableAliasesListResult) GetItems() []*aliases.Alias {
+	return n.Items
+}
+
+func (n ResolvableAliasesListResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// ListResolvableAliases returns the aliases the user can use in place of a
+// target id when authorizing a session. Users can only list the aliases they
+// themselves can resolve.
+func (c *Client) ListResolvableAliases(ctx context.Context, userId string, opt ...Option) (*ResolvableAliasesListResult, error) {
+	if userId == "" {
+		return nil, fmt.Errorf("empty userId value passed into ListResolvableAliases request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("users/%s:list-resolvable-aliases", url.PathEscape(userId)), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListResolvableAliases request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListResolvableAliases call: %w", err)
+	}
+
+	target := new(ResolvableAliasesListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListResolvableAliases response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
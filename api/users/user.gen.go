@@ -18,21 +18,22 @@ import (
 )
 
 type User struct {
-	Id                string            `json:"id,omitempty"`
-	ScopeId           string            `json:"scope_id,omitempty"`
-	Scope             *scopes.ScopeInfo `json:"scope,omitempty"`
-	Name              string            `json:"name,omitempty"`
-	Description       string            `json:"description,omitempty"`
-	CreatedTime       time.Time         `json:"created_time,omitempty"`
-	UpdatedTime       time.Time         `json:"updated_time,omitempty"`
-	Version           uint32            `json:"version,omitempty"`
-	AccountIds        []string          `json:"account_ids,omitempty"`
-	Accounts          []*Account        `json:"accounts,omitempty"`
-	AuthorizedActions []string          `json:"authorized_actions,omitempty"`
-	LoginName         string            `json:"login_name,omitempty"`
-	FullName          string            `json:"full_name,omitempty"`
-	Email             string            `json:"email,omitempty"`
-	PrimaryAccountId  string            `json:"primary_account_id,omitempty"`
+	Id                string                 `json:"id,omitempty"`
+	ScopeId           string                 `json:"scope_id,omitempty"`
+	Scope             *scopes.ScopeInfo      `json:"scope,omitempty"`
+	Name              string                 `json:"name,omitempty"`
+	Description       string                 `json:"description,omitempty"`
+	CreatedTime       time.Time              `json:"created_time,omitempty"`
+	UpdatedTime       time.Time              `json:"updated_time,omitempty"`
+	Version           uint32                 `json:"version,omitempty"`
+	AccountIds        []string               `json:"account_ids,omitempty"`
+	Accounts          []*Account             `json:"accounts,omitempty"`
+	AuthorizedActions []string               `json:"authorized_actions,omitempty"`
+	LoginName         string                 `json:"login_name,omitempty"`
+	FullName          string                 `json:"full_name,omitempty"`
+	Email             string                 `json:"email,omitempty"`
+	PrimaryAccountId  string                 `json:"primary_account_id,omitempty"`
+	Attributes        map[string]interface{} `json:"attributes,omitempty"`
 
 	response *api.Response
 }
@@ -37,6 +37,8 @@ type Worker struct {
 	ApiTags                            map[string][]string `json:"api_tags,omitempty"`
 	ReleaseVersion                     string              `json:"release_version,omitempty"`
 	DirectlyConnectedDownstreamWorkers []string            `json:"directly_connected_downstream_workers,omitempty"`
+	OperationalState                   string              `json:"operational_state,omitempty"`
+	DrainDeadline                      time.Time           `json:"drain_deadline,omitempty"`
 	AuthorizedActions                  []string            `json:"authorized_actions,omitempty"`
 
 	response *api.Response
@@ -7,4 +7,8 @@ import (
 	// Enable tcp target support.
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets/tcp"
 	_ "github.com/hashicorp/boundary/internal/target/tcp"
+
+	// Enable udp target support.
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets/udp"
+	_ "github.com/hashicorp/boundary/internal/target/udp"
 )
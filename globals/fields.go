@@ -74,7 +74,9 @@ const (
 	DnsNamesField                               = "dns_names"
 	SecretsHmacField                            = "secrets_hmac"
 	ExternalIdField                             = "external_id"
+	HealthField                                 = "health"
 	ExternalNameField                           = "external_name"
+	ExclusiveCheckoutCredentialSourceIdsField   = "exclusive_checkout_credential_source_ids"
 	InjectedApplicationCredentialSourceIdsField = "injected_application_credential_source_ids"
 	InjectedApplicationCredentialSourcesField   = "injected_application_credential_sources"
 	ConnectionsField                            = "connections"
@@ -119,4 +121,24 @@ const (
 	StoragePolicyIdField                        = "storage_policy_id"
 	RetainUntilField                            = "retain_until"
 	DeleteAfterField                            = "delete_after"
+	ApprovalRequiredField                       = "approval_required"
+	WorkerSelectionStrategyField                = "worker_selection_strategy"
+	BandwidthLimitUpField                       = "bandwidth_limit_up"
+	BandwidthLimitDownField                     = "bandwidth_limit_down"
+	SessionBandwidthLimitUpField                = "session_bandwidth_limit_up"
+	SessionBandwidthLimitDownField              = "session_bandwidth_limit_down"
+	SessionConnectionRateLimitField             = "session_connection_rate_limit"
+	IdleTimeoutField                            = "idle_timeout"
+	AccessRequestIdField                        = "access_request_id"
+	ValidForSecondsField                        = "valid_for_seconds"
+	ValueField                                  = "value"
+	DestinationIdField                          = "destination_id"
+	ShadowsField                                = "shadows"
+	ShadowIdField                               = "shadow_id"
+	ReadWriteField                              = "read_write"
+	OperationalStateField                       = "operational_state"
+	DrainDeadlineField                          = "drain_deadline"
+	DrainTimeoutField                           = "drain_timeout"
+	ReverseField                                = "reverse"
+	CredentialIdField                           = "credential_id"
 )
@@ -42,6 +42,20 @@ const (
 	// AccountPrefix defines the prefix for Account public ids.
 	LdapAccountPrefix = "acctldap"
 
+	// JwtAuthMethodPrefix defines the prefix for JWT AuthMethod public ids
+	JwtAuthMethodPrefix = "amjwt"
+	// JwtAccountPrefix defines the prefix for JWT Account public ids
+	JwtAccountPrefix = "acctjwt"
+	// JwtManagedGroupPrefix defines the prefix for JWT ManagedGroup public ids
+	JwtManagedGroupPrefix = "mgjwt"
+
+	// SamlAuthMethodPrefix defines the prefix for SAML AuthMethod public ids
+	SamlAuthMethodPrefix = "amsaml"
+	// SamlAccountPrefix defines the prefix for SAML Account public ids
+	SamlAccountPrefix = "acctsaml"
+	// SamlManagedGroupPrefix defines the prefix for SAML ManagedGroup public ids
+	SamlManagedGroupPrefix = "mgsaml"
+
 	// ProjectPrefix is the prefix for project scopes
 	ProjectPrefix = "p"
 	// OrgPrefix is the prefix for org scopes
@@ -72,6 +86,35 @@ const (
 	// DynamicCredentialPrefix is the prefix for Vault dynamic credentials
 	VaultDynamicCredentialPrefix = "cdvlt"
 
+	// PluginCredentialStorePrefix is the prefix for plugin credential stores
+	PluginCredentialStorePrefix = "csplg"
+	// PluginCredentialLibraryPrefix is the prefix for plugin credential
+	// libraries
+	PluginCredentialLibraryPrefix = "clplg"
+	// PluginDynamicCredentialPrefix is the prefix for plugin dynamic
+	// credentials
+	PluginDynamicCredentialPrefix = "cdplg"
+
+	// SshCaCredentialStorePrefix is the prefix for SSH certificate authority
+	// credential stores
+	SshCaCredentialStorePrefix = "csssh"
+	// SshCaCredentialLibraryPrefix is the prefix for SSH certificate authority
+	// credential libraries
+	SshCaCredentialLibraryPrefix = "clssh"
+	// SshCaDynamicCredentialPrefix is the prefix for SSH certificates issued
+	// by SSH certificate authority credential libraries
+	SshCaDynamicCredentialPrefix = "cdssh"
+
+	// PostgresCredentialStorePrefix is the prefix for Postgres credential
+	// stores
+	PostgresCredentialStorePrefix = "cspg"
+	// PostgresCredentialLibraryPrefix is the prefix for Postgres credential
+	// libraries
+	PostgresCredentialLibraryPrefix = "clpg"
+	// PostgresDynamicCredentialPrefix is the prefix for database roles
+	// issued by Postgres credential libraries
+	PostgresDynamicCredentialPrefix = "cdpg"
+
 	// UsernamePasswordCredentialPrefix is the prefix for username/password
 	// creds
 	UsernamePasswordCredentialPrefix = "credup"
@@ -111,6 +154,8 @@ const (
 	TcpTargetPrefix = "ttcp"
 	// SshTargetPrefix is the prefix for TCP targets
 	SshTargetPrefix = "tssh"
+	// UdpTargetPrefix is the prefix for UDP targets
+	UdpTargetPrefix = "tudp"
 
 	// WorkerPrefix is the prefix for workers
 	WorkerPrefix = "w"
@@ -127,6 +172,9 @@ const (
 
 	// StoragePolicyPrefix for storage policies.
 	StoragePolicyPrefix = "pst"
+
+	// TargetAliasPrefix is the prefix for aliases to targets
+	TargetAliasPrefix = "alt"
 )
 
 type ResourceInfo struct {
@@ -180,6 +228,32 @@ var prefixToResourceType = map[string]ResourceInfo{
 		Subtype: UnknownSubtype,
 	},
 
+	JwtAuthMethodPrefix: {
+		Type:    resource.AuthMethod,
+		Subtype: UnknownSubtype,
+	},
+	JwtAccountPrefix: {
+		Type:    resource.Account,
+		Subtype: UnknownSubtype,
+	},
+	JwtManagedGroupPrefix: {
+		Type:    resource.ManagedGroup,
+		Subtype: UnknownSubtype,
+	},
+
+	SamlAuthMethodPrefix: {
+		Type:    resource.AuthMethod,
+		Subtype: UnknownSubtype,
+	},
+	SamlAccountPrefix: {
+		Type:    resource.Account,
+		Subtype: UnknownSubtype,
+	},
+	SamlManagedGroupPrefix: {
+		Type:    resource.ManagedGroup,
+		Subtype: UnknownSubtype,
+	},
+
 	ProjectPrefix: {
 		Type:    resource.Scope,
 		Subtype: UnknownSubtype,
@@ -232,6 +306,45 @@ var prefixToResourceType = map[string]ResourceInfo{
 		Subtype: UnknownSubtype,
 	},
 
+	PluginCredentialStorePrefix: {
+		Type:    resource.CredentialStore,
+		Subtype: UnknownSubtype,
+	},
+	PluginCredentialLibraryPrefix: {
+		Type:    resource.CredentialLibrary,
+		Subtype: UnknownSubtype,
+	},
+	PluginDynamicCredentialPrefix: {
+		Type:    resource.Credential,
+		Subtype: UnknownSubtype,
+	},
+
+	SshCaCredentialStorePrefix: {
+		Type:    resource.CredentialStore,
+		Subtype: UnknownSubtype,
+	},
+	SshCaCredentialLibraryPrefix: {
+		Type:    resource.CredentialLibrary,
+		Subtype: UnknownSubtype,
+	},
+	SshCaDynamicCredentialPrefix: {
+		Type:    resource.Credential,
+		Subtype: UnknownSubtype,
+	},
+
+	PostgresCredentialStorePrefix: {
+		Type:    resource.CredentialStore,
+		Subtype: UnknownSubtype,
+	},
+	PostgresCredentialLibraryPrefix: {
+		Type:    resource.CredentialLibrary,
+		Subtype: UnknownSubtype,
+	},
+	PostgresDynamicCredentialPrefix: {
+		Type:    resource.Credential,
+		Subtype: UnknownSubtype,
+	},
+
 	UsernamePasswordCredentialPrefix: {
 		Type:    resource.Credential,
 		Subtype: UnknownSubtype,
@@ -300,6 +413,10 @@ var prefixToResourceType = map[string]ResourceInfo{
 		Type:    resource.Target,
 		Subtype: UnknownSubtype,
 	},
+	UdpTargetPrefix: {
+		Type:    resource.Target,
+		Subtype: UnknownSubtype,
+	},
 
 	WorkerPrefix: {
 		Type:    resource.Worker,
@@ -320,6 +437,11 @@ var prefixToResourceType = map[string]ResourceInfo{
 		Type:    resource.Policy,
 		Subtype: UnknownSubtype,
 	},
+
+	TargetAliasPrefix: {
+		Type:    resource.Alias,
+		Subtype: UnknownSubtype,
+	},
 }
 
 var resourceTypeToPrefixes map[resource.Type][]string = func() map[resource.Type][]string {
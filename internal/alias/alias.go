@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package alias provides aliases, which are DNS-style names that resolve to a
+// target and, optionally, to a specific host of the target. Aliases are
+// unique across the cluster and are owned by the global scope.
+package alias
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/alias/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"google.golang.org/protobuf/proto"
+)
+
+// TargetType is the type of aliases which resolve to targets, currently the
+// only type of alias.
+const TargetType = "target"
+
+// maxValueLength is the maximum length of an alias value, which is the
+// maximum length of a DNS name.
+const maxValueLength = 253
+
+var valueRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*$`)
+
+// ValidValue reports whether v can be used as the value of an alias. Values
+// are DNS-style names made of lower case labels separated by dots.
+func ValidValue(v string) bool {
+	return len(v) <= maxValueLength && valueRegexp.MatchString(v)
+}
+
+// NormalizeValue returns v in the form it is stored in. Values are case
+// insensitive, so they are stored in lower case.
+func NormalizeValue(v string) string {
+	return strings.ToLower(strings.TrimSpace(v))
+}
+
+// An Alias is a name which resolves to a target. It is owned by the global
+// scope.
+type Alias struct {
+	*store.Alias
+	tableName string `gorm:"-"`
+}
+
+// NewAlias creates a new in memory Alias with the given value assigned to
+// scopeId, which must be the global scope. Name, description, destination id
+// and host id are the only valid options. All other options are ignored.
+func NewAlias(ctx context.Context, scopeId, value string, opt ...Option) (*Alias, error) {
+	const op = "alias.NewAlias"
+	value = NormalizeValue(value)
+	switch {
+	case scopeId != scope.Global.String():
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "scope id must be global")
+	case value == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing value")
+	case !ValidValue(value):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("value %q is not a valid dns name", value))
+	}
+	opts := getOpts(opt...)
+	if opts.withHostId != "" && opts.withDestinationId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "host id provided without a destination id")
+	}
+	return &Alias{
+		Alias: &store.Alias{
+			ScopeId:       scopeId,
+			Value:         value,
+			Name:          opts.withName,
+			Description:   opts.withDescription,
+			DestinationId: opts.withDestinationId,
+			HostId:        opts.withHostId,
+		},
+	}, nil
+}
+
+func (a *Alias) clone() *Alias {
+	cp := proto.Clone(a.Alias)
+	return &Alias{
+		Alias: cp.(*store.Alias),
+	}
+}
+
+// TableName returns the table name for the alias.
+func (a *Alias) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return "alias_target"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (a *Alias) SetTableName(n string) {
+	a.tableName = n
+}
+
+// GetResourceType returns the resource type of the Alias
+func (a *Alias) GetResourceType() resource.Type {
+	return resource.Alias
+}
+
+func allocAlias() *Alias {
+	return &Alias{
+		Alias: &store.Alias{},
+	}
+}
+
+func newAliasId(ctx context.Context) (string, error) {
+	const op = "alias.newAliasId"
+	id, err := db.NewPublicId(ctx, globals.TargetAliasPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newAliasMetadata(a *Alias, op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{a.GetPublicId()},
+		"resource-type":      []string{"target alias"},
+		"op-type":            []string{op.String()},
+	}
+	if a.ScopeId != "" {
+		metadata["scope-id"] = []string{a.ScopeId}
+	}
+	return metadata
+}
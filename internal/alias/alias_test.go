@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package alias
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/alias/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidValue(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{value: "prod-db", want: true},
+		{value: "prod-db.example", want: true},
+		{value: "1.example.com", want: true},
+		{value: "a", want: true},
+		{value: "", want: false},
+		{value: "Prod-DB", want: false},
+		{value: "-prod", want: false},
+		{value: "prod-", want: false},
+		{value: "prod..db", want: false},
+		{value: "prod.db.", want: false},
+		{value: "prod_db", want: false},
+		{value: "ttcp_1234567890", want: false},
+		{value: "prod db", want: false},
+		{value: strings.Repeat("a", 64), want: false},
+		{value: strings.Repeat("a.", 126) + "a", want: true},
+		{value: strings.Repeat("a.", 127) + "a", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.want, ValidValue(tt.value))
+		})
+	}
+}
+
+func TestNewAlias(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		scopeId string
+		value   string
+		opts    []Option
+		want    *Alias
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			scopeId: "global",
+			value:   "prod-db.example",
+			opts: []Option{
+				WithName("name"),
+				WithDescription("description"),
+				WithDestinationId("ttcp_1234567890"),
+				WithHostId("hst_1234567890"),
+			},
+			want: &Alias{Alias: &store.Alias{
+				ScopeId:       "global",
+				Value:         "prod-db.example",
+				Name:          "name",
+				Description:   "description",
+				DestinationId: "ttcp_1234567890",
+				HostId:        "hst_1234567890",
+			}},
+		},
+		{
+			name:    "normalized-value",
+			scopeId: "global",
+			value:   " Prod-DB.Example ",
+			want: &Alias{Alias: &store.Alias{
+				ScopeId: "global",
+				Value:   "prod-db.example",
+			}},
+		},
+		{
+			name:    "org-scope",
+			scopeId: "o_1234567890",
+			value:   "prod-db",
+			wantErr: true,
+		},
+		{
+			name:    "missing-value",
+			scopeId: "global",
+			wantErr: true,
+		},
+		{
+			name:    "invalid-value",
+			scopeId: "global",
+			value:   "prod_db",
+			wantErr: true,
+		},
+		{
+			name:    "host-without-destination",
+			scopeId: "global",
+			value:   "prod-db",
+			opts:    []Option{WithHostId("hst_1234567890")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAlias(ctx, tt.scopeId, tt.value, tt.opts...)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
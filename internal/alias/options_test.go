@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package alias
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithName", func(t *testing.T) {
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDestinationId", func(t *testing.T) {
+		opts := getOpts(WithDestinationId("ttcp_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withDestinationId = "ttcp_1234567890"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithHostId", func(t *testing.T) {
+		opts := getOpts(WithHostId("hst_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withHostId = "hst_1234567890"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		opts := getOpts(WithLimit(5))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithPublicId", func(t *testing.T) {
+		opts := getOpts(WithPublicId("alt_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withPublicId = "alt_1234567890"
+		assert.Equal(t, opts, testOpts)
+	})
+}
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package alias
+
+const (
+	listResolvableAliasesQuery = `
+select alias_target.public_id,
+       alias_target.value,
+       alias_target.destination_id,
+       alias_target.host_id,
+       target.project_id as destination_project_id
+  from alias_target
+  join target
+    on target.public_id = alias_target.destination_id
+order by alias_target.value;
+`
+)
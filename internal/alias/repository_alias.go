@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package alias
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// ResolvableAlias is an alias which resolves to a target, along with the
+// project of the target. The project is needed to determine whether a user
+// is permitted to connect to the target through the alias.
+type ResolvableAlias struct {
+	PublicId             string
+	Value                string
+	DestinationId        string
+	HostId               string
+	DestinationProjectId string
+}
+
+// CreateAlias inserts a into the repository and returns a new Alias
+// containing the alias's PublicId. a is not changed. a must contain a valid
+// ScopeId and Value. a must not contain a PublicId. The PublicId is generated
+// and assigned by this method. WithPublicId is the only valid option.
+//
+// a.Value must be unique across the cluster. If a.Name is set, it must be
+// unique within a.ScopeId. If a.HostId is set, a.DestinationId must be set
+// as well.
+func (r *Repository) CreateAlias(ctx context.Context, a *Alias, opt ...Option) (*Alias, error) {
+	const op = "alias.(Repository).CreateAlias"
+	switch {
+	case a == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil Alias")
+	case a.Alias == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded Alias")
+	case a.ScopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no scope id")
+	case a.Value == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no value")
+	case a.PublicId != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	case a.HostId != "" && a.DestinationId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "host id provided without a destination id")
+	}
+	a = a.clone()
+	a.Value = NormalizeValue(a.Value)
+	if !ValidValue(a.Value) {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("value %q is not a valid dns name", a.Value))
+	}
+
+	opts := getOpts(opt...)
+	if opts.withPublicId != "" {
+		if !strings.HasPrefix(opts.withPublicId, globals.TargetAliasPrefix+"_") {
+			return nil, errors.New(ctx,
+				errors.InvalidPublicId,
+				op,
+				fmt.Sprintf("passed-in public ID %q has wrong prefix, should be %q", opts.withPublicId, globals.TargetAliasPrefix),
+			)
+		}
+		a.PublicId = opts.withPublicId
+	} else {
+		id, err := newAliasId(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		a.PublicId = id
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, a.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	metadata := newAliasMetadata(a, oplog.OpType_OP_TYPE_CREATE)
+
+	var newAlias *Alias
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newAlias = a.clone()
+			if err := w.Create(ctx, newAlias, db.WithOplog(oplogWrapper, metadata)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("alias value %q or name %q already exists", a.Value, a.Name)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in scope: %s", a.ScopeId)))
+	}
+	return newAlias, nil
+}
+
+// UpdateAlias updates the repository entry for a.PublicId with the values in
+// a for the fields listed in fieldMask. It returns a new Alias containing the
+// updated values and a count of the number of records updated. a is not
+// changed.
+//
+// a must contain a valid PublicId. Only a.Name, a.Description, a.Value,
+// a.DestinationId and a.HostId can be updated. Removing a.DestinationId
+// removes a.HostId as well.
+//
+// An attribute of a will be set to NULL in the database if the attribute in
+// a is the zero value and it is included in fieldMask.
+func (r *Repository) UpdateAlias(ctx context.Context, a *Alias, version uint32, fieldMask []string, _ ...Option) (*Alias, int, error) {
+	const op = "alias.(Repository).UpdateAlias"
+	switch {
+	case a == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil Alias")
+	case a.Alias == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil embedded Alias")
+	case a.PublicId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	case a.ScopeId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no scope id")
+	case len(fieldMask) == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+	a = a.clone()
+	a.Value = NormalizeValue(a.Value)
+
+	var dbMask, nullFields []string
+	for _, f := range fieldMask {
+		switch {
+		case strings.EqualFold("name", f) && a.Name == "":
+			nullFields = append(nullFields, "name")
+		case strings.EqualFold("name", f) && a.Name != "":
+			dbMask = append(dbMask, "name")
+		case strings.EqualFold("description", f) && a.Description == "":
+			nullFields = append(nullFields, "description")
+		case strings.EqualFold("description", f) && a.Description != "":
+			dbMask = append(dbMask, "description")
+		case strings.EqualFold("value", f) && a.Value == "":
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "value cannot be removed")
+		case strings.EqualFold("value", f) && !ValidValue(a.Value):
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("value %q is not a valid dns name", a.Value))
+		case strings.EqualFold("value", f):
+			dbMask = append(dbMask, "value")
+		case strings.EqualFold("destinationid", f) && a.DestinationId == "":
+			nullFields = append(nullFields, "DestinationId")
+		case strings.EqualFold("destinationid", f) && a.DestinationId != "":
+			dbMask = append(dbMask, "DestinationId")
+		case strings.EqualFold("hostid", f) && a.HostId == "":
+			nullFields = append(nullFields, "HostId")
+		case strings.EqualFold("hostid", f) && a.HostId != "":
+			dbMask = append(dbMask, "HostId")
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, a.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	metadata := newAliasMetadata(a, oplog.OpType_OP_TYPE_UPDATE)
+
+	var rowsUpdated int
+	var returnedAlias *Alias
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			returnedAlias = a.clone()
+			var err error
+			rowsUpdated, err = w.Update(
+				ctx,
+				returnedAlias,
+				dbMask,
+				nullFields,
+				db.WithOplog(oplogWrapper, metadata),
+				db.WithVersion(&version),
+			)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			// The host id may have been cleared by the database when the
+			// destination was removed, so read back the stored alias
+			if rowsUpdated == 1 {
+				returnedAlias = allocAlias()
+				returnedAlias.PublicId = a.PublicId
+				if err := reader.LookupByPublicId(ctx, returnedAlias); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in %s: alias value %q or name %q already exists", a.PublicId, a.Value, a.Name)))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in %s", a.PublicId)))
+	}
+
+	return returnedAlias, rowsUpdated, nil
+}
+
+// LookupAlias returns the Alias for id. Returns nil, nil if no Alias is
+// found for id.
+func (r *Repository) LookupAlias(ctx context.Context, id string, _ ...Option) (*Alias, error) {
+	const op = "alias.(Repository).LookupAlias"
+	if id == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	a := allocAlias()
+	a.PublicId = id
+	if err := r.reader.LookupByPublicId(ctx, a); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", id)))
+	}
+	return a, nil
+}
+
+// LookupAliasByValue returns the Alias with the given value. Values are case
+// insensitive. Returns nil, nil if no Alias has the value.
+func (r *Repository) LookupAliasByValue(ctx context.Context, value string, _ ...Option) (*Alias, error) {
+	const op = "alias.(Repository).LookupAliasByValue"
+	value = NormalizeValue(value)
+	if value == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no value")
+	}
+	a := allocAlias()
+	if err := r.reader.LookupWhere(ctx, a, "value = ?", []any{value}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", value)))
+	}
+	return a, nil
+}
+
+// ListAliases returns a slice of the aliases in the scopes, ordered by value.
+// WithLimit is the only option supported.
+func (r *Repository) ListAliases(ctx context.Context, scopeIds []string, opt ...Option) ([]*Alias, error) {
+	const op = "alias.(Repository).ListAliases"
+	if len(scopeIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no scope ids")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var aliases []*Alias
+	if err := r.reader.SearchWhere(ctx, &aliases, "scope_id in (?)", []any{scopeIds}, db.WithLimit(limit), db.WithOrder("value")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return aliases, nil
+}
+
+// ListResolvableAliases returns every alias which resolves to a target, along
+// with the project of the target, ordered by value.
+func (r *Repository) ListResolvableAliases(ctx context.Context, _ ...Option) ([]*ResolvableAlias, error) {
+	const op = "alias.(Repository).ListResolvableAliases"
+	rows, err := r.reader.Query(ctx, listResolvableAliasesQuery, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var aliases []*ResolvableAlias
+	for rows.Next() {
+		if err := r.reader.ScanRows(ctx, rows, &aliases); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return aliases, nil
+}
+
+// DeleteAlias deletes id from the repository returning a count of the
+// number of records deleted.
+func (r *Repository) DeleteAlias(ctx context.Context, id string, _ ...Option) (int, error) {
+	const op = "alias.(Repository).DeleteAlias"
+	if id == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+
+	a := allocAlias()
+	a.PublicId = id
+	if err := r.reader.LookupByPublicId(ctx, a); err != nil {
+		if errors.IsNotFoundError(err) {
+			return db.NoRowsAffected, nil
+		}
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", id)))
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, a.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	metadata := newAliasMetadata(a, oplog.OpType_OP_TYPE_DELETE)
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			rowsDeleted, err = w.Delete(ctx, a.clone(), db.WithOplog(oplogWrapper, metadata))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("delete failed for %s", a.PublicId)))
+	}
+
+	return rowsDeleted, nil
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package alias_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/alias"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Alias(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+
+	catalog := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hosts := static.TestHosts(t, conn, catalog.GetPublicId(), 1)
+	tar := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test target", target.WithDefaultPort(22))
+
+	repo, err := alias.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	t.Run("create", func(t *testing.T) {
+		a, err := alias.NewAlias(ctx, "global", "Create.Example",
+			alias.WithName("create"),
+			alias.WithDestinationId(tar.GetPublicId()),
+			alias.WithHostId(hosts[0].GetPublicId()))
+		require.NoError(t, err)
+		got, err := repo.CreateAlias(ctx, a)
+		require.NoError(t, err)
+		assert.NotEmpty(t, got.GetPublicId())
+		assert.Equal(t, "create.example", got.GetValue())
+		assert.Equal(t, tar.GetPublicId(), got.GetDestinationId())
+		assert.Equal(t, hosts[0].GetPublicId(), got.GetHostId())
+
+		// Values are unique across the cluster
+		a, err = alias.NewAlias(ctx, "global", "create.example")
+		require.NoError(t, err)
+		_, err = repo.CreateAlias(ctx, a)
+		assert.True(t, errors.IsUniqueError(err))
+
+		// The destination must exist
+		a, err = alias.NewAlias(ctx, "global", "missing.example", alias.WithDestinationId("ttcp_doesnotexist"))
+		require.NoError(t, err)
+		_, err = repo.CreateAlias(ctx, a)
+		assert.Error(t, err)
+	})
+
+	t.Run("lookup", func(t *testing.T) {
+		a := alias.TestAlias(t, conn, "lookup.example", alias.WithDestinationId(tar.GetPublicId()))
+
+		got, err := repo.LookupAlias(ctx, a.GetPublicId())
+		require.NoError(t, err)
+		assert.Equal(t, a.GetValue(), got.GetValue())
+
+		got, err = repo.LookupAliasByValue(ctx, "LOOKUP.example")
+		require.NoError(t, err)
+		assert.Equal(t, a.GetPublicId(), got.GetPublicId())
+
+		got, err = repo.LookupAliasByValue(ctx, "nothing.example")
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		a := alias.TestAlias(t, conn, "update.example",
+			alias.WithDestinationId(tar.GetPublicId()),
+			alias.WithHostId(hosts[0].GetPublicId()))
+
+		a.Value = "updated.example"
+		a.Description = "updated"
+		got, n, err := repo.UpdateAlias(ctx, a, a.GetVersion(), []string{"Value", "Description"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, "updated.example", got.GetValue())
+		assert.Equal(t, "updated", got.GetDescription())
+
+		// Removing the destination removes the host as well
+		a.DestinationId = ""
+		got, n, err = repo.UpdateAlias(ctx, a, got.GetVersion(), []string{"DestinationId"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Empty(t, got.GetDestinationId())
+		assert.Empty(t, got.GetHostId())
+
+		a.Value = "not_valid"
+		_, _, err = repo.UpdateAlias(ctx, a, got.GetVersion(), []string{"Value"})
+		assert.Error(t, err)
+	})
+
+	t.Run("resolvable-and-delete", func(t *testing.T) {
+		tar2 := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "deleted target", target.WithDefaultPort(22))
+		a := alias.TestAlias(t, conn, "resolvable.example", alias.WithDestinationId(tar2.GetPublicId()))
+		alias.TestAlias(t, conn, "unresolvable.example")
+
+		resolvable, err := repo.ListResolvableAliases(ctx)
+		require.NoError(t, err)
+		var found *alias.ResolvableAlias
+		for _, r := range resolvable {
+			assert.NotEqual(t, "unresolvable.example", r.Value)
+			if r.PublicId == a.GetPublicId() {
+				found = r
+			}
+		}
+		require.NotNil(t, found)
+		assert.Equal(t, prj.GetPublicId(), found.DestinationProjectId)
+
+		// Aliases outlive their destination
+		targetRepo, err := target.NewRepository(ctx, rw, rw, kmsCache)
+		require.NoError(t, err)
+		_, err = targetRepo.DeleteTarget(ctx, tar2.GetPublicId())
+		require.NoError(t, err)
+		got, err := repo.LookupAlias(ctx, a.GetPublicId())
+		require.NoError(t, err)
+		assert.Empty(t, got.GetDestinationId())
+
+		n, err := repo.DeleteAlias(ctx, a.GetPublicId())
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		got, err = repo.LookupAlias(ctx, a.GetPublicId())
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		aliases, err := repo.ListAliases(ctx, []string{"global"})
+		require.NoError(t, err)
+		assert.NotEmpty(t, aliases)
+		for i := 1; i < len(aliases); i++ {
+			assert.Less(t, aliases[i-1].GetValue(), aliases[i].GetValue())
+		}
+	})
+}
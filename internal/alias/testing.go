@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package alias
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAlias creates an alias with the provided value in the global scope.
+// WithName, WithDescription, WithDestinationId and WithHostId are supported.
+// If any errors are encountered during the creation of the alias, the test
+// will fail.
+func TestAlias(t testing.TB, conn *db.DB, value string, opt ...Option) *Alias {
+	t.Helper()
+	ctx := context.Background()
+	a, err := NewAlias(ctx, scope.Global.String(), value, opt...)
+	require.NoError(t, err)
+	a.PublicId, err = newAliasId(ctx)
+	require.NoError(t, err)
+
+	w := db.New(conn)
+	require.NoError(t, w.Create(ctx, a))
+	return a
+}
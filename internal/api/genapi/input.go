@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/gen/controller/api"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/accounts"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/aliases"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authtokens"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
@@ -230,6 +231,21 @@ var inputStructs = []*structInfo{
 		outFile:     "users/account.gen.go",
 		skipOptions: true,
 	},
+	{
+		inProto:     &users.GrantPrincipal{},
+		outFile:     "users/grant_principal.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &users.GrantExplanation{},
+		outFile:     "users/grant_explanation.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &users.PermissionExplanation{},
+		outFile:     "users/permission_explanation.gen.go",
+		skipOptions: true,
+	},
 	{
 		inProto: &users.User{},
 		outFile: "users/user.gen.go",
@@ -354,11 +370,39 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:        &authmethods.JwtAuthMethodAttributes{},
+		outFile:        "authmethods/jwt_auth_method_attributes.gen.go",
+		subtypeName:    "JwtAuthMethod",
+		parentTypeName: "AuthMethod",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:        &authmethods.SamlAuthMethodAttributes{},
+		outFile:        "authmethods/saml_auth_method_attributes.gen.go",
+		subtypeName:    "SamlAuthMethod",
+		parentTypeName: "AuthMethod",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto:     &authmethods.OidcAuthMethodAuthenticateStartResponse{},
 		outFile:     "authmethods/oidc_auth_method_authenticate_start_response.gen.go",
 		subtypeName: "OidcAuthMethod",
 	},
+	{
+		inProto:     &authmethods.SamlAuthMethodAuthenticateStartResponse{},
+		outFile:     "authmethods/saml_auth_method_authenticate_start_response.gen.go",
+		subtypeName: "SamlAuthMethod",
+	},
+	{
+		inProto:             &authmethods.ScimToken{},
+		outFile:             "authmethods/scim_token.gen.go",
+		createResponseTypes: []string{ReadResponseType, DeleteResponseType},
+	},
 	{
 		inProto: &authmethods.AuthMethod{},
 		outFile: "authmethods/authmethods.gen.go",
@@ -422,6 +466,24 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:        &accounts.JwtAccountAttributes{},
+		outFile:        "accounts/jwt_account_attributes.gen.go",
+		subtypeName:    "JwtAccount",
+		parentTypeName: "Account",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:        &accounts.SamlAccountAttributes{},
+		outFile:        "accounts/saml_account_attributes.gen.go",
+		subtypeName:    "SamlAccount",
+		parentTypeName: "Account",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &accounts.Account{},
 		outFile: "accounts/account.gen.go",
@@ -469,6 +531,36 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:     &managedgroups.JwtManagedGroupAttributes{},
+		outFile:     "managedgroups/jwt_managed_group_attributes.gen.go",
+		subtypeName: "JwtManagedGroup",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Filter",
+				SkipDefault: true,
+			},
+		},
+		parentTypeName: "ManagedGroup",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:     &managedgroups.SamlManagedGroupAttributes{},
+		outFile:     "managedgroups/saml_managed_group_attributes.gen.go",
+		subtypeName: "SamlManagedGroup",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Filter",
+				SkipDefault: true,
+			},
+		},
+		parentTypeName: "ManagedGroup",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &managedgroups.ManagedGroup{},
 		outFile: "managedgroups/managedgroups.gen.go",
@@ -509,6 +601,31 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:        &credentialstores.SshCaCredentialStoreAttributes{},
+		outFile:        "credentialstores/ssh_ca_credential_store_attributes.gen.go",
+		subtypeName:    "SshCaCredentialStore",
+		subtype:        "ssh-ca",
+		parentTypeName: "CredentialStore",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:        &credentialstores.PostgresCredentialStoreAttributes{},
+		outFile:        "credentialstores/postgres_credential_store_attributes.gen.go",
+		subtypeName:    "PostgresCredentialStore",
+		subtype:        "postgres",
+		parentTypeName: "CredentialStore",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:     &credentialstores.CredentialLease{},
+		outFile:     "credentialstores/credential_lease.gen.go",
+		subtypeName: "CredentialLease",
+	},
 	{
 		inProto: &credentialstores.CredentialStore{},
 		outFile: "credentialstores/credential_store.gen.go",
@@ -599,6 +716,40 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:     &credentiallibraries.SshCaCredentialLibraryAttributes{},
+		outFile:     "credentiallibraries/ssh_ca_credential_library_attributes.gen.go",
+		subtypeName: "SshCaCredentialLibrary",
+		subtype:     "ssh-ca",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Username",
+				SkipDefault: true,
+			},
+			{
+				Name:      "CriticalOptions",
+				FieldType: "map[string]string",
+			},
+			{
+				Name:      "Extensions",
+				FieldType: "map[string]string",
+			},
+		},
+		parentTypeName: "CredentialLibrary",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:        &credentiallibraries.PostgresCredentialLibraryAttributes{},
+		outFile:        "credentiallibraries/postgres_credential_library_attributes.gen.go",
+		subtypeName:    "PostgresCredentialLibrary",
+		subtype:        "postgres",
+		parentTypeName: "CredentialLibrary",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &credentiallibraries.CredentialLibrary{},
 		outFile: "credentiallibraries/credential_library.gen.go",
@@ -815,6 +966,43 @@ var inputStructs = []*structInfo{
 		recursiveListing:    true,
 	},
 
+	// Alias related resources
+	{
+		inProto: &aliases.Alias{},
+		outFile: "aliases/alias.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+			template.Must(template.New("").Funcs(
+				template.FuncMap{
+					"snakeCase": snakeCase,
+					"funcName": func() string {
+						return "Create"
+					},
+					"apiAction": func() string {
+						return ""
+					},
+					"extraRequiredParams": func() []requiredParam {
+						return []requiredParam{
+							{
+								Name:     "resourceType",
+								Typ:      "string",
+								PostType: "type",
+							},
+						}
+					},
+				},
+			).Parse(createTemplateStr)),
+			readTemplate,
+			updateTemplate,
+			deleteTemplate,
+			listTemplate,
+		},
+		pluralResourceName:  "aliases",
+		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
+		versionEnabled:      true,
+		recursiveListing:    true,
+	},
+
 	// Host related resources
 	{
 		inProto: &hostcatalogs.HostCatalog{},
@@ -940,10 +1128,24 @@ var inputStructs = []*structInfo{
 		outFile:     "targets/session_authorization.gen.go",
 		subtypeName: "SessionAuthorization",
 	},
+	{
+		inProto:     &targets.AccessRequest{},
+		outFile:     "targets/access_request.gen.go",
+		subtypeName: "AccessRequest",
+	},
 	{
 		inProto:     &targets.SessionAuthorizationData{},
 		outFile:     "targets/session_authorization_data.gen.go",
 		subtypeName: "SessionAuthorizationData",
+		fieldOverrides: []fieldInfo{
+			// int64 fields get marshalled by protobuf as strings, so we have
+			// to tell the json parser that their json representation is a
+			// string but they go into Go int64 types.
+			{Name: "TargetBandwidthLimitUp", JsonTags: []string{"string"}},
+			{Name: "TargetBandwidthLimitDown", JsonTags: []string{"string"}},
+			{Name: "BandwidthLimitUp", JsonTags: []string{"string"}},
+			{Name: "BandwidthLimitDown", JsonTags: []string{"string"}},
+		},
 	},
 	{
 		inProto:     &targets.WorkerInfo{},
@@ -959,6 +1161,15 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:        &targets.UdpTargetAttributes{},
+		outFile:        "targets/udp_target_attributes.gen.go",
+		subtypeName:    "UdpTarget",
+		parentTypeName: "Target",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto:        &targets.SshTargetAttributes{},
 		outFile:        "targets/ssh_target_attributes.gen.go",
@@ -1031,6 +1242,36 @@ var inputStructs = []*structInfo{
 				FieldType:   "string",
 				SkipDefault: true,
 			},
+			{
+				Name:        "AccessRequestId",
+				ProtoName:   "access_request_id",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
+			{
+				Name:        "Reason",
+				ProtoName:   "reason",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
+			{
+				Name:        "ValidForSeconds",
+				ProtoName:   "valid_for_seconds",
+				FieldType:   "uint32",
+				SkipDefault: true,
+			},
+			{
+				Name:        "Deny",
+				ProtoName:   "deny",
+				FieldType:   "bool",
+				SkipDefault: true,
+			},
+			{
+				Name:        "Reverse",
+				ProtoName:   "reverse",
+				FieldType:   "bool",
+				SkipDefault: true,
+			},
 			{
 				Name:      "BrokeredCredentialSourceIds",
 				ProtoName: "brokered_credential_source_ids",
@@ -1041,10 +1282,24 @@ var inputStructs = []*structInfo{
 				ProtoName: "injected_application_credential_source_ids",
 				FieldType: "[]string",
 			},
+			{
+				Name:      "ExclusiveCheckoutCredentialSourceIds",
+				ProtoName: "exclusive_checkout_credential_source_ids",
+				FieldType: "[]string",
+			},
 		},
 		versionEnabled:      true,
 		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
 		recursiveListing:    true,
+		fieldOverrides: []fieldInfo{
+			// int64 fields get marshalled by protobuf as strings, so we have
+			// to tell the json parser that their json representation is a
+			// string but they go into Go int64 types.
+			{Name: "BandwidthLimitUp", JsonTags: []string{"string"}},
+			{Name: "BandwidthLimitDown", JsonTags: []string{"string"}},
+			{Name: "SessionBandwidthLimitUp", JsonTags: []string{"string"}},
+			{Name: "SessionBandwidthLimitDown", JsonTags: []string{"string"}},
+		},
 	},
 	{
 		inProto: &sessions.SessionState{},
@@ -1061,6 +1316,10 @@ var inputStructs = []*structInfo{
 			{Name: "BytesDown", JsonTags: []string{"string"}},
 		},
 	},
+	{
+		inProto: &sessions.SessionShadow{},
+		outFile: "sessions/session_shadow.gen.go",
+	},
 	{
 		inProto: &sessions.Session{},
 		outFile: "sessions/session.gen.go",
@@ -103,6 +103,7 @@ var (
 	boolValueName   = (&wrapperspb.BoolValue{}).ProtoReflect().Descriptor().FullName()
 	uInt32ValueName = (&wrapperspb.UInt32Value{}).ProtoReflect().Descriptor().FullName()
 	int32ValueName  = (&wrapperspb.Int32Value{}).ProtoReflect().Descriptor().FullName()
+	int64ValueName  = (&wrapperspb.Int64Value{}).ProtoReflect().Descriptor().FullName()
 	structValueName = (&_struct.Struct{}).ProtoReflect().Descriptor().FullName()
 	timestampName   = (&timestamppb.Timestamp{}).ProtoReflect().Descriptor().FullName()
 	durationName    = (&durationpb.Duration{}).ProtoReflect().Descriptor().FullName()
@@ -119,6 +120,8 @@ func messageKind(fd protoreflect.FieldDescriptor) (ptr, pkg, name string) {
 		return "", "", "uint32"
 	case int32ValueName:
 		return "", "", "int32"
+	case int64ValueName:
+		return "", "", "int64"
 	case structValueName:
 		return "", "", "map[string]interface{}"
 	case valueName:
@@ -260,6 +260,9 @@ func TestSelfReadingDifferentOutputFields(t *testing.T) {
 		tc.Controller().IamRepoFn,
 		tc.Controller().AuthTokenRepoFn,
 		tc.Controller().LdapRepoFn,
+		tc.Controller().JwtRepoFn,
+		tc.Controller().SamlRepoFn,
+		tc.Controller().ScimRepoFn,
 		tc.Controller().AuthMethodRepoFn,
 		1000,
 	)
@@ -57,9 +57,10 @@ type AuthMethodListQueryResult struct {
 	AccountAttributeMap      string
 	DereferenceAliases       string
 	MaximumPageSize          uint32
+	// Optionally set by oidc or jwt auth methods.
+	Issuer string
 	// Optionally set by oidc auth method.
 	DisableDiscoveredConfigValidation bool
-	Issuer                            string
 	ClientId                          string
 	ClientSecretHmac                  string
 	KeyId                             string
@@ -70,6 +71,20 @@ type AuthMethodListQueryResult struct {
 	ClaimsScopes                      string
 	AccountClaimMaps                  string
 	Prompts                           string
+	// Optionally set by jwt auth method.
+	JwksUrl           string
+	JwksCaCerts       string
+	PublicKeys        string
+	BoundAudiences    string
+	SigningAlgorithms string
+	BoundClaims       string
+	SubjectClaim      string
+	FullNameClaim     string
+	EmailClaim        string
+	// Optionally set by saml auth method, along with ApiUrl.
+	IdpMetadata          string
+	SpEntityId           string
+	AccountAttributeMaps string
 	// Optionally set by password auth method.
 	PasswordConfId     string
 	MinLoginNameLength uint32
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package jwt
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/auth/jwt/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultAudClaimTableName defines the default table name for an AudClaim
+const defaultAudClaimTableName = "auth_jwt_aud_claim"
+
+// AudClaim defines an audience bound to a JWT auth method. It is assigned to a
+// JWT AuthMethod and updates/deletes to that AuthMethod are cascaded to its
+// AudClaims. AudClaims are value objects of an AuthMethod, therefore there's
+// no need for oplog metadata, since only the AuthMethod will have metadata
+// because it's the root aggregate.
+type AudClaim struct {
+	*store.AudClaim
+	tableName string
+}
+
+// NewAudClaim creates a new in memory audience claim assigned to a JWT
+// AuthMethod. It supports no options. The aud claim of the JWTs presented to
+// the AuthMethod must contain one of its assigned audiences to be valid.
+func NewAudClaim(ctx context.Context, authMethodId string, audClaim string) (*AudClaim, error) {
+	const op = "jwt.NewAudClaim"
+
+	c := &AudClaim{
+		AudClaim: &store.AudClaim{
+			JwtMethodId: authMethodId,
+			Aud:         audClaim,
+		},
+	}
+	if err := c.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped
+	}
+	return c, nil
+}
+
+// validate the AudClaim. On success, it will return nil.
+func (a *AudClaim) validate(ctx context.Context, caller errors.Op) error {
+	if a.JwtMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing jwt auth method id")
+	}
+	if a.Aud == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing aud claim")
+	}
+	return nil
+}
+
+// AllocAudClaim makes an empty one in memory
+func AllocAudClaim() AudClaim {
+	return AudClaim{
+		AudClaim: &store.AudClaim{},
+	}
+}
+
+// Clone an AudClaim
+func (a *AudClaim) Clone() *AudClaim {
+	cp := proto.Clone(a.AudClaim)
+	return &AudClaim{
+		AudClaim: cp.(*store.AudClaim),
+	}
+}
+
+// TableName returns the table name.
+func (a *AudClaim) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return defaultAudClaimTableName
+}
+
+// SetTableName sets the table name.
+func (a *AudClaim) SetTableName(n string) {
+	a.tableName = n
+}
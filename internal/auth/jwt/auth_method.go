@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package jwt
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/jwt/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	capjwt "github.com/hashicorp/cap/jwt"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultAuthMethodTableName defines the default table name for an AuthMethod
+const defaultAuthMethodTableName = "auth_jwt_method"
+
+// DefaultSubjectClaim is the claim accounts are keyed by when an auth method
+// doesn't specify one.
+const DefaultSubjectClaim = "sub"
+
+// Alg represents asymmetric signing algorithms
+type Alg string
+
+const (
+	// JOSE asymmetric signing algorithm values as defined by RFC 7518.
+	//
+	// See: https://tools.ietf.org/html/rfc7518#section-3.1
+	RS256 Alg = "RS256" // RSASSA-PKCS-v1.5 using SHA-256
+	RS384 Alg = "RS384" // RSASSA-PKCS-v1.5 using SHA-384
+	RS512 Alg = "RS512" // RSASSA-PKCS-v1.5 using SHA-512
+	ES256 Alg = "ES256" // ECDSA using P-256 and SHA-256
+	ES384 Alg = "ES384" // ECDSA using P-384 and SHA-384
+	ES512 Alg = "ES512" // ECDSA using P-521 and SHA-512
+	PS256 Alg = "PS256" // RSASSA-PSS using SHA256 and MGF1-SHA256
+	PS384 Alg = "PS384" // RSASSA-PSS using SHA384 and MGF1-SHA384
+	PS512 Alg = "PS512" // RSASSA-PSS using SHA512 and MGF1-SHA512
+	EdDSA Alg = "EdDSA"
+)
+
+// AuthMethod contains a JWT auth method configuration. It is owned by a
+// scope. A JWT auth method authenticates clients presenting a JWT signed by
+// a trusted issuer, so unlike OIDC there is no interactive redirect involved,
+// which makes it suitable for machines and workloads.
+//
+// The list attributes (JwksCaCerts, PublicKeys, BoundAudiences and
+// SigningAlgorithms) are value objects of the auth method, which are stored in
+// their own tables and operated on as complete sets. BoundClaims is stored
+// marshaled as JSON, since its values can be of any scalar type.
+type AuthMethod struct {
+	*store.AuthMethod
+	tableName string
+}
+
+// NewAuthMethod creates a new in memory AuthMethod assigned to scopeId.
+//
+// Issuer equals the value the iss claim of presented JWTs must match.
+//
+// Exactly one of WithJwksUrl or WithPublicKeys must be provided so signatures
+// can be verified. Supports the options of WithName, WithDescription,
+// WithOperationalState, WithJwksUrl, WithJwksCaCerts, WithPublicKeys,
+// WithBoundAudiences, WithSigningAlgs, WithBoundClaims, WithSubjectClaim,
+// WithFullNameClaim and WithEmailClaim and all other options are ignored.
+func NewAuthMethod(ctx context.Context, scopeId string, issuer string, opt ...Option) (*AuthMethod, error) {
+	const op = "jwt.NewAuthMethod"
+	opts := getOpts(opt...)
+
+	a := &AuthMethod{
+		AuthMethod: &store.AuthMethod{
+			ScopeId:          scopeId,
+			Name:             opts.withName,
+			Description:      opts.withDescription,
+			OperationalState: string(opts.withOperationalState),
+			Issuer:           issuer,
+			SubjectClaim:     opts.withSubjectClaim,
+			FullNameClaim:    opts.withFullNameClaim,
+			EmailClaim:       opts.withEmailClaim,
+		},
+	}
+	if opts.withJwksUrl != nil {
+		a.JwksUrl = opts.withJwksUrl.String()
+	}
+	a.JwksCaCerts = opts.withJwksCaCerts
+	a.PublicKeys = opts.withPublicKeys
+	a.BoundAudiences = opts.withBoundAudiences
+	for _, alg := range opts.withSigningAlgs {
+		a.SigningAlgorithms = append(a.SigningAlgorithms, string(alg))
+	}
+	if len(opts.withBoundClaims) > 0 {
+		b, err := json.Marshal(opts.withBoundClaims)
+		if err != nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to marshal bound claims", errors.WithWrap(err))
+		}
+		a.BoundClaims = string(b)
+	}
+	if err := a.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+	return a, nil
+}
+
+// validate the AuthMethod. On success, it will return nil.
+func (a *AuthMethod) validate(ctx context.Context, caller errors.Op) error {
+	if a.ScopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing scope id")
+	}
+	if !validState(a.OperationalState) {
+		return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("invalid state: %s", a.OperationalState))
+	}
+	if a.Issuer == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing issuer")
+	}
+	if a.SubjectClaim == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing subject claim")
+	}
+	switch {
+	case a.JwksUrl == "" && len(a.PublicKeys) == 0:
+		return errors.New(ctx, errors.InvalidParameter, caller, "either a jwks url or public keys must be set")
+	case a.JwksUrl != "" && len(a.PublicKeys) > 0:
+		return errors.New(ctx, errors.InvalidParameter, caller, "a jwks url and public keys cannot both be set")
+	}
+	if a.JwksUrl != "" {
+		u, err := url.Parse(a.JwksUrl)
+		if err != nil {
+			return errors.New(ctx, errors.InvalidParameter, caller, "not a valid jwks url", errors.WithWrap(err))
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("jwks url scheme must be either http or https, not %q", u.Scheme))
+		}
+	}
+	for _, c := range a.JwksCaCerts {
+		block, _ := pem.Decode([]byte(c))
+		if block == nil {
+			return errors.New(ctx, errors.InvalidParameter, caller, "failed to parse jwks ca certificate PEM")
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return errors.New(ctx, errors.InvalidParameter, caller, "failed to parse jwks ca certificate", errors.WithWrap(err))
+		}
+	}
+	for _, k := range a.PublicKeys {
+		if _, err := capjwt.ParsePublicKeyPEM([]byte(k)); err != nil {
+			return errors.New(ctx, errors.InvalidParameter, caller, "failed to parse public key", errors.WithWrap(err))
+		}
+	}
+	for _, aud := range a.BoundAudiences {
+		if strings.TrimSpace(aud) == "" {
+			return errors.New(ctx, errors.InvalidParameter, caller, "bound audiences must not be empty")
+		}
+	}
+	for _, alg := range a.SigningAlgorithms {
+		if err := capjwt.SupportedSigningAlgorithm(capjwt.Alg(alg)); err != nil {
+			return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("unsupported signing algorithm: %s", alg))
+		}
+	}
+	claims, err := a.BoundClaimMap()
+	if err != nil {
+		return errors.Wrap(ctx, err, caller, errors.WithCode(errors.InvalidParameter))
+	}
+	for k, v := range claims {
+		if strings.TrimSpace(k) == "" {
+			return errors.New(ctx, errors.InvalidParameter, caller, "bound claim names must not be empty")
+		}
+		if !validBoundClaimValue(v) {
+			return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("bound claim %q must be a string, number or bool, or a list of those", k))
+		}
+	}
+	return nil
+}
+
+// BoundClaimMap returns the claims presented JWTs must have.
+func (a *AuthMethod) BoundClaimMap() (map[string]any, error) {
+	if a.GetBoundClaims() == "" {
+		return nil, nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal([]byte(a.GetBoundClaims()), &m); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal bound claims: %w", err)
+	}
+	return m, nil
+}
+
+// AllocAuthMethod makes an empty one in memory
+func AllocAuthMethod() AuthMethod {
+	return AuthMethod{
+		AuthMethod: &store.AuthMethod{},
+	}
+}
+
+// Clone an AuthMethod.
+func (a *AuthMethod) Clone() *AuthMethod {
+	cp := proto.Clone(a.AuthMethod)
+	return &AuthMethod{
+		AuthMethod: cp.(*store.AuthMethod),
+	}
+}
+
+// TableName returns the table name.
+func (a *AuthMethod) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return defaultAuthMethodTableName
+}
+
+// SetTableName sets the table name.
+func (a *AuthMethod) SetTableName(n string) {
+	a.tableName = n
+}
+
+// GetResourceType returns the resource type of the AuthMethod
+func (a *AuthMethod) GetResourceType() resource.Type {
+	return resource.AuthMethod
+}
+
+// oplog will create oplog metadata for the AuthMethod.
+func (a *AuthMethod) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{a.GetPublicId()},
+		"resource-type":      []string{"jwt auth method"},
+		"op-type":            []string{op.String()},
+		"scope-id":           []string{a.ScopeId},
+	}
+	return metadata
+}
+
+// validBoundClaimValue reports whether v can be compared against a claim.
+func validBoundClaimValue(v any) bool {
+	switch t := v.(type) {
+	case string, float64, bool:
+		return true
+	case []any:
+		if len(t) == 0 {
+			return false
+		}
+		for _, e := range t {
+			switch e.(type) {
+			case string, float64, bool:
+			default:
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+type convertedValues struct {
+	Certs []any
+	Keys  []any
+	Auds  []any
+	Algs  []any
+}
+
+// convertValueObjects converts the embedded value objects. It will return an
+// error if the AuthMethod's public id is not set.
+func (a *AuthMethod) convertValueObjects(ctx context.Context) (*convertedValues, error) {
+	const op = "jwt.(AuthMethod).convertValueObjects"
+	if a.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	var err error
+	var cv convertedValues
+	if cv.Certs, err = a.convertCertificates(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if cv.Keys, err = a.convertPublicKeys(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if cv.Auds, err = a.convertAudClaims(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if cv.Algs, err = a.convertSigningAlgs(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &cv, nil
+}
+
+// convertCertificates converts the embedded jwks ca certificates from []string
+// to []any where each slice element is a *Certificate.
+func (a *AuthMethod) convertCertificates(ctx context.Context) ([]any, error) {
+	const op = "jwt.(AuthMethod).convertCertificates"
+	newInterfaces := make([]any, 0, len(a.JwksCaCerts))
+	for _, c := range a.JwksCaCerts {
+		obj, err := NewCertificate(ctx, a.PublicId, c)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		newInterfaces = append(newInterfaces, obj)
+	}
+	return newInterfaces, nil
+}
+
+// convertPublicKeys converts the embedded public keys from []string to []any
+// where each slice element is a *PublicKey.
+func (a *AuthMethod) convertPublicKeys(ctx context.Context) ([]any, error) {
+	const op = "jwt.(AuthMethod).convertPublicKeys"
+	newInterfaces := make([]any, 0, len(a.PublicKeys))
+	for _, k := range a.PublicKeys {
+		obj, err := NewPublicKey(ctx, a.PublicId, k)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		newInterfaces = append(newInterfaces, obj)
+	}
+	return newInterfaces, nil
+}
+
+// convertAudClaims converts the embedded bound audiences from []string to
+// []any where each slice element is a *AudClaim.
+func (a *AuthMethod) convertAudClaims(ctx context.Context) ([]any, error) {
+	const op = "jwt.(AuthMethod).convertAudClaims"
+	newInterfaces := make([]any, 0, len(a.BoundAudiences))
+	for _, aud := range a.BoundAudiences {
+		obj, err := NewAudClaim(ctx, a.PublicId, aud)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		newInterfaces = append(newInterfaces, obj)
+	}
+	return newInterfaces, nil
+}
+
+// convertSigningAlgs converts the embedded signing algorithms from []string to
+// []any where each slice element is a *SigningAlg.
+func (a *AuthMethod) convertSigningAlgs(ctx context.Context) ([]any, error) {
+	const op = "jwt.(AuthMethod).convertSigningAlgs"
+	newInterfaces := make([]any, 0, len(a.SigningAlgorithms))
+	for _, alg := range a.SigningAlgorithms {
+		obj, err := NewSigningAlg(ctx, a.PublicId, Alg(alg))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		newInterfaces = append(newInterfaces, obj)
+	}
+	return newInterfaces, nil
+}
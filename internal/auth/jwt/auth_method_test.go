@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package jwt
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/cap/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuthMethod(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	pub, _ := oidc.TestGenerateKeys(t)
+	testPem := TestEncodePublicKey(t, pub)
+	testUrl, err := url.Parse("https://example.com/.well-known/jwks.json")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		scopeId         string
+		issuer          string
+		opts            []Option
+		wantErrMatch    *errors.Template
+		wantErrContains string
+	}{
+		{
+			name:    "valid-jwks-url",
+			scopeId: "o_1234567890",
+			issuer:  "https://example.com",
+			opts: []Option{
+				WithJwksUrl(testUrl),
+				WithBoundAudiences("boundary"),
+				WithSigningAlgs(ES256),
+				WithBoundClaims(map[string]any{"env": []any{"prod", "stage"}}),
+			},
+		},
+		{
+			name:    "valid-public-keys",
+			scopeId: "o_1234567890",
+			issuer:  "https://example.com",
+			opts:    []Option{WithPublicKeys(testPem)},
+		},
+		{
+			name:            "missing-scope",
+			issuer:          "https://example.com",
+			opts:            []Option{WithPublicKeys(testPem)},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing scope id",
+		},
+		{
+			name:            "missing-issuer",
+			scopeId:         "o_1234567890",
+			opts:            []Option{WithPublicKeys(testPem)},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing issuer",
+		},
+		{
+			name:         "missing-keys",
+			scopeId:      "o_1234567890",
+			issuer:       "https://example.com",
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name:         "jwks-url-and-public-keys",
+			scopeId:      "o_1234567890",
+			issuer:       "https://example.com",
+			opts:         []Option{WithJwksUrl(testUrl), WithPublicKeys(testPem)},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name:         "invalid-public-key",
+			scopeId:      "o_1234567890",
+			issuer:       "https://example.com",
+			opts:         []Option{WithPublicKeys("not-a-key")},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name:         "invalid-alg",
+			scopeId:      "o_1234567890",
+			issuer:       "https://example.com",
+			opts:         []Option{WithPublicKeys(testPem), WithSigningAlgs("HS256")},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name:         "invalid-bound-claim",
+			scopeId:      "o_1234567890",
+			issuer:       "https://example.com",
+			opts:         []Option{WithPublicKeys(testPem), WithBoundClaims(map[string]any{"env": map[string]any{"a": "b"}})},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			am, err := NewAuthMethod(testCtx, tc.scopeId, tc.issuer, tc.opts...)
+			if tc.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tc.wantErrMatch, err), "want err code: %q got: %q", tc.wantErrMatch.Code, err)
+				if tc.wantErrContains != "" {
+					assert.Contains(err.Error(), tc.wantErrContains)
+				}
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.scopeId, am.ScopeId)
+			assert.Equal(tc.issuer, am.Issuer)
+			assert.Equal(DefaultSubjectClaim, am.SubjectClaim)
+			assert.Equal(string(InactiveState), am.OperationalState)
+		})
+	}
+}
+
+func Test_claimMatches(t *testing.T) {
+	t.Parallel()
+	claims := map[string]any{
+		"sub":    "alice",
+		"groups": []any{"admin", "dev"},
+		"nested": map[string]any{"env": "prod"},
+		"uid":    float64(1001),
+	}
+	tests := []struct {
+		name  string
+		claim string
+		want  any
+		match bool
+	}{
+		{name: "string", claim: "sub", want: "alice", match: true},
+		{name: "string-mismatch", claim: "sub", want: "bob"},
+		{name: "list-contains", claim: "groups", want: "dev", match: true},
+		{name: "any-of", claim: "groups", want: []any{"ops", "admin"}, match: true},
+		{name: "none-of", claim: "groups", want: []any{"ops"}},
+		{name: "json-pointer", claim: "/nested/env", want: "prod", match: true},
+		{name: "number", claim: "uid", want: float64(1001), match: true},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := getClaim(claims, tc.claim)
+			require.NoError(t, err)
+			assert.Equal(t, tc.match, claimMatches(tc.want, got))
+		})
+	}
+
+	_, err := getClaim(claims, "missing")
+	assert.Error(t, err)
+	uid, err := stringClaim(claims, "uid")
+	require.NoError(t, err)
+	assert.Equal(t, "1001", uid)
+}
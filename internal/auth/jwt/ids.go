@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package jwt
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+func init() {
+	globals.RegisterPrefixToResourceInfo(globals.JwtAuthMethodPrefix, resource.AuthMethod, auth.Domain, Subtype)
+	globals.RegisterPrefixToResourceInfo(globals.JwtAccountPrefix, resource.Account, auth.Domain, Subtype)
+	globals.RegisterPrefixToResourceInfo(globals.JwtManagedGroupPrefix, resource.ManagedGroup, auth.Domain, Subtype)
+}
+
+const (
+	Subtype = globals.Subtype("jwt")
+)
+
+func newAuthMethodId(ctx context.Context) (string, error) {
+	const op = "jwt.newAuthMethodId"
+	id, err := db.NewPublicId(ctx, globals.JwtAuthMethodPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+// newAccountId generates a predictable account id from the auth method id
+// and the subject, so concurrent first logins of the same subject resolve to
+// the same account.
+func newAccountId(ctx context.Context, authMethodId, subject string) (string, error) {
+	const op = "jwt.newAccountId"
+	if authMethodId == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	if subject == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing subject")
+	}
+	id, err := db.NewPublicId(ctx, globals.JwtAccountPrefix, db.WithPrngValues([]string{authMethodId, subject}))
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newManagedGroupId(ctx context.Context) (string, error) {
+	const op = "jwt.newManagedGroupId"
+	id, err := db.NewPublicId(ctx, globals.JwtManagedGroupPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package jwt
+
+import (
+	"net/url"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/pagination"
+)
+
+// getOpts - iterate the inbound Options and return a struct.
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName               string
+	withDescription        string
+	withLimit              int
+	withEmail              string
+	withFullName           string
+	withPublicId           string
+	withOperationalState   AuthMethodState
+	withJwksUrl            *url.URL
+	withJwksCaCerts        []string
+	withPublicKeys         []string
+	withBoundAudiences     []string
+	withSigningAlgs        []Alg
+	withBoundClaims        map[string]any
+	withSubjectClaim       string
+	withFullNameClaim      string
+	withEmailClaim         string
+	withReader             db.Reader
+	withWriter             db.Writer
+	withStartPageAfterItem pagination.Item
+}
+
+func getDefaultOptions() options {
+	return options{
+		withOperationalState: InactiveState,
+		withSubjectClaim:     DefaultSubjectClaim,
+	}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit.  Intentionally allowing
+// negative integers.   If WithLimit < 0, then unlimited results are returned.
+// If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithEmail provides an optional email address for the account.
+func WithEmail(email string) Option {
+	return func(o *options) {
+		o.withEmail = email
+	}
+}
+
+// WithFullName provides an optional full name for the account.
+func WithFullName(n string) Option {
+	return func(o *options) {
+		o.withFullName = n
+	}
+}
+
+// WithPublicId provides an optional public id.
+func WithPublicId(publicId string) Option {
+	return func(o *options) {
+		o.withPublicId = publicId
+	}
+}
+
+// WithOperationalState provides an option for specifying the auth method's
+// operational state.
+func WithOperationalState(state AuthMethodState) Option {
+	return func(o *options) {
+		o.withOperationalState = state
+	}
+}
+
+// WithJwksUrl provides the URL of the JSON Web Key Set used to verify the
+// signatures of presented JWTs.
+func WithJwksUrl(u *url.URL) Option {
+	return func(o *options) {
+		o.withJwksUrl = u
+	}
+}
+
+// WithJwksCaCerts provides optional PEM encoded x509 certificates to trust
+// when retrieving the JSON Web Key Set.
+func WithJwksCaCerts(pems ...string) Option {
+	return func(o *options) {
+		o.withJwksCaCerts = pems
+	}
+}
+
+// WithPublicKeys provides PEM encoded public keys used to verify the
+// signatures of presented JWTs.
+func WithPublicKeys(pems ...string) Option {
+	return func(o *options) {
+		o.withPublicKeys = pems
+	}
+}
+
+// WithBoundAudiences provides optional audiences, one of which the aud claim of
+// presented JWTs must contain.
+func WithBoundAudiences(aud ...string) Option {
+	return func(o *options) {
+		o.withBoundAudiences = aud
+	}
+}
+
+// WithSigningAlgs provides optional signing algorithms allowed for presented
+// JWTs.
+func WithSigningAlgs(alg ...Alg) Option {
+	return func(o *options) {
+		o.withSigningAlgs = alg
+	}
+}
+
+// WithBoundClaims provides optional claims presented JWTs must have. Each
+// value must be a string, number or bool, or a list of those.
+func WithBoundClaims(claims map[string]any) Option {
+	return func(o *options) {
+		o.withBoundClaims = claims
+	}
+}
+
+// WithSubjectClaim provides an optional claim the accounts of the auth method
+// are keyed by. Defaults to "sub".
+func WithSubjectClaim(claim string) Option {
+	return func(o *options) {
+		o.withSubjectClaim = claim
+	}
+}
+
+// WithFullNameClaim provides an optional claim to set the full name of
+// accounts from.
+func WithFullNameClaim(claim string) Option {
+	return func(o *options) {
+		o.withFullNameClaim = claim
+	}
+}
+
+// WithEmailClaim provides an optional claim to set the email of accounts
+// from.
+func WithEmailClaim(claim string) Option {
+	return func(o *options) {
+		o.withEmailClaim = claim
+	}
+}
+
+// WithReader provides an optional reader.
+func WithReader(reader db.Reader) Option {
+	return func(o *options) {
+		o.withReader = reader
+	}
+}
+
+// WithStartPageAfterItem is used to paginate over the results.
+// The next page will start after the provided item.
+func WithStartPageAfterItem(item pagination.Item) Option {
+	return func(o *options) {
+		o.withStartPageAfterItem = item
+	}
+}
+
+// WithReaderWriter allows the caller to pass an inflight transaction to be used
+// for the database writes of the operation. If WithReaderWriter(...) is used,
+// then the caller is responsible for managing the transaction.
+func WithReaderWriter(r db.Reader, w db.Writer) Option {
+	return func(o *options) {
+		o.withReader = r
+		o.withWriter = w
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package jwt
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/auth/jwt/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	capjwt "github.com/hashicorp/cap/jwt"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultPublicKeyTableName defines the default table name for a PublicKey
+const defaultPublicKeyTableName = "auth_jwt_public_key"
+
+// PublicKey defines a public key used to verify the signatures of the JWTs
+// presented to a JWT auth method. It is assigned to a JWT AuthMethod and
+// updates/deletes to that AuthMethod are cascaded to its PublicKeys.
+// PublicKeys are value objects of an AuthMethod, therefore there's no need
+// for oplog metadata, since only the AuthMethod will have metadata because
+// it's the root aggregate.
+type PublicKey struct {
+	*store.PublicKey
+	tableName string
+}
+
+// NewPublicKey creates a new in memory public key assigned to a JWT auth
+// method.
+func NewPublicKey(ctx context.Context, authMethodId string, publicKeyPem string) (*PublicKey, error) {
+	const op = "jwt.NewPublicKey"
+
+	k := &PublicKey{
+		PublicKey: &store.PublicKey{
+			JwtMethodId: authMethodId,
+			PublicKey:   publicKeyPem,
+		},
+	}
+	if err := k.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped
+	}
+	return k, nil
+}
+
+// validate the PublicKey and on success return nil
+func (k *PublicKey) validate(ctx context.Context, caller errors.Op) error {
+	if k.JwtMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing jwt auth method id")
+	}
+	if k.PublicKey.PublicKey == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "empty public key")
+	}
+	if _, err := capjwt.ParsePublicKeyPEM([]byte(k.PublicKey.PublicKey)); err != nil {
+		return errors.New(ctx, errors.InvalidParameter, caller, "failed to parse public key", errors.WithWrap(err))
+	}
+	return nil
+}
+
+// AllocPublicKey makes an empty one in memory
+func AllocPublicKey() PublicKey {
+	return PublicKey{
+		PublicKey: &store.PublicKey{},
+	}
+}
+
+// Clone a PublicKey
+func (k *PublicKey) Clone() *PublicKey {
+	cp := proto.Clone(k.PublicKey)
+	return &PublicKey{
+		PublicKey: cp.(*store.PublicKey),
+	}
+}
+
+// TableName returns the table name.
+func (k *PublicKey) TableName() string {
+	if k.tableName != "" {
+		return k.tableName
+	}
+	return defaultPublicKeyTableName
+}
+
+// SetTableName sets the table name.
+func (k *PublicKey) SetTableName(n string) {
+	k.tableName = n
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package jwt
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth"
+)
+
+func init() {
+	auth.RegisterAuthMethodSubtype("jwt", &authMethodHooks{})
+}
+
+type authMethodHooks struct{}
+
+// NewAuthMethod creates a new jwt auth method from the result
+func (authMethodHooks) NewAuthMethod(ctx context.Context, result *auth.AuthMethodListQueryResult) (auth.AuthMethod, error) {
+	delimiter := "|"
+
+	am := AllocAuthMethod()
+	am.PublicId = result.PublicId
+	am.ScopeId = result.ScopeId
+	am.IsPrimaryAuthMethod = result.IsPrimaryAuthMethod
+	am.Name = result.Name
+	am.Description = result.Description
+	am.CreateTime = result.CreateTime
+	am.UpdateTime = result.UpdateTime
+	am.Version = result.Version
+	am.OperationalState = result.State
+	am.Issuer = result.Issuer
+	am.JwksUrl = result.JwksUrl
+	am.BoundClaims = result.BoundClaims
+	am.SubjectClaim = result.SubjectClaim
+	am.FullNameClaim = result.FullNameClaim
+	am.EmailClaim = result.EmailClaim
+	if result.JwksCaCerts != "" {
+		am.JwksCaCerts = strings.Split(result.JwksCaCerts, delimiter)
+	}
+	if result.PublicKeys != "" {
+		am.PublicKeys = strings.Split(result.PublicKeys, delimiter)
+	}
+	if result.BoundAudiences != "" {
+		am.BoundAudiences = strings.Split(result.BoundAudiences, delimiter)
+	}
+	if result.SigningAlgorithms != "" {
+		am.SigningAlgorithms = strings.Split(result.SigningAlgorithms, delimiter)
+	}
+
+	return &am, nil
+}
@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package jwt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-dbw"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+)
+
+const (
+	OperationalStateField  = "OperationalState"
+	VersionField           = "Version"
+	NameField              = "Name"
+	DescriptionField       = "Description"
+	FilterField            = "Filter"
+	IssuerField            = "Issuer"
+	JwksUrlField           = "JwksUrl"
+	JwksCaCertsField       = "JwksCaCerts"
+	PublicKeysField        = "PublicKeys"
+	BoundAudiencesField    = "BoundAudiences"
+	SigningAlgorithmsField = "SigningAlgorithms"
+	BoundClaimsField       = "BoundClaims"
+	SubjectClaimField      = "SubjectClaim"
+	FullNameClaimField     = "FullNameClaim"
+	EmailClaimField        = "EmailClaim"
+)
+
+// UpdateAuthMethod will retrieve the auth method from the repository,
+// and update it based on the field masks provided.
+//
+// fieldMaskPaths provides field_mask.proto paths for fields that should
+// be updated.  Fields will be set to NULL if the field is a
+// zero value and included in fieldMask. OperationalState, Name, Description,
+// Issuer, JwksUrl, JwksCaCerts, PublicKeys, BoundAudiences, SigningAlgorithms,
+// BoundClaims, SubjectClaim, FullNameClaim and EmailClaim are all updatable
+// fields. If no updatable fields are included in the fieldMaskPaths, then an
+// error is returned.
+//
+// The updated auth method is validated as a whole before it's persisted, so
+// for example switching from a JwksUrl to PublicKeys requires both fields to be
+// included in the fieldMaskPaths.
+//
+// No options are currently supported.
+func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, version uint32, fieldMaskPaths []string, _ ...Option) (*AuthMethod, int, error) {
+	const op = "jwt.(Repository).UpdateAuthMethod"
+	switch {
+	case am == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	case am.AuthMethod == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing auth method store")
+	case am.PublicId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	if err := validateFieldMask(ctx, fieldMaskPaths); err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	fields := map[string]any{
+		OperationalStateField:  am.OperationalState,
+		NameField:              am.Name,
+		DescriptionField:       am.Description,
+		IssuerField:            am.Issuer,
+		JwksUrlField:           am.JwksUrl,
+		JwksCaCertsField:       am.JwksCaCerts,
+		PublicKeysField:        am.PublicKeys,
+		BoundAudiencesField:    am.BoundAudiences,
+		SigningAlgorithmsField: am.SigningAlgorithms,
+		BoundClaimsField:       am.BoundClaims,
+		SubjectClaimField:      am.SubjectClaim,
+		FullNameClaimField:     am.FullNameClaim,
+		EmailClaimField:        am.EmailClaim,
+	}
+	dbMask, nullFields := dbw.BuildUpdatePaths(fields, fieldMaskPaths, nil)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+	for _, f := range []string{OperationalStateField, IssuerField, SubjectClaimField} {
+		if strutil.StrListContains(nullFields, f) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s cannot be unset", f))
+		}
+	}
+
+	origAm, err := r.LookupAuthMethod(ctx, am.PublicId)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("%q auth method not found", am.PublicId)))
+	}
+	if origAm == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %q", am.PublicId))
+	}
+	// there's no reason to continue if another controller has already updated this auth method.
+	if origAm.Version != version {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("update version %d doesn't match db version %d", version, origAm.Version))
+	}
+
+	updatedAm := applyUpdate(origAm, am, dbMask, nullFields)
+	if err := updatedAm.validate(ctx, op); err != nil {
+		return nil, db.NoRowsAffected, err // validate properly sets the op to the caller, the code and the msg, so just return it.
+	}
+
+	// the value objects are operated on as complete sets, so work out which
+	// ones need to be added and deleted to reconcile them.
+	type voChange struct {
+		name     string
+		add, del []any
+	}
+	var voChanges []voChange
+	for _, vo := range []struct {
+		name     string
+		new, old []string
+	}{
+		{JwksCaCertsField, am.JwksCaCerts, origAm.JwksCaCerts},
+		{PublicKeysField, am.PublicKeys, origAm.PublicKeys},
+		{BoundAudiencesField, am.BoundAudiences, origAm.BoundAudiences},
+		{SigningAlgorithmsField, am.SigningAlgorithms, origAm.SigningAlgorithms},
+	} {
+		add, del, err := valueObjectChanges(ctx, origAm.PublicId, vo.name, vo.new, vo.old, dbMask, nullFields)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		if len(add) > 0 || len(del) > 0 {
+			voChanges = append(voChanges, voChange{name: vo.name, add: add, del: del})
+		}
+	}
+
+	var filteredDbMask, filteredNullFields []string
+	for _, f := range dbMask {
+		if !isValueObjectField(f) {
+			filteredDbMask = append(filteredDbMask, f)
+		}
+	}
+	for _, f := range nullFields {
+		if !isValueObjectField(f) {
+			filteredNullFields = append(filteredNullFields, f)
+		}
+	}
+
+	// handle no changes...
+	if len(filteredDbMask) == 0 && len(filteredNullFields) == 0 && len(voChanges) == 0 {
+		return origAm, db.NoRowsAffected, nil
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, origAm.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	var returnedAm *AuthMethod
+	var rowsUpdated int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 1+2*len(voChanges))
+			ticket, err := w.GetTicket(ctx, updatedAm)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+			var authMethodOplogMsg oplog.Message
+			switch {
+			case len(filteredDbMask) == 0 && len(filteredNullFields) == 0:
+				// the auth method's fields are not being updated, just its
+				// value objects, so we need to just update its version.
+				versionAm := updatedAm.Clone()
+				versionAm.Version = version + 1
+				rowsUpdated, err = w.Update(ctx, versionAm, []string{VersionField}, nil, db.NewOplogMsg(&authMethodOplogMsg), db.WithVersion(&version))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update auth method version"))
+				}
+			default:
+				rowsUpdated, err = w.Update(ctx, updatedAm.Clone(), filteredDbMask, filteredNullFields, db.NewOplogMsg(&authMethodOplogMsg), db.WithVersion(&version))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update auth method"))
+				}
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated auth method and %d rows updated", rowsUpdated))
+			}
+			msgs = append(msgs, &authMethodOplogMsg)
+
+			for _, c := range voChanges {
+				if len(c.del) > 0 {
+					deleteOplogMsgs := make([]*oplog.Message, 0, len(c.del))
+					rowsDeleted, err := w.DeleteItems(ctx, c.del, db.NewOplogMsgs(&deleteOplogMsgs))
+					if err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to delete %s", c.name)))
+					}
+					if rowsDeleted != len(c.del) {
+						return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("%s deleted %d did not match request for %d", c.name, rowsDeleted, len(c.del)))
+					}
+					msgs = append(msgs, deleteOplogMsgs...)
+				}
+				if len(c.add) > 0 {
+					addOplogMsgs := make([]*oplog.Message, 0, len(c.add))
+					if err := w.CreateItems(ctx, c.add, db.NewOplogMsgs(&addOplogMsgs)); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to add %s", c.name)))
+					}
+					msgs = append(msgs, addOplogMsgs...)
+				}
+			}
+
+			metadata := updatedAm.oplog(oplog.OpType_OP_TYPE_UPDATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			// we need a new repo, that's using the same reader/writer as this TxHandler
+			txRepo := &Repository{
+				reader: reader,
+				writer: w,
+				kms:    r.kms,
+			}
+			returnedAm, err = txRepo.lookupAuthMethod(ctx, updatedAm.PublicId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup auth method after update"))
+			}
+			if returnedAm == nil {
+				return errors.New(ctx, errors.RecordNotFound, op, "unable to lookup auth method after update")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return returnedAm, rowsUpdated, nil
+}
+
+// applyUpdate returns a copy of orig with the fields in dbMask set to their
+// values in am and the fields in nullFields cleared.
+func applyUpdate(orig, am *AuthMethod, dbMask, nullFields []string) *AuthMethod {
+	cp := orig.Clone()
+	set := func(field string, dst *string, v string) {
+		switch {
+		case strutil.StrListContains(dbMask, field):
+			*dst = v
+		case strutil.StrListContains(nullFields, field):
+			*dst = ""
+		}
+	}
+	setList := func(field string, dst *[]string, v []string) {
+		switch {
+		case strutil.StrListContains(dbMask, field):
+			*dst = v
+		case strutil.StrListContains(nullFields, field):
+			*dst = nil
+		}
+	}
+	set(OperationalStateField, &cp.OperationalState, am.OperationalState)
+	set(NameField, &cp.Name, am.Name)
+	set(DescriptionField, &cp.Description, am.Description)
+	set(IssuerField, &cp.Issuer, am.Issuer)
+	set(JwksUrlField, &cp.JwksUrl, am.JwksUrl)
+	setList(JwksCaCertsField, &cp.JwksCaCerts, am.JwksCaCerts)
+	setList(PublicKeysField, &cp.PublicKeys, am.PublicKeys)
+	setList(BoundAudiencesField, &cp.BoundAudiences, am.BoundAudiences)
+	setList(SigningAlgorithmsField, &cp.SigningAlgorithms, am.SigningAlgorithms)
+	set(BoundClaimsField, &cp.BoundClaims, am.BoundClaims)
+	set(SubjectClaimField, &cp.SubjectClaim, am.SubjectClaim)
+	set(FullNameClaimField, &cp.FullNameClaim, am.FullNameClaim)
+	set(EmailClaimField, &cp.EmailClaim, am.EmailClaim)
+	return cp
+}
+
+// isValueObjectField reports whether f is the field of one of the auth
+// method's value objects, which are stored in their own tables.
+func isValueObjectField(f string) bool {
+	switch f {
+	case JwksCaCertsField, PublicKeysField, BoundAudiencesField, SigningAlgorithmsField:
+		return true
+	default:
+		return false
+	}
+}
+
+// valueObjectFactories create a value object of the auth method for each of
+// the value object fields.
+var valueObjectFactories = map[string]func(ctx context.Context, publicId string, v string) (any, error){
+	JwksCaCertsField: func(ctx context.Context, publicId string, v string) (any, error) {
+		return NewCertificate(ctx, publicId, v)
+	},
+	PublicKeysField: func(ctx context.Context, publicId string, v string) (any, error) {
+		return NewPublicKey(ctx, publicId, v)
+	},
+	BoundAudiencesField: func(ctx context.Context, publicId string, v string) (any, error) {
+		return NewAudClaim(ctx, publicId, v)
+	},
+	SigningAlgorithmsField: func(ctx context.Context, publicId string, v string) (any, error) {
+		return NewSigningAlg(ctx, publicId, Alg(v))
+	},
+}
+
+// valueObjectChanges takes the new and old lists of a value object field and
+// using the dbMask/nullFields it will return the value objects which need to
+// be added and deleted in order to reconcile the auth method's value objects.
+func valueObjectChanges(ctx context.Context, publicId string, field string, newVOs, oldVOs, dbMask, nullFields []string) (add []any, del []any, e error) {
+	const op = "jwt.valueObjectChanges"
+	if publicId == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	factory, ok := valueObjectFactories[field]
+	if !ok {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported factory for value object: %s", field))
+	}
+	inMask, inNull := strutil.StrListContains(dbMask, field), strutil.StrListContains(nullFields, field)
+	if !inMask && !inNull {
+		return nil, nil, nil
+	}
+	if len(strutil.RemoveDuplicates(newVOs, false)) != len(newVOs) {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("duplicate new %s", field))
+	}
+
+	found := make(map[string]bool, len(oldVOs))
+	for _, v := range oldVOs {
+		found[v] = true
+	}
+	if inMask {
+		for _, v := range newVOs {
+			if found[v] {
+				delete(found, v)
+				continue
+			}
+			obj, err := factory(ctx, publicId, v)
+			if err != nil {
+				return nil, nil, errors.Wrap(ctx, err, op)
+			}
+			add = append(add, obj)
+		}
+	}
+	// anything left over from the old set is no longer wanted.
+	for _, v := range oldVOs {
+		if !found[v] {
+			continue
+		}
+		obj, err := factory(ctx, publicId, v)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		del = append(del, obj)
+	}
+	return add, del, nil
+}
+
+// validateFieldMask will validate that the fieldMaskPaths are all updatable
+// fields of an auth method.
+func validateFieldMask(ctx context.Context, fieldMaskPaths []string) error {
+	const op = "jwt.validateFieldMask"
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(OperationalStateField, f):
+		case strings.EqualFold(NameField, f):
+		case strings.EqualFold(DescriptionField, f):
+		case strings.EqualFold(IssuerField, f):
+		case strings.EqualFold(JwksUrlField, f):
+		case strings.EqualFold(JwksCaCertsField, f):
+		case strings.EqualFold(PublicKeysField, f):
+		case strings.EqualFold(BoundAudiencesField, f):
+		case strings.EqualFold(SigningAlgorithmsField, f):
+		case strings.EqualFold(BoundClaimsField, f):
+		case strings.EqualFold(SubjectClaimField, f):
+		case strings.EqualFold(FullNameClaimField, f):
+		case strings.EqualFold(EmailClaimField, f):
+		default:
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid field mask: %q", f))
+		}
+	}
+	return nil
+}
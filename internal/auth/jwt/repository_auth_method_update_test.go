@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package jwt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_valueObjectChanges(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	const testId = "amjwt_1234567890"
+
+	auds := func(t *testing.T, vos []any) []string {
+		t.Helper()
+		var l []string
+		for _, vo := range vos {
+			l = append(l, vo.(*AudClaim).Aud)
+		}
+		return l
+	}
+
+	tests := []struct {
+		name       string
+		newVOs     []string
+		oldVOs     []string
+		dbMask     []string
+		nullFields []string
+		wantAdd    []string
+		wantDel    []string
+		wantErr    bool
+	}{
+		{
+			name:   "not-in-mask",
+			newVOs: []string{"a"},
+			oldVOs: []string{"b"},
+			dbMask: []string{NameField},
+		},
+		{
+			name:    "replace",
+			newVOs:  []string{"a", "b"},
+			oldVOs:  []string{"b", "c"},
+			dbMask:  []string{BoundAudiencesField},
+			wantAdd: []string{"a"},
+			wantDel: []string{"c"},
+		},
+		{
+			name:       "null",
+			oldVOs:     []string{"b", "c"},
+			nullFields: []string{BoundAudiencesField},
+			wantDel:    []string{"b", "c"},
+		},
+		{
+			name:    "duplicate-new",
+			newVOs:  []string{"a", "a"},
+			dbMask:  []string{BoundAudiencesField},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			add, del, err := valueObjectChanges(testCtx, testId, BoundAudiencesField, tc.newVOs, tc.oldVOs, tc.dbMask, tc.nullFields)
+			if tc.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.wantAdd, auds(t, add))
+			assert.Equal(tc.wantDel, auds(t, del))
+		})
+	}
+}
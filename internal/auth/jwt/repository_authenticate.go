@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	capjwt "github.com/hashicorp/cap/jwt"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/mitchellh/pointerstructure"
+)
+
+// keySets caches the key set of each auth method, so the JSON Web Key Set of
+// an auth method isn't fetched on every authentication. Entries are replaced
+// whenever the auth method's version changes.
+var keySets sync.Map // map[string]*cachedKeySet
+
+type cachedKeySet struct {
+	version uint32
+	keySet  capjwt.KeySet
+}
+
+// Authenticate validates token against the auth method's configuration: its
+// signature must verify against the auth method's JSON Web Key Set or public
+// keys, its iss claim must match the auth method's issuer, its aud claim must
+// contain one of the bound audiences and it must have all the bound claims.
+// The account keyed by the value of the auth method's subject claim is
+// returned if authentication is successful, and is created if it doesn't
+// exist yet. Any other error is returned if authentication fails.
+//
+// Authenticate will update the stored values for the authenticated
+// Account: FullName, Email and TokenClaims. It will also set the account's
+// managed group memberships to the managed groups whose filter matches the
+// token's claims.
+func (r *Repository) Authenticate(ctx context.Context, authMethodId, token string) (*Account, error) {
+	const op = "jwt.(Repository).Authenticate"
+	switch {
+	case authMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id", errors.WithoutEvent())
+	case token == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token", errors.WithoutEvent())
+	}
+
+	am, err := r.LookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to lookup auth method id: %q", authMethodId)))
+	}
+	if am == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method id %q not found", authMethodId))
+	}
+	if am.OperationalState == string(InactiveState) {
+		return nil, errors.New(ctx, errors.AuthMethodInactive, op, "not allowed to authenticate with an inactive auth method")
+	}
+
+	claims, err := validateToken(ctx, am, token)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	subject, err := stringClaim(claims, am.SubjectClaim)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unauthorized, op, fmt.Sprintf("invalid subject claim %q", am.SubjectClaim), errors.WithWrap(err))
+	}
+	acct, err := NewAccount(ctx, am.PublicId, subject)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if acct.PublicId, err = newAccountId(ctx, am.PublicId, subject); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if am.FullNameClaim != "" {
+		// missing or malformed optional claims are not an authentication failure
+		acct.FullName, _ = stringClaim(claims, am.FullNameClaim)
+	}
+	if am.EmailClaim != "" {
+		acct.Email, _ = stringClaim(claims, am.EmailClaim)
+	}
+	encodedClaims, err := json.Marshal(claims)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Encode, op, "unable to encode token claims", errors.WithWrap(err))
+	}
+	acct.TokenClaims = string(encodedClaims)
+	if err := acct.validate(ctx, op); err != nil {
+		return nil, err
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	if err := r.writer.Create(
+		ctx,
+		acct,
+		db.WithOnConflict(&db.OnConflict{
+			Target: db.Columns{"public_id"}, // id is predictable and uses both auth method id and subject for inputs
+			Action: db.SetColumns([]string{"full_name", "email", "token_claims"}),
+		}),
+		db.WithOplog(oplogWrapper, acct.oplog(oplog.OpType_OP_TYPE_CREATE, am.ScopeId)),
+	); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create/update jwt account"))
+	}
+
+	mgs, _, err := r.ListManagedGroups(ctx, am.PublicId, WithLimit(-1))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(mgs) > 0 {
+		matchedMgs := make([]*ManagedGroup, 0, len(mgs))
+		evalData := map[string]any{
+			"token": claims,
+		}
+		for _, mg := range mgs {
+			eval, err := bexpr.CreateEvaluator(mg.Filter)
+			if err != nil {
+				// We check all filters on ingress so this should never happen,
+				// but we validate anyways
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			match, err := eval.Evaluate(evalData)
+			if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			if match {
+				matchedMgs = append(matchedMgs, mg)
+			}
+		}
+		// We always pass it in, even if none match, because in that case we
+		// need to remove any mappings that exist
+		if _, _, err := r.SetManagedGroupMemberships(ctx, am, acct, matchedMgs); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	return acct, nil
+}
+
+// validateToken validates token against am and returns its claims. The aud
+// claim of the token must contain one of the auth method's bound audiences, so
+// an auth method without any can't be used to authenticate, since otherwise a
+// token issued to any other audience by the same issuer would be accepted.
+func validateToken(ctx context.Context, am *AuthMethod, token string) (map[string]any, error) {
+	const op = "jwt.validateToken"
+	if len(am.BoundAudiences) == 0 {
+		return nil, errors.New(ctx, errors.Unauthorized, op, "auth method has no bound audiences")
+	}
+	keySet, err := keySetFor(ctx, am)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	validator, err := capjwt.NewValidator(keySet)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create validator"))
+	}
+	expected := capjwt.Expected{
+		Issuer:    am.Issuer,
+		Audiences: am.BoundAudiences,
+	}
+	for _, alg := range am.SigningAlgorithms {
+		expected.SigningAlgorithms = append(expected.SigningAlgorithms, capjwt.Alg(alg))
+	}
+	claims, err := validator.Validate(ctx, token, expected)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unauthorized, op, "invalid token", errors.WithWrap(err))
+	}
+
+	boundClaims, err := am.BoundClaimMap()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for name, want := range boundClaims {
+		got, err := getClaim(claims, name)
+		if err != nil {
+			return nil, errors.New(ctx, errors.Unauthorized, op, fmt.Sprintf("missing bound claim %q", name), errors.WithWrap(err))
+		}
+		if !claimMatches(want, got) {
+			return nil, errors.New(ctx, errors.Unauthorized, op, fmt.Sprintf("claim %q does not match its bound value", name))
+		}
+	}
+	return claims, nil
+}
+
+// keySetFor returns the key set of am, creating it if it isn't cached yet or
+// the auth method has changed since it was cached.
+func keySetFor(ctx context.Context, am *AuthMethod) (capjwt.KeySet, error) {
+	const op = "jwt.keySetFor"
+	if v, ok := keySets.Load(am.PublicId); ok {
+		if c := v.(*cachedKeySet); c.version == am.Version {
+			return c.keySet, nil
+		}
+	}
+
+	var keySet capjwt.KeySet
+	var err error
+	switch {
+	case am.JwksUrl != "":
+		keySet, err = capjwt.NewJSONWebKeySet(ctx, am.JwksUrl, strings.Join(am.JwksCaCerts, "\n"))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create json web key set"))
+		}
+	default:
+		keys := make([]crypto.PublicKey, 0, len(am.PublicKeys))
+		for _, p := range am.PublicKeys {
+			k, err := capjwt.ParsePublicKeyPEM([]byte(p))
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse public key"))
+			}
+			keys = append(keys, k)
+		}
+		keySet, err = capjwt.NewStaticKeySet(keys)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create static key set"))
+		}
+	}
+	keySets.Store(am.PublicId, &cachedKeySet{version: am.Version, keySet: keySet})
+	return keySet, nil
+}
+
+// getClaim returns the value of the named claim. Names beginning with a slash
+// are treated as JSON pointers into nested claims, e.g. "/groups/0".
+func getClaim(claims map[string]any, name string) (any, error) {
+	if strings.HasPrefix(name, "/") {
+		return pointerstructure.Get(claims, name)
+	}
+	v, ok := claims[name]
+	if !ok {
+		return nil, pointerstructure.ErrNotFound
+	}
+	return v, nil
+}
+
+// stringClaim returns the value of the named claim as a string. Numeric claims
+// are converted to their decimal representation.
+func stringClaim(claims map[string]any, name string) (string, error) {
+	v, err := getClaim(claims, name)
+	if err != nil {
+		return "", err
+	}
+	switch t := v.(type) {
+	case string:
+		if t == "" {
+			return "", fmt.Errorf("claim %q is empty", name)
+		}
+		return t, nil
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64), nil
+	case json.Number:
+		return t.String(), nil
+	default:
+		return "", fmt.Errorf("claim %q is a %T, not a string", name, v)
+	}
+}
+
+// claimMatches reports whether got, which may be a single value or a list,
+// contains want or, if want is a list, any of its values.
+func claimMatches(want, got any) bool {
+	wants, ok := want.([]any)
+	if !ok {
+		wants = []any{want}
+	}
+	gots, ok := got.([]any)
+	if !ok {
+		gots = []any{got}
+	}
+	for _, w := range wants {
+		for _, g := range gots {
+			switch g.(type) {
+			case string, float64, bool:
+				if w == g {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package jwt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/cap/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Authenticate(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+
+	rootWrapper := db.TestWrapper(t)
+
+	testConn, _ := db.TestSetup(t, "postgres")
+	testRw := db.New(testConn)
+
+	testKms := kms.TestKms(t, testConn, rootWrapper)
+
+	testRepo, err := NewRepository(testCtx, testRw, testRw, testKms)
+	require.NoError(t, err)
+
+	iamRepo := iam.TestRepo(t, testConn, rootWrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	pub, priv := oidc.TestGenerateKeys(t)
+	const testIssuer = "https://issuer.example.com"
+
+	testAm := TestAuthMethod(t, testConn, org.PublicId, testIssuer,
+		WithOperationalState(ActivePublicState),
+		WithPublicKeys(TestEncodePublicKey(t, pub)),
+		WithSigningAlgs(ES256),
+		WithBoundAudiences("boundary"),
+		WithBoundClaims(map[string]any{"env": "prod"}),
+		WithEmailClaim("email"),
+	)
+	inactiveAm := TestAuthMethod(t, testConn, org.PublicId, testIssuer,
+		WithPublicKeys(TestEncodePublicKey(t, pub)),
+	)
+	noAudAm := TestAuthMethod(t, testConn, org.PublicId, testIssuer,
+		WithOperationalState(ActivePublicState),
+		WithPublicKeys(TestEncodePublicKey(t, pub)),
+		WithSigningAlgs(ES256),
+	)
+	testMg := TestManagedGroup(t, testConn, testAm, `"ops" in "/token/groups"`)
+	otherMg := TestManagedGroup(t, testConn, testAm, `"dev" in "/token/groups"`)
+
+	signToken := func(t *testing.T, overrides map[string]any) string {
+		t.Helper()
+		now := time.Now()
+		claims := map[string]any{
+			"iss":    testIssuer,
+			"aud":    []string{"boundary"},
+			"sub":    "ci-runner",
+			"env":    "prod",
+			"email":  "ci-runner@example.com",
+			"groups": []string{"ops"},
+			"iat":    now.Unix(),
+			"nbf":    now.Add(-time.Minute).Unix(),
+			"exp":    now.Add(time.Minute).Unix(),
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(claims, k)
+				continue
+			}
+			claims[k] = v
+		}
+		return oidc.TestSignJWT(t, priv, string(ES256), claims, nil)
+	}
+
+	tests := []struct {
+		name         string
+		authMethodId string
+		token        string
+		wantErrMatch *errors.Template
+	}{
+		{
+			name:         "success",
+			authMethodId: testAm.PublicId,
+			token:        signToken(t, nil),
+		},
+		{
+			name:         "missing-auth-method-id",
+			token:        signToken(t, nil),
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name:         "missing-token",
+			authMethodId: testAm.PublicId,
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name:         "inactive-auth-method",
+			authMethodId: inactiveAm.PublicId,
+			token:        signToken(t, nil),
+			wantErrMatch: errors.T(errors.AuthMethodInactive),
+		},
+		{
+			name:         "wrong-issuer",
+			authMethodId: testAm.PublicId,
+			token:        signToken(t, map[string]any{"iss": "https://other.example.com"}),
+			wantErrMatch: errors.T(errors.Unauthorized),
+		},
+		{
+			name:         "wrong-audience",
+			authMethodId: testAm.PublicId,
+			token:        signToken(t, map[string]any{"aud": []string{"other"}}),
+			wantErrMatch: errors.T(errors.Unauthorized),
+		},
+		{
+			name:         "no-bound-audiences",
+			authMethodId: noAudAm.PublicId,
+			token:        signToken(t, nil),
+			wantErrMatch: errors.T(errors.Unauthorized),
+		},
+		{
+			name:         "expired",
+			authMethodId: testAm.PublicId,
+			token:        signToken(t, map[string]any{"exp": time.Now().Add(-time.Hour).Unix()}),
+			wantErrMatch: errors.T(errors.Unauthorized),
+		},
+		{
+			name:         "bound-claim-mismatch",
+			authMethodId: testAm.PublicId,
+			token:        signToken(t, map[string]any{"env": "dev"}),
+			wantErrMatch: errors.T(errors.Unauthorized),
+		},
+		{
+			name:         "missing-subject",
+			authMethodId: testAm.PublicId,
+			token:        signToken(t, map[string]any{"sub": nil}),
+			wantErrMatch: errors.T(errors.Unauthorized),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			acct, err := testRepo.Authenticate(testCtx, tc.authMethodId, tc.token)
+			if tc.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tc.wantErrMatch, err), "want err code: %q got: %q", tc.wantErrMatch.Code, err)
+				assert.Nil(acct)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(testAm.PublicId, acct.AuthMethodId)
+			assert.Equal("ci-runner", acct.Subject)
+			assert.Equal("ci-runner@example.com", acct.Email)
+			assert.NotEmpty(acct.TokenClaims)
+
+			// authenticating again must update the same account
+			again, err := testRepo.Authenticate(testCtx, tc.authMethodId, tc.token)
+			require.NoError(err)
+			assert.Equal(acct.PublicId, again.PublicId)
+
+			memberships, err := testRepo.ListManagedGroupMembershipsByMember(testCtx, acct.PublicId)
+			require.NoError(err)
+			require.Len(memberships, 1)
+			assert.Equal(testMg.PublicId, memberships[0].ManagedGroupId)
+			assert.NotEqual(otherMg.PublicId, memberships[0].ManagedGroupId)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuthMethod creates a new auth method and it's persisted in the database.
+// See NewAuthMethod for list of supported options.
+func TestAuthMethod(t testing.TB, conn *db.DB, scopeId, issuer string, opt ...Option) *AuthMethod {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	am, err := NewAuthMethod(ctx, scopeId, issuer, opt...)
+	require.NoError(err)
+	id, err := newAuthMethodId(ctx)
+	require.NoError(err)
+	am.PublicId = id
+
+	require.NoError(rw.Create(ctx, am))
+
+	vo, err := am.convertValueObjects(ctx)
+	require.NoError(err)
+	for _, items := range [][]any{vo.Certs, vo.Keys, vo.Auds, vo.Algs} {
+		if len(items) > 0 {
+			require.NoError(rw.CreateItems(ctx, items))
+		}
+	}
+	return am
+}
+
+// TestAccount creates a test jwt auth account.
+func TestAccount(t testing.TB, conn *db.DB, am *AuthMethod, subject string, opt ...Option) *Account {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	a, err := NewAccount(ctx, am.PublicId, subject, opt...)
+	require.NoError(err)
+
+	id, err := newAccountId(ctx, am.PublicId, subject)
+	require.NoError(err)
+	a.PublicId = id
+
+	require.NoError(rw.Create(ctx, a))
+	return a
+}
+
+// TestManagedGroup creates a test jwt managed group.
+func TestManagedGroup(t testing.TB, conn *db.DB, am *AuthMethod, filter string, opt ...Option) *ManagedGroup {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	mg, err := NewManagedGroup(ctx, am.PublicId, filter, opt...)
+	require.NoError(err)
+
+	id, err := newManagedGroupId(ctx)
+	require.NoError(err)
+	mg.PublicId = id
+
+	require.NoError(rw.Create(ctx, mg))
+	return mg
+}
+
+// TestEncodePublicKey will PEM encode the public key pub, so it can be used
+// WithPublicKeys.
+func TestEncodePublicKey(t testing.TB, pub crypto.PublicKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/pagination"
 	"github.com/hashicorp/boundary/internal/util"
@@ -51,6 +52,8 @@ type options struct {
 	withDerefAliases         DerefAliasType
 	withMaximumPageSize      uint32
 	withStartPageAfterItem   pagination.Item
+	withReader               db.Reader
+	withWriter               db.Writer
 }
 
 // Option - how options are passed as args
@@ -429,3 +432,14 @@ func WithStartPageAfterItem(ctx context.Context, item pagination.Item) Option {
 		return nil
 	}
 }
+
+// WithReaderWriter allows the caller to pass an inflight transaction to be used
+// for the database writes of the operation. If WithReaderWriter(...) is used,
+// then the caller is responsible for managing the transaction.
+func WithReaderWriter(r db.Reader, w db.Writer) Option {
+	return func(o *options) error {
+		o.withReader = r
+		o.withWriter = w
+		return nil
+	}
+}
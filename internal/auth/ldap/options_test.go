@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/pagination"
@@ -386,4 +387,12 @@ func Test_getOpts(t *testing.T) {
 		assert.Equal(opts.withStartPageAfterItem.GetUpdateTime(), timestamp.New(updateTime))
 		assert.Equal(opts.withStartPageAfterItem.GetCreateTime(), timestamp.New(createTime))
 	})
+	t.Run("WithReaderWriter", func(t *testing.T) {
+		assert := assert.New(t)
+		rw := db.New(nil)
+		opts, err := getOpts(WithReaderWriter(rw, rw))
+		require.NoError(t, err)
+		assert.Equal(rw, opts.withReader)
+		assert.Equal(rw, opts.withWriter)
+	})
 }
@@ -26,7 +26,9 @@ import (
 //
 // Both a.Name and a.Description are optional. If a.Name is set, it must be
 // unique within a.AuthMethodId.
-func (r *Repository) CreateAccount(ctx context.Context, a *Account, _ ...Option) (*Account, error) {
+//
+// WithReaderWriter is the only valid option.
+func (r *Repository) CreateAccount(ctx context.Context, a *Account, opt ...Option) (*Account, error) {
 	const op = "ldap.(Repository).CreateAccount"
 	switch {
 	case a == nil:
@@ -46,6 +48,11 @@ func (r *Repository) CreateAccount(ctx context.Context, a *Account, _ ...Option)
 	}
 	a.PublicId = id
 
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
 	oplogWrapper, err := r.kms.GetWrapper(ctx, a.ScopeId, kms.KeyPurposeOplog)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"), errors.WithCode(errors.Encrypt))
@@ -56,15 +63,21 @@ func (r *Repository) CreateAccount(ctx context.Context, a *Account, _ ...Option)
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate account oplog metadata"))
 	}
 	var newAccount *Account
-	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
-			newAccount = a.clone()
-			if err := w.Create(ctx, newAccount, db.WithOplog(oplogWrapper, md)); err != nil {
-				return err
-			}
-			return nil
-		},
-	)
+	createFn := func(_ db.Reader, w db.Writer) error {
+		newAccount = a.clone()
+		if err := w.Create(ctx, newAccount, db.WithOplog(oplogWrapper, md)); err != nil {
+			return err
+		}
+		return nil
+	}
+	if opts.withReader != nil && opts.withWriter != nil {
+		if !opts.withWriter.IsTx(ctx) {
+			return nil, errors.New(ctx, errors.Internal, op, "writer is not in transaction")
+		}
+		err = createFn(opts.withReader, opts.withWriter)
+	} else {
+		_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, createFn)
+	}
 
 	if err != nil {
 		switch {
@@ -199,8 +212,8 @@ func (r *Repository) queryAccounts(ctx context.Context, whereClause string, args
 }
 
 // DeleteAccount deletes the account for the provided id from the repository returning a count of the
-// number of records deleted.  All options are ignored.
-func (r *Repository) DeleteAccount(ctx context.Context, withPublicId string, _ ...Option) (int, error) {
+// number of records deleted. WithReaderWriter is the only valid option.
+func (r *Repository) DeleteAccount(ctx context.Context, withPublicId string, opt ...Option) (int, error) {
 	const op = "ldap.(Repository).DeleteAccount"
 	switch {
 	case withPublicId == "":
@@ -209,6 +222,11 @@ func (r *Repository) DeleteAccount(ctx context.Context, withPublicId string, _ .
 	ac := AllocAccount()
 	ac.PublicId = withPublicId
 
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
 	if err := r.reader.LookupById(ctx, ac); err != nil {
 		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("account not found"))
 	}
@@ -223,22 +241,25 @@ func (r *Repository) DeleteAccount(ctx context.Context, withPublicId string, _ .
 	}
 
 	var rowsDeleted int
-	_, err = r.writer.DoTx(
-		ctx,
-		db.StdRetryCnt,
-		db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) (err error) {
-			dAc := ac.clone()
-			rowsDeleted, err = w.Delete(ctx, dAc, db.WithOplog(oplogWrapper, metadata))
-			switch {
-			case err != nil:
-				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete ldap account"))
-			case rowsDeleted > 1:
-				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
-			}
-			return nil
-		},
-	)
+	deleteFn := func(_ db.Reader, w db.Writer) (err error) {
+		dAc := ac.clone()
+		rowsDeleted, err = w.Delete(ctx, dAc, db.WithOplog(oplogWrapper, metadata))
+		switch {
+		case err != nil:
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete ldap account"))
+		case rowsDeleted > 1:
+			return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+		}
+		return nil
+	}
+	if opts.withReader != nil && opts.withWriter != nil {
+		if !opts.withWriter.IsTx(ctx) {
+			return db.NoRowsAffected, errors.New(ctx, errors.Internal, op, "writer is not in transaction")
+		}
+		err = deleteFn(opts.withReader, opts.withWriter)
+	} else {
+		_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, deleteFn)
+	}
 	if err != nil {
 		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(withPublicId))
 	}
@@ -6,6 +6,8 @@ package oidc
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -23,8 +25,32 @@ const (
 	ToSubClaim   AccountToClaim = "sub"
 	ToEmailClaim AccountToClaim = "email"
 	ToNameClaim  AccountToClaim = "name"
+
+	// ToAttributeClaimPrefix is the prefix of the claims that map to the
+	// account's attributes, e.g. "attributes.department".
+	ToAttributeClaimPrefix = "attributes."
 )
 
+// validAttributeName matches the names of the attributes claims can be mapped
+// to.
+var validAttributeName = regexp.MustCompile(`^[A-Za-z0-9_-]{1,128}$`)
+
+// ToAttributeClaim returns the AccountToClaim that maps a claim to the
+// account attribute with the given name.
+func ToAttributeClaim(name string) AccountToClaim {
+	return AccountToClaim(ToAttributeClaimPrefix + name)
+}
+
+// AttributeName returns the name of the account attribute the claim maps to
+// and true, or an empty string and false if it doesn't map to an attribute.
+func (c AccountToClaim) AttributeName() (string, bool) {
+	name, ok := strings.CutPrefix(string(c), ToAttributeClaimPrefix)
+	if !ok || !validAttributeName.MatchString(name) {
+		return "", false
+	}
+	return name, true
+}
+
 func ConvertToAccountToClaim(ctx context.Context, s string) (AccountToClaim, error) {
 	const op = "oidc.(AccountToClaim).convertToAccountToClaim"
 	switch s {
@@ -35,6 +61,9 @@ func ConvertToAccountToClaim(ctx context.Context, s string) (AccountToClaim, err
 	case string(ToNameClaim):
 		return ToNameClaim, nil
 	default:
+		if _, ok := AccountToClaim(s).AttributeName(); ok {
+			return AccountToClaim(s), nil
+		}
 		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is not a valid ToAccountClaim value", s))
 	}
 }
@@ -79,6 +79,22 @@ func TestAccountClaimMap_Create(t *testing.T) {
 			wantCreateErr:   true,
 			wantCreateIsErr: errors.NotUnique,
 		},
+		{
+			name: "valid-attribute",
+			args: args{
+				authMethodId: testAuthMethod.PublicId,
+				to:           ToAttributeClaim("department"),
+				from:         "dept",
+			},
+			create: true,
+			want: func() *AccountClaimMap {
+				want := AllocAccountClaimMap()
+				want.OidcMethodId = testAuthMethod.PublicId
+				want.ToClaim = "attributes.department"
+				want.FromClaim = "dept"
+				return &want
+			}(),
+		},
 		{
 			name: "empty-auth-method",
 			args: args{
@@ -137,6 +153,42 @@ func TestAccountClaimMap_Create(t *testing.T) {
 	}
 }
 
+func TestConvertToAccountToClaim(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		in            string
+		want          AccountToClaim
+		wantErr       bool
+		wantAttribute string
+	}{
+		{name: "sub", in: "sub", want: ToSubClaim},
+		{name: "email", in: "email", want: ToEmailClaim},
+		{name: "name", in: "name", want: ToNameClaim},
+		{name: "attribute", in: "attributes.cost_center", want: ToAttributeClaim("cost_center"), wantAttribute: "cost_center"},
+		{name: "case-sensitive", in: "Email", wantErr: true},
+		{name: "missing-attribute-name", in: "attributes.", wantErr: true},
+		{name: "nested-attribute-name", in: "attributes.org.unit", wantErr: true},
+		{name: "invalid", in: "groups", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := ConvertToAccountToClaim(context.Background(), tt.in)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+			name, ok := got.AttributeName()
+			assert.Equal(tt.wantAttribute != "", ok)
+			assert.Equal(tt.wantAttribute, name)
+		})
+	}
+}
+
 func TestAccountClaimMap_Delete(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
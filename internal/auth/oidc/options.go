@@ -50,6 +50,7 @@ type options struct {
 	withOperationalState    AuthMethodState
 	withAccountClaimMap     map[string]AccountToClaim
 	withReader              db.Reader
+	withWriter              db.Writer
 	withStartPageAfterItem  pagination.Item
 }
 
@@ -250,3 +251,13 @@ func WithStartPageAfterItem(item pagination.Item) Option {
 		o.withStartPageAfterItem = item
 	}
 }
+
+// WithReaderWriter allows the caller to pass an inflight transaction to be used
+// for the database writes of the operation. If WithReaderWriter(...) is used,
+// then the caller is responsible for managing the transaction.
+func WithReaderWriter(r db.Reader, w db.Writer) Option {
+	return func(o *options) {
+		o.withReader = r
+		o.withWriter = w
+	}
+}
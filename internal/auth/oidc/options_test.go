@@ -228,6 +228,13 @@ func Test_GetOpts(t *testing.T) {
 		opts := getOpts(WithReader(r))
 		assert.Equal(r, opts.withReader)
 	})
+	t.Run("WithReaderWriter", func(t *testing.T) {
+		assert := assert.New(t)
+		rw := db.New(nil)
+		opts := getOpts(WithReaderWriter(rw, rw))
+		assert.Equal(rw, opts.withReader)
+		assert.Equal(rw, opts.withWriter)
+	})
 	t.Run("WithStartPageAfterItem", func(t *testing.T) {
 		assert := assert.New(t)
 		updateTime := time.Now()
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"sync"
 
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/cap/oidc"
 )
@@ -32,16 +33,29 @@ func providerCache() *providers {
 // providers is a cache of oidc.Provider types used by the Repository to
 // make requests to the IdP, verify ID tokens, etc.  For more info on
 // oidc.Provider capabilities see: https://github.com/hashicorp/cap
+//
+// It also caches the discovery information of each provider, which is needed
+// for device authorization grants, so the provider's discovery document isn't
+// retrieved every time a client polls.
 type providers struct {
-	cache map[string]*oidc.Provider
-	mu    *sync.RWMutex
+	cache      map[string]*oidc.Provider
+	discovered map[string]*discoveredProvider
+	mu         *sync.RWMutex
+}
+
+// discoveredProvider is the discovery information of a provider along with
+// the config hash of the provider it was discovered with.
+type discoveredProvider struct {
+	configHash uint64
+	provider   *gooidc.Provider
 }
 
 // newProviderCache make a new cache
 func newProviderCache() *providers {
 	return &providers{
-		cache: map[string]*oidc.Provider{},
-		mu:    &sync.RWMutex{},
+		cache:      map[string]*oidc.Provider{},
+		discovered: map[string]*discoveredProvider{},
+		mu:         &sync.RWMutex{},
 	}
 }
 
@@ -98,6 +112,38 @@ func (c *providers) delete(ctx context.Context, authMethodId string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	delete(c.cache, authMethodId)
+	delete(c.discovered, authMethodId)
+}
+
+// discover returns the discovery information of the auth method's provider p,
+// which was returned by get. The discovery information is retrieved from the
+// provider's issuer the first time, and whenever p's configuration has
+// changed since it was cached.
+func (c *providers) discover(ctx context.Context, p *oidc.Provider, am *AuthMethod) (*gooidc.Provider, error) {
+	const op = "oidc.(providers).discover"
+	hash, err := p.ConfigHash()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to hash provider"))
+	}
+	c.mu.RLock()
+	d, ok := c.discovered[am.PublicId]
+	c.mu.RUnlock()
+	if ok && d.configHash == hash {
+		return d.provider, nil
+	}
+
+	providerCtx, err := p.HTTPClientContext(ctx)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to get provider http client", errors.WithWrap(err))
+	}
+	discovered, err := gooidc.NewProvider(providerCtx, am.Issuer)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to discover oidc provider", errors.WithWrap(err))
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.discovered[am.PublicId] = &discoveredProvider{configHash: hash, provider: discovered}
+	return discovered, nil
 }
 
 func convertToProvider(ctx context.Context, am *AuthMethod) (*oidc.Provider, error) {
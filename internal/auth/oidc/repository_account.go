@@ -32,7 +32,7 @@ import (
 // Both a.Name and a.Description are optional. If a.Name is set, it must be
 // unique within a.AuthMethodId.
 //
-// WithPublicId is currently the only valid option.
+// WithPublicId and WithReaderWriter are the only valid options.
 func (r *Repository) CreateAccount(ctx context.Context, scopeId string, a *Account, opt ...Option) (*Account, error) {
 	const op = "oidc.(Repository).CreateAccount"
 	if a == nil {
@@ -97,15 +97,21 @@ func (r *Repository) CreateAccount(ctx context.Context, scopeId string, a *Accou
 	}
 
 	var newAccount *Account
-	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
-			newAccount = a.Clone()
-			if err := w.Create(ctx, newAccount, db.WithOplog(oplogWrapper, a.oplog(oplog.OpType_OP_TYPE_CREATE, scopeId))); err != nil {
-				return errors.Wrap(ctx, err, op)
-			}
-			return nil
-		},
-	)
+	createFn := func(_ db.Reader, w db.Writer) error {
+		newAccount = a.Clone()
+		if err := w.Create(ctx, newAccount, db.WithOplog(oplogWrapper, a.oplog(oplog.OpType_OP_TYPE_CREATE, scopeId))); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		return nil
+	}
+	if opts.withReader != nil && opts.withWriter != nil {
+		if !opts.withWriter.IsTx(ctx) {
+			return nil, errors.New(ctx, errors.Internal, op, "writer is not in transaction")
+		}
+		err = createFn(opts.withReader, opts.withWriter)
+	} else {
+		_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, createFn)
+	}
 
 	if err != nil {
 		if errors.IsUniqueError(err) {
@@ -230,7 +236,7 @@ func (r *Repository) queryAccounts(ctx context.Context, whereClause string, args
 }
 
 // DeleteAccount deletes the account for the provided id from the repository returning a count of the
-// number of records deleted.  All options are ignored.
+// number of records deleted. WithReaderWriter is the only valid option.
 func (r *Repository) DeleteAccount(ctx context.Context, scopeId, withPublicId string, opt ...Option) (int, error) {
 	const op = "oidc.(Repository).DeleteAccount"
 	if withPublicId == "" {
@@ -242,29 +248,34 @@ func (r *Repository) DeleteAccount(ctx context.Context, scopeId, withPublicId st
 	ac := AllocAccount()
 	ac.PublicId = withPublicId
 
+	opts := getOpts(opt...)
+
 	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
 	if err != nil {
 		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
 	}
 
 	var rowsDeleted int
-	_, err = r.writer.DoTx(
-		ctx,
-		db.StdRetryCnt,
-		db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) (err error) {
-			metadata := ac.oplog(oplog.OpType_OP_TYPE_DELETE, scopeId)
-			dAc := ac.Clone()
-			rowsDeleted, err = w.Delete(ctx, dAc, db.WithOplog(oplogWrapper, metadata))
-			if err != nil {
-				return errors.Wrap(ctx, err, op)
-			}
-			if rowsDeleted > 1 {
-				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
-			}
-			return nil
-		},
-	)
+	deleteFn := func(_ db.Reader, w db.Writer) (err error) {
+		metadata := ac.oplog(oplog.OpType_OP_TYPE_DELETE, scopeId)
+		dAc := ac.Clone()
+		rowsDeleted, err = w.Delete(ctx, dAc, db.WithOplog(oplogWrapper, metadata))
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if rowsDeleted > 1 {
+			return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+		}
+		return nil
+	}
+	if opts.withReader != nil && opts.withWriter != nil {
+		if !opts.withWriter.IsTx(ctx) {
+			return db.NoRowsAffected, errors.New(ctx, errors.Internal, op, "writer is not in transaction")
+		}
+		err = deleteFn(opts.withReader, opts.withWriter)
+	} else {
+		_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, deleteFn)
+	}
 
 	if err != nil {
 		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(withPublicId))
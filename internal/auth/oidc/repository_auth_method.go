@@ -39,6 +39,9 @@ func (r *Repository) upsertAccount(ctx context.Context, am *AuthMethod, IdTokenC
 	}
 
 	fromSub, fromName, fromEmail := string(ToSubClaim), string(ToNameClaim), string(ToEmailClaim)
+	// fromAttributes maps the names of account attributes to the claims they
+	// are mapped from.
+	fromAttributes := map[string]string{}
 	if len(am.AccountClaimMaps) > 0 {
 		acms, err := ParseAccountClaimMaps(ctx, am.AccountClaimMaps...)
 		if err != nil {
@@ -57,6 +60,10 @@ func (r *Repository) upsertAccount(ctx context.Context, am *AuthMethod, IdTokenC
 			case ToNameClaim:
 				fromName = m.From
 			default:
+				if name, ok := toClaim.AttributeName(); ok {
+					fromAttributes[name] = m.From
+					continue
+				}
 				// should never happen, but including it just in case.
 				return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s=%s is not a valid account claim map", m.From, m.To))
 			}
@@ -149,6 +156,30 @@ func (r *Repository) upsertAccount(ctx context.Context, am *AuthMethod, IdTokenC
 		nullMasks = append(nullMasks, "Email")
 	}
 
+	attributes := make(map[string]any, len(fromAttributes))
+	for name, from := range fromAttributes {
+		switch {
+		case AccessTokenClaims[from] != nil:
+			attributes[name] = AccessTokenClaims[from]
+		case IdTokenClaims[from] != nil:
+			attributes[name] = IdTokenClaims[from]
+		}
+	}
+	var marshaledAttributes string
+	if len(attributes) > 0 {
+		b, err := json.Marshal(attributes)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		marshaledAttributes = string(b)
+		columns, values = append(columns, "attributes"), append(values, sql.Named(fmt.Sprintf("%d", len(values)+1), marshaledAttributes))
+		conflictClauses = append(conflictClauses, fmt.Sprintf("attributes = @%d", len(values)))
+		fieldMasks = append(fieldMasks, AttributesField)
+	} else {
+		conflictClauses = append(conflictClauses, "attributes = NULL")
+		nullMasks = append(nullMasks, AttributesField)
+	}
+
 	placeHolders := make([]string, 0, len(columns))
 	for colNum := range columns {
 		placeHolders = append(placeHolders, fmt.Sprintf("@%d", colNum+1))
@@ -208,6 +239,7 @@ func (r *Repository) upsertAccount(ctx context.Context, am *AuthMethod, IdTokenC
 					if foundName != nil {
 						acctForOplog.FullName = foundName.(string)
 					}
+					acctForOplog.Attributes = marshaledAttributes
 					if err := upsertOplog(ctx, w, oplogWrapper, oplog.OpType_OP_TYPE_UPDATE, am.ScopeId, acctForOplog, fieldMasks, nullMasks); err != nil {
 						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write update oplog for account"))
 					}
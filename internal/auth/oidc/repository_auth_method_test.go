@@ -49,6 +49,14 @@ func Test_upsertAccount(t *testing.T) {
 		WithApiUrl(TestConvertToUrls(t, "https://alice-active-priv.com/callback")[0]),
 		WithSigningAlgs(RS256))
 
+	amWithAttributes := TestAuthMethod(
+		t,
+		conn, databaseWrapper, org.PublicId, ActivePrivateState,
+		"alice_rp", "fido",
+		WithAccountClaimMap(map[string]AccountToClaim{"dept": ToAttributeClaim("department"), "groups": ToAttributeClaim("groups")}),
+		WithApiUrl(TestConvertToUrls(t, "https://alice-active-priv.com/callback")[0]),
+		WithSigningAlgs(RS256))
+
 	tests := []struct {
 		name            string
 		am              *AuthMethod
@@ -114,6 +122,20 @@ func Test_upsertAccount(t *testing.T) {
 				UserinfoClaims: `{}`,
 			}},
 		},
+		{
+			name:     "success-map-attributes",
+			am:       amWithAttributes,
+			idClaims: map[string]any{"iss": "https://alice-active-priv.com", "sub": "success-map-attributes", "dept": "eng", "groups": []any{"admins", "devs"}},
+			atClaims: map[string]any{"dept": "sre"},
+			wantAcct: &Account{Account: &store.Account{
+				AuthMethodId:   amWithAttributes.PublicId,
+				Issuer:         "https://alice-active-priv.com",
+				Subject:        "success-map-attributes",
+				TokenClaims:    `{"dept":"eng","groups":["admins","devs"],"iss":"https://alice-active-priv.com","sub":"success-map-attributes"}`,
+				UserinfoClaims: `{"dept":"sre"}`,
+				Attributes:     `{"department":"sre","groups":["admins","devs"]}`,
+			}},
+		},
 		{
 			name:            "non-existent-auth-method-scope-id",
 			am:              func() *AuthMethod { cp := amActivePriv.Clone(); cp.ScopeId = "non-existent-scope-id"; return cp }(),
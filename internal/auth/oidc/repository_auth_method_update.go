@@ -39,6 +39,7 @@ const (
 	AccountClaimMapsField                  = "AccountClaimMaps"
 	TokenClaimsField                       = "TokenClaims"
 	UserinfoClaimsField                    = "UserinfoClaims"
+	AttributesField                        = "Attributes"
 	KeyIdField                             = "KeyId"
 	PromptsField                           = "Prompts"
 )
@@ -5,6 +5,7 @@ package oidc
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -187,21 +188,51 @@ func Callback(
 		}
 	}
 
+	if err := r.finishAuth(ctx, iamRepoFn, atRepoFn, am, reqState.TokenRequestId, idTkClaims, userInfoClaims); err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	// tada!  we can return a final redirect URL for the successful authentication.
+	return reqState.FinalRedirectUrl, nil
+}
+
+// finishAuth completes a successful authentication with the claims of the
+// provider's ID Token and userinfo. It creates or updates the account,
+// sets its managed group memberships and creates a pending auth token with
+// the tokenRequestId, which the client retrieves with TokenRequest.
+//
+// The sequentially ordered transactions all leave the database in a
+// consistent state, even if subsequent transactions fail.
+func (r *Repository) finishAuth(
+	ctx context.Context,
+	iamRepoFn IamRepoFactory,
+	atRepoFn AuthTokenRepoFactory,
+	am *AuthMethod,
+	tokenRequestId string,
+	idTkClaims, userInfoClaims map[string]any,
+) error {
+	const op = "oidc.(Repository).finishAuth"
 	acct, err := r.upsertAccount(ctx, am, idTkClaims, userInfoClaims)
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
 
 	// Get the set of all managed groups so we can filter
 	mgs, _, err := r.ListManagedGroups(ctx, am.GetPublicId())
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
 	if len(mgs) > 0 {
 		matchedMgs := make([]*ManagedGroup, 0, len(mgs))
+		attributes := map[string]any{}
+		if acct.Attributes != "" {
+			if err := json.Unmarshal([]byte(acct.Attributes), &attributes); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to unmarshal account attributes"))
+			}
+		}
 		evalData := map[string]any{
-			"token":    idTkClaims,
-			"userinfo": userInfoClaims,
+			"token":      idTkClaims,
+			"userinfo":   userInfoClaims,
+			"attributes": attributes,
 		}
 		// Iterate through and check claims against filters
 		for _, mg := range mgs {
@@ -209,11 +240,11 @@ func Callback(
 			if err != nil {
 				// We check all filters on ingress so this should never happen,
 				// but we validate anyways
-				return "", errors.Wrap(ctx, err, op)
+				return errors.Wrap(ctx, err, op)
 			}
 			match, err := eval.Evaluate(evalData)
 			if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
-				return "", errors.Wrap(ctx, err, op)
+				return errors.Wrap(ctx, err, op)
 			}
 			if match {
 				matchedMgs = append(matchedMgs, mg)
@@ -222,7 +253,7 @@ func Callback(
 		// We always pass it in, even if none match, because in that case we
 		// need to remove any mappings that exist
 		if _, _, err := r.SetManagedGroupMemberships(ctx, am, acct, matchedMgs); err != nil {
-			return "", errors.Wrap(ctx, err, op)
+			return errors.Wrap(ctx, err, op)
 		}
 	}
 
@@ -231,17 +262,25 @@ func Callback(
 	// autovivify users for the scope.
 	iamRepo, err := iamRepoFn()
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
 
 	_, err = iamRepo.LookupScope(ctx, am.ScopeId)
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup account scope: "+am.ScopeId))
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup account scope: "+am.ScopeId))
 	}
 
 	user, err := iamRepo.LookupUserWithLogin(ctx, acct.PublicId)
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
+	}
+
+	// The user's attributes are those of the account the user last
+	// authenticated with.
+	if user.Attributes != acct.Attributes {
+		if user, err = iamRepo.SetUserAttributes(ctx, user.GetPublicId(), acct.Attributes); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
 	}
 
 	// Now we need to check filters and assign managed groups by filter.
@@ -251,19 +290,18 @@ func Callback(
 	// that initialed the authentication attempt.
 	tokenRepo, err := atRepoFn()
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
-	authToken, err := tokenRepo.CreateAuthToken(ctx, user, acct.PublicId, authtoken.WithPublicId(reqState.TokenRequestId), authtoken.WithStatus(authtoken.PendingStatus))
+	authToken, err := tokenRepo.CreateAuthToken(ctx, user, acct.PublicId, authtoken.WithPublicId(tokenRequestId), authtoken.WithStatus(authtoken.PendingStatus))
 	if err != nil {
 		if errors.Match(errors.T(errors.NotUnique), err) {
-			return "", errors.New(ctx, errors.Forbidden, op, "not a unique request", errors.WithWrap(err))
+			return errors.New(ctx, errors.Forbidden, op, "not a unique request", errors.WithWrap(err))
 		}
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
 	if err := event.WriteObservation(ctx, op, event.WithDetails("user_id", user.GetPublicId(), "auth_token_start",
 		authToken.GetCreateTime(), "auth_token_end", authToken.GetExpirationTime())); err != nil {
-		return "", errors.Wrap(ctx, err, op, errors.WithMsg("Unable to write observation event for authenticate method"))
+		return errors.Wrap(ctx, err, op, errors.WithMsg("Unable to write observation event for authenticate method"))
 	}
-	// tada!  we can return a final redirect URL for the successful authentication.
-	return reqState.FinalRedirectUrl, nil
+	return nil
 }
@@ -0,0 +1,487 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/hashicorp/boundary/internal/auth/oidc/request"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/cap/oidc"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// deviceCodeGrantType is the grant type of device access token requests.
+	// See: https://datatracker.ietf.org/doc/html/rfc8628#section-3.4
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	// defaultDeviceInterval is the interval between device access token
+	// requests when the provider doesn't return one.
+	// See: https://datatracker.ietf.org/doc/html/rfc8628#section-3.2
+	defaultDeviceInterval = 5 * time.Second
+
+	// slowDownIncrement is added to the interval between device access token
+	// requests each time the provider responds with slow_down.
+	// See: https://datatracker.ietf.org/doc/html/rfc8628#section-3.5
+	slowDownIncrement = 5 * time.Second
+)
+
+// DeviceAuthorization is returned by StartDeviceAuth and contains what a user
+// needs to complete a device authorization grant on another device.
+type DeviceAuthorization struct {
+	// UserCode is the code the user enters at the VerificationUri.
+	UserCode string
+	// VerificationUri is where the user enters the UserCode.
+	VerificationUri string
+	// VerificationUriComplete, if the provider returned it, is the
+	// VerificationUri with the UserCode included.
+	VerificationUriComplete string
+	// Interval is the time the client should wait between token requests.
+	Interval time.Duration
+	// ExpirationTime is when the device authorization grant expires.
+	ExpirationTime time.Time
+}
+
+// StartDeviceAuth accepts a request to start an OAuth 2.0 device authorization
+// grant with the OIDC auth method, for clients which cannot open a browser. It
+// returns the DeviceAuthorization the user needs to authenticate on another
+// device, and a tokenId. The tokenId is an encrypted payload for the token
+// request the client polls with. While the user hasn't finished authenticating,
+// each call to PollDeviceAuth with the tokenId polls the provider's token
+// endpoint once.
+//
+// If the auth method is in an InactiveState, or the provider doesn't support
+// the device authorization grant, then an error is returned.
+//
+// See: https://datatracker.ietf.org/doc/html/rfc8628
+func StartDeviceAuth(ctx context.Context, oidcRepoFn OidcRepoFactory, authMethodId string) (*DeviceAuthorization, string, error) {
+	const op = "oidc.StartDeviceAuth"
+	if authMethodId == "" {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	if oidcRepoFn == nil {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, "missing oidc repo function")
+	}
+	r, err := oidcRepoFn()
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	am, err := r.lookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, "", errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", authMethodId))
+	}
+	if am.OperationalState == string(InactiveState) {
+		return nil, "", errors.New(ctx, errors.AuthMethodInactive, op, "not allowed to start authentication attempt")
+	}
+
+	// get the provider from the cache (if possible)
+	provider, err := providerCache().get(ctx, am)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	hash, err := provider.ConfigHash()
+	if err != nil {
+		return nil, "", errors.New(ctx, errors.Unknown, op, "unable to get provider config hash", errors.WithWrap(err))
+	}
+	discovered, providerCtx, err := discoverProvider(ctx, provider, am)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	if discovered.Endpoint().DeviceAuthURL == "" {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, "provider does not support the device authorization grant")
+	}
+
+	conf := &oauth2.Config{
+		ClientID: am.ClientId,
+		Endpoint: discovered.Endpoint(),
+		Scopes:   strutil.RemoveDuplicatesStable(append([]string{gooidc.ScopeOpenID}, am.ClaimsScopes...), false),
+	}
+	var authOpts []oauth2.AuthCodeOption
+	if am.ClientSecret != "" {
+		authOpts = append(authOpts, oauth2.SetAuthURLParam("client_secret", am.ClientSecret))
+	}
+	da, err := conf.DeviceAuth(providerCtx, authOpts...)
+	if err != nil {
+		return nil, "", errors.New(ctx, errors.Unknown, op, "unable to start device authorization with oidc provider", errors.WithWrap(err))
+	}
+	if da.DeviceCode == "" || da.UserCode == "" || da.VerificationURI == "" {
+		return nil, "", errors.New(ctx, errors.Unknown, op, "incomplete device authorization response from oidc provider")
+	}
+
+	exp := da.Expiry
+	if exp.IsZero() {
+		exp = time.Now().Add(AttemptExpiration)
+	}
+	exp = exp.Truncate(time.Second)
+	interval := defaultDeviceInterval
+	if da.Interval > 0 {
+		interval = time.Duration(da.Interval) * time.Second
+	}
+
+	tokenRequestId, err := authtoken.NewAuthTokenId(ctx)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	requestWrapper, err := requestWrappingWrapper(ctx, r.kms, am.ScopeId, authMethodId)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	t := &request.Token{
+		RequestId:             tokenRequestId,
+		ExpirationTime:        &timestamp.Timestamp{Timestamp: timestamppb.New(exp)},
+		DeviceCode:            da.DeviceCode,
+		ProviderConfigHash:    hash,
+		DeviceIntervalSeconds: uint32(interval.Seconds()),
+	}
+	encodedEncryptedTk, err := encryptMessage(ctx, requestWrapper, am, t)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	return &DeviceAuthorization{
+		UserCode:                da.UserCode,
+		VerificationUri:         da.VerificationURI,
+		VerificationUriComplete: da.VerificationURIComplete,
+		Interval:                interval,
+		ExpirationTime:          exp,
+	}, encodedEncryptedTk, nil
+}
+
+// PollDeviceAuth is an oidc domain service function for polling the provider
+// on behalf of a Boundary client which started a device authorization grant
+// with StartDeviceAuth. It's called with the tokenRequestId before each
+// TokenRequest. For token requests which aren't from device authorization
+// grants it does nothing.
+//
+// The provider's token endpoint is polled at most once, and not before the
+// interval the provider asked for has passed since the previous poll. The
+// interval grows each time the provider asks to slow down, so clients polling
+// more often than that don't cause the provider to be polled more often. While
+// the user hasn't finished authenticating, nothing is done. Once they have, the provider's
+// ID Token is validated and, like the Callback of an authorization code flow,
+// the account is created/updated and a pending auth token is created for the
+// following TokenRequest to issue.
+//
+// PollDeviceAuth returns errors.Forbidden if the user denied the request and
+// errors.AuthAttemptExpired once the device code has expired.
+func PollDeviceAuth(
+	ctx context.Context,
+	oidcRepoFn OidcRepoFactory,
+	iamRepoFn IamRepoFactory,
+	atRepoFn AuthTokenRepoFactory,
+	authMethodId, tokenRequestId string,
+) error {
+	const op = "oidc.PollDeviceAuth"
+	switch {
+	case oidcRepoFn == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing oidc repository function")
+	case iamRepoFn == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing iam repository function")
+	case atRepoFn == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing auth token repository function")
+	case authMethodId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case tokenRequestId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing token request id")
+	}
+
+	r, err := oidcRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	reqTk, err := decryptRequestToken(ctx, r.kms, authMethodId, tokenRequestId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if reqTk.DeviceCode == "" {
+		// not a device authorization grant
+		return nil
+	}
+	if time.Now().After(reqTk.ExpirationTime.Timestamp.AsTime()) {
+		return errors.New(ctx, errors.AuthAttemptExpired, op, "request token id has expired")
+	}
+
+	// The provider only issues tokens for a device code once, so if a pending
+	// token was already created, the client just hasn't retrieved it yet.
+	tokenRepo, err := atRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	pending, err := tokenRepo.LookupAuthToken(ctx, reqTk.RequestId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if pending != nil {
+		return nil
+	}
+
+	am, err := r.lookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", authMethodId))
+	}
+	provider, err := providerCache().get(ctx, am)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	// if auth method is inactive, we don't allow inflight requests to finish if the
+	// auth method's config has changed since the request was kicked off.
+	hash, err := provider.ConfigHash()
+	if err != nil {
+		return errors.New(ctx, errors.Unknown, op, "unable to get provider config hash", errors.WithWrap(err))
+	}
+	if reqTk.ProviderConfigHash != hash && am.OperationalState == string(InactiveState) {
+		return errors.New(ctx, errors.AuthMethodInactive, op, "auth method configuration changed during in-flight authentication attempt")
+	}
+	discovered, providerCtx, err := discoverProvider(ctx, provider, am)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	client, err := provider.HTTPClient()
+	if err != nil {
+		return errors.New(ctx, errors.Unknown, op, "unable to get provider http client", errors.WithWrap(err))
+	}
+
+	interval := defaultDeviceInterval
+	if reqTk.DeviceIntervalSeconds > 0 {
+		interval = time.Duration(reqTk.DeviceIntervalSeconds) * time.Second
+	}
+	if !devicePollCache().due(reqTk.RequestId, interval, reqTk.ExpirationTime.Timestamp.AsTime()) {
+		// the provider was polled too recently, so the user is treated as not
+		// having finished authenticating yet
+		return nil
+	}
+	tk, err := deviceAccessToken(providerCtx, client, discovered.Endpoint().TokenURL, am.ClientId, string(am.ClientSecret), reqTk.DeviceCode)
+	if err != nil {
+		return errors.New(ctx, errors.Unknown, op, "unable to request token from oidc provider", errors.WithWrap(err))
+	}
+	switch tk.Error {
+	case "":
+		devicePollCache().delete(reqTk.RequestId)
+	case "authorization_pending":
+		// the user hasn't finished authenticating yet
+		return nil
+	case "slow_down":
+		// the user hasn't finished authenticating yet, and the provider
+		// wants to be polled less often
+		devicePollCache().slowDown(reqTk.RequestId)
+		return nil
+	case "access_denied":
+		devicePollCache().delete(reqTk.RequestId)
+		return errors.New(ctx, errors.Forbidden, op, "device authorization was denied")
+	case "expired_token":
+		devicePollCache().delete(reqTk.RequestId)
+		return errors.New(ctx, errors.AuthAttemptExpired, op, "device code has expired")
+	default:
+		devicePollCache().delete(reqTk.RequestId)
+		return errors.New(ctx, errors.Unknown, op, fmt.Sprintf("oidc provider returned error %q: %s", tk.Error, tk.ErrorDescription))
+	}
+	if tk.IdToken == "" {
+		return errors.New(ctx, errors.Unknown, op, "oidc provider did not return an ID Token")
+	}
+
+	// Device authorization requests don't carry a nonce, so the ID Token is
+	// verified without one.
+	verifier := discovered.Verifier(&gooidc.Config{
+		ClientID:             am.ClientId,
+		SupportedSigningAlgs: am.SigningAlgs,
+		SkipClientIDCheck:    len(am.AudClaims) > 0,
+	})
+	idTk, err := verifier.Verify(providerCtx, tk.IdToken)
+	if err != nil {
+		return errors.New(ctx, errors.Unknown, op, "invalid ID Token", errors.WithWrap(err))
+	}
+	if len(am.AudClaims) > 0 && !audiencesAllowed(am.AudClaims, idTk.Audience) {
+		return errors.New(ctx, errors.Unknown, op, "invalid ID Token audiences")
+	}
+
+	idTkClaims := map[string]any{}     // intentionally, NOT nil for call to finishAuth(...)
+	userInfoClaims := map[string]any{} // intentionally, NOT nil for call to finishAuth(...)
+	if err := idTk.Claims(&idTkClaims); err != nil {
+		return errors.New(ctx, errors.Unknown, op, "unable to parse ID Token claims", errors.WithWrap(err))
+	}
+	if tk.AccessToken != "" {
+		ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: tk.AccessToken, TokenType: tk.TokenType})
+		if err := provider.UserInfo(providerCtx, ts, idTk.Subject, &userInfoClaims); err != nil {
+			return errors.New(ctx, errors.Unknown, op, "unable to get user info from provider", errors.WithWrap(err))
+		}
+	}
+
+	if err := r.finishAuth(ctx, iamRepoFn, atRepoFn, am, reqTk.RequestId, idTkClaims, userInfoClaims); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// discoverProvider returns the discovery information of the auth method's
+// provider, which includes its device authorization endpoint, from the
+// provider cache. The returned context carries the provider's http client.
+func discoverProvider(ctx context.Context, provider *oidc.Provider, am *AuthMethod) (*gooidc.Provider, context.Context, error) {
+	const op = "oidc.discoverProvider"
+	providerCtx, err := provider.HTTPClientContext(ctx)
+	if err != nil {
+		return nil, nil, errors.New(ctx, errors.Unknown, op, "unable to get provider http client", errors.WithWrap(err))
+	}
+	discovered, err := providerCache().discover(ctx, provider, am)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	return discovered, providerCtx, nil
+}
+
+var (
+	// cachedDevicePolls tracks when the provider's token endpoint may next be
+	// polled for each in-flight device authorization grant. Like
+	// cachedProviders, it can't be done within the Repository, since a new
+	// Repository is created for every request.
+	cachedDevicePolls     *devicePolls
+	initCachedDevicePolls sync.Once
+)
+
+// devicePollCache returns the cache of device authorization grant polls
+func devicePollCache() *devicePolls {
+	initCachedDevicePolls.Do(func() {
+		cachedDevicePolls = &devicePolls{
+			polls: map[string]*devicePoll{},
+		}
+	})
+	return cachedDevicePolls
+}
+
+// devicePolls is a cache of device authorization grant polls, keyed by token
+// request id.
+type devicePolls struct {
+	mu    sync.Mutex
+	polls map[string]*devicePoll
+}
+
+// devicePoll is the state of the polling for a device authorization grant.
+type devicePoll struct {
+	interval       time.Duration
+	next           time.Time
+	expirationTime time.Time
+}
+
+// due reports whether the provider's token endpoint may be polled for the
+// token request. If it may, the next poll is scheduled an interval from now,
+// so concurrent callers don't poll the provider more than once. The interval
+// is only used the first time a token request is seen; afterwards the cached
+// interval, which slowDown may have grown, is used.
+func (c *devicePolls) due(requestId string, interval time.Duration, expirationTime time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for id, p := range c.polls {
+		if now.After(p.expirationTime) {
+			delete(c.polls, id)
+		}
+	}
+	p, ok := c.polls[requestId]
+	if !ok {
+		p = &devicePoll{interval: interval, expirationTime: expirationTime}
+		c.polls[requestId] = p
+	}
+	if now.Before(p.next) {
+		return false
+	}
+	p.next = now.Add(p.interval)
+	return true
+}
+
+// slowDown grows the interval between polls for the token request, as the
+// provider asked to be polled less often.
+func (c *devicePolls) slowDown(requestId string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if p, ok := c.polls[requestId]; ok {
+		p.interval += slowDownIncrement
+		p.next = time.Now().Add(p.interval)
+	}
+}
+
+// delete will delete the token request from the cache, once its device
+// authorization grant is done.
+func (c *devicePolls) delete(requestId string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.polls, requestId)
+}
+
+// deviceTokenResponse is the response of a provider's token endpoint to a
+// device access token request.
+//
+// See: https://datatracker.ietf.org/doc/html/rfc8628#section-3.5
+type deviceTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	IdToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// deviceAccessToken makes a single device access token request to the token
+// endpoint. Error responses, like authorization_pending, are returned in the
+// deviceTokenResponse.
+func deviceAccessToken(ctx context.Context, client *http.Client, tokenUrl, clientId, clientSecret, deviceCode string) (*deviceTokenResponse, error) {
+	const op = "oidc.deviceAccessToken"
+	v := url.Values{
+		"grant_type":  {deviceCodeGrantType},
+		"device_code": {deviceCode},
+		"client_id":   {clientId},
+	}
+	if clientSecret != "" {
+		v.Set("client_secret", clientSecret)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenUrl, strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to read response body: %w", op, err)
+	}
+	var tk deviceTokenResponse
+	if err := json.Unmarshal(body, &tk); err != nil {
+		return nil, fmt.Errorf("%s: unable to parse response (status %s): %w", op, resp.Status, err)
+	}
+	if tk.Error == "" && (resp.StatusCode < 200 || resp.StatusCode > 299) {
+		return nil, fmt.Errorf("%s: unexpected response status %s", op, resp.Status)
+	}
+	return &tk, nil
+}
+
+// audiencesAllowed reports whether any of the ID Token's audiences is allowed.
+func audiencesAllowed(allowed, audiences []string) bool {
+	for _, a := range audiences {
+		if strutil.StrListContains(allowed, a) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_deviceAccessToken(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		clientSecret string
+		status       int
+		resp         any
+		want         *deviceTokenResponse
+		wantErr      bool
+	}{
+		{
+			name:   "pending",
+			status: http.StatusBadRequest,
+			resp:   map[string]string{"error": "authorization_pending"},
+			want:   &deviceTokenResponse{Error: "authorization_pending"},
+		},
+		{
+			name:   "denied",
+			status: http.StatusBadRequest,
+			resp:   map[string]string{"error": "access_denied", "error_description": "denied by user"},
+			want:   &deviceTokenResponse{Error: "access_denied", ErrorDescription: "denied by user"},
+		},
+		{
+			name:         "success",
+			clientSecret: "fido",
+			status:       http.StatusOK,
+			resp:         map[string]string{"access_token": "at", "token_type": "Bearer", "id_token": "idt"},
+			want:         &deviceTokenResponse{AccessToken: "at", TokenType: "Bearer", IdToken: "idt"},
+		},
+		{
+			name:    "server-error",
+			status:  http.StatusInternalServerError,
+			resp:    map[string]string{},
+			wantErr: true,
+		},
+		{
+			name:    "not-json",
+			status:  http.StatusOK,
+			resp:    "not json",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.NoError(r.ParseForm())
+				assert.Equal(deviceCodeGrantType, r.PostForm.Get("grant_type"))
+				assert.Equal("device-code", r.PostForm.Get("device_code"))
+				assert.Equal("alice-rp", r.PostForm.Get("client_id"))
+				assert.Equal(tt.clientSecret, r.PostForm.Get("client_secret"))
+				w.WriteHeader(tt.status)
+				if s, ok := tt.resp.(string); ok {
+					_, _ = w.Write([]byte(s))
+					return
+				}
+				require.NoError(json.NewEncoder(w).Encode(tt.resp))
+			}))
+			defer srv.Close()
+
+			got, err := deviceAccessToken(ctx, srv.Client(), srv.URL, "alice-rp", tt.clientSecret, "device-code")
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
+func Test_PollDeviceAuth_Parameters(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	oidcRepoFn := func() (*Repository, error) { return nil, nil }
+	iamRepoFn := IamRepoFactory(nil)
+	atRepoFn := AuthTokenRepoFactory(nil)
+
+	err := PollDeviceAuth(ctx, nil, iamRepoFn, atRepoFn, "amoidc_1234567890", "token-request")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got %v", err)
+	assert.ErrorContains(t, err, "missing oidc repository function")
+
+	err = PollDeviceAuth(ctx, oidcRepoFn, iamRepoFn, atRepoFn, "amoidc_1234567890", "token-request")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got %v", err)
+	assert.ErrorContains(t, err, "missing iam repository function")
+}
+
+func Test_audiencesAllowed(t *testing.T) {
+	t.Parallel()
+	assert.True(t, audiencesAllowed([]string{"a", "b"}, []string{"c", "b"}))
+	assert.False(t, audiencesAllowed([]string{"a", "b"}, []string{"c"}))
+	assert.False(t, audiencesAllowed([]string{"a"}, nil))
+}
+
+func Test_devicePolls(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	c := &devicePolls{polls: map[string]*devicePoll{}}
+	exp := time.Now().Add(time.Hour)
+
+	assert.True(c.due("req", time.Second, exp), "first poll must be due")
+	assert.False(c.due("req", time.Second, exp), "poll within the interval must not be due")
+	assert.True(c.due("other", time.Second, exp), "polls are tracked per token request")
+
+	c.slowDown("req")
+	assert.Equal(time.Second+slowDownIncrement, c.polls["req"].interval)
+	c.polls["req"].next = time.Now().Add(-time.Millisecond)
+	assert.True(c.due("req", time.Second, exp))
+	assert.Equal(time.Second+slowDownIncrement, c.polls["req"].interval, "slowed down interval must be kept")
+
+	// expired token requests are swept
+	assert.True(c.due("expired", time.Second, time.Now().Add(-time.Second)))
+	c.due("req", time.Second, exp)
+	assert.NotContains(c.polls, "expired")
+
+	c.delete("req")
+	assert.NotContains(c.polls, "req")
+}
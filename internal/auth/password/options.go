@@ -3,7 +3,10 @@
 
 package password
 
-import "github.com/hashicorp/boundary/internal/pagination"
+import (
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/pagination"
+)
 
 // GetOpts - iterate the inbound Options and return a struct.
 func GetOpts(opt ...Option) options {
@@ -30,6 +33,8 @@ type options struct {
 	withOrderByCreateTime  bool
 	ascending              bool
 	withStartPageAfterItem pagination.Item
+	withReader             db.Reader
+	withWriter             db.Writer
 }
 
 func getDefaultOptions() options {
@@ -106,3 +111,13 @@ func WithStartPageAfterItem(item pagination.Item) Option {
 		o.withStartPageAfterItem = item
 	}
 }
+
+// WithReaderWriter allows the caller to pass an inflight transaction to be used
+// for the database writes of the operation. If WithReaderWriter(...) is used,
+// then the caller is responsible for managing the transaction.
+func WithReaderWriter(r db.Reader, w db.Writer) Option {
+	return func(o *options) {
+		o.withReader = r
+		o.withWriter = w
+	}
+}
@@ -6,6 +6,7 @@ package password
 import (
 	"testing"
 
+	"github.com/hashicorp/boundary/internal/db"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -68,4 +69,13 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.ascending = true
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithReaderWriter", func(t *testing.T) {
+		assert := assert.New(t)
+		rw := db.New(nil)
+		opts := GetOpts(WithReaderWriter(rw, rw))
+		testOpts := getDefaultOptions()
+		testOpts.withReader = rw
+		testOpts.withWriter = rw
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -8,7 +8,9 @@ const (
 select sum(reltuples::bigint) as estimate from pg_class where oid in (
     'auth_password_method'::regclass,
     'auth_ldap_method'::regclass,
-    'auth_oidc_method'::regclass
+    'auth_oidc_method'::regclass,
+    'auth_jwt_method'::regclass,
+    'auth_saml_method'::regclass
 )
 `
 
@@ -24,6 +26,14 @@ select public_id
 select public_id
   from auth_ldap_method_deleted
  where delete_time >= @since
+ union
+select public_id
+  from auth_jwt_method_deleted
+ where delete_time >= @since
+ union
+select public_id
+  from auth_saml_method_deleted
+ where delete_time >= @since
 `
 
 	listAuthMethodsTemplate = `
@@ -44,6 +54,16 @@ oidc as (
       from oidc_auth_method_with_value_obj
      where public_id in (select public_id from auth_methods)
 ),
+jwt as (
+    select *
+      from jwt_auth_method_with_value_obj
+     where public_id in (select public_id from auth_methods)
+),
+saml as (
+    select *
+      from saml_auth_method_with_is_primary
+     where public_id in (select public_id from auth_methods)
+),
 password as (
     select *
       from auth_password_method_with_is_primary
@@ -99,6 +119,18 @@ final as (
            null as claims_scopes,
            null as prompts,
            null as account_claim_maps,
+           null as jwks_url,
+           null as jwks_ca_certs,
+           null as public_keys,
+           null as bound_audiences,
+           null as signing_algorithms,
+           null as bound_claims,
+           null as subject_claim,
+           null as full_name_claim,
+           null as email_claim,
+           null as idp_metadata,
+           null as sp_entity_id,
+           null as account_attribute_maps,
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
@@ -154,12 +186,158 @@ final as (
            claims_scopes,
            prompts,
            account_claim_maps,
+           null as jwks_url,
+           null as jwks_ca_certs,
+           null as public_keys,
+           null as bound_audiences,
+           null as signing_algorithms,
+           null as bound_claims,
+           null as subject_claim,
+           null as full_name_claim,
+           null as email_claim,
+           null as idp_metadata,
+           null as sp_entity_id,
+           null as account_attribute_maps,
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
            'oidc' as subtype
       from oidc
      union
+    select public_id,
+           scope_id,
+           is_primary_auth_method,
+           name,
+           description,
+           create_time,
+           update_time,
+           version,
+           state,
+           null as start_tls,                    -- Add to make union uniform
+           null as insecure_tls,
+           null as discover_dn,
+           null as anon_group_search,
+           null as upn_domain,
+           null as enable_groups,
+           null as use_token_groups,
+           null as maximum_page_size,
+           null as urls,
+           null as certs,
+           null as account_attribute_map,
+           null as user_dn,
+           null as user_attr,
+           null as user_filter,
+           null as group_dn,
+           null as group_attr,
+           null as group_filter,
+           null as client_certificate_key,
+           null as client_certificate_key_hmac,
+           null as client_certificate_key_id,
+           null as client_certificate_cert,
+           null as bind_dn,
+           null as bind_password,
+           null as bind_password_hmac,
+           null as bind_password_key_id,
+           null as dereference_aliases,
+           null as disable_discovered_config_validation,
+           null as api_url,
+           issuer,
+           null as client_id,
+           null as client_secret,
+           null as client_secret_hmac,
+           null as key_id,
+           null as max_age,
+           null as algs,
+           null as auds,
+           null as certs,
+           null as claims_scopes,
+           null as prompts,
+           null as account_claim_maps,
+           jwks_url,
+           jwks_ca_certs,
+           public_keys,
+           bound_audiences,
+           signing_algorithms,
+           bound_claims::text,
+           subject_claim,
+           full_name_claim,
+           email_claim,
+           null as idp_metadata,
+           null as sp_entity_id,
+           null as account_attribute_maps,
+           null as password_conf_id,
+           null::integer as min_login_name_length,
+           null::integer as min_password_length,
+           'jwt' as subtype
+      from jwt
+     union
+    select public_id,
+           scope_id,
+           is_primary_auth_method,
+           name,
+           description,
+           create_time,
+           update_time,
+           version,
+           state,
+           null as start_tls,                    -- Add to make union uniform
+           null as insecure_tls,
+           null as discover_dn,
+           null as anon_group_search,
+           null as upn_domain,
+           null as enable_groups,
+           null as use_token_groups,
+           null as maximum_page_size,
+           null as urls,
+           null as certs,
+           null as account_attribute_map,
+           null as user_dn,
+           null as user_attr,
+           null as user_filter,
+           null as group_dn,
+           null as group_attr,
+           null as group_filter,
+           null as client_certificate_key,
+           null as client_certificate_key_hmac,
+           null as client_certificate_key_id,
+           null as client_certificate_cert,
+           null as bind_dn,
+           null as bind_password,
+           null as bind_password_hmac,
+           null as bind_password_key_id,
+           null as dereference_aliases,
+           null as disable_discovered_config_validation,
+           api_url,
+           null as issuer,
+           null as client_id,
+           null as client_secret,
+           null as client_secret_hmac,
+           null as key_id,
+           null as max_age,
+           null as algs,
+           null as auds,
+           null as certs,
+           null as claims_scopes,
+           null as prompts,
+           null as account_claim_maps,
+           null as jwks_url,
+           null as jwks_ca_certs,
+           null as public_keys,
+           null as bound_audiences,
+           null as signing_algorithms,
+           null as bound_claims,
+           null as subject_claim,
+           null as full_name_claim,
+           null as email_claim,
+           idp_metadata,
+           sp_entity_id,
+           account_attribute_maps::text,
+           null as password_conf_id,
+           null::integer as min_login_name_length,
+           null::integer as min_password_length,
+           'saml' as subtype
+      from saml
+     union
     select public_id,
            scope_id,
            is_primary_auth_method,
@@ -209,6 +387,18 @@ final as (
            null as claims_scopes,
            null as prompts,
            null as account_claim_maps,
+           null as jwks_url,
+           null as jwks_ca_certs,
+           null as public_keys,
+           null as bound_audiences,
+           null as signing_algorithms,
+           null as bound_claims,
+           null as subject_claim,
+           null as full_name_claim,
+           null as email_claim,
+           null as idp_metadata,
+           null as sp_entity_id,
+           null as account_attribute_maps,
            password_conf_id,
            min_login_name_length,
            min_password_length,
@@ -239,6 +429,16 @@ oidc as (
       from oidc_auth_method_with_value_obj
      where public_id in (select public_id from auth_methods)
 ),
+jwt as (
+    select *
+      from jwt_auth_method_with_value_obj
+     where public_id in (select public_id from auth_methods)
+),
+saml as (
+    select *
+      from saml_auth_method_with_is_primary
+     where public_id in (select public_id from auth_methods)
+),
 password as (
     select *
       from auth_password_method_with_is_primary
@@ -294,6 +494,18 @@ final as (
            null as claims_scopes,
            null as prompts,
            null as account_claim_maps,
+           null as jwks_url,
+           null as jwks_ca_certs,
+           null as public_keys,
+           null as bound_audiences,
+           null as signing_algorithms,
+           null as bound_claims,
+           null as subject_claim,
+           null as full_name_claim,
+           null as email_claim,
+           null as idp_metadata,
+           null as sp_entity_id,
+           null as account_attribute_maps,
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
@@ -349,12 +561,158 @@ final as (
            claims_scopes,
            prompts,
            account_claim_maps,
+           null as jwks_url,
+           null as jwks_ca_certs,
+           null as public_keys,
+           null as bound_audiences,
+           null as signing_algorithms,
+           null as bound_claims,
+           null as subject_claim,
+           null as full_name_claim,
+           null as email_claim,
+           null as idp_metadata,
+           null as sp_entity_id,
+           null as account_attribute_maps,
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
            'oidc' as subtype
       from oidc
      union
+    select public_id,
+           scope_id,
+           is_primary_auth_method,
+           name,
+           description,
+           create_time,
+           update_time,
+           version,
+           state,
+           null as start_tls,                    -- Add to make union uniform
+           null as insecure_tls,
+           null as discover_dn,
+           null as anon_group_search,
+           null as upn_domain,
+           null as enable_groups,
+           null as use_token_groups,
+           null as maximum_page_size,
+           null as urls,
+           null as certs,
+           null as account_attribute_map,
+           null as user_dn,
+           null as user_attr,
+           null as user_filter,
+           null as group_dn,
+           null as group_attr,
+           null as group_filter,
+           null as client_certificate_key,
+           null as client_certificate_key_hmac,
+           null as client_certificate_key_id,
+           null as client_certificate_cert,
+           null as bind_dn,
+           null as bind_password,
+           null as bind_password_hmac,
+           null as bind_password_key_id,
+           null as dereference_aliases,
+           null as disable_discovered_config_validation,
+           null as api_url,
+           issuer,
+           null as client_id,
+           null as client_secret,
+           null as client_secret_hmac,
+           null as key_id,
+           null as max_age,
+           null as algs,
+           null as auds,
+           null as certs,
+           null as claims_scopes,
+           null as prompts,
+           null as account_claim_maps,
+           jwks_url,
+           jwks_ca_certs,
+           public_keys,
+           bound_audiences,
+           signing_algorithms,
+           bound_claims::text,
+           subject_claim,
+           full_name_claim,
+           email_claim,
+           null as idp_metadata,
+           null as sp_entity_id,
+           null as account_attribute_maps,
+           null as password_conf_id,
+           null::integer as min_login_name_length,
+           null::integer as min_password_length,
+           'jwt' as subtype
+      from jwt
+     union
+    select public_id,
+           scope_id,
+           is_primary_auth_method,
+           name,
+           description,
+           create_time,
+           update_time,
+           version,
+           state,
+           null as start_tls,                    -- Add to make union uniform
+           null as insecure_tls,
+           null as discover_dn,
+           null as anon_group_search,
+           null as upn_domain,
+           null as enable_groups,
+           null as use_token_groups,
+           null as maximum_page_size,
+           null as urls,
+           null as certs,
+           null as account_attribute_map,
+           null as user_dn,
+           null as user_attr,
+           null as user_filter,
+           null as group_dn,
+           null as group_attr,
+           null as group_filter,
+           null as client_certificate_key,
+           null as client_certificate_key_hmac,
+           null as client_certificate_key_id,
+           null as client_certificate_cert,
+           null as bind_dn,
+           null as bind_password,
+           null as bind_password_hmac,
+           null as bind_password_key_id,
+           null as dereference_aliases,
+           null as disable_discovered_config_validation,
+           api_url,
+           null as issuer,
+           null as client_id,
+           null as client_secret,
+           null as client_secret_hmac,
+           null as key_id,
+           null as max_age,
+           null as algs,
+           null as auds,
+           null as certs,
+           null as claims_scopes,
+           null as prompts,
+           null as account_claim_maps,
+           null as jwks_url,
+           null as jwks_ca_certs,
+           null as public_keys,
+           null as bound_audiences,
+           null as signing_algorithms,
+           null as bound_claims,
+           null as subject_claim,
+           null as full_name_claim,
+           null as email_claim,
+           idp_metadata,
+           sp_entity_id,
+           account_attribute_maps::text,
+           null as password_conf_id,
+           null::integer as min_login_name_length,
+           null::integer as min_password_length,
+           'saml' as subtype
+      from saml
+     union
     select public_id,
            scope_id,
            is_primary_auth_method,
@@ -404,6 +762,18 @@ final as (
            null as claims_scopes,
            null as prompts,
            null as account_claim_maps,
+           null as jwks_url,
+           null as jwks_ca_certs,
+           null as public_keys,
+           null as bound_audiences,
+           null as signing_algorithms,
+           null as bound_claims,
+           null as subject_claim,
+           null as full_name_claim,
+           null as email_claim,
+           null as idp_metadata,
+           null as sp_entity_id,
+           null as account_attribute_maps,
            password_conf_id,
            min_login_name_length,
            min_password_length,
@@ -434,6 +804,16 @@ oidc as (
       from oidc_auth_method_with_value_obj
      where public_id in (select public_id from auth_methods)
 ),
+jwt as (
+    select *
+      from jwt_auth_method_with_value_obj
+     where public_id in (select public_id from auth_methods)
+),
+saml as (
+    select *
+      from saml_auth_method_with_is_primary
+     where public_id in (select public_id from auth_methods)
+),
 password as (
     select *
       from auth_password_method_with_is_primary
@@ -489,6 +869,18 @@ final as (
            null as claims_scopes,
            null as prompts,
            null as account_claim_maps,
+           null as jwks_url,
+           null as jwks_ca_certs,
+           null as public_keys,
+           null as bound_audiences,
+           null as signing_algorithms,
+           null as bound_claims,
+           null as subject_claim,
+           null as full_name_claim,
+           null as email_claim,
+           null as idp_metadata,
+           null as sp_entity_id,
+           null as account_attribute_maps,
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
@@ -544,12 +936,158 @@ final as (
            claims_scopes,
            prompts,
            account_claim_maps,
+           null as jwks_url,
+           null as jwks_ca_certs,
+           null as public_keys,
+           null as bound_audiences,
+           null as signing_algorithms,
+           null as bound_claims,
+           null as subject_claim,
+           null as full_name_claim,
+           null as email_claim,
+           null as idp_metadata,
+           null as sp_entity_id,
+           null as account_attribute_maps,
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
            'oidc' as subtype
       from oidc
      union
+    select public_id,
+           scope_id,
+           is_primary_auth_method,
+           name,
+           description,
+           create_time,
+           update_time,
+           version,
+           state,
+           null as start_tls,                    -- Add to make union uniform
+           null as insecure_tls,
+           null as discover_dn,
+           null as anon_group_search,
+           null as upn_domain,
+           null as enable_groups,
+           null as use_token_groups,
+           null as maximum_page_size,
+           null as urls,
+           null as certs,
+           null as account_attribute_map,
+           null as user_dn,
+           null as user_attr,
+           null as user_filter,
+           null as group_dn,
+           null as group_attr,
+           null as group_filter,
+           null as client_certificate_key,
+           null as client_certificate_key_hmac,
+           null as client_certificate_key_id,
+           null as client_certificate_cert,
+           null as bind_dn,
+           null as bind_password,
+           null as bind_password_hmac,
+           null as bind_password_key_id,
+           null as dereference_aliases,
+           null as disable_discovered_config_validation,
+           null as api_url,
+           issuer,
+           null as client_id,
+           null as client_secret,
+           null as client_secret_hmac,
+           null as key_id,
+           null as max_age,
+           null as algs,
+           null as auds,
+           null as certs,
+           null as claims_scopes,
+           null as prompts,
+           null as account_claim_maps,
+           jwks_url,
+           jwks_ca_certs,
+           public_keys,
+           bound_audiences,
+           signing_algorithms,
+           bound_claims::text,
+           subject_claim,
+           full_name_claim,
+           email_claim,
+           null as idp_metadata,
+           null as sp_entity_id,
+           null as account_attribute_maps,
+           null as password_conf_id,
+           null::integer as min_login_name_length,
+           null::integer as min_password_length,
+           'jwt' as subtype
+      from jwt
+     union
+    select public_id,
+           scope_id,
+           is_primary_auth_method,
+           name,
+           description,
+           create_time,
+           update_time,
+           version,
+           state,
+           null as start_tls,                    -- Add to make union uniform
+           null as insecure_tls,
+           null as discover_dn,
+           null as anon_group_search,
+           null as upn_domain,
+           null as enable_groups,
+           null as use_token_groups,
+           null as maximum_page_size,
+           null as urls,
+           null as certs,
+           null as account_attribute_map,
+           null as user_dn,
+           null as user_attr,
+           null as user_filter,
+           null as group_dn,
+           null as group_attr,
+           null as group_filter,
+           null as client_certificate_key,
+           null as client_certificate_key_hmac,
+           null as client_certificate_key_id,
+           null as client_certificate_cert,
+           null as bind_dn,
+           null as bind_password,
+           null as bind_password_hmac,
+           null as bind_password_key_id,
+           null as dereference_aliases,
+           null as disable_discovered_config_validation,
+           api_url,
+           null as issuer,
+           null as client_id,
+           null as client_secret,
+           null as client_secret_hmac,
+           null as key_id,
+           null as max_age,
+           null as algs,
+           null as auds,
+           null as certs,
+           null as claims_scopes,
+           null as prompts,
+           null as account_claim_maps,
+           null as jwks_url,
+           null as jwks_ca_certs,
+           null as public_keys,
+           null as bound_audiences,
+           null as signing_algorithms,
+           null as bound_claims,
+           null as subject_claim,
+           null as full_name_claim,
+           null as email_claim,
+           idp_metadata,
+           sp_entity_id,
+           account_attribute_maps::text,
+           null as password_conf_id,
+           null::integer as min_login_name_length,
+           null::integer as min_password_length,
+           'saml' as subtype
+      from saml
+     union
     select public_id,
            scope_id,
            is_primary_auth_method,
@@ -599,6 +1137,18 @@ final as (
            null as claims_scopes,
            null as prompts,
            null as account_claim_maps,
+           null as jwks_url,
+           null as jwks_ca_certs,
+           null as public_keys,
+           null as bound_audiences,
+           null as signing_algorithms,
+           null as bound_claims,
+           null as subject_claim,
+           null as full_name_claim,
+           null as email_claim,
+           null as idp_metadata,
+           null as sp_entity_id,
+           null as account_attribute_maps,
            password_conf_id,
            min_login_name_length,
            min_password_length,
@@ -630,6 +1180,16 @@ oidc as (
       from oidc_auth_method_with_value_obj
      where public_id in (select public_id from auth_methods)
 ),
+jwt as (
+    select *
+      from jwt_auth_method_with_value_obj
+     where public_id in (select public_id from auth_methods)
+),
+saml as (
+    select *
+      from saml_auth_method_with_is_primary
+     where public_id in (select public_id from auth_methods)
+),
 password as (
     select *
       from auth_password_method_with_is_primary
@@ -685,6 +1245,18 @@ final as (
            null as claims_scopes,
            null as prompts,
            null as account_claim_maps,
+           null as jwks_url,
+           null as jwks_ca_certs,
+           null as public_keys,
+           null as bound_audiences,
+           null as signing_algorithms,
+           null as bound_claims,
+           null as subject_claim,
+           null as full_name_claim,
+           null as email_claim,
+           null as idp_metadata,
+           null as sp_entity_id,
+           null as account_attribute_maps,
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
@@ -740,12 +1312,158 @@ final as (
            claims_scopes,
            prompts,
            account_claim_maps,
+           null as jwks_url,
+           null as jwks_ca_certs,
+           null as public_keys,
+           null as bound_audiences,
+           null as signing_algorithms,
+           null as bound_claims,
+           null as subject_claim,
+           null as full_name_claim,
+           null as email_claim,
+           null as idp_metadata,
+           null as sp_entity_id,
+           null as account_attribute_maps,
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
            'oidc' as subtype
       from oidc
      union
+    select public_id,
+           scope_id,
+           is_primary_auth_method,
+           name,
+           description,
+           create_time,
+           update_time,
+           version,
+           state,
+           null as start_tls,                    -- Add to make union uniform
+           null as insecure_tls,
+           null as discover_dn,
+           null as anon_group_search,
+           null as upn_domain,
+           null as enable_groups,
+           null as use_token_groups,
+           null as maximum_page_size,
+           null as urls,
+           null as certs,
+           null as account_attribute_map,
+           null as user_dn,
+           null as user_attr,
+           null as user_filter,
+           null as group_dn,
+           null as group_attr,
+           null as group_filter,
+           null as client_certificate_key,
+           null as client_certificate_key_hmac,
+           null as client_certificate_key_id,
+           null as client_certificate_cert,
+           null as bind_dn,
+           null as bind_password,
+           null as bind_password_hmac,
+           null as bind_password_key_id,
+           null as dereference_aliases,
+           null as disable_discovered_config_validation,
+           null as api_url,
+           issuer,
+           null as client_id,
+           null as client_secret,
+           null as client_secret_hmac,
+           null as key_id,
+           null as max_age,
+           null as algs,
+           null as auds,
+           null as certs,
+           null as claims_scopes,
+           null as prompts,
+           null as account_claim_maps,
+           jwks_url,
+           jwks_ca_certs,
+           public_keys,
+           bound_audiences,
+           signing_algorithms,
+           bound_claims::text,
+           subject_claim,
+           full_name_claim,
+           email_claim,
+           null as idp_metadata,
+           null as sp_entity_id,
+           null as account_attribute_maps,
+           null as password_conf_id,
+           null::integer as min_login_name_length,
+           null::integer as min_password_length,
+           'jwt' as subtype
+      from jwt
+     union
+    select public_id,
+           scope_id,
+           is_primary_auth_method,
+           name,
+           description,
+           create_time,
+           update_time,
+           version,
+           state,
+           null as start_tls,                    -- Add to make union uniform
+           null as insecure_tls,
+           null as discover_dn,
+           null as anon_group_search,
+           null as upn_domain,
+           null as enable_groups,
+           null as use_token_groups,
+           null as maximum_page_size,
+           null as urls,
+           null as certs,
+           null as account_attribute_map,
+           null as user_dn,
+           null as user_attr,
+           null as user_filter,
+           null as group_dn,
+           null as group_attr,
+           null as group_filter,
+           null as client_certificate_key,
+           null as client_certificate_key_hmac,
+           null as client_certificate_key_id,
+           null as client_certificate_cert,
+           null as bind_dn,
+           null as bind_password,
+           null as bind_password_hmac,
+           null as bind_password_key_id,
+           null as dereference_aliases,
+           null as disable_discovered_config_validation,
+           api_url,
+           null as issuer,
+           null as client_id,
+           null as client_secret,
+           null as client_secret_hmac,
+           null as key_id,
+           null as max_age,
+           null as algs,
+           null as auds,
+           null as certs,
+           null as claims_scopes,
+           null as prompts,
+           null as account_claim_maps,
+           null as jwks_url,
+           null as jwks_ca_certs,
+           null as public_keys,
+           null as bound_audiences,
+           null as signing_algorithms,
+           null as bound_claims,
+           null as subject_claim,
+           null as full_name_claim,
+           null as email_claim,
+           idp_metadata,
+           sp_entity_id,
+           account_attribute_maps::text,
+           null as password_conf_id,
+           null::integer as min_login_name_length,
+           null::integer as min_password_length,
+           'saml' as subtype
+      from saml
+     union
     select public_id,
            scope_id,
            is_primary_auth_method,
@@ -795,6 +1513,18 @@ final as (
            null as claims_scopes,
            null as prompts,
            null as account_claim_maps,
+           null as jwks_url,
+           null as jwks_ca_certs,
+           null as public_keys,
+           null as bound_audiences,
+           null as signing_algorithms,
+           null as bound_claims,
+           null as subject_claim,
+           null as full_name_claim,
+           null as email_claim,
+           null as idp_metadata,
+           null as sp_entity_id,
+           null as account_attribute_maps,
            password_conf_id,
            min_login_name_length,
            min_password_length,
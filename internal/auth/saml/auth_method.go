@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/saml/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	kvbuilder "github.com/hashicorp/go-secure-stdlib/kv-builder"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultAuthMethodTableName defines the default table name for an AuthMethod
+const defaultAuthMethodTableName = "auth_saml_method"
+
+// AccountToAttribute defines the account fields an assertion attribute can be
+// mapped to.
+type AccountToAttribute string
+
+const (
+	ToSubAttribute   AccountToAttribute = "sub"
+	ToEmailAttribute AccountToAttribute = "email"
+	ToNameAttribute  AccountToAttribute = "name"
+)
+
+// ConvertToAccountToAttribute converts s to an AccountToAttribute, returning
+// an error if it isn't a valid account field.
+func ConvertToAccountToAttribute(ctx context.Context, s string) (AccountToAttribute, error) {
+	const op = "saml.ConvertToAccountToAttribute"
+	switch s {
+	case string(ToSubAttribute):
+		return ToSubAttribute, nil
+	case string(ToEmailAttribute):
+		return ToEmailAttribute, nil
+	case string(ToNameAttribute):
+		return ToNameAttribute, nil
+	default:
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is not a valid ToAccountAttribute value", s))
+	}
+}
+
+// AttributeMap maps the assertion attribute From to the account field To.
+type AttributeMap struct {
+	To   string
+	From string
+}
+
+// ParseAccountAttributeMaps will parse the inbound attribute maps, which are
+// formatted as "from=to".
+func ParseAccountAttributeMaps(ctx context.Context, m ...string) ([]AttributeMap, error) {
+	const op = "saml.ParseAccountAttributeMaps"
+	var b kvbuilder.Builder
+	if err := b.Add(m...); err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "error parsing map", errors.WithWrap(err))
+	}
+	fromKeys := make([]string, 0, len(m))
+	for k := range b.Map() {
+		fromKeys = append(fromKeys, k)
+	}
+	sort.Strings(fromKeys)
+
+	attributeMap := make([]AttributeMap, 0, len(fromKeys))
+	for _, from := range fromKeys {
+		to, ok := b.Map()[from].(string)
+		if !ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("account attribute map %s value %q is not a string", from, b.Map()[from]))
+		}
+		attributeMap = append(attributeMap, AttributeMap{
+			To:   to,
+			From: from,
+		})
+	}
+	return attributeMap, nil
+}
+
+// AuthMethod contains a SAML auth method configuration. It is owned by a
+// scope. A SAML auth method authenticates users with SP-initiated logins at
+// the identity provider described by its metadata, which posts a signed SAML
+// response to the controller's assertion consumer service (ACS) endpoint.
+//
+// The account attribute maps are stored marshaled as JSON, since they are only
+// ever read and written along with the auth method itself.
+type AuthMethod struct {
+	*store.AuthMethod
+	tableName string
+}
+
+// NewAuthMethod creates a new in memory AuthMethod assigned to scopeId.
+//
+// apiUrl is the URL prefix of the controller's API, which the ACS URL is built
+// from. idpMetadata is the IdP's SAML metadata XML document.
+//
+// Supports the options of WithName, WithDescription, WithOperationalState,
+// WithSpEntityId and WithAccountAttributeMap and all other options are ignored.
+func NewAuthMethod(ctx context.Context, scopeId string, apiUrl *url.URL, idpMetadata string, opt ...Option) (*AuthMethod, error) {
+	const op = "saml.NewAuthMethod"
+	opts := getOpts(opt...)
+
+	a := &AuthMethod{
+		AuthMethod: &store.AuthMethod{
+			ScopeId:          scopeId,
+			Name:             opts.withName,
+			Description:      opts.withDescription,
+			OperationalState: string(opts.withOperationalState),
+			IdpMetadata:      idpMetadata,
+			SpEntityId:       opts.withSpEntityId,
+		},
+	}
+	if apiUrl != nil {
+		a.ApiUrl = strings.TrimSuffix(apiUrl.String(), "/")
+	}
+	if len(opts.withAccountAttributeMap) > 0 {
+		b, err := json.Marshal(opts.withAccountAttributeMap)
+		if err != nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to marshal account attribute maps", errors.WithWrap(err))
+		}
+		a.AccountAttributeMaps = string(b)
+	}
+	if err := a.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+	return a, nil
+}
+
+// validate the AuthMethod. On success, it will return nil.
+func (a *AuthMethod) validate(ctx context.Context, caller errors.Op) error {
+	if a.ScopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing scope id")
+	}
+	if !validState(a.OperationalState) {
+		return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("invalid state: %s", a.OperationalState))
+	}
+	if a.ApiUrl == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing api url")
+	}
+	u, err := url.Parse(a.ApiUrl)
+	if err != nil {
+		return errors.New(ctx, errors.InvalidParameter, caller, "not a valid api url", errors.WithWrap(err))
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("api url scheme must be either http or https, not %q", u.Scheme))
+	}
+	if a.IdpMetadata == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing idp metadata")
+	}
+	if _, err := ParseIdpMetadata(a.IdpMetadata); err != nil {
+		return errors.New(ctx, errors.InvalidParameter, caller, "invalid idp metadata", errors.WithWrap(err))
+	}
+	m, err := a.AccountAttributeMap()
+	if err != nil {
+		return errors.Wrap(ctx, err, caller, errors.WithCode(errors.InvalidParameter))
+	}
+	foundTo := make(map[AccountToAttribute]bool, len(m))
+	for from, to := range m {
+		if strings.TrimSpace(from) == "" {
+			return errors.New(ctx, errors.InvalidParameter, caller, "account attribute map names must not be empty")
+		}
+		if _, err := ConvertToAccountToAttribute(ctx, string(to)); err != nil {
+			return errors.Wrap(ctx, err, caller)
+		}
+		if foundTo[to] {
+			return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("%s account field is mapped more than once", to))
+		}
+		foundTo[to] = true
+	}
+	return nil
+}
+
+// AcsUrl returns the URL of the assertion consumer service the IdP posts SAML
+// responses for the auth method to.
+func (a *AuthMethod) AcsUrl() string {
+	return fmt.Sprintf(AcsEndpoint, a.GetApiUrl(), a.GetPublicId())
+}
+
+// EffectiveSpEntityId returns the entity id of Boundary as a service
+// provider, which defaults to the ACS URL.
+func (a *AuthMethod) EffectiveSpEntityId() string {
+	if a.GetSpEntityId() != "" {
+		return a.GetSpEntityId()
+	}
+	return a.AcsUrl()
+}
+
+// AccountAttributeMap returns the account attribute maps, keyed by the
+// assertion attribute the account field is set from.
+func (a *AuthMethod) AccountAttributeMap() (map[string]AccountToAttribute, error) {
+	if a.GetAccountAttributeMaps() == "" {
+		return nil, nil
+	}
+	var m map[string]AccountToAttribute
+	if err := json.Unmarshal([]byte(a.GetAccountAttributeMaps()), &m); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal account attribute maps: %w", err)
+	}
+	return m, nil
+}
+
+// AllocAuthMethod makes an empty one in memory
+func AllocAuthMethod() AuthMethod {
+	return AuthMethod{
+		AuthMethod: &store.AuthMethod{},
+	}
+}
+
+// Clone an AuthMethod.
+func (a *AuthMethod) Clone() *AuthMethod {
+	cp := proto.Clone(a.AuthMethod)
+	return &AuthMethod{
+		AuthMethod: cp.(*store.AuthMethod),
+	}
+}
+
+// TableName returns the table name.
+func (a *AuthMethod) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return defaultAuthMethodTableName
+}
+
+// SetTableName sets the table name.
+func (a *AuthMethod) SetTableName(n string) {
+	a.tableName = n
+}
+
+// GetResourceType returns the resource type of the AuthMethod
+func (a *AuthMethod) GetResourceType() resource.Type {
+	return resource.AuthMethod
+}
+
+// oplog will create oplog metadata for the AuthMethod.
+func (a *AuthMethod) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{a.GetPublicId()},
+		"resource-type":      []string{"saml auth method"},
+		"op-type":            []string{op.String()},
+		"scope-id":           []string{a.ScopeId},
+	}
+	return metadata
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuthMethod(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	testIdp := NewTestIdp(t)
+	testUrl, err := url.Parse("https://boundary.example.com/")
+	require.NoError(t, err)
+	ftpUrl, err := url.Parse("ftp://boundary.example.com")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		scopeId         string
+		apiUrl          *url.URL
+		idpMetadata     string
+		opts            []Option
+		wantErrMatch    *errors.Template
+		wantErrContains string
+	}{
+		{
+			name:        "valid",
+			scopeId:     "o_1234567890",
+			apiUrl:      testUrl,
+			idpMetadata: testIdp.Metadata(),
+			opts: []Option{
+				WithSpEntityId("https://boundary.example.com/sp"),
+				WithAccountAttributeMap(map[string]AccountToAttribute{
+					"mail":        ToEmailAttribute,
+					"displayName": ToNameAttribute,
+				}),
+			},
+		},
+		{
+			name:            "missing-scope",
+			apiUrl:          testUrl,
+			idpMetadata:     testIdp.Metadata(),
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing scope id",
+		},
+		{
+			name:            "missing-api-url",
+			scopeId:         "o_1234567890",
+			idpMetadata:     testIdp.Metadata(),
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing api url",
+		},
+		{
+			name:            "invalid-api-url-scheme",
+			scopeId:         "o_1234567890",
+			apiUrl:          ftpUrl,
+			idpMetadata:     testIdp.Metadata(),
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "api url scheme must be either http or https",
+		},
+		{
+			name:            "missing-idp-metadata",
+			scopeId:         "o_1234567890",
+			apiUrl:          testUrl,
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing idp metadata",
+		},
+		{
+			name:            "invalid-idp-metadata",
+			scopeId:         "o_1234567890",
+			apiUrl:          testUrl,
+			idpMetadata:     "<md:EntityDescriptor/>",
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "invalid idp metadata",
+		},
+		{
+			name:        "invalid-account-attribute-map",
+			scopeId:     "o_1234567890",
+			apiUrl:      testUrl,
+			idpMetadata: testIdp.Metadata(),
+			opts: []Option{
+				WithAccountAttributeMap(map[string]AccountToAttribute{"uid": "login"}),
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "login is not a valid ToAccountAttribute value",
+		},
+		{
+			name:        "duplicate-account-attribute-map",
+			scopeId:     "o_1234567890",
+			apiUrl:      testUrl,
+			idpMetadata: testIdp.Metadata(),
+			opts: []Option{
+				WithAccountAttributeMap(map[string]AccountToAttribute{
+					"mail":  ToEmailAttribute,
+					"email": ToEmailAttribute,
+				}),
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "email account field is mapped more than once",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewAuthMethod(testCtx, tt.scopeId, tt.apiUrl, tt.idpMetadata, tt.opts...)
+			if tt.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tt.wantErrMatch, err), "want err code: %q got: %q", tt.wantErrMatch.Code, err)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(string(InactiveState), got.OperationalState)
+			assert.Equal("https://boundary.example.com", got.ApiUrl)
+			got.PublicId = "amsaml_1234567890"
+			assert.Equal("https://boundary.example.com/v1/auth-methods/amsaml_1234567890:authenticate:callback", got.AcsUrl())
+			assert.Equal("https://boundary.example.com/sp", got.EffectiveSpEntityId())
+			m, err := got.AccountAttributeMap()
+			require.NoError(err)
+			assert.Equal(map[string]AccountToAttribute{"mail": ToEmailAttribute, "displayName": ToNameAttribute}, m)
+		})
+	}
+}
+
+func TestParseAccountAttributeMaps(t *testing.T) {
+	t.Parallel()
+	got, err := ParseAccountAttributeMaps(context.Background(), "mail=email", "displayName=name")
+	require.NoError(t, err)
+	assert.Equal(t, []AttributeMap{
+		{From: "displayName", To: "name"},
+		{From: "mail", To: "email"},
+	}, got)
+
+	_, err = ParseAccountAttributeMaps(context.Background(), "mail")
+	require.Error(t, err)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/go-secure-stdlib/base62"
+)
+
+// authnRequest is a SAML AuthnRequest sent to the IdP using the HTTP-Redirect
+// binding.
+type authnRequest struct {
+	XMLName                     xml.Name     `xml:"urn:oasis:names:tc:SAML:2.0:protocol AuthnRequest"`
+	Id                          string       `xml:"ID,attr"`
+	Version                     string       `xml:"Version,attr"`
+	IssueInstant                string       `xml:"IssueInstant,attr"`
+	Destination                 string       `xml:"Destination,attr"`
+	AssertionConsumerServiceUrl string       `xml:"AssertionConsumerServiceURL,attr"`
+	ProtocolBinding             string       `xml:"ProtocolBinding,attr"`
+	Issuer                      issuer       `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	NameIdPolicy                nameIdPolicy `xml:"urn:oasis:names:tc:SAML:2.0:protocol NameIDPolicy"`
+}
+
+type issuer struct {
+	Value string `xml:",chardata"`
+}
+
+type nameIdPolicy struct {
+	AllowCreate bool `xml:"AllowCreate,attr"`
+}
+
+// newAuthnRequestId returns a new random AuthnRequest id. Ids must be valid
+// xml NCNames, so they can't start with a digit.
+func newAuthnRequestId() (string, error) {
+	id, err := base62.Random(32)
+	if err != nil {
+		return "", err
+	}
+	return "_" + id, nil
+}
+
+// authnRequestUrl returns the URL the user agent is sent to in order to
+// authenticate with the IdP, with the encoded AuthnRequest and relayState as
+// query parameters.
+func authnRequestUrl(md *IdpMetadata, id, spEntityId, acsUrl, relayState string, now time.Time) (string, error) {
+	req := authnRequest{
+		Id:                          id,
+		Version:                     "2.0",
+		IssueInstant:                now.UTC().Format(time.RFC3339),
+		Destination:                 md.SsoUrl,
+		AssertionConsumerServiceUrl: acsUrl,
+		ProtocolBinding:             httpPostBinding,
+		Issuer:                      issuer{Value: spEntityId},
+		NameIdPolicy:                nameIdPolicy{AllowCreate: true},
+	}
+	raw, err := xml.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal authn request: %w", err)
+	}
+
+	// The HTTP-Redirect binding deflates the request before base64 encoding
+	// it.
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestCompression)
+	if err != nil {
+		return "", fmt.Errorf("unable to compress authn request: %w", err)
+	}
+	if _, err := w.Write(raw); err != nil {
+		return "", fmt.Errorf("unable to compress authn request: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("unable to compress authn request: %w", err)
+	}
+
+	u, err := url.Parse(md.SsoUrl)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse idp single sign-on service url: %w", err)
+	}
+	q := u.Query()
+	q.Set("SAMLRequest", base64.StdEncoding.EncodeToString(buf.Bytes()))
+	q.Set("RelayState", relayState)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
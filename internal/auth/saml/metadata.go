@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net/url"
+)
+
+const (
+	metadataNamespace  = "urn:oasis:names:tc:SAML:2.0:metadata"
+	assertionNamespace = "urn:oasis:names:tc:SAML:2.0:assertion"
+	protocolNamespace  = "urn:oasis:names:tc:SAML:2.0:protocol"
+
+	httpRedirectBinding = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	httpPostBinding     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+)
+
+// IdpMetadata is the configuration of a SAML identity provider read from its
+// metadata document.
+type IdpMetadata struct {
+	// EntityId is the IdP's entity id, which the issuer of its assertions must
+	// match.
+	EntityId string
+	// SsoUrl is the IdP's single sign-on service URL for the HTTP-Redirect
+	// binding, which AuthnRequests are sent to.
+	SsoUrl string
+	// Certificates are the IdP's signing certificates, which SAML responses
+	// are verified against.
+	Certificates []*x509.Certificate
+}
+
+// ParseIdpMetadata parses the metadata document of a SAML identity provider.
+// The document must describe exactly one IdP with a single sign-on service
+// supporting the HTTP-Redirect binding and at least one signing certificate.
+func ParseIdpMetadata(doc string) (*IdpMetadata, error) {
+	root, err := parseXML([]byte(doc))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse idp metadata: %w", err)
+	}
+	var entities []*element
+	switch {
+	case root.is(metadataNamespace, "EntityDescriptor"):
+		entities = []*element{root}
+	case root.is(metadataNamespace, "EntitiesDescriptor"):
+		entities = root.childElements(metadataNamespace, "EntityDescriptor")
+	default:
+		return nil, fmt.Errorf("idp metadata must be an EntityDescriptor")
+	}
+
+	var entity, idp *element
+	for _, e := range entities {
+		if d := e.childElement(metadataNamespace, "IDPSSODescriptor"); d != nil {
+			if idp != nil {
+				return nil, fmt.Errorf("idp metadata describes more than one identity provider")
+			}
+			entity, idp = e, d
+		}
+	}
+	if idp == nil {
+		return nil, fmt.Errorf("idp metadata has no IDPSSODescriptor")
+	}
+
+	md := &IdpMetadata{
+		EntityId: entity.attr("entityID"),
+	}
+	if md.EntityId == "" {
+		return nil, fmt.Errorf("idp metadata is missing the entity id")
+	}
+	for _, sso := range idp.childElements(metadataNamespace, "SingleSignOnService") {
+		if sso.attr("Binding") == httpRedirectBinding {
+			md.SsoUrl = sso.attr("Location")
+			break
+		}
+	}
+	if md.SsoUrl == "" {
+		return nil, fmt.Errorf("idp metadata has no single sign-on service for the HTTP-Redirect binding")
+	}
+	if u, err := url.Parse(md.SsoUrl); err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+		return nil, fmt.Errorf("idp single sign-on service location %q is not an http or https URL", md.SsoUrl)
+	}
+
+	for _, kd := range idp.childElements(metadataNamespace, "KeyDescriptor") {
+		if use := kd.attr("use"); use != "" && use != "signing" {
+			continue
+		}
+		keyInfo := kd.childElement(dsigNamespace, "KeyInfo")
+		if keyInfo == nil {
+			continue
+		}
+		for _, data := range keyInfo.childElements(dsigNamespace, "X509Data") {
+			for _, c := range data.childElements(dsigNamespace, "X509Certificate") {
+				der, err := decodeBase64(c.text())
+				if err != nil {
+					return nil, fmt.Errorf("unable to decode idp certificate: %w", err)
+				}
+				cert, err := x509.ParseCertificate(der)
+				if err != nil {
+					return nil, fmt.Errorf("unable to parse idp certificate: %w", err)
+				}
+				md.Certificates = append(md.Certificates, cert)
+			}
+		}
+	}
+	if len(md.Certificates) == 0 {
+		return nil, fmt.Errorf("idp metadata has no signing certificate")
+	}
+	return md, nil
+}
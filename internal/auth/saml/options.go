@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/pagination"
+)
+
+// getOpts - iterate the inbound Options and return a struct.
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName                string
+	withDescription         string
+	withLimit               int
+	withEmail               string
+	withFullName            string
+	withPublicId            string
+	withOperationalState    AuthMethodState
+	withSpEntityId          string
+	withAccountAttributeMap map[string]AccountToAttribute
+	withRoundtripPayload    string
+	withKeyId               string
+	withReader              db.Reader
+	withWriter              db.Writer
+	withStartPageAfterItem  pagination.Item
+}
+
+func getDefaultOptions() options {
+	return options{
+		withOperationalState: InactiveState,
+	}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit.  Intentionally allowing
+// negative integers.   If WithLimit < 0, then unlimited results are returned.
+// If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithEmail provides an optional email address for the account.
+func WithEmail(email string) Option {
+	return func(o *options) {
+		o.withEmail = email
+	}
+}
+
+// WithFullName provides an optional full name for the account.
+func WithFullName(n string) Option {
+	return func(o *options) {
+		o.withFullName = n
+	}
+}
+
+// WithPublicId provides an optional public id.
+func WithPublicId(publicId string) Option {
+	return func(o *options) {
+		o.withPublicId = publicId
+	}
+}
+
+// WithOperationalState provides an option for specifying the auth method's
+// operational state.
+func WithOperationalState(state AuthMethodState) Option {
+	return func(o *options) {
+		o.withOperationalState = state
+	}
+}
+
+// WithSpEntityId provides an optional entity id of Boundary as a service
+// provider. It defaults to the assertion consumer service URL.
+func WithSpEntityId(id string) Option {
+	return func(o *options) {
+		o.withSpEntityId = id
+	}
+}
+
+// WithAccountAttributeMap provides an option for specifying the account fields
+// set from assertion attributes, keyed by the attribute name.
+func WithAccountAttributeMap(m map[string]AccountToAttribute) Option {
+	return func(o *options) {
+		o.withAccountAttributeMap = m
+	}
+}
+
+// WithRoundTripPayload provides an option for a client roundtrip payload,
+// which is added to the final redirect as a query parameter.
+func WithRoundTripPayload(payload string) Option {
+	return func(o *options) {
+		o.withRoundtripPayload = payload
+	}
+}
+
+// WithKeyId provides an option for specifying the version of the oidc DEK
+// request state is encrypted with.
+func WithKeyId(id string) Option {
+	return func(o *options) {
+		o.withKeyId = id
+	}
+}
+
+// WithReader provides an optional reader.
+func WithReader(reader db.Reader) Option {
+	return func(o *options) {
+		o.withReader = reader
+	}
+}
+
+// WithStartPageAfterItem is used to paginate over the results.
+// The next page will start after the provided item.
+func WithStartPageAfterItem(item pagination.Item) Option {
+	return func(o *options) {
+		o.withStartPageAfterItem = item
+	}
+}
+
+// WithReaderWriter allows the caller to pass an inflight transaction to be used
+// for the database writes of the operation. If WithReaderWriter(...) is used,
+// then the caller is responsible for managing the transaction.
+func WithReaderWriter(r db.Reader, w db.Writer) Option {
+	return func(o *options) {
+		o.withReader = r
+		o.withWriter = w
+	}
+}
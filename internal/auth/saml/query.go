@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+const (
+	deleteManagedGroupMembersQuery = `
+	delete from auth_saml_managed_group_member_account
+	 where managed_group_id = @managed_group_id
+	`
+
+	estimateCountAccounts = `
+	select sum(reltuples::bigint) as estimate from pg_class where oid in ('auth_saml_account'::regclass)
+	`
+	estimateCountManagedGroups = `
+	select sum(reltuples::bigint) as estimate from pg_class where oid in ('auth_saml_managed_group'::regclass)
+	`
+)
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/auth"
+)
+
+func init() {
+	auth.RegisterAuthMethodSubtype("saml", &authMethodHooks{})
+}
+
+type authMethodHooks struct{}
+
+// NewAuthMethod creates a new saml auth method from the result
+func (authMethodHooks) NewAuthMethod(ctx context.Context, result *auth.AuthMethodListQueryResult) (auth.AuthMethod, error) {
+	am := AllocAuthMethod()
+	am.PublicId = result.PublicId
+	am.ScopeId = result.ScopeId
+	am.IsPrimaryAuthMethod = result.IsPrimaryAuthMethod
+	am.Name = result.Name
+	am.Description = result.Description
+	am.CreateTime = result.CreateTime
+	am.UpdateTime = result.UpdateTime
+	am.Version = result.Version
+	am.OperationalState = result.State
+	am.ApiUrl = result.ApiUrl
+	am.IdpMetadata = result.IdpMetadata
+	am.SpEntityId = result.SpEntityId
+	am.AccountAttributeMaps = result.AccountAttributeMaps
+
+	return &am, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/auth/saml/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// LookupAuthMethod will lookup an auth method in the repo, along with whether
+// it's the primary auth method of its scope. If it's not found, it will
+// return nil, nil. No options are currently supported.
+func (r *Repository) LookupAuthMethod(ctx context.Context, publicId string, _ ...Option) (*AuthMethod, error) {
+	const op = "saml.(Repository).LookupAuthMethod"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	am, err := r.lookupAuthMethod(ctx, publicId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return am, nil
+}
+
+// lookupAuthMethod will lookup a single auth method. If it's not found, it
+// will return nil, nil.
+func (r *Repository) lookupAuthMethod(ctx context.Context, publicId string) (*AuthMethod, error) {
+	const op = "saml.(Repository).lookupAuthMethod"
+	var aggs []*authMethodAgg
+	if err := r.reader.SearchWhere(ctx, &aggs, "public_id = ?", []any{publicId}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	switch len(aggs) {
+	case 0:
+		return nil, nil
+	case 1:
+		return aggs[0].toAuthMethod(), nil
+	default:
+		return nil, errors.New(ctx, errors.NotSpecificIntegrity, op, "more than one auth method found")
+	}
+}
+
+// authMethodAgg is a view that includes whether the auth method is the
+// primary auth method of its scope.
+type authMethodAgg struct {
+	PublicId             string `gorm:"primary_key"`
+	ScopeId              string
+	IsPrimaryAuthMethod  bool
+	Name                 string
+	Description          string
+	CreateTime           *timestamp.Timestamp
+	UpdateTime           *timestamp.Timestamp
+	Version              uint32
+	State                string
+	ApiUrl               string
+	IdpMetadata          string
+	SpEntityId           string
+	AccountAttributeMaps string
+}
+
+// TableName returns the table name for gorm
+func (agg *authMethodAgg) TableName() string { return "saml_auth_method_with_is_primary" }
+
+func (agg *authMethodAgg) toAuthMethod() *AuthMethod {
+	return &AuthMethod{
+		AuthMethod: &store.AuthMethod{
+			PublicId:             agg.PublicId,
+			ScopeId:              agg.ScopeId,
+			IsPrimaryAuthMethod:  agg.IsPrimaryAuthMethod,
+			Name:                 agg.Name,
+			Description:          agg.Description,
+			CreateTime:           agg.CreateTime,
+			UpdateTime:           agg.UpdateTime,
+			Version:              agg.Version,
+			OperationalState:     agg.State,
+			ApiUrl:               agg.ApiUrl,
+			IdpMetadata:          agg.IdpMetadata,
+			SpEntityId:           agg.SpEntityId,
+			AccountAttributeMaps: agg.AccountAttributeMaps,
+		},
+	}
+}
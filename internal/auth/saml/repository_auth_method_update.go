@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-dbw"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+)
+
+const (
+	OperationalStateField     = "OperationalState"
+	VersionField              = "Version"
+	NameField                 = "Name"
+	DescriptionField          = "Description"
+	FilterField               = "Filter"
+	ApiUrlField               = "ApiUrl"
+	IdpMetadataField          = "IdpMetadata"
+	SpEntityIdField           = "SpEntityId"
+	AccountAttributeMapsField = "AccountAttributeMaps"
+)
+
+// UpdateAuthMethod will retrieve the auth method from the repository,
+// and update it based on the field masks provided.
+//
+// fieldMaskPaths provides field_mask.proto paths for fields that should
+// be updated.  Fields will be set to NULL if the field is a
+// zero value and included in fieldMask. OperationalState, Name, Description,
+// ApiUrl, IdpMetadata, SpEntityId and AccountAttributeMaps are all updatable
+// fields. If no updatable fields are included in the fieldMaskPaths, then an
+// error is returned.
+//
+// The updated auth method is validated as a whole before it's persisted.
+//
+// No options are currently supported.
+func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, version uint32, fieldMaskPaths []string, _ ...Option) (*AuthMethod, int, error) {
+	const op = "saml.(Repository).UpdateAuthMethod"
+	switch {
+	case am == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	case am.AuthMethod == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing auth method store")
+	case am.PublicId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	if err := validateFieldMask(ctx, fieldMaskPaths); err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	fields := map[string]any{
+		OperationalStateField:     am.OperationalState,
+		NameField:                 am.Name,
+		DescriptionField:          am.Description,
+		ApiUrlField:               am.ApiUrl,
+		IdpMetadataField:          am.IdpMetadata,
+		SpEntityIdField:           am.SpEntityId,
+		AccountAttributeMapsField: am.AccountAttributeMaps,
+	}
+	dbMask, nullFields := dbw.BuildUpdatePaths(fields, fieldMaskPaths, nil)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+	for _, f := range []string{OperationalStateField, ApiUrlField, IdpMetadataField} {
+		if strutil.StrListContains(nullFields, f) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s cannot be unset", f))
+		}
+	}
+
+	origAm, err := r.LookupAuthMethod(ctx, am.PublicId)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("%q auth method not found", am.PublicId)))
+	}
+	if origAm == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %q", am.PublicId))
+	}
+	// there's no reason to continue if another controller has already updated this auth method.
+	if origAm.Version != version {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("update version %d doesn't match db version %d", version, origAm.Version))
+	}
+
+	updatedAm := applyUpdate(origAm, am, dbMask, nullFields)
+	if err := updatedAm.validate(ctx, op); err != nil {
+		return nil, db.NoRowsAffected, err // validate properly sets the op to the caller, the code and the msg, so just return it.
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, origAm.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	var returnedAm *AuthMethod
+	var rowsUpdated int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			rowsUpdated, err = w.Update(ctx, updatedAm.Clone(), dbMask, nullFields, db.WithOplog(oplogWrapper, updatedAm.oplog(oplog.OpType_OP_TYPE_UPDATE)), db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update auth method"))
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated auth method and %d rows updated", rowsUpdated))
+			}
+			// we need a new repo, that's using the same reader/writer as this TxHandler
+			txRepo := &Repository{
+				reader: reader,
+				writer: w,
+				kms:    r.kms,
+			}
+			returnedAm, err = txRepo.lookupAuthMethod(ctx, updatedAm.PublicId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup auth method after update"))
+			}
+			if returnedAm == nil {
+				return errors.New(ctx, errors.RecordNotFound, op, "unable to lookup auth method after update")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return returnedAm, rowsUpdated, nil
+}
+
+// applyUpdate returns a copy of orig with the fields in dbMask set to their
+// values in am and the fields in nullFields cleared.
+func applyUpdate(orig, am *AuthMethod, dbMask, nullFields []string) *AuthMethod {
+	cp := orig.Clone()
+	set := func(field string, dst *string, v string) {
+		switch {
+		case strutil.StrListContains(dbMask, field):
+			*dst = v
+		case strutil.StrListContains(nullFields, field):
+			*dst = ""
+		}
+	}
+	set(OperationalStateField, &cp.OperationalState, am.OperationalState)
+	set(NameField, &cp.Name, am.Name)
+	set(DescriptionField, &cp.Description, am.Description)
+	set(ApiUrlField, &cp.ApiUrl, am.ApiUrl)
+	set(IdpMetadataField, &cp.IdpMetadata, am.IdpMetadata)
+	set(SpEntityIdField, &cp.SpEntityId, am.SpEntityId)
+	set(AccountAttributeMapsField, &cp.AccountAttributeMaps, am.AccountAttributeMaps)
+	return cp
+}
+
+// validateFieldMask will validate that the fieldMaskPaths are all updatable
+// fields of an auth method.
+func validateFieldMask(ctx context.Context, fieldMaskPaths []string) error {
+	const op = "saml.validateFieldMask"
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(OperationalStateField, f):
+		case strings.EqualFold(NameField, f):
+		case strings.EqualFold(DescriptionField, f):
+		case strings.EqualFold(ApiUrlField, f):
+		case strings.EqualFold(IdpMetadataField, f):
+		case strings.EqualFold(SpEntityIdField, f):
+		case strings.EqualFold(AccountAttributeMapsField, f):
+		default:
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid field mask: %q", f))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+)
+
+const (
+	statusSuccess      = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	bearerConfirmation = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+
+	// clockSkew is the allowed difference between the clocks of the IdP and
+	// the controller when checking the validity period of an assertion.
+	clockSkew = 2 * time.Minute
+)
+
+// assertionInfo is the information read from a validated SAML assertion.
+type assertionInfo struct {
+	// nameId is the NameID of the assertion's subject.
+	nameId string
+	// attributes are the values of the assertion's attributes keyed by their
+	// name. Attributes with a friendly name can also be looked up by it.
+	attributes map[string][]string
+}
+
+// responseValidation is the expected values a SAML response is validated
+// against.
+type responseValidation struct {
+	md             *IdpMetadata
+	acsUrl         string
+	spEntityId     string
+	authnRequestId string
+	now            time.Time
+}
+
+// validateResponse parses and validates a base64 encoded SAML response
+// received through the HTTP-POST binding. The response or its assertion must
+// be signed by one of the IdP's certificates and only a single, unencrypted
+// assertion is supported.
+func validateResponse(encoded string, v responseValidation) (*assertionInfo, error) {
+	raw, err := decodeBase64(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode response: %w", err)
+	}
+	resp, err := parseXML(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse response: %w", err)
+	}
+	if !resp.is(protocolNamespace, "Response") {
+		return nil, fmt.Errorf("not a saml response")
+	}
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(raw); err != nil {
+		return nil, fmt.Errorf("unable to parse response: %w", err)
+	}
+
+	if len(resp.childElements(assertionNamespace, "EncryptedAssertion")) > 0 {
+		return nil, fmt.Errorf("encrypted assertions are not supported")
+	}
+	assertions := resp.childElements(assertionNamespace, "Assertion")
+	if len(assertions) != 1 {
+		return nil, fmt.Errorf("response must contain exactly one assertion")
+	}
+	assertion := assertions[0]
+
+	// Everything read from here on comes from the signed elements returned
+	// by verifySignature, never from the elements as received.
+	responseSigned, assertionSigned := hasSignature(resp), hasSignature(assertion)
+	if !responseSigned && !assertionSigned {
+		return nil, fmt.Errorf("neither the response nor its assertion is signed")
+	}
+	if responseSigned {
+		if resp, err = verifySignature(resp, doc.Root(), v.md.Certificates); err != nil {
+			return nil, fmt.Errorf("invalid response signature: %w", err)
+		}
+		if assertions = resp.childElements(assertionNamespace, "Assertion"); len(assertions) != 1 {
+			return nil, fmt.Errorf("response must contain exactly one assertion")
+		}
+		assertion = assertions[0]
+	}
+	if assertionSigned {
+		el, err := etreeutils.NSFindOneChild(doc.Root(), assertionNamespace, "Assertion")
+		if err != nil || el == nil {
+			return nil, fmt.Errorf("response must contain exactly one assertion")
+		}
+		if assertion, err = verifySignature(assertion, el, v.md.Certificates); err != nil {
+			return nil, fmt.Errorf("invalid assertion signature: %w", err)
+		}
+	}
+
+	if got := resp.attr("Version"); got != "2.0" {
+		return nil, fmt.Errorf("unsupported saml version %q", got)
+	}
+	if got := resp.attr("Destination"); got != "" && got != v.acsUrl {
+		return nil, fmt.Errorf("response destination %q does not match the assertion consumer service url", got)
+	}
+	if got := resp.attr("InResponseTo"); got != v.authnRequestId {
+		return nil, fmt.Errorf("response is not in response to the authentication request")
+	}
+	if err := checkIssuer(resp, v.md.EntityId); err != nil {
+		return nil, fmt.Errorf("response %w", err)
+	}
+	status := resp.childElement(protocolNamespace, "Status")
+	if status == nil {
+		return nil, fmt.Errorf("response is missing a status")
+	}
+	code := status.childElement(protocolNamespace, "StatusCode")
+	if code == nil {
+		return nil, fmt.Errorf("response is missing a status code")
+	}
+	if got := code.attr("Value"); got != statusSuccess {
+		msg := got
+		if m := status.childElement(protocolNamespace, "StatusMessage"); m != nil {
+			msg = fmt.Sprintf("%s: %s", got, m.text())
+		}
+		return nil, fmt.Errorf("authentication was not successful: %s", msg)
+	}
+
+	if err := checkIssuer(assertion, v.md.EntityId); err != nil {
+		return nil, fmt.Errorf("assertion %w", err)
+	}
+	if err := checkSubject(assertion, v); err != nil {
+		return nil, err
+	}
+	if err := checkConditions(assertion, v); err != nil {
+		return nil, err
+	}
+
+	info := &assertionInfo{
+		nameId:     assertion.childElement(assertionNamespace, "Subject").childElement(assertionNamespace, "NameID").text(),
+		attributes: map[string][]string{},
+	}
+	friendly := map[string][]string{}
+	for _, stmt := range assertion.childElements(assertionNamespace, "AttributeStatement") {
+		for _, a := range stmt.childElements(assertionNamespace, "Attribute") {
+			var values []string
+			for _, av := range a.childElements(assertionNamespace, "AttributeValue") {
+				values = append(values, av.text())
+			}
+			info.attributes[a.attr("Name")] = append(info.attributes[a.attr("Name")], values...)
+			if fn := a.attr("FriendlyName"); fn != "" {
+				friendly[fn] = append(friendly[fn], values...)
+			}
+		}
+	}
+	for name, values := range friendly {
+		if _, ok := info.attributes[name]; !ok {
+			info.attributes[name] = values
+		}
+	}
+	return info, nil
+}
+
+// checkIssuer checks that the issuer of e, if any, is the IdP.
+func checkIssuer(e *element, idpEntityId string) error {
+	iss := e.childElement(assertionNamespace, "Issuer")
+	if iss == nil {
+		if e.is(assertionNamespace, "Assertion") {
+			return fmt.Errorf("is missing an issuer")
+		}
+		return nil
+	}
+	if got := iss.text(); got != idpEntityId {
+		return fmt.Errorf("issuer %q does not match the idp entity id", got)
+	}
+	return nil
+}
+
+// checkSubject checks that the assertion's subject has a NameID and can be
+// confirmed as the bearer of the assertion.
+func checkSubject(assertion *element, v responseValidation) error {
+	subject := assertion.childElement(assertionNamespace, "Subject")
+	if subject == nil {
+		return fmt.Errorf("assertion is missing a subject")
+	}
+	if nameId := subject.childElement(assertionNamespace, "NameID"); nameId == nil || nameId.text() == "" {
+		return fmt.Errorf("assertion subject is missing a name id")
+	}
+	for _, sc := range subject.childElements(assertionNamespace, "SubjectConfirmation") {
+		if sc.attr("Method") != bearerConfirmation {
+			continue
+		}
+		data := sc.childElement(assertionNamespace, "SubjectConfirmationData")
+		if data == nil {
+			continue
+		}
+		if data.attr("Recipient") != v.acsUrl {
+			continue
+		}
+		if got := data.attr("InResponseTo"); got != "" && got != v.authnRequestId {
+			continue
+		}
+		notOnOrAfter, err := parseTime(data.attr("NotOnOrAfter"))
+		if err != nil || notOnOrAfter.IsZero() || !v.now.Before(notOnOrAfter.Add(clockSkew)) {
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("assertion subject can not be confirmed")
+}
+
+// checkConditions checks the validity period and audience restrictions of
+// the assertion.
+func checkConditions(assertion *element, v responseValidation) error {
+	conditions := assertion.childElement(assertionNamespace, "Conditions")
+	if conditions == nil {
+		return nil
+	}
+	notBefore, err := parseTime(conditions.attr("NotBefore"))
+	if err != nil {
+		return fmt.Errorf("invalid assertion conditions: %w", err)
+	}
+	if !notBefore.IsZero() && v.now.Add(clockSkew).Before(notBefore) {
+		return fmt.Errorf("assertion is not yet valid")
+	}
+	notOnOrAfter, err := parseTime(conditions.attr("NotOnOrAfter"))
+	if err != nil {
+		return fmt.Errorf("invalid assertion conditions: %w", err)
+	}
+	if !notOnOrAfter.IsZero() && !v.now.Before(notOnOrAfter.Add(clockSkew)) {
+		return fmt.Errorf("assertion has expired")
+	}
+	// Every audience restriction must be satisfied.
+	for _, ar := range conditions.childElements(assertionNamespace, "AudienceRestriction") {
+		var found bool
+		for _, a := range ar.childElements(assertionNamespace, "Audience") {
+			if a.text() == v.spEntityId {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("assertion audience does not include %q", v.spEntityId)
+		}
+	}
+	return nil
+}
+
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339Nano, s)
+}
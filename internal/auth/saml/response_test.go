@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"encoding/base64"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testdata/response.xml was signed independently of this package, using
+// xmllint --exc-c14n and openssl, with the key of the certificate in
+// testdata/idp_metadata.xml.
+func testResponseFixtures(t *testing.T) (*IdpMetadata, string) {
+	t.Helper()
+	md, err := os.ReadFile("testdata/idp_metadata.xml")
+	require.NoError(t, err)
+	idp, err := ParseIdpMetadata(string(md))
+	require.NoError(t, err)
+	resp, err := os.ReadFile("testdata/response.xml")
+	require.NoError(t, err)
+	return idp, string(resp)
+}
+
+func Test_validateResponse(t *testing.T) {
+	t.Parallel()
+	idp, resp := testResponseFixtures(t)
+
+	validation := func() responseValidation {
+		return responseValidation{
+			md:             idp,
+			acsUrl:         "https://boundary.example.com/v1/auth-methods/amsaml_1234567890:authenticate:callback",
+			spEntityId:     "https://boundary.example.com/sp",
+			authnRequestId: "_request1",
+			now:            time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC),
+		}
+	}
+	encode := func(s string) string {
+		return base64.StdEncoding.EncodeToString([]byte(s))
+	}
+
+	tests := []struct {
+		name            string
+		resp            string
+		validation      func(v *responseValidation)
+		wantErrContains string
+	}{
+		{
+			name: "valid",
+			resp: resp,
+		},
+		{
+			name:            "tampered-attribute",
+			resp:            strings.Replace(resp, "engineering", "security", 1),
+			wantErrContains: "signature not valid for any trusted certificate",
+		},
+		{
+			name:            "sha1-signature",
+			resp:            strings.Replace(resp, "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256", "http://www.w3.org/2000/09/xmldsig#rsa-sha1", 1),
+			wantErrContains: "unsupported signature method",
+		},
+		{
+			name:            "sha1-digest",
+			resp:            strings.Replace(resp, "http://www.w3.org/2001/04/xmlenc#sha256", "http://www.w3.org/2000/09/xmldsig#sha1", 1),
+			wantErrContains: "unsupported digest method",
+		},
+		{
+			name:            "tampered-signed-info",
+			resp:            strings.Replace(resp, `<ds:Transform Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"/>`, `<ds:Transform Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"></ds:Transform>`+"\n", 1),
+			wantErrContains: "signature not valid for any trusted certificate",
+		},
+		{
+			name: "unsigned",
+			resp: func() string {
+				start := strings.Index(resp, "<ds:Signature")
+				end := strings.Index(resp, "</ds:Signature>") + len("</ds:Signature>")
+				return resp[:start] + resp[end:]
+			}(),
+			wantErrContains: "neither the response nor its assertion is signed",
+		},
+		{
+			name:            "wrapped-assertion",
+			resp:            strings.Replace(resp, "</samlp:Status>", `</samlp:Status><saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_evil"/>`, 1),
+			wantErrContains: "response must contain exactly one assertion",
+		},
+		{
+			name: "untrusted-certificate",
+			resp: resp,
+			validation: func(v *responseValidation) {
+				md := *v.md
+				md.Certificates = nil
+				v.md = &md
+			},
+			wantErrContains: "signature not valid for any trusted certificate",
+		},
+		{
+			name: "wrong-request",
+			resp: resp,
+			validation: func(v *responseValidation) {
+				v.authnRequestId = "_request2"
+			},
+			wantErrContains: "response is not in response to the authentication request",
+		},
+		{
+			name: "wrong-destination",
+			resp: resp,
+			validation: func(v *responseValidation) {
+				v.acsUrl = "https://other.example.com/callback"
+			},
+			wantErrContains: "does not match the assertion consumer service url",
+		},
+		{
+			name: "wrong-issuer",
+			resp: resp,
+			validation: func(v *responseValidation) {
+				md := *v.md
+				md.EntityId = "https://other-idp.example.com"
+				v.md = &md
+			},
+			wantErrContains: "does not match the idp entity id",
+		},
+		{
+			name: "wrong-audience",
+			resp: resp,
+			validation: func(v *responseValidation) {
+				v.spEntityId = "https://other.example.com/sp"
+			},
+			wantErrContains: "assertion audience does not include",
+		},
+		{
+			name: "expired",
+			resp: resp,
+			validation: func(v *responseValidation) {
+				v.now = v.now.Add(time.Hour)
+			},
+			wantErrContains: "assertion subject can not be confirmed",
+		},
+		{
+			name: "not-yet-valid",
+			resp: resp,
+			validation: func(v *responseValidation) {
+				v.now = v.now.Add(-time.Hour)
+			},
+			wantErrContains: "assertion is not yet valid",
+		},
+		{
+			name:            "not-saml",
+			resp:            `<foo/>`,
+			wantErrContains: "not a saml response",
+		},
+		{
+			name:            "dtd",
+			resp:            `<!DOCTYPE foo [<!ENTITY x "y">]><foo/>`,
+			wantErrContains: "xml directives are not supported",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			v := validation()
+			if tt.validation != nil {
+				tt.validation(&v)
+			}
+			got, err := validateResponse(encode(tt.resp), v)
+			if tt.wantErrContains != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal("alice@example.com", got.nameId)
+			assert.Equal(map[string][]string{
+				"urn:oid:0.9.2342.19200300.100.1.3": {"alice@example.com"},
+				"mail":                              {"alice@example.com"},
+				"displayName":                       {"Alice Doe"},
+				"groups":                            {"admins", "engineering"},
+			}, got.attributes)
+		})
+	}
+}
+
+func TestParseIdpMetadata(t *testing.T) {
+	t.Parallel()
+	md, err := os.ReadFile("testdata/idp_metadata.xml")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		doc             string
+		wantErrContains string
+	}{
+		{
+			name: "valid",
+			doc:  string(md),
+		},
+		{
+			name:            "missing-redirect-binding",
+			doc:             strings.Replace(string(md), "bindings:HTTP-Redirect", "bindings:SOAP", 1),
+			wantErrContains: "no single sign-on service for the HTTP-Redirect binding",
+		},
+		{
+			name:            "missing-certificate",
+			doc:             strings.Replace(string(md), `use="signing"`, `use="encryption"`, 1),
+			wantErrContains: "idp metadata has no signing certificate",
+		},
+		{
+			name:            "sp-metadata",
+			doc:             strings.ReplaceAll(string(md), "IDPSSODescriptor", "SPSSODescriptor"),
+			wantErrContains: "idp metadata has no IDPSSODescriptor",
+		},
+		{
+			name:            "not-xml",
+			doc:             "not xml",
+			wantErrContains: "unable to parse idp metadata",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := ParseIdpMetadata(tt.doc)
+			if tt.wantErrContains != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal("https://idp.example.com/metadata", got.EntityId)
+			assert.Equal("https://idp.example.com/sso/redirect", got.SsoUrl)
+			require.Len(got.Certificates, 1)
+			assert.Equal("idp.example.com", got.Certificates[0].Subject.CommonName)
+		})
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/saml/request"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	aead "github.com/hashicorp/go-kms-wrapping/v2/aead"
+	"github.com/mr-tron/base58"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// AttemptExpiration defines the TTL for an authentication attempt
+	AttemptExpiration = 5 * 60 * time.Second
+
+	// FinalRedirectEndpoint is the endpoint that the saml callback redirects
+	// the client to after the callback is complete.
+	FinalRedirectEndpoint = "%s/authentication-complete"
+
+	// AuthenticationErrorsEndpoint is the endpoint that will returned as the
+	// final redirect from the callback when there are auth errors
+	AuthenticationErrorsEndpoint = "%s/authentication-error"
+
+	// AcsEndpoint is the assertion consumer service endpoint of an auth
+	// method, which the IdP posts SAML responses to. Unlike the oidc callback,
+	// it includes the auth method id, since the IdP is configured with it
+	// per service provider.
+	AcsEndpoint = "%s/v1/auth-methods/%s:authenticate:callback"
+)
+
+type (
+	// SamlRepoFactory is used by "service functions" to create a new saml repo
+	SamlRepoFactory func() (*Repository, error)
+
+	// IamRepoFactory is used by "service functions" to create a new iam repo
+	IamRepoFactory func() (*iam.Repository, error)
+
+	// AuthTokenRepoFactory is used by "service functions" to create a new auth token repo
+	AuthTokenRepoFactory func() (*authtoken.Repository, error)
+)
+
+// validator defines the interface request messages implement, which allows
+// encryptMessage to validate them before encryption.
+type validator interface {
+	Validate(context.Context) error
+}
+
+// encryptMessage will encrypt the message. The encrypted message will be
+// wrapped in a request.Wrapper and then encoded into the returned string. This
+// function supports encrypting request.State and request.Token messages.
+func encryptMessage(ctx context.Context, wrapper wrapping.Wrapper, am *AuthMethod, m proto.Message) (string, error) {
+	const op = "saml.encryptMessage"
+	if wrapper == nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing wrapper")
+	}
+	keyId, err := wrapper.KeyId(ctx)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("error fetching wrapper key id"))
+	}
+	if keyId == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing wrapper key id")
+	}
+	if am == nil || am.AuthMethod == nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if am.ScopeId == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing auth method scope id")
+	}
+	if am.PublicId == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing auth method public id")
+	}
+	if m == nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing message to encrypt")
+	}
+	switch v := m.(type) {
+	case *request.State, *request.Token:
+	default:
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported message type %v for encryption", v))
+	}
+	if v, ok := m.(validator); ok {
+		if err := v.Validate(ctx); err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+	}
+	marshaled, err := proto.Marshal(m)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to marshal message"), errors.WithCode(errors.Encode))
+	}
+	blobInfo, err := wrapper.Encrypt(ctx, marshaled, wrapping.WithAad([]byte(fmt.Sprintf("%s%s", am.PublicId, am.ScopeId))))
+	if err != nil {
+		return "", errors.New(ctx, errors.Encrypt, op, "unable to encrypt message", errors.WithWrap(err))
+	}
+	marshaledBlob, err := proto.Marshal(blobInfo)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to marshal blob"), errors.WithCode(errors.Encode))
+	}
+	wrapped := &request.Wrapper{
+		AuthMethodId: am.PublicId,
+		ScopeId:      am.ScopeId,
+		WrapperKeyId: keyId,
+		Ct:           marshaledBlob,
+	}
+	if err := wrapped.Validate(ctx); err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	marshaledWrapped, err := proto.Marshal(wrapped)
+	if err != nil {
+		return "", errors.New(ctx, errors.Unknown, op, "unable to marshal encrypted message", errors.WithWrap(err))
+	}
+	return base58.FastBase58Encoding(marshaledWrapped), nil
+}
+
+// decryptMessage will decrypt messages that were previously encrypted with
+// saml.encryptMessage(...). The returned messageBytes can be unmarshaled via
+// proto.Unmarshal into the appropriate message.
+func decryptMessage(ctx context.Context, wrappingWrapper wrapping.Wrapper, wrappedRequest *request.Wrapper) ([]byte, error) {
+	const op = "saml.decryptMessage"
+	if wrappedRequest == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing wrapped request")
+	}
+	if wrappingWrapper == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing wrapping wrapper")
+	}
+	var blobInfo wrapping.BlobInfo
+	if err := proto.Unmarshal(wrappedRequest.Ct, &blobInfo); err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to unmarshal blob info", errors.WithWrap(err))
+	}
+	decryptedMsg, err := wrappingWrapper.Decrypt(ctx, &blobInfo, wrapping.WithAad([]byte(fmt.Sprintf("%s%s", wrappedRequest.AuthMethodId, wrappedRequest.ScopeId))))
+	if err != nil {
+		return nil, errors.New(ctx, errors.Decrypt, op, "unable to decrypt message", errors.WithWrap(err))
+	}
+	return decryptedMsg, nil
+}
+
+// UnwrapMessage unwraps the encoded request.Wrapper proto message
+func UnwrapMessage(ctx context.Context, encodedWrappedMsg string) (*request.Wrapper, error) {
+	const op = "saml.UnwrapMessage"
+	decoded, err := base58.FastBase58Decoding(encodedWrappedMsg)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to decode message", errors.WithWrap(err))
+	}
+	var wrapper request.Wrapper
+	if err := proto.Unmarshal(decoded, &wrapper); err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to unmarshal encoded/encrypted message", errors.WithWrap(err))
+	}
+	return &wrapper, nil
+}
+
+// requestWrappingWrapper finds the wrapping wrapper to use when
+// encrypting/decrypting both a request.State and request.Token.
+//
+// Like the oidc auth method, the key is derived from the scope's oidc DEK,
+// using the auth method id and scope id as salt and info. A distinct derived
+// key purpose keeps the keys of the two auth method types apart.
+//
+// It supports the WithKeyId(...) option which allows you to specify which
+// oidc DEK to use vs the default of just using the latest version of the DEK.
+func requestWrappingWrapper(ctx context.Context, k *kms.Kms, scopeId, authMethodId string, opt ...Option) (wrapping.Wrapper, error) {
+	const op = "saml.requestWrappingWrapper"
+	if k == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if authMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	opts := getOpts(opt...)
+	oidcWrapper, err := k.GetWrapper(ctx, scopeId, kms.KeyPurposeOidc, kms.WithKeyId(opts.withKeyId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oidc wrapper"))
+	}
+	keyId, err := oidcWrapper.KeyId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oidc wrapper key id"))
+	}
+	keyId = fmt.Sprintf("%s.%s.%s", derivedKeyPurposeState, keyId, authMethodId)
+	if derivedWrapper, ok := k.GetDerivedPurposeCache().Load(keyId); ok {
+		return derivedWrapper.(*aead.Wrapper), nil
+	}
+
+	reader, err := crypto.NewDerivedReader(ctx, oidcWrapper, 32, []byte(authMethodId), []byte(scopeId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	privKey, _, err := ed25519.GenerateKey(reader)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Encrypt, op, "unable to generate key", errors.WithWrap(err))
+	}
+	wrapper := aead.NewWrapper()
+	if _, err := wrapper.SetConfig(ctx, wrapping.WithKeyId(keyId)); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("error setting config on aead wrapper in auth method %s", authMethodId)))
+	}
+	if err := wrapper.SetAesGcmKeyBytes(privKey); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("error setting key bytes on aead wrapper in auth method %s", authMethodId)))
+	}
+	k.GetDerivedPurposeCache().Store(keyId, wrapper)
+	return wrapper, nil
+}
+
+// derivedKeyPurposeState is the purpose of the keys derived to encrypt
+// request states and tokens.
+const derivedKeyPurposeState = "saml_state"
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/saml/request"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/mitchellh/pointerstructure"
+	"google.golang.org/protobuf/proto"
+)
+
+// Callback is a saml domain service function for processing a SAML response
+// posted by the IdP to the auth method's assertion consumer service. On
+// success, it returns a final redirect URL for the user agent.
+//
+// Callback can return several errors including errors.Forbidden for
+// responses whose relay state was already used (which are replays).
+//
+// The service operation includes:
+//
+// * Decrypt the relayState which has been encrypted with a key derived from
+// the OIDC DEK. If decryption fails, an error is returned. The decrypted state
+// payload includes the token_request_id, the AuthnRequest id and
+// final_redirect_url.
+//
+// * Validate the samlResponse: it must be in response to the AuthnRequest,
+// addressed to the auth method's ACS URL and audience, and the response or its
+// assertion must be signed by one of the certificates of the IdP metadata.
+//
+// * Create/update the account keyed by the assertion's NameID (or its mapped
+// attribute), setting its email, full name and assertion attributes, and set
+// its managed group memberships.
+//
+// * Use iam.(Repository).LookupUserWithLogin(...) look up the iam.User matching
+// the Account.
+//
+// * Use the authtoken.(Repository).CreateAuthToken(...) to create a pending
+// auth token for the authenticated user.
+func Callback(
+	ctx context.Context,
+	samlRepoFn SamlRepoFactory,
+	iamRepoFn IamRepoFactory,
+	atRepoFn AuthTokenRepoFactory,
+	am *AuthMethod,
+	samlResponse, relayState string,
+) (finalRedirect string, e error) {
+	const op = "saml.Callback"
+	switch {
+	case samlRepoFn == nil:
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing saml repository function")
+	case iamRepoFn == nil:
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing iam repository function")
+	case atRepoFn == nil:
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing auth token repository function")
+	case am == nil || am.AuthMethod == nil:
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	case samlResponse == "":
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing saml response")
+	case relayState == "":
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing relay state")
+	}
+
+	r, err := samlRepoFn()
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+
+	// relayState is a proto request.Wrapper msg, which contains a cipher text
+	// field, so we need the derived wrapper that was used to encrypt it.
+	requestWrapper, err := requestWrappingWrapper(ctx, r.kms, am.ScopeId, am.GetPublicId())
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	stateWrapper, err := UnwrapMessage(ctx, relayState)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	if stateWrapper.AuthMethodId != am.GetPublicId() {
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s auth method id does not match request wrapper auth method id: %s", am.GetPublicId(), stateWrapper.AuthMethodId))
+	}
+	stateBytes, err := decryptMessage(ctx, requestWrapper, stateWrapper)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	var reqState request.State
+	if err := proto.Unmarshal(stateBytes, &reqState); err != nil {
+		return "", errors.New(ctx, errors.Unknown, op, "unable to unmarshal request state", errors.WithWrap(err))
+	}
+
+	// if auth method is inactive, we don't allow inflight requests to finish if the
+	// auth method's config has changed since the request was kicked off.
+	if reqState.AuthMethodVersion != am.Version && am.OperationalState == string(InactiveState) {
+		return "", errors.New(ctx, errors.AuthMethodInactive, op, "auth method configuration changed during in-flight authentication attempt")
+	}
+
+	// before proceeding, make sure the request hasn't timed out
+	now := time.Now()
+	if now.After(reqState.ExpirationTime.Timestamp.AsTime()) {
+		return "", errors.New(ctx, errors.AuthAttemptExpired, op, "request state has expired")
+	}
+
+	md, err := ParseIdpMetadata(am.IdpMetadata)
+	if err != nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "invalid idp metadata", errors.WithWrap(err))
+	}
+	info, err := validateResponse(samlResponse, responseValidation{
+		md:             md,
+		acsUrl:         am.AcsUrl(),
+		spEntityId:     am.EffectiveSpEntityId(),
+		authnRequestId: reqState.AuthnRequestId,
+		now:            now,
+	})
+	if err != nil {
+		return "", errors.New(ctx, errors.Unauthorized, op, "invalid saml response", errors.WithWrap(err))
+	}
+
+	if err := r.finishAuth(ctx, iamRepoFn, atRepoFn, am, reqState.TokenRequestId, info); err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return reqState.FinalRedirectUrl, nil
+}
+
+// finishAuth completes a successful authentication with the information of
+// the validated assertion. It creates or updates the account, sets its
+// managed group memberships and creates a pending auth token with the
+// tokenRequestId, which the client retrieves with TokenRequest.
+//
+// The sequentially ordered transactions all leave the database in a
+// consistent state, even if subsequent transactions fail.
+func (r *Repository) finishAuth(
+	ctx context.Context,
+	iamRepoFn IamRepoFactory,
+	atRepoFn AuthTokenRepoFactory,
+	am *AuthMethod,
+	tokenRequestId string,
+	info *assertionInfo,
+) error {
+	const op = "saml.(Repository).finishAuth"
+	acct, err := r.upsertAccount(ctx, am, info)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	mgs, _, err := r.ListManagedGroups(ctx, am.GetPublicId(), WithLimit(-1))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if len(mgs) > 0 {
+		matchedMgs := make([]*ManagedGroup, 0, len(mgs))
+		evalData := map[string]any{
+			"attributes": info.attributes,
+		}
+		for _, mg := range mgs {
+			eval, err := bexpr.CreateEvaluator(mg.Filter)
+			if err != nil {
+				// We check all filters on ingress so this should never happen,
+				// but we validate anyways
+				return errors.Wrap(ctx, err, op)
+			}
+			match, err := eval.Evaluate(evalData)
+			if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
+				return errors.Wrap(ctx, err, op)
+			}
+			if match {
+				matchedMgs = append(matchedMgs, mg)
+			}
+		}
+		// We always pass it in, even if none match, because in that case we
+		// need to remove any mappings that exist
+		if _, _, err := r.SetManagedGroupMemberships(ctx, am, acct, matchedMgs); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+
+	iamRepo, err := iamRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	user, err := iamRepo.LookupUserWithLogin(ctx, acct.PublicId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	tokenRepo, err := atRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	authToken, err := tokenRepo.CreateAuthToken(ctx, user, acct.PublicId, authtoken.WithPublicId(tokenRequestId), authtoken.WithStatus(authtoken.PendingStatus))
+	if err != nil {
+		if errors.Match(errors.T(errors.NotUnique), err) {
+			return errors.New(ctx, errors.Forbidden, op, "not a unique request", errors.WithWrap(err))
+		}
+		return errors.Wrap(ctx, err, op)
+	}
+	if err := event.WriteObservation(ctx, op, event.WithDetails("user_id", user.GetPublicId(), "auth_token_start",
+		authToken.GetCreateTime(), "auth_token_end", authToken.GetExpirationTime())); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("Unable to write observation event for authenticate method"))
+	}
+	return nil
+}
+
+// upsertAccount creates or updates the account of the assertion described by
+// info. The account is keyed by the assertion's NameID, unless an attribute is
+// mapped to the subject.
+func (r *Repository) upsertAccount(ctx context.Context, am *AuthMethod, info *assertionInfo) (*Account, error) {
+	const op = "saml.(Repository).upsertAccount"
+	attributeMap, err := am.AccountAttributeMap()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	subject := info.nameId
+	var fullName, email string
+	for from, to := range attributeMap {
+		values := info.attributes[from]
+		if len(values) == 0 {
+			continue
+		}
+		switch to {
+		case ToSubAttribute:
+			subject = values[0]
+		case ToNameAttribute:
+			fullName = values[0]
+		case ToEmailAttribute:
+			email = values[0]
+		}
+	}
+
+	acct, err := NewAccount(ctx, am.PublicId, subject, WithFullName(fullName), WithEmail(email))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if acct.PublicId, err = newAccountId(ctx, am.PublicId, subject); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	encodedAttributes, err := json.Marshal(info.attributes)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Encode, op, "unable to encode assertion attributes", errors.WithWrap(err))
+	}
+	acct.AssertionAttributes = string(encodedAttributes)
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	if err := r.writer.Create(
+		ctx,
+		acct,
+		db.WithOnConflict(&db.OnConflict{
+			Target: db.Columns{"public_id"}, // id is predictable and uses both auth method id and subject for inputs
+			Action: db.SetColumns([]string{"full_name", "email", "assertion_attributes"}),
+		}),
+		db.WithOplog(oplogWrapper, acct.oplog(oplog.OpType_OP_TYPE_CREATE, am.ScopeId)),
+	); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create/update saml account"))
+	}
+	return acct, nil
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StartAuth_to_Callback(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	rootWrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, rootWrapper)
+
+	c := event.TestEventerConfig(t, "Test_StartAuth_to_Callback", event.TestWithObservationSink(t))
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+		Name:  "test",
+	})
+	c.EventerConfig.TelemetryEnabled = true
+	require.NoError(t, event.InitSysEventer(testLogger, testLock, "use-Test_StartAuth_to_Callback", event.WithEventerConfig(&c.EventerConfig)))
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	repoFn := func() (*Repository, error) {
+		return NewRepository(ctx, rw, rw, kmsCache)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	repo, err := repoFn()
+	require.NoError(t, err)
+
+	iamRepo := iam.TestRepo(t, conn, rootWrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	idp := NewTestIdp(t)
+	testAm := TestAuthMethod(t, conn, org.PublicId, "https://boundary.example.com", idp.Metadata(),
+		WithOperationalState(ActivePublicState),
+		WithAccountAttributeMap(map[string]AccountToAttribute{
+			"mail":        ToEmailAttribute,
+			"displayName": ToNameAttribute,
+		}),
+	)
+	inactiveAm := TestAuthMethod(t, conn, org.PublicId, "https://boundary.example.com", idp.Metadata())
+	adminsMg := TestManagedGroup(t, conn, testAm, `"admins" in "/attributes/groups"`)
+	devsMg := TestManagedGroup(t, conn, testAm, `"devs" in "/attributes/groups"`)
+
+	// set this as the primary so users will be created on first login
+	iam.TestSetPrimaryAuthMethod(t, iamRepo, org, testAm.PublicId)
+
+	attributes := map[string][]string{
+		"mail":        {"alice@example.com"},
+		"displayName": {"Alice Doe"},
+		"groups":      {"admins", "engineering"},
+	}
+
+	t.Run("inactive-auth-method", func(t *testing.T) {
+		_, _, err := StartAuth(ctx, repoFn, inactiveAm.PublicId)
+		require.Error(t, err)
+		assert.Truef(t, errors.Match(errors.T(errors.AuthMethodInactive), err), "unexpected error: %s", err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		authUrl, tokenId, err := StartAuth(ctx, repoFn, testAm.PublicId, WithRoundTripPayload("payload"))
+		require.NoError(err)
+		assert.Equal("idp.example.com", authUrl.Host)
+		relayState := authUrl.Query().Get("RelayState")
+		require.NotEmpty(relayState)
+		authnRequestId := TestAuthnRequestId(t, authUrl)
+
+		// the token isn't available before the callback
+		tk, err := TokenRequest(ctx, kmsCache, atRepoFn, testAm.PublicId, tokenId)
+		require.NoError(err)
+		assert.Nil(tk)
+
+		samlResponse := idp.Response(t, testAm, authnRequestId, "alice", attributes)
+		finalRedirect, err := Callback(ctx, repoFn, iamRepoFn, atRepoFn, testAm, samlResponse, relayState)
+		require.NoError(err)
+		assert.Equal("https://boundary.example.com/authentication-complete?roundtrip_payload=payload", finalRedirect)
+
+		tk, err = TokenRequest(ctx, kmsCache, atRepoFn, testAm.PublicId, tokenId)
+		require.NoError(err)
+		require.NotNil(tk)
+
+		acct, err := repo.LookupAccount(ctx, tk.GetAuthAccountId())
+		require.NoError(err)
+		assert.Equal("alice", acct.Subject)
+		assert.Equal("alice@example.com", acct.Email)
+		assert.Equal("Alice Doe", acct.FullName)
+		assert.NotEmpty(acct.AssertionAttributes)
+
+		memberships, err := repo.ListManagedGroupMembershipsByMember(ctx, acct.PublicId)
+		require.NoError(err)
+		require.Len(memberships, 1)
+		assert.Equal(adminsMg.PublicId, memberships[0].ManagedGroupId)
+		assert.NotEqual(devsMg.PublicId, memberships[0].ManagedGroupId)
+
+		// replaying the response must fail, since the pending token exists
+		_, err = Callback(ctx, repoFn, iamRepoFn, atRepoFn, testAm, samlResponse, relayState)
+		require.Error(err)
+		assert.Truef(errors.Match(errors.T(errors.Forbidden), err), "unexpected error: %s", err)
+	})
+
+	t.Run("wrong-authn-request", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		authUrl, _, err := StartAuth(ctx, repoFn, testAm.PublicId)
+		require.NoError(err)
+		relayState := authUrl.Query().Get("RelayState")
+
+		samlResponse := idp.Response(t, testAm, "_other", "alice", attributes)
+		_, err = Callback(ctx, repoFn, iamRepoFn, atRepoFn, testAm, samlResponse, relayState)
+		require.Error(err)
+		assert.Truef(errors.Match(errors.T(errors.Unauthorized), err), "unexpected error: %s", err)
+	})
+
+	t.Run("untrusted-idp", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		authUrl, _, err := StartAuth(ctx, repoFn, testAm.PublicId)
+		require.NoError(err)
+		relayState := authUrl.Query().Get("RelayState")
+
+		samlResponse := NewTestIdp(t).Response(t, testAm, TestAuthnRequestId(t, authUrl), "alice", attributes)
+		_, err = Callback(ctx, repoFn, iamRepoFn, atRepoFn, testAm, samlResponse, relayState)
+		require.Error(err)
+		assert.Truef(errors.Match(errors.T(errors.Unauthorized), err), "unexpected error: %s", err)
+	})
+
+	t.Run("wrong-auth-method", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		authUrl, _, err := StartAuth(ctx, repoFn, testAm.PublicId)
+		require.NoError(err)
+		relayState := authUrl.Query().Get("RelayState")
+
+		samlResponse := idp.Response(t, inactiveAm, TestAuthnRequestId(t, authUrl), "alice", attributes)
+		_, err = Callback(ctx, repoFn, iamRepoFn, atRepoFn, inactiveAm, samlResponse, relayState)
+		require.Error(err)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %s", err)
+	})
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/saml/request"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StartAuth accepts a request to start a SAML SP-initiated login. It returns
+// an authUrl and a tokenId. The authUrl is the IdP's single sign-on service
+// URL with an AuthnRequest using the HTTP-Redirect binding. Its RelayState
+// is encrypted and has a payload which includes (among other things) the
+// final redirect, a token_request_id and the id of the AuthnRequest, which the
+// SAML response must be in response to. The tokenId is an encrypted payload
+// the client can use to retrieve the results of the user's authentication
+// attempt.
+//
+// If the auth method is in an InactiveState, then an error is returned.
+//
+// Options supported:
+//
+// WithRoundTripPayload(string) provides an option for a client roundtrip
+// payload. This payload will be added to the final redirect as a query
+// parameter.
+func StartAuth(ctx context.Context, samlRepoFn SamlRepoFactory, authMethodId string, opt ...Option) (authUrl *url.URL, tokenId string, e error) {
+	const op = "saml.StartAuth"
+	if authMethodId == "" {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	if samlRepoFn == nil {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, "missing saml repo function")
+	}
+	r, err := samlRepoFn()
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	am, err := r.lookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, "", errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", authMethodId))
+	}
+	if am.OperationalState == string(InactiveState) {
+		return nil, "", errors.New(ctx, errors.AuthMethodInactive, op, "not allowed to start authentication attempt")
+	}
+	md, err := ParseIdpMetadata(am.IdpMetadata)
+	if err != nil {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, "invalid idp metadata", errors.WithWrap(err))
+	}
+
+	opts := getOpts(opt...)
+	finalRedirect := fmt.Sprintf(FinalRedirectEndpoint, am.GetApiUrl())
+	if opts.withRoundtripPayload != "" {
+		u := make(url.Values)
+		u.Add("roundtrip_payload", opts.withRoundtripPayload)
+		finalRedirect = fmt.Sprintf("%s?%s", finalRedirect, u.Encode())
+	}
+	now := time.Now()
+	createTime := timestamppb.New(now.Truncate(time.Second))
+	exp := timestamppb.New(now.Add(AttemptExpiration).Truncate(time.Second))
+	tokenRequestId, err := authtoken.NewAuthTokenId(ctx)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	authnRequestId, err := newAuthnRequestId()
+	if err != nil {
+		return nil, "", errors.New(ctx, errors.Unknown, op, "unable to generate authn request id", errors.WithWrap(err))
+	}
+	st := &request.State{
+		TokenRequestId:    tokenRequestId,
+		CreateTime:        &timestamp.Timestamp{Timestamp: createTime},
+		ExpirationTime:    &timestamp.Timestamp{Timestamp: exp},
+		FinalRedirectUrl:  finalRedirect,
+		AuthnRequestId:    authnRequestId,
+		AuthMethodVersion: am.Version,
+	}
+
+	requestWrapper, err := requestWrappingWrapper(ctx, r.kms, am.ScopeId, authMethodId)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	relayState, err := encryptMessage(ctx, requestWrapper, am, st)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	u, err := authnRequestUrl(md, authnRequestId, am.EffectiveSpEntityId(), am.AcsUrl(), relayState, now)
+	if err != nil {
+		return nil, "", errors.New(ctx, errors.Unknown, op, "unable to create authn request", errors.WithWrap(err))
+	}
+	authUrl, err = url.Parse(u)
+	if err != nil {
+		return nil, "", errors.New(ctx, errors.Unknown, op, "unable to parse auth url", errors.WithWrap(err))
+	}
+
+	t := &request.Token{
+		RequestId:      tokenRequestId,
+		ExpirationTime: &timestamp.Timestamp{Timestamp: exp},
+	}
+	encodedEncryptedTk, err := encryptMessage(ctx, requestWrapper, am, t)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	return authUrl, encodedEncryptedTk, nil
+}
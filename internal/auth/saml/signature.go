@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"crypto/x509"
+	"fmt"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+)
+
+const (
+	dsigNamespace = "http://www.w3.org/2000/09/xmldsig#"
+
+	excC14NAlgorithm             = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	excC14NWithCommentsAlgorithm = "http://www.w3.org/2001/10/xml-exc-c14n#WithComments"
+	envelopedSignatureAlgorithm  = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+)
+
+// digestAlgorithms and signatureAlgorithms are the supported algorithms of
+// XML signatures. The SHA-1 based algorithms are deliberately not supported.
+var (
+	digestAlgorithms = map[string]bool{
+		"http://www.w3.org/2001/04/xmlenc#sha256":       true,
+		"http://www.w3.org/2001/04/xmldsig-more#sha384": true,
+		"http://www.w3.org/2001/04/xmlenc#sha512":       true,
+	}
+	signatureAlgorithms = map[string]bool{
+		"http://www.w3.org/2001/04/xmldsig-more#rsa-sha256":   true,
+		"http://www.w3.org/2001/04/xmldsig-more#rsa-sha384":   true,
+		"http://www.w3.org/2001/04/xmldsig-more#rsa-sha512":   true,
+		"http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha256": true,
+		"http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha384": true,
+		"http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha512": true,
+	}
+)
+
+// hasSignature reports whether e has an enveloped XML signature.
+func hasSignature(e *element) bool {
+	return e.childElement(dsigNamespace, "Signature") != nil
+}
+
+// verifySignature verifies the enveloped XML signature of e, where el is the
+// same element as parsed by etree, against the public keys of certs and
+// returns the signed element. The signature must reference e itself, so an
+// attacker can't wrap a signed element into an unsigned one. Only certs are
+// trusted; a certificate included in the signature must be one of them.
+//
+// The canonicalization and cryptographic verification are done by goxmldsig
+// and only the content it verified is returned, with the signature removed.
+func verifySignature(e *element, el *etree.Element, certs []*x509.Certificate) (*element, error) {
+	if err := checkSignatureShape(e); err != nil {
+		return nil, err
+	}
+
+	// Detach the element with all the namespaces in scope declared on it, so
+	// it can be verified on its own.
+	nsCtx, err := etreeutils.NSBuildParentContext(el)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve namespaces: %w", err)
+	}
+	detached, err := etreeutils.NSDetatch(nsCtx, el)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve namespaces: %w", err)
+	}
+
+	var lastErr error
+	for _, c := range certs {
+		vctx := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{
+			Roots: []*x509.Certificate{c},
+		})
+		vctx.IdAttribute = "ID"
+		// The IdP metadata is the trust anchor for its certificates, so their
+		// validity period isn't enforced.
+		vctx.Clock = dsig.NewFakeClockAt(c.NotBefore)
+		verified, err := vctx.Validate(detached)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		doc := etree.NewDocument()
+		doc.SetRoot(verified)
+		raw, err := doc.WriteToBytes()
+		if err != nil {
+			return nil, fmt.Errorf("unable to serialize signed element: %w", err)
+		}
+		signed, err := parseXML(raw)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse signed element: %w", err)
+		}
+		return signed, nil
+	}
+	if lastErr != nil {
+		return nil, fmt.Errorf("signature not valid for any trusted certificate: %w", lastErr)
+	}
+	return nil, fmt.Errorf("signature not valid for any trusted certificate")
+}
+
+// checkSignatureShape checks that e has a single enveloped signature with a
+// single reference to e, using exclusive canonicalization and supported
+// algorithms. Any other signatures within e must also use supported
+// algorithms.
+func checkSignatureShape(e *element) error {
+	sigs := e.childElements(dsigNamespace, "Signature")
+	switch len(sigs) {
+	case 0:
+		return fmt.Errorf("missing signature")
+	case 1:
+	default:
+		return fmt.Errorf("multiple signatures")
+	}
+	sig := sigs[0]
+
+	signedInfos := sig.childElements(dsigNamespace, "SignedInfo")
+	if len(signedInfos) != 1 {
+		return fmt.Errorf("signature must have exactly one SignedInfo")
+	}
+	signedInfo := signedInfos[0]
+
+	c14nMethod := signedInfo.childElement(dsigNamespace, "CanonicalizationMethod")
+	if c14nMethod == nil {
+		return fmt.Errorf("missing canonicalization method")
+	}
+	if err := checkCanonicalization(c14nMethod); err != nil {
+		return err
+	}
+
+	refs := signedInfo.childElements(dsigNamespace, "Reference")
+	if len(refs) != 1 {
+		return fmt.Errorf("signature must have exactly one reference")
+	}
+	ref := refs[0]
+	id := e.attr("ID")
+	if id == "" || ref.attr("URI") != "#"+id {
+		return fmt.Errorf("signature does not reference the signed element")
+	}
+
+	var enveloped bool
+	if transforms := ref.childElement(dsigNamespace, "Transforms"); transforms != nil {
+		for _, t := range transforms.childElements(dsigNamespace, "Transform") {
+			switch t.attr("Algorithm") {
+			case envelopedSignatureAlgorithm:
+				enveloped = true
+			default:
+				if err := checkCanonicalization(t); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if !enveloped {
+		return fmt.Errorf("signature must be an enveloped signature")
+	}
+
+	return checkAlgorithms(e)
+}
+
+// checkCanonicalization checks that method is exclusive canonicalization.
+func checkCanonicalization(method *element) error {
+	switch method.attr("Algorithm") {
+	case excC14NAlgorithm, excC14NWithCommentsAlgorithm:
+		return nil
+	default:
+		return fmt.Errorf("unsupported canonicalization method %q", method.attr("Algorithm"))
+	}
+}
+
+// checkAlgorithms checks that all the signature and digest methods within e
+// are supported.
+func checkAlgorithms(e *element) error {
+	for _, c := range e.children {
+		ce, ok := c.(*element)
+		if !ok {
+			continue
+		}
+		switch {
+		case ce.is(dsigNamespace, "SignatureMethod"):
+			if !signatureAlgorithms[ce.attr("Algorithm")] {
+				return fmt.Errorf("unsupported signature method %q", ce.attr("Algorithm"))
+			}
+		case ce.is(dsigNamespace, "DigestMethod"):
+			if !digestAlgorithms[ce.attr("Algorithm")] {
+				return fmt.Errorf("unsupported digest method %q", ce.attr("Algorithm"))
+			}
+		}
+		if err := checkAlgorithms(ce); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math/big"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/hashicorp/boundary/internal/db"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuthMethod creates a new auth method and it's persisted in the database.
+// See NewAuthMethod for list of supported options.
+func TestAuthMethod(t testing.TB, conn *db.DB, scopeId, apiUrl, idpMetadata string, opt ...Option) *AuthMethod {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	u, err := url.Parse(apiUrl)
+	require.NoError(err)
+	am, err := NewAuthMethod(ctx, scopeId, u, idpMetadata, opt...)
+	require.NoError(err)
+	id, err := newAuthMethodId(ctx)
+	require.NoError(err)
+	am.PublicId = id
+
+	require.NoError(rw.Create(ctx, am))
+	return am
+}
+
+// TestAccount creates a test saml auth account.
+func TestAccount(t testing.TB, conn *db.DB, am *AuthMethod, subject string, opt ...Option) *Account {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	a, err := NewAccount(ctx, am.PublicId, subject, opt...)
+	require.NoError(err)
+
+	id, err := newAccountId(ctx, am.PublicId, subject)
+	require.NoError(err)
+	a.PublicId = id
+
+	require.NoError(rw.Create(ctx, a))
+	return a
+}
+
+// TestManagedGroup creates a test saml managed group.
+func TestManagedGroup(t testing.TB, conn *db.DB, am *AuthMethod, filter string, opt ...Option) *ManagedGroup {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	mg, err := NewManagedGroup(ctx, am.PublicId, filter, opt...)
+	require.NoError(err)
+
+	id, err := newManagedGroupId(ctx)
+	require.NoError(err)
+	mg.PublicId = id
+
+	require.NoError(rw.Create(ctx, mg))
+	return mg
+}
+
+// TestIdp is a SAML identity provider for tests, which signs the responses
+// it issues with a generated key.
+type TestIdp struct {
+	EntityId string
+	SsoUrl   string
+	key      *rsa.PrivateKey
+	certDer  []byte
+}
+
+// NewTestIdp creates a new TestIdp.
+func NewTestIdp(t testing.TB) *TestIdp {
+	t.Helper()
+	require := require.New(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(err)
+	return &TestIdp{
+		EntityId: "https://idp.example.com/metadata",
+		SsoUrl:   "https://idp.example.com/sso",
+		key:      key,
+		certDer:  der,
+	}
+}
+
+// Metadata returns the metadata document of the IdP.
+func (i *TestIdp) Metadata() string {
+	return fmt.Sprintf(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="%s">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">
+        <ds:X509Data><ds:X509Certificate>%s</ds:X509Certificate></ds:X509Data>
+      </ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="%s"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, i.EntityId, base64.StdEncoding.EncodeToString(i.certDer), i.SsoUrl)
+}
+
+// Response returns a base64 encoded SAML response to the AuthnRequest with
+// authnRequestId for the auth method am, as it's posted to the ACS. Its
+// assertion is signed, has nameId as subject and includes attributes.
+func (i *TestIdp) Response(t testing.TB, am *AuthMethod, authnRequestId, nameId string, attributes map[string][]string) string {
+	t.Helper()
+	require := require.New(t)
+	now := time.Now().UTC()
+	notOnOrAfter := now.Add(5 * time.Minute).Format(time.RFC3339)
+
+	var attrs strings.Builder
+	names := make([]string, 0, len(attributes))
+	for n := range attributes {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		attrs.WriteString(`<saml:Attribute Name="` + xmlEscape(n) + `">`)
+		for _, v := range attributes[n] {
+			attrs.WriteString(`<saml:AttributeValue>` + xmlEscape(v) + `</saml:AttributeValue>`)
+		}
+		attrs.WriteString(`</saml:Attribute>`)
+	}
+
+	issuer := `<saml:Issuer>` + xmlEscape(i.EntityId) + `</saml:Issuer>`
+	assertion := fmt.Sprintf(`<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_assertion" IssueInstant="%s" Version="2.0">%s`+
+		`<saml:Subject><saml:NameID>%s</saml:NameID><saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer">`+
+		`<saml:SubjectConfirmationData InResponseTo="%s" NotOnOrAfter="%s" Recipient="%s"/></saml:SubjectConfirmation></saml:Subject>`+
+		`<saml:Conditions NotBefore="%s" NotOnOrAfter="%s"><saml:AudienceRestriction><saml:Audience>%s</saml:Audience></saml:AudienceRestriction></saml:Conditions>`+
+		`<saml:AttributeStatement>%s</saml:AttributeStatement></saml:Assertion>`,
+		now.Format(time.RFC3339), issuer, xmlEscape(nameId), authnRequestId, notOnOrAfter, xmlEscape(am.AcsUrl()),
+		now.Format(time.RFC3339), notOnOrAfter, xmlEscape(am.EffectiveSpEntityId()), attrs.String())
+
+	doc := etree.NewDocument()
+	require.NoError(doc.ReadFromString(assertion))
+	sctx, err := dsig.NewSigningContext(i.key, [][]byte{i.certDer})
+	require.NoError(err)
+	sctx.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	signed, err := sctx.SignEnveloped(doc.Root())
+	require.NoError(err)
+	doc.SetRoot(signed)
+	assertion, err = doc.WriteToString()
+	require.NoError(err)
+
+	resp := fmt.Sprintf(`<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" Destination="%s" ID="_response" InResponseTo="%s" IssueInstant="%s" Version="2.0">`+
+		`<saml:Issuer xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion">%s</saml:Issuer>`+
+		`<samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>%s</samlp:Response>`,
+		xmlEscape(am.AcsUrl()), authnRequestId, now.Format(time.RFC3339), xmlEscape(i.EntityId), assertion)
+	return base64.StdEncoding.EncodeToString([]byte(resp))
+}
+
+// TestAuthnRequestId returns the id of the AuthnRequest of an auth url
+// returned by StartAuth.
+func TestAuthnRequestId(t testing.TB, authUrl *url.URL) string {
+	t.Helper()
+	require := require.New(t)
+	raw, err := base64.StdEncoding.DecodeString(authUrl.Query().Get("SAMLRequest"))
+	require.NoError(err)
+	inflated, err := io.ReadAll(flate.NewReader(bytes.NewReader(raw)))
+	require.NoError(err)
+	var req authnRequest
+	require.NoError(xml.Unmarshal(inflated, &req))
+	return req.Id
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
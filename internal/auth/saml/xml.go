@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// element is a minimal XML DOM node. Unlike encoding/xml's unmarshaling, it
+// retains the namespace prefixes and declarations of the document, so
+// elements are matched by the namespace their prefix resolves to.
+type element struct {
+	parent *element
+	// prefix and local are the name of the element as written in the
+	// document, and space is the namespace the prefix resolves to.
+	prefix string
+	local  string
+	space  string
+	// nsDecls are the namespace declarations of the element, keyed by prefix.
+	// The default namespace has an empty prefix.
+	nsDecls  map[string]string
+	attrs    []attr
+	children []node
+}
+
+type attr struct {
+	prefix string
+	local  string
+	space  string
+	value  string
+}
+
+// node is one of *element, charData, comment or procInst.
+type node any
+
+type (
+	charData string
+	comment  string
+	procInst struct{ target, inst string }
+)
+
+// parseXML parses doc into its document element. Documents with a DTD are
+// rejected, since SAML messages never need one.
+func parseXML(doc []byte) (*element, error) {
+	d := xml.NewDecoder(bytes.NewReader(doc))
+	d.Strict = true
+	var root, cur *element
+	for {
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			e := &element{
+				parent: cur,
+				prefix: t.Name.Space,
+				local:  t.Name.Local,
+			}
+			for _, a := range t.Attr {
+				switch {
+				case a.Name.Space == "" && a.Name.Local == "xmlns":
+					e.declare("", a.Value)
+				case a.Name.Space == "xmlns":
+					e.declare(a.Name.Local, a.Value)
+				default:
+					e.attrs = append(e.attrs, attr{prefix: a.Name.Space, local: a.Name.Local, value: a.Value})
+				}
+			}
+			var ok bool
+			if e.space, ok = e.lookupNamespace(e.prefix); !ok {
+				return nil, fmt.Errorf("undeclared namespace prefix %q", e.prefix)
+			}
+			for i, a := range e.attrs {
+				if a.prefix == "" {
+					// unprefixed attributes are in no namespace
+					continue
+				}
+				if e.attrs[i].space, ok = e.lookupNamespace(a.prefix); !ok {
+					return nil, fmt.Errorf("undeclared namespace prefix %q", a.prefix)
+				}
+			}
+			if cur == nil {
+				if root != nil {
+					return nil, fmt.Errorf("multiple document elements")
+				}
+				root = e
+			} else {
+				cur.children = append(cur.children, e)
+			}
+			cur = e
+		case xml.EndElement:
+			if cur == nil || cur.prefix != t.Name.Space || cur.local != t.Name.Local {
+				return nil, fmt.Errorf("unexpected end element %q", t.Name.Local)
+			}
+			cur = cur.parent
+		case xml.CharData:
+			if cur != nil {
+				cur.children = append(cur.children, charData(t))
+			}
+		case xml.Comment:
+			if cur != nil {
+				cur.children = append(cur.children, comment(t))
+			}
+		case xml.ProcInst:
+			if cur != nil {
+				cur.children = append(cur.children, procInst{target: t.Target, inst: string(t.Inst)})
+			}
+		case xml.Directive:
+			return nil, fmt.Errorf("xml directives are not supported")
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("missing document element")
+	}
+	if cur != nil {
+		return nil, fmt.Errorf("unexpected end of document")
+	}
+	return root, nil
+}
+
+func (e *element) declare(prefix, uri string) {
+	if e.nsDecls == nil {
+		e.nsDecls = make(map[string]string)
+	}
+	e.nsDecls[prefix] = uri
+}
+
+// lookupNamespace resolves prefix to the namespace it's bound to in the scope
+// of e.
+func (e *element) lookupNamespace(prefix string) (string, bool) {
+	switch prefix {
+	case "xml":
+		return xmlNamespace, true
+	case "xmlns":
+		return "", false
+	}
+	for c := e; c != nil; c = c.parent {
+		if uri, ok := c.nsDecls[prefix]; ok {
+			return uri, true
+		}
+	}
+	// the default namespace is empty unless declared
+	return "", prefix == ""
+}
+
+// attr returns the value of the unqualified attribute named local.
+func (e *element) attr(local string) string {
+	for _, a := range e.attrs {
+		if a.space == "" && a.local == local {
+			return a.value
+		}
+	}
+	return ""
+}
+
+// is reports whether e is named local in namespace space.
+func (e *element) is(space, local string) bool {
+	return e.space == space && e.local == local
+}
+
+// childElements returns the child elements of e named local in namespace
+// space.
+func (e *element) childElements(space, local string) []*element {
+	var out []*element
+	for _, c := range e.children {
+		if ce, ok := c.(*element); ok && ce.is(space, local) {
+			out = append(out, ce)
+		}
+	}
+	return out
+}
+
+// childElement returns the first child element of e named local in
+// namespace space, or nil if there is none.
+func (e *element) childElement(space, local string) *element {
+	if l := e.childElements(space, local); len(l) > 0 {
+		return l[0]
+	}
+	return nil
+}
+
+// text returns the concatenated character data of e's children.
+func (e *element) text() string {
+	var sb strings.Builder
+	for _, c := range e.children {
+		if t, ok := c.(charData); ok {
+			sb.WriteString(string(t))
+		}
+	}
+	return sb.String()
+}
+
+// decodeBase64 decodes s, ignoring the whitespace base64 values in XML are
+// commonly wrapped with.
+func decodeBase64(s string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+}
@@ -47,6 +47,8 @@ type StartCommand struct {
 	flagMaxSearchStaleness      time.Duration
 	flagMaxSearchRefreshTimeout time.Duration
 	flagDatabaseUrl             string
+	flagDnsListenAddress        string
+	flagInterceptAddressRange   string
 	flagLogLevel                string
 	flagLogFormat               string
 	flagStoreDebug              bool
@@ -121,6 +123,30 @@ func (c *StartCommand) Flags() *base.FlagSets {
 		Usage:   `If a search request triggers a best effort refresh, this specifies how long the refresh should run before timing out.`,
 		Default: daemon.DefaultSearchRefreshTimeout,
 	})
+	f.StringVar(&base.StringVar{
+		Name:   "dns-listen-address",
+		Target: &c.flagDnsListenAddress,
+		Usage:  `If set, the daemon serves DNS on this address, resolving the values of cached aliases to loopback addresses. Connections to those addresses on the port of the alias's target are proxied to the target through a session authorized with the cached auth token, for example "127.0.0.1:5353".`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:    "intercept-address-range",
+		Target:  &c.flagInterceptAddressRange,
+		Usage:   `The IPv4 loopback range from which names resolved using -dns-listen-address are given addresses.`,
+		Default: daemon.DefaultInterceptAddressRange,
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "token-name",
+		Target: &c.FlagTokenName,
+		EnvVar: base.EnvTokenName,
+		Usage:  `The name of the keyring stored token used to resolve aliases and authorize sessions for names resolved using -dns-listen-address. Only that token's user's aliases are resolved.`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:    "keyring-type",
+		Target:  &c.FlagKeyringType,
+		Default: "auto",
+		EnvVar:  base.EnvKeyringType,
+		Usage:   `The type of keyring holding the token given by -token-name. Defaults to "auto" which will use the Windows credential manager, OSX keychain, or cross-platform password store depending on platform.`,
+	})
 	f.BoolVar(&base.BoolVar{
 		Name:    "store-debug",
 		Target:  &c.flagStoreDebug,
@@ -206,6 +232,21 @@ func (c *StartCommand) Run(args []string) int {
 		DotDirectory:            dotDir,
 		RunningInBackground:     os.Getenv(backgroundEnvName) == backgroundEnvVal,
 	}
+	if c.flagDnsListenAddress != "" {
+		keyringType, tokenName, err := c.DiscoverKeyringTokenInfo()
+		if err != nil {
+			c.PrintCliError(err)
+			return base.CommandUserError
+		}
+		if keyringType == "" {
+			c.PrintCliError(stderrors.New("-dns-listen-address requires a token stored in a keyring"))
+			return base.CommandUserError
+		}
+		cfg.DnsListenAddress = c.flagDnsListenAddress
+		cfg.InterceptAddressRange = c.flagInterceptAddressRange
+		cfg.DnsKeyringType = keyringType
+		cfg.DnsTokenName = tokenName
+	}
 
 	srv, err := daemon.New(ctx, cfg)
 	if err != nil {
@@ -319,6 +360,16 @@ func (c *StartCommand) makeBackground(ctx context.Context, dotDir string) (bool,
 	if c.flagDatabaseUrl != "" {
 		args = append(args, "-database-url", c.flagDatabaseUrl)
 	}
+	if c.flagDnsListenAddress != "" {
+		args = append(args, "-dns-listen-address", c.flagDnsListenAddress)
+		args = append(args, "-intercept-address-range", c.flagInterceptAddressRange)
+		if c.FlagKeyringType != "" {
+			args = append(args, "-keyring-type", c.FlagKeyringType)
+		}
+		if c.FlagTokenName != "" {
+			args = append(args, "-token-name", c.FlagTokenName)
+		}
+	}
 	if c.flagStoreDebug {
 		args = append(args, "-store-debug")
 	}
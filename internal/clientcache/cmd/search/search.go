@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/aliases"
 	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
 	daemoncmd "github.com/hashicorp/boundary/internal/clientcache/cmd/daemon"
@@ -31,6 +32,7 @@ var (
 	supportedResourceTypes = []string{
 		"targets",
 		"sessions",
+		"aliases",
 	}
 
 	errDaemonNotRunning = stderrors.New("The deamon process is not running.")
@@ -167,6 +169,8 @@ func (c *SearchCommand) Run(args []string) int {
 			c.UI.Output(printTargetListTable(result.Targets))
 		case len(result.Sessions) > 0:
 			c.UI.Output(printSessionListTable(result.Sessions))
+		case len(result.Aliases) > 0:
+			c.UI.Output(printAliasListTable(result.Aliases))
 		default:
 			c.UI.Output("No items found")
 		}
@@ -372,6 +376,53 @@ func printSessionListTable(items []*sessions.Session) string {
 	return base.WrapForHelpText(output)
 }
 
+func printAliasListTable(items []*aliases.Alias) string {
+	if len(items) == 0 {
+		return "No aliases found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Alias information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		if item.Id != "" {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", item.Id),
+			)
+		} else {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", "(not available)"),
+			)
+		}
+		if item.Type != "" {
+			output = append(output,
+				fmt.Sprintf("    Type:                %s", item.Type),
+			)
+		}
+		if item.Value != "" {
+			output = append(output,
+				fmt.Sprintf("    Value:               %s", item.Value),
+			)
+		}
+		if item.DestinationId != "" {
+			output = append(output,
+				fmt.Sprintf("    Destination ID:      %s", item.DestinationId),
+			)
+		}
+		if item.HostId != "" {
+			output = append(output,
+				fmt.Sprintf("    Host ID:             %s", item.HostId),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
 type filterBy struct {
 	flagFilter   string
 	flagQuery    string
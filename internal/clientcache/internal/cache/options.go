@@ -14,6 +14,7 @@ type options struct {
 	withUserId                 string
 	withTargetRetrievalFunc    TargetRetrievalFunc
 	withSessionRetrievalFunc   SessionRetrievalFunc
+	withAliasRetrievalFunc     AliasRetrievalFunc
 	withIgnoreSearchStaleness  bool
 }
 
@@ -77,6 +78,14 @@ func WithSessionRetrievalFunc(fn SessionRetrievalFunc) Option {
 	}
 }
 
+// WithAliasRetrievalFunc provides an option for specifying an aliasRetrievalFunc
+func WithAliasRetrievalFunc(fn AliasRetrievalFunc) Option {
+	return func(o *options) error {
+		o.withAliasRetrievalFunc = fn
+		return nil
+	}
+}
+
 // WithIgnoreSearchStaleness provides an option for ignoring the resource
 // staleness when performing a search.
 func WithIgnoreSearchStaleness(b bool) Option {
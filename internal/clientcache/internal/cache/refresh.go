@@ -210,6 +210,16 @@ func (r *RefreshService) RefreshForSearch(ctx context.Context, authTokenid strin
 				return errors.Wrap(ctx, err, op)
 			}
 		}
+	case Aliases:
+		rtv, err := r.repo.lookupRefreshToken(ctx, u, aliasResourceType)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if opts.withIgnoreSearchStaleness || rtv != nil && time.Since(rtv.UpdateTime) > r.maxSearchStaleness {
+			if err := r.repo.refreshAliases(ctx, u, tokens, opt...); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		}
 	default:
 		return errors.New(ctx, errors.InvalidParameter, op, "unrecognized resource type")
 	}
@@ -221,8 +231,9 @@ func (r *RefreshService) RefreshForSearch(ctx context.Context, authTokenid strin
 // have a refresh token or which do not have any resources in the cache yet. It
 // then attempts to read those user's resources from boundary and updates the
 // cache with the values retrieved there. Refresh accepts the options
-// WithTargetRetrievalFunc and WithSessionRetrievalFunc which overwrites the
-// default functions used to retrieve those resources from boundary.
+// WithTargetRetrievalFunc, WithSessionRetrievalFunc, and WithAliasRetrievalFunc
+// which overwrites the default functions used to retrieve those resources from
+// boundary.
 func (r *RefreshService) Refresh(ctx context.Context, opt ...Option) error {
 	const op = "cache.(RefreshService).Refresh"
 	if err := r.repo.cleanExpiredOrOrphanedAuthTokens(ctx); err != nil {
@@ -256,6 +267,9 @@ func (r *RefreshService) Refresh(ctx context.Context, opt ...Option) error {
 		if err := r.repo.refreshSessions(ctx, u, tokens, opt...); err != nil {
 			retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for user id %s", u.Id))))
 		}
+		if err := r.repo.refreshAliases(ctx, u, tokens, opt...); err != nil {
+			retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for user id %s", u.Id))))
+		}
 
 	}
 	return retErr
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/aliases"
 	"github.com/hashicorp/boundary/api/authtokens"
 	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
@@ -52,6 +53,16 @@ func testStaticResourceRetrievalFunc[T any](t *testing.T, ret [][]T, removed [][
 	}
 }
 
+// testStaticAliasRetrievalFunc returns a function that always returns the
+// provided aliases and a nil error. The returned function can be passed into
+// WithAliasRetrievalFunc.
+func testStaticAliasRetrievalFunc(t *testing.T, ret []*aliases.Alias) AliasRetrievalFunc {
+	t.Helper()
+	return func(context.Context, string, string, string) ([]*aliases.Alias, error) {
+		return ret, nil
+	}
+}
+
 // testNoRefreshRetrievalFunc simulates a controller that doesn't support refresh
 // since it does not return any refresh token.
 func testNoRefreshRetrievalFunc[T any](t *testing.T) func(context.Context, string, string, RefreshTokenValue) ([]T, []string, RefreshTokenValue, error) {
@@ -366,6 +377,7 @@ func TestRefreshForSearch(t *testing.T) {
 			target("4"),
 		}
 		opts := []Option{
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t, nil, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t,
 				[][]*targets.Target{
@@ -417,6 +429,7 @@ func TestRefreshForSearch(t *testing.T) {
 			target("4"),
 		}
 		opts := []Option{
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t, nil, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t,
 				[][]*targets.Target{
@@ -471,6 +484,7 @@ func TestRefreshForSearch(t *testing.T) {
 
 		// Get the first set of resources, but no refresh tokens
 		err = rs.Refresh(ctx,
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)),
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t)))
 		assert.ErrorContains(t, err, ErrRefreshNotSupported.Error())
@@ -483,6 +497,7 @@ func TestRefreshForSearch(t *testing.T) {
 		// wont be refreshed any more, and we wont see the error when refreshing
 		// any more.
 		err = rs.Refresh(ctx,
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)),
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t)))
 		assert.Nil(t, err)
@@ -524,6 +539,7 @@ func TestRefreshForSearch(t *testing.T) {
 			session("4"),
 		}
 		opts := []Option{
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t, nil, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t,
 				[][]*sessions.Session{
@@ -572,6 +588,7 @@ func TestRefreshForSearch(t *testing.T) {
 			session("4"),
 		}
 		opts := []Option{
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t, nil, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t,
 				[][]*sessions.Session{
@@ -643,6 +660,7 @@ func TestRefresh(t *testing.T) {
 			target("4"),
 		}
 		opts := []Option{
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t, nil, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t,
 				[][]*targets.Target{
@@ -684,6 +702,7 @@ func TestRefresh(t *testing.T) {
 			session("4"),
 		}
 		opts := []Option{
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t, nil, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t,
 				[][]*sessions.Session{
@@ -708,6 +727,44 @@ func TestRefresh(t *testing.T) {
 		assert.ElementsMatch(t, retSess[2:], cachedSessions)
 	})
 
+	t.Run("set aliases", func(t *testing.T) {
+		s, err := db.Open(ctx)
+		require.NoError(t, err)
+		r, err := NewRepository(ctx, s, &sync.Map{}, mapBasedAuthTokenKeyringLookup(atMap), sliceBasedAuthTokenBoundaryReader(boundaryAuthTokens))
+		require.NoError(t, err)
+		rs, err := NewRefreshService(ctx, r, 0, 0)
+		require.NoError(t, err)
+		require.NoError(t, r.AddKeyringToken(ctx, boundaryAddr, KeyringToken{KeyringType: "k", TokenName: "t", AuthTokenId: at.Id}))
+
+		retAliases := []*aliases.Alias{
+			alias("1"),
+			alias("2"),
+			alias("3"),
+		}
+		opts := []Option{
+			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t, nil, nil)),
+			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t, nil, nil)),
+		}
+		assert.NoError(t, rs.Refresh(ctx, append(opts, WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, retAliases)))...))
+		cachedAliases, err := r.ListAliases(ctx, at.Id)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, retAliases, cachedAliases)
+
+		// Aliases are always fully refreshed so a second call replaces the cache
+		assert.NoError(t, rs.Refresh(ctx, append(opts, WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, retAliases[2:])))...))
+		cachedAliases, err = r.ListAliases(ctx, at.Id)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, retAliases[2:], cachedAliases)
+
+		// A controller which doesn't know about aliases clears them from the cache
+		assert.NoError(t, rs.Refresh(ctx, append(opts, WithAliasRetrievalFunc(func(context.Context, string, string, string) ([]*aliases.Alias, error) {
+			return nil, api.ErrNotFound
+		}))...))
+		cachedAliases, err = r.ListAliases(ctx, at.Id)
+		assert.NoError(t, err)
+		assert.Empty(t, cachedAliases)
+	})
+
 	t.Run("error propogates up", func(t *testing.T) {
 		s, err := db.Open(ctx)
 		require.NoError(t, err)
@@ -719,6 +776,7 @@ func TestRefresh(t *testing.T) {
 
 		innerErr := errors.New("test error")
 		err = rs.Refresh(ctx,
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t, nil, nil)),
 			WithTargetRetrievalFunc(func(ctx context.Context, addr, token string, refreshTok RefreshTokenValue) ([]*targets.Target, []string, RefreshTokenValue, error) {
 				require.Equal(t, boundaryAddr, addr)
@@ -727,6 +785,7 @@ func TestRefresh(t *testing.T) {
 			}))
 		assert.ErrorContains(t, err, innerErr.Error())
 		err = rs.Refresh(ctx,
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t, nil, nil)),
 			WithSessionRetrievalFunc(func(ctx context.Context, addr, token string, refreshTok RefreshTokenValue) ([]*sessions.Session, []string, RefreshTokenValue, error) {
 				require.Equal(t, boundaryAddr, addr)
@@ -759,6 +818,7 @@ func TestRefresh(t *testing.T) {
 		assert.Len(t, us, 1)
 
 		rs.Refresh(ctx,
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t, nil, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t, nil, nil)))
 
@@ -810,6 +870,7 @@ func TestRecheckCachingSupport(t *testing.T) {
 		assert.Empty(t, got)
 
 		err = rs.Refresh(ctx,
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)),
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t)))
 		assert.ErrorIs(t, err, ErrRefreshNotSupported)
@@ -842,6 +903,7 @@ func TestRecheckCachingSupport(t *testing.T) {
 		assert.Empty(t, got)
 
 		err = rs.Refresh(ctx,
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)),
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)))
 		assert.ErrorIs(t, err, ErrRefreshNotSupported)
@@ -868,6 +930,7 @@ func TestRecheckCachingSupport(t *testing.T) {
 		require.NoError(t, r.AddKeyringToken(ctx, boundaryAddr, KeyringToken{KeyringType: "k", TokenName: "t", AuthTokenId: at.Id}))
 
 		err = rs.Refresh(ctx,
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)),
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)))
 		assert.ErrorIs(t, err, ErrRefreshNotSupported)
@@ -902,6 +965,7 @@ func TestRecheckCachingSupport(t *testing.T) {
 		require.NoError(t, r.AddKeyringToken(ctx, boundaryAddr, KeyringToken{KeyringType: "k", TokenName: "t", AuthTokenId: at.Id}))
 
 		err = rs.Refresh(ctx,
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)),
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)))
 		assert.ErrorIs(t, err, ErrRefreshNotSupported)
@@ -953,3 +1017,13 @@ func session(suffix string) *sessions.Session {
 		Type: "tcp",
 	}
 }
+
+func alias(suffix string) *aliases.Alias {
+	return &aliases.Alias{
+		Id:            fmt.Sprintf("alt_%s", suffix),
+		ScopeId:       "global",
+		Type:          "target",
+		Value:         fmt.Sprintf("value_%s", suffix),
+		DestinationId: fmt.Sprintf("target_%s", suffix),
+	}
+}
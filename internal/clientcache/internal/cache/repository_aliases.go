@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/aliases"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/api/users"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/util"
+	"github.com/hashicorp/mql"
+)
+
+// fullFetchRefreshToken is the value set in the refresh token table for
+// resources which boundary only returns in full. Its update time records when
+// the resources were last fetched.
+const fullFetchRefreshToken RefreshTokenValue = "__full_fetch__"
+
+// AliasRetrievalFunc is a function that retrieves the aliases the provided
+// user can resolve from the provided boundary addr using the provided token.
+type AliasRetrievalFunc func(ctx context.Context, addr, authTok, userId string) ([]*aliases.Alias, error)
+
+func defaultAliasFunc(ctx context.Context, addr, authTok, userId string) ([]*aliases.Alias, error) {
+	const op = "cache.defaultAliasFunc"
+	client, err := api.NewClient(&api.Config{
+		Addr:  addr,
+		Token: authTok,
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	uClient := users.NewClient(client)
+	l, err := uClient.ListResolvableAliases(ctx, userId)
+	if err != nil {
+		if api.ErrNotFound.Is(err) {
+			return nil, err
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return l.Items, nil
+}
+
+// refreshAliases replaces the cached aliases for the provided user with the
+// aliases boundary reports the user can resolve. Boundary does not provide
+// refresh tokens for resolvable aliases so every refresh is a full fetch. If
+// the boundary instance does not know about aliases the cached aliases for
+// the user are removed and no error is returned.
+func (r *Repository) refreshAliases(ctx context.Context, u *user, tokens map[AuthToken]string, opt ...Option) error {
+	const op = "cache.(Repository).refreshAliases"
+	switch {
+	case util.IsNil(u):
+		return errors.New(ctx, errors.InvalidParameter, op, "user is nil")
+	case u.Id == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "user id is missing")
+	case u.Address == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "user boundary address is missing")
+	}
+	const resourceType = aliasResourceType
+
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if opts.withAliasRetrievalFunc == nil {
+		opts.withAliasRetrievalFunc = defaultAliasFunc
+	}
+
+	// Find and use a token for retrieving aliases
+	var gotResponse bool
+	var resp []*aliases.Alias
+	var unsupported bool
+	var retErr error
+	for at, t := range tokens {
+		resp, err = opts.withAliasRetrievalFunc(ctx, u.Address, t, u.Id)
+		if err != nil {
+			if api.ErrNotFound.Is(err) {
+				unsupported = true
+			} else {
+				retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg("for token %q", at.Id)))
+				continue
+			}
+		}
+		gotResponse = true
+		break
+	}
+
+	if retErr != nil {
+		if saveErr := r.saveError(r.serverCtx, u, resourceType, retErr); saveErr != nil {
+			return stderrors.Join(err, errors.Wrap(ctx, saveErr, op))
+		}
+	}
+	if !gotResponse {
+		return retErr
+	}
+
+	var numDeleted int
+	_, err = r.rw.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(_ db.Reader, w db.Writer) error {
+		var err error
+		if numDeleted, err = w.Exec(ctx, "delete from alias where fk_user_id = @fk_user_id",
+			[]any{sql.Named("fk_user_id", u.Id)}); err != nil {
+			return err
+		}
+		if unsupported {
+			return deleteRefreshToken(ctx, w, u, resourceType)
+		}
+		if err := upsertAliases(ctx, w, u, resp); err != nil {
+			return err
+		}
+		return upsertRefreshToken(ctx, w, u, resourceType, fullFetchRefreshToken)
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	event.WriteSysEvent(ctx, op, "aliases updated", "deleted", numDeleted, "upserted", len(resp), "user_id", u.Id)
+	return nil
+}
+
+// upsertAliases upserts the provided aliases to be stored for the provided user.
+func upsertAliases(ctx context.Context, w db.Writer, u *user, in []*aliases.Alias) error {
+	const op = "cache.upsertAliases"
+	switch {
+	case util.IsNil(w):
+		return errors.New(ctx, errors.InvalidParameter, op, "writer is nil")
+	case !w.IsTx(ctx):
+		return errors.New(ctx, errors.InvalidParameter, op, "writer isn't in a transaction")
+	case util.IsNil(u):
+		return errors.New(ctx, errors.InvalidParameter, op, "user is nil")
+	}
+
+	for _, a := range in {
+		item, err := json.Marshal(a)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		newAlias := &Alias{
+			FkUserId:      u.Id,
+			Id:            a.Id,
+			Type:          a.Type,
+			Value:         a.Value,
+			DestinationId: a.DestinationId,
+			HostId:        a.HostId,
+			Item:          string(item),
+		}
+		onConflict := db.OnConflict{
+			Target: db.Columns{"fk_user_id", "id"},
+			Action: db.SetColumns([]string{"type", "value", "destination_id", "host_id", "item"}),
+		}
+		if err := w.Create(ctx, newAlias, db.WithOnConflict(&onConflict)); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	return nil
+}
+
+func (r *Repository) ListAliases(ctx context.Context, authTokenId string) ([]*aliases.Alias, error) {
+	const op = "cache.(Repository).ListAliases"
+	switch {
+	case authTokenId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "auth token id is missing")
+	}
+	ret, err := r.searchAliases(ctx, "true", nil, withAuthTokenId(authTokenId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
+
+func (r *Repository) QueryAliases(ctx context.Context, authTokenId, query string) ([]*aliases.Alias, error) {
+	const op = "cache.(Repository).QueryAliases"
+	switch {
+	case authTokenId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "auth token id is missing")
+	case query == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "query is missing")
+	}
+
+	w, err := mql.Parse(query, Alias{}, mql.WithIgnoredFields("FkUserId", "Item"))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+	ret, err := r.searchAliases(ctx, w.Condition, w.Args, withAuthTokenId(authTokenId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
+
+// LookupAliasByValue returns the cached alias with the provided value that
+// the user of the provided auth token can resolve. If no such alias is cached
+// nil is returned without an error.
+func (r *Repository) LookupAliasByValue(ctx context.Context, authTokenId, value string) (*aliases.Alias, error) {
+	const op = "cache.(Repository).LookupAliasByValue"
+	switch {
+	case authTokenId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "auth token id is missing")
+	case value == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "value is missing")
+	}
+	ret, err := r.searchAliases(ctx, "value = ?", []any{value}, withAuthTokenId(authTokenId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	switch len(ret) {
+	case 0:
+		return nil, nil
+	case 1:
+		return ret[0], nil
+	default:
+		return nil, errors.New(ctx, errors.NotSpecificIntegrity, op, fmt.Sprintf("found %d aliases with the value %q", len(ret), value))
+	}
+}
+
+// ResolvedAlias is a cached alias along with what is needed to connect to its
+// destination on behalf of a user who can resolve it.
+type ResolvedAlias struct {
+	Alias *aliases.Alias
+	// Target is the cached destination target of the alias, or nil if the
+	// target is not in the cache.
+	Target *targets.Target
+	// BoundaryAddr is the address of the boundary instance the alias is from.
+	BoundaryAddr string
+	// AuthToken is the token the alias was resolved with. It has not been
+	// validated against boundary.
+	AuthToken string
+}
+
+// ResolveAlias returns the cached alias with the provided value for the user of
+// the auth token stored in the keyring with the provided type and token name.
+// Only that user's cached aliases and auth token are used. If the keyring
+// token is unknown to the cache, is no longer in the keyring, or its user
+// cannot resolve the alias, nil is returned without an error.
+func (r *Repository) ResolveAlias(ctx context.Context, keyringType, tokenName, value string) (*ResolvedAlias, error) {
+	const op = "cache.(Repository).ResolveAlias"
+	switch {
+	case keyringType == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "keyring type is missing")
+	case tokenName == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "token name is missing")
+	case value == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "value is missing")
+	}
+	var kts []*KeyringToken
+	if err := r.rw.SearchWhere(ctx, &kts, "keyring_type = ? and token_name = ?", []any{keyringType, tokenName}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(kts) == 0 {
+		return nil, nil
+	}
+	at := r.tokenKeyringFn(keyringType, tokenName)
+	if at == nil || at.Id != kts[0].AuthTokenId {
+		return nil, nil
+	}
+	cachedTok, err := r.LookupToken(ctx, at.Id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if cachedTok == nil {
+		return nil, nil
+	}
+	u, err := r.lookupUser(ctx, cachedTok.UserId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if u == nil {
+		return nil, nil
+	}
+	as, err := r.searchAliases(ctx, "value = ?", []any{value}, withUserId(u.Id))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(as) == 0 {
+		return nil, nil
+	}
+	ret := &ResolvedAlias{
+		Alias:        as[0],
+		BoundaryAddr: u.Address,
+		AuthToken:    at.Token,
+	}
+	if ret.Alias.DestinationId != "" {
+		ts, err := r.searchTargets(ctx, "id = ?", []any{ret.Alias.DestinationId}, withUserId(u.Id))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if len(ts) > 0 {
+			ret.Target = ts[0]
+		}
+	}
+	return ret, nil
+}
+
+func (r *Repository) searchAliases(ctx context.Context, condition string, searchArgs []any, opt ...Option) ([]*aliases.Alias, error) {
+	const op = "cache.(Repository).searchAliases"
+	switch {
+	case condition == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "condition is missing")
+	}
+
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case opts.withAuthTokenId != "" && opts.withUserId != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "both user id and auth token id were provided")
+	case opts.withAuthTokenId == "" && opts.withUserId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "neither user id nor auth token id were provided")
+	case opts.withAuthTokenId != "":
+		condition = fmt.Sprintf("%s and fk_user_id in (select user_id from auth_token where id = ?)", condition)
+		searchArgs = append(searchArgs, opts.withAuthTokenId)
+	case opts.withUserId != "":
+		condition = fmt.Sprintf("%s and fk_user_id = ?", condition)
+		searchArgs = append(searchArgs, opts.withUserId)
+	}
+
+	var cachedAliases []*Alias
+	if err := r.rw.SearchWhere(ctx, &cachedAliases, condition, searchArgs, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	retAliases := make([]*aliases.Alias, 0, len(cachedAliases))
+	for _, cachedAlias := range cachedAliases {
+		var a aliases.Alias
+		if err := json.Unmarshal([]byte(cachedAlias.Item), &a); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		retAliases = append(retAliases, &a)
+	}
+	return retAliases, nil
+}
+
+type Alias struct {
+	FkUserId      string `gorm:"primaryKey"`
+	Id            string `gorm:"primaryKey"`
+	Type          string `gorm:"default:null"`
+	Value         string `gorm:"default:null"`
+	DestinationId string `gorm:"default:null"`
+	HostId        string `gorm:"default:null"`
+	Item          string `gorm:"default:null"`
+}
+
+func (*Alias) TableName() string {
+	return "alias"
+}
@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/aliases"
+	"github.com/hashicorp/boundary/api/authtokens"
+	"github.com/hashicorp/boundary/api/targets"
+	cachedb "github.com/hashicorp/boundary/internal/clientcache/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
+)
+
+func TestRepository_refreshAliases(t *testing.T) {
+	ctx := context.Background()
+	s, err := cachedb.Open(ctx)
+	require.NoError(t, err)
+
+	addr := "address"
+	u := &user{
+		Id:      "u1",
+		Address: addr,
+	}
+	at := &authtokens.AuthToken{
+		Id:     "at_1",
+		Token:  "at_1_token",
+		UserId: u.Id,
+	}
+	kt := KeyringToken{
+		KeyringType: "keyring",
+		TokenName:   "token",
+		AuthTokenId: at.Id,
+	}
+	atMap := map[ringToken]*authtokens.AuthToken{
+		{kt.KeyringType, kt.TokenName}: at,
+	}
+	r, err := NewRepository(ctx, s, &sync.Map{}, mapBasedAuthTokenKeyringLookup(atMap), sliceBasedAuthTokenBoundaryReader(maps.Values(atMap)))
+	require.NoError(t, err)
+	require.NoError(t, r.AddKeyringToken(ctx, addr, kt))
+
+	t.Run("missing user", func(t *testing.T) {
+		err := r.refreshAliases(ctx, nil, map[AuthToken]string{{Id: "id"}: "something"},
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)))
+		assert.ErrorContains(t, err, "user is nil")
+	})
+	t.Run("missing user address", func(t *testing.T) {
+		err := r.refreshAliases(ctx, &user{Id: u.Id}, map[AuthToken]string{{Id: "id"}: "something"},
+			WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, nil)))
+		assert.ErrorContains(t, err, "user boundary address is missing")
+	})
+
+	var gotUserId string
+	as := []*aliases.Alias{alias("1"), alias("2")}
+	require.NoError(t, r.refreshAliases(ctx, u, map[AuthToken]string{{Id: "id"}: "something"},
+		WithAliasRetrievalFunc(func(_ context.Context, _, _, userId string) ([]*aliases.Alias, error) {
+			gotUserId = userId
+			return as, nil
+		})))
+	assert.Equal(t, u.Id, gotUserId)
+
+	rt, err := r.lookupRefreshToken(ctx, u, aliasResourceType)
+	require.NoError(t, err)
+	require.NotNil(t, rt)
+	assert.Equal(t, fullFetchRefreshToken, rt.RefreshToken)
+
+	cs, err := r.cacheSupportState(ctx, u)
+	require.NoError(t, err)
+	assert.Equal(t, SupportedCacheSupport, cs.supported)
+
+	l, err := r.ListAliases(ctx, at.Id)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, as, l)
+}
+
+func TestRepository_ResolveAlias(t *testing.T) {
+	ctx := context.Background()
+	s, err := cachedb.Open(ctx)
+	require.NoError(t, err)
+
+	addr := "address"
+	u := &user{
+		Id:      "u1",
+		Address: addr,
+	}
+	at := &authtokens.AuthToken{
+		Id:     "at_1",
+		Token:  "at_1_token",
+		UserId: u.Id,
+	}
+	kt := KeyringToken{
+		KeyringType: "keyring",
+		TokenName:   "token",
+		AuthTokenId: at.Id,
+	}
+	u2 := &user{
+		Id:      "u2",
+		Address: addr,
+	}
+	at2 := &authtokens.AuthToken{
+		Id:     "at_2",
+		Token:  "at_2_token",
+		UserId: u2.Id,
+	}
+	kt2 := KeyringToken{
+		KeyringType: "keyring",
+		TokenName:   "token2",
+		AuthTokenId: at2.Id,
+	}
+	atMap := map[ringToken]*authtokens.AuthToken{
+		{kt.KeyringType, kt.TokenName}:   at,
+		{kt2.KeyringType, kt2.TokenName}: at2,
+	}
+	r, err := NewRepository(ctx, s, &sync.Map{}, mapBasedAuthTokenKeyringLookup(atMap), sliceBasedAuthTokenBoundaryReader(maps.Values(atMap)))
+	require.NoError(t, err)
+	require.NoError(t, r.AddKeyringToken(ctx, addr, kt))
+	require.NoError(t, r.AddKeyringToken(ctx, addr, kt2))
+
+	t.Run("missing keyring type", func(t *testing.T) {
+		ra, err := r.ResolveAlias(ctx, "", kt.TokenName, "value")
+		assert.Nil(t, ra)
+		assert.ErrorContains(t, err, "keyring type is missing")
+	})
+	t.Run("missing token name", func(t *testing.T) {
+		ra, err := r.ResolveAlias(ctx, kt.KeyringType, "", "value")
+		assert.Nil(t, ra)
+		assert.ErrorContains(t, err, "token name is missing")
+	})
+	t.Run("missing value", func(t *testing.T) {
+		ra, err := r.ResolveAlias(ctx, kt.KeyringType, kt.TokenName, "")
+		assert.Nil(t, ra)
+		assert.ErrorContains(t, err, "value is missing")
+	})
+
+	as := []*aliases.Alias{alias("1"), alias("2")}
+	require.NoError(t, r.refreshAliases(ctx, u, map[AuthToken]string{{Id: "id"}: "something"},
+		WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, as))))
+	tar := target("1")
+	require.NoError(t, r.refreshTargets(ctx, u, map[AuthToken]string{{Id: "id"}: "something"},
+		WithTargetRetrievalFunc(testStaticResourceRetrievalFunc(t, [][]*targets.Target{{tar}}, [][]string{nil}))))
+
+	t.Run("unknown value", func(t *testing.T) {
+		ra, err := r.ResolveAlias(ctx, kt.KeyringType, kt.TokenName, "unknown")
+		assert.NoError(t, err)
+		assert.Nil(t, ra)
+	})
+	t.Run("unknown keyring token", func(t *testing.T) {
+		ra, err := r.ResolveAlias(ctx, kt.KeyringType, "unknown", as[0].Value)
+		assert.NoError(t, err)
+		assert.Nil(t, ra)
+	})
+	t.Run("cached target", func(t *testing.T) {
+		ra, err := r.ResolveAlias(ctx, kt.KeyringType, kt.TokenName, as[0].Value)
+		require.NoError(t, err)
+		require.NotNil(t, ra)
+		assert.Equal(t, as[0], ra.Alias)
+		assert.Equal(t, tar, ra.Target)
+		assert.Equal(t, addr, ra.BoundaryAddr)
+		assert.Equal(t, at.Token, ra.AuthToken)
+	})
+	t.Run("uncached target", func(t *testing.T) {
+		ra, err := r.ResolveAlias(ctx, kt.KeyringType, kt.TokenName, as[1].Value)
+		require.NoError(t, err)
+		require.NotNil(t, ra)
+		assert.Equal(t, as[1], ra.Alias)
+		assert.Nil(t, ra.Target)
+	})
+	t.Run("other user's alias", func(t *testing.T) {
+		ra, err := r.ResolveAlias(ctx, kt2.KeyringType, kt2.TokenName, as[0].Value)
+		assert.NoError(t, err)
+		assert.Nil(t, ra)
+	})
+	t.Run("no available token", func(t *testing.T) {
+		delete(atMap, ringToken{kt.KeyringType, kt.TokenName})
+		t.Cleanup(func() { atMap[ringToken{kt.KeyringType, kt.TokenName}] = at })
+		ra, err := r.ResolveAlias(ctx, kt.KeyringType, kt.TokenName, as[0].Value)
+		assert.NoError(t, err)
+		assert.Nil(t, ra)
+	})
+	t.Run("keyring holds a different token", func(t *testing.T) {
+		atMap[ringToken{kt.KeyringType, kt.TokenName}] = at2
+		t.Cleanup(func() { atMap[ringToken{kt.KeyringType, kt.TokenName}] = at })
+		ra, err := r.ResolveAlias(ctx, kt.KeyringType, kt.TokenName, as[0].Value)
+		assert.NoError(t, err)
+		assert.Nil(t, ra)
+	})
+}
+
+func TestRepository_ListAliases(t *testing.T) {
+	ctx := context.Background()
+	s, err := cachedb.Open(ctx)
+	require.NoError(t, err)
+
+	addr := "address"
+	u1 := &user{
+		Id:      "u1",
+		Address: addr,
+	}
+	at1 := &authtokens.AuthToken{
+		Id:     "at_1",
+		Token:  "at_1_token",
+		UserId: u1.Id,
+	}
+	kt1 := KeyringToken{
+		KeyringType: "k1",
+		TokenName:   "t1",
+		AuthTokenId: at1.Id,
+	}
+	at2 := &authtokens.AuthToken{
+		Id:     "at_2",
+		Token:  "at_2_token",
+		UserId: "u2",
+	}
+	kt2 := KeyringToken{
+		KeyringType: "k2",
+		TokenName:   "t2",
+		AuthTokenId: at2.Id,
+	}
+	atMap := map[ringToken]*authtokens.AuthToken{
+		{"k1", "t1"}: at1,
+		{"k2", "t2"}: at2,
+	}
+	r, err := NewRepository(ctx, s, &sync.Map{}, mapBasedAuthTokenKeyringLookup(atMap), sliceBasedAuthTokenBoundaryReader(maps.Values(atMap)))
+	require.NoError(t, err)
+	require.NoError(t, r.AddKeyringToken(ctx, addr, kt1))
+	require.NoError(t, r.AddKeyringToken(ctx, addr, kt2))
+
+	t.Run("auth token id is missing", func(t *testing.T) {
+		l, err := r.ListAliases(ctx, "")
+		assert.Nil(t, l)
+		assert.ErrorContains(t, err, "auth token id is missing")
+	})
+
+	as := []*aliases.Alias{alias("1"), alias("2"), alias("3")}
+	require.NoError(t, r.refreshAliases(ctx, u1, map[AuthToken]string{{Id: "id"}: "something"},
+		WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, as))))
+
+	t.Run("wrong user gets no aliases", func(t *testing.T) {
+		l, err := r.ListAliases(ctx, kt2.AuthTokenId)
+		assert.NoError(t, err)
+		assert.Empty(t, l)
+	})
+	t.Run("correct token gets aliases", func(t *testing.T) {
+		l, err := r.ListAliases(ctx, kt1.AuthTokenId)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, l, as)
+	})
+}
+
+func TestRepository_QueryAliases(t *testing.T) {
+	ctx := context.Background()
+	s, err := cachedb.Open(ctx)
+	require.NoError(t, err)
+
+	addr := "address"
+	u1 := &user{
+		Id:      "u1",
+		Address: addr,
+	}
+	at1 := &authtokens.AuthToken{
+		Id:     "at_1",
+		Token:  "at_1_token",
+		UserId: u1.Id,
+	}
+	kt1 := KeyringToken{
+		KeyringType: "k1",
+		TokenName:   "t1",
+		AuthTokenId: at1.Id,
+	}
+	at2 := &authtokens.AuthToken{
+		Id:     "at_2",
+		Token:  "at_2_token",
+		UserId: "u2",
+	}
+	kt2 := KeyringToken{
+		KeyringType: "k2",
+		TokenName:   "t2",
+		AuthTokenId: at2.Id,
+	}
+	atMap := map[ringToken]*authtokens.AuthToken{
+		{"k1", "t1"}: at1,
+		{"k2", "t2"}: at2,
+	}
+	r, err := NewRepository(ctx, s, &sync.Map{}, mapBasedAuthTokenKeyringLookup(atMap), sliceBasedAuthTokenBoundaryReader(maps.Values(atMap)))
+	require.NoError(t, err)
+	require.NoError(t, r.AddKeyringToken(ctx, addr, kt1))
+	require.NoError(t, r.AddKeyringToken(ctx, addr, kt2))
+
+	query := `value % "value_1" or destination_id = "target_2"`
+
+	errorCases := []struct {
+		name        string
+		t           string
+		query       string
+		errContains string
+	}{
+		{
+			name:        "auth token id is missing",
+			t:           "",
+			query:       query,
+			errContains: "auth token id is missing",
+		},
+		{
+			name:        "query is missing",
+			t:           "token id",
+			errContains: "query is missing",
+		},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l, err := r.QueryAliases(ctx, tc.t, tc.query)
+			assert.Nil(t, l)
+			assert.ErrorContains(t, err, tc.errContains)
+		})
+	}
+
+	as := []*aliases.Alias{alias("1"), alias("2"), alias("3")}
+	require.NoError(t, r.refreshAliases(ctx, u1, map[AuthToken]string{{Id: "id"}: "something"},
+		WithAliasRetrievalFunc(testStaticAliasRetrievalFunc(t, as))))
+
+	t.Run("wrong token gets no aliases", func(t *testing.T) {
+		l, err := r.QueryAliases(ctx, kt2.AuthTokenId, query)
+		assert.NoError(t, err)
+		assert.Empty(t, l)
+	})
+	t.Run("correct token gets aliases", func(t *testing.T) {
+		l, err := r.QueryAliases(ctx, kt1.AuthTokenId, query)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, l, as[0:2])
+	})
+	t.Run("lookup by value", func(t *testing.T) {
+		a, err := r.LookupAliasByValue(ctx, kt1.AuthTokenId, "value_3")
+		assert.NoError(t, err)
+		assert.Equal(t, as[2], a)
+
+		a, err = r.LookupAliasByValue(ctx, kt2.AuthTokenId, "value_3")
+		assert.NoError(t, err)
+		assert.Nil(t, a)
+	})
+}
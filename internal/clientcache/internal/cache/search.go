@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/boundary/api/aliases"
 	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -21,11 +22,12 @@ const (
 	Unknown  SearchableResource = "unknown"
 	Targets  SearchableResource = "targets"
 	Sessions SearchableResource = "sessions"
+	Aliases  SearchableResource = "aliases"
 )
 
 func (r SearchableResource) Valid() bool {
 	switch r {
-	case Targets, Sessions:
+	case Targets, Sessions, Aliases:
 		return true
 	}
 	return false
@@ -37,13 +39,15 @@ func ToSearchableResource(s string) SearchableResource {
 		return Targets
 	case strings.EqualFold(s, string(Sessions)):
 		return Sessions
+	case strings.EqualFold(s, string(Aliases)):
+		return Aliases
 	}
 	return Unknown
 }
 
 // SearchParams contains the parameters for searching in the cache
 type SearchParams struct {
-	// the name of the resource. eg. "targets" or "sessions"
+	// the name of the resource. eg. "targets", "sessions", or "aliases"
 	Resource SearchableResource
 	// the auth token id for the user id that has resources synced to the cache
 	AuthTokenId string
@@ -57,6 +61,7 @@ type SearchParams struct {
 type SearchResult struct {
 	Targets  []*targets.Target
 	Sessions []*sessions.Session
+	Aliases  []*aliases.Alias
 }
 
 // SearchService is a domain service that can search across all resources in the
@@ -89,6 +94,13 @@ func NewSearchService(ctx context.Context, repo *Repository) (*SearchService, er
 					return &SearchResult{Sessions: s}
 				},
 			},
+			Aliases: &resourceSearchFns[*aliases.Alias]{
+				list:  repo.ListAliases,
+				query: repo.QueryAliases,
+				searchResult: func(a []*aliases.Alias) *SearchResult {
+					return &SearchResult{Aliases: a}
+				},
+			},
 		},
 	}, nil
 }
@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package daemon
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/clientcache/internal/cache"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/util"
+	"github.com/miekg/dns"
+)
+
+const (
+	// DefaultInterceptAddressRange is the default range of loopback addresses
+	// that names resolved by the daemon's DNS resolver are given.
+	DefaultInterceptAddressRange = "127.100.0.0/16"
+
+	// resolvedNameTtl is the ttl, in seconds, of the records returned by the
+	// resolver. It is kept short so clients notice when an alias goes away.
+	resolvedNameTtl = 30
+)
+
+// aliasResolveFn returns the cached alias with the provided value, or nil if
+// the user the resolver acts for cannot resolve it.
+type aliasResolveFn func(ctx context.Context, value string) (*cache.ResolvedAlias, error)
+
+// sessionProxyFn proxies the provided connection to the destination of the
+// provided alias. It returns once the connection is done.
+type sessionProxyFn func(ctx context.Context, ra *cache.ResolvedAlias, conn net.Conn) error
+
+// aliasResolver is a DNS server which answers queries for the values of cached
+// aliases with loopback addresses. Each address it hands out is listened on
+// using the port of the alias's destination target, and connections accepted
+// there are proxied to the target through a newly authorized session. Only
+// connections made by the user running the resolver are proxied, since the
+// sessions are authorized with that user's auth token.
+type aliasResolver struct {
+	listenAddr string
+	addrRange  netip.Prefix
+	resolveFn  aliasResolveFn
+	proxyFn    sessionProxyFn
+	uid        int
+
+	ctx        context.Context
+	dnsServers []*dns.Server
+	wg         *sync.WaitGroup
+
+	mu         sync.Mutex
+	nextAddr   netip.Addr
+	intercepts map[string]*intercept
+}
+
+// intercept is a listener for the connections made to a resolved name.
+type intercept struct {
+	addrPort netip.AddrPort
+	listener net.Listener
+}
+
+func newAliasResolver(ctx context.Context, listenAddr, addrRange string, resolveFn aliasResolveFn, proxyFn sessionProxyFn) (*aliasResolver, error) {
+	const op = "daemon.newAliasResolver"
+	switch {
+	case listenAddr == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing listen address")
+	case addrRange == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing intercept address range")
+	case util.IsNil(resolveFn):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing alias resolve function")
+	case util.IsNil(proxyFn):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session proxy function")
+	}
+	prefix, err := netip.ParsePrefix(addrRange)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+	prefix = prefix.Masked()
+	if !prefix.Addr().Is4() || !prefix.Addr().IsLoopback() {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("intercept address range %q is not an IPv4 loopback range", addrRange))
+	}
+	return &aliasResolver{
+		listenAddr: listenAddr,
+		addrRange:  prefix,
+		resolveFn:  resolveFn,
+		proxyFn:    proxyFn,
+		uid:        os.Getuid(),
+		wg:         new(sync.WaitGroup),
+		nextAddr:   prefix.Addr().Next(),
+		intercepts: make(map[string]*intercept),
+	}, nil
+}
+
+// start starts serving DNS over udp and tcp on the resolver's listen address.
+// Serving stops when shutdown is called.
+func (r *aliasResolver) start(ctx context.Context) error {
+	const op = "daemon.(aliasResolver).start"
+	r.ctx = ctx
+	pc, err := net.ListenPacket("udp", r.listenAddr)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	l, err := net.Listen("tcp", pc.LocalAddr().String())
+	if err != nil {
+		pc.Close()
+		return errors.Wrap(ctx, err, op)
+	}
+	r.dnsServers = []*dns.Server{
+		{PacketConn: pc, Handler: r},
+		{Listener: l, Handler: r},
+	}
+	for _, srv := range r.dnsServers {
+		r.wg.Add(1)
+		go func(srv *dns.Server) {
+			defer r.wg.Done()
+			if err := srv.ActivateAndServe(); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("dns server stopped"))
+			}
+		}(srv)
+	}
+	return nil
+}
+
+// addr returns the address the resolver is serving DNS on.
+func (r *aliasResolver) addr() string {
+	if len(r.dnsServers) == 0 {
+		return r.listenAddr
+	}
+	return r.dnsServers[0].PacketConn.LocalAddr().String()
+}
+
+// shutdown stops serving DNS and closes the listeners of all resolved names.
+// It waits for in flight proxied connections to finish.
+func (r *aliasResolver) shutdown(ctx context.Context) error {
+	const op = "daemon.(aliasResolver).shutdown"
+	var retErr error
+	for _, srv := range r.dnsServers {
+		if err := srv.ShutdownContext(ctx); err != nil {
+			retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op))
+		}
+	}
+	r.mu.Lock()
+	for name, in := range r.intercepts {
+		in.listener.Close()
+		delete(r.intercepts, name)
+	}
+	r.mu.Unlock()
+	r.wg.Wait()
+	return retErr
+}
+
+// ServeDNS implements dns.Handler. Names which are the value of a cached alias
+// resolve to the loopback address assigned to them. All other names are
+// reported as not existing.
+func (r *aliasResolver) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	const op = "daemon.(aliasResolver).ServeDNS"
+	m := new(dns.Msg)
+	m.SetReply(req)
+	m.Authoritative = true
+	for _, q := range req.Question {
+		name := strings.ToLower(strings.TrimSuffix(q.Name, "."))
+		in, err := r.interceptFor(r.ctx, name)
+		switch {
+		case err != nil:
+			event.WriteError(r.ctx, op, err, event.WithInfoMsg("resolving name", "name", name))
+			m.Rcode = dns.RcodeServerFailure
+		case in == nil:
+			m.Rcode = dns.RcodeNameError
+		case q.Qtype == dns.TypeA:
+			m.Answer = append(m.Answer, &dns.A{
+				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: resolvedNameTtl},
+				A:   in.addrPort.Addr().AsSlice(),
+			})
+		}
+	}
+	if err := w.WriteMsg(m); err != nil {
+		event.WriteError(r.ctx, op, err, event.WithInfoMsg("writing dns response"))
+	}
+}
+
+// interceptFor returns the intercept for the provided name, starting to
+// listen for it if needed. Nil is returned if the name is not the value of a
+// cached alias.
+func (r *aliasResolver) interceptFor(ctx context.Context, name string) (*intercept, error) {
+	const op = "daemon.(aliasResolver).interceptFor"
+	ra, err := r.resolveFn(ctx, name)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if ra == nil {
+		return nil, nil
+	}
+	port := targetPort(ra.Target)
+	if port == 0 {
+		return nil, errors.New(ctx, errors.NotFound, op, fmt.Sprintf("no port is known for the destination of alias %q", name))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	in, ok := r.intercepts[name]
+	switch {
+	case ok && in.addrPort.Port() == port:
+		return in, nil
+	case ok:
+		// The destination's port changed, so listen on the same address
+		// using the new port.
+		in.listener.Close()
+		delete(r.intercepts, name)
+	}
+
+	var addr netip.Addr
+	switch {
+	case in != nil:
+		addr = in.addrPort.Addr()
+	default:
+		addr = r.nextAddr
+		if !r.addrRange.Contains(addr) {
+			return nil, errors.New(ctx, errors.Internal, op, "intercept address range is exhausted")
+		}
+		r.nextAddr = addr.Next()
+	}
+	addrPort := netip.AddrPortFrom(addr, port)
+	l, err := net.Listen("tcp", addrPort.String())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	in = &intercept{addrPort: addrPort, listener: l}
+	r.intercepts[name] = in
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.accept(ctx, name, l)
+	}()
+	return in, nil
+}
+
+// accept proxies the connections accepted on the provided listener until it
+// is closed.
+func (r *aliasResolver) accept(ctx context.Context, name string, l net.Listener) {
+	const op = "daemon.(aliasResolver).accept"
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("accepting connection", "name", name))
+			}
+			return
+		}
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			defer conn.Close()
+			switch uid, err := peerUid(conn); {
+			case err != nil:
+				event.WriteError(ctx, op, err, event.WithInfoMsg("identifying connecting user", "name", name))
+				return
+			case uid != r.uid:
+				event.WriteSysEvent(ctx, op, "rejecting connection from another user", "name", name, "uid", uid)
+				return
+			}
+			ra, err := r.resolveFn(ctx, name)
+			switch {
+			case err != nil:
+				event.WriteError(ctx, op, err, event.WithInfoMsg("resolving name", "name", name))
+				return
+			case ra == nil:
+				event.WriteSysEvent(ctx, op, "name is no longer resolvable", "name", name)
+				return
+			}
+			if err := r.proxyFn(ctx, ra, conn); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("proxying connection", "name", name))
+			}
+		}()
+	}
+}
+
+// targetPort returns the port clients use to connect to the provided target,
+// or 0 if it is not known.
+func targetPort(t *targets.Target) uint16 {
+	if t == nil {
+		return 0
+	}
+	for _, k := range []string{"default_client_port", "default_port"} {
+		if p, ok := t.Attributes[k].(float64); ok && p > 0 && p <= 65535 {
+			return uint16(p)
+		}
+	}
+	return 0
+}
+
+// defaultSessionProxyFn returns a sessionProxyFn which authorizes a session
+// using the alias and the auth token of the resolved alias and proxies the
+// connection through that session.
+func defaultSessionProxyFn(ctx context.Context, cp ClientProvider) (sessionProxyFn, error) {
+	const op = "daemon.defaultSessionProxyFn"
+	switch {
+	case util.IsNil(cp):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "client provider is nil")
+	}
+	return func(ctx context.Context, ra *cache.ResolvedAlias, conn net.Conn) error {
+		c, err := cp.Client(base.WithNoTokenValue())
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		c.SetAddr(ra.BoundaryAddr)
+		c.SetToken(ra.AuthToken)
+		sar, err := targets.NewClient(c).AuthorizeSession(ctx, ra.Alias.Value)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		sa := sar.GetItem().(*targets.SessionAuthorization)
+		if sa.SessionId == "" {
+			return errors.New(ctx, errors.Forbidden, op, "target requires approval before a session can be authorized")
+		}
+
+		proxyCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		p, err := proxy.New(proxyCtx, sa.AuthorizationToken, proxy.WithListener(newSingleConnListener(conn, cancel)))
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := p.Start(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		return nil
+	}, nil
+}
+
+// singleConnListener is a net.Listener which returns a single connection that
+// has already been accepted. Closing that connection calls the provided done
+// function, which allows a proxy serving the listener to stop once the
+// connection is finished.
+type singleConnListener struct {
+	conns     chan net.Conn
+	addr      net.Addr
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+var _ net.Listener = (*singleConnListener)(nil)
+
+func newSingleConnListener(conn net.Conn, done func()) *singleConnListener {
+	l := &singleConnListener{
+		conns:  make(chan net.Conn, 1),
+		addr:   conn.LocalAddr(),
+		closed: make(chan struct{}),
+	}
+	l.conns <- &doneConn{Conn: conn, done: done}
+	return l
+}
+
+// Accept returns the listener's connection the first time it is called. Later
+// calls block until the listener is closed.
+func (l *singleConnListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *singleConnListener) Close() error {
+	l.closeOnce.Do(func() { close(l.closed) })
+	return nil
+}
+
+func (l *singleConnListener) Addr() net.Addr {
+	return l.addr
+}
+
+// doneConn is a net.Conn which calls done once it is closed.
+type doneConn struct {
+	net.Conn
+	done     func()
+	doneOnce sync.Once
+}
+
+func (c *doneConn) Close() error {
+	err := c.Conn.Close()
+	c.doneOnce.Do(c.done)
+	return err
+}
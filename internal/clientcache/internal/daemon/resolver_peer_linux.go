@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+// +build linux
+
+package daemon
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	stderrors "errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// peerUidSupported reports whether peerUid can identify the owner of the
+// other end of a loopback connection on this platform.
+const peerUidSupported = true
+
+// procNetTcpFiles are the files listing the tcp sockets known to the kernel.
+var procNetTcpFiles = []string{"/proc/net/tcp", "/proc/net/tcp6"}
+
+// peerUid returns the uid of the user owning the socket on the other end of
+// the provided loopback tcp connection.
+func peerUid(conn net.Conn) (int, error) {
+	local, err := netip.ParseAddrPort(conn.LocalAddr().String())
+	if err != nil {
+		return -1, fmt.Errorf("parsing local address: %w", err)
+	}
+	remote, err := netip.ParseAddrPort(conn.RemoteAddr().String())
+	if err != nil {
+		return -1, fmt.Errorf("parsing remote address: %w", err)
+	}
+	local = netip.AddrPortFrom(local.Addr().Unmap(), local.Port())
+	remote = netip.AddrPortFrom(remote.Addr().Unmap(), remote.Port())
+	for _, f := range procNetTcpFiles {
+		// The peer's socket has the connection's addresses reversed.
+		uid, found, err := socketUid(f, remote, local)
+		if err != nil {
+			return -1, err
+		}
+		if found {
+			return uid, nil
+		}
+	}
+	return -1, stderrors.New("peer socket not found")
+}
+
+// socketUid returns the uid of the owner of the socket in the provided
+// /proc/net/tcp formatted file with the provided local and remote addresses.
+func socketUid(path string, local, remote netip.AddrPort) (int, bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if stderrors.Is(err, fs.ErrNotExist) {
+			return -1, false, nil
+		}
+		return -1, false, err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	// Skip the header line
+	s.Scan()
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) < 8 {
+			continue
+		}
+		l, err := parseProcNetAddr(fields[1])
+		if err != nil || l != local {
+			continue
+		}
+		r, err := parseProcNetAddr(fields[2])
+		if err != nil || r != remote {
+			continue
+		}
+		uid, err := strconv.Atoi(fields[7])
+		if err != nil {
+			return -1, false, fmt.Errorf("parsing socket uid: %w", err)
+		}
+		return uid, true, nil
+	}
+	return -1, false, s.Err()
+}
+
+// parseProcNetAddr parses an address as formatted in /proc/net/tcp, where the
+// address is printed as hex encoded 32 bit words in host byte order and the
+// port as a hex encoded number.
+func parseProcNetAddr(s string) (netip.AddrPort, error) {
+	addrHex, portHex, ok := strings.Cut(s, ":")
+	if !ok {
+		return netip.AddrPort{}, fmt.Errorf("malformed address %q", s)
+	}
+	port, err := strconv.ParseUint(portHex, 16, 16)
+	if err != nil {
+		return netip.AddrPort{}, err
+	}
+	b, err := hex.DecodeString(addrHex)
+	if err != nil {
+		return netip.AddrPort{}, err
+	}
+	if len(b) != net.IPv4len && len(b) != net.IPv6len {
+		return netip.AddrPort{}, fmt.Errorf("malformed address %q", s)
+	}
+	for i := 0; i < len(b); i += 4 {
+		binary.NativeEndian.PutUint32(b[i:], binary.BigEndian.Uint32(b[i:]))
+	}
+	addr, _ := netip.AddrFromSlice(b)
+	return netip.AddrPortFrom(addr.Unmap(), uint16(port)), nil
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+// +build linux
+
+package daemon
+
+import (
+	"net"
+	"net/netip"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProcNetAddr(t *testing.T) {
+	cases := []struct {
+		in          string
+		want        netip.AddrPort
+		errContains string
+	}{
+		{in: "0100007F:0CEA", want: netip.MustParseAddrPort("127.0.0.1:3306")},
+		{in: "0000000000000000FFFF00000100007F:0CEA", want: netip.MustParseAddrPort("127.0.0.1:3306")},
+		{in: "00000000000000000000000001000000:0016", want: netip.MustParseAddrPort("[::1]:22")},
+		{in: "0100007F", errContains: "malformed address"},
+		{in: "0100:0016", errContains: "malformed address"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := parseProcNetAddr(tc.in)
+			if tc.errContains != "" {
+				assert.ErrorContains(t, err, tc.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPeerUid(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	client, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+	defer client.Close()
+	server, err := l.Accept()
+	require.NoError(t, err)
+	defer server.Close()
+
+	uid, err := peerUid(server)
+	require.NoError(t, err)
+	assert.Equal(t, os.Getuid(), uid)
+}
@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !linux
+// +build !linux
+
+package daemon
+
+import (
+	stderrors "errors"
+	"net"
+)
+
+// peerUidSupported reports whether peerUid can identify the owner of the
+// other end of a loopback connection on this platform.
+const peerUidSupported = false
+
+// peerUid always fails on this platform, so no intercepted connection is ever
+// proxied.
+func peerUid(net.Conn) (int, error) {
+	return -1, stderrors.New("identifying the owner of a connection is not supported on this platform")
+}
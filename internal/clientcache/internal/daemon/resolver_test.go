@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package daemon
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/netip"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/aliases"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/clientcache/internal/cache"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAliasResolver(t *testing.T) {
+	ctx := context.Background()
+	resolveFn := func(context.Context, string) (*cache.ResolvedAlias, error) { return nil, nil }
+	proxyFn := func(context.Context, *cache.ResolvedAlias, net.Conn) error { return nil }
+
+	cases := []struct {
+		name        string
+		listenAddr  string
+		addrRange   string
+		resolveFn   aliasResolveFn
+		proxyFn     sessionProxyFn
+		errContains string
+	}{
+		{
+			name:        "missing listen address",
+			addrRange:   DefaultInterceptAddressRange,
+			resolveFn:   resolveFn,
+			proxyFn:     proxyFn,
+			errContains: "missing listen address",
+		},
+		{
+			name:        "missing address range",
+			listenAddr:  "127.0.0.1:0",
+			resolveFn:   resolveFn,
+			proxyFn:     proxyFn,
+			errContains: "missing intercept address range",
+		},
+		{
+			name:        "missing resolve fn",
+			listenAddr:  "127.0.0.1:0",
+			addrRange:   DefaultInterceptAddressRange,
+			proxyFn:     proxyFn,
+			errContains: "missing alias resolve function",
+		},
+		{
+			name:        "missing proxy fn",
+			listenAddr:  "127.0.0.1:0",
+			addrRange:   DefaultInterceptAddressRange,
+			resolveFn:   resolveFn,
+			errContains: "missing session proxy function",
+		},
+		{
+			name:        "not loopback",
+			listenAddr:  "127.0.0.1:0",
+			addrRange:   "10.0.0.0/8",
+			resolveFn:   resolveFn,
+			proxyFn:     proxyFn,
+			errContains: "is not an IPv4 loopback range",
+		},
+		{
+			name:       "valid",
+			listenAddr: "127.0.0.1:0",
+			addrRange:  DefaultInterceptAddressRange,
+			resolveFn:  resolveFn,
+			proxyFn:    proxyFn,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := newAliasResolver(ctx, tc.listenAddr, tc.addrRange, tc.resolveFn, tc.proxyFn)
+			if tc.errContains != "" {
+				assert.ErrorContains(t, err, tc.errContains)
+				assert.Nil(t, r)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, netip.MustParseAddr("127.100.0.1"), r.nextAddr)
+		})
+	}
+}
+
+func TestAliasResolver(t *testing.T) {
+	if !peerUidSupported {
+		t.Skip("identifying the owner of a connection is not supported on this platform")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Find a port that is free to use for the alias destinations
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.NoError(t, l.Close())
+
+	resolved := map[string]*cache.ResolvedAlias{}
+	for _, v := range []string{"prod-db.boundary", "other.boundary"} {
+		resolved[v] = &cache.ResolvedAlias{
+			Alias:        &aliases.Alias{Id: "alt_1234567890", Value: v, DestinationId: "ttcp_1234567890"},
+			Target:       &targets.Target{Id: "ttcp_1234567890", Attributes: map[string]any{"default_port": float64(port)}},
+			BoundaryAddr: "address",
+			AuthToken:    "token",
+		}
+	}
+	resolved["no-port.boundary"] = &cache.ResolvedAlias{
+		Alias: &aliases.Alias{Id: "alt_0987654321", Value: "no-port.boundary"},
+	}
+	resolveFn := func(_ context.Context, value string) (*cache.ResolvedAlias, error) {
+		return resolved[value], nil
+	}
+	proxied := make(chan *cache.ResolvedAlias, 1)
+	proxyFn := func(_ context.Context, ra *cache.ResolvedAlias, conn net.Conn) error {
+		proxied <- ra
+		_, err := io.Copy(conn, conn)
+		return err
+	}
+
+	r, err := newAliasResolver(ctx, "127.0.0.1:0", DefaultInterceptAddressRange, resolveFn, proxyFn)
+	require.NoError(t, err)
+	require.NoError(t, r.start(ctx))
+	t.Cleanup(func() {
+		cancel()
+		assert.NoError(t, r.shutdown(context.Background()))
+	})
+
+	query := func(t *testing.T, name string) *dns.Msg {
+		t.Helper()
+		m := new(dns.Msg)
+		m.SetQuestion(dns.Fqdn(name), dns.TypeA)
+		resp, _, err := new(dns.Client).Exchange(m, r.addr())
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("unknown name", func(t *testing.T) {
+		resp := query(t, "unknown.boundary")
+		assert.Equal(t, dns.RcodeNameError, resp.Rcode)
+		assert.Empty(t, resp.Answer)
+	})
+	t.Run("no known port", func(t *testing.T) {
+		resp := query(t, "no-port.boundary")
+		assert.Equal(t, dns.RcodeServerFailure, resp.Rcode)
+		assert.Empty(t, resp.Answer)
+	})
+	t.Run("resolves and proxies", func(t *testing.T) {
+		resp := query(t, "Prod-DB.boundary")
+		require.Equal(t, dns.RcodeSuccess, resp.Rcode)
+		require.Len(t, resp.Answer, 1)
+		a, ok := resp.Answer[0].(*dns.A)
+		require.True(t, ok)
+		assert.Equal(t, "127.100.0.1", a.A.String())
+
+		// The same name keeps its address
+		resp = query(t, "prod-db.boundary")
+		require.Len(t, resp.Answer, 1)
+		assert.Equal(t, "127.100.0.1", resp.Answer[0].(*dns.A).A.String())
+
+		// A different name is given the next address
+		resp = query(t, "other.boundary")
+		require.Len(t, resp.Answer, 1)
+		assert.Equal(t, "127.100.0.2", resp.Answer[0].(*dns.A).A.String())
+
+		conn, err := net.Dial("tcp", netip.AddrPortFrom(netip.MustParseAddr("127.100.0.1"), uint16(port)).String())
+		require.NoError(t, err)
+		defer conn.Close()
+		_, err = conn.Write([]byte("hello"))
+		require.NoError(t, err)
+		buf := make([]byte, 5)
+		_, err = io.ReadFull(conn, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(buf))
+		assert.Equal(t, resolved["prod-db.boundary"], <-proxied)
+	})
+}
+
+func TestAliasResolver_RejectsOtherUsers(t *testing.T) {
+	if !peerUidSupported {
+		t.Skip("identifying the owner of a connection is not supported on this platform")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.NoError(t, l.Close())
+
+	ra := &cache.ResolvedAlias{
+		Alias:        &aliases.Alias{Id: "alt_1234567890", Value: "prod-db.boundary", DestinationId: "ttcp_1234567890"},
+		Target:       &targets.Target{Id: "ttcp_1234567890", Attributes: map[string]any{"default_port": float64(port)}},
+		BoundaryAddr: "address",
+		AuthToken:    "token",
+	}
+	resolveFn := func(context.Context, string) (*cache.ResolvedAlias, error) {
+		return ra, nil
+	}
+	proxied := make(chan struct{}, 1)
+	proxyFn := func(context.Context, *cache.ResolvedAlias, net.Conn) error {
+		proxied <- struct{}{}
+		return nil
+	}
+
+	r, err := newAliasResolver(ctx, "127.0.0.1:0", DefaultInterceptAddressRange, resolveFn, proxyFn)
+	require.NoError(t, err)
+	// Act as if the resolver is run by some other user
+	r.uid = os.Getuid() + 1
+	require.NoError(t, r.start(ctx))
+	t.Cleanup(func() {
+		cancel()
+		assert.NoError(t, r.shutdown(context.Background()))
+	})
+
+	m := new(dns.Msg)
+	m.SetQuestion(dns.Fqdn("prod-db.boundary"), dns.TypeA)
+	resp, _, err := new(dns.Client).Exchange(m, r.addr())
+	require.NoError(t, err)
+	require.Len(t, resp.Answer, 1)
+
+	addr, ok := netip.AddrFromSlice(resp.Answer[0].(*dns.A).A.To4())
+	require.True(t, ok)
+
+	conn, err := net.Dial("tcp", netip.AddrPortFrom(addr, uint16(port)).String())
+	require.NoError(t, err)
+	defer conn.Close()
+	// The connection is closed without being proxied
+	_, err = conn.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Empty(t, proxied)
+}
+
+func TestSingleConnListener(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+
+	var done bool
+	l := newSingleConnListener(server, func() { done = true })
+	c, err := l.Accept()
+	require.NoError(t, err)
+
+	accepted := make(chan error)
+	go func() {
+		_, err := l.Accept()
+		accepted <- err
+	}()
+	require.NoError(t, l.Close())
+	assert.ErrorIs(t, <-accepted, net.ErrClosed)
+
+	assert.False(t, done)
+	require.NoError(t, c.Close())
+	assert.True(t, done)
+}
@@ -11,6 +11,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/aliases"
 	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/clientcache/internal/cache"
@@ -23,6 +24,7 @@ import (
 type SearchResult struct {
 	Targets  []*targets.Target   `json:"targets,omitempty"`
 	Sessions []*sessions.Session `json:"sessions,omitempty"`
+	Aliases  []*aliases.Alias    `json:"aliases,omitempty"`
 }
 
 const (
@@ -134,6 +136,7 @@ func toApiResult(sr *cache.SearchResult) *SearchResult {
 	return &SearchResult{
 		Targets:  sr.Targets,
 		Sessions: sr.Sessions,
+		Aliases:  sr.Aliases,
 	}
 }
 
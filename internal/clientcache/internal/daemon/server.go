@@ -88,6 +88,18 @@ type Config struct {
 	// The maximum amount of time a refresh should block a search request from
 	// completeing before it times out.
 	MaxSearchRefreshTimeout time.Duration
+	// If set, the address on which to serve DNS for the values of cached
+	// aliases. Connections to the addresses it resolves are proxied to the
+	// alias's target.
+	DnsListenAddress string
+	// The keyring type and token name of the auth token used to resolve
+	// aliases and authorize sessions for names resolved through DNS. Both are
+	// required when DnsListenAddress is set.
+	DnsKeyringType string
+	DnsTokenName   string
+	// The loopback address range from which names resolved through DNS are
+	// given addresses. Defaults to DefaultInterceptAddressRange.
+	InterceptAddressRange string
 }
 
 func (sc *Config) validate(ctx context.Context) error {
@@ -105,6 +117,12 @@ func (sc *Config) validate(ctx context.Context) error {
 		return errors.New(ctx, errors.InvalidParameter, op, "negative recheck support interval")
 	case sc.MaxSearchStaleness < 0:
 		return errors.New(ctx, errors.InvalidParameter, op, "negative max search staleness")
+	case sc.InterceptAddressRange != "" && sc.DnsListenAddress == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "intercept address range set without a dns listen address")
+	case sc.DnsListenAddress != "" && (sc.DnsKeyringType == "" || sc.DnsTokenName == ""):
+		return errors.New(ctx, errors.InvalidParameter, op, "dns listen address set without a keyring token")
+	case sc.DnsListenAddress != "" && !peerUidSupported:
+		return errors.New(ctx, errors.InvalidParameter, op, "serving dns for aliases is not supported on this platform")
 	}
 	return nil
 }
@@ -256,13 +274,45 @@ func (s *CacheServer) Serve(ctx context.Context, cmd Commander, opt ...Option) e
 		ticOptions = append(ticOptions, withRecheckSupportInterval(ctx, s.conf.RecheckSupportInterval))
 	}
 
-	s.printInfo(ctx)
-
 	repo, err := cache.NewRepository(ctx, s.store, &sync.Map{}, cmd.ReadTokenFromKeyring, opts.withBoundaryTokenReaderFunc)
 	if err != nil {
 		return errors.Wrap(ctx, err, op)
 	}
 
+	if s.conf.DnsListenAddress != "" {
+		interceptAddressRange := DefaultInterceptAddressRange
+		if s.conf.InterceptAddressRange != "" {
+			interceptAddressRange = s.conf.InterceptAddressRange
+		}
+		proxyFn, err := defaultSessionProxyFn(ctx, cmd)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		resolveFn := func(ctx context.Context, value string) (*cache.ResolvedAlias, error) {
+			return repo.ResolveAlias(ctx, s.conf.DnsKeyringType, s.conf.DnsTokenName, value)
+		}
+		resolver, err := newAliasResolver(ctx, s.conf.DnsListenAddress, interceptAddressRange, resolveFn, proxyFn)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := resolver.start(ctx); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		defer func() {
+			shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer shutdownCancel()
+			if err := resolver.shutdown(shutdownCtx); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("shutting down dns resolver"))
+			}
+		}()
+		s.info["DNS listening address"] = resolver.addr()
+		s.infoKeys = append(s.infoKeys, "DNS listening address")
+		s.info["Intercept address range"] = interceptAddressRange
+		s.infoKeys = append(s.infoKeys, "Intercept address range")
+	}
+
+	s.printInfo(ctx)
+
 	refreshService, err := cache.NewRefreshService(ctx, repo, maxSearchStaleness, maxSearchRefreshTimeout)
 	if err != nil {
 		return errors.Wrap(ctx, err, op)
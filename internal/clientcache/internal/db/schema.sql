@@ -17,14 +17,15 @@ create table if not exists user (
 create table if not exists resource_type_enm(
   string text not null primary key
     constraint only_predefined_resource_types_allowed
-    check(string in ('unknown', 'target', 'session'))
+    check(string in ('unknown', 'target', 'session', 'alias'))
 );
 
 insert into resource_type_enm (string)
 values
   ('unknown'),
   ('target'),
-  ('session');
+  ('session'),
+  ('alias');
 
 -- Contains refresh tokens for list requests sent by the client daemon to the
 -- boundary instance.
@@ -155,6 +156,29 @@ create table if not exists session (
   primary key (fk_user_id, id)
 );
 
+-- alias contains cached boundary alias resources which resolve for a specific
+-- user and with specific fields extracted to facilitate searching over those
+-- fields
+create table if not exists alias (
+  -- the boundary user id of the user who is able to resolve this alias
+  fk_user_id text not null
+    references user(id)
+    on delete cascade,
+  -- the boundary id of the alias
+  id text not null
+    check (length(id) > 0),
+  -- the following fields are used for searching and are set to the values
+  -- from the boundary resource
+  type text,
+  value text,
+  destination_id text,
+  host_id text,
+  -- item is the json representation of this resource from the perspective of
+  -- the the requesting user.
+  item text,
+  primary key (fk_user_id, id)
+);
+
 -- contains errors from the last attempt to sync data from boundary for a
 -- specific resource type
 create table if not exists api_error (
@@ -668,8 +668,31 @@ func (b *Server) RegisterPlugin(ctx context.Context, name string, hostClient plg
 			if err := hpRepo.AddSupportFlag(ctx, plg, plugin.PluginTypeStorage); err != nil {
 				return nil, err
 			}
+		case plugin.PluginTypeCredential:
+			if err := hpRepo.AddSupportFlag(ctx, plg, plugin.PluginTypeCredential); err != nil {
+				return nil, err
+			}
 		}
 	}
 
 	return plg, nil
 }
+
+// RegisterCredentialPlugin creates a plugin in the database if not present,
+// flags it as a credential plugin, and registers the plugin in the shared map
+// of running credential plugins. credClient must not be nil.
+func (b *Server) RegisterCredentialPlugin(ctx context.Context, name string, credClient plgpb.CredentialPluginServiceClient, opt ...plugin.Option) (*plugin.Plugin, error) {
+	const op = "base.(Server).RegisterCredentialPlugin"
+	if util.IsNil(credClient) {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no credential client provided when initializing credential plugin")
+	}
+	plg, err := b.RegisterPlugin(ctx, name, nil, []plugin.PluginType{plugin.PluginTypeCredential}, opt...)
+	if err != nil {
+		return nil, err
+	}
+	if b.CredentialPlugins == nil {
+		b.CredentialPlugins = make(map[string]plgpb.CredentialPluginServiceClient)
+	}
+	b.CredentialPlugins[plg.GetPublicId()] = credClient
+	return plg, nil
+}
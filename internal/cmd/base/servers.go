@@ -126,8 +126,9 @@ type Server struct {
 	DevTargetSessionConnectionLimit  int
 	DevLoopbackPluginId              string
 
-	EnabledPlugins []EnabledPlugin
-	HostPlugins    map[string]plgpb.HostPluginServiceClient
+	EnabledPlugins    []EnabledPlugin
+	HostPlugins       map[string]plgpb.HostPluginServiceClient
+	CredentialPlugins map[string]plgpb.CredentialPluginServiceClient
 
 	DevOidcSetup oidcSetup
 	DevLdapSetup ldapSetup
@@ -6,6 +6,7 @@ package cmd
 import (
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/cmd/commands/accountscmd"
+	"github.com/hashicorp/boundary/internal/cmd/commands/aliasescmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/authenticate"
 	"github.com/hashicorp/boundary/internal/cmd/commands/authmethodscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/authtokenscmd"
@@ -52,6 +53,7 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			return &server.Command{
 				Server:    base.NewServer(base.NewServerCommand(serverCmdUi)),
 				SighupCh:  base.MakeSighupCh(),
+				SigUSR1Ch: MakeSigUSR1Ch(),
 				SigUSR2Ch: MakeSigUSR2Ch(),
 			}, nil
 		},
@@ -59,6 +61,7 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			return &dev.Command{
 				Server:    base.NewServer(base.NewServerCommand(serverCmdUi)),
 				SighupCh:  base.MakeSighupCh(),
+				SigUSR1Ch: MakeSigUSR1Ch(),
 				SigUSR2Ch: MakeSigUSR2Ch(),
 			}, nil
 		},
@@ -84,6 +87,14 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			&authenticate.LdapCommand{
 				Command: base.NewCommand(ui, opts...),
 			}),
+		"authenticate jwt": clientCacheWrapper(
+			&authenticate.JwtCommand{
+				Command: base.NewCommand(ui, opts...),
+			}),
+		"authenticate saml": clientCacheWrapper(
+			&authenticate.SamlCommand{
+				Command: base.NewCommand(ui, opts...),
+			}),
 
 		"accounts": func() (cli.Command, error) {
 			return &accountscmd.Command{
@@ -156,6 +167,47 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}),
 
+		"aliases": func() (cli.Command, error) {
+			return &aliasescmd.Command{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+		"aliases read": clientCacheWrapper(
+			&aliasescmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "read",
+			}),
+		"aliases delete": clientCacheWrapper(
+			&aliasescmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "delete",
+			}),
+		"aliases list": clientCacheWrapper(
+			&aliasescmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "list",
+			}),
+		"aliases create": clientCacheWrapper(
+			&aliasescmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
+		"aliases create target": clientCacheWrapper(
+			&aliasescmd.TargetCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
+		"aliases update": clientCacheWrapper(
+			&aliasescmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
+		"aliases update target": clientCacheWrapper(
+			&aliasescmd.TargetCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
+
 		"auth-methods": func() (cli.Command, error) {
 			return &authmethodscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -196,6 +248,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "create",
 			}),
+		"auth-methods create jwt": clientCacheWrapper(
+			&authmethodscmd.JwtCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
+		"auth-methods create saml": clientCacheWrapper(
+			&authmethodscmd.SamlCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
 		"auth-methods update": clientCacheWrapper(
 			&authmethodscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -216,11 +278,36 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "update",
 			}),
+		"auth-methods update jwt": clientCacheWrapper(
+			&authmethodscmd.JwtCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
+		"auth-methods update saml": clientCacheWrapper(
+			&authmethodscmd.SamlCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
 		"auth-methods change-state oidc": clientCacheWrapper(
 			&authmethodscmd.OidcCommand{
 				Command: base.NewCommand(ui, opts...),
 				Func:    "change-state",
 			}),
+		"auth-methods scim-token": func() (cli.Command, error) {
+			return &authmethodscmd.ScimTokenCommand{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+		"auth-methods scim-token generate": clientCacheWrapper(
+			&authmethodscmd.ScimTokenCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "generate",
+			}),
+		"auth-methods scim-token revoke": clientCacheWrapper(
+			&authmethodscmd.ScimTokenCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "revoke",
+			}),
 
 		"auth-tokens": func() (cli.Command, error) {
 			return &authtokenscmd.Command{
@@ -372,6 +459,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "create",
 			}),
+		"credential-libraries create ssh-ca": clientCacheWrapper(
+			&credentiallibrariescmd.SshCaCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
+		"credential-libraries create postgres": clientCacheWrapper(
+			&credentiallibrariescmd.PostgresCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
 		"credential-libraries update": clientCacheWrapper(
 			&credentiallibrariescmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -392,6 +489,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "update",
 			}),
+		"credential-libraries update ssh-ca": clientCacheWrapper(
+			&credentiallibrariescmd.SshCaCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
+		"credential-libraries update postgres": clientCacheWrapper(
+			&credentiallibrariescmd.PostgresCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
 
 		"credential-stores": func() (cli.Command, error) {
 			return &credentialstorescmd.Command{
@@ -413,6 +520,21 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "list",
 			}),
+		"credential-stores list-leases": clientCacheWrapper(
+			&credentialstorescmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "list-leases",
+			}),
+		"credential-stores renew-lease": clientCacheWrapper(
+			&credentialstorescmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "renew-lease",
+			}),
+		"credential-stores revoke-lease": clientCacheWrapper(
+			&credentialstorescmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "revoke-lease",
+			}),
 		"credential-stores create": clientCacheWrapper(
 			&credentialstorescmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -428,6 +550,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "create",
 			}),
+		"credential-stores create ssh-ca": clientCacheWrapper(
+			&credentialstorescmd.SshCaCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
+		"credential-stores create postgres": clientCacheWrapper(
+			&credentialstorescmd.PostgresCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
 		"credential-stores update": clientCacheWrapper(
 			&credentialstorescmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -443,6 +575,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "update",
 			}),
+		"credential-stores update ssh-ca": clientCacheWrapper(
+			&credentialstorescmd.SshCaCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
+		"credential-stores update postgres": clientCacheWrapper(
+			&credentialstorescmd.PostgresCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
 
 		"credentials": func() (cli.Command, error) {
 			return &credentialscmd.Command{
@@ -459,6 +601,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "delete",
 			}),
+		"credentials check-in": clientCacheWrapper(
+			&credentialscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "check-in",
+			}),
 		"credentials list": clientCacheWrapper(
 			&credentialscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -764,6 +911,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "create",
 			}),
+		"managed-groups create jwt": clientCacheWrapper(
+			&managedgroupscmd.JwtCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
+		"managed-groups create saml": clientCacheWrapper(
+			&managedgroupscmd.SamlCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
 		"managed-groups update": clientCacheWrapper(
 			&managedgroupscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -779,6 +936,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "update",
 			}),
+		"managed-groups update jwt": clientCacheWrapper(
+			&managedgroupscmd.JwtCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
+		"managed-groups update saml": clientCacheWrapper(
+			&managedgroupscmd.SamlCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
 
 		"policies": func() (cli.Command, error) {
 			return &policiescmd.Command{
@@ -995,6 +1162,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "cancel",
 			}),
+		"sessions approve-shadow": clientCacheWrapper(
+			&sessionscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "approve-shadow",
+			}),
 
 		"session-recordings": func() (cli.Command, error) {
 			return &sessionrecordingscmd.Command{
@@ -1096,6 +1268,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "create",
 			}),
+		"targets create udp": clientCacheWrapper(
+			&targetscmd.UdpCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
 		"targets update": clientCacheWrapper(
 			&targetscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -1111,6 +1288,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "update",
 			}),
+		"targets update udp": clientCacheWrapper(
+			&targetscmd.UdpCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
 		"targets add-host-sources": clientCacheWrapper(
 			&targetscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -1141,6 +1323,21 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "set-credential-sources",
 			}),
+		"targets list-access-requests": clientCacheWrapper(
+			&targetscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "list-access-requests",
+			}),
+		"targets approve-access-request": clientCacheWrapper(
+			&targetscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "approve-access-request",
+			}),
+		"targets deny-access-request": clientCacheWrapper(
+			&targetscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "deny-access-request",
+			}),
 
 		"update": func() (cli.Command, error) {
 			return &genericcmd.Command{
@@ -1194,6 +1391,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "remove-accounts",
 			}),
+		"users explain-permissions": clientCacheWrapper(
+			&userscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "explain-permissions",
+			}),
 
 		"workers": func() (cli.Command, error) {
 			return &workerscmd.Command{
@@ -1250,6 +1452,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "remove-worker-tags",
 			}),
+		"workers drain": clientCacheWrapper(
+			&workerscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "drain",
+			}),
 		"workers certificate-authority": clientCacheWrapper(
 			&workerscmd.WorkerCACommand{
 				Command: base.NewCommand(ui, opts...),
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package aliasescmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/aliases"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary aliases [sub command] [options] [args]",
+			"",
+			"  This command allows operations on Boundary alias resources. Example:",
+			"",
+			"    Read an alias:",
+			"",
+			`      $ boundary aliases read -id alt_1234567890`,
+			"",
+			"  Please see the aliases subcommand help for detailed usage information.",
+		})
+	case "create":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary aliases create [type] [sub command] [options] [args]",
+			"",
+			"  This command allows create operations on Boundary alias resources. Example:",
+			"",
+			"    Create a target-type alias:",
+			"",
+			`      $ boundary aliases create target -value prod-db.example -destination-id ttcp_1234567890`,
+			"",
+			"  Please see the typed subcommand help for detailed usage information.",
+		})
+	case "update":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary aliases update [type] [sub command] [options] [args]",
+			"",
+			"  This command allows update operations on Boundary alias resources. Example:",
+			"",
+			"    Update a target-type alias:",
+			"",
+			`      $ boundary aliases update target -id alt_1234567890 -destination-id ttcp_0987654321`,
+			"",
+			"  Please see the typed subcommand help for detailed usage information.",
+		})
+	default:
+		helpStr = helpMap[c.Func]()
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func (c *Command) printListTable(items []*aliases.Alias) string {
+	if len(items) == 0 {
+		return "No aliases found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Alias information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		if item.Id != "" {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", item.Id),
+			)
+		} else {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", "(not available)"),
+			)
+		}
+		if item.Version > 0 {
+			output = append(output,
+				fmt.Sprintf("    Version:             %d", item.Version),
+			)
+		}
+		if item.Type != "" {
+			output = append(output,
+				fmt.Sprintf("    Type:                %s", item.Type),
+			)
+		}
+		if item.Name != "" {
+			output = append(output,
+				fmt.Sprintf("    Name:                %s", item.Name),
+			)
+		}
+		if item.Description != "" {
+			output = append(output,
+				fmt.Sprintf("    Description:         %s", item.Description),
+			)
+		}
+		if item.Value != "" {
+			output = append(output,
+				fmt.Sprintf("    Value:               %s", item.Value),
+			)
+		}
+		if item.DestinationId != "" {
+			output = append(output,
+				fmt.Sprintf("    Destination ID:      %s", item.DestinationId),
+			)
+		}
+		if item.HostId != "" {
+			output = append(output,
+				fmt.Sprintf("    Host ID:             %s", item.HostId),
+			)
+		}
+		if len(item.AuthorizedActions) > 0 {
+			output = append(output,
+				"    Authorized Actions:",
+				base.WrapSlice(6, item.AuthorizedActions),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func printItemTable(item *aliases.Alias, _ *api.Response) string {
+	nonAttributeMap := map[string]any{}
+	if item.Id != "" {
+		nonAttributeMap["ID"] = item.Id
+	}
+	if item.Version != 0 {
+		nonAttributeMap["Version"] = item.Version
+	}
+	if item.Type != "" {
+		nonAttributeMap["Type"] = item.Type
+	}
+	if item.Name != "" {
+		nonAttributeMap["Name"] = item.Name
+	}
+	if item.Description != "" {
+		nonAttributeMap["Description"] = item.Description
+	}
+	if item.Value != "" {
+		nonAttributeMap["Value"] = item.Value
+	}
+	if item.DestinationId != "" {
+		nonAttributeMap["Destination ID"] = item.DestinationId
+	}
+	if item.HostId != "" {
+		nonAttributeMap["Host ID"] = item.HostId
+	}
+	if !item.CreatedTime.IsZero() {
+		nonAttributeMap["Created Time"] = item.CreatedTime.Local().Format(time.RFC1123)
+	}
+	if !item.UpdatedTime.IsZero() {
+		nonAttributeMap["Updated Time"] = item.UpdatedTime.Local().Format(time.RFC1123)
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Alias information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if item.Scope != nil {
+		ret = append(ret,
+			"",
+			"  Scope:",
+			base.ScopeInfoForOutput(item.Scope, maxLength),
+		)
+	}
+
+	if len(item.AuthorizedActions) > 0 {
+		ret = append(ret,
+			"",
+			"  Authorized Actions:",
+			base.WrapSlice(4, item.AuthorizedActions),
+		)
+	}
+
+	return base.WrapForHelpText(ret)
+}
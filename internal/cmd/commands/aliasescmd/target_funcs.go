@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package aliasescmd
+
+import (
+	"github.com/hashicorp/boundary/api/aliases"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/posener/complete"
+)
+
+func init() {
+	extraTargetActionsFlagsMapFunc = extraTargetActionsFlagsMapFuncImpl
+	extraTargetFlagsFunc = extraTargetFlagsFuncImpl
+	extraTargetFlagsHandlingFunc = extraTargetFlagsHandlingFuncImpl
+}
+
+type extraTargetCmdVars struct {
+	flagValue         string
+	flagDestinationId string
+	flagHostId        string
+}
+
+func (c *TargetCommand) extraTargetHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary aliases create target [options] [args]",
+			"",
+			"  Create a target-type alias. Example:",
+			"",
+			`    $ boundary aliases create target -value prod-db.example -destination-id ttcp_1234567890 -host-id hst_1234567890`,
+			"",
+			"",
+		})
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary aliases update target [options] [args]",
+			"",
+			"  Update a target-type alias given its ID. Example:",
+			"",
+			`    $ boundary aliases update target -id alt_1234567890 -host-id null`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraTargetActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {"value", "destination-id", "host-id"},
+		"update": {"value", "destination-id", "host-id"},
+	}
+}
+
+func extraTargetFlagsFuncImpl(c *TargetCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("Target Alias Options")
+
+	for _, name := range flagsTargetMap[c.Func] {
+		switch name {
+		case "value":
+			f.StringVar(&base.StringVar{
+				Name:   "value",
+				Target: &c.flagValue,
+				Usage:  "The DNS-style name which resolves to the destination, e.g. prod-db.example.",
+			})
+		case "destination-id":
+			f.StringVar(&base.StringVar{
+				Name:       "destination-id",
+				Target:     &c.flagDestinationId,
+				Completion: complete.PredictAnything,
+				Usage:      "The ID of the target the alias resolves to.",
+			})
+		case "host-id":
+			f.StringVar(&base.StringVar{
+				Name:       "host-id",
+				Target:     &c.flagHostId,
+				Completion: complete.PredictAnything,
+				Usage:      "The ID of the host of the destination sessions are authorized to when no host is requested.",
+			})
+		}
+	}
+}
+
+func extraTargetFlagsHandlingFuncImpl(c *TargetCommand, _ *base.FlagSets, opts *[]aliases.Option) bool {
+	switch c.flagValue {
+	case "":
+	case "null":
+		*opts = append(*opts, aliases.DefaultValue())
+	default:
+		*opts = append(*opts, aliases.WithValue(c.flagValue))
+	}
+
+	switch c.flagDestinationId {
+	case "":
+	case "null":
+		*opts = append(*opts, aliases.DefaultDestinationId())
+	default:
+		*opts = append(*opts, aliases.WithDestinationId(c.flagDestinationId))
+	}
+
+	switch c.flagHostId {
+	case "":
+	case "null":
+		*opts = append(*opts, aliases.DefaultHostId())
+	default:
+		*opts = append(*opts, aliases.WithHostId(c.flagHostId))
+	}
+
+	return true
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package authenticate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+const envJwt = "BOUNDARY_AUTHENTICATE_JWT"
+
+var (
+	_ cli.Command             = (*JwtCommand)(nil)
+	_ cli.CommandAutocomplete = (*JwtCommand)(nil)
+)
+
+type JwtCommand struct {
+	*base.Command
+
+	flagJwt    string
+	parsedOpts base.Options
+}
+
+func (c *JwtCommand) Synopsis() string {
+	return wordwrap.WrapString("Invoke the jwt auth method to authenticate with Boundary", base.TermWidth)
+}
+
+func (c *JwtCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary authenticate jwt [options] [args]",
+		"",
+		"  Invoke the jwt auth method to authenticate the Boundary CLI with a signed JWT, such as a Kubernetes service account token or a CI identity token. Example:",
+		"",
+		`    $ boundary authenticate jwt -auth-method-id amjwt_1234567890 -jwt file:///var/run/secrets/tokens/boundary`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *JwtCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "jwt",
+		Target: &c.flagJwt,
+		EnvVar: envJwt,
+		Usage:  `The JWT to present to the auth method. This can refer to a file on disk (file://) or an env var (env://) from which the JWT will be read. If set to "-", the JWT will be read from stdin.`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "auth-method-id",
+		EnvVar: "BOUNDARY_AUTH_METHOD_ID",
+		Target: &c.FlagAuthMethodId,
+		Usage:  "The auth-method resource to use for the operation.",
+	})
+
+	if !c.parsedOpts.WithSkipScopeIdFlag {
+		f.StringVar(&base.StringVar{
+			Name:   "scope-id",
+			EnvVar: "BOUNDARY_SCOPE_ID",
+			Target: &c.FlagScopeId,
+			Usage:  "The scope ID to use for the operation.",
+		})
+	}
+
+	return set
+}
+
+func (c *JwtCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *JwtCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *JwtCommand) Run(args []string) int {
+	c.parsedOpts = base.GetOpts(c.Opts...)
+
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch c.flagJwt {
+	case "":
+		c.UI.Error("A JWT must be provided via -jwt or the " + envJwt + " env var")
+		return base.CommandUserError
+
+	case "-":
+		value, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error reading JWT from stdin: %v", err))
+			return base.CommandUserError
+		}
+		c.flagJwt = strings.TrimSpace(string(value))
+
+	default:
+		token, err := parseutil.ParsePath(c.flagJwt)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing jwt flag: %v", err))
+			return base.CommandUserError
+		}
+		c.flagJwt = strings.TrimSpace(token)
+	}
+
+	client, err := c.Client(base.WithNoTokenScope(), base.WithNoTokenValue())
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	aClient := authmethods.NewClient(client)
+
+	// if auth method ID isn't passed on the CLI, try looking up the primary auth method ID
+	if c.FlagAuthMethodId == "" {
+		// if flag for scope is empty try looking up global
+		if c.FlagScopeId == "" {
+			c.FlagScopeId = scope.Global.String()
+		}
+
+		pri, err := getPrimaryAuthMethodId(c.Context, aClient, c.FlagScopeId, globals.JwtAuthMethodPrefix)
+		if err != nil {
+			c.PrintCliError(err)
+			return base.CommandUserError
+		}
+
+		c.FlagAuthMethodId = pri
+	}
+
+	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "login",
+		map[string]any{
+			"token": c.flagJwt,
+		})
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when performing authentication")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to perform authentication: %w", err))
+		return base.CommandCliError
+	}
+
+	return saveAndOrPrintToken(c.Command, result, c.Opts...)
+}
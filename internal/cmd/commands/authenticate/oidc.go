@@ -30,6 +30,8 @@ type OidcCommand struct {
 	*base.Command
 
 	parsedOpts base.Options
+
+	flagDevice bool
 }
 
 func (c *OidcCommand) Synopsis() string {
@@ -44,6 +46,10 @@ func (c *OidcCommand) Help() string {
 		"",
 		`    $ boundary authenticate oidc -auth-method-id amoidc_1234567890`,
 		"",
+		"  On machines without a browser, use -device to authenticate with a code on another device:",
+		"",
+		`    $ boundary authenticate oidc -auth-method-id amoidc_1234567890 -device`,
+		"",
 		"",
 	}) + c.Flags().Help()
 }
@@ -59,6 +65,12 @@ func (c *OidcCommand) Flags() *base.FlagSets {
 		Usage:  "The auth-method resource to use for the operation",
 	})
 
+	f.BoolVar(&base.BoolVar{
+		Name:   "device",
+		Target: &c.flagDevice,
+		Usage:  "If set, use the OIDC device authorization grant instead of opening a browser. A URL and a code are printed for the user to enter on another device. The provider must support the device authorization grant.",
+	})
+
 	if !c.parsedOpts.WithSkipScopeIdFlag {
 		f.StringVar(&base.StringVar{
 			Name:   "scope-id",
@@ -118,7 +130,11 @@ func (c *OidcCommand) Run(args []string) int {
 		c.FlagAuthMethodId = pri
 	}
 
-	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "start", nil)
+	var startAttrs map[string]any
+	if c.flagDevice {
+		startAttrs = map[string]any{"device_flow": true}
+	}
+	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "start", startAttrs)
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr != nil {
 			c.PrintApiError(apiErr, "Error from controller when performing authentication start")
@@ -134,14 +150,28 @@ func (c *OidcCommand) Run(args []string) int {
 		return base.CommandCliError
 	}
 
-	if base.Format(c.UI) == "table" {
-		c.UI.Output("Opening returned authentication URL in your browser...")
-		c.UI.Output(startResp.AuthUrl)
-	}
-	if err := util.OpenURL(startResp.AuthUrl); err != nil {
-		c.UI.Error(fmt.Errorf("Unable to open authentication URL in browser: %w", err).Error())
-		c.UI.Warn("Please copy and paste this link into a browser manually:")
-		c.UI.Output(startResp.AuthUrl)
+	pollInterval := 1500 * time.Millisecond
+	switch {
+	case c.flagDevice:
+		// The user may be on another device, so the instructions are
+		// printed regardless of the output format.
+		c.UI.Warn(fmt.Sprintf("To authenticate, visit %s and enter the code: %s", startResp.VerificationUri, startResp.UserCode))
+		if startResp.VerificationUriComplete != "" {
+			c.UI.Warn(fmt.Sprintf("Or visit: %s", startResp.VerificationUriComplete))
+		}
+		if startResp.Interval > 0 {
+			pollInterval = time.Duration(startResp.Interval) * time.Second
+		}
+	default:
+		if base.Format(c.UI) == "table" {
+			c.UI.Output("Opening returned authentication URL in your browser...")
+			c.UI.Output(startResp.AuthUrl)
+		}
+		if err := util.OpenURL(startResp.AuthUrl); err != nil {
+			c.UI.Error(fmt.Errorf("Unable to open authentication URL in browser: %w", err).Error())
+			c.UI.Warn("Please copy and paste this link into a browser manually:")
+			c.UI.Output(startResp.AuthUrl)
+		}
 	}
 
 	var watchCode int
@@ -156,7 +186,7 @@ func (c *OidcCommand) Run(args []string) int {
 				watchCode = base.CommandCliError
 				return
 
-			case <-time.After(1500 * time.Millisecond):
+			case <-time.After(pollInterval):
 				result, err = aClient.Authenticate(c.Context, c.FlagAuthMethodId, "token", map[string]any{
 					"token_id": startResp.TokenId,
 				})
@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package authmethodscmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraJwtActionsFlagsMapFunc = extraJwtActionsFlagsMapFuncImpl
+	extraJwtFlagsFunc = extraJwtFlagsFuncImpl
+	extraJwtFlagsHandlingFunc = extraJwtFlagHandlingFuncImpl
+}
+
+type extraJwtCmdVars struct {
+	flagState                   string
+	flagIssuer                  string
+	flagJwksUrl                 string
+	flagJwksCaCerts             []string
+	flagJwtValidationPublicKeys []string
+	flagBoundAudiences          []string
+	flagSigningAlgorithms       []string
+	flagBoundClaims             string
+	flagSubjectClaim            string
+	flagFullNameClaim           string
+	flagEmailClaim              string
+}
+
+const (
+	jwksUrlFlagName                = "jwks-url"
+	jwksCaCertFlagName             = "jwks-ca-cert"
+	jwtValidationPublicKeyFlagName = "jwt-validation-public-key"
+	boundAudienceFlagName          = "bound-audience"
+	boundClaimsFlagName            = "bound-claims"
+	subjectClaimFlagName           = "subject-claim"
+	fullNameClaimFlagName          = "full-name-claim"
+	emailClaimFlagName             = "email-claim"
+)
+
+func extraJwtActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			issuerFlagName,
+			jwksUrlFlagName,
+			jwksCaCertFlagName,
+			jwtValidationPublicKeyFlagName,
+			boundAudienceFlagName,
+			signingAlgorithmFlagName,
+			boundClaimsFlagName,
+			subjectClaimFlagName,
+			fullNameClaimFlagName,
+			emailClaimFlagName,
+			stateFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraJwtFlagsFuncImpl(c *JwtCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("JWT Auth Method Options")
+
+	for _, name := range flagsJwtMap[c.Func] {
+		switch name {
+		case issuerFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   issuerFlagName,
+				Target: &c.flagIssuer,
+				Usage:  `The value the issuer ("iss") claim of presented JWTs must match.`,
+			})
+		case jwksUrlFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   jwksUrlFlagName,
+				Target: &c.flagJwksUrl,
+				Usage:  "The JSON Web Key Set URL used to fetch the keys that verify JWT signatures. Cannot be used along with -jwt-validation-public-key.",
+			})
+		case jwksCaCertFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   jwksCaCertFlagName,
+				Target: &c.flagJwksCaCerts,
+				Usage:  "Optional PEM-encoded X.509 CA certificate that can be used as trust anchors when fetching the JSON Web Key Set. Can refer to a file on disk (file://) or an env var (env://). May be specified multiple times.",
+			})
+		case jwtValidationPublicKeyFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   jwtValidationPublicKeyFlagName,
+				Target: &c.flagJwtValidationPublicKeys,
+				Usage:  "A PEM-encoded public key used to verify JWT signatures. Can refer to a file on disk (file://) or an env var (env://). Cannot be used along with -jwks-url. May be specified multiple times.",
+			})
+		case boundAudienceFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   boundAudienceFlagName,
+				Target: &c.flagBoundAudiences,
+				Usage:  `An audience, one of which the audience ("aud") claim of presented JWTs must contain. At least one is required to authenticate with the auth method. May be specified multiple times.`,
+			})
+		case signingAlgorithmFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   signingAlgorithmFlagName,
+				Target: &c.flagSigningAlgorithms,
+				Usage:  "The allowed signing algorithm. May be specified multiple times for multiple values.",
+			})
+		case boundClaimsFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   boundClaimsFlagName,
+				Target: &c.flagBoundClaims,
+				Usage:  `A JSON object of claims presented JWTs must have, for example '{"env": ["prod", "stage"]}'. A list value matches when the claim equals any of its entries. Can refer to a file on disk (file://) or an env var (env://).`,
+			})
+		case subjectClaimFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   subjectClaimFlagName,
+				Target: &c.flagSubjectClaim,
+				Usage:  `The claim that uniquely identifies the account of a presented JWT. Defaults to "sub".`,
+			})
+		case fullNameClaimFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   fullNameClaimFlagName,
+				Target: &c.flagFullNameClaim,
+				Usage:  "The optional claim an account's full name is read from.",
+			})
+		case emailClaimFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   emailClaimFlagName,
+				Target: &c.flagEmailClaim,
+				Usage:  "The optional claim an account's email is read from.",
+			})
+		case stateFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   stateFlagName,
+				Target: &c.flagState,
+				Usage:  "The desired operational state of the auth method.",
+			})
+		}
+	}
+}
+
+func (c *JwtCommand) extraJwtHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods create jwt [options] [args]",
+			"",
+			"  Create a jwt-type auth method. Example:",
+			"",
+			`    $ boundary auth-methods create jwt -name ci -issuer "https://token.actions.githubusercontent.com" -jwks-url "https://token.actions.githubusercontent.com/.well-known/jwks" -bound-audience boundary`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods update jwt [options] [args]",
+			"",
+			"  Update a jwt-type auth method given its ID. Example:",
+			"",
+			`    $ boundary auth-methods update jwt -id amjwt_1234567890 -state active-public`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraJwtFlagHandlingFuncImpl(c *JwtCommand, _ *base.FlagSets, opts *[]authmethods.Option) bool {
+	switch c.flagIssuer {
+	case "":
+	case "null":
+		c.UI.Error("Issuer is required, you cannot set it to null")
+		return false
+	default:
+		*opts = append(*opts, authmethods.WithJwtAuthMethodIssuer(c.flagIssuer))
+	}
+	switch c.flagJwksUrl {
+	case "":
+	case "null":
+		*opts = append(*opts, authmethods.DefaultJwtAuthMethodJwksUrl())
+	default:
+		*opts = append(*opts, authmethods.WithJwtAuthMethodJwksUrl(c.flagJwksUrl))
+	}
+	switch {
+	case len(c.flagJwksCaCerts) == 0:
+	case len(c.flagJwksCaCerts) == 1 && c.flagJwksCaCerts[0] == "null":
+		*opts = append(*opts, authmethods.DefaultJwtAuthMethodJwksCaCerts())
+	default:
+		pems := make([]string, 0, len(c.flagJwksCaCerts))
+		for _, certFlag := range c.flagJwksCaCerts {
+			p, err := parseutil.ParsePath(certFlag)
+			if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+				c.UI.Error(fmt.Sprintf("Error parsing jwks ca certificate flag: %v", err))
+				return false
+			}
+			if validationErr := validateCerts(p); validationErr != nil {
+				c.UI.Error(fmt.Sprintf("invalid jwks ca certificate in %q: %s", certFlag, validationErr.Error()))
+				return false
+			}
+			pems = append(pems, p)
+		}
+		*opts = append(*opts, authmethods.WithJwtAuthMethodJwksCaCerts(pems))
+	}
+	switch {
+	case len(c.flagJwtValidationPublicKeys) == 0:
+	case len(c.flagJwtValidationPublicKeys) == 1 && c.flagJwtValidationPublicKeys[0] == "null":
+		*opts = append(*opts, authmethods.DefaultJwtAuthMethodJwtValidationPublicKeys())
+	default:
+		pems := make([]string, 0, len(c.flagJwtValidationPublicKeys))
+		for _, keyFlag := range c.flagJwtValidationPublicKeys {
+			p, err := parseutil.ParsePath(keyFlag)
+			if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+				c.UI.Error(fmt.Sprintf("Error parsing jwt validation public key flag: %v", err))
+				return false
+			}
+			pems = append(pems, p)
+		}
+		*opts = append(*opts, authmethods.WithJwtAuthMethodJwtValidationPublicKeys(pems))
+	}
+	switch {
+	case len(c.flagBoundAudiences) == 0:
+	case len(c.flagBoundAudiences) == 1 && c.flagBoundAudiences[0] == "null":
+		*opts = append(*opts, authmethods.DefaultJwtAuthMethodBoundAudiences())
+	default:
+		*opts = append(*opts, authmethods.WithJwtAuthMethodBoundAudiences(c.flagBoundAudiences))
+	}
+	switch {
+	case len(c.flagSigningAlgorithms) == 0:
+	case len(c.flagSigningAlgorithms) == 1 && c.flagSigningAlgorithms[0] == "null":
+		*opts = append(*opts, authmethods.DefaultJwtAuthMethodSigningAlgorithms())
+	default:
+		*opts = append(*opts, authmethods.WithJwtAuthMethodSigningAlgorithms(c.flagSigningAlgorithms))
+	}
+	switch c.flagBoundClaims {
+	case "":
+	case "null":
+		*opts = append(*opts, authmethods.DefaultJwtAuthMethodBoundClaims())
+	default:
+		raw, err := parseutil.ParsePath(c.flagBoundClaims)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing bound claims flag: %v", err))
+			return false
+		}
+		var claims map[string]any
+		if err := json.Unmarshal([]byte(raw), &claims); err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing bound claims as a JSON object: %v", err))
+			return false
+		}
+		*opts = append(*opts, authmethods.WithJwtAuthMethodBoundClaims(claims))
+	}
+	switch c.flagSubjectClaim {
+	case "":
+	case "null":
+		c.UI.Error("Subject claim is required, you cannot set it to null")
+		return false
+	default:
+		*opts = append(*opts, authmethods.WithJwtAuthMethodSubjectClaim(c.flagSubjectClaim))
+	}
+	switch c.flagFullNameClaim {
+	case "":
+	case "null":
+		*opts = append(*opts, authmethods.DefaultJwtAuthMethodFullNameClaim())
+	default:
+		*opts = append(*opts, authmethods.WithJwtAuthMethodFullNameClaim(c.flagFullNameClaim))
+	}
+	switch c.flagEmailClaim {
+	case "":
+	case "null":
+		*opts = append(*opts, authmethods.DefaultJwtAuthMethodEmailClaim())
+	default:
+		*opts = append(*opts, authmethods.WithJwtAuthMethodEmailClaim(c.flagEmailClaim))
+	}
+	switch c.flagState {
+	case "":
+		// there is a default value during "create", so it's okay to not
+		// specify a state
+	case "null":
+		c.UI.Error("State is required, you cannot set it to null")
+		return false
+	default:
+		*opts = append(*opts, authmethods.WithJwtAuthMethodState(c.flagState))
+	}
+	return true
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package authmethodscmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraSamlActionsFlagsMapFunc = extraSamlActionsFlagsMapFuncImpl
+	extraSamlFlagsFunc = extraSamlFlagsFuncImpl
+	extraSamlFlagsHandlingFunc = extraSamlFlagHandlingFuncImpl
+}
+
+type extraSamlCmdVars struct {
+	flagState                string
+	flagApiUrlPrefix         string
+	flagIdpMetadataXml       string
+	flagSpEntityId           string
+	flagAccountAttributeMaps []string
+}
+
+const (
+	idpMetadataXmlFlagName       = "idp-metadata-xml"
+	spEntityIdFlagName           = "sp-entity-id"
+	accountAttributeMapsFlagName = "account-attribute-maps"
+)
+
+func extraSamlActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			apiUrlPrefixFlagName,
+			idpMetadataXmlFlagName,
+			spEntityIdFlagName,
+			accountAttributeMapsFlagName,
+			stateFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraSamlFlagsFuncImpl(c *SamlCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("SAML Auth Method Options")
+
+	for _, name := range flagsSamlMap[c.Func] {
+		switch name {
+		case apiUrlPrefixFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   apiUrlPrefixFlagName,
+				Target: &c.flagApiUrlPrefix,
+				Usage:  "The URL prefix of the controller, which the assertion consumer service URL the IdP posts SAML responses to is built from.",
+			})
+		case idpMetadataXmlFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   idpMetadataXmlFlagName,
+				Target: &c.flagIdpMetadataXml,
+				Usage:  "The SAML metadata document of the IdP, which includes its entity ID, single sign-on URL and signing certificates. Can refer to a file on disk (file://) or an env var (env://).",
+			})
+		case spEntityIdFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   spEntityIdFlagName,
+				Target: &c.flagSpEntityId,
+				Usage:  "The optional entity ID of Boundary as a service provider. Defaults to the assertion consumer service URL.",
+			})
+		case accountAttributeMapsFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   accountAttributeMapsFlagName,
+				Target: &c.flagAccountAttributeMaps,
+				Usage:  `The optional account attribute maps from assertion attributes to the account fields of sub, name and email. These maps are represented as key=value where the key equals the IdP's attribute name and the value equals the Boundary account field. For example "mail=email". May be specified multiple times for different account fields.`,
+			})
+		case stateFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   stateFlagName,
+				Target: &c.flagState,
+				Usage:  "The desired operational state of the auth method.",
+			})
+		}
+	}
+}
+
+func (c *SamlCommand) extraSamlHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods create saml [options] [args]",
+			"",
+			"  Create a saml-type auth method. Example:",
+			"",
+			`    $ boundary auth-methods create saml -name corp -api-url-prefix "https://boundary.example.com" -idp-metadata-xml file://idp-metadata.xml -account-attribute-maps mail=email`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods update saml [options] [args]",
+			"",
+			"  Update a saml-type auth method given its ID. Example:",
+			"",
+			`    $ boundary auth-methods update saml -id amsaml_1234567890 -state active-public`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraSamlFlagHandlingFuncImpl(c *SamlCommand, _ *base.FlagSets, opts *[]authmethods.Option) bool {
+	switch c.flagApiUrlPrefix {
+	case "":
+	case "null":
+		c.UI.Error("API URL prefix is required, you cannot set it to null")
+		return false
+	default:
+		*opts = append(*opts, authmethods.WithSamlAuthMethodApiUrlPrefix(c.flagApiUrlPrefix))
+	}
+	switch c.flagIdpMetadataXml {
+	case "":
+	case "null":
+		c.UI.Error("IdP metadata is required, you cannot set it to null")
+		return false
+	default:
+		md, err := parseutil.ParsePath(c.flagIdpMetadataXml)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing idp metadata flag: %v", err))
+			return false
+		}
+		*opts = append(*opts, authmethods.WithSamlAuthMethodIdpMetadataXml(md))
+	}
+	switch c.flagSpEntityId {
+	case "":
+	case "null":
+		*opts = append(*opts, authmethods.DefaultSamlAuthMethodSpEntityId())
+	default:
+		*opts = append(*opts, authmethods.WithSamlAuthMethodSpEntityId(c.flagSpEntityId))
+	}
+	switch {
+	case len(c.flagAccountAttributeMaps) == 0:
+	case len(c.flagAccountAttributeMaps) == 1 && c.flagAccountAttributeMaps[0] == "null":
+		*opts = append(*opts, authmethods.DefaultSamlAuthMethodAccountAttributeMaps())
+	default:
+		*opts = append(*opts, authmethods.WithSamlAuthMethodAccountAttributeMaps(c.flagAccountAttributeMaps))
+	}
+	switch c.flagState {
+	case "":
+		// there is a default value during "create", so it's okay to not
+		// specify a state
+	case "null":
+		c.UI.Error("State is required, you cannot set it to null")
+		return false
+	default:
+		*opts = append(*opts, authmethods.WithSamlAuthMethodState(c.flagState))
+	}
+	return true
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package authmethodscmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ScimTokenCommand)(nil)
+	_ cli.CommandAutocomplete = (*ScimTokenCommand)(nil)
+)
+
+type ScimTokenCommand struct {
+	*base.Command
+
+	Func string
+}
+
+func (c *ScimTokenCommand) Synopsis() string {
+	switch c.Func {
+	case "generate":
+		return wordwrap.WrapString("Generate the SCIM token of an auth method", base.TermWidth)
+	case "revoke":
+		return wordwrap.WrapString("Revoke the SCIM token of an auth method", base.TermWidth)
+	}
+	return wordwrap.WrapString("Manage the tokens SCIM clients use to provision users and groups into auth methods", base.TermWidth)
+}
+
+var flagsScimToken = map[string][]string{
+	"generate": {"id"},
+	"revoke":   {"id"},
+}
+
+func (c *ScimTokenCommand) Help() string {
+	switch c.Func {
+	case "generate":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods scim-token generate [options] [args]",
+			"",
+			"  Generate the token a SCIM client uses to provision users and groups into an auth method. Any existing token of the auth method is revoked. The token is only displayed once. Example:",
+			"",
+			`    $ boundary auth-methods scim-token generate -id ampw_1234567890`,
+			"",
+			"",
+		}) + c.Flags().Help()
+	case "revoke":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods scim-token revoke [options] [args]",
+			"",
+			"  Revoke the SCIM token of an auth method. Example:",
+			"",
+			`    $ boundary auth-methods scim-token revoke -id ampw_1234567890`,
+			"",
+			"",
+		}) + c.Flags().Help()
+	}
+	return base.WrapForHelpText([]string{
+		"Usage: boundary auth-methods scim-token [sub command] [options] [args]",
+		"",
+		"  This command allows management of the tokens SCIM clients use to provision users and groups into auth methods. Example:",
+		"",
+		"    Generate a SCIM token:",
+		"",
+		`      $ boundary auth-methods scim-token generate -id ampw_1234567890`,
+		"",
+		"  Please see the scim-token subcommand help for detailed usage information.",
+	})
+}
+
+func (c *ScimTokenCommand) Flags() *base.FlagSets {
+	if len(flagsScimToken[c.Func]) == 0 {
+		return c.FlagSet(base.FlagSetNone)
+	}
+
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	common.PopulateCommonFlags(c.Command, f, "auth method", flagsScimToken, c.Func)
+
+	return set
+}
+
+func (c *ScimTokenCommand) AutocompleteArgs() complete.Predictor {
+	initFlags()
+	return complete.PredictAnything
+}
+
+func (c *ScimTokenCommand) AutocompleteFlags() complete.Flags {
+	initFlags()
+	return c.Flags().Completions()
+}
+
+func (c *ScimTokenCommand) Run(args []string) int {
+	initFlags()
+
+	switch c.Func {
+	case "":
+		return cli.RunResultHelp
+	}
+
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+	if c.FlagId == "" {
+		c.PrintCliError(errors.New("ID is required but not passed in via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+	amClient := authmethods.NewClient(client)
+
+	var resp *api.Response
+	var item *authmethods.ScimToken
+	switch c.Func {
+	case "generate":
+		result, err := amClient.GenerateScimToken(c.Context, c.FlagId)
+		if exitCode := c.checkFuncError(err); exitCode > 0 {
+			return exitCode
+		}
+		resp = result.GetResponse()
+		item = result.GetItem()
+	case "revoke":
+		result, err := amClient.RevokeScimToken(c.Context, c.FlagId)
+		if exitCode := c.checkFuncError(err); exitCode > 0 {
+			return exitCode
+		}
+		resp = result.GetResponse()
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(resp); !ok {
+			return base.CommandCliError
+		}
+	case "table":
+		if item == nil {
+			c.UI.Output("The revoke operation completed successfully.")
+			break
+		}
+		c.UI.Output(printScimTokenTable(item))
+	}
+
+	return base.CommandSuccess
+}
+
+func (c *ScimTokenCommand) checkFuncError(err error) int {
+	if err == nil {
+		return 0
+	}
+	if apiErr := api.AsServerError(err); apiErr != nil {
+		c.PrintApiError(apiErr, fmt.Sprintf("Error from controller when performing %s on SCIM token", c.Func))
+		return base.CommandApiError
+	}
+	c.PrintCliError(fmt.Errorf("Error trying to %s SCIM token: %s", c.Func, err.Error()))
+	return base.CommandCliError
+}
+
+func printScimTokenTable(item *authmethods.ScimToken) string {
+	output := []string{
+		"",
+		"SCIM token information:",
+		fmt.Sprintf("  Auth Method ID:      %s", item.AuthMethodId),
+		fmt.Sprintf("  SCIM Path:           %s", item.ScimPath),
+		fmt.Sprintf("  Created Time:        %s", item.CreatedTime.Local().Format(time.RFC1123)),
+		fmt.Sprintf("  Token:               %s", item.Token),
+		"",
+		"  The token is only displayed once. Store it in the SCIM client now.",
+	}
+	return base.WrapForHelpText(output)
+}
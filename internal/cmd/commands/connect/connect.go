@@ -20,6 +20,7 @@ import (
 
 	"github.com/hashicorp/boundary/api"
 	apiproxy "github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/mitchellh/cli"
@@ -28,7 +29,13 @@ import (
 	exec "golang.org/x/sys/execabs"
 )
 
-const sessionCancelTimeout = 10 * time.Second
+const (
+	sessionCancelTimeout = 10 * time.Second
+
+	// shadowApprovalPollInterval is how often a pending shadow is checked for
+	// approval
+	shadowApprovalPollInterval = 2 * time.Second
+)
 
 type SessionInfo struct {
 	Address         string                       `json:"address"`
@@ -42,6 +49,7 @@ type SessionInfo struct {
 	TargetId        string                       `json:"-"`
 	HostId          string                       `json:"-"`
 	Credentials     []*targets.SessionCredential `json:"credentials,omitempty"`
+	ReverseAddress  string                       `json:"reverse_address,omitempty"`
 }
 
 type ConnectionInfo struct {
@@ -70,6 +78,16 @@ type Command struct {
 	flagUsername   string
 	flagDbname     string
 
+	flagAccessRequestId string
+
+	flagShadowSessionId string
+	flagReadWrite       bool
+
+	flagUdpFlowIdleTimeout time.Duration
+
+	flagReverse     bool
+	flagReverseAddr string
+
 	// HTTP
 	httpFlags
 
@@ -120,7 +138,7 @@ func (c *Command) Help() string {
 	switch c.Func {
 	case "connect":
 		return base.WrapForHelpText([]string{
-			"Usage: boundary connect [options] [args]",
+			"Usage: boundary connect [options] [target ID or alias]",
 			"",
 			`  This command performs a target authorization (or consumes an existing authorization token) and launches a proxied connection.`,
 			"",
@@ -128,12 +146,16 @@ func (c *Command) Help() string {
 			"",
 			`      $ boundary connect -target-id ttcp_1234567890`,
 			"",
+			"  Connect using an alias of the target:",
+			"",
+			`      $ boundary connect prod-db.example`,
+			"",
 			"",
 		}) + c.Flags().Help()
 
 	default:
 		return base.WrapForHelpText([]string{
-			fmt.Sprintf("Usage: boundary connect %s [options] [args]", c.Func),
+			fmt.Sprintf("Usage: boundary connect %s [options] [target ID or alias]", c.Func),
 			"",
 			fmt.Sprintf(`  This command performs a target authorization (or consumes an existing authorization token) and launches a proxied %s connection.`, c.Func),
 			"",
@@ -161,7 +183,7 @@ func (c *Command) Flags() *base.FlagSets {
 	f.StringVar(&base.StringVar{
 		Name:   "target-id",
 		Target: &c.flagTargetId,
-		Usage:  "The ID of the target to authorize against. Cannot be used with -authz-token.",
+		Usage:  "The ID or an alias of the target to authorize against. The target ID or alias can also be given as the only argument. Cannot be used with -authz-token.",
 	})
 
 	f.StringVar(&base.StringVar{
@@ -170,6 +192,24 @@ func (c *Command) Flags() *base.FlagSets {
 		Usage:  "The ID of a specific host to connect to out of the hosts from the target's host sets. If not specified, one is chosen at random.",
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:   "access-request-id",
+		Target: &c.flagAccessRequestId,
+		Usage:  "The ID of an approved access request to use when the target requires approval. Cannot be used with -authz-token.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "shadow-session-id",
+		Target: &c.flagShadowSessionId,
+		Usage:  "The ID of an active session to attach to as a shadow instead of authorizing a new session. The shadow sees the data sent to the session's most recent connection. Cannot be used with -authz-token or target flags.",
+	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "read-write",
+		Target: &c.flagReadWrite,
+		Usage:  "If set along with -shadow-session-id, request a read-write shadow that can also write to the shadowed connection. Read-write shadows must be approved before they can attach; waiting for approval requires permission to read the session.",
+	})
+
 	f.StringVar(&base.StringVar{
 		Name:       "exec",
 		Target:     &c.flagExec,
@@ -218,6 +258,26 @@ func (c *Command) Flags() *base.FlagSets {
 			Usage:      `If set, the CLI will attempt to bind its listening port to the given value. If it cannot, the command will error.`,
 		})
 
+		f.DurationVar(&base.DurationVar{
+			Name:       "udp-flow-idle-timeout",
+			Target:     &c.flagUdpFlowIdleTimeout,
+			Completion: complete.PredictAnything,
+			Usage:      `For UDP targets, how long the datagrams from a local address are relayed without traffic in either direction before the flow is closed. Each flow uses a connection of the session. Defaults to 1m.`,
+		})
+
+		f.BoolVar(&base.BoolVar{
+			Name:   "reverse",
+			Target: &c.flagReverse,
+			Usage:  `If set, authorize a reverse session: the worker listens for connections from hosts in the target's network and forwards them to the local service given by -reverse-addr, until the session expires. Requires the reverse-connect action on the target. Cannot be used with -authz-token or -shadow-session-id.`,
+		})
+
+		f.StringVar(&base.StringVar{
+			Name:       "reverse-addr",
+			Target:     &c.flagReverseAddr,
+			Completion: complete.PredictAnything,
+			Usage:      `The address and port of the local service, for example 127.0.0.1:8080, that connections are forwarded to in a reverse session. Required with -reverse, or when the session given with -authz-token is a reverse session.`,
+		})
+
 	case "http":
 		httpOptions(c, set)
 
@@ -262,6 +322,12 @@ func (c *Command) Run(args []string) (retCode int) {
 		return base.CommandUserError
 	}
 
+	// The target ID, or an alias of the target, can also be given as the only
+	// argument.
+	if fArgs := f.Args(); c.flagTargetId == "" && len(fArgs) == 1 {
+		c.flagTargetId = fArgs[0]
+	}
+
 	if c.flagListenPort < 0 || c.flagListenPort > math.MaxUint16 {
 		c.PrintCliError(errors.New("Invalid listen port supplied"))
 		return base.CommandCliError
@@ -271,6 +337,23 @@ func (c *Command) Run(args []string) (retCode int) {
 	defer c.proxyCancel()
 
 	switch {
+	case c.flagReverse && c.flagShadowSessionId != "":
+		c.PrintCliError(errors.New(`-reverse cannot be specified with -shadow-session-id`))
+		return base.CommandUserError
+	case c.flagReverse && c.flagAuthzToken != "":
+		c.PrintCliError(errors.New(`-reverse cannot be specified with -authz-token; the session authorized by the token determines whether it is a reverse session`))
+		return base.CommandUserError
+	case c.flagReverse && c.flagReverseAddr == "":
+		c.PrintCliError(errors.New(`-reverse-addr must be specified with -reverse`))
+		return base.CommandUserError
+	case c.flagShadowSessionId != "":
+		if c.flagAuthzToken != "" || c.flagTargetId != "" || c.flagTargetName != "" {
+			c.PrintCliError(errors.New(`-shadow-session-id cannot be specified with -authz-token or target flags`))
+			return base.CommandUserError
+		}
+	case c.flagReadWrite:
+		c.PrintCliError(errors.New(`-read-write can only be specified with -shadow-session-id`))
+		return base.CommandUserError
 	case c.flagAuthzToken != "":
 		switch {
 		case c.flagTargetId != "":
@@ -309,8 +392,48 @@ func (c *Command) Run(args []string) (retCode int) {
 		}
 	}
 
+	var shadowId, shadowToken string
 	authzString := c.flagAuthzToken
 	switch {
+	case c.flagShadowSessionId != "":
+		client, err := c.Client()
+		if c.WrapperCleanupFunc != nil {
+			defer func() {
+				if err := c.WrapperCleanupFunc(); err != nil {
+					c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+				}
+			}()
+		}
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error creating API client: %s", err))
+			return base.CommandCliError
+		}
+		shadow, err := c.shadowSession(sessions.NewClient(client))
+		if err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.PrintApiError(apiErr, "Error from controller when performing shadow action against given session")
+				return base.CommandApiError
+			}
+			c.PrintCliError(fmt.Errorf("Error trying to shadow session: %w", err))
+			return base.CommandCliError
+		}
+		sad, err := targets.SessionAuthorization{AuthorizationToken: shadow.AuthorizationToken}.GetSessionAuthorizationData()
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error decoding session authorization data: %w", err))
+			return base.CommandCliError
+		}
+		c.sessInfo = SessionInfo{
+			Protocol:        sad.Type,
+			ConnectionLimit: sad.ConnectionLimit,
+			SessionId:       sad.SessionId,
+			Endpoint:        sad.Endpoint,
+			Type:            sad.Type,
+			TargetId:        sad.TargetId,
+			HostId:          sad.HostId,
+		}
+		authzString = shadow.AuthorizationToken
+		shadowId, shadowToken = shadow.Id, shadow.Token
+
 	case authzString != "":
 		if authzString == "-" {
 			authBytes, err := io.ReadAll(os.Stdin)
@@ -382,6 +505,12 @@ func (c *Command) Run(args []string) (retCode int) {
 		if len(c.FlagScopeName) > 0 {
 			opts = append(opts, targets.WithScopeName(c.FlagScopeName))
 		}
+		if len(c.flagAccessRequestId) > 0 {
+			opts = append(opts, targets.WithAccessRequestId(c.flagAccessRequestId))
+		}
+		if c.flagReverse {
+			opts = append(opts, targets.WithReverse(true))
+		}
 
 		sar, err := targetClient.AuthorizeSession(c.Context, c.flagTargetId, opts...)
 		if err != nil {
@@ -394,6 +523,10 @@ func (c *Command) Run(args []string) (retCode int) {
 		}
 
 		sa := sar.GetItem().(*targets.SessionAuthorization)
+		if sa.AccessRequest != nil && sa.SessionId == "" {
+			c.PrintCliError(fmt.Errorf("Target requires approval; access request %s was created and must be approved before connecting with -access-request-id", sa.AccessRequest.Id))
+			return base.CommandUserError
+		}
 		c.sessInfo = SessionInfo{
 			Protocol:        sa.Type,
 			ConnectionLimit: sa.ConnectionLimit,
@@ -424,6 +557,16 @@ func (c *Command) Run(args []string) (retCode int) {
 	if listenAddr.IsValid() {
 		apiProxyOpts = append(apiProxyOpts, apiproxy.WithListenAddrPort(listenAddr))
 	}
+	if shadowId != "" {
+		apiProxyOpts = append(apiProxyOpts, apiproxy.WithShadow(shadowId, shadowToken))
+	}
+	if c.flagUdpFlowIdleTimeout != 0 {
+		apiProxyOpts = append(apiProxyOpts, apiproxy.WithUdpFlowIdleTimeout(c.flagUdpFlowIdleTimeout))
+	}
+	if c.flagReverseAddr != "" {
+		apiProxyOpts = append(apiProxyOpts, apiproxy.WithReverseAddress(c.flagReverseAddr))
+		c.sessInfo.ReverseAddress = c.flagReverseAddr
+	}
 	clientProxy, err := apiproxy.New(
 		c.proxyCtx,
 		authzString,
@@ -465,7 +608,13 @@ func (c *Command) Run(args []string) (retCode int) {
 		// The only way a user will be able to connect to the session is by
 		// connecting directly to the port and address we report to them here.
 
-		proxyAddr := clientProxy.ListenerAddress(context.Background())
+		// The address is never known if the proxy fails to start listening
+		addrCtx, addrCancel := context.WithCancel(c.proxyCtx)
+		go func() {
+			defer addrCancel()
+			<-clientProxyCloseCh
+		}()
+		proxyAddr := clientProxy.ListenerAddress(addrCtx)
 		var clientProxyHost, clientProxyPort string
 		clientProxyHost, clientProxyPort, err = net.SplitHostPort(proxyAddr)
 		if err != nil {
@@ -550,6 +699,41 @@ func (c *Command) Run(args []string) (retCode int) {
 	return
 }
 
+// shadowSession creates a shadow of the session given by -shadow-session-id.
+// If the shadow must be approved before it can attach, it waits until the
+// shadow is approved.
+func (c *Command) shadowSession(sessionClient *sessions.Client) (*sessions.SessionShadow, error) {
+	ssr, err := sessionClient.Shadow(c.Context, c.flagShadowSessionId, c.flagReadWrite)
+	if err != nil {
+		return nil, err
+	}
+	shadow := ssr.GetItem()
+	if shadow.Status == "approved" {
+		return shadow, nil
+	}
+
+	c.UI.Warn(fmt.Sprintf("Shadow %s of session %s is pending approval. Waiting for the session's owner or another authorized user to approve it, for example:", shadow.Id, c.flagShadowSessionId))
+	c.UI.Warn(fmt.Sprintf("  boundary sessions approve-shadow -id %s -shadow-id %s", c.flagShadowSessionId, shadow.Id))
+	ticker := time.NewTicker(shadowApprovalPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.Context.Done():
+			return nil, c.Context.Err()
+		case <-ticker.C:
+		}
+		srr, err := sessionClient.Read(c.Context, c.flagShadowSessionId)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range srr.GetItem().Shadows {
+			if s.Id == shadow.Id && s.Status == "approved" {
+				return shadow, nil
+			}
+		}
+	}
+}
+
 func (c *Command) printCredentials(creds []*targets.SessionCredential) error {
 	if len(creds) == 0 {
 		return nil
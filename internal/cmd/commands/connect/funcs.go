@@ -24,12 +24,18 @@ func generateSessionInfoTableOutput(in SessionInfo) string {
 		"Expiration":       in.Expiration.Local().Format(time.RFC1123),
 		"Connection Limit": in.ConnectionLimit,
 	}
+	header := "Proxy listening information:"
+	if in.ReverseAddress != "" {
+		// The address is the worker's listener for reverse sessions
+		header = "Worker listening information:"
+		nonAttributeMap["Forwarding To"] = in.ReverseAddress
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
 
 	ret := []string{
 		"",
-		"Proxy listening information:",
+		header,
 		base.WrapMap(2, maxLength+2, nonAttributeMap),
 	}
 	if len(in.Credentials) > 0 {
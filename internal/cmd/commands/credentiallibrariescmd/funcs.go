@@ -150,6 +150,8 @@ func printItemTable(item *credentiallibraries.CredentialLibrary, resp *api.Respo
 	case "vault-generic":
 		keySubstMap = genericKeySubstMap
 	case "vault-ssh-certificate":
+		fallthrough
+	case "ssh-ca":
 		keySubstMap = sshCertKeySubstMap
 	}
 
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentiallibrariescmd
+
+import (
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraPostgresFlagsFunc = extraPostgresFlagsFuncImpl
+	extraPostgresActionsFlagsMapFunc = extraPostgresActionsFlagsMapFuncImpl
+	extraPostgresFlagsHandlingFunc = extraPostgresFlagHandlingFuncImpl
+}
+
+const (
+	grantStatementsName = "grant-statements"
+	roleNamePrefixName  = "role-name-prefix"
+)
+
+type extraPostgresCmdVars struct {
+	flagGrantStatements string
+	flagRoleNamePrefix  string
+	flagTtl             string
+}
+
+func extraPostgresActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			grantStatementsName,
+			roleNamePrefixName,
+			ttlName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraPostgresFlagsFuncImpl(c *PostgresCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("Postgres Credential Library Options")
+
+	for _, name := range flagsPostgresMap[c.Func] {
+		switch name {
+		case grantStatementsName:
+			f.StringVar(&base.StringVar{
+				Name:   grantStatementsName,
+				Target: &c.flagGrantStatements,
+				Usage:  "The SQL statements executed after each role is created. {{name}} is replaced with the name of the role. This can be a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		case roleNamePrefixName:
+			f.StringVar(&base.StringVar{
+				Name:   roleNamePrefixName,
+				Target: &c.flagRoleNamePrefix,
+				Usage:  "The prefix of the name of each role. Defaults to boundary.",
+			})
+		case ttlName:
+			f.StringVar(&base.StringVar{
+				Name:   ttlName,
+				Target: &c.flagTtl,
+				Usage:  "The duration each role is valid for. Defaults to 8h.",
+			})
+		}
+	}
+}
+
+func extraPostgresFlagHandlingFuncImpl(c *PostgresCommand, _ *base.FlagSets, opts *[]credentiallibraries.Option) bool {
+	switch c.flagGrantStatements {
+	case "":
+	default:
+		stmts, _ := parseutil.ParsePath(c.flagGrantStatements)
+		*opts = append(*opts, credentiallibraries.WithPostgresCredentialLibraryGrantStatements(stmts))
+	}
+	switch c.flagRoleNamePrefix {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultPostgresCredentialLibraryRoleNamePrefix())
+	default:
+		*opts = append(*opts, credentiallibraries.WithPostgresCredentialLibraryRoleNamePrefix(c.flagRoleNamePrefix))
+	}
+	switch c.flagTtl {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultPostgresCredentialLibraryTtl())
+	default:
+		*opts = append(*opts, credentiallibraries.WithPostgresCredentialLibraryTtl(c.flagTtl))
+	}
+
+	return true
+}
+
+func (c *PostgresCommand) extraPostgresHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries create postgres -credential-store-id [options] [args]",
+			"",
+			"  Create a postgres-type credential library. Example:",
+			"",
+			`    $ boundary credential-libraries create postgres -credential-store-id cspg_1234567890 -grant-statements "grant select on all tables in schema public to {{name}}"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries update postgres [options] [args]",
+			"",
+			"  Update a postgres-type credential library given its ID. Example:",
+			"",
+			`    $ boundary credential-libraries update postgres -id clpg_1234567890 -name devops -description "For DevOps usage"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentiallibrariescmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+)
+
+func init() {
+	extraSshCaFlagsFunc = extraSshCaFlagsFuncImpl
+	extraSshCaActionsFlagsMapFunc = extraSshCaActionsFlagsMapFuncImpl
+	extraSshCaFlagsHandlingFunc = extraSshCaFlagHandlingFuncImpl
+}
+
+type extraSshCaCmdVars struct {
+	flagUsername                  string
+	flagKeyType                   string
+	flagKeyBits                   string
+	flagTtl                       string
+	flagKeyId                     string
+	flagCriticalOptions           string
+	flagCriticalOpts              []base.CombinedSliceFlagValue
+	flagExtensions                string
+	flagExtens                    []base.CombinedSliceFlagValue
+	flagAdditionalValidPrincipals []base.CombinedSliceFlagValue
+}
+
+func extraSshCaActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			usernameName,
+			keyTypeName,
+			keyBitsName,
+			ttlName,
+			keyIdName,
+			criticalOptionsName,
+			piecewiseCriticalOptionsName,
+			extensionsName,
+			piecewiseExtensionName,
+			additionalValidPrincipalsName,
+		},
+		"update": {
+			usernameName,
+			keyTypeName,
+			keyBitsName,
+			ttlName,
+			keyIdName,
+			criticalOptionsName,
+			piecewiseCriticalOptionsName,
+			extensionsName,
+			piecewiseExtensionName,
+			additionalValidPrincipalsName,
+		},
+	}
+	return flags
+}
+
+func extraSshCaFlagsFuncImpl(c *SshCaCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("SSH CA Credential Library Options")
+
+	for _, name := range flagsSshCaMap[c.Func] {
+		switch name {
+		case usernameName:
+			f.StringVar(&base.StringVar{
+				Name:   usernameName,
+				Target: &c.flagUsername,
+				Usage:  "The username to use with the ssh certificate.",
+			})
+		case keyTypeName:
+			f.StringVar(&base.StringVar{
+				Name:   keyTypeName,
+				Target: &c.flagKeyType,
+				Usage:  "The key type for the generated ssh private key. One of: ed25519, ecdsa, rsa.",
+			})
+		case keyBitsName:
+			f.StringVar(&base.StringVar{
+				Name:   keyBitsName,
+				Target: &c.flagKeyBits,
+				Usage:  "The number of bits when generating the ssh private key. Depends on key_type. If ed25519 this should not be set, or set to 0, if ecdsa one of 256, 384, 521, if rsa one of 2048, 3072, 4096.",
+			})
+		case ttlName:
+			f.StringVar(&base.StringVar{
+				Name:   ttlName,
+				Target: &c.flagTtl,
+				Usage:  "The time-to-live for the generated certificate.",
+			})
+		case keyIdName:
+			f.StringVar(&base.StringVar{
+				Name:   keyIdName,
+				Target: &c.flagKeyId,
+				Usage:  "The key id that the created certificate should have.",
+			})
+		case additionalValidPrincipalsName:
+			f.CombinationSliceVar(&base.CombinationSliceVar{
+				Name:   additionalValidPrincipalsName,
+				Target: &c.flagAdditionalValidPrincipals,
+				Usage:  "Principals to be signed as \"valid_principles\" in addition to username.",
+			})
+		}
+	}
+	criticalOptsInput := common.CombinedSliceFlagValuePopulationInput{
+		FlagSet:                          f,
+		FlagNames:                        flagsSshCaMap[c.Func],
+		FullPopulationFlag:               &c.flagCriticalOptions,
+		FullPopulationInputName:          criticalOptionsName,
+		PiecewisePopulationFlag:          &c.flagCriticalOpts,
+		PiecewisePopulationInputBaseName: piecewiseCriticalOptionsName,
+		PiecewiseNoProtoCompat:           true,
+	}
+	common.PopulateCombinedSliceFlagValue(criticalOptsInput)
+
+	extensionsInput := common.CombinedSliceFlagValuePopulationInput{
+		FlagSet:                          f,
+		FlagNames:                        flagsSshCaMap[c.Func],
+		FullPopulationFlag:               &c.flagExtensions,
+		FullPopulationInputName:          extensionsName,
+		PiecewisePopulationFlag:          &c.flagExtens,
+		PiecewisePopulationInputBaseName: piecewiseExtensionName,
+		PiecewiseNoProtoCompat:           true,
+	}
+	common.PopulateCombinedSliceFlagValue(extensionsInput)
+}
+
+func extraSshCaFlagHandlingFuncImpl(c *SshCaCommand, _ *base.FlagSets, opts *[]credentiallibraries.Option) bool {
+	switch c.flagUsername {
+	case "":
+	default:
+		*opts = append(*opts, credentiallibraries.WithSshCaCredentialLibraryUsername(c.flagUsername))
+	}
+	switch c.flagKeyType {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultSshCaCredentialLibraryKeyType())
+	default:
+		*opts = append(*opts, credentiallibraries.WithSshCaCredentialLibraryKeyType(c.flagKeyType))
+	}
+	switch c.flagKeyBits {
+	case "":
+	case "0", "null":
+		*opts = append(*opts, credentiallibraries.DefaultSshCaCredentialLibraryKeyBits())
+	default:
+		var final uint32
+		keyBits, err := strconv.ParseUint(c.flagKeyBits, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagKeyBits, err))
+			return false
+		}
+		final = uint32(keyBits)
+		*opts = append(*opts, credentiallibraries.WithSshCaCredentialLibraryKeyBits(final))
+	}
+	switch c.flagTtl {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultSshCaCredentialLibraryTtl())
+	default:
+		*opts = append(*opts, credentiallibraries.WithSshCaCredentialLibraryTtl(c.flagTtl))
+	}
+	switch c.flagKeyId {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultSshCaCredentialLibraryKeyId())
+	default:
+		*opts = append(*opts, credentiallibraries.WithSshCaCredentialLibraryKeyId(c.flagKeyId))
+	}
+	// the weird formatting of this switch is to determine if there was only 0 or 1 principals passed, and if that signifies using default (nil)
+	switch len(c.flagAdditionalValidPrincipals) {
+	case 0:
+	case 1:
+		if len(c.flagAdditionalValidPrincipals[0].Keys) == 1 && c.flagAdditionalValidPrincipals[0].Keys[0] == "null" && c.flagAdditionalValidPrincipals[0].Value == nil {
+			*opts = append(*opts, credentiallibraries.DefaultSshCaCredentialLibraryAdditionalValidPrincipals())
+			break
+		}
+		fallthrough
+	default:
+		avp := make([]string, len(c.flagAdditionalValidPrincipals))
+		for i, p := range c.flagAdditionalValidPrincipals {
+			avp[i] = p.Value.GetValue()
+		}
+		*opts = append(*opts, credentiallibraries.WithSshCaCredentialLibraryAdditionalValidPrincipals(avp))
+	}
+
+	if err := common.HandleAttributeFlags(
+		c.Command,
+		piecewiseCriticalOptionsName,
+		c.flagCriticalOptions,
+		c.flagCriticalOpts,
+		func() {
+			*opts = append(*opts, credentiallibraries.DefaultSshCaCredentialLibraryCriticalOptions())
+		},
+		func(in map[string]any) {
+			inn := make(map[string]string, len(in))
+			for k, v := range in {
+				switch vv := v.(type) {
+				case nil:
+					inn[k] = ""
+				case string:
+					inn[k] = vv
+				default:
+					continue
+				}
+			}
+			*opts = append(*opts, credentiallibraries.WithSshCaCredentialLibraryCriticalOptions(inn))
+		}); err != nil {
+		return false
+	}
+	if err := common.HandleAttributeFlags(
+		c.Command,
+		piecewiseExtensionName,
+		c.flagExtensions,
+		c.flagExtens,
+		func() {
+			*opts = append(*opts, credentiallibraries.DefaultSshCaCredentialLibraryExtensions())
+		},
+		func(in map[string]any) {
+			inn := make(map[string]string, len(in))
+			for k, v := range in {
+				switch vv := v.(type) {
+				case nil:
+					inn[k] = ""
+				case string:
+					inn[k] = vv
+				default:
+					continue
+				}
+			}
+			*opts = append(*opts, credentiallibraries.WithSshCaCredentialLibraryExtensions(inn))
+		}); err != nil {
+		return false
+	}
+
+	return true
+}
+
+func (c *SshCaCommand) extraSshCaHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries create ssh-ca -credential-store-id [options] [args]",
+			"",
+			"  Create an ssh-ca-type credential library. Example:",
+			"",
+			`    $ boundary credential-libraries create ssh-ca -credential-store-id csssh_1234567890 -username user`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries update ssh-ca [options] [args]",
+			"",
+			"  Update an ssh-ca-type credential library given its ID. Example:",
+			"",
+			`    $ boundary credential-libraries update ssh-ca -id clssh_1234567890 -name devops -description "For DevOps usage"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
@@ -20,6 +20,39 @@ const (
 	secretFlagName               = "secret"
 )
 
+func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"check-in": {"id"},
+	}
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "check-in":
+		return "Release the exclusive checkout of a static credential"
+	default:
+		return ""
+	}
+}
+
+func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *credentials.Credential, origItems []*credentials.Credential, origError error, credsClient *credentials.Client, _ uint32, opts []credentials.Option) (*api.Response, *credentials.Credential, []*credentials.Credential, error) {
+	switch c.Func {
+	case "check-in":
+		result, err := credsClient.CheckIn(c.Context, c.FlagId, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, nil
+	}
+	return origResp, origItem, origItems, origError
+}
+
 func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 	var helpStr string
 	switch c.Func {
@@ -47,6 +80,16 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
+	case "check-in":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials check-in [options] [args]",
+			"",
+			"  This command releases the exclusive checkout of a static credential held by a session, allowing another session to check it out. Example:",
+			"",
+			`      $ boundary credentials check-in -id credup_1234567890`,
+			"",
+			"",
+		})
 	case "update":
 		helpStr = base.WrapForHelpText([]string{
 			"Usage: boundary credentials update [type] [sub command] [options] [args]",
@@ -13,6 +13,125 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/base"
 )
 
+func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
+}
+
+type extraCmdVars struct {
+	flagSessionId    string
+	flagCredentialId string
+	leaseResult      *credentialstores.CredentialLeaseReadResult
+	leaseListResult  *credentialstores.CredentialLeaseListResult
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"list-leases":  {"id", "session-id"},
+		"renew-lease":  {"id", "credential-id"},
+		"revoke-lease": {"id", "credential-id"},
+	}
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "list-leases":
+		return "List the Vault leases of the credentials issued by a credential store"
+	case "renew-lease":
+		return "Renew the Vault lease of a credential issued by a credential store"
+	case "revoke-lease":
+		return "Revoke the Vault lease of a credential issued by a credential store"
+	default:
+		return ""
+	}
+}
+
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case "session-id":
+			f.StringVar(&base.StringVar{
+				Name:   "session-id",
+				Target: &c.flagSessionId,
+				Usage:  "If set, only the leases issued for this session are listed.",
+			})
+		case "credential-id":
+			f.StringVar(&base.StringVar{
+				Name:   "credential-id",
+				Target: &c.flagCredentialId,
+				Usage:  "The ID of the credential whose lease is renewed or revoked.",
+			})
+		}
+	}
+}
+
+func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]credentialstores.Option) bool {
+	switch c.Func {
+	case "list-leases":
+		if c.flagSessionId != "" {
+			*opts = append(*opts, credentialstores.WithSessionId(c.flagSessionId))
+		}
+	case "renew-lease", "revoke-lease":
+		if c.flagCredentialId == "" {
+			c.UI.Error("Credential ID is required but not passed in via -credential-id")
+			return false
+		}
+	}
+	return true
+}
+
+func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *credentialstores.CredentialStore, origItems []*credentialstores.CredentialStore, origError error, csClient *credentialstores.Client, _ uint32, opts []credentialstores.Option) (*api.Response, *credentialstores.CredentialStore, []*credentialstores.CredentialStore, error) {
+	var err error
+	switch c.Func {
+	case "list-leases":
+		c.plural = "leases for credential store"
+		c.leaseListResult, err = csClient.ListLeases(c.Context, c.FlagId, opts...)
+		return nil, nil, nil, err
+	case "renew-lease":
+		c.plural = "lease for credential store"
+		c.leaseResult, err = csClient.RenewLease(c.Context, c.FlagId, c.flagCredentialId, opts...)
+		return nil, nil, nil, err
+	case "revoke-lease":
+		c.plural = "lease for credential store"
+		c.leaseResult, err = csClient.RevokeLease(c.Context, c.FlagId, c.flagCredentialId, opts...)
+		return nil, nil, nil, err
+	}
+	return origResp, origItem, origItems, origError
+}
+
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "list-leases":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printLeaseListTable(c.leaseListResult.GetItems()))
+			return true, nil
+		case "json":
+			if ok := c.PrintJsonItems(c.leaseListResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "renew-lease", "revoke-lease":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printLeaseTable(c.leaseResult.GetItem()))
+			return true, nil
+		case "json":
+			if ok := c.PrintJsonItem(c.leaseResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 	var helpStr string
 	switch c.Func {
@@ -42,6 +161,14 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary credential-stores create static -scope-id p_1234567890`,
 			"",
+			"    Create an ssh-ca-type credential store:",
+			"",
+			`      $ boundary credential-stores create ssh-ca -scope-id p_1234567890`,
+			"",
+			"    Create a postgres-type credential store:",
+			"",
+			`      $ boundary credential-stores create postgres -scope-id p_1234567890 -postgres-address db.example.com -postgres-username boundary -postgres-password env://PG_PASSWORD`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "update":
@@ -58,8 +185,46 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary credential-stores update static -id cs_1234567890 -name devops -description "For DevOps usage"`,
 			"",
+			"    Update an ssh-ca-type credential store:",
+			"",
+			`      $ boundary credential-stores update ssh-ca -id csssh_1234567890 -name devops -description "For DevOps usage"`,
+			"",
+			"    Update a postgres-type credential store:",
+			"",
+			`      $ boundary credential-stores update postgres -id cspg_1234567890 -name devops -description "For DevOps usage"`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
+	case "list-leases":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores list-leases [options] [args]",
+			"",
+			"  This command allows listing the Vault leases of the credentials issued by a vault-type credential store. Example:",
+			"",
+			`      $ boundary credential-stores list-leases -id csvlt_1234567890 -session-id s_1234567890`,
+			"",
+			"",
+		})
+	case "renew-lease":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores renew-lease [options] [args]",
+			"",
+			"  This command allows renewing the Vault lease of a credential issued by a vault-type credential store. Example:",
+			"",
+			`      $ boundary credential-stores renew-lease -id csvlt_1234567890 -credential-id cdvlt_1234567890`,
+			"",
+			"",
+		})
+	case "revoke-lease":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores revoke-lease [options] [args]",
+			"",
+			"  This command allows revoking the Vault lease of a credential issued by a vault-type credential store. Example:",
+			"",
+			`      $ boundary credential-stores revoke-lease -id csvlt_1234567890 -credential-id cdvlt_1234567890`,
+			"",
+			"",
+		})
 	default:
 		helpStr = helpMap["base"]()
 	}
@@ -201,6 +366,64 @@ func printItemTable(item *credentialstores.CredentialStore, resp *api.Response)
 	return base.WrapForHelpText(ret)
 }
 
+func leaseMap(item *credentialstores.CredentialLease) map[string]any {
+	m := map[string]any{
+		"ID":        item.Id,
+		"Lease ID":  item.LeaseId,
+		"Status":    item.Status,
+		"Renewable": item.Renewable,
+	}
+	if item.CredentialLibraryId != "" {
+		m["Credential Library ID"] = item.CredentialLibraryId
+	}
+	if item.SessionId != "" {
+		m["Session ID"] = item.SessionId
+	}
+	if item.TtlSeconds != 0 {
+		m["TTL"] = (time.Duration(item.TtlSeconds) * time.Second).String()
+	}
+	if !item.ExpirationTime.IsZero() {
+		m["Expiration Time"] = item.ExpirationTime.Local().Format(time.RFC1123)
+	}
+	if !item.LastRenewalTime.IsZero() {
+		m["Last Renewal Time"] = item.LastRenewalTime.Local().Format(time.RFC1123)
+	}
+	if item.LastRenewalError != "" {
+		m["Last Renewal Error"] = item.LastRenewalError
+	}
+	if !item.CreatedTime.IsZero() {
+		m["Created Time"] = item.CreatedTime.Local().Format(time.RFC1123)
+	}
+	return m
+}
+
+func printLeaseTable(item *credentialstores.CredentialLease) string {
+	m := leaseMap(item)
+	return base.WrapForHelpText([]string{
+		"",
+		"Credential lease information:",
+		base.WrapMap(2, base.MaxAttributesLength(m, nil, nil), m),
+	})
+}
+
+func printLeaseListTable(items []*credentialstores.CredentialLease) string {
+	if len(items) == 0 {
+		return "No credential leases found"
+	}
+	ret := []string{
+		"",
+		"Credential lease information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			ret = append(ret, "")
+		}
+		m := leaseMap(item)
+		ret = append(ret, base.WrapMap(2, base.MaxAttributesLength(m, nil, nil), m))
+	}
+	return base.WrapForHelpText(ret)
+}
+
 var keySubstMap = map[string]string{
 	"address":                     "Address",
 	"namespace":                   "Namespace",
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentialstorescmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api/credentialstores"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraPostgresFlagsFunc = extraPostgresFlagsFuncImpl
+	extraPostgresActionsFlagsMapFunc = extraPostgresActionsFlagsMapFuncImpl
+	extraPostgresFlagsHandlingFunc = extraPostgresFlagHandlingFuncImpl
+}
+
+const (
+	postgresAddressFlagName      = "postgres-address"
+	postgresPortFlagName         = "postgres-port"
+	postgresDatabaseNameFlagName = "postgres-database-name"
+	postgresUsernameFlagName     = "postgres-username"
+	postgresPasswordFlagName     = "postgres-password"
+	postgresSslModeFlagName      = "postgres-ssl-mode"
+)
+
+type extraPostgresCmdVars struct {
+	flagAddress      string
+	flagPort         string
+	flagDatabaseName string
+	flagUsername     string
+	flagPassword     string
+	flagSslMode      string
+}
+
+func extraPostgresActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			postgresAddressFlagName,
+			postgresPortFlagName,
+			postgresDatabaseNameFlagName,
+			postgresUsernameFlagName,
+			postgresPasswordFlagName,
+			postgresSslModeFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraPostgresFlagsFuncImpl(c *PostgresCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("Postgres Credential Store Options")
+
+	for _, name := range flagsPostgresMap[c.Func] {
+		switch name {
+		case postgresAddressFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   postgresAddressFlagName,
+				Target: &c.flagAddress,
+				Usage:  "The address of the postgres server, without the port.",
+			})
+		case postgresPortFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   postgresPortFlagName,
+				Target: &c.flagPort,
+				Usage:  "The port of the postgres server. Defaults to 5432.",
+			})
+		case postgresDatabaseNameFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   postgresDatabaseNameFlagName,
+				Target: &c.flagDatabaseName,
+				Usage:  "The database to connect to on the postgres server. Defaults to postgres.",
+			})
+		case postgresUsernameFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   postgresUsernameFlagName,
+				Target: &c.flagUsername,
+				Usage:  "The administrative role used to create and drop roles on the postgres server.",
+			})
+		case postgresPasswordFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   postgresPasswordFlagName,
+				Target: &c.flagPassword,
+				Usage:  "The password of the administrative role. This can be a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		case postgresSslModeFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   postgresSslModeFlagName,
+				Target: &c.flagSslMode,
+				Usage:  "The SSL mode used to connect to the postgres server. One of: disable, require, verify-ca, verify-full. Defaults to require.",
+			})
+		}
+	}
+}
+
+func extraPostgresFlagHandlingFuncImpl(c *PostgresCommand, _ *base.FlagSets, opts *[]credentialstores.Option) bool {
+	switch c.flagAddress {
+	case "":
+	default:
+		*opts = append(*opts, credentialstores.WithPostgresCredentialStoreAddress(c.flagAddress))
+	}
+	switch c.flagPort {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultPostgresCredentialStorePort())
+	default:
+		port, err := strconv.ParseUint(c.flagPort, 10, 16)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagPort, err))
+			return false
+		}
+		*opts = append(*opts, credentialstores.WithPostgresCredentialStorePort(uint32(port)))
+	}
+	switch c.flagDatabaseName {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultPostgresCredentialStoreDatabaseName())
+	default:
+		*opts = append(*opts, credentialstores.WithPostgresCredentialStoreDatabaseName(c.flagDatabaseName))
+	}
+	switch c.flagUsername {
+	case "":
+	default:
+		*opts = append(*opts, credentialstores.WithPostgresCredentialStoreUsername(c.flagUsername))
+	}
+	switch c.flagPassword {
+	case "":
+	default:
+		password, err := parseutil.MustParsePath(c.flagPassword)
+		switch {
+		case err == nil:
+		case errors.Is(err, parseutil.ErrNotParsed):
+			c.UI.Error("Password flag must be used with env:// or file:// syntax")
+			return false
+		default:
+			c.UI.Error(fmt.Sprintf("Error parsing password flag: %v", err))
+			return false
+		}
+		*opts = append(*opts, credentialstores.WithPostgresCredentialStorePassword(password))
+	}
+	switch c.flagSslMode {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultPostgresCredentialStoreSslMode())
+	default:
+		*opts = append(*opts, credentialstores.WithPostgresCredentialStoreSslMode(c.flagSslMode))
+	}
+
+	return true
+}
+
+func (c *PostgresCommand) extraPostgresHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores create postgres [options] [args]",
+			"",
+			"  Create a postgres-type credential store. The store creates a role on the postgres server for each session. Example:",
+			"",
+			`    $ boundary credential-stores create postgres -scope-id p_1234567890 -postgres-address db.example.com -postgres-username boundary -postgres-password env://PG_PASSWORD`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores update postgres [options] [args]",
+			"",
+			"  Update a postgres-type credential store given its ID. Example:",
+			"",
+			`    $ boundary credential-stores update postgres -id cspg_1234567890 -name devops -description "For DevOps usage"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentialstorescmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api/credentialstores"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraSshCaFlagsFunc = extraSshCaFlagsFuncImpl
+	extraSshCaActionsFlagsMapFunc = extraSshCaActionsFlagsMapFuncImpl
+	extraSshCaFlagsHandlingFunc = extraSshCaFlagHandlingFuncImpl
+}
+
+const (
+	keyTypeFlagName = "key-type"
+	keyBitsFlagName = "key-bits"
+)
+
+type extraSshCaCmdVars struct {
+	flagKeyType string
+	flagKeyBits string
+}
+
+func extraSshCaActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {
+			keyTypeFlagName,
+			keyBitsFlagName,
+		},
+	}
+}
+
+func extraSshCaFlagsFuncImpl(c *SshCaCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("SSH CA Credential Store Options")
+
+	for _, name := range flagsSshCaMap[c.Func] {
+		switch name {
+		case keyTypeFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   keyTypeFlagName,
+				Target: &c.flagKeyType,
+				Usage:  "The key type of the generated certificate authority key. One of: ed25519, ecdsa, rsa. Defaults to ed25519.",
+			})
+		case keyBitsFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   keyBitsFlagName,
+				Target: &c.flagKeyBits,
+				Usage:  "The number of bits of the generated certificate authority key. Depends on key-type. If ed25519 this should not be set, if ecdsa one of 256, 384, 521, if rsa one of 2048, 3072, 4096.",
+			})
+		}
+	}
+}
+
+func extraSshCaFlagHandlingFuncImpl(c *SshCaCommand, _ *base.FlagSets, opts *[]credentialstores.Option) bool {
+	switch c.flagKeyType {
+	case "":
+	default:
+		*opts = append(*opts, credentialstores.WithSshCaCredentialStoreKeyType(c.flagKeyType))
+	}
+	switch c.flagKeyBits {
+	case "":
+	default:
+		keyBits, err := strconv.ParseUint(c.flagKeyBits, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagKeyBits, err))
+			return false
+		}
+		*opts = append(*opts, credentialstores.WithSshCaCredentialStoreKeyBits(uint32(keyBits)))
+	}
+
+	return true
+}
+
+func (c *SshCaCommand) extraSshCaHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores create ssh-ca [options] [args]",
+			"",
+			"  Create an ssh-ca-type credential store. A new certificate authority key is generated for the store. Example:",
+			"",
+			`    $ boundary credential-stores create ssh-ca -scope-id p_1234567890 -key-type ecdsa -key-bits 384`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores update ssh-ca [options] [args]",
+			"",
+			"  Update an ssh-ca-type credential store given its ID. Example:",
+			"",
+			`    $ boundary credential-stores update ssh-ca -id csssh_1234567890 -name devops -description "For DevOps usage"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
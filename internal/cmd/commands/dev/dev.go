@@ -60,6 +60,7 @@ type Command struct {
 	SighupCh      chan struct{}
 	childSighupCh []chan struct{}
 	ReloadedCh    chan struct{}
+	SigUSR1Ch     chan struct{}
 	SigUSR2Ch     chan struct{}
 
 	Config     *config.Config
@@ -1071,6 +1072,18 @@ func (c *Command) Run(args []string) int {
 		case <-c.SighupCh:
 			c.UI.Output("==> Boundary dev environment does not support configuration reloading, taking no action")
 
+		case <-c.SigUSR1Ch:
+			if c.worker == nil {
+				c.UI.Output("==> Boundary dev environment is not running a worker, taking no action")
+				break
+			}
+			drainTimeout := server.DefaultDrainTimeout
+			if c.Config.Worker.DrainTimeoutDuration > 0 {
+				drainTimeout = c.Config.Worker.DrainTimeoutDuration
+			}
+			c.UI.Output(fmt.Sprintf("==> Boundary dev environment worker drain triggered, draining for up to %s", drainTimeout))
+			c.worker.Drain(time.Now().Add(drainTimeout))
+
 		case <-c.SigUSR2Ch:
 			buf := make([]byte, 32*1024*1024)
 			n := runtime.Stack(buf[:], true)
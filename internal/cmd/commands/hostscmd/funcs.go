@@ -153,6 +153,9 @@ func printItemTable(item *hosts.Host, resp *api.Response) string {
 	if item.ExternalName != "" {
 		nonAttributeMap["External Name"] = item.ExternalName
 	}
+	if item.Health != "" {
+		nonAttributeMap["Health"] = item.Health
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, item.Attributes, keySubstMap)
 
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package managedgroupscmd
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/api/managedgroups"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-bexpr"
+)
+
+func init() {
+	extraJwtFlagsFunc = extraJwtFlagsFuncImpl
+	extraJwtActionsFlagsMapFunc = extraJwtActionsFlagsMapFuncImpl
+	extraJwtFlagsHandlingFunc = extraJwtFlagsHandlingFuncImpl
+}
+
+func extraJwtActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {filterFlagName},
+		"update": {filterFlagName},
+	}
+}
+
+type extraJwtCmdVars struct {
+	flagFilter string
+}
+
+func (c *JwtCommand) extraJwtHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary managed-groups create jwt [options] [args]",
+			"",
+			"  Create a jwt-type managed group. Example:",
+			"",
+			`    $ boundary managed-groups create jwt -auth-method-id amjwt_1234567890 -filter '"ci" in "/token/groups"' -description "JWT managed group for CI runners"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary managed-groups update jwt [options] [args]",
+			"",
+			"  Update a jwt-type managed group given its ID. Example:",
+			"",
+			`    $ boundary managed-groups update jwt -id mgjwt_1234567890 -name "ci" -description "JWT managed group for CI runners"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraJwtFlagsFuncImpl(c *JwtCommand, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsJwtMap[c.Func] {
+		switch name {
+		case filterFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   filterFlagName,
+				Target: &c.flagFilter,
+				Usage:  "The filter defining the criteria against which the claims of the JWT an account authenticates with will be evaluated to determine membership at login time.",
+			})
+		}
+	}
+}
+
+func extraJwtFlagsHandlingFuncImpl(c *JwtCommand, _ *base.FlagSets, opts *[]managedgroups.Option) bool {
+	switch c.flagFilter {
+	case "null":
+		c.UI.Error("Filter must be defined, and cannot be cleared.")
+		return false
+
+	case "":
+		if c.Func == "create" {
+			c.UI.Error("The -filter flag must be provided when creating a managed group.")
+			return false
+		}
+
+	default:
+		_, err := bexpr.CreateEvaluator(c.flagFilter)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error when parsing filter to check validity: %v", err))
+			return false
+		}
+		*opts = append(*opts, managedgroups.WithAttributes(map[string]any{filterFlagName: c.flagFilter}))
+	}
+
+	return true
+}
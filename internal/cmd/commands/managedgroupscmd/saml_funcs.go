@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package managedgroupscmd
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/api/managedgroups"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-bexpr"
+)
+
+func init() {
+	extraSamlFlagsFunc = extraSamlFlagsFuncImpl
+	extraSamlActionsFlagsMapFunc = extraSamlActionsFlagsMapFuncImpl
+	extraSamlFlagsHandlingFunc = extraSamlFlagsHandlingFuncImpl
+}
+
+func extraSamlActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {filterFlagName},
+		"update": {filterFlagName},
+	}
+}
+
+type extraSamlCmdVars struct {
+	flagFilter string
+}
+
+func (c *SamlCommand) extraSamlHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary managed-groups create saml [options] [args]",
+			"",
+			"  Create a saml-type managed group. Example:",
+			"",
+			`    $ boundary managed-groups create saml -auth-method-id amsaml_1234567890 -filter '"engineering" in "/attributes/groups"' -description "SAML managed group for engineering"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary managed-groups update saml [options] [args]",
+			"",
+			"  Update a saml-type managed group given its ID. Example:",
+			"",
+			`    $ boundary managed-groups update saml -id mgsaml_1234567890 -name "engineering" -description "SAML managed group for engineering"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraSamlFlagsFuncImpl(c *SamlCommand, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsSamlMap[c.Func] {
+		switch name {
+		case filterFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   filterFlagName,
+				Target: &c.flagFilter,
+				Usage:  "The filter defining the criteria against which the attributes of the SAML assertion an account authenticates with will be evaluated to determine membership at login time.",
+			})
+		}
+	}
+}
+
+func extraSamlFlagsHandlingFuncImpl(c *SamlCommand, _ *base.FlagSets, opts *[]managedgroups.Option) bool {
+	switch c.flagFilter {
+	case "null":
+		c.UI.Error("Filter must be defined, and cannot be cleared.")
+		return false
+
+	case "":
+		if c.Func == "create" {
+			c.UI.Error("The -filter flag must be provided when creating a managed group.")
+			return false
+		}
+
+	default:
+		_, err := bexpr.CreateEvaluator(c.flagFilter)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error when parsing filter to check validity: %v", err))
+			return false
+		}
+		*opts = append(*opts, managedgroups.WithAttributes(map[string]any{filterFlagName: c.flagFilter}))
+	}
+
+	return true
+}
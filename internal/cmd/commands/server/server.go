@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-secure-stdlib/mlock"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
@@ -47,6 +48,7 @@ type Command struct {
 	opsServer *ops.Server
 
 	SighupCh  chan struct{}
+	SigUSR1Ch chan struct{}
 	SigUSR2Ch chan struct{}
 
 	Config *config.Config
@@ -810,6 +812,18 @@ func (c *Command) WaitForInterrupt() int {
 				c.UI.Error(fmt.Errorf("Error(s) were encountered during reload: %w", err).Error())
 			}
 
+		case <-c.SigUSR1Ch:
+			if c.worker == nil {
+				c.UI.Output("==> Boundary server is not running a worker, taking no action")
+				break
+			}
+			drainTimeout := server.DefaultDrainTimeout
+			if c.Config.Worker.DrainTimeoutDuration > 0 {
+				drainTimeout = c.Config.Worker.DrainTimeoutDuration
+			}
+			c.UI.Output(fmt.Sprintf("==> Boundary server worker drain triggered, draining for up to %s", drainTimeout))
+			c.worker.Drain(time.Now().Add(drainTimeout))
+
 		case <-c.SigUSR2Ch:
 			buf := make([]byte, 32*1024*1024)
 			n := runtime.Stack(buf[:], true)
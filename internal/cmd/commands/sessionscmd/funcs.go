@@ -4,6 +4,7 @@
 package sessionscmd
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"strconv"
@@ -17,6 +18,7 @@ import (
 
 const (
 	flagIncludeTerminated = "include-terminated"
+	flagShadowId          = "shadow-id"
 )
 
 func init() {
@@ -28,13 +30,15 @@ func init() {
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"cancel": {"id"},
-		"list":   {flagIncludeTerminated},
+		"cancel":         {"id"},
+		"list":           {flagIncludeTerminated},
+		"approve-shadow": {"id", flagShadowId},
 	}
 }
 
 type extraCmdVars struct {
 	flagIncludeTerminated bool
+	flagShadowId          string
 }
 
 func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
@@ -46,11 +50,21 @@ func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagIncludeTerminated,
 				Usage:  "If set, terminated sessions will be included in the results.",
 			})
+		case flagShadowId:
+			f.StringVar(&base.StringVar{
+				Name:   flagShadowId,
+				Target: &c.flagShadowId,
+				Usage:  "The ID of the pending read-write shadow to approve.",
+			})
 		}
 	}
 }
 
 func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]sessions.Option) bool {
+	if c.Func == "approve-shadow" && c.flagShadowId == "" {
+		c.PrintCliError(errors.New("Shadow ID is required but not passed in via -shadow-id"))
+		return false
+	}
 	if c.flagIncludeTerminated {
 		*opts = append(*opts, sessions.WithIncludeTerminated(c.flagIncludeTerminated))
 	}
@@ -84,6 +98,17 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 		})
 
+	case "approve-shadow":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions approve-shadow [options] [args]",
+			"",
+			"  Approve a pending read-write shadow of the session specified by ID. Once approved, the shadow can attach to the session and write to its connection. Example:",
+			"",
+			`    $ boundary sessions approve-shadow -id s_1234567890 -shadow-id ssd_1234567890`,
+			"",
+			"",
+		})
+
 	default:
 		helpStr = helpMap["base"]()
 	}
@@ -99,6 +124,12 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *sessi
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "approve-shadow":
+		result, err := sessionClient.ApproveShadow(c.Context, c.FlagId, c.flagShadowId, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
@@ -249,6 +280,32 @@ func printItemTable(item *sessions.Session, resp *api.Response) string {
 		connectionsMaps = append(connectionsMaps, cm)
 	}
 
+	var shadowsMaps []map[string]any
+	for _, ss := range item.Shadows {
+		sm := map[string]any{
+			"ID":           ss.Id,
+			"User ID":      ss.UserId,
+			"Read Write":   ss.ReadWrite,
+			"Status":       ss.Status,
+			"Created Time": ss.CreatedTime.Local().Format(time.RFC1123),
+		}
+		if ss.ApproverId != "" {
+			sm["Approver ID"] = ss.ApproverId
+		}
+		if ss.ConnectionId != "" {
+			sm["Connection ID"] = ss.ConnectionId
+		}
+		if !ss.AttachedTime.IsZero() {
+			sm["Attached Time"] = ss.AttachedTime.Local().Format(time.RFC1123)
+		}
+		shadowsMaps = append(shadowsMaps, sm)
+	}
+	if len(shadowsMaps) > 0 {
+		if l := len("Connection ID"); l > maxLength {
+			maxLength = l
+		}
+	}
+
 	ret := []string{
 		"",
 		"Session information:",
@@ -297,5 +354,18 @@ func printItemTable(item *sessions.Session, resp *api.Response) string {
 		}
 	}
 
+	if len(item.Shadows) > 0 {
+		ret = append(ret,
+			"",
+			"  Shadows:",
+		)
+		for _, m := range shadowsMaps {
+			ret = append(ret,
+				base.WrapMap(4, maxLength, m),
+				"",
+			)
+		}
+	}
+
 	return base.WrapForHelpText(ret)
 }
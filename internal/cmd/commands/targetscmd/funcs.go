@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -39,19 +40,29 @@ type extraCmdVars struct {
 	flagHostSources                          []string
 	flagBrokeredCredentialSources            []string
 	flagInjectedApplicationCredentialSources []string
+	flagExclusiveCheckoutCredentialSources   []string
 	flagHostId                               string
+	flagAccessRequestId                      string
+	flagReason                               string
+	flagValidFor                             string
+	flagReverse                              bool
 	sar                                      *targets.SessionAuthorizationResult
+	accessRequestResult                      *targets.AccessRequestReadResult
+	accessRequestListResult                  *targets.AccessRequestListResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"authorize-session":         {"id", "host-id"},
+		"authorize-session":         {"id", "host-id", "access-request-id", "reason", "reverse"},
+		"list-access-requests":      {"id"},
+		"approve-access-request":    {"id", "access-request-id", "valid-for"},
+		"deny-access-request":       {"id", "access-request-id"},
 		"add-host-sources":          {"id", "host-source", "version"},
 		"remove-host-sources":       {"id", "host-source", "version"},
 		"set-host-sources":          {"id", "host-source", "version"},
-		"add-credential-sources":    {"id", "brokered-credential-source", "injected-application-credential-source", "version"},
+		"add-credential-sources":    {"id", "brokered-credential-source", "injected-application-credential-source", "exclusive-checkout-credential-source", "version"},
 		"remove-credential-sources": {"id", "brokered-credential-source", "injected-application-credential-source", "version"},
-		"set-credential-sources":    {"id", "brokered-credential-source", "injected-application-credential-source", "version"},
+		"set-credential-sources":    {"id", "brokered-credential-source", "injected-application-credential-source", "exclusive-checkout-credential-source", "version"},
 	}
 }
 
@@ -84,6 +95,15 @@ func extraSynopsisFuncImpl(c *Command) string {
 	case "authorize-session":
 		return "Request session authorization against the target"
 
+	case "list-access-requests":
+		return "List the access requests made against the target"
+
+	case "approve-access-request":
+		return "Approve a pending access request made against the target"
+
+	case "deny-access-request":
+		return "Deny a pending access request made against the target"
+
 	default:
 		return ""
 	}
@@ -202,7 +222,7 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 		})
 	case "authorize-session":
 		helpStr = base.WrapForHelpText([]string{
-			"Usage: boundary target authorize-session [options] [args]",
+			"Usage: boundary target authorize-session [options] [target ID or alias]",
 			"",
 			"  This command allows fetching session authorization credentials against a target. Example:",
 			"",
@@ -214,6 +234,44 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary targets authorize-session -scope-id o_1234567890 -name prod-ssh`,
 			"",
+			"    Request an authorized session using an alias of the target:",
+			"",
+			`      $ boundary targets authorize-session prod-ssh.example`,
+			"",
+			"    Request an authorized session using a previously approved access request:",
+			"",
+			`      $ boundary targets authorize-session -id ttcp_1234567890 -access-request-id sar_1234567890`,
+			"",
+			"",
+		})
+	case "list-access-requests":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary target list-access-requests [options] [args]",
+			"",
+			"  This command allows listing the access requests made against a target which requires approval. Example:",
+			"",
+			`      $ boundary targets list-access-requests -id ttcp_1234567890`,
+			"",
+			"",
+		})
+	case "approve-access-request":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary target approve-access-request [options] [args]",
+			"",
+			"  This command allows approving a pending access request made against a target. The requesting user can then authorize a session using the access request. Example:",
+			"",
+			`      $ boundary targets approve-access-request -id ttcp_1234567890 -access-request-id sar_1234567890 -valid-for 30m`,
+			"",
+			"",
+		})
+	case "deny-access-request":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary target deny-access-request [options] [args]",
+			"",
+			"  This command allows denying a pending access request made against a target. Example:",
+			"",
+			`      $ boundary targets deny-access-request -id ttcp_1234567890 -access-request-id sar_1234567890`,
+			"",
 			"",
 		})
 	}
@@ -253,6 +311,36 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagInjectedApplicationCredentialSources,
 				Usage:  "The credential source to add, set, or remove that Boundary will inject when creating a connection. May be specified multiple times.",
 			})
+		case "exclusive-checkout-credential-source":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "exclusive-checkout-credential-source",
+				Target: &c.flagExclusiveCheckoutCredentialSources,
+				Usage:  "A static credential, also supplied as a brokered or injected application credential source, that can only be held by one session at a time. May be specified multiple times.",
+			})
+		case "access-request-id":
+			f.StringVar(&base.StringVar{
+				Name:   "access-request-id",
+				Target: &c.flagAccessRequestId,
+				Usage:  "The ID of the access request to use, approve, or deny.",
+			})
+		case "reason":
+			f.StringVar(&base.StringVar{
+				Name:   "reason",
+				Target: &c.flagReason,
+				Usage:  "The reason access is needed, recorded on the access request created when the target requires approval.",
+			})
+		case "reverse":
+			f.BoolVar(&base.BoolVar{
+				Name:   "reverse",
+				Target: &c.flagReverse,
+				Usage:  "If set, authorize a reverse session, which forwards connections made to a listener on the worker to the client. Requires the reverse-connect action on the target.",
+			})
+		case "valid-for":
+			f.StringVar(&base.StringVar{
+				Name:   "valid-for",
+				Target: &c.flagValidFor,
+				Usage:  "How long the approved access request can be used to authorize a session. Can be specified as an integer number of seconds or a duration string. If not specified, the controller default of one hour is used.",
+			})
 		}
 	}
 
@@ -286,13 +374,18 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 	}
 }
 
-func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]targets.Option) bool {
+func extraFlagsHandlingFuncImpl(c *Command, f *base.FlagSets, opts *[]targets.Option) bool {
 	// This is custom logic because of the authorized-session handling. If we
 	// support all resources to be looked up by name + scope info we can
 	// eventually graduate this out to the main template.
 	if strutil.StrListContains(flagsMap[c.Func], "id") {
 		switch c.Func {
 		case "authorize-session":
+			// The target ID, or an alias of the target, can also be given as
+			// the only argument.
+			if args := f.Args(); c.FlagId == "" && len(args) == 1 {
+				c.FlagId = args[0]
+			}
 			if c.FlagId == "" &&
 				(c.FlagName == "" ||
 					(c.FlagScopeId == "" && c.FlagScopeName == "")) {
@@ -346,6 +439,9 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]targets.Op
 		if len(c.flagInjectedApplicationCredentialSources) > 0 {
 			*opts = append(*opts, targets.WithInjectedApplicationCredentialSourceIds(c.flagInjectedApplicationCredentialSources))
 		}
+		if len(c.flagExclusiveCheckoutCredentialSources) > 0 {
+			*opts = append(*opts, targets.WithExclusiveCheckoutCredentialSourceIds(c.flagExclusiveCheckoutCredentialSources))
+		}
 
 	case "set-credential-sources":
 		if len(c.flagBrokeredCredentialSources)+len(c.flagInjectedApplicationCredentialSources) == 0 {
@@ -377,11 +473,51 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]targets.Op
 		default:
 			*opts = append(*opts, targets.WithInjectedApplicationCredentialSourceIds(c.flagInjectedApplicationCredentialSources))
 		}
+		if len(c.flagExclusiveCheckoutCredentialSources) > 0 {
+			*opts = append(*opts, targets.WithExclusiveCheckoutCredentialSourceIds(c.flagExclusiveCheckoutCredentialSources))
+		}
 
 	case "authorize-session":
 		if len(c.flagHostId) != 0 {
 			*opts = append(*opts, targets.WithHostId(c.flagHostId))
 		}
+		if c.flagAccessRequestId != "" && c.flagReason != "" {
+			c.UI.Error("Cannot specify a reason when using an existing access request")
+			return false
+		}
+		if c.flagAccessRequestId != "" {
+			*opts = append(*opts, targets.WithAccessRequestId(c.flagAccessRequestId))
+		}
+		if c.flagReason != "" {
+			*opts = append(*opts, targets.WithReason(c.flagReason))
+		}
+		if c.flagReverse {
+			*opts = append(*opts, targets.WithReverse(true))
+		}
+
+	case "approve-access-request", "deny-access-request":
+		if c.flagAccessRequestId == "" {
+			c.UI.Error("Access request ID is required but not passed in via -access-request-id")
+			return false
+		}
+		if c.Func == "deny-access-request" {
+			*opts = append(*opts, targets.WithDeny(true))
+		}
+		if c.flagValidFor != "" {
+			var final uint32
+			dur, err := strconv.ParseUint(c.flagValidFor, 10, 32)
+			if err == nil {
+				final = uint32(dur)
+			} else {
+				dur, err := time.ParseDuration(c.flagValidFor)
+				if err != nil {
+					c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagValidFor, err))
+					return false
+				}
+				final = uint32(dur.Seconds())
+			}
+			*opts = append(*opts, targets.WithValidForSeconds(final))
+		}
 	}
 
 	return true
@@ -430,6 +566,16 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *targe
 		c.plural = "a session against target"
 		c.sar, err = targetClient.AuthorizeSession(c.Context, c.FlagId, opts...)
 		return nil, nil, nil, err
+	case "list-access-requests":
+		var err error
+		c.plural = "access requests for target"
+		c.accessRequestListResult, err = targetClient.ListAccessRequests(c.Context, c.FlagId, opts...)
+		return nil, nil, nil, err
+	case "approve-access-request", "deny-access-request":
+		var err error
+		c.plural = "access request for target"
+		c.accessRequestResult, err = targetClient.ApproveAccessRequest(c.Context, c.FlagId, c.flagAccessRequestId, opts...)
+		return nil, nil, nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
@@ -532,6 +678,30 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 	if item.IngressWorkerFilter != "" {
 		nonAttributeMap["Ingress Worker Filter"] = item.IngressWorkerFilter
 	}
+	if item.ApprovalRequired {
+		nonAttributeMap["Approval Required"] = item.ApprovalRequired
+	}
+	if item.WorkerSelectionStrategy != "" {
+		nonAttributeMap["Worker Selection Strategy"] = item.WorkerSelectionStrategy
+	}
+	if item.BandwidthLimitUp != 0 {
+		nonAttributeMap["Bandwidth Limit Up"] = item.BandwidthLimitUp
+	}
+	if item.BandwidthLimitDown != 0 {
+		nonAttributeMap["Bandwidth Limit Down"] = item.BandwidthLimitDown
+	}
+	if item.SessionBandwidthLimitUp != 0 {
+		nonAttributeMap["Session Bandwidth Limit Up"] = item.SessionBandwidthLimitUp
+	}
+	if item.SessionBandwidthLimitDown != 0 {
+		nonAttributeMap["Session Bandwidth Limit Down"] = item.SessionBandwidthLimitDown
+	}
+	if item.SessionConnectionRateLimit != 0 {
+		nonAttributeMap["Session Connection Rate Limit"] = item.SessionConnectionRateLimit
+	}
+	if item.IdleTimeout != 0 {
+		nonAttributeMap["Idle Timeout"] = item.IdleTimeout
+	}
 	if resp != nil && resp.Map != nil {
 		if resp.Map[globals.SessionConnectionLimitField] != nil {
 			nonAttributeMap["Session Connection Limit"] = item.SessionConnectionLimit
@@ -568,6 +738,9 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 				"ID":                  source.Id,
 				"Credential Store ID": source.CredentialStoreId,
 			}
+			if source.ExclusiveCheckout {
+				m["Exclusive Checkout"] = true
+			}
 			brokeredCredentialSourceMaps = append(brokeredCredentialSourceMaps, m)
 		}
 		credentialSourceMaps[credential.BrokeredPurpose] = brokeredCredentialSourceMaps
@@ -585,6 +758,9 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 				"ID":                  source.Id,
 				"Credential Store ID": source.CredentialStoreId,
 			}
+			if source.ExclusiveCheckout {
+				m["Exclusive Checkout"] = true
+			}
 			injectedApplicationCredentialSourceMaps = append(injectedApplicationCredentialSourceMaps, m)
 		}
 		credentialSourceMaps[credential.InjectedApplicationPurpose] = injectedApplicationCredentialSourceMaps
@@ -667,6 +843,12 @@ func printCustomActionOutputImpl(c *Command) (bool, error) {
 
 		switch base.Format(c.UI) {
 		case "table":
+			if item.AccessRequest != nil && item.SessionId == "" {
+				c.UI.Output(printAccessRequestTable(item.AccessRequest))
+				c.UI.Output("The target requires approval. Once the access request is approved, authorize a session again using -access-request-id.")
+				return true, nil
+			}
+
 			var ret []string
 
 			nonAttributeMap := map[string]any{
@@ -779,11 +961,94 @@ func printCustomActionOutputImpl(c *Command) (bool, error) {
 			}
 			return true, nil
 		}
+
+	case "list-access-requests":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printAccessRequestListTable(c.accessRequestListResult.GetItems()))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItems(c.accessRequestListResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "approve-access-request", "deny-access-request":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printAccessRequestTable(c.accessRequestResult.GetItem()))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.accessRequestResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
 	}
 
 	return false, nil
 }
 
+func accessRequestMap(item *targets.AccessRequest) map[string]any {
+	m := map[string]any{
+		"ID":        item.Id,
+		"Target ID": item.TargetId,
+		"User ID":   item.UserId,
+		"Status":    item.Status,
+	}
+	if item.Reason != "" {
+		m["Reason"] = item.Reason
+	}
+	if item.ApproverId != "" {
+		m["Approver ID"] = item.ApproverId
+	}
+	if !item.ApprovalExpirationTime.IsZero() {
+		m["Approval Expiration Time"] = item.ApprovalExpirationTime.Local().Format(time.RFC1123)
+	}
+	if item.SessionId != "" {
+		m["Session ID"] = item.SessionId
+	}
+	if !item.CreatedTime.IsZero() {
+		m["Created Time"] = item.CreatedTime.Local().Format(time.RFC1123)
+	}
+	if item.Version != 0 {
+		m["Version"] = item.Version
+	}
+	return m
+}
+
+func printAccessRequestTable(item *targets.AccessRequest) string {
+	m := accessRequestMap(item)
+	ret := []string{
+		"",
+		"Access request information:",
+		base.WrapMap(2, base.MaxAttributesLength(m, nil, nil), m),
+		"",
+	}
+	return base.WrapForHelpText(ret)
+}
+
+func printAccessRequestListTable(items []*targets.AccessRequest) string {
+	if len(items) == 0 {
+		return "No access requests found"
+	}
+	ret := []string{
+		"",
+		"Access request information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			ret = append(ret, "")
+		}
+		m := accessRequestMap(item)
+		ret = append(ret, base.WrapMap(2, base.MaxAttributesLength(m, nil, nil), m))
+	}
+	return base.WrapForHelpText(ret)
+}
+
 var keySubstMap = map[string]string{
 	"default_port":             "Default Port",
 	"default_client_port":      "Default Client Port",
@@ -832,3 +1097,112 @@ func exampleOutput() string {
 	}
 	return printItemTable(item, nil)
 }
+
+// limitCmdVars holds the bandwidth, connection rate, and idle timeout flags
+// shared by the tcp and ssh target commands.
+type limitCmdVars struct {
+	flagBandwidthLimitUp           string
+	flagBandwidthLimitDown         string
+	flagSessionBandwidthLimitUp    string
+	flagSessionBandwidthLimitDown  string
+	flagSessionConnectionRateLimit string
+	flagIdleTimeout                string
+}
+
+// addLimitFlag adds the named limit flag to fs. It returns false if name is
+// not a limit flag.
+func (l *limitCmdVars) addLimitFlag(fs *base.FlagSet, name string) bool {
+	var target *string
+	var usage string
+	switch name {
+	case "bandwidth-limit-up":
+		target = &l.flagBandwidthLimitUp
+		usage = "The maximum bytes per second sent from clients to the target, shared by all of the target's sessions on a worker. 0 means unlimited."
+	case "bandwidth-limit-down":
+		target = &l.flagBandwidthLimitDown
+		usage = "The maximum bytes per second sent from the target to clients, shared by all of the target's sessions on a worker. 0 means unlimited."
+	case "session-bandwidth-limit-up":
+		target = &l.flagSessionBandwidthLimitUp
+		usage = "The maximum bytes per second sent from the client to the target in a session. 0 means unlimited."
+	case "session-bandwidth-limit-down":
+		target = &l.flagSessionBandwidthLimitDown
+		usage = "The maximum bytes per second sent from the target to the client in a session. 0 means unlimited."
+	case "session-connection-rate-limit":
+		target = &l.flagSessionConnectionRateLimit
+		usage = "The maximum number of new connections per minute allowed in a session. 0 means unlimited."
+	case "idle-timeout":
+		target = &l.flagIdleTimeout
+		usage = "How long a connection can go without sending data in either direction before it is closed. Can be specified as an integer number of seconds or a duration string. 0 means connections are never closed for being idle."
+	default:
+		return false
+	}
+	fs.StringVar(&base.StringVar{
+		Name:   name,
+		Target: target,
+		Usage:  usage,
+	})
+	return true
+}
+
+// limitOptions appends the options for the limit flags that were set to opts.
+// It returns false if a flag value could not be parsed.
+func (l *limitCmdVars) limitOptions(c *base.Command, opts *[]targets.Option) bool {
+	bandwidthFlags := []struct {
+		value   string
+		with    func(int64) targets.Option
+		without func() targets.Option
+	}{
+		{l.flagBandwidthLimitUp, targets.WithBandwidthLimitUp, targets.DefaultBandwidthLimitUp},
+		{l.flagBandwidthLimitDown, targets.WithBandwidthLimitDown, targets.DefaultBandwidthLimitDown},
+		{l.flagSessionBandwidthLimitUp, targets.WithSessionBandwidthLimitUp, targets.DefaultSessionBandwidthLimitUp},
+		{l.flagSessionBandwidthLimitDown, targets.WithSessionBandwidthLimitDown, targets.DefaultSessionBandwidthLimitDown},
+	}
+	for _, f := range bandwidthFlags {
+		switch f.value {
+		case "":
+		case "null":
+			*opts = append(*opts, f.without())
+		default:
+			limit, err := strconv.ParseInt(f.value, 10, 64)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", f.value, err))
+				return false
+			}
+			*opts = append(*opts, f.with(limit))
+		}
+	}
+
+	switch l.flagSessionConnectionRateLimit {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionConnectionRateLimit())
+	default:
+		limit, err := strconv.ParseInt(l.flagSessionConnectionRateLimit, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", l.flagSessionConnectionRateLimit, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSessionConnectionRateLimit(int32(limit)))
+	}
+
+	switch l.flagIdleTimeout {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultIdleTimeout())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(l.flagIdleTimeout, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(l.flagIdleTimeout)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", l.flagIdleTimeout, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithIdleTimeout(final))
+	}
+	return true
+}
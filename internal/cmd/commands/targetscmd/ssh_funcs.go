@@ -25,12 +25,14 @@ func extraSshActionsFlagsMapFuncImpl() map[string][]string {
 		"create": {
 			"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit",
 			"egress-worker-filter", "ingress-worker-filter", "enable-session-recording",
-			"storage-bucket-id",
+			"storage-bucket-id", "approval-required", "worker-selection-strategy",
+			"bandwidth-limit-up", "bandwidth-limit-down", "session-bandwidth-limit-up", "session-bandwidth-limit-down", "session-connection-rate-limit", "idle-timeout",
 		},
 		"update": {
 			"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit",
 			"worker-filter", "egress-worker-filter", "ingress-worker-filter", "enable-session-recording",
-			"storage-bucket-id",
+			"storage-bucket-id", "approval-required", "worker-selection-strategy",
+			"bandwidth-limit-up", "bandwidth-limit-down", "session-bandwidth-limit-up", "session-bandwidth-limit-down", "session-connection-rate-limit", "idle-timeout",
 		},
 	}
 }
@@ -46,6 +48,9 @@ type extraSshCmdVars struct {
 	flagAddress                string
 	flagStorageBucketId        string
 	flagEnableSessionRecording string
+	flagApprovalRequired       string
+	flagWorkerSelection        string
+	limitCmdVars
 }
 
 func (c *SshCommand) extraSshHelpFunc(helpMap map[string]func() string) string {
@@ -141,6 +146,20 @@ func extraSshFlagsFuncImpl(c *SshCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagEnableSessionRecording,
 				Usage:  "A boolean indicating if session recording is enabled for this target.",
 			})
+		case "approval-required":
+			fs.StringVar(&base.StringVar{
+				Name:   "approval-required",
+				Target: &c.flagApprovalRequired,
+				Usage:  "A boolean indicating if sessions to this target must be approved by another user before they can be authorized.",
+			})
+		case "worker-selection-strategy":
+			fs.StringVar(&base.StringVar{
+				Name:   "worker-selection-strategy",
+				Target: &c.flagWorkerSelection,
+				Usage:  `The strategy used to order the workers that can handle sessions for this target: "random", "least_connections", or "locality". If not set, the controller's default is used.`,
+			})
+		default:
+			c.addLimitFlag(fs, name)
 		}
 	}
 }
@@ -267,7 +286,26 @@ func extraSshFlagsHandlingFuncImpl(c *SshCommand, _ *base.FlagSets, opts *[]targ
 		return false
 	}
 
-	return true
+	switch c.flagApprovalRequired {
+	case "":
+	case "false":
+		*opts = append(*opts, targets.WithApprovalRequired(false))
+	case "true":
+		*opts = append(*opts, targets.WithApprovalRequired(true))
+	default:
+		c.UI.Error(fmt.Sprintf("Invalid bool value for approval-required %v", c.flagApprovalRequired))
+		return false
+	}
+
+	switch c.flagWorkerSelection {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultWorkerSelectionStrategy())
+	default:
+		*opts = append(*opts, targets.WithWorkerSelectionStrategy(c.flagWorkerSelection))
+	}
+
+	return c.limitOptions(c.Command, opts)
 }
 
 func extraSshSynopsisFuncImpl(_ *SshCommand) string {
@@ -20,17 +20,22 @@ func init() {
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 type extraCmdVars struct {
-	flagAccounts []string
+	flagAccounts      []string
+	flagResourceId    string
+	flagAction        string
+	explanationResult *users.PermissionExplanationReadResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"add-accounts":    {"id", "account", "version"},
-		"set-accounts":    {"id", "account", "version"},
-		"remove-accounts": {"id", "account", "version"},
+		"add-accounts":        {"id", "account", "version"},
+		"set-accounts":        {"id", "account", "version"},
+		"remove-accounts":     {"id", "account", "version"},
+		"explain-permissions": {"id", "resource-id", "action"},
 	}
 }
 
@@ -47,6 +52,9 @@ func extraSynopsisFuncImpl(c *Command) string {
 			in = "Remove accounts from"
 		}
 		return wordwrap.WrapString(fmt.Sprintf("%s a user within Boundary", in), base.TermWidth)
+
+	case "explain-permissions":
+		return "Explain whether a user is allowed to perform an action on a resource"
 	}
 
 	return ""
@@ -88,6 +96,17 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 		})
 
+	case "explain-permissions":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary users explain-permissions [options] [args]",
+			"",
+			"  Evaluates whether a user is allowed to perform an action on a resource given its ID. The output lists the grants that allow the action, along with the roles they are in and the principals (user, group, or managed group) through which the user is assigned those roles. If no grant allows the action, the reasons are listed instead. Example:",
+			"",
+			`    $ boundary users explain-permissions -id u_1234567890 -resource-id ttcp_1234567890 -action authorize-session`,
+			"",
+			"",
+		})
+
 	default:
 		helpStr = helpMap["base"]()
 	}
@@ -103,6 +122,18 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagAccounts,
 				Usage:  "The accounts to add, remove, or set. May be specified multiple times.",
 			})
+		case "resource-id":
+			f.StringVar(&base.StringVar{
+				Name:   "resource-id",
+				Target: &c.flagResourceId,
+				Usage:  "The ID of the resource to evaluate the user's permissions against.",
+			})
+		case "action":
+			f.StringVar(&base.StringVar{
+				Name:   "action",
+				Target: &c.flagAction,
+				Usage:  "The action to evaluate the user's permissions for, e.g. authorize-session.",
+			})
 		}
 	}
 }
@@ -125,6 +156,16 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]users.Opti
 				c.flagAccounts = nil
 			}
 		}
+
+	case "explain-permissions":
+		if c.flagResourceId == "" {
+			c.UI.Error("Resource ID is required but not passed in via -resource-id")
+			return false
+		}
+		if c.flagAction == "" {
+			c.UI.Error("Action is required but not passed in via -action")
+			return false
+		}
 	}
 
 	return true
@@ -150,10 +191,33 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *users
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "explain-permissions":
+		var err error
+		c.plural = "permissions for user"
+		c.explanationResult, err = userClient.ExplainPermissions(c.Context, c.FlagId, c.flagResourceId, c.flagAction, opts...)
+		return nil, nil, nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "explain-permissions":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printExplanationTable(c.explanationResult.GetItem()))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.explanationResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (c *Command) printListTable(items []*users.User) string {
 	if len(items) == 0 {
 		return "No users found"
@@ -263,7 +327,7 @@ func printItemTable(item *users.User, resp *api.Response) string {
 		nonAttributeMap["Email"] = item.Email
 	}
 
-	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+	maxLength := base.MaxAttributesLength(nonAttributeMap, item.Attributes, nil)
 
 	var userMaps []map[string]any
 	if len(item.Accounts) > 0 {
@@ -301,6 +365,14 @@ func printItemTable(item *users.User, resp *api.Response) string {
 		)
 	}
 
+	if len(item.Attributes) > 0 {
+		ret = append(ret,
+			"",
+			"  Attributes:",
+			base.WrapMap(4, maxLength, item.Attributes),
+		)
+	}
+
 	if len(item.Accounts) > 0 {
 		ret = append(ret,
 			"",
@@ -316,3 +388,60 @@ func printItemTable(item *users.User, resp *api.Response) string {
 
 	return base.WrapForHelpText(ret)
 }
+
+func printExplanationTable(item *users.PermissionExplanation) string {
+	nonAttributeMap := map[string]any{
+		"User ID":       item.UserId,
+		"Resource ID":   item.ResourceId,
+		"Resource Type": item.ResourceType,
+		"Scope ID":      item.ScopeId,
+		"Action":        item.Action,
+		"Authorized":    item.Authorized,
+	}
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Permission explanation:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if len(item.MatchingGrants) > 0 {
+		ret = append(ret,
+			"",
+			"  Matching Grants:",
+		)
+		for _, g := range item.MatchingGrants {
+			m := map[string]any{
+				"Grant":          g.Grant,
+				"Role ID":        g.RoleId,
+				"Role Scope ID":  g.RoleScopeId,
+				"Grant Scope ID": g.GrantScopeId,
+			}
+			ret = append(ret, base.WrapMap(4, base.MaxAttributesLength(m, nil, nil), m))
+			if len(g.Principals) > 0 {
+				ret = append(ret, "    Principals:")
+				for _, p := range g.Principals {
+					pm := map[string]any{
+						"ID":       p.Id,
+						"Type":     p.Type,
+						"Scope ID": p.ScopeId,
+					}
+					ret = append(ret, base.WrapMap(6, base.MaxAttributesLength(pm, nil, nil), pm), "")
+				}
+			} else {
+				ret = append(ret, "")
+			}
+		}
+	}
+
+	if len(item.Reasons) > 0 {
+		ret = append(ret,
+			"",
+			"  Reasons:",
+			base.WrapSlice(4, item.Reasons),
+		)
+	}
+
+	return base.WrapForHelpText(ret)
+}
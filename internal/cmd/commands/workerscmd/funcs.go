@@ -22,11 +22,16 @@ func init() {
 	executeExtraActions = executeExtraActionsImpl
 }
 
+type extraCmdVars struct {
+	flagDrainTimeout string
+}
+
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"add-worker-tags":    {"id", "tag", "version"},
 		"set-worker-tags":    {"id", "tag", "version"},
 		"remove-worker-tags": {"id", "tag", "version"},
+		"drain":              {"id", "version", "drain-timeout"},
 	}
 }
 
@@ -38,6 +43,8 @@ func extraSynopsisFuncImpl(c *Command) string {
 		return "Set api tags for the specified worker"
 	case "remove-worker-tags":
 		return "Remove api tags from the specified worker"
+	case "drain":
+		return "Drain the specified worker"
 	default:
 		return ""
 	}
@@ -94,6 +101,18 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"",
 		})
+	case "drain":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary workers drain [options] [args]",
+			"",
+			"  Drain a worker. The worker is no longer used for new sessions and its existing connections are allowed to finish until the drain timeout, after which they are closed and the worker shuts down. Example:",
+			"",
+			"    Drain a worker, closing any remaining connections after 30 minutes:",
+			"",
+			`      $ boundary workers drain -id w_1234567890 -drain-timeout 30m`,
+			"",
+			"",
+		})
 	default:
 		helpStr = helpMap[c.Func]()
 	}
@@ -116,6 +135,12 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				NullCheck: nullCheckFn,
 				Usage:     "The api tag resources to add, remove, or set.",
 			})
+		case "drain-timeout":
+			f.StringVar(&base.StringVar{
+				Name:   "drain-timeout",
+				Target: &c.flagDrainTimeout,
+				Usage:  "How long existing connections are allowed to finish before they are closed, as a duration (e.g. 30m). Defaults to one hour.",
+			})
 		}
 	}
 }
@@ -161,6 +186,20 @@ func executeExtraActionsImpl(c *Command, inResp *api.Response, inItem *workers.W
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "drain":
+		var drainTimeout time.Duration
+		if c.flagDrainTimeout != "" {
+			var err error
+			drainTimeout, err = time.ParseDuration(c.flagDrainTimeout)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("error parsing -drain-timeout: %w", err)
+			}
+		}
+		result, err := workerClient.Drain(c.Context, c.FlagId, version, drainTimeout, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
 	}
 	return inResp, inItem, inItems, inErr
 }
@@ -223,6 +262,11 @@ func (c *Command) printListTable(items []*workers.Worker) string {
 				fmt.Sprintf("    ReleaseVersion:          %s", item.ReleaseVersion),
 			)
 		}
+		if item.OperationalState != "" {
+			output = append(output,
+				fmt.Sprintf("    Operational State:       %s", item.OperationalState),
+			)
+		}
 		if !item.LastStatusTime.IsZero() {
 			output = append(output,
 				fmt.Sprintf("    Last Status Time:        %s", item.LastStatusTime.Format(time.RFC1123)),
@@ -277,6 +321,12 @@ func printItemTable(item *workers.Worker, resp *api.Response) string {
 	if !item.LastStatusTime.IsZero() {
 		nonAttributeMap["Last Status Time"] = item.LastStatusTime
 	}
+	if item.OperationalState != "" {
+		nonAttributeMap["Operational State"] = item.OperationalState
+	}
+	if !item.DrainDeadline.IsZero() {
+		nonAttributeMap["Drain Deadline"] = item.DrainDeadline.Local().Format(time.RFC1123)
+	}
 	if item.ControllerGeneratedActivationToken != "" {
 		nonAttributeMap["Controller-Generated Activation Token"] = item.ControllerGeneratedActivationToken
 	}
@@ -28,3 +28,19 @@ func MakeSigUSR2Ch() chan struct{} {
 	}()
 	return resultCh
 }
+
+// MakeSigUSR1Ch returns a channel that can be used for SIGUSR1 worker
+// draining. This channel will send a message for every SIGUSR1 received.
+func MakeSigUSR1Ch() chan struct{} {
+	resultCh := make(chan struct{})
+
+	signalCh := make(chan os.Signal, 4)
+	signal.Notify(signalCh, syscall.SIGUSR1)
+	go func() {
+		for {
+			<-signalCh
+			resultCh <- struct{}{}
+		}
+	}()
+	return resultCh
+}
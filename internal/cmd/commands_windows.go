@@ -7,3 +7,8 @@ package cmd
 func MakeSigUSR2Ch() chan struct{} {
 	return make(chan struct{})
 }
+
+// MakeSigUSR1Ch does nothing useful on Windows.
+func MakeSigUSR1Ch() chan struct{} {
+	return make(chan struct{})
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/event"
 	"github.com/hashicorp/boundary/internal/ratelimit"
+	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/util"
 	kms_plugin_assets "github.com/hashicorp/boundary/plugins/kms"
 	"github.com/hashicorp/boundary/sdk/wrapper"
@@ -229,6 +230,15 @@ type Controller struct {
 	// it is rejected by the controller.
 	MaxPageSizeRaw any  `hcl:"max_page_size"`
 	MaxPageSize    uint `hcl:"-"`
+
+	// SessionQuotas limits the number of sessions that can be active at the
+	// same time.
+	SessionQuotas SessionQuotas `hcl:"session_quotas"`
+
+	// WorkerSelectionStrategy is the default strategy used to order the
+	// workers that can handle a session, for targets that do not specify one.
+	// If not set, workers are ordered randomly.
+	WorkerSelectionStrategy string `hcl:"worker_selection_strategy"`
 }
 
 func (c *Controller) InitNameIfEmpty(ctx context.Context) error {
@@ -287,6 +297,17 @@ type Worker struct {
 	SuccessfulStatusGracePeriod         interface{}   `hcl:"successful_status_grace_period"`
 	SuccessfulStatusGracePeriodDuration time.Duration `hcl:"-"`
 
+	// DrainTimeout represents the period of time (as a duration) that the
+	// worker lets existing connections finish when it is drained by sending it
+	// SIGUSR1. Defaults to one hour.
+	DrainTimeout         interface{}   `hcl:"drain_timeout"`
+	DrainTimeoutDuration time.Duration `hcl:"-"`
+
+	// ReverseListenAddress is the host that the listeners of reverse sessions
+	// are opened on, with a port picked for each session. Defaults to all
+	// interfaces.
+	ReverseListenAddress string `hcl:"reverse_listen_address"`
+
 	// AuthStoragePath represents the location a worker stores its node credentials, if set
 	AuthStoragePath string `hcl:"auth_storage_path"`
 
@@ -303,6 +324,34 @@ type Worker struct {
 	// pre-0.13 method of using KMSes to authenticate. This is currently only
 	// supported to throw an error if used telling people they need to upgrade.
 	UseDeprecatedKmsAuthMethod bool `hcl:"use_deprecated_kms_auth_method"`
+
+	// HostHealthCheck configures the health checks the worker runs against the
+	// hosts of the targets it is able to reach. Health checks are disabled if
+	// it is not set.
+	HostHealthCheck *HostHealthCheck `hcl:"host_health_check"`
+}
+
+// HostHealthCheck is the configuration block that specifies how a worker
+// health checks hosts.
+type HostHealthCheck struct {
+	// Type is the type of health check, either "tcp" (the default), which
+	// checks that a connection can be established to the host, or "http",
+	// which checks that an HTTP GET request to HttpPath on the host returns a
+	// 2xx or 3xx status code.
+	Type string `hcl:"type"`
+
+	// HttpPath is the path requested by "http" health checks. Defaults to "/".
+	HttpPath string `hcl:"http_path"`
+
+	// Interval is the time between health checks of each host. Defaults to
+	// 30 seconds.
+	Interval         any           `hcl:"interval"`
+	IntervalDuration time.Duration `hcl:"-"`
+
+	// Timeout is the time after which a health check of a host fails.
+	// Defaults to 5 seconds.
+	Timeout         any           `hcl:"timeout"`
+	TimeoutDuration time.Duration `hcl:"-"`
 }
 
 type Database struct {
@@ -337,6 +386,23 @@ type Scheduler struct {
 	MonitorIntervalDuration time.Duration
 }
 
+// SessionQuotas configures the maximum number of pending or active sessions
+// that may exist at the same time. A value of zero means the quota is not
+// enforced.
+type SessionQuotas struct {
+	// MaxActivePerUser is the maximum number of active sessions a single user
+	// may hold across all targets.
+	MaxActivePerUser int `hcl:"max_active_per_user"`
+
+	// MaxActivePerUserPerTarget is the maximum number of active sessions a
+	// single user may hold against a single target.
+	MaxActivePerUserPerTarget int `hcl:"max_active_per_user_per_target"`
+
+	// MaxActivePerProject is the maximum number of active sessions that may
+	// exist in a single project.
+	MaxActivePerProject int `hcl:"max_active_per_project"`
+}
+
 type Plugins struct {
 	ExecutionDir string `hcl:"execution_dir"`
 }
@@ -663,6 +729,19 @@ func Parse(d string) (*Config, error) {
 			}
 		}
 
+		switch {
+		case result.Controller.SessionQuotas.MaxActivePerUser < 0:
+			return nil, errors.New("Controller session quota max active per user value is negative")
+		case result.Controller.SessionQuotas.MaxActivePerUserPerTarget < 0:
+			return nil, errors.New("Controller session quota max active per user per target value is negative")
+		case result.Controller.SessionQuotas.MaxActivePerProject < 0:
+			return nil, errors.New("Controller session quota max active per project value is negative")
+		}
+
+		if s := result.Controller.WorkerSelectionStrategy; s != "" && !server.WorkerSelectionStrategy(s).Valid() {
+			return nil, fmt.Errorf("Controller worker selection strategy %q is unknown", s)
+		}
+
 		if result.Controller.Database != nil {
 			if result.Controller.Database.MaxOpenConnectionsRaw != nil {
 				switch t := result.Controller.Database.MaxOpenConnectionsRaw.(type) {
@@ -790,6 +869,17 @@ func Parse(d string) (*Config, error) {
 			return nil, errors.New("Successful status grace period value is negative")
 		}
 
+		if drainTimeout := result.Worker.DrainTimeout; !util.IsNil(drainTimeout) {
+			t, err := parseutil.ParseDurationSecond(drainTimeout)
+			if err != nil {
+				return result, err
+			}
+			result.Worker.DrainTimeoutDuration = t
+		}
+		if result.Worker.DrainTimeoutDuration < 0 {
+			return nil, errors.New("Drain timeout value is negative")
+		}
+
 		switch {
 		case result.Worker.StatusCallTimeoutDuration == 0 && result.Worker.SuccessfulStatusGracePeriodDuration == 0:
 			// Nothing
@@ -801,6 +891,41 @@ func Parse(d string) (*Config, error) {
 			return nil, fmt.Errorf("Worker settings for status call timeout duration and successful status grace period duration must either both be set or both be empty")
 		}
 
+		if hc := result.Worker.HostHealthCheck; hc != nil {
+			switch hc.Type {
+			case "":
+				hc.Type = "tcp"
+			case "tcp", "http":
+			default:
+				return nil, fmt.Errorf("Unknown host health check type %q", hc.Type)
+			}
+			if hc.HttpPath == "" {
+				hc.HttpPath = "/"
+			}
+			hc.IntervalDuration = 30 * time.Second
+			if hc.Interval != nil {
+				t, err := parseutil.ParseDurationSecond(hc.Interval)
+				if err != nil {
+					return nil, fmt.Errorf("Error parsing host health check interval: %w", err)
+				}
+				hc.IntervalDuration = t
+			}
+			if hc.IntervalDuration <= 0 {
+				return nil, errors.New("Host health check interval must be positive")
+			}
+			hc.TimeoutDuration = 5 * time.Second
+			if hc.Timeout != nil {
+				t, err := parseutil.ParseDurationSecond(hc.Timeout)
+				if err != nil {
+					return nil, fmt.Errorf("Error parsing host health check timeout: %w", err)
+				}
+				hc.TimeoutDuration = t
+			}
+			if hc.TimeoutDuration <= 0 {
+				return nil, errors.New("Host health check timeout must be positive")
+			}
+		}
+
 		if result.Worker.TagsRaw != nil {
 			switch t := result.Worker.TagsRaw.(type) {
 			// We allow `tags` to be a simple string containing a URL with schema.
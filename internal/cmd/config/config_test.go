@@ -2785,3 +2785,231 @@ func TestMaxPageSize(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionQuotas(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		in        string
+		want      SessionQuotas
+		expErrStr string
+	}{
+		{
+			name: "not set",
+			in: `
+			controller {
+				name = "example-controller"
+			}`,
+			want: SessionQuotas{},
+		},
+		{
+			name: "all set",
+			in: `
+			controller {
+				name = "example-controller"
+				session_quotas {
+					max_active_per_user = 10
+					max_active_per_user_per_target = 2
+					max_active_per_project = 500
+				}
+			}`,
+			want: SessionQuotas{
+				MaxActivePerUser:          10,
+				MaxActivePerUserPerTarget: 2,
+				MaxActivePerProject:       500,
+			},
+		},
+		{
+			name: "negative per user",
+			in: `
+			controller {
+				name = "example-controller"
+				session_quotas {
+					max_active_per_user = -1
+				}
+			}`,
+			expErrStr: "Controller session quota max active per user value is negative",
+		},
+		{
+			name: "negative per user per target",
+			in: `
+			controller {
+				name = "example-controller"
+				session_quotas {
+					max_active_per_user_per_target = -1
+				}
+			}`,
+			expErrStr: "Controller session quota max active per user per target value is negative",
+		},
+		{
+			name: "negative per project",
+			in: `
+			controller {
+				name = "example-controller"
+				session_quotas {
+					max_active_per_project = -1
+				}
+			}`,
+			expErrStr: "Controller session quota max active per project value is negative",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c, err := Parse(tt.in)
+			if tt.expErrStr != "" {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, c)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Controller)
+			assert.Equal(t, tt.want, c.Controller.SessionQuotas)
+		})
+	}
+}
+
+func TestWorkerSelectionStrategy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		in        string
+		want      string
+		expErrStr string
+	}{
+		{
+			name: "not set",
+			in: `
+			controller {
+				name = "example-controller"
+			}`,
+		},
+		{
+			name: "valid",
+			in: `
+			controller {
+				name = "example-controller"
+				worker_selection_strategy = "least_connections"
+			}`,
+			want: "least_connections",
+		},
+		{
+			name: "unknown",
+			in: `
+			controller {
+				name = "example-controller"
+				worker_selection_strategy = "round_robin"
+			}`,
+			expErrStr: `Controller worker selection strategy "round_robin" is unknown`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c, err := Parse(tt.in)
+			if tt.expErrStr != "" {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, c)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Controller)
+			assert.Equal(t, tt.want, c.Controller.WorkerSelectionStrategy)
+		})
+	}
+}
+
+func TestHostHealthCheck(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		in        string
+		want      *HostHealthCheck
+		expErrStr string
+	}{
+		{
+			name: "not set",
+			in: `
+			worker {
+				name = "example-worker"
+			}`,
+		},
+		{
+			name: "defaults",
+			in: `
+			worker {
+				name = "example-worker"
+				host_health_check {}
+			}`,
+			want: &HostHealthCheck{
+				Type:             "tcp",
+				HttpPath:         "/",
+				IntervalDuration: 30 * time.Second,
+				TimeoutDuration:  5 * time.Second,
+			},
+		},
+		{
+			name: "http",
+			in: `
+			worker {
+				name = "example-worker"
+				host_health_check {
+					type = "http"
+					http_path = "/healthz"
+					interval = "1m"
+					timeout = 2
+				}
+			}`,
+			want: &HostHealthCheck{
+				Type:             "http",
+				HttpPath:         "/healthz",
+				Interval:         "1m",
+				IntervalDuration: time.Minute,
+				Timeout:          2,
+				TimeoutDuration:  2 * time.Second,
+			},
+		},
+		{
+			name: "unknown type",
+			in: `
+			worker {
+				name = "example-worker"
+				host_health_check {
+					type = "icmp"
+				}
+			}`,
+			expErrStr: `Unknown host health check type "icmp"`,
+		},
+		{
+			name: "negative interval",
+			in: `
+			worker {
+				name = "example-worker"
+				host_health_check {
+					interval = "-1s"
+				}
+			}`,
+			expErrStr: "Host health check interval must be positive",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c, err := Parse(tt.in)
+			if tt.expErrStr != "" {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, c)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Worker)
+			assert.Equal(t, tt.want, c.Worker.HostHealthCheck)
+		})
+	}
+}
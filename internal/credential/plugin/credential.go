@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/plugin/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// A CredentialStatus represents the status of a plugin credential.
+type CredentialStatus string
+
+const (
+	// ActiveCredential represents a plugin credential that is being used in
+	// an active session. Renewable credentials in this state are renewed
+	// before they expire.
+	ActiveCredential CredentialStatus = "active"
+
+	// RevokeCredential represents a plugin credential that needs to be
+	// revoked.
+	RevokeCredential CredentialStatus = "revoke"
+
+	// RevokedCredential represents a credential that has been revoked. This is a
+	// terminal status. It does not transition to ExpiredCredential.
+	RevokedCredential CredentialStatus = "revoked"
+
+	// ExpiredCredential represents a credential that expired. This is a terminal
+	// status. It does not transition to RevokedCredential.
+	ExpiredCredential CredentialStatus = "expired"
+
+	// UnknownCredentialStatus represents a credential that has an unknown
+	// status.
+	UnknownCredentialStatus CredentialStatus = "unknown"
+)
+
+// A Credential contains the lease data for a credential issued by a
+// credential plugin. It is owned by a credential library.
+type Credential struct {
+	*store.Credential
+	tableName  string        `gorm:"-"`
+	expiration time.Duration `gorm:"-"`
+}
+
+func newCredential(ctx context.Context, libraryId, sessionId, externalId string, renewable bool, expiration time.Duration) (*Credential, error) {
+	const op = "plugin.newCredential"
+	switch {
+	case libraryId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no library id")
+	case sessionId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	case externalId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no external id")
+	}
+
+	c := &Credential{
+		expiration: expiration.Round(time.Second),
+		Credential: &store.Credential{
+			LibraryId:   libraryId,
+			SessionId:   sessionId,
+			ExternalId:  externalId,
+			IsRenewable: renewable,
+			Status:      string(ActiveCredential),
+		},
+	}
+	return c, nil
+}
+
+func allocCredential() *Credential {
+	return &Credential{
+		Credential: &store.Credential{},
+	}
+}
+
+func (c *Credential) clone() *Credential {
+	cp := proto.Clone(c.Credential)
+	return &Credential{
+		expiration: c.expiration,
+		Credential: cp.(*store.Credential),
+	}
+}
+
+// TableName returns the table name.
+func (c *Credential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential_plugin_credential"
+}
+
+// SetTableName sets the table name.
+func (c *Credential) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *Credential) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{c.PublicId},
+		"resource-type":      []string{"credential-plugin-credential"},
+		"op-type":            []string{op.String()},
+	}
+	if c.LibraryId != "" {
+		metadata["library-id"] = []string{c.LibraryId}
+	}
+	return metadata
+}
+
+// issuingLibrary is a credential library along with the purpose the
+// credential is being issued for.
+type issuingLibrary struct {
+	*CredentialLibrary
+	purpose credential.Purpose
+}
+
+var _ credential.Dynamic = (*baseCred)(nil)
+
+type baseCred struct {
+	*Credential
+
+	lib        *issuingLibrary
+	secretData map[string]any
+}
+
+func (bc *baseCred) Secret() credential.SecretData { return bc.secretData }
+func (bc *baseCred) Library() credential.Library   { return bc.lib }
+func (bc *baseCred) Purpose() credential.Purpose   { return bc.lib.purpose }
+
+// convert converts bc to a specific credential type if the library of bc
+// is not UnspecifiedType. An error is returned if the secret data returned
+// by the plugin does not contain the fields required by the credential type.
+func convert(ctx context.Context, bc *baseCred) (credential.Dynamic, error) {
+	const op = "plugin.convert"
+	switch bc.Library().CredentialType() {
+	case globals.UsernamePasswordCredentialType:
+		username, _ := bc.secretData["username"].(string)
+		password, _ := bc.secretData["password"].(string)
+		if username == "" || password == "" {
+			return nil, errors.New(ctx, errors.ExternalPlugin, op, "plugin returned a secret without a username and password")
+		}
+		return &usrPassCred{
+			baseCred: bc,
+			username: username,
+			password: credential.Password(password),
+		}, nil
+	case globals.SshPrivateKeyCredentialType:
+		username, _ := bc.secretData["username"].(string)
+		pk, _ := bc.secretData["private_key"].(string)
+		if username == "" || pk == "" {
+			return nil, errors.New(ctx, errors.ExternalPlugin, op, "plugin returned a secret without a username and private key")
+		}
+		var passphrase []byte
+		if p, _ := bc.secretData["private_key_passphrase"].(string); p != "" {
+			passphrase = []byte(p)
+		}
+		return &sshPrivateKeyCred{
+			baseCred:   bc,
+			username:   username,
+			privateKey: credential.PrivateKey(pk),
+			passphrase: passphrase,
+		}, nil
+	}
+	return bc, nil
+}
+
+var _ credential.UsernamePassword = (*usrPassCred)(nil)
+
+type usrPassCred struct {
+	*baseCred
+	username string
+	password credential.Password
+}
+
+func (c *usrPassCred) Username() string              { return c.username }
+func (c *usrPassCred) Password() credential.Password { return c.password }
+
+var _ credential.SshPrivateKey = (*sshPrivateKeyCred)(nil)
+
+type sshPrivateKeyCred struct {
+	*baseCred
+	username   string
+	privateKey credential.PrivateKey
+	passphrase []byte
+}
+
+func (c *sshPrivateKeyCred) Username() string                  { return c.username }
+func (c *sshPrivateKeyCred) PrivateKey() credential.PrivateKey { return c.privateKey }
+func (c *sshPrivateKeyCred) PrivateKeyPassphrase() []byte      { return c.passphrase }
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	newBase := func(ct globals.CredentialType, secret map[string]any) *baseCred {
+		lib, err := NewCredentialLibrary(ctx, "csplg_1234567890", WithCredentialType(ct))
+		require.NoError(t, err)
+		return &baseCred{
+			Credential: allocCredential(),
+			lib:        &issuingLibrary{CredentialLibrary: lib, purpose: credential.BrokeredPurpose},
+			secretData: secret,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		ct       globals.CredentialType
+		secret   map[string]any
+		wantType any
+		wantErr  bool
+	}{
+		{
+			name:     "unspecified",
+			ct:       globals.UnspecifiedCredentialType,
+			secret:   map[string]any{"anything": "goes"},
+			wantType: &baseCred{},
+		},
+		{
+			name:     "username-password",
+			ct:       globals.UsernamePasswordCredentialType,
+			secret:   map[string]any{"username": "user", "password": "pass"},
+			wantType: &usrPassCred{},
+		},
+		{
+			name:    "username-password-missing-password",
+			ct:      globals.UsernamePasswordCredentialType,
+			secret:  map[string]any{"username": "user"},
+			wantErr: true,
+		},
+		{
+			name:     "ssh-private-key",
+			ct:       globals.SshPrivateKeyCredentialType,
+			secret:   map[string]any{"username": "user", "private_key": "key", "private_key_passphrase": "pass"},
+			wantType: &sshPrivateKeyCred{},
+		},
+		{
+			name:    "ssh-private-key-missing-key",
+			ct:      globals.SshPrivateKeyCredentialType,
+			secret:  map[string]any{"username": "user"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := convert(ctx, newBase(tt.ct, tt.secret))
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.ExternalPlugin), err))
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.IsType(tt.wantType, got)
+			switch c := got.(type) {
+			case *usrPassCred:
+				assert.Equal("user", c.Username())
+				assert.Equal(credential.Password("pass"), c.Password())
+			case *sshPrivateKeyCred:
+				assert.Equal("user", c.Username())
+				assert.Equal(credential.PrivateKey("key"), c.PrivateKey())
+				assert.Equal([]byte("pass"), c.PrivateKeyPassphrase())
+			}
+		})
+	}
+}
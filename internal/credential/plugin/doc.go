@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package plugin implements a credential store backed by a credential
+// plugin. A plugin credential store delegates issuing, renewing, and
+// revoking dynamic credentials to the external secret manager the plugin
+// integrates with.
+package plugin
@@ -0,0 +1,433 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	ua "go.uber.org/atomic"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	credentialRenewalJobName    = "plugin_credential_renewal"
+	credentialRevocationJobName = "plugin_credential_revocation"
+	credentialCleanupJobName    = "plugin_credential_cleanup"
+
+	defaultNextRunIn = 5 * time.Minute
+	renewalWindow    = 10 * time.Minute
+)
+
+// RegisterJobs registers the jobs that renew, revoke, and clean up
+// credentials issued by credential plugins.
+func RegisterJobs(ctx context.Context, sched *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms, plgm map[string]plgpb.CredentialPluginServiceClient) error {
+	const op = "plugin.RegisterJobs"
+	credRenewal, err := newCredentialRenewalJob(ctx, r, w, kms, sched, plgm)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = sched.RegisterJob(ctx, credRenewal); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential renewal job"))
+	}
+	credRevoke, err := newCredentialRevocationJob(ctx, r, w, kms, sched, plgm)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = sched.RegisterJob(ctx, credRevoke); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential revocation job"))
+	}
+	credCleanup, err := newCredentialCleanupJob(ctx, w)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = sched.RegisterJob(ctx, credCleanup); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential cleanup job"))
+	}
+	return nil
+}
+
+// jobCredential is the subset of a plugin credential the renewal and
+// revocation jobs need.
+type jobCredential struct {
+	PublicId        string `gorm:"primary_key"`
+	StoreId         string
+	ExternalId      string
+	LastRenewalTime *timestamp.Timestamp
+	ExpirationTime  *timestamp.Timestamp
+}
+
+// TableName returns the table name for gorm.
+func (*jobCredential) TableName() string { return "credential_plugin_credential" }
+
+// storeRequest returns the plugin client, the credential store in the
+// format expected by the plugin, and the persisted data of the credential
+// store that issued c.
+func storeRequest(ctx context.Context, repo *Repository, c *jobCredential) (plgpb.CredentialPluginServiceClient, *pb.CredentialStore, *plgpb.CredentialStorePersisted, error) {
+	const op = "plugin.storeRequest"
+	cs, persisted, err := repo.getStore(ctx, c.StoreId)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(ctx, err, op)
+	}
+	plgClient, ok := repo.plugins[cs.GetPluginId()]
+	if !ok || plgClient == nil {
+		return nil, nil, nil, errors.New(ctx, errors.Internal, op, fmt.Sprintf("plugin %q not available", cs.GetPluginId()))
+	}
+	plgCs, err := toPluginStore(ctx, cs)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(ctx, err, op)
+	}
+	return plgClient, plgCs, persisted, nil
+}
+
+// CredentialRenewalJob is the recurring job that renews renewable plugin
+// credentials that are in the active state. The CredentialRenewalJob is
+// not thread safe, an attempt to Run the job concurrently will result in
+// an JobAlreadyRunning error.
+type CredentialRenewalJob struct {
+	reader db.Reader
+	writer db.Writer
+	repo   *Repository
+	limit  int
+
+	running      ua.Bool
+	numCreds     int
+	numProcessed int
+}
+
+func newCredentialRenewalJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, sched *scheduler.Scheduler, plgm map[string]plgpb.CredentialPluginServiceClient) (*CredentialRenewalJob, error) {
+	const op = "plugin.newCredentialRenewalJob"
+	repo, err := NewRepository(ctx, r, w, kms, sched, plgm)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &CredentialRenewalJob{
+		reader: r,
+		writer: w,
+		repo:   repo,
+		limit:  db.DefaultLimit,
+	}, nil
+}
+
+// Status returns the current status of the credential renewal job.
+func (r *CredentialRenewalJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: r.numProcessed,
+		Total:     r.numCreds,
+	}
+}
+
+// Run queries for renewable plugin credentials that will reach their
+// renewal point within the renewal window and asks the plugin of each
+// credential's store to renew it. Can not be run in parallel, if Run is
+// invoked while already running an error with code JobAlreadyRunning will
+// be returned.
+func (r *CredentialRenewalJob) Run(ctx context.Context) error {
+	const op = "plugin.(CredentialRenewalJob).Run"
+	if !r.running.CAS(r.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer r.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	var creds []*jobCredential
+	err := r.reader.SearchWhere(ctx, &creds,
+		`status = ? and is_renewable = true and last_renewal_time + (expiration_time - last_renewal_time) / 2 < wt_add_seconds_to_now(?)`,
+		[]any{ActiveCredential, renewalWindow.Seconds()}, db.WithLimit(r.limit))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	r.numProcessed, r.numCreds = 0, len(creds)
+	for _, c := range creds {
+		// Verify context is not done before renewing next credential
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := r.renewCred(ctx, c); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error renewing credential", "credential id", c.PublicId))
+		}
+		r.numProcessed++
+	}
+
+	return nil
+}
+
+func (r *CredentialRenewalJob) renewCred(ctx context.Context, c *jobCredential) error {
+	const op = "plugin.(CredentialRenewalJob).renewCred"
+	plgClient, plgCs, persisted, err := storeRequest(ctx, r.repo, c)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	resp, err := plgClient.RenewCredential(ctx, &plgpb.RenewCredentialRequest{
+		Store:      plgCs,
+		Persisted:  persisted,
+		ExternalId: c.ExternalId,
+	})
+	if status.Code(err) == codes.NotFound {
+		// The plugin no longer knows about the credential, it has either
+		// expired or been revoked outside of Boundary.
+		if _, err := r.writer.Exec(ctx, updateCredentialStatusQuery, []any{ExpiredCredential, c.PublicId}); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.ExternalPlugin), errors.WithMsg("unable to renew credential"))
+	}
+
+	numRows, err := r.writer.Exec(ctx, updateCredentialExpirationQuery, []any{resp.GetLeaseDurationSeconds(), c.PublicId})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if numRows != 1 {
+		return errors.New(ctx, errors.Unknown, op, "credential renewed but failed to update repo")
+	}
+	return nil
+}
+
+// NextRunIn queries the plugin credential repo to determine when the next
+// credential renewal job should run.
+func (r *CredentialRenewalJob) NextRunIn(ctx context.Context) (time.Duration, error) {
+	const op = "plugin.(CredentialRenewalJob).NextRunIn"
+	rows, err := r.reader.Query(ctx, credentialRenewalNextRunInQuery, nil)
+	if err != nil {
+		return defaultNextRunIn, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		type NextRenewal struct {
+			RenewalIn time.Duration
+		}
+		var n NextRenewal
+		if err := r.reader.ScanRows(ctx, rows, &n); err != nil {
+			return defaultNextRunIn, errors.Wrap(ctx, err, op)
+		}
+		if n.RenewalIn < 0 {
+			// If we are past the next renewal time, return 0 to schedule immediately
+			return 0, nil
+		}
+		return n.RenewalIn * time.Second, nil
+	}
+	if err := rows.Err(); err != nil {
+		return defaultNextRunIn, errors.Wrap(ctx, err, op)
+	}
+	return defaultNextRunIn, nil
+}
+
+// Name is the unique name of the job.
+func (r *CredentialRenewalJob) Name() string {
+	return credentialRenewalJobName
+}
+
+// Description is the human readable description of the job.
+func (r *CredentialRenewalJob) Description() string {
+	return "Periodically renews plugin credentials that are attached to an active/pending session (in the active state)."
+}
+
+// CredentialRevocationJob is the recurring job that revokes plugin
+// credentials that are no longer being used by an active or pending
+// session. The CredentialRevocationJob is not thread safe, an attempt to
+// Run the job concurrently will result in an JobAlreadyRunning error.
+type CredentialRevocationJob struct {
+	reader db.Reader
+	writer db.Writer
+	repo   *Repository
+	limit  int
+
+	running      ua.Bool
+	numCreds     int
+	numProcessed int
+}
+
+func newCredentialRevocationJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, sched *scheduler.Scheduler, plgm map[string]plgpb.CredentialPluginServiceClient) (*CredentialRevocationJob, error) {
+	const op = "plugin.newCredentialRevocationJob"
+	repo, err := NewRepository(ctx, r, w, kms, sched, plgm)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &CredentialRevocationJob{
+		reader: r,
+		writer: w,
+		repo:   repo,
+		limit:  db.DefaultLimit,
+	}, nil
+}
+
+// Status returns the current status of the credential revocation job.
+func (r *CredentialRevocationJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: r.numProcessed,
+		Total:     r.numCreds,
+	}
+}
+
+// Run queries for plugin credentials in the revoke state and asks the
+// plugin of each credential's store to revoke it. Can not be run in
+// parallel, if Run is invoked while already running an error with code
+// JobAlreadyRunning will be returned.
+func (r *CredentialRevocationJob) Run(ctx context.Context) error {
+	const op = "plugin.(CredentialRevocationJob).Run"
+	if !r.running.CAS(r.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer r.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	var creds []*jobCredential
+	err := r.reader.SearchWhere(ctx, &creds, "status = ?", []any{RevokeCredential}, db.WithLimit(r.limit))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	r.numProcessed, r.numCreds = 0, len(creds)
+	for _, c := range creds {
+		// Verify context is not done before revoking next credential
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := r.revokeCred(ctx, c); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error revoking credential", "credential id", c.PublicId))
+		}
+		r.numProcessed++
+	}
+
+	return nil
+}
+
+func (r *CredentialRevocationJob) revokeCred(ctx context.Context, c *jobCredential) error {
+	const op = "plugin.(CredentialRevocationJob).revokeCred"
+	plgClient, plgCs, persisted, err := storeRequest(ctx, r.repo, c)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	_, err = plgClient.RevokeCredential(ctx, &plgpb.RevokeCredentialRequest{
+		Store:      plgCs,
+		Persisted:  persisted,
+		ExternalId: c.ExternalId,
+	})
+	switch status.Code(err) {
+	case codes.OK, codes.NotFound:
+		// A credential the plugin no longer knows about has already expired
+		// or been revoked.
+	default:
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.ExternalPlugin), errors.WithMsg("unable to revoke credential"))
+	}
+
+	numRows, err := r.writer.Exec(ctx, updateCredentialStatusQuery, []any{RevokedCredential, c.PublicId})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if numRows != 1 {
+		return errors.New(ctx, errors.Unknown, op, "credential revoked but failed to update repo")
+	}
+	return nil
+}
+
+// NextRunIn determine when the next credential revocation job should run.
+func (r *CredentialRevocationJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return defaultNextRunIn, nil
+}
+
+// Name is the unique name of the job.
+func (r *CredentialRevocationJob) Name() string {
+	return credentialRevocationJobName
+}
+
+// Description is the human readable description of the job.
+func (r *CredentialRevocationJob) Description() string {
+	return "Periodically revokes plugin credentials that are no longer in use and have been set for revocation (in the revoke state)."
+}
+
+// CredentialCleanupJob is the recurring job that deletes plugin credentials
+// that are no longer attached to a session (have a null session_id) and are
+// not active. The CredentialCleanupJob is not thread safe, an attempt to
+// Run the job concurrently will result in an JobAlreadyRunning error.
+type CredentialCleanupJob struct {
+	writer db.Writer
+
+	running  ua.Bool
+	numCreds int
+}
+
+func newCredentialCleanupJob(ctx context.Context, w db.Writer) (*CredentialCleanupJob, error) {
+	const op = "plugin.newCredentialCleanupJob"
+	if w == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	}
+	return &CredentialCleanupJob{
+		writer: w,
+	}, nil
+}
+
+// Status returns the current status of the credential cleanup job.
+func (r *CredentialCleanupJob) Status() scheduler.JobStatus {
+	// Cleanup runs a single exec command to the database, therefore completed and total
+	// are both set to numCreds.
+	return scheduler.JobStatus{
+		Completed: r.numCreds,
+		Total:     r.numCreds,
+	}
+}
+
+// Run deletes all plugin credentials in the repo that have a null
+// session_id and are not active. Can not be run in parallel, if Run is
+// invoked while already running an error with code JobAlreadyRunning will
+// be returned.
+func (r *CredentialCleanupJob) Run(ctx context.Context) error {
+	const op = "plugin.(CredentialCleanupJob).Run"
+	if !r.running.CAS(r.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer r.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	numRows, err := r.writer.Exec(ctx, credCleanupQuery, nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	r.numCreds = numRows
+
+	return nil
+}
+
+// NextRunIn determine when the next credential cleanup job should run.
+func (r *CredentialCleanupJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return defaultNextRunIn, nil
+}
+
+// Name is the unique name of the job.
+func (r *CredentialCleanupJob) Name() string {
+	return credentialCleanupJobName
+}
+
+// Description is the human readable description of the job.
+func (r *CredentialCleanupJob) Description() string {
+	return "Periodically deletes plugin credentials that are no longer attached to a session (have a null session_id) and are not active."
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"github.com/hashicorp/boundary/globals"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withPublicId       string
+	withName           string
+	withDescription    string
+	withAttributes     *structpb.Struct
+	withSecrets        *structpb.Struct
+	withSecretsHmac    []byte
+	withCredentialType globals.CredentialType
+	withLimit          int
+}
+
+func getDefaultOptions() options {
+	return options{
+		withAttributes:     &structpb.Struct{},
+		withCredentialType: globals.UnspecifiedCredentialType,
+	}
+}
+
+// WithPublicId provides an optional public id.
+func WithPublicId(with string) Option {
+	return func(o *options) {
+		o.withPublicId = with
+	}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithAttributes provides an optional attributes field.
+func WithAttributes(attrs *structpb.Struct) Option {
+	return func(o *options) {
+		o.withAttributes = attrs
+	}
+}
+
+// WithSecrets provides an optional secrets field.
+func WithSecrets(secrets *structpb.Struct) Option {
+	return func(o *options) {
+		o.withSecrets = secrets
+	}
+}
+
+// WithSecretsHmac provides an optional HMAC of secrets. Used for testing.
+func WithSecretsHmac(secretsHmac []byte) Option {
+	return func(o *options) {
+		o.withSecretsHmac = secretsHmac
+	}
+}
+
+// WithCredentialType provides an optional credential type to associate
+// with a credential library.
+func WithCredentialType(t globals.CredentialType) Option {
+	return func(o *options) {
+		o.withCredentialType = t
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are
+// returned. If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithPublicId", func(t *testing.T) {
+		opts := getOpts(WithPublicId("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withPublicId = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithName", func(t *testing.T) {
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithAttributes", func(t *testing.T) {
+		attrs, err := structpb.NewStruct(map[string]any{"foo": "bar"})
+		assert.NoError(t, err)
+		opts := getOpts(WithAttributes(attrs))
+		testOpts := getDefaultOptions()
+		testOpts.withAttributes = attrs
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSecrets", func(t *testing.T) {
+		secrets, err := structpb.NewStruct(map[string]any{"foo": "bar"})
+		assert.NoError(t, err)
+		opts := getOpts(WithSecrets(secrets))
+		testOpts := getDefaultOptions()
+		testOpts.withSecrets = secrets
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSecretsHmac", func(t *testing.T) {
+		opts := getOpts(WithSecretsHmac([]byte("hmac")))
+		testOpts := getDefaultOptions()
+		testOpts.withSecretsHmac = []byte("hmac")
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithCredentialType", func(t *testing.T) {
+		opts := getOpts(WithCredentialType(globals.UsernamePasswordCredentialType))
+		testOpts := getDefaultOptions()
+		testOpts.withCredentialType = globals.UsernamePasswordCredentialType
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		opts := getOpts(WithLimit(5))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(t, opts, testOpts)
+	})
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+func init() {
+	globals.RegisterPrefixToResourceInfo(globals.PluginCredentialStorePrefix, resource.CredentialStore, credential.Domain, Subtype)
+	globals.RegisterPrefixToResourceInfo(globals.PluginCredentialLibraryPrefix, resource.CredentialLibrary, credential.Domain, Subtype)
+	globals.RegisterPrefixToResourceInfo(globals.PluginDynamicCredentialPrefix, resource.Credential, credential.Domain, Subtype)
+}
+
+// PublicId prefixes for the resources in the plugin package.
+const (
+	Subtype = globals.Subtype("plugin")
+)
+
+func newCredentialStoreId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(ctx, globals.PluginCredentialStorePrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "plugin.newCredentialStoreId")
+	}
+	return id, nil
+}
+
+func newCredentialLibraryId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(ctx, globals.PluginCredentialLibraryPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "plugin.newCredentialLibraryId")
+	}
+	return id, nil
+}
+
+func newCredentialId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(ctx, globals.PluginDynamicCredentialPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "plugin.newCredentialId")
+	}
+	return id, nil
+}
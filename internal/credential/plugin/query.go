@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+const (
+	insertCredentialWithExpirationQuery = `
+insert into credential_plugin_credential (
+  public_id, -- $1
+  library_id, -- $2
+  session_id, -- $3
+  store_id, -- $4
+  external_id, -- $5
+  is_renewable, -- $6
+  status, -- $7
+  last_renewal_time, -- $8
+  expiration_time -- $9
+) values (
+  @public_id, -- public_id
+  @library_id, -- library_id
+  @session_id, -- session_id
+  @store_id, -- store_id
+  @external_id, -- external_id
+  @is_renewable, -- is_renewable
+  @status, -- status
+  now(), -- last_renewal_time
+  wt_add_seconds_to_now(@expiration_time)  -- expiration_time
+);
+`
+
+	insertCredentialWithInfiniteExpirationQuery = `
+insert into credential_plugin_credential (
+  public_id, -- $1
+  library_id, -- $2
+  session_id, -- $3
+  store_id, -- $4
+  external_id, -- $5
+  is_renewable, -- $6
+  status, -- $7
+  last_renewal_time, -- $8
+  expiration_time -- infinity
+) values (
+  @public_id, -- public_id
+  @library_id, -- library_id
+  @session_id, -- session_id
+  @store_id, -- store_id
+  @external_id, -- external_id
+  @is_renewable, -- is_renewable
+  @status, -- status
+  now(), -- last_renewal_time
+  'infinity' -- expiration_time
+);
+`
+
+	updateSessionCredentialQuery = `
+update session_credential_dynamic
+   set credential_id = @public_id
+ where library_id = @library_id
+   and session_id = @session_id
+   and credential_purpose = @purpose
+   and credential_id is null
+returning *;
+`
+
+	revokeCredentialsQuery = `
+update credential_plugin_credential
+   set status = 'revoke'
+ where session_id = ?
+   and status = 'active';
+`
+
+	updateCredentialExpirationQuery = `
+update credential_plugin_credential
+   set last_renewal_time = now(),
+       expiration_time   = wt_add_seconds_to_now(?)
+ where public_id = ?;
+`
+
+	updateCredentialStatusQuery = `
+update credential_plugin_credential
+   set status = ?
+ where public_id = ?;
+`
+
+	credentialRenewalNextRunInQuery = `
+select extract(epoch from (last_renewal_time + (expiration_time - last_renewal_time) / 2) - now())::int as renewal_in
+  from credential_plugin_credential
+ where status = 'active'
+   and is_renewable = true
+   and expiration_time = (
+         select min(expiration_time)
+           from credential_plugin_credential
+          where status = 'active'
+            and is_renewable = true
+       )
+ limit 1;
+`
+
+	credCleanupQuery = `
+delete from credential_plugin_credential
+ where session_id is null
+   and status not in ('active', 'revoke');
+`
+
+	estimateCountCredentialLibraries = `
+select reltuples::bigint as estimate
+  from pg_class
+ where oid = 'credential_plugin_library'::regclass
+`
+
+	listLibrariesTemplate = `
+  select *
+    from credential_plugin_library
+   where store_id = @store_id
+order by create_time desc, public_id desc
+   limit %d;
+`
+
+	listLibrariesPageTemplate = `
+  select *
+    from credential_plugin_library
+   where store_id = @store_id
+     and (create_time, public_id) < (@last_item_create_time, @last_item_id)
+order by create_time desc, public_id desc
+   limit %d;
+`
+
+	listLibrariesRefreshTemplate = `
+  select *
+    from credential_plugin_library
+   where store_id = @store_id
+     and update_time > @updated_after_time
+order by update_time desc, public_id desc
+   limit %d;
+`
+
+	listLibrariesRefreshPageTemplate = `
+  select *
+    from credential_plugin_library
+   where store_id = @store_id
+     and update_time > @updated_after_time
+     and (update_time, public_id) < (@last_item_update_time, @last_item_id)
+order by update_time desc, public_id desc
+   limit %d;
+`
+)
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/credential"
+)
+
+func init() {
+	credential.RegisterStoreSubtype("plugin", &credentialHooks{})
+}
+
+type credentialHooks struct{}
+
+// NewStore creates a new plugin credential store from the result
+func (credentialHooks) NewStore(ctx context.Context, result *credential.StoreListQueryResult) (credential.Store, error) {
+	s := allocCredentialStore()
+	s.PublicId = result.PublicId
+	s.ProjectId = result.ProjectId
+	s.CreateTime = result.CreateTime
+	s.UpdateTime = result.UpdateTime
+	s.Name = result.Name
+	s.Description = result.Description
+	s.Version = result.Version
+	s.PluginId = result.PluginId
+	s.Attributes = result.Attributes
+	s.SecretsHmac = result.SecretsHmac
+
+	return s, nil
+}
@@ -0,0 +1,622 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/libs/patchstruct"
+	"github.com/hashicorp/boundary/internal/oplog"
+	plg "github.com/hashicorp/boundary/internal/plugin"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/mr-tron/base58"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// CreateCredentialStore inserts cs into the repository and returns a new
+// CredentialStore containing the credential store's PublicId. cs must
+// contain a valid ProjectId and PluginId. cs must not contain a PublicId.
+// The PublicId is generated and assigned by this method. opt is ignored.
+//
+// cs.Secrets, cs.Name and cs.Description are optional. If cs.Name is set,
+// it must be unique within cs.ProjectId. cs.Secrets are sent to the plugin
+// in OnCreateCredentialStore; any persisted data returned by the plugin is
+// stored encrypted but not included in the returned *CredentialStore.
+//
+// Both cs.CreateTime and cs.UpdateTime are ignored.
+func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialStore, _ ...Option) (*CredentialStore, *plg.Plugin, error) {
+	const op = "plugin.(Repository).CreateCredentialStore"
+	if cs == nil {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "nil CredentialStore")
+	}
+	if cs.CredentialStore == nil {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded CredentialStore")
+	}
+	if cs.ProjectId == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	if cs.PublicId != "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+	if cs.PluginId == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "no plugin id")
+	}
+	if cs.Attributes == nil {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "nil attributes")
+	}
+	cs = cs.clone()
+	id, err := newCredentialStoreId(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	cs.PublicId = id
+
+	// Use PatchBytes' functionality that does not add keys where the values
+	// are nil to the resulting struct since we do not want to store nil valued
+	// attributes.
+	cs.Attributes, err = patchstruct.PatchBytes([]byte{}, cs.Attributes)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, cs.ProjectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	// If secrets were passed in, HMAC 'em
+	if cs.Secrets != nil && len(cs.Secrets.GetFields()) > 0 {
+		if err := cs.hmacSecrets(ctx, databaseWrapper); err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("error hmac'ing passed-in secrets"))
+		}
+	}
+
+	plgCs, err := toPluginStore(ctx, cs)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	plgClient, ok := r.plugins[cs.GetPluginId()]
+	if !ok || plgClient == nil {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("plugin %q not available", cs.GetPluginId()))
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, cs.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	// If the call to the plugin succeeded, we do not want to call it again if
+	// the transaction failed and is being retried.
+	var pluginCalledSuccessfully bool
+	var plgResp *plgpb.OnCreateCredentialStoreResponse
+
+	var newCredentialStore *CredentialStore
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 3)
+			ticket, err := w.GetTicket(ctx, cs)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			newCredentialStore = cs.clone()
+			var csOplogMsg oplog.Message
+			if err := w.Create(ctx, newCredentialStore, db.NewOplogMsg(&csOplogMsg)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			msgs = append(msgs, &csOplogMsg)
+
+			if !pluginCalledSuccessfully {
+				plgResp, err = plgClient.OnCreateCredentialStore(ctx, &plgpb.OnCreateCredentialStoreRequest{Store: plgCs})
+				if err != nil {
+					if status.Code(err) != codes.Unimplemented {
+						return errors.Wrap(ctx, err, op)
+					}
+				}
+				pluginCalledSuccessfully = true
+			}
+
+			if plgResp != nil && len(plgResp.GetPersisted().GetSecrets().GetFields()) > 0 {
+				csSecret, err := newCredentialStoreSecret(ctx, id, plgResp.GetPersisted().GetSecrets())
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if err := csSecret.encrypt(ctx, databaseWrapper); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				newSecret := csSecret.clone()
+				var sOplogMsg oplog.Message
+				if err := w.Create(ctx, newSecret, db.NewOplogMsg(&sOplogMsg)); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				msgs = append(msgs, &sOplogMsg)
+			}
+
+			metadata := cs.oplog(oplog.OpType_OP_TYPE_CREATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			return nil
+		},
+	)
+
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in project: %s: name %s already exists", cs.ProjectId, cs.Name)))
+		}
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in project: %s", cs.ProjectId)))
+	}
+	plg, err := r.getPlugin(ctx, newCredentialStore.GetPluginId())
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	return newCredentialStore, plg, nil
+}
+
+// UpdateCredentialStore updates the repository entry for cs.PublicId with
+// the values in cs for the fields listed in fieldMask. It returns a new
+// CredentialStore containing the updated values and a count of the number
+// of records updated. cs is not changed.
+//
+// cs must contain a valid PublicId. cs.Name, cs.Description, and
+// cs.Attributes can be updated; if cs.Secrets is present, its contents are
+// sent to the plugin along with any other changes before the update is
+// sent to the database.
+//
+// An attribute of cs will be set to NULL in the database if the attribute
+// in cs is the zero value and it is included in fieldMask. This does not
+// apply to cs.Attributes; individual attributes are removed by setting
+// them to null.
+//
+// Updates are sent to OnUpdateCredentialStore with a full copy of both the
+// current credential store and the state of the new credential store,
+// along with any secrets included in the new request. This request may
+// alter the returned persisted state. Update of the record in the database
+// is aborted if this call fails.
+func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialStore, version uint32, fieldMask []string, _ ...Option) (*CredentialStore, *plg.Plugin, int, error) {
+	const op = "plugin.(Repository).UpdateCredentialStore"
+	if cs == nil {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil CredentialStore")
+	}
+	if cs.CredentialStore == nil {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil embedded CredentialStore")
+	}
+	if cs.PublicId == "" {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	if cs.ProjectId == "" {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	if len(fieldMask) == 0 {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+
+	currentStore, currentPersisted, err := r.getStore(ctx, cs.PublicId)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("error looking up credential store with id %q", cs.PublicId)))
+	}
+	if currentStore.GetVersion() != version {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("credential store version mismatch, want=%d, got=%d", currentStore.GetVersion(), version))
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, currentStore.ProjectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+
+	newStore := currentStore.clone()
+	var updateAttributes, alreadySetSecrets bool
+	var dbMask, nullFields []string
+	for _, f := range fieldMask {
+		switch {
+		case strings.EqualFold("name", f) && cs.Name == "":
+			nullFields = append(nullFields, "name")
+			newStore.Name = cs.Name
+		case strings.EqualFold("name", f) && cs.Name != "":
+			dbMask = append(dbMask, "name")
+			newStore.Name = cs.Name
+		case strings.EqualFold("description", f) && cs.Description == "":
+			nullFields = append(nullFields, "description")
+			newStore.Description = cs.Description
+		case strings.EqualFold("description", f) && cs.Description != "":
+			dbMask = append(dbMask, "description")
+			newStore.Description = cs.Description
+		case strings.EqualFold("attributes", strings.Split(f, ".")[0]):
+			// Flag attributes for updating. While multiple masks may be
+			// sent, we only need to do this once.
+			updateAttributes = true
+		case strings.EqualFold("secrets", strings.Split(f, ".")[0]):
+			if alreadySetSecrets {
+				continue
+			}
+			alreadySetSecrets = true
+			// Secrets are passed along to the plugin wholesale. Only the
+			// HMAC of the secrets is stored in the database.
+			newStore.Secrets = cs.Secrets
+			switch {
+			case newStore.Secrets == nil,
+				len(newStore.Secrets.GetFields()) == 0:
+				nullFields = append(nullFields, "SecretsHmac")
+			default:
+				if err := newStore.hmacSecrets(ctx, databaseWrapper); err != nil {
+					return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("error hmac'ing passed-in secrets"))
+				}
+				dbMask = append(dbMask, "SecretsHmac")
+			}
+		default:
+			return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+
+	if updateAttributes {
+		dbMask = append(dbMask, "attributes")
+		newStore.Attributes, err = patchstruct.PatchBytes(newStore.Attributes, cs.Attributes)
+		if err != nil {
+			return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("error in credential store attribute JSON"))
+		}
+	}
+
+	// Fetch the plugin here so that if there's an integrity error, we don't
+	// call the plugin.
+	plg, err := r.getPlugin(ctx, currentStore.GetPluginId())
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	plgClient, ok := r.plugins[currentStore.GetPluginId()]
+	if !ok || plgClient == nil {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.Internal, op, fmt.Sprintf("plugin %q not available", currentStore.GetPluginId()))
+	}
+
+	currPlgCs, err := toPluginStore(ctx, currentStore)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	newPlgCs, err := toPluginStore(ctx, newStore)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, newStore.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var pluginCalledSuccessfully bool
+	var plgResp *plgpb.OnUpdateCredentialStoreResponse
+
+	var returnedStore *CredentialStore
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 3)
+			ticket, err := w.GetTicket(ctx, newStore)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			var recordUpdated bool
+			if len(dbMask) != 0 || len(nullFields) != 0 {
+				returnedStore = newStore.clone()
+				var csOplogMsg oplog.Message
+				storesUpdated, err := w.Update(
+					ctx,
+					returnedStore,
+					dbMask,
+					nullFields,
+					db.NewOplogMsg(&csOplogMsg),
+					db.WithVersion(&version),
+				)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if storesUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("expected 1 credential store to be updated, got %d", storesUpdated))
+				}
+				msgs = append(msgs, &csOplogMsg)
+				recordUpdated = true
+			} else {
+				returnedStore = currentStore.clone()
+			}
+
+			if !pluginCalledSuccessfully {
+				plgResp, err = plgClient.OnUpdateCredentialStore(ctx, &plgpb.OnUpdateCredentialStoreRequest{
+					CurrentStore: currPlgCs,
+					NewStore:     newPlgCs,
+					Persisted:    currentPersisted,
+				})
+				if err != nil {
+					if status.Code(err) != codes.Unimplemented {
+						return errors.Wrap(ctx, err, op)
+					}
+				}
+				pluginCalledSuccessfully = true
+			}
+
+			var updatedPersisted bool
+			if plgResp != nil && plgResp.GetPersisted().GetSecrets() != nil {
+				csSecret, err := newCredentialStoreSecret(ctx, currentStore.GetPublicId(), plgResp.GetPersisted().GetSecrets())
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				var sOplogMsg oplog.Message
+				switch {
+				case len(plgResp.GetPersisted().GetSecrets().GetFields()) == 0:
+					// The plugin returned an empty map, delete the persisted
+					// data if any exists.
+					if currentPersisted == nil {
+						break
+					}
+					if _, err := w.Delete(ctx, csSecret.clone(), db.NewOplogMsg(&sOplogMsg)); err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+					updatedPersisted = true
+					msgs = append(msgs, &sOplogMsg)
+				default:
+					if err := csSecret.encrypt(ctx, databaseWrapper); err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+					if err := w.Create(
+						ctx,
+						csSecret.clone(),
+						db.WithOnConflict(&db.OnConflict{
+							Target: db.Columns{"store_id"},
+							Action: db.SetColumns([]string{"secret", "key_id"}),
+						}),
+						db.NewOplogMsg(&sOplogMsg),
+					); err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+					updatedPersisted = true
+					msgs = append(msgs, &sOplogMsg)
+				}
+			}
+
+			if !recordUpdated && updatedPersisted {
+				// Only the persisted data was updated, so the version of
+				// the credential store needs to be incremented manually.
+				returnedStore = newStore.clone()
+				returnedStore.Version = version + 1
+				var csOplogMsg oplog.Message
+				storesUpdated, err := w.Update(
+					ctx,
+					returnedStore,
+					[]string{"version"},
+					[]string{},
+					db.NewOplogMsg(&csOplogMsg),
+					db.WithVersion(&version),
+				)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if storesUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("expected 1 credential store to be updated, got %d", storesUpdated))
+				}
+				msgs = append(msgs, &csOplogMsg)
+			}
+
+			if len(msgs) != 0 {
+				metadata := newStore.oplog(oplog.OpType_OP_TYPE_UPDATE)
+				if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in %s: name %s already exists", newStore.PublicId, newStore.Name)))
+		}
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in %s", newStore.PublicId)))
+	}
+
+	// Even if no records were updated, the record was found with the
+	// appropriate version so returning 1 row updated is consistent with the
+	// other credential store update behaviors.
+	return returnedStore, plg, 1, nil
+}
+
+// LookupCredentialStore returns the CredentialStore for id. Returns nil,
+// nil, nil if no CredentialStore is found for id.
+func (r *Repository) LookupCredentialStore(ctx context.Context, id string, _ ...Option) (*CredentialStore, *plg.Plugin, error) {
+	const op = "plugin.(Repository).LookupCredentialStore"
+	if id == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	cs, _, err := r.getStore(ctx, id)
+	if errors.IsNotFoundError(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", id)))
+	}
+	plg, err := r.getPlugin(ctx, cs.GetPluginId())
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	return cs, plg, nil
+}
+
+// DeleteCredentialStore deletes the credential store for the provided id
+// from the repository returning a count of the number of records deleted.
+// The plugin's OnDeleteCredentialStore hook is called before the delete;
+// an error returned by the plugin is logged but does not prevent the
+// credential store from being deleted. All options are ignored.
+func (r *Repository) DeleteCredentialStore(ctx context.Context, id string, _ ...Option) (int, error) {
+	const op = "plugin.(Repository).DeleteCredentialStore"
+	if id == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+
+	cs, p, err := r.getStore(ctx, id)
+	if err != nil && !errors.IsNotFoundError(err) {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if cs == nil {
+		return db.NoRowsAffected, nil
+	}
+	plgCs, err := toPluginStore(ctx, cs)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	plgClient, ok := r.plugins[cs.GetPluginId()]
+	if !ok || plgClient == nil {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("plugin %q not available", cs.GetPluginId()))
+	}
+	_, err = plgClient.OnDeleteCredentialStore(ctx, &plgpb.OnDeleteCredentialStoreRequest{
+		Store:     plgCs,
+		Persisted: p,
+	})
+	if err != nil && status.Code(err) != codes.Unimplemented {
+		// Even if the plugin returns an error, we ignore it and proceed with
+		// deleting the credential store.
+		event.WriteError(ctx, op, err, event.WithInfoMsg("plugin deleting credential store", "credential plugin id", cs.GetPluginId()))
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, cs.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	metadata := cs.oplog(oplog.OpType_OP_TYPE_DELETE)
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			rowsDeleted, err = w.Delete(ctx, cs.clone(), db.WithOplog(oplogWrapper, metadata))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("delete failed for %s", cs.PublicId)))
+	}
+	return rowsDeleted, nil
+}
+
+// getStore retrieves the *CredentialStore with the provided id and its
+// decrypted persisted data. If it is not found or there is a problem
+// getting it from the database an error is returned instead.
+func (r *Repository) getStore(ctx context.Context, id string) (*CredentialStore, *plgpb.CredentialStorePersisted, error) {
+	const op = "plugin.(Repository).getStore"
+	sa := &storeAgg{}
+	sa.PublicId = id
+	if err := r.reader.LookupByPublicId(ctx, sa); err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", id)))
+	}
+	cs, s := sa.toStoreAndPersisted()
+	var p *plgpb.CredentialStorePersisted
+	if s != nil {
+		var err error
+		p, err = toPluginPersistedData(ctx, r.kms, cs.GetProjectId(), s)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	return cs, p, nil
+}
+
+func (r *Repository) getPlugin(ctx context.Context, plgId string) (*plg.Plugin, error) {
+	const op = "plugin.(Repository).getPlugin"
+	if plgId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no plugin id")
+	}
+	plg := plg.NewPlugin()
+	plg.PublicId = plgId
+	if err := r.reader.LookupByPublicId(ctx, plg); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to get credential plugin with id %q", plgId)))
+	}
+	return plg, nil
+}
+
+// toPluginStore returns a credential store, with its secrets if available,
+// in the format expected by the credential plugin system.
+func toPluginStore(ctx context.Context, in *CredentialStore) (*pb.CredentialStore, error) {
+	const op = "plugin.toPluginStore"
+	if in == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil storage credential store")
+	}
+	var name, description *wrapperspb.StringValue
+	if inName := in.GetName(); inName != "" {
+		name = wrapperspb.String(inName)
+	}
+	if inDescription := in.GetDescription(); inDescription != "" {
+		description = wrapperspb.String(inDescription)
+	}
+
+	cs := &pb.CredentialStore{
+		Id:          in.GetPublicId(),
+		ScopeId:     in.GetProjectId(),
+		PluginId:    in.GetPluginId(),
+		Name:        name,
+		Description: description,
+		Type:        Subtype.String(),
+	}
+	if len(in.GetSecretsHmac()) > 0 {
+		cs.SecretsHmac = base58.Encode(in.GetSecretsHmac())
+	}
+	if in.GetAttributes() != nil {
+		attrs := &structpb.Struct{}
+		if err := proto.Unmarshal(in.GetAttributes(), attrs); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to unmarshal attributes"))
+		}
+		cs.Attrs = &pb.CredentialStore_Attributes{
+			Attributes: attrs,
+		}
+	}
+	if in.Secrets != nil {
+		cs.Secrets = in.Secrets
+	}
+	return cs, nil
+}
+
+// toPluginPersistedData converts a *CredentialStoreSecret from storage to a
+// *plgpb.CredentialStorePersisted expected by a plugin. Project Id must be
+// set.
+func toPluginPersistedData(ctx context.Context, kmsCache *kms.Kms, projectId string, s *CredentialStoreSecret) (*plgpb.CredentialStorePersisted, error) {
+	const op = "plugin.toPluginPersistedData"
+	if projectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "empty project id")
+	}
+	if s == nil {
+		return nil, nil
+	}
+	dbWrapper, err := kmsCache.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get db wrapper"))
+	}
+	if err := s.decrypt(ctx, dbWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	secrets := &structpb.Struct{}
+	if err := proto.Unmarshal(s.GetSecret(), secrets); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unmarshaling secret"))
+	}
+	return &plgpb.CredentialStorePersisted{Secrets: secrets}, nil
+}
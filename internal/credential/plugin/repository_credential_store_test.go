@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/plugin/loopback"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestRepository_CredentialStoreLifecycle(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	plg := plugin.TestPlugin(t, conn, "test", plugin.WithCredentialFlag(true))
+
+	var gotDelete bool
+	plgm := map[string]plgpb.CredentialPluginServiceClient{
+		plg.GetPublicId(): loopback.NewWrappingPluginCredentialClient(&loopback.TestPluginCredentialServer{
+			OnCreateCredentialStoreFn: func(_ context.Context, req *plgpb.OnCreateCredentialStoreRequest) (*plgpb.OnCreateCredentialStoreResponse, error) {
+				return &plgpb.OnCreateCredentialStoreResponse{
+					Persisted: &plgpb.CredentialStorePersisted{Secrets: req.GetStore().GetSecrets()},
+				}, nil
+			},
+			OnUpdateCredentialStoreFn: func(_ context.Context, req *plgpb.OnUpdateCredentialStoreRequest) (*plgpb.OnUpdateCredentialStoreResponse, error) {
+				return &plgpb.OnUpdateCredentialStoreResponse{
+					Persisted: &plgpb.CredentialStorePersisted{Secrets: req.GetNewStore().GetSecrets()},
+				}, nil
+			},
+			OnDeleteCredentialStoreFn: func(context.Context, *plgpb.OnDeleteCredentialStoreRequest) (*plgpb.OnDeleteCredentialStoreResponse, error) {
+				gotDelete = true
+				return &plgpb.OnDeleteCredentialStoreResponse{}, nil
+			},
+		}),
+	}
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sched, plgm)
+	require.NoError(t, err)
+
+	attrs, err := structpb.NewStruct(map[string]any{"address": "https://example.com"})
+	require.NoError(t, err)
+	secrets, err := structpb.NewStruct(map[string]any{"token": "secret"})
+	require.NoError(t, err)
+
+	in, err := NewCredentialStore(ctx, prj.GetPublicId(), plg.GetPublicId(),
+		WithName("store"), WithAttributes(attrs), WithSecrets(secrets))
+	require.NoError(t, err)
+
+	got, gotPlg, err := repo.CreateCredentialStore(ctx, in)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, plg.GetPublicId(), gotPlg.GetPublicId())
+	assert.Equal(t, "store", got.GetName())
+	assert.NotEmpty(t, got.GetSecretsHmac())
+
+	found, _, err := repo.LookupCredentialStore(ctx, got.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, got.GetPublicId(), found.GetPublicId())
+	assert.Equal(t, got.GetSecretsHmac(), found.GetSecretsHmac())
+
+	_, persisted, err := repo.getStore(ctx, got.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, "secret", persisted.GetSecrets().AsMap()["token"])
+
+	upd := got.clone()
+	upd.Name = "updated"
+	upd.Secrets, err = structpb.NewStruct(map[string]any{"token": "rotated"})
+	require.NoError(t, err)
+	updated, _, n, err := repo.UpdateCredentialStore(ctx, upd, got.GetVersion(), []string{"Name", "Secrets"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "updated", updated.GetName())
+	assert.NotEqual(t, got.GetSecretsHmac(), updated.GetSecretsHmac())
+
+	_, persisted, err = repo.getStore(ctx, got.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, "rotated", persisted.GetSecrets().AsMap()["token"])
+
+	n, err = repo.DeleteCredentialStore(ctx, got.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.True(t, gotDelete)
+
+	found, _, err = repo.LookupCredentialStore(ctx, got.GetPublicId())
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
+
+func TestRepository_CreateCredentialStore_InvalidPlugin(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	plg := plugin.TestPlugin(t, conn, "test", plugin.WithCredentialFlag(true))
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sched, map[string]plgpb.CredentialPluginServiceClient{})
+	require.NoError(t, err)
+
+	in, err := NewCredentialStore(ctx, prj.GetPublicId(), plg.GetPublicId())
+	require.NoError(t, err)
+	got, _, err := repo.CreateCredentialStore(ctx, in)
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/util/template"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ credential.Issuer = (*Repository)(nil)
+
+func insertQuery(c *Credential, storeId string) (query string, queryValues []any) {
+	queryValues = []any{
+		sql.Named("public_id", c.PublicId),
+		sql.Named("library_id", c.LibraryId),
+		sql.Named("session_id", c.SessionId),
+		sql.Named("store_id", storeId),
+		sql.Named("external_id", c.ExternalId),
+		sql.Named("is_renewable", c.IsRenewable),
+		sql.Named("status", c.Status),
+	}
+	switch {
+	case c.expiration == 0:
+		query = insertCredentialWithInfiniteExpirationQuery
+	default:
+		query = insertCredentialWithExpirationQuery
+		queryValues = append(queryValues, sql.Named("expiration_time", int(c.expiration.Round(time.Second).Seconds())))
+	}
+	return
+}
+
+func updateSessionQuery(c *Credential, purpose credential.Purpose) (query string, queryValues []any) {
+	queryValues = []any{
+		sql.Named("public_id", c.PublicId),
+		sql.Named("library_id", c.LibraryId),
+		sql.Named("session_id", c.SessionId),
+		sql.Named("purpose", string(purpose)),
+	}
+	query = updateSessionCredentialQuery
+	return
+}
+
+// Issue issues and returns dynamic credentials from the credential plugins
+// of the libraries in requests and assigns them to sessionId. If an error
+// occurs, any credentials already issued for the requests are marked for
+// revocation.
+//
+// Supported Options: credential.WithTemplateData
+func (r *Repository) Issue(ctx context.Context, sessionId string, requests []credential.Request, opt ...credential.Option) ([]credential.Dynamic, error) {
+	const op = "plugin.(Repository).Issue"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+	if len(requests) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no requests")
+	}
+	opts, err := credential.GetOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	templateData, err := templateDataToStruct(opts.WithTemplateData)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var creds []credential.Dynamic
+	var issuedIds []string
+	var minLease time.Duration
+	runJobsInterval := r.scheduler.GetRunJobsInterval()
+	for _, req := range requests {
+		cred, exp, err := r.issue(ctx, sessionId, req, templateData)
+		if err != nil {
+			if len(issuedIds) > 0 {
+				r.revokeIssued(ctx, issuedIds)
+			}
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		issuedIds = append(issuedIds, cred.GetPublicId())
+		creds = append(creds, cred)
+
+		if exp == 0 {
+			continue
+		}
+		if exp < runJobsInterval {
+			event.WriteError(ctx, op,
+				fmt.Errorf("WARNING: credential will expire before job scheduler can run"),
+				event.WithInfo("credential_public_id", cred.GetPublicId()),
+				event.WithInfo("credential_library_public_id", req.SourceId),
+				event.WithInfo("runJobsInterval", runJobsInterval.String()),
+			)
+		}
+		if minLease == 0 || minLease > exp {
+			minLease = exp
+		}
+	}
+
+	// Best effort update next run time of credential renewal job, but an error should not
+	// cause Issue to fail.
+	_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, credentialRenewalJobName, minLease)
+
+	return creds, nil
+}
+
+// issue issues a single credential for req from the plugin of the library's
+// credential store and persists it. It returns the credential and the
+// duration of its lease; a zero duration means the credential does not
+// expire.
+func (r *Repository) issue(ctx context.Context, sessionId string, req credential.Request, templateData *structpb.Struct) (credential.Dynamic, time.Duration, error) {
+	const op = "plugin.(Repository).issue"
+	lib, err := r.LookupCredentialLibrary(ctx, req.SourceId)
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	if lib == nil {
+		return nil, 0, errors.New(ctx, errors.NotSpecificIntegrity, op, fmt.Sprintf("credential library %s not found", req.SourceId))
+	}
+	cs, persisted, err := r.getStore(ctx, lib.GetStoreId())
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	plgClient, ok := r.plugins[cs.GetPluginId()]
+	if !ok || plgClient == nil {
+		return nil, 0, errors.New(ctx, errors.Internal, op, fmt.Sprintf("plugin %q not available", cs.GetPluginId()))
+	}
+	plgCs, err := toPluginStore(ctx, cs)
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	plgLib, err := toPluginLibrary(ctx, lib)
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+
+	resp, err := plgClient.IssueCredential(ctx, &plgpb.IssueCredentialRequest{
+		Store:        plgCs,
+		Library:      plgLib,
+		Persisted:    persisted,
+		SessionId:    sessionId,
+		TemplateData: templateData,
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op, errors.WithCode(errors.ExternalPlugin), errors.WithMsg("unable to issue credential"))
+	}
+	if resp.GetSecret() == nil {
+		return nil, 0, errors.New(ctx, errors.ExternalPlugin, op, "plugin returned an empty secret")
+	}
+
+	c, err := newCredential(ctx, lib.GetPublicId(), sessionId, resp.GetExternalId(), resp.GetRenewable(), time.Duration(resp.GetLeaseDurationSeconds())*time.Second)
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op, errors.WithCode(errors.ExternalPlugin))
+	}
+	if c.PublicId, err = newCredentialId(ctx); err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+
+	cred, err := convert(ctx, &baseCred{
+		Credential: c,
+		lib:        &issuingLibrary{CredentialLibrary: lib, purpose: req.Purpose},
+		secretData: resp.GetSecret().AsMap(),
+	})
+	if err != nil {
+		// The credential was issued by the plugin but cannot be used.
+		// Persist it so it is revoked by the revocation job.
+		c.Status = string(RevokeCredential)
+	}
+
+	insertQuery, insertQueryValues := insertQuery(c, lib.GetStoreId())
+	updateQuery, updateQueryValues := updateSessionQuery(c, req.Purpose)
+	if _, txErr := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			rowsInserted, err := w.Exec(ctx, insertQuery, insertQueryValues)
+			switch {
+			case err != nil:
+				return errors.Wrap(ctx, err, op)
+			case rowsInserted > 1:
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 credential would have been inserted")
+			}
+			if c.Status != string(ActiveCredential) {
+				return nil
+			}
+
+			rowsUpdated, err := w.Exec(ctx, updateQuery, updateQueryValues)
+			switch {
+			case err != nil:
+				return errors.Wrap(ctx, err, op)
+			case rowsUpdated == 0:
+				return errors.New(ctx, errors.InvalidDynamicCredential, op, "no matching dynamic credential for session found")
+			case rowsUpdated > 1:
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 session credential would have been updated")
+			}
+			return nil
+		},
+	); txErr != nil {
+		return nil, 0, errors.Wrap(ctx, txErr, op)
+	}
+	if err != nil {
+		_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, credentialRevocationJobName, 0)
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	return cred, c.expiration, nil
+}
+
+// revokeIssued marks the credentials for ids for revocation. Errors are
+// logged but not returned since revokeIssued is only called when issuing
+// has already failed.
+func (r *Repository) revokeIssued(ctx context.Context, ids []string) {
+	const op = "plugin.(Repository).revokeIssued"
+	for _, id := range ids {
+		if _, err := r.writer.Exec(ctx, updateCredentialStatusQuery, []any{RevokeCredential, id}); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfo("credential_public_id", id))
+		}
+	}
+	_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, credentialRevocationJobName, 0)
+}
+
+var _ credential.Revoker = (*Repository)(nil)
+
+// Revoke marks all dynamic credentials issued by credential plugins for
+// sessionId for revocation.
+func (r *Repository) Revoke(ctx context.Context, sessionId string) error {
+	const op = "plugin.(Repository).Revoke"
+	if sessionId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, revokeCredentialsQuery, []any{sessionId}); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	return err
+}
+
+// toPluginLibrary returns a credential library in the format expected by
+// the credential plugin system.
+func toPluginLibrary(ctx context.Context, in *CredentialLibrary) (*pb.CredentialLibrary, error) {
+	const op = "plugin.toPluginLibrary"
+	if in == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil storage credential library")
+	}
+	l := &pb.CredentialLibrary{
+		Id:                in.GetPublicId(),
+		CredentialStoreId: in.GetStoreId(),
+		Version:           in.GetVersion(),
+		Type:              Subtype.String(),
+		CredentialType:    string(in.CredentialType()),
+	}
+	if in.GetName() != "" {
+		l.Name = wrapperspb.String(in.GetName())
+	}
+	if in.GetDescription() != "" {
+		l.Description = wrapperspb.String(in.GetDescription())
+	}
+	if in.GetAttributes() != nil {
+		attrs := &structpb.Struct{}
+		if err := proto.Unmarshal(in.GetAttributes(), attrs); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to unmarshal attributes"))
+		}
+		l.Attrs = &pb.CredentialLibrary_Attributes{
+			Attributes: attrs,
+		}
+	}
+	return l, nil
+}
+
+// templateDataToStruct converts template data into the struct sent to
+// credential plugins. Fields without a value are omitted.
+func templateDataToStruct(in template.Data) (*structpb.Struct, error) {
+	set := func(m map[string]any, k string, v *string) {
+		if v != nil && *v != "" {
+			m[k] = *v
+		}
+	}
+	user := map[string]any{}
+	set(user, "id", in.User.Id)
+	set(user, "name", in.User.Name)
+	set(user, "full_name", in.User.FullName)
+	set(user, "email", in.User.Email)
+	account := map[string]any{}
+	set(account, "id", in.Account.Id)
+	set(account, "name", in.Account.Name)
+	set(account, "login_name", in.Account.LoginName)
+	set(account, "subject", in.Account.Subject)
+	set(account, "email", in.Account.Email)
+	return structpb.NewStruct(map[string]any{
+		"user":    user,
+		"account": account,
+	})
+}
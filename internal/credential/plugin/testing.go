@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCredentialStore creates a plugin credential store in the provided DB
+// with the provided project id and plugin id. The plugin's hooks are not
+// called. If any errors are encountered during the creation of the
+// credential store, the test will fail.
+func TestCredentialStore(t testing.TB, conn *db.DB, projectId, pluginId string, opt ...Option) *CredentialStore {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	cs, err := NewCredentialStore(ctx, projectId, pluginId, opt...)
+	require.NoError(t, err)
+	id, err := newCredentialStoreId(ctx)
+	require.NoError(t, err)
+	cs.PublicId = id
+
+	require.NoError(t, w.Create(ctx, cs))
+	return cs
+}
+
+// TestCredentialLibraries creates count number of plugin credential
+// libraries in the provided DB with the provided store id. If any errors
+// are encountered during the creation of the credential libraries, the test
+// will fail.
+func TestCredentialLibraries(t testing.TB, conn *db.DB, storeId string, count int, opt ...Option) []*CredentialLibrary {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	var libs []*CredentialLibrary
+	for i := 0; i < count; i++ {
+		lib, err := NewCredentialLibrary(ctx, storeId, opt...)
+		require.NoError(t, err)
+		id, err := newCredentialLibraryId(ctx)
+		require.NoError(t, err)
+		lib.PublicId = id
+
+		require.NoError(t, w.Create(ctx, lib))
+		libs = append(libs, lib)
+	}
+	return libs
+}
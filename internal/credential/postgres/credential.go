@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/postgres/store"
+	"google.golang.org/protobuf/proto"
+)
+
+// A CredentialStatus represents the status of a postgres credential.
+type CredentialStatus string
+
+const (
+	// ActiveCredential represents a role that is being used in an active
+	// session.
+	ActiveCredential CredentialStatus = "active"
+
+	// RevokeCredential represents a role that needs to be dropped.
+	RevokeCredential CredentialStatus = "revoke"
+
+	// RevokedCredential represents a role that has been dropped. This is a
+	// terminal status.
+	RevokedCredential CredentialStatus = "revoked"
+
+	// UnknownCredentialStatus represents a credential that has an unknown
+	// status.
+	UnknownCredentialStatus CredentialStatus = "unknown"
+)
+
+// A Credential is a record of a role created in the postgres server of a
+// CredentialStore for a session. The password of the role is not
+// persisted.
+type Credential struct {
+	*store.Credential
+	tableName string `gorm:"-"`
+}
+
+func allocCredential() *Credential {
+	return &Credential{
+		Credential: &store.Credential{},
+	}
+}
+
+func (c *Credential) clone() *Credential {
+	cp := proto.Clone(c.Credential)
+	return &Credential{
+		Credential: cp.(*store.Credential),
+	}
+}
+
+// TableName returns the table name.
+func (c *Credential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential_postgres_credential"
+}
+
+// SetTableName sets the table name.
+func (c *Credential) SetTableName(n string) {
+	c.tableName = n
+}
+
+// issuingLibrary is a credential library along with the purpose the
+// credential is being issued for.
+type issuingLibrary struct {
+	*CredentialLibrary
+	purpose credential.Purpose
+}
+
+var _ credential.UsernamePassword = (*usrPassCred)(nil)
+
+type usrPassCred struct {
+	*Credential
+
+	lib        *issuingLibrary
+	password   credential.Password
+	secretData map[string]any
+}
+
+func (c *usrPassCred) Secret() credential.SecretData { return c.secretData }
+func (c *usrPassCred) Library() credential.Library   { return c.lib }
+func (c *usrPassCred) Purpose() credential.Purpose   { return c.lib.purpose }
+func (c *usrPassCred) Username() string              { return c.RoleName }
+func (c *usrPassCred) Password() credential.Password { return c.password }
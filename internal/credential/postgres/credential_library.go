@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/postgres/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultRoleNamePrefix is the prefix of the names of the roles created
+	// by a credential library that does not set one.
+	DefaultRoleNamePrefix = "boundary"
+
+	// DefaultTtl is the duration a role created by a credential library is
+	// valid for if the credential library does not set a ttl.
+	DefaultTtl = 8 * time.Hour
+
+	// RoleNamePlaceholder is replaced with the quoted name of the role in
+	// the grant statements of a credential library.
+	RoleNamePlaceholder = "{{name}}"
+)
+
+var roleNamePrefixRegexp = regexp.MustCompile(`^[a-z_][a-z0-9_]{0,31}$`)
+
+// ValidRoleNamePrefix reports whether p can be used as the prefix of the
+// names of the roles created by a credential library. A valid prefix starts
+// with a lowercase letter or an underscore, contains only lowercase
+// letters, digits, and underscores, and is at most 32 characters long.
+func ValidRoleNamePrefix(p string) bool {
+	return roleNamePrefixRegexp.MatchString(p)
+}
+
+// A CredentialLibrary creates a role in the postgres server of its
+// credential store for each session and grants it the privileges in its
+// grant statements. It is owned by a CredentialStore.
+type CredentialLibrary struct {
+	*store.CredentialLibrary
+	tableName string `gorm:"-"`
+}
+
+// NewCredentialLibrary creates a new in memory CredentialLibrary assigned
+// to storeId. The grant statements must be set. Name, description, role
+// name prefix, and ttl are the only valid options. All other options are
+// ignored.
+func NewCredentialLibrary(storeId string, grantStatements string, opt ...Option) (*CredentialLibrary, error) {
+	opts := getOpts(opt...)
+
+	l := &CredentialLibrary{
+		CredentialLibrary: &store.CredentialLibrary{
+			StoreId:         storeId,
+			Name:            opts.withName,
+			Description:     opts.withDescription,
+			GrantStatements: grantStatements,
+			RoleNamePrefix:  opts.withRoleNamePrefix,
+			Ttl:             opts.withTtl,
+			CredentialType:  string(globals.UsernamePasswordCredentialType),
+		},
+	}
+	return l, nil
+}
+
+func allocCredentialLibrary() *CredentialLibrary {
+	return &CredentialLibrary{
+		CredentialLibrary: &store.CredentialLibrary{},
+	}
+}
+
+func (l *CredentialLibrary) clone() *CredentialLibrary {
+	cp := proto.Clone(l.CredentialLibrary)
+	return &CredentialLibrary{
+		CredentialLibrary: cp.(*store.CredentialLibrary),
+	}
+}
+
+// TableName returns the table name.
+func (l *CredentialLibrary) TableName() string {
+	if l.tableName != "" {
+		return l.tableName
+	}
+	return "credential_postgres_library"
+}
+
+// SetTableName sets the table name.
+func (l *CredentialLibrary) SetTableName(n string) {
+	l.tableName = n
+}
+
+// GetResourceType returns the resource type of the CredentialLibrary
+func (l *CredentialLibrary) GetResourceType() resource.Type {
+	return resource.CredentialLibrary
+}
+
+func (l *CredentialLibrary) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{l.PublicId},
+		"resource-type":      []string{"credential-postgres-library"},
+		"op-type":            []string{op.String()},
+	}
+	if l.StoreId != "" {
+		metadata["store-id"] = []string{l.StoreId}
+	}
+	return metadata
+}
+
+// CredentialType returns the type of credential the library issues.
+func (l *CredentialLibrary) CredentialType() globals.CredentialType {
+	return globals.CredentialType(l.CredentialLibrary.CredentialType)
+}
+
+var _ credential.Library = (*CredentialLibrary)(nil)
+
+type deletedCredentialLibrary struct {
+	PublicId   string `gorm:"primary_key"`
+	DeleteTime *timestamp.Timestamp
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (s *deletedCredentialLibrary) TableName() string {
+	return "credential_postgres_library_deleted"
+}
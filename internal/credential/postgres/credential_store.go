@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/postgres/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// The ssl modes supported when connecting to a postgres server.
+const (
+	SslModeDisable    = "disable"
+	SslModeRequire    = "require"
+	SslModeVerifyCa   = "verify-ca"
+	SslModeVerifyFull = "verify-full"
+)
+
+const (
+	// DefaultPort is the port used when a credential store does not set
+	// one.
+	DefaultPort uint32 = 5432
+
+	// DefaultDatabaseName is the database used when a credential store does
+	// not set one.
+	DefaultDatabaseName = "postgres"
+
+	// DefaultSslMode is the ssl mode used when a credential store does not
+	// set one.
+	DefaultSslMode = SslModeRequire
+)
+
+// ValidSslMode reports whether m is a supported ssl mode.
+func ValidSslMode(m string) bool {
+	switch m {
+	case SslModeDisable, SslModeRequire, SslModeVerifyCa, SslModeVerifyFull:
+		return true
+	}
+	return false
+}
+
+// A CredentialStore contains the address of a postgres server and the
+// credentials of an administrative role of the server. It contains postgres
+// credential libraries and is owned by a project.
+type CredentialStore struct {
+	*store.CredentialStore
+	tableName string `gorm:"-"`
+}
+
+// NewCredentialStore creates a new in memory CredentialStore assigned to
+// projectId for the postgres server at address. The username and password
+// are the credentials of a role allowed to create and drop roles. Name,
+// description, port, database name, and ssl mode are the only valid
+// options. All other options are ignored.
+func NewCredentialStore(projectId string, address string, username string, password credential.Password, opt ...Option) (*CredentialStore, error) {
+	opts := getOpts(opt...)
+	cs := &CredentialStore{
+		CredentialStore: &store.CredentialStore{
+			ProjectId:    projectId,
+			Name:         opts.withName,
+			Description:  opts.withDescription,
+			Address:      address,
+			Port:         opts.withPort,
+			DatabaseName: opts.withDatabaseName,
+			Username:     username,
+			Password:     []byte(password),
+			SslMode:      opts.withSslMode,
+		},
+	}
+	return cs, nil
+}
+
+func allocCredentialStore() *CredentialStore {
+	return &CredentialStore{
+		CredentialStore: &store.CredentialStore{},
+	}
+}
+
+// clone provides a deep copy of the CredentialStore.
+func (cs *CredentialStore) clone() *CredentialStore {
+	cp := proto.Clone(cs.CredentialStore)
+	return &CredentialStore{
+		CredentialStore: cp.(*store.CredentialStore),
+	}
+}
+
+// TableName returns the table name for the credential store.
+func (cs *CredentialStore) TableName() string {
+	if cs.tableName != "" {
+		return cs.tableName
+	}
+	return "credential_postgres_store"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (cs *CredentialStore) SetTableName(n string) {
+	cs.tableName = n
+}
+
+// GetResourceType returns the resource type of the CredentialStore
+func (cs *CredentialStore) GetResourceType() resource.Type {
+	return resource.CredentialStore
+}
+
+func (cs *CredentialStore) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{cs.PublicId},
+		"resource-type":      []string{"postgres-credential-store"},
+		"op-type":            []string{op.String()},
+	}
+	if cs.ProjectId != "" {
+		metadata["project-id"] = []string{cs.ProjectId}
+	}
+	return metadata
+}
+
+func (cs *CredentialStore) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "postgres.(CredentialStore).encrypt"
+	if len(cs.Password) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no password defined")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, cs.CredentialStore, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	var err error
+	cs.KeyId, err = cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to discover wrapper key id"))
+	}
+	if err := cs.hmacPassword(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	cs.Password = nil
+	return nil
+}
+
+func (cs *CredentialStore) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "postgres.(CredentialStore).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, cs.CredentialStore, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	cs.CtPassword = nil
+	return nil
+}
+
+func (cs *CredentialStore) hmacPassword(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "postgres.(CredentialStore).hmacPassword"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	hm, err := crypto.HmacSha256(ctx, cs.Password, cipher, []byte(cs.PublicId), nil, crypto.WithEd25519())
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	cs.PasswordHmac = []byte(hm)
+	return nil
+}
+
+type deletedStore struct {
+	PublicId   string `gorm:"primary_key"`
+	DeleteTime *timestamp.Timestamp
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (s *deletedStore) TableName() string {
+	return "credential_postgres_store_deleted"
+}
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package postgres implements a credential store that creates a temporary
+// role in a Postgres database for each session. The credential store holds
+// the address of a Postgres server and the username and password of an
+// administrative role that is allowed to create roles. The password is
+// encrypted with a database key of the owning project.
+//
+// Credential libraries in the store create a new login role with a random
+// name and password when a session is authorized, and execute the grant
+// statements of the library for the role. The role is dropped by a job
+// after the session is canceled or terminated.
+package postgres
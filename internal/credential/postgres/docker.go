@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import "testing"
+
+var newPostgresServer func(t testing.TB) *TestPostgresServer = skipNewServer
+
+func skipNewServer(t testing.TB) *TestPostgresServer {
+	t.Skip("docker not available")
+	return nil
+}
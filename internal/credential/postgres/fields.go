@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+// These constants are the field names used in the postgres related field
+// masks.
+const (
+	nameField        = "Name"
+	descriptionField = "Description"
+
+	addressField      = "Address"
+	portField         = "Port"
+	databaseNameField = "DatabaseName"
+	usernameField     = "Username"
+	passwordField     = "Password"
+	sslModeField      = "SslMode"
+
+	grantStatementsField = "GrantStatements"
+	roleNamePrefixField  = "RoleNamePrefix"
+	ttlField             = "Ttl"
+)
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	ua "go.uber.org/atomic"
+)
+
+const (
+	credentialRevocationJobName = "postgres_credential_revocation"
+	credentialCleanupJobName    = "postgres_credential_cleanup"
+
+	defaultNextRunIn = 5 * time.Minute
+)
+
+// RegisterJobs registers the jobs that drop the roles created by postgres
+// credential libraries and clean up their records.
+func RegisterJobs(ctx context.Context, sched *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms) error {
+	const op = "postgres.RegisterJobs"
+	credRevoke, err := newCredentialRevocationJob(ctx, r, w, kms, sched)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = sched.RegisterJob(ctx, credRevoke); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential revocation job"))
+	}
+	credCleanup, err := newCredentialCleanupJob(ctx, w)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = sched.RegisterJob(ctx, credCleanup); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential cleanup job"))
+	}
+	return nil
+}
+
+// jobCredential is the subset of a postgres credential the revocation job
+// needs.
+type jobCredential struct {
+	PublicId string `gorm:"primary_key"`
+	StoreId  string
+	RoleName string
+}
+
+// TableName returns the table name for gorm.
+func (*jobCredential) TableName() string { return "credential_postgres_credential" }
+
+// CredentialRevocationJob is the recurring job that drops the roles of
+// postgres credentials that are no longer being used by an active or
+// pending session. The CredentialRevocationJob is not thread safe, an
+// attempt to Run the job concurrently will result in an JobAlreadyRunning
+// error.
+type CredentialRevocationJob struct {
+	reader db.Reader
+	writer db.Writer
+	repo   *Repository
+	limit  int
+
+	running      ua.Bool
+	numCreds     int
+	numProcessed int
+}
+
+func newCredentialRevocationJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, sched *scheduler.Scheduler) (*CredentialRevocationJob, error) {
+	const op = "postgres.newCredentialRevocationJob"
+	repo, err := NewRepository(ctx, r, w, kms, sched)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &CredentialRevocationJob{
+		reader: r,
+		writer: w,
+		repo:   repo,
+		limit:  db.DefaultLimit,
+	}, nil
+}
+
+// Status returns the current status of the credential revocation job.
+func (r *CredentialRevocationJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: r.numProcessed,
+		Total:     r.numCreds,
+	}
+}
+
+// Run queries for postgres credentials in the revoke state and drops their
+// roles in the postgres server of each credential's store. Can not be run
+// in parallel, if Run is invoked while already running an error with code
+// JobAlreadyRunning will be returned.
+func (r *CredentialRevocationJob) Run(ctx context.Context) error {
+	const op = "postgres.(CredentialRevocationJob).Run"
+	if !r.running.CAS(r.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer r.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	var creds []*jobCredential
+	err := r.reader.SearchWhere(ctx, &creds, "status = ?", []any{RevokeCredential}, db.WithLimit(r.limit))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	r.numProcessed, r.numCreds = 0, len(creds)
+	for _, c := range creds {
+		// Verify context is not done before revoking next credential
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := r.revokeCred(ctx, c); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error revoking credential", "credential id", c.PublicId))
+		}
+		r.numProcessed++
+	}
+
+	return nil
+}
+
+func (r *CredentialRevocationJob) revokeCred(ctx context.Context, c *jobCredential) error {
+	const op = "postgres.(CredentialRevocationJob).revokeCred"
+	conn, err := r.repo.connect(ctx, c.StoreId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer conn.Close(context.Background())
+
+	if err := dropRole(ctx, conn, c.RoleName); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	numRows, err := r.writer.Exec(ctx, updateCredentialStatusQuery, []any{RevokedCredential, c.PublicId})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if numRows != 1 {
+		return errors.New(ctx, errors.Unknown, op, "credential revoked but failed to update repo")
+	}
+	return nil
+}
+
+// NextRunIn determine when the next credential revocation job should run.
+func (r *CredentialRevocationJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return defaultNextRunIn, nil
+}
+
+// Name is the unique name of the job.
+func (r *CredentialRevocationJob) Name() string {
+	return credentialRevocationJobName
+}
+
+// Description is the human readable description of the job.
+func (r *CredentialRevocationJob) Description() string {
+	return "Periodically drops the roles of postgres credentials that are no longer in use and have been set for revocation (in the revoke state)."
+}
+
+// CredentialCleanupJob is the recurring job that deletes postgres credentials
+// that are no longer attached to a session (have a null session_id) and are
+// not active. The CredentialCleanupJob is not thread safe, an attempt to
+// Run the job concurrently will result in an JobAlreadyRunning error.
+type CredentialCleanupJob struct {
+	writer db.Writer
+
+	running  ua.Bool
+	numCreds int
+}
+
+func newCredentialCleanupJob(ctx context.Context, w db.Writer) (*CredentialCleanupJob, error) {
+	const op = "postgres.newCredentialCleanupJob"
+	if w == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	}
+	return &CredentialCleanupJob{
+		writer: w,
+	}, nil
+}
+
+// Status returns the current status of the credential cleanup job.
+func (r *CredentialCleanupJob) Status() scheduler.JobStatus {
+	// Cleanup runs a single exec command to the database, therefore completed and total
+	// are both set to numCreds.
+	return scheduler.JobStatus{
+		Completed: r.numCreds,
+		Total:     r.numCreds,
+	}
+}
+
+// Run deletes all postgres credentials in the repo that have a null
+// session_id and are not active. Can not be run in parallel, if Run is
+// invoked while already running an error with code JobAlreadyRunning will
+// be returned.
+func (r *CredentialCleanupJob) Run(ctx context.Context) error {
+	const op = "postgres.(CredentialCleanupJob).Run"
+	if !r.running.CAS(r.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer r.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	numRows, err := r.writer.Exec(ctx, credCleanupQuery, nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	r.numCreds = numRows
+
+	return nil
+}
+
+// NextRunIn determine when the next credential cleanup job should run.
+func (r *CredentialCleanupJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return defaultNextRunIn, nil
+}
+
+// Name is the unique name of the job.
+func (r *CredentialCleanupJob) Name() string {
+	return credentialCleanupJobName
+}
+
+// Description is the human readable description of the job.
+func (r *CredentialCleanupJob) Description() string {
+	return "Periodically deletes postgres credentials that are no longer attached to a session (have a null session_id) and are not active."
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName           string
+	withDescription    string
+	withPort           uint32
+	withDatabaseName   string
+	withSslMode        string
+	withRoleNamePrefix string
+	withTtl            string
+	withLimit          int
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithPort provides an optional port of the postgres server. Defaults to
+// 5432.
+func WithPort(p uint32) Option {
+	return func(o *options) {
+		o.withPort = p
+	}
+}
+
+// WithDatabaseName provides an optional name of the database to connect
+// to. Defaults to "postgres".
+func WithDatabaseName(n string) Option {
+	return func(o *options) {
+		o.withDatabaseName = n
+	}
+}
+
+// WithSslMode provides an optional ssl mode used to connect to the
+// postgres server. Valid values are "disable", "require", "verify-ca", and
+// "verify-full". Defaults to "require".
+func WithSslMode(m string) Option {
+	return func(o *options) {
+		o.withSslMode = m
+	}
+}
+
+// WithRoleNamePrefix provides an optional prefix for the names of the
+// roles created by a credential library. Defaults to "boundary".
+func WithRoleNamePrefix(p string) Option {
+	return func(o *options) {
+		o.withRoleNamePrefix = p
+	}
+}
+
+// WithTtl provides an optional duration a role created by a credential
+// library is valid for.
+func WithTtl(t string) Option {
+	return func(o *options) {
+		o.withTtl = t
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are
+// returned. If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
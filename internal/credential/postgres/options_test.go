@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithName", func(t *testing.T) {
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithPort", func(t *testing.T) {
+		opts := getOpts(WithPort(5433))
+		testOpts := getDefaultOptions()
+		testOpts.withPort = 5433
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDatabaseName", func(t *testing.T) {
+		opts := getOpts(WithDatabaseName("app"))
+		testOpts := getDefaultOptions()
+		testOpts.withDatabaseName = "app"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSslMode", func(t *testing.T) {
+		opts := getOpts(WithSslMode(SslModeVerifyFull))
+		testOpts := getDefaultOptions()
+		testOpts.withSslMode = SslModeVerifyFull
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithRoleNamePrefix", func(t *testing.T) {
+		opts := getOpts(WithRoleNamePrefix("app"))
+		testOpts := getDefaultOptions()
+		testOpts.withRoleNamePrefix = "app"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithTtl", func(t *testing.T) {
+		opts := getOpts(WithTtl("1h"))
+		testOpts := getDefaultOptions()
+		testOpts.withTtl = "1h"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		opts := getOpts(WithLimit(5))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(t, opts, testOpts)
+	})
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+func init() {
+	globals.RegisterPrefixToResourceInfo(globals.PostgresCredentialStorePrefix, resource.CredentialStore, credential.Domain, Subtype)
+	globals.RegisterPrefixToResourceInfo(globals.PostgresCredentialLibraryPrefix, resource.CredentialLibrary, credential.Domain, Subtype)
+	globals.RegisterPrefixToResourceInfo(globals.PostgresDynamicCredentialPrefix, resource.Credential, credential.Domain, Subtype)
+}
+
+// PublicId prefixes for the resources in the postgres package.
+const (
+	Subtype = globals.Subtype("postgres")
+)
+
+func newCredentialStoreId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(ctx, globals.PostgresCredentialStorePrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "postgres.newCredentialStoreId")
+	}
+	return id, nil
+}
+
+func newCredentialLibraryId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(ctx, globals.PostgresCredentialLibraryPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "postgres.newCredentialLibraryId")
+	}
+	return id, nil
+}
+
+func newCredentialId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(ctx, globals.PostgresDynamicCredentialPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "postgres.newCredentialId")
+	}
+	return id, nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+const (
+	updateSessionCredentialQuery = `
+update session_credential_dynamic
+   set credential_id = @public_id
+ where library_id = @library_id
+   and session_id = @session_id
+   and credential_purpose = @purpose
+   and credential_id is null
+returning *;
+`
+
+	revokeCredentialsQuery = `
+update credential_postgres_credential
+   set status = 'revoke'
+ where session_id = ?
+   and status = 'active';
+`
+
+	updateCredentialStatusQuery = `
+update credential_postgres_credential
+   set status = ?
+ where public_id = ?;
+`
+
+	credCleanupQuery = `
+delete from credential_postgres_credential
+ where session_id is null
+   and status not in ('active', 'revoke');
+`
+
+	estimateCountCredentialLibraries = `
+select reltuples::bigint as estimate
+  from pg_class
+ where oid = 'credential_postgres_library'::regclass
+`
+
+	listLibrariesTemplate = `
+  select *
+    from credential_postgres_library
+   where store_id = @store_id
+order by create_time desc, public_id desc
+   limit %d;
+`
+
+	listLibrariesPageTemplate = `
+  select *
+    from credential_postgres_library
+   where store_id = @store_id
+     and (create_time, public_id) < (@last_item_create_time, @last_item_id)
+order by create_time desc, public_id desc
+   limit %d;
+`
+
+	listLibrariesRefreshTemplate = `
+  select *
+    from credential_postgres_library
+   where store_id = @store_id
+     and update_time > @updated_after_time
+order by update_time desc, public_id desc
+   limit %d;
+`
+
+	listLibrariesRefreshPageTemplate = `
+  select *
+    from credential_postgres_library
+   where store_id = @store_id
+     and update_time > @updated_after_time
+     and (update_time, public_id) < (@last_item_update_time, @last_item_id)
+order by update_time desc, public_id desc
+   limit %d;
+`
+)
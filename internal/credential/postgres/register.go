@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/credential"
+)
+
+func init() {
+	credential.RegisterStoreSubtype("postgres", &credentialHooks{})
+}
+
+type credentialHooks struct{}
+
+// NewStore creates a new postgres credential store from the result
+func (credentialHooks) NewStore(ctx context.Context, result *credential.StoreListQueryResult) (credential.Store, error) {
+	s := allocCredentialStore()
+	s.PublicId = result.PublicId
+	s.ProjectId = result.ProjectId
+	s.CreateTime = result.CreateTime
+	s.UpdateTime = result.UpdateTime
+	s.Name = result.Name
+	s.Description = result.Description
+	s.Version = result.Version
+	s.Address = result.Address
+	s.Port = result.Port
+	s.DatabaseName = result.DatabaseName
+	s.Username = result.Username
+	s.SslMode = result.SslMode
+	s.PasswordHmac = result.PasswordHmac
+
+	return s, nil
+}
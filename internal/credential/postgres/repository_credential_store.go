@@ -0,0 +1,371 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-dbw"
+	"github.com/jackc/pgx/v5"
+)
+
+// CreateCredentialStore inserts cs into the repository and returns a new
+// CredentialStore containing the credential store's PublicId. cs is not
+// changed. cs must not contain a PublicId. The PublicId is generated and
+// assigned by this method. cs must contain a valid ProjectId, Address,
+// Username, and Password.
+//
+// cs.Port defaults to 5432, cs.DatabaseName defaults to "postgres", and
+// cs.SslMode defaults to "require". The password is encrypted with a
+// database key of the project and a HmacSha256 of the password is
+// calculated. Only the PasswordHmac is returned.
+//
+// Both cs.Name and cs.Description are optional. If cs.Name is set, it must
+// be unique within cs.ProjectId. Both cs.CreateTime and cs.UpdateTime are
+// ignored.
+func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialStore, _ ...Option) (*CredentialStore, error) {
+	const op = "postgres.(Repository).CreateCredentialStore"
+	if cs == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing CredentialStore")
+	}
+	if cs.CredentialStore == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing embedded CredentialStore")
+	}
+	if cs.ProjectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	}
+	if cs.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+	if cs.Address == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing address")
+	}
+	if cs.Username == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing username")
+	}
+	if len(cs.Password) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing password")
+	}
+
+	cs = cs.clone()
+	if cs.Port == 0 {
+		cs.Port = DefaultPort
+	}
+	if cs.DatabaseName == "" {
+		cs.DatabaseName = DefaultDatabaseName
+	}
+	if cs.SslMode == "" {
+		cs.SslMode = DefaultSslMode
+	}
+	if !ValidSslMode(cs.SslMode) {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid ssl mode %q", cs.SslMode))
+	}
+
+	id, err := newCredentialStoreId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	cs.PublicId = id
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, cs.ProjectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := cs.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, cs.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var newCredentialStore *CredentialStore
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newCredentialStore = cs.clone()
+			if err := w.Create(ctx, newCredentialStore,
+				db.WithOplog(oplogWrapper, newCredentialStore.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in project: %s: name %s already exists", cs.ProjectId, cs.Name)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in project: %s", cs.ProjectId)))
+	}
+
+	newCredentialStore.CtPassword = nil
+	newCredentialStore.Password = nil
+	return newCredentialStore, nil
+}
+
+// LookupCredentialStore returns the CredentialStore for publicId. The
+// password of the administrative role is not returned. Returns nil, nil if
+// no CredentialStore is found for publicId.
+func (r *Repository) LookupCredentialStore(ctx context.Context, publicId string, _ ...Option) (*CredentialStore, error) {
+	const op = "postgres.(Repository).LookupCredentialStore"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	cs := allocCredentialStore()
+	cs.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, cs); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+	}
+	cs.CtPassword = nil
+	return cs, nil
+}
+
+// connect opens a connection to the postgres server of the credential
+// store for publicId as the administrative role of the credential store.
+// The caller must close the returned connection.
+func (r *Repository) connect(ctx context.Context, publicId string) (*pgx.Conn, error) {
+	const op = "postgres.(Repository).connect"
+	cs := allocCredentialStore()
+	cs.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, cs); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, cs.ProjectId, kms.KeyPurposeDatabase, kms.WithKeyId(cs.KeyId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := cs.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	conn, err := pgx.Connect(ctx, cs.connectionUrl())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Unavailable), errors.WithMsg(fmt.Sprintf("unable to connect to postgres server of credential store %s", publicId)))
+	}
+	return conn, nil
+}
+
+// connectionUrl returns the url used to connect to the postgres server of
+// cs. cs must be decrypted.
+func (cs *CredentialStore) connectionUrl() string {
+	u := &url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cs.Username, string(cs.Password)),
+		Host:     net.JoinHostPort(cs.Address, strconv.FormatUint(uint64(cs.Port), 10)),
+		Path:     "/" + cs.DatabaseName,
+		RawQuery: url.Values{"sslmode": []string{cs.SslMode}}.Encode(),
+	}
+	return u.String()
+}
+
+// UpdateCredentialStore updates the repository entry for cs.PublicId with
+// the values in cs for the fields listed in fieldMaskPaths. It returns a
+// new CredentialStore containing the updated values and a count of the
+// number of records updated. cs is not changed.
+//
+// cs must contain a valid PublicId. Name, Description, Address, Port,
+// DatabaseName, Username, Password, and SslMode can be changed. If cs.Name
+// is set to a non-empty string, it must be unique within cs.ProjectId. If
+// the Password is changed, it is encrypted and its HmacSha256 is
+// recalculated.
+//
+// An attribute of cs will be set to NULL in the database if the attribute
+// in cs is the zero value and it is included in fieldMaskPaths.
+func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialStore, version uint32, fieldMaskPaths []string, _ ...Option) (*CredentialStore, int, error) {
+	const op = "postgres.(Repository).UpdateCredentialStore"
+	if cs == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing CredentialStore")
+	}
+	if cs.CredentialStore == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing embedded CredentialStore")
+	}
+	if cs.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+	if cs.ProjectId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	}
+	cs = cs.clone()
+
+	var updatePassword bool
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(nameField, f):
+		case strings.EqualFold(descriptionField, f):
+		case strings.EqualFold(addressField, f):
+			if cs.Address == "" {
+				return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing address")
+			}
+		case strings.EqualFold(portField, f):
+			if cs.Port == 0 {
+				cs.Port = DefaultPort
+			}
+		case strings.EqualFold(databaseNameField, f):
+			if cs.DatabaseName == "" {
+				cs.DatabaseName = DefaultDatabaseName
+			}
+		case strings.EqualFold(usernameField, f):
+			if cs.Username == "" {
+				return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing username")
+			}
+		case strings.EqualFold(passwordField, f):
+			if len(cs.Password) == 0 {
+				return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing password")
+			}
+			updatePassword = true
+		case strings.EqualFold(sslModeField, f):
+			if cs.SslMode == "" {
+				cs.SslMode = DefaultSslMode
+			}
+			if !ValidSslMode(cs.SslMode) {
+				return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid ssl mode %q", cs.SslMode))
+			}
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
+		}
+	}
+	var filteredPaths []string
+	for _, f := range fieldMaskPaths {
+		if !strings.EqualFold(passwordField, f) {
+			filteredPaths = append(filteredPaths, f)
+		}
+	}
+	dbMask, nullFields := dbw.BuildUpdatePaths(
+		map[string]any{
+			nameField:         cs.Name,
+			descriptionField:  cs.Description,
+			addressField:      cs.Address,
+			portField:         cs.Port,
+			databaseNameField: cs.DatabaseName,
+			usernameField:     cs.Username,
+			sslModeField:      cs.SslMode,
+		},
+		filteredPaths,
+		nil,
+	)
+	if updatePassword {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, cs.ProjectId, kms.KeyPurposeDatabase)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := cs.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		dbMask = append(dbMask, "CtPassword", "PasswordHmac", "KeyId")
+	}
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, cs.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected,
+			errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	var returnedCredentialStore *CredentialStore
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(rr db.Reader, w db.Writer) error {
+			ucs := cs.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, ucs,
+				dbMask, nullFields,
+				db.WithOplog(oplogWrapper, ucs.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			switch rowsUpdated {
+			case 1:
+			case 0:
+				return nil
+			default:
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+
+			returnedCredentialStore = allocCredentialStore()
+			returnedCredentialStore.PublicId = cs.PublicId
+			if err := rr.LookupByPublicId(ctx, returnedCredentialStore); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve updated credential store"))
+			}
+			returnedCredentialStore.CtPassword = nil
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op,
+				fmt.Sprintf("name %s already exists: %s", cs.Name, cs.PublicId))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(cs.PublicId))
+	}
+
+	return returnedCredentialStore, rowsUpdated, nil
+}
+
+// DeleteCredentialStore deletes publicId from the repository and returns
+// the number of records deleted. All options are ignored.
+func (r *Repository) DeleteCredentialStore(ctx context.Context, publicId string, _ ...Option) (int, error) {
+	const op = "postgres.(Repository).DeleteCredentialStore"
+	if publicId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	cs := allocCredentialStore()
+	cs.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, cs); err != nil {
+		if errors.IsNotFoundError(err) {
+			return db.NoRowsAffected, nil
+		}
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", publicId)))
+	}
+	if cs.ProjectId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, cs.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			dcs := allocCredentialStore()
+			dcs.PublicId = cs.PublicId
+			dcs.ProjectId = cs.ProjectId
+			rowsDeleted, err = w.Delete(ctx, dcs, db.WithOplog(oplogWrapper, dcs.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(publicId))
+	}
+
+	return rowsDeleted, nil
+}
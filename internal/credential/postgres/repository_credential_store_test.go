@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CredentialStoreLifecycle(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sched)
+	require.NoError(t, err)
+
+	in, err := NewCredentialStore(prj.GetPublicId(), "db.example.com", "admin", credential.Password("admin-password"), WithName("store"))
+	require.NoError(t, err)
+
+	got, err := repo.CreateCredentialStore(ctx, in)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "store", got.GetName())
+	assert.Equal(t, DefaultPort, got.GetPort())
+	assert.Equal(t, DefaultDatabaseName, got.GetDatabaseName())
+	assert.Equal(t, DefaultSslMode, got.GetSslMode())
+	assert.Empty(t, got.GetPassword())
+	assert.Empty(t, got.GetCtPassword())
+	assert.NotEmpty(t, got.GetPasswordHmac())
+	assert.NotEmpty(t, got.GetKeyId())
+
+	found, err := repo.LookupCredentialStore(ctx, got.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, got.GetPasswordHmac(), found.GetPasswordHmac())
+	assert.Empty(t, found.GetCtPassword())
+
+	// The name must be unique within the project.
+	_, err = repo.CreateCredentialStore(ctx, in)
+	require.Error(t, err)
+
+	upd := got.clone()
+	upd.Name = "updated"
+	upd.Password = []byte("new-password")
+	upd.SslMode = SslModeVerifyFull
+	updated, n, err := repo.UpdateCredentialStore(ctx, upd, got.GetVersion(), []string{"Name", "Password", "SslMode"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "updated", updated.GetName())
+	assert.Equal(t, SslModeVerifyFull, updated.GetSslMode())
+	assert.NotEqual(t, got.GetPasswordHmac(), updated.GetPasswordHmac())
+	assert.Empty(t, updated.GetCtPassword())
+
+	upd.SslMode = "prefer"
+	_, _, err = repo.UpdateCredentialStore(ctx, upd, updated.GetVersion(), []string{"SslMode"})
+	require.Error(t, err)
+
+	n, err = repo.DeleteCredentialStore(ctx, got.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	found, err = repo.LookupCredentialStore(ctx, got.GetPublicId())
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/go-secure-stdlib/base62"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// roleNameSuffixLength is the length of the random suffix appended to
+	// the role name prefix of a library to create the name of a role.
+	roleNameSuffixLength = 20
+
+	// passwordLength is the length of the random password of a role.
+	passwordLength = 32
+)
+
+var _ credential.Issuer = (*Repository)(nil)
+
+// Issue creates a role in the postgres server of the credential store of
+// each library in requests, executes the grant statements of the library
+// for the role, and assigns the role to sessionId. If an error occurs,
+// any roles already created for the requests are marked for revocation.
+//
+// Template data is not applied to the grant statements; all options are
+// ignored.
+func (r *Repository) Issue(ctx context.Context, sessionId string, requests []credential.Request, _ ...credential.Option) ([]credential.Dynamic, error) {
+	const op = "postgres.(Repository).Issue"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+	if len(requests) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no requests")
+	}
+
+	var creds []credential.Dynamic
+	var issuedIds []string
+	for _, req := range requests {
+		cred, err := r.issue(ctx, sessionId, req)
+		if err != nil {
+			if len(issuedIds) > 0 {
+				r.revokeIssued(ctx, issuedIds)
+			}
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		issuedIds = append(issuedIds, cred.GetPublicId())
+		creds = append(creds, cred)
+	}
+	return creds, nil
+}
+
+// issue creates a single role for req and records it. The role is created
+// in a transaction on the postgres server that is only committed after the
+// role has been recorded in the repository.
+func (r *Repository) issue(ctx context.Context, sessionId string, req credential.Request) (credential.Dynamic, error) {
+	const op = "postgres.(Repository).issue"
+	lib, err := r.LookupCredentialLibrary(ctx, req.SourceId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if lib == nil {
+		return nil, errors.New(ctx, errors.NotSpecificIntegrity, op, fmt.Sprintf("credential library %s not found", req.SourceId))
+	}
+
+	ttl := DefaultTtl
+	if lib.Ttl != "" {
+		if ttl, err = time.ParseDuration(lib.Ttl); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("unable to parse ttl"))
+		}
+	}
+	prefix := DefaultRoleNamePrefix
+	if lib.RoleNamePrefix != "" {
+		prefix = lib.RoleNamePrefix
+	}
+	suffix, err := base62.Random(roleNameSuffixLength)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate role name"))
+	}
+	roleName := prefix + "_" + strings.ToLower(suffix)
+	password, err := base62.Random(passwordLength)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate password"))
+	}
+	expiration := time.Now().Add(ttl)
+
+	conn, err := r.connect(ctx, lib.StoreId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer conn.Close(context.Background())
+
+	pgTx, err := conn.Begin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Unavailable), errors.WithMsg("unable to begin postgres transaction"))
+	}
+	defer pgTx.Rollback(context.Background())
+
+	ident := pgx.Identifier{roleName}.Sanitize()
+	createRole := fmt.Sprintf("create role %s with login password %s valid until %s",
+		ident, quoteLiteral(password), quoteLiteral(expiration.UTC().Format(time.RFC3339)))
+	if _, err := pgTx.Exec(ctx, createRole); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Unavailable), errors.WithMsg("unable to create role"))
+	}
+	if _, err := pgTx.Exec(ctx, strings.ReplaceAll(lib.GrantStatements, RoleNamePlaceholder, ident)); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("unable to execute grant statements"))
+	}
+
+	c := allocCredential()
+	if c.PublicId, err = newCredentialId(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	c.LibraryId = lib.PublicId
+	c.SessionId = sessionId
+	c.StoreId = lib.StoreId
+	c.RoleName = roleName
+	c.ExpirationTime = timestamp.New(expiration)
+	c.Status = string(ActiveCredential)
+
+	updateQueryValues := []any{
+		sql.Named("public_id", c.PublicId),
+		sql.Named("library_id", c.LibraryId),
+		sql.Named("session_id", c.SessionId),
+		sql.Named("purpose", string(req.Purpose)),
+	}
+	if _, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if err := w.Create(ctx, c.clone()); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			rowsUpdated, err := w.Exec(ctx, updateSessionCredentialQuery, updateQueryValues)
+			switch {
+			case err != nil:
+				return errors.Wrap(ctx, err, op)
+			case rowsUpdated == 0:
+				return errors.New(ctx, errors.InvalidDynamicCredential, op, "no matching dynamic credential for session found")
+			case rowsUpdated > 1:
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 session credential would have been updated")
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	if err := pgTx.Commit(ctx); err != nil {
+		// The commit may have been applied by the server before the error,
+		// let the revocation job drop the role if it exists.
+		r.revokeIssued(ctx, []string{c.PublicId})
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Unavailable), errors.WithMsg("unable to commit postgres transaction"))
+	}
+
+	return &usrPassCred{
+		Credential: c,
+		lib:        &issuingLibrary{CredentialLibrary: lib, purpose: req.Purpose},
+		password:   credential.Password(password),
+		secretData: map[string]any{
+			"username": roleName,
+			"password": password,
+		},
+	}, nil
+}
+
+// quoteLiteral returns s as a quoted SQL string literal.
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// revokeIssued marks the credentials for ids for revocation. Errors are
+// logged but not returned since revokeIssued is only called when issuing
+// has already failed.
+func (r *Repository) revokeIssued(ctx context.Context, ids []string) {
+	const op = "postgres.(Repository).revokeIssued"
+	for _, id := range ids {
+		if _, err := r.writer.Exec(ctx, updateCredentialStatusQuery, []any{RevokeCredential, id}); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfo("credential_public_id", id))
+		}
+	}
+	_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, credentialRevocationJobName, 0)
+}
+
+var _ credential.Revoker = (*Repository)(nil)
+
+// Revoke marks all roles created by postgres credential libraries for
+// sessionId for revocation. The roles are dropped by the revocation job.
+func (r *Repository) Revoke(ctx context.Context, sessionId string) error {
+	const op = "postgres.(Repository).Revoke"
+	if sessionId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, revokeCredentialsQuery, []any{sessionId}); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+	_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, credentialRevocationJobName, 0)
+	return nil
+}
+
+// dropRole terminates the connections of the role roleName, reassigns the
+// objects it owns to the administrative role of conn, and drops it. A role
+// that no longer exists is ignored.
+func dropRole(ctx context.Context, conn *pgx.Conn, roleName string) error {
+	const op = "postgres.dropRole"
+	var exists bool
+	if err := conn.QueryRow(ctx, "select exists(select 1 from pg_roles where rolname = $1)", roleName).Scan(&exists); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Unavailable), errors.WithMsg("unable to look up role"))
+	}
+	if !exists {
+		return nil
+	}
+
+	ident := pgx.Identifier{roleName}.Sanitize()
+	pgTx, err := conn.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Unavailable), errors.WithMsg("unable to begin postgres transaction"))
+	}
+	defer pgTx.Rollback(context.Background())
+	if _, err := pgTx.Exec(ctx, "select pg_terminate_backend(pid) from pg_stat_activity where usename = $1", roleName); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to terminate role connections"))
+	}
+	for _, stmt := range []string{
+		"reassign owned by " + ident + " to current_user",
+		"drop owned by " + ident,
+		"drop role " + ident,
+	} {
+		if _, err := pgTx.Exec(ctx, stmt); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to drop role"))
+		}
+	}
+	if err := pgTx.Commit(ctx); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Unavailable), errors.WithMsg("unable to commit postgres transaction"))
+	}
+	return nil
+}
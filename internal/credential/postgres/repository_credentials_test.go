@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_IssueAndRevoke(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+
+	pg := NewTestPostgresServer(t)
+	pg.Exec(t, "create table boundary_opened ( name text primary key )")
+
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, kmsCache, prj.GetPublicId(), pg.Address, pg.Username, credential.Password(pg.Password),
+		WithPort(pg.Port), WithDatabaseName(pg.DatabaseName), WithSslMode(SslModeDisable))
+	lib := TestCredentialLibraries(t, conn, cs.GetPublicId(), "grant select, insert on boundary_opened to {{name}};", 1,
+		WithRoleNamePrefix("app"), WithTtl("1h"))[0]
+
+	at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+	hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+
+	req := credential.Request{SourceId: lib.GetPublicId(), Purpose: credential.BrokeredPurpose}
+	sess := session.TestSession(t, conn, wrapper, session.ComposedOf{
+		UserId:      at.GetIamUserId(),
+		HostId:      h.GetPublicId(),
+		TargetId:    tar.GetPublicId(),
+		HostSetId:   hs.GetPublicId(),
+		AuthTokenId: at.GetPublicId(),
+		ProjectId:   prj.GetPublicId(),
+		Endpoint:    "tcp://127.0.0.1:5432",
+		DynamicCredentials: []*session.DynamicCredential{
+			{LibraryId: req.SourceId, CredentialPurpose: string(req.Purpose)},
+		},
+	})
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sched)
+	require.NoError(err)
+
+	got, err := repo.Issue(ctx, sess.GetPublicId(), []credential.Request{req})
+	require.NoError(err)
+	require.Len(got, 1)
+
+	up, ok := got[0].(credential.UsernamePassword)
+	require.True(ok)
+	assert.Equal(sess.GetPublicId(), got[0].GetSessionId())
+	assert.Regexp(`^app_[a-z0-9]{20}$`, up.Username())
+	assert.True(pg.RoleExists(t, up.Username()))
+
+	// The role can log in and use the privileges of the grant statements.
+	roleUrl := (&TestPostgresServer{
+		Address:      pg.Address,
+		Port:         pg.Port,
+		DatabaseName: pg.DatabaseName,
+		Username:     up.Username(),
+		Password:     string(up.Password()),
+	}).url()
+	roleConn, err := pgx.Connect(ctx, roleUrl)
+	require.NoError(err)
+	_, err = roleConn.Exec(ctx, "insert into boundary_opened (name) values ('alice')")
+	require.NoError(err)
+	require.NoError(roleConn.Close(ctx))
+
+	require.NoError(repo.Revoke(ctx, sess.GetPublicId()))
+
+	job, err := newCredentialRevocationJob(ctx, rw, rw, kmsCache, sched)
+	require.NoError(err)
+	require.NoError(job.Run(ctx))
+	assert.Equal(1, job.Status().Completed)
+	assert.False(pg.RoleExists(t, up.Username()))
+
+	c := allocCredential()
+	c.PublicId = got[0].GetPublicId()
+	require.NoError(rw.LookupByPublicId(ctx, c))
+	assert.Equal(string(RevokedCredential), c.GetStatus())
+}
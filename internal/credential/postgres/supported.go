@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux || darwin || windows
+// +build linux darwin windows
+
+package postgres
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/jackc/pgx/v5"
+	"github.com/ory/dockertest/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var postgresRepository = "postgres"
+
+func init() {
+	newPostgresServer = gotNewServer
+
+	mirror := os.Getenv("DOCKER_MIRROR")
+	if mirror != "" {
+		postgresRepository = strings.Join([]string{mirror, postgresRepository}, "/")
+	}
+}
+
+func gotNewServer(t testing.TB) *TestPostgresServer {
+	require := require.New(t)
+	pool, err := dockertest.NewPool("")
+	require.NoError(err)
+
+	dockerOptions := &dockertest.RunOptions{
+		Repository: postgresRepository,
+		Tag:        globals.MinimumSupportedPostgresVersion,
+		Env:        []string{"POSTGRES_PASSWORD=password", "POSTGRES_DB=boundarytest"},
+	}
+	resource, err := pool.RunWithOptions(dockerOptions)
+	require.NoError(err)
+	t.Cleanup(func() {
+		cleanupResource(t, pool, resource)
+	})
+
+	port, err := strconv.ParseUint(resource.GetPort("5432/tcp"), 10, 32)
+	require.NoError(err)
+	server := &TestPostgresServer{
+		Address:      "localhost",
+		Port:         uint32(port),
+		DatabaseName: "boundarytest",
+		Username:     "postgres",
+		Password:     "password",
+	}
+
+	err = pool.Retry(func() error {
+		conn, err := pgx.Connect(context.Background(), server.url())
+		if err != nil {
+			return err
+		}
+		return conn.Close(context.Background())
+	})
+	require.NoError(err)
+	return server
+}
+
+func cleanupResource(t testing.TB, pool *dockertest.Pool, resource *dockertest.Resource) {
+	t.Helper()
+	var err error
+	for i := 0; i < 10; i++ {
+		err = pool.Purge(resource)
+		if err == nil {
+			return
+		}
+		time.Sleep(1 * time.Second)
+	}
+
+	if strings.Contains(err.Error(), "No such container") {
+		return
+	}
+	t.Fatalf("Failed to cleanup local container: %s", err)
+}
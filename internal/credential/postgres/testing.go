@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPostgresServer is a postgres server running in a docker container
+// for testing.
+type TestPostgresServer struct {
+	Address      string
+	Port         uint32
+	DatabaseName string
+	Username     string
+	Password     string
+}
+
+// NewTestPostgresServer starts a postgres server in a docker container.
+// The test is skipped if docker is not available. The container is
+// removed when the test completes.
+func NewTestPostgresServer(t testing.TB) *TestPostgresServer {
+	t.Helper()
+	return newPostgresServer(t)
+}
+
+func (s *TestPostgresServer) url() string {
+	cs := allocCredentialStore()
+	cs.Address = s.Address
+	cs.Port = s.Port
+	cs.DatabaseName = s.DatabaseName
+	cs.Username = s.Username
+	cs.Password = []byte(s.Password)
+	cs.SslMode = SslModeDisable
+	return cs.connectionUrl()
+}
+
+// RoleExists reports whether roleName is a role in the postgres server.
+func (s *TestPostgresServer) RoleExists(t testing.TB, roleName string) bool {
+	t.Helper()
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, s.url())
+	require.NoError(t, err)
+	defer conn.Close(ctx)
+	var exists bool
+	require.NoError(t, conn.QueryRow(ctx, "select exists(select 1 from pg_roles where rolname = $1)", roleName).Scan(&exists))
+	return exists
+}
+
+// Exec executes sql in the database of the postgres server as the
+// administrative role.
+func (s *TestPostgresServer) Exec(t testing.TB, sql string) {
+	t.Helper()
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, s.url())
+	require.NoError(t, err)
+	defer conn.Close(ctx)
+	_, err = conn.Exec(ctx, sql)
+	require.NoError(t, err, sql)
+}
+
+// TestCredentialStore creates a postgres credential store in the provided
+// DB with the provided project id for the postgres server at address. The
+// password is encrypted with kmsCache. If any errors are encountered during
+// the creation of the credential store, the test will fail.
+func TestCredentialStore(t testing.TB, conn *db.DB, kmsCache *kms.Kms, projectId, address, username string, password credential.Password, opt ...Option) *CredentialStore {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	cs, err := NewCredentialStore(projectId, address, username, password, opt...)
+	require.NoError(t, err)
+	if cs.Port == 0 {
+		cs.Port = DefaultPort
+	}
+	if cs.DatabaseName == "" {
+		cs.DatabaseName = DefaultDatabaseName
+	}
+	if cs.SslMode == "" {
+		cs.SslMode = DefaultSslMode
+	}
+	id, err := newCredentialStoreId(ctx)
+	require.NoError(t, err)
+	cs.PublicId = id
+
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	require.NoError(t, cs.encrypt(ctx, databaseWrapper))
+	require.NoError(t, w.Create(ctx, cs))
+	cs.CtPassword = nil
+	return cs
+}
+
+// TestCredentialLibraries creates count number of postgres credential
+// libraries in the provided DB with the provided store id and grant
+// statements. If any errors are encountered during the creation of the
+// credential libraries, the test will fail.
+func TestCredentialLibraries(t testing.TB, conn *db.DB, storeId, grantStatements string, count int, opt ...Option) []*CredentialLibrary {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	var libs []*CredentialLibrary
+	for i := 0; i < count; i++ {
+		lib, err := NewCredentialLibrary(storeId, grantStatements, opt...)
+		require.NoError(t, err)
+		id, err := newCredentialLibraryId(ctx)
+		require.NoError(t, err)
+		lib.PublicId = id
+
+		require.NoError(t, w.Create(ctx, lib))
+		libs = append(libs, lib)
+	}
+	return libs
+}
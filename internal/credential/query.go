@@ -7,7 +7,10 @@ const (
 	estimateCountStoresQuery = `
 select sum(reltuples::bigint) as estimate from pg_class where oid in (
 	'credential_vault_store'::regclass,
-	'credential_static_store'::regclass
+	'credential_static_store'::regclass,
+	'credential_plugin_store'::regclass,
+	'credential_ssh_ca_store'::regclass,
+	'credential_postgres_store'::regclass
 )
 `
 
@@ -19,6 +22,18 @@ select public_id
 select public_id
   from credential_static_store_deleted
  where delete_time >= @since
+ union
+select public_id
+  from credential_plugin_store_deleted
+ where delete_time >= @since
+ union
+select public_id
+  from credential_ssh_ca_store_deleted
+ where delete_time >= @since
+ union
+select public_id
+  from credential_postgres_store_deleted
+ where delete_time >= @since
 `
 
 	listStoresTemplate = `
@@ -55,6 +70,21 @@ static_stores as (
     from credential_static_store
    where public_id in (select public_id from stores)
 ),
+plugin_stores as (
+  select *
+    from credential_plugin_store
+   where public_id in (select public_id from stores)
+),
+ssh_ca_stores as (
+  select *
+    from credential_ssh_ca_store
+   where public_id in (select public_id from stores)
+),
+postgres_stores as (
+  select *
+    from credential_postgres_store
+   where public_id in (select public_id from stores)
+),
 final as (
      select store.public_id,
             store.project_id,
@@ -74,6 +104,18 @@ final as (
             coalesce(token.status, 'expired') as token_status,
             cert.certificate                  as client_cert,
             cert.certificate_key_hmac         as client_cert_key_hmac,
+            null::text                        as plugin_id,    -- Add to make union uniform
+            null::bytea                       as attributes,   -- Add to make union uniform
+            null::bytea                       as secrets_hmac, -- Add to make union uniform
+            null::text                        as key_type,     -- Add to make union uniform
+            null::int                         as key_bits,     -- Add to make union uniform
+            null::text                        as public_key,   -- Add to make union uniform
+            null::text                        as address,       -- Add to make union uniform
+            null::int                         as port,          -- Add to make union uniform
+            null::text                        as database_name, -- Add to make union uniform
+            null::text                        as username,      -- Add to make union uniform
+            null::text                        as ssl_mode,      -- Add to make union uniform
+            null::bytea                       as password_hmac, -- Add to make union uniform
             'vault' as subtype
        from vault_stores store
   left join vault_tokens token      on store.public_id = token.store_id
@@ -97,8 +139,119 @@ final as (
             null as token_status,         -- Add to make union uniform
             null as client_cert,          -- Add to make union uniform
             null as client_cert_key_hmac, -- Add to make union uniform
+            null as plugin_id,            -- Add to make union uniform
+            null as attributes,           -- Add to make union uniform
+            null as secrets_hmac,         -- Add to make union uniform
+            null as key_type,             -- Add to make union uniform
+            null as key_bits,             -- Add to make union uniform
+            null as public_key,           -- Add to make union uniform
+            null as address,              -- Add to make union uniform
+            null as port,                 -- Add to make union uniform
+            null as database_name,        -- Add to make union uniform
+            null as username,             -- Add to make union uniform
+            null as ssl_mode,             -- Add to make union uniform
+            null as password_hmac,        -- Add to make union uniform
             'static' as subtype
        from static_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            plugin_id,
+            attributes,
+            secrets_hmac,
+            null as key_type,             -- Add to make union uniform
+            null as key_bits,             -- Add to make union uniform
+            null as public_key,           -- Add to make union uniform
+            null as address,              -- Add to make union uniform
+            null as port,                 -- Add to make union uniform
+            null as database_name,        -- Add to make union uniform
+            null as username,             -- Add to make union uniform
+            null as ssl_mode,             -- Add to make union uniform
+            null as password_hmac,        -- Add to make union uniform
+            'plugin' as subtype
+       from plugin_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            null as plugin_id,            -- Add to make union uniform
+            null as attributes,           -- Add to make union uniform
+            null as secrets_hmac,         -- Add to make union uniform
+            key_type,
+            key_bits,
+            public_key,
+            null as address,              -- Add to make union uniform
+            null as port,                 -- Add to make union uniform
+            null as database_name,        -- Add to make union uniform
+            null as username,             -- Add to make union uniform
+            null as ssl_mode,             -- Add to make union uniform
+            null as password_hmac,        -- Add to make union uniform
+            'ssh-ca' as subtype
+       from ssh_ca_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            null as plugin_id,            -- Add to make union uniform
+            null as attributes,           -- Add to make union uniform
+            null as secrets_hmac,         -- Add to make union uniform
+            null as key_type,             -- Add to make union uniform
+            null as key_bits,             -- Add to make union uniform
+            null as public_key,           -- Add to make union uniform
+            address,
+            port,
+            database_name,
+            username,
+            ssl_mode,
+            password_hmac,
+            'postgres' as subtype
+       from postgres_stores
 )
   select *
     from final
@@ -140,6 +293,21 @@ static_stores as (
     from credential_static_store
    where public_id in (select public_id from stores)
 ),
+plugin_stores as (
+  select *
+    from credential_plugin_store
+   where public_id in (select public_id from stores)
+),
+ssh_ca_stores as (
+  select *
+    from credential_ssh_ca_store
+   where public_id in (select public_id from stores)
+),
+postgres_stores as (
+  select *
+    from credential_postgres_store
+   where public_id in (select public_id from stores)
+),
 final as (
      select store.public_id,
             store.project_id,
@@ -159,6 +327,18 @@ final as (
             coalesce(token.status, 'expired') as token_status,
             cert.certificate                  as client_cert,
             cert.certificate_key_hmac         as client_cert_key_hmac,
+            null::text                        as plugin_id,    -- Add to make union uniform
+            null::bytea                       as attributes,   -- Add to make union uniform
+            null::bytea                       as secrets_hmac, -- Add to make union uniform
+            null::text                        as key_type,     -- Add to make union uniform
+            null::int                         as key_bits,     -- Add to make union uniform
+            null::text                        as public_key,   -- Add to make union uniform
+            null::text                        as address,       -- Add to make union uniform
+            null::int                         as port,          -- Add to make union uniform
+            null::text                        as database_name, -- Add to make union uniform
+            null::text                        as username,      -- Add to make union uniform
+            null::text                        as ssl_mode,      -- Add to make union uniform
+            null::bytea                       as password_hmac, -- Add to make union uniform
             'vault' as subtype
        from vault_stores store
   left join vault_tokens token      on store.public_id = token.store_id
@@ -182,8 +362,119 @@ final as (
             null as token_status,         -- Add to make union uniform
             null as client_cert,          -- Add to make union uniform
             null as client_cert_key_hmac, -- Add to make union uniform
+            null as plugin_id,            -- Add to make union uniform
+            null as attributes,           -- Add to make union uniform
+            null as secrets_hmac,         -- Add to make union uniform
+            null as key_type,             -- Add to make union uniform
+            null as key_bits,             -- Add to make union uniform
+            null as public_key,           -- Add to make union uniform
+            null as address,              -- Add to make union uniform
+            null as port,                 -- Add to make union uniform
+            null as database_name,        -- Add to make union uniform
+            null as username,             -- Add to make union uniform
+            null as ssl_mode,             -- Add to make union uniform
+            null as password_hmac,        -- Add to make union uniform
             'static' as subtype
        from static_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            plugin_id,
+            attributes,
+            secrets_hmac,
+            null as key_type,             -- Add to make union uniform
+            null as key_bits,             -- Add to make union uniform
+            null as public_key,           -- Add to make union uniform
+            null as address,              -- Add to make union uniform
+            null as port,                 -- Add to make union uniform
+            null as database_name,        -- Add to make union uniform
+            null as username,             -- Add to make union uniform
+            null as ssl_mode,             -- Add to make union uniform
+            null as password_hmac,        -- Add to make union uniform
+            'plugin' as subtype
+       from plugin_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            null as plugin_id,            -- Add to make union uniform
+            null as attributes,           -- Add to make union uniform
+            null as secrets_hmac,         -- Add to make union uniform
+            key_type,
+            key_bits,
+            public_key,
+            null as address,              -- Add to make union uniform
+            null as port,                 -- Add to make union uniform
+            null as database_name,        -- Add to make union uniform
+            null as username,             -- Add to make union uniform
+            null as ssl_mode,             -- Add to make union uniform
+            null as password_hmac,        -- Add to make union uniform
+            'ssh-ca' as subtype
+       from ssh_ca_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            null as plugin_id,            -- Add to make union uniform
+            null as attributes,           -- Add to make union uniform
+            null as secrets_hmac,         -- Add to make union uniform
+            null as key_type,             -- Add to make union uniform
+            null as key_bits,             -- Add to make union uniform
+            null as public_key,           -- Add to make union uniform
+            address,
+            port,
+            database_name,
+            username,
+            ssl_mode,
+            password_hmac,
+            'postgres' as subtype
+       from postgres_stores
 )
   select *
     from final
@@ -225,6 +516,21 @@ static_stores as (
     from credential_static_store
    where public_id in (select public_id from stores)
 ),
+plugin_stores as (
+  select *
+    from credential_plugin_store
+   where public_id in (select public_id from stores)
+),
+ssh_ca_stores as (
+  select *
+    from credential_ssh_ca_store
+   where public_id in (select public_id from stores)
+),
+postgres_stores as (
+  select *
+    from credential_postgres_store
+   where public_id in (select public_id from stores)
+),
 final as (
      select store.public_id,
             store.project_id,
@@ -244,6 +550,18 @@ final as (
             coalesce(token.status, 'expired') as token_status,
             cert.certificate                  as client_cert,
             cert.certificate_key_hmac         as client_cert_key_hmac,
+            null::text                        as plugin_id,    -- Add to make union uniform
+            null::bytea                       as attributes,   -- Add to make union uniform
+            null::bytea                       as secrets_hmac, -- Add to make union uniform
+            null::text                        as key_type,     -- Add to make union uniform
+            null::int                         as key_bits,     -- Add to make union uniform
+            null::text                        as public_key,   -- Add to make union uniform
+            null::text                        as address,       -- Add to make union uniform
+            null::int                         as port,          -- Add to make union uniform
+            null::text                        as database_name, -- Add to make union uniform
+            null::text                        as username,      -- Add to make union uniform
+            null::text                        as ssl_mode,      -- Add to make union uniform
+            null::bytea                       as password_hmac, -- Add to make union uniform
             'vault' as subtype
        from vault_stores store
   left join vault_tokens token      on store.public_id = token.store_id
@@ -267,8 +585,119 @@ final as (
             null as token_status,         -- Add to make union uniform
             null as client_cert,          -- Add to make union uniform
             null as client_cert_key_hmac, -- Add to make union uniform
+            null as plugin_id,            -- Add to make union uniform
+            null as attributes,           -- Add to make union uniform
+            null as secrets_hmac,         -- Add to make union uniform
+            null as key_type,             -- Add to make union uniform
+            null as key_bits,             -- Add to make union uniform
+            null as public_key,           -- Add to make union uniform
+            null as address,              -- Add to make union uniform
+            null as port,                 -- Add to make union uniform
+            null as database_name,        -- Add to make union uniform
+            null as username,             -- Add to make union uniform
+            null as ssl_mode,             -- Add to make union uniform
+            null as password_hmac,        -- Add to make union uniform
             'static' as subtype
        from static_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            plugin_id,
+            attributes,
+            secrets_hmac,
+            null as key_type,             -- Add to make union uniform
+            null as key_bits,             -- Add to make union uniform
+            null as public_key,           -- Add to make union uniform
+            null as address,              -- Add to make union uniform
+            null as port,                 -- Add to make union uniform
+            null as database_name,        -- Add to make union uniform
+            null as username,             -- Add to make union uniform
+            null as ssl_mode,             -- Add to make union uniform
+            null as password_hmac,        -- Add to make union uniform
+            'plugin' as subtype
+       from plugin_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            null as plugin_id,            -- Add to make union uniform
+            null as attributes,           -- Add to make union uniform
+            null as secrets_hmac,         -- Add to make union uniform
+            key_type,
+            key_bits,
+            public_key,
+            null as address,              -- Add to make union uniform
+            null as port,                 -- Add to make union uniform
+            null as database_name,        -- Add to make union uniform
+            null as username,             -- Add to make union uniform
+            null as ssl_mode,             -- Add to make union uniform
+            null as password_hmac,        -- Add to make union uniform
+            'ssh-ca' as subtype
+       from ssh_ca_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            null as plugin_id,            -- Add to make union uniform
+            null as attributes,           -- Add to make union uniform
+            null as secrets_hmac,         -- Add to make union uniform
+            null as key_type,             -- Add to make union uniform
+            null as key_bits,             -- Add to make union uniform
+            null as public_key,           -- Add to make union uniform
+            address,
+            port,
+            database_name,
+            username,
+            ssl_mode,
+            password_hmac,
+            'postgres' as subtype
+       from postgres_stores
 )
   select *
     from final
@@ -311,6 +740,21 @@ static_stores as (
     from credential_static_store
    where public_id in (select public_id from stores)
 ),
+plugin_stores as (
+  select *
+    from credential_plugin_store
+   where public_id in (select public_id from stores)
+),
+ssh_ca_stores as (
+  select *
+    from credential_ssh_ca_store
+   where public_id in (select public_id from stores)
+),
+postgres_stores as (
+  select *
+    from credential_postgres_store
+   where public_id in (select public_id from stores)
+),
 final as (
      select store.public_id,
             store.project_id,
@@ -330,6 +774,18 @@ final as (
             coalesce(token.status, 'expired') as token_status,
             cert.certificate                  as client_cert,
             cert.certificate_key_hmac         as client_cert_key_hmac,
+            null::text                        as plugin_id,    -- Add to make union uniform
+            null::bytea                       as attributes,   -- Add to make union uniform
+            null::bytea                       as secrets_hmac, -- Add to make union uniform
+            null::text                        as key_type,     -- Add to make union uniform
+            null::int                         as key_bits,     -- Add to make union uniform
+            null::text                        as public_key,   -- Add to make union uniform
+            null::text                        as address,       -- Add to make union uniform
+            null::int                         as port,          -- Add to make union uniform
+            null::text                        as database_name, -- Add to make union uniform
+            null::text                        as username,      -- Add to make union uniform
+            null::text                        as ssl_mode,      -- Add to make union uniform
+            null::bytea                       as password_hmac, -- Add to make union uniform
             'vault' as subtype
        from vault_stores store
   left join vault_tokens token      on store.public_id = token.store_id
@@ -353,8 +809,119 @@ final as (
             null as token_status,         -- Add to make union uniform
             null as client_cert,          -- Add to make union uniform
             null as client_cert_key_hmac, -- Add to make union uniform
+            null as plugin_id,            -- Add to make union uniform
+            null as attributes,           -- Add to make union uniform
+            null as secrets_hmac,         -- Add to make union uniform
+            null as key_type,             -- Add to make union uniform
+            null as key_bits,             -- Add to make union uniform
+            null as public_key,           -- Add to make union uniform
+            null as address,              -- Add to make union uniform
+            null as port,                 -- Add to make union uniform
+            null as database_name,        -- Add to make union uniform
+            null as username,             -- Add to make union uniform
+            null as ssl_mode,             -- Add to make union uniform
+            null as password_hmac,        -- Add to make union uniform
             'static' as subtype
        from static_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            plugin_id,
+            attributes,
+            secrets_hmac,
+            null as key_type,             -- Add to make union uniform
+            null as key_bits,             -- Add to make union uniform
+            null as public_key,           -- Add to make union uniform
+            null as address,              -- Add to make union uniform
+            null as port,                 -- Add to make union uniform
+            null as database_name,        -- Add to make union uniform
+            null as username,             -- Add to make union uniform
+            null as ssl_mode,             -- Add to make union uniform
+            null as password_hmac,        -- Add to make union uniform
+            'plugin' as subtype
+       from plugin_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            null as plugin_id,            -- Add to make union uniform
+            null as attributes,           -- Add to make union uniform
+            null as secrets_hmac,         -- Add to make union uniform
+            key_type,
+            key_bits,
+            public_key,
+            null as address,              -- Add to make union uniform
+            null as port,                 -- Add to make union uniform
+            null as database_name,        -- Add to make union uniform
+            null as username,             -- Add to make union uniform
+            null as ssl_mode,             -- Add to make union uniform
+            null as password_hmac,        -- Add to make union uniform
+            'ssh-ca' as subtype
+       from ssh_ca_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            null as plugin_id,            -- Add to make union uniform
+            null as attributes,           -- Add to make union uniform
+            null as secrets_hmac,         -- Add to make union uniform
+            null as key_type,             -- Add to make union uniform
+            null as key_bits,             -- Add to make union uniform
+            null as public_key,           -- Add to make union uniform
+            address,
+            port,
+            database_name,
+            username,
+            ssl_mode,
+            password_hmac,
+            'postgres' as subtype
+       from postgres_stores
 )
   select *
     from final
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+import (
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/sshca/store"
+	"google.golang.org/protobuf/proto"
+)
+
+// A Credential is a record of an ssh certificate signed by the certificate
+// authority of a CredentialStore for a session. The private key and the
+// certificate are not persisted.
+type Credential struct {
+	*store.Credential
+	tableName string `gorm:"-"`
+}
+
+func allocCredential() *Credential {
+	return &Credential{
+		Credential: &store.Credential{},
+	}
+}
+
+func (c *Credential) clone() *Credential {
+	cp := proto.Clone(c.Credential)
+	return &Credential{
+		Credential: cp.(*store.Credential),
+	}
+}
+
+// TableName returns the table name.
+func (c *Credential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential_ssh_ca_credential"
+}
+
+// SetTableName sets the table name.
+func (c *Credential) SetTableName(n string) {
+	c.tableName = n
+}
+
+// issuingLibrary is a credential library along with the purpose the
+// credential is being issued for.
+type issuingLibrary struct {
+	*CredentialLibrary
+	purpose credential.Purpose
+}
+
+var _ credential.SshCertificate = (*sshCertCred)(nil)
+
+type sshCertCred struct {
+	*Credential
+
+	lib         *issuingLibrary
+	username    string
+	privateKey  credential.PrivateKey
+	certificate []byte
+	secretData  map[string]any
+}
+
+func (c *sshCertCred) Secret() credential.SecretData     { return c.secretData }
+func (c *sshCertCred) Library() credential.Library       { return c.lib }
+func (c *sshCertCred) Purpose() credential.Purpose       { return c.lib.purpose }
+func (c *sshCertCred) Username() string                  { return c.username }
+func (c *sshCertCred) PrivateKey() credential.PrivateKey { return c.privateKey }
+func (c *sshCertCred) PrivateKeyPassphrase() []byte      { return nil }
+func (c *sshCertCred) Certificate() []byte               { return c.certificate }
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package sshca implements a credential store that is an SSH certificate
+// authority. The private key of the certificate authority is generated when
+// the credential store is created and is encrypted with a database key of
+// the owning project. Credential libraries in the store issue a new key
+// pair and a user certificate signed by the certificate authority for each
+// session, without an external secret manager.
+//
+// The public key of the certificate authority is exposed by the credential
+// store so it can be added to the TrustedUserCAKeys of SSH servers.
+package sshca
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+// These constants are the field names used in the sshca related field masks.
+const (
+	nameField        = "Name"
+	descriptionField = "Description"
+
+	usernameField = "Username"
+	keyTypeField  = "KeyType"
+	keyBitsField  = "KeyBits"
+	ttlField      = "Ttl"
+	keyIdField    = "KeyId"
+	// CriticalOptionsField represents the field mask indicating a critical option
+	// update has been requested.
+	CriticalOptionsField = "CriticalOptions"
+	// ExtensionsField represents the field mask indicating an extension
+	// update has been requested.
+	ExtensionsField = "Extensions"
+	// AdditionalValidPrincipalsField represents the field mask indicating a valid
+	// principal update has been requested.
+	AdditionalValidPrincipalsField = "AdditionalValidPrincipals"
+)
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	KeyTypeEcdsa   = "ecdsa"
+	KeyTypeEd25519 = "ed25519"
+	KeyTypeRsa     = "rsa"
+
+	KeyBitsDefault = 0
+
+	KeyBitsEcdsa256 = 256
+	KeyBitsEcdsa384 = 384
+	KeyBitsEcdsa521 = 521
+
+	KeyBitsRsa2048 = 2048
+	KeyBitsRsa3072 = 3072
+	KeyBitsRsa4096 = 4096
+)
+
+// defaultKeyBits returns the default number of bits for keyType.
+func defaultKeyBits(keyType string) uint32 {
+	switch keyType {
+	case KeyTypeEcdsa:
+		return KeyBitsEcdsa256
+	case KeyTypeRsa:
+		return KeyBitsRsa2048
+	default:
+		return KeyBitsDefault
+	}
+}
+
+// generateKey generates a new private key of keyType and keyBits. It
+// returns the private key in the OpenSSH PEM format along with its signer.
+func generateKey(ctx context.Context, keyType string, keyBits uint32) ([]byte, ssh.Signer, error) {
+	const op = "sshca.generateKey"
+	var key crypto.Signer
+	var err error
+	switch keyType {
+	case KeyTypeEd25519:
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	case KeyTypeEcdsa:
+		var curve elliptic.Curve
+		switch keyBits {
+		case KeyBitsEcdsa256:
+			curve = elliptic.P256()
+		case KeyBitsEcdsa384:
+			curve = elliptic.P384()
+		case KeyBitsEcdsa521:
+			curve = elliptic.P521()
+		default:
+			return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "invalid KeyBits. when KeyType=ecdsa, KeyBits must be one of: 256, 384, or 521")
+		}
+		key, err = ecdsa.GenerateKey(curve, rand.Reader)
+	case KeyTypeRsa:
+		switch keyBits {
+		case KeyBitsRsa2048, KeyBitsRsa3072, KeyBitsRsa4096:
+		default:
+			return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "invalid KeyBits. when KeyType=rsa, KeyBits must be one of: 2048, 3072, or 4096")
+		}
+		key, err = rsa.GenerateKey(rand.Reader, int(keyBits))
+	default:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "invalid KeyType, must be one of: \"rsa\", \"ed25519\", or \"ecdsa\"")
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+
+	signer, err := ssh.NewSignerFromSigner(key)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	block, err := ssh.MarshalPrivateKey(key, "")
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
+	}
+	return pem.EncodeToMemory(block), signer, nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func Test_generateKey(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		keyType  string
+		keyBits  uint32
+		wantAlgo string
+		wantErr  errors.Code
+	}{
+		{name: "ed25519", keyType: KeyTypeEd25519, wantAlgo: ssh.KeyAlgoED25519},
+		{name: "ecdsa-256", keyType: KeyTypeEcdsa, keyBits: KeyBitsEcdsa256, wantAlgo: ssh.KeyAlgoECDSA256},
+		{name: "ecdsa-384", keyType: KeyTypeEcdsa, keyBits: KeyBitsEcdsa384, wantAlgo: ssh.KeyAlgoECDSA384},
+		{name: "ecdsa-521", keyType: KeyTypeEcdsa, keyBits: KeyBitsEcdsa521, wantAlgo: ssh.KeyAlgoECDSA521},
+		{name: "rsa-2048", keyType: KeyTypeRsa, keyBits: KeyBitsRsa2048, wantAlgo: ssh.KeyAlgoRSA},
+		{name: "ecdsa-invalid-bits", keyType: KeyTypeEcdsa, keyBits: 1024, wantErr: errors.InvalidParameter},
+		{name: "rsa-invalid-bits", keyType: KeyTypeRsa, keyBits: 1024, wantErr: errors.InvalidParameter},
+		{name: "invalid-type", keyType: "dsa", wantErr: errors.InvalidParameter},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			pk, signer, err := generateKey(ctx, tt.keyType, tt.keyBits)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(pk)
+				assert.Nil(signer)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantAlgo, signer.PublicKey().Type())
+
+			parsed, err := ssh.ParsePrivateKey(pk)
+			require.NoError(err)
+			assert.Equal(signer.PublicKey().Marshal(), parsed.PublicKey().Marshal())
+		})
+	}
+}
+
+func Test_newSerial(t *testing.T) {
+	t.Parallel()
+	seen := make(map[uint64]bool)
+	for i := 0; i < 100; i++ {
+		s, err := newSerial()
+		require.NoError(t, err)
+		assert.NotZero(t, s)
+		assert.Less(t, s, uint64(1)<<63)
+		assert.False(t, seen[s])
+		seen[s] = true
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName                      string
+	withDescription               string
+	withKeyType                   string
+	withKeyBits                   uint32
+	withTtl                       string
+	withKeyId                     string
+	withCriticalOptions           string
+	withExtensions                string
+	withAdditionalValidPrincipals []string
+	withLimit                     int
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithKeyType provides an optional key type. Valid values are "ed25519",
+// "ecdsa", and "rsa". Defaults to "ed25519".
+func WithKeyType(t string) Option {
+	return func(o *options) {
+		o.withKeyType = t
+	}
+}
+
+// WithKeyBits provides an optional key bits value. It is ignored if the
+// key type is "ed25519".
+func WithKeyBits(b uint32) Option {
+	return func(o *options) {
+		o.withKeyBits = b
+	}
+}
+
+// WithTtl provides an optional duration an issued certificate is valid
+// for.
+func WithTtl(t string) Option {
+	return func(o *options) {
+		o.withTtl = t
+	}
+}
+
+// WithKeyId provides an optional key id to embed in issued certificates.
+func WithKeyId(id string) Option {
+	return func(o *options) {
+		o.withKeyId = id
+	}
+}
+
+// WithCriticalOptions provides an optional JSON encoded map of the critical
+// options set on issued certificates.
+func WithCriticalOptions(s string) Option {
+	return func(o *options) {
+		o.withCriticalOptions = s
+	}
+}
+
+// WithExtensions provides an optional JSON encoded map of the extensions
+// set on issued certificates.
+func WithExtensions(s string) Option {
+	return func(o *options) {
+		o.withExtensions = s
+	}
+}
+
+// WithAdditionalValidPrincipals provides optional principals added to the
+// username in the valid principals of issued certificates.
+func WithAdditionalValidPrincipals(p []string) Option {
+	return func(o *options) {
+		o.withAdditionalValidPrincipals = p
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are
+// returned. If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
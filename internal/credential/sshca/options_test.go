@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithName", func(t *testing.T) {
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithKeyType", func(t *testing.T) {
+		opts := getOpts(WithKeyType(KeyTypeRsa))
+		testOpts := getDefaultOptions()
+		testOpts.withKeyType = KeyTypeRsa
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithKeyBits", func(t *testing.T) {
+		opts := getOpts(WithKeyBits(KeyBitsRsa4096))
+		testOpts := getDefaultOptions()
+		testOpts.withKeyBits = KeyBitsRsa4096
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithTtl", func(t *testing.T) {
+		opts := getOpts(WithTtl("1h"))
+		testOpts := getDefaultOptions()
+		testOpts.withTtl = "1h"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithKeyId", func(t *testing.T) {
+		opts := getOpts(WithKeyId("{{.User.Name}}"))
+		testOpts := getDefaultOptions()
+		testOpts.withKeyId = "{{.User.Name}}"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithCriticalOptions", func(t *testing.T) {
+		opts := getOpts(WithCriticalOptions(`{"force-command":"/bin/true"}`))
+		testOpts := getDefaultOptions()
+		testOpts.withCriticalOptions = `{"force-command":"/bin/true"}`
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithExtensions", func(t *testing.T) {
+		opts := getOpts(WithExtensions(`{"permit-pty":""}`))
+		testOpts := getDefaultOptions()
+		testOpts.withExtensions = `{"permit-pty":""}`
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithAdditionalValidPrincipals", func(t *testing.T) {
+		opts := getOpts(WithAdditionalValidPrincipals([]string{"root", "admin"}))
+		testOpts := getDefaultOptions()
+		testOpts.withAdditionalValidPrincipals = []string{"root", "admin"}
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		opts := getOpts(WithLimit(5))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(t, opts, testOpts)
+	})
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+func init() {
+	globals.RegisterPrefixToResourceInfo(globals.SshCaCredentialStorePrefix, resource.CredentialStore, credential.Domain, Subtype)
+	globals.RegisterPrefixToResourceInfo(globals.SshCaCredentialLibraryPrefix, resource.CredentialLibrary, credential.Domain, Subtype)
+	globals.RegisterPrefixToResourceInfo(globals.SshCaDynamicCredentialPrefix, resource.Credential, credential.Domain, Subtype)
+}
+
+// PublicId prefixes for the resources in the sshca package.
+const (
+	Subtype = globals.Subtype("ssh-ca")
+)
+
+func newCredentialStoreId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(ctx, globals.SshCaCredentialStorePrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "sshca.newCredentialStoreId")
+	}
+	return id, nil
+}
+
+func newCredentialLibraryId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(ctx, globals.SshCaCredentialLibraryPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "sshca.newCredentialLibraryId")
+	}
+	return id, nil
+}
+
+func newCredentialId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(ctx, globals.SshCaDynamicCredentialPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "sshca.newCredentialId")
+	}
+	return id, nil
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+const (
+	updateSessionCredentialQuery = `
+update session_credential_dynamic
+   set credential_id = @public_id
+ where library_id = @library_id
+   and session_id = @session_id
+   and credential_purpose = @purpose
+   and credential_id is null
+returning *;
+`
+
+	estimateCountCredentialLibraries = `
+select reltuples::bigint as estimate
+  from pg_class
+ where oid = 'credential_ssh_ca_library'::regclass
+`
+
+	listLibrariesTemplate = `
+  select *
+    from credential_ssh_ca_library
+   where store_id = @store_id
+order by create_time desc, public_id desc
+   limit %d;
+`
+
+	listLibrariesPageTemplate = `
+  select *
+    from credential_ssh_ca_library
+   where store_id = @store_id
+     and (create_time, public_id) < (@last_item_create_time, @last_item_id)
+order by create_time desc, public_id desc
+   limit %d;
+`
+
+	listLibrariesRefreshTemplate = `
+  select *
+    from credential_ssh_ca_library
+   where store_id = @store_id
+     and update_time > @updated_after_time
+order by update_time desc, public_id desc
+   limit %d;
+`
+
+	listLibrariesRefreshPageTemplate = `
+  select *
+    from credential_ssh_ca_library
+   where store_id = @store_id
+     and update_time > @updated_after_time
+     and (update_time, public_id) < (@last_item_update_time, @last_item_id)
+order by update_time desc, public_id desc
+   limit %d;
+`
+)
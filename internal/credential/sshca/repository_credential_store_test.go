@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestRepository_CredentialStoreLifecycle(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	in, err := NewCredentialStore(prj.GetPublicId(), WithName("store"), WithKeyType(KeyTypeEcdsa))
+	require.NoError(t, err)
+
+	got, err := repo.CreateCredentialStore(ctx, in)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "store", got.GetName())
+	assert.Equal(t, KeyTypeEcdsa, got.GetKeyType())
+	assert.Equal(t, uint32(KeyBitsEcdsa256), got.GetKeyBits())
+	assert.Empty(t, got.GetPrivateKey())
+	assert.Empty(t, got.GetCtPrivateKey())
+	assert.NotEmpty(t, got.GetKeyId())
+
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(got.GetPublicKey()))
+	require.NoError(t, err)
+	assert.Equal(t, ssh.KeyAlgoECDSA256, pub.Type())
+
+	found, err := repo.LookupCredentialStore(ctx, got.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, got.GetPublicKey(), found.GetPublicKey())
+	assert.Empty(t, found.GetCtPrivateKey())
+
+	signer, err := repo.getSigner(ctx, got.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, pub.Marshal(), signer.PublicKey().Marshal())
+
+	upd := got.clone()
+	upd.Name = "updated"
+	updated, n, err := repo.UpdateCredentialStore(ctx, upd, got.GetVersion(), []string{"Name"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "updated", updated.GetName())
+	assert.Equal(t, got.GetPublicKey(), updated.GetPublicKey())
+
+	_, _, err = repo.UpdateCredentialStore(ctx, upd, updated.GetVersion(), []string{"KeyType"})
+	require.Error(t, err)
+
+	n, err = repo.DeleteCredentialStore(ctx, got.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	found, err = repo.LookupCredentialStore(ctx, got.GetPublicId())
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/util/template"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// DefaultTtl is the duration an issued certificate is valid for if the
+	// credential library does not set a ttl.
+	DefaultTtl = 8 * time.Hour
+
+	// clockSkew is subtracted from the start of the validity period of
+	// issued certificates to allow for clock differences between the
+	// controller and the SSH server.
+	clockSkew = 30 * time.Second
+)
+
+var _ credential.Issuer = (*Repository)(nil)
+
+// Issue generates a new key pair and signs a user certificate for it with
+// the certificate authority of the credential store for each library in
+// requests, and assigns them to sessionId. The private keys and
+// certificates are not persisted.
+//
+// Supported Options: credential.WithTemplateData
+func (r *Repository) Issue(ctx context.Context, sessionId string, requests []credential.Request, opt ...credential.Option) ([]credential.Dynamic, error) {
+	const op = "sshca.(Repository).Issue"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+	if len(requests) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no requests")
+	}
+	opts, err := credential.GetOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var creds []credential.Dynamic
+	for _, req := range requests {
+		cred, err := r.issue(ctx, sessionId, req, opts.WithTemplateData)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		creds = append(creds, cred)
+	}
+	return creds, nil
+}
+
+// issue signs a single certificate for req and records it.
+func (r *Repository) issue(ctx context.Context, sessionId string, req credential.Request, templateData template.Data) (credential.Dynamic, error) {
+	const op = "sshca.(Repository).issue"
+	lib, err := r.LookupCredentialLibrary(ctx, req.SourceId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if lib == nil {
+		return nil, errors.New(ctx, errors.NotSpecificIntegrity, op, fmt.Sprintf("credential library %s not found", req.SourceId))
+	}
+
+	username, err := generateTemplate(ctx, lib.Username, templateData)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	keyId, err := generateTemplate(ctx, lib.KeyId, templateData)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	principals := []string{username}
+	if lib.AdditionalValidPrincipals != "" {
+		principals = append(principals, strings.Split(lib.AdditionalValidPrincipals, ",")...)
+	}
+
+	var criticalOptions, extensions map[string]string
+	if lib.CriticalOptions != "" {
+		if err := json.Unmarshal([]byte(lib.CriticalOptions), &criticalOptions); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode), errors.WithMsg("unable to decode critical options"))
+		}
+	}
+	if lib.Extensions != "" {
+		if err := json.Unmarshal([]byte(lib.Extensions), &extensions); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode), errors.WithMsg("unable to decode extensions"))
+		}
+	}
+
+	ttl := DefaultTtl
+	if lib.Ttl != "" {
+		if ttl, err = time.ParseDuration(lib.Ttl); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("unable to parse ttl"))
+		}
+	}
+
+	serial, err := newSerial()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	caSigner, err := r.getSigner(ctx, lib.StoreId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	privateKey, userSigner, err := generateKey(ctx, lib.KeyType, lib.KeyBits)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	now := time.Now()
+	expiration := now.Add(ttl)
+	cert := &ssh.Certificate{
+		Key:             userSigner.PublicKey(),
+		Serial:          serial,
+		CertType:        ssh.UserCert,
+		KeyId:           keyId,
+		ValidPrincipals: principals,
+		ValidAfter:      uint64(now.Add(-clockSkew).Unix()),
+		ValidBefore:     uint64(expiration.Unix()),
+		Permissions: ssh.Permissions{
+			CriticalOptions: criticalOptions,
+			Extensions:      extensions,
+		},
+	}
+	if err := cert.SignCert(rand.Reader, caSigner); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to sign certificate"))
+	}
+	signedKey := ssh.MarshalAuthorizedKey(cert)
+
+	c := allocCredential()
+	if c.PublicId, err = newCredentialId(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	c.LibraryId = lib.PublicId
+	c.SessionId = sessionId
+	c.StoreId = lib.StoreId
+	c.Serial = serial
+	c.CertificateKeyId = keyId
+	c.ValidPrincipals = strings.Join(principals, ",")
+	c.ExpirationTime = timestamp.New(expiration)
+
+	updateQueryValues := []any{
+		sql.Named("public_id", c.PublicId),
+		sql.Named("library_id", c.LibraryId),
+		sql.Named("session_id", c.SessionId),
+		sql.Named("purpose", string(req.Purpose)),
+	}
+	if _, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if err := w.Create(ctx, c.clone()); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			rowsUpdated, err := w.Exec(ctx, updateSessionCredentialQuery, updateQueryValues)
+			switch {
+			case err != nil:
+				return errors.Wrap(ctx, err, op)
+			case rowsUpdated == 0:
+				return errors.New(ctx, errors.InvalidDynamicCredential, op, "no matching dynamic credential for session found")
+			case rowsUpdated > 1:
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 session credential would have been updated")
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	return &sshCertCred{
+		Credential:  c,
+		lib:         &issuingLibrary{CredentialLibrary: lib, purpose: req.Purpose},
+		username:    username,
+		privateKey:  credential.PrivateKey(privateKey),
+		certificate: signedKey,
+		secretData: map[string]any{
+			"serial_number": fmt.Sprintf("%016x", serial),
+			"signed_key":    string(signedKey),
+			"private_key":   string(privateKey),
+		},
+	}, nil
+}
+
+// generateTemplate returns s with any template data applied. An empty s is
+// returned as is.
+func generateTemplate(ctx context.Context, s string, data template.Data) (string, error) {
+	const op = "sshca.generateTemplate"
+	if s == "" {
+		return "", nil
+	}
+	tplate, err := template.New(ctx, s)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	out, err := tplate.Generate(ctx, data)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return out, nil
+}
+
+// newSerial returns a random, positive serial number that fits in a
+// signed 64 bit integer.
+func newSerial() (uint64, error) {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return 0, err
+	}
+	serial := binary.BigEndian.Uint64(b[:]) >> 1
+	if serial == 0 {
+		serial = 1
+	}
+	return serial, nil
+}
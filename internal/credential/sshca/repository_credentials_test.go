@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/hashicorp/boundary/internal/util/template"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestRepository_Issue(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := sshca.TestCredentialStore(t, conn, kms, prj.GetPublicId())
+	lib := sshca.TestCredentialLibraries(t, conn, cs.GetPublicId(), "{{.Account.Name}}", 1,
+		sshca.WithKeyType(sshca.KeyTypeRsa),
+		sshca.WithTtl("1h"),
+		sshca.WithKeyId("boundary-{{.User.Name}}"),
+		sshca.WithExtensions(`{"permit-pty":""}`),
+		sshca.WithAdditionalValidPrincipals([]string{"ops"}),
+	)[0]
+
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+	hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+
+	req := credential.Request{SourceId: lib.GetPublicId(), Purpose: credential.BrokeredPurpose}
+	sess := session.TestSession(t, conn, wrapper, session.ComposedOf{
+		UserId:      at.GetIamUserId(),
+		HostId:      h.GetPublicId(),
+		TargetId:    tar.GetPublicId(),
+		HostSetId:   hs.GetPublicId(),
+		AuthTokenId: at.GetPublicId(),
+		ProjectId:   prj.GetPublicId(),
+		Endpoint:    "tcp://127.0.0.1:22",
+		DynamicCredentials: []*session.DynamicCredential{
+			{LibraryId: req.SourceId, CredentialPurpose: string(req.Purpose)},
+		},
+	})
+
+	repo, err := sshca.NewRepository(ctx, rw, rw, kms)
+	require.NoError(err)
+
+	_, err = repo.Issue(ctx, "", []credential.Request{req})
+	require.Error(err)
+
+	accountName, userName := "alice", "Alice"
+	got, err := repo.Issue(ctx, sess.GetPublicId(), []credential.Request{req},
+		credential.WithTemplateData(template.Data{
+			User:    template.User{Name: &userName},
+			Account: template.Account{Name: &accountName},
+		}))
+	require.NoError(err)
+	require.Len(got, 1)
+
+	sc, ok := got[0].(credential.SshCertificate)
+	require.True(ok)
+	assert.Equal(sess.GetPublicId(), got[0].GetSessionId())
+	assert.Equal("alice", sc.Username())
+
+	_, err = ssh.ParsePrivateKey([]byte(sc.PrivateKey()))
+	require.NoError(err)
+
+	pub, _, _, _, err := ssh.ParseAuthorizedKey(sc.Certificate())
+	require.NoError(err)
+	cert, ok := pub.(*ssh.Certificate)
+	require.True(ok)
+	assert.Equal(uint32(ssh.UserCert), cert.CertType)
+	assert.Equal("boundary-Alice", cert.KeyId)
+	assert.Equal([]string{"alice", "ops"}, cert.ValidPrincipals)
+	assert.Contains(cert.Permissions.Extensions, "permit-pty")
+	assert.InDelta(time.Now().Add(time.Hour).Unix(), int64(cert.ValidBefore), 60)
+
+	caPub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(cs.GetPublicKey()))
+	require.NoError(err)
+	checker := &ssh.CertChecker{
+		IsUserAuthority: func(auth ssh.PublicKey) bool {
+			return string(auth.Marshal()) == string(caPub.Marshal())
+		},
+	}
+	assert.NoError(checker.CheckCert("alice", cert))
+}
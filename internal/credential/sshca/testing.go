@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCredentialStore creates an ssh certificate authority credential store
+// in the provided DB with the provided project id. The key pair of the
+// certificate authority is generated and encrypted with kmsCache. If any
+// errors are encountered during the creation of the credential store, the
+// test will fail.
+func TestCredentialStore(t testing.TB, conn *db.DB, kmsCache *kms.Kms, projectId string, opt ...Option) *CredentialStore {
+	t.Helper()
+	ctx := context.Background()
+	rw := db.New(conn)
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	cs, err := NewCredentialStore(projectId, opt...)
+	require.NoError(t, err)
+	cs, err = repo.CreateCredentialStore(ctx, cs)
+	require.NoError(t, err)
+	return cs
+}
+
+// TestCredentialLibraries creates count number of ssh certificate
+// authority credential libraries in the provided DB with the provided
+// store id and username. If any errors are encountered during the creation
+// of the credential libraries, the test will fail.
+func TestCredentialLibraries(t testing.TB, conn *db.DB, storeId, username string, count int, opt ...Option) []*CredentialLibrary {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	var libs []*CredentialLibrary
+	for i := 0; i < count; i++ {
+		lib, err := NewCredentialLibrary(storeId, username, opt...)
+		require.NoError(t, err)
+		if lib.KeyType == "" {
+			lib.KeyType = KeyTypeEd25519
+		}
+		if lib.KeyBits == KeyBitsDefault {
+			lib.KeyBits = defaultKeyBits(lib.KeyType)
+		}
+		id, err := newCredentialLibraryId(ctx)
+		require.NoError(t, err)
+		lib.PublicId = id
+
+		require.NoError(t, w.Create(ctx, lib))
+		libs = append(libs, lib)
+	}
+	return libs
+}
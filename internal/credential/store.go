@@ -52,6 +52,30 @@ type StoreListQueryResult struct {
 	ClientCert []byte
 	// Optional client cert key HMAC of the credential store.
 	ClientCertKeyHmac []byte
+	// Optional plugin id of the credential store.
+	PluginId string
+	// Optional attributes of the credential store.
+	Attributes []byte
+	// Optional secrets HMAC of the credential store.
+	SecretsHmac []byte
+	// Optional key type of the credential store.
+	KeyType string
+	// Optional key bits of the credential store.
+	KeyBits uint32
+	// Optional public key of the credential store.
+	PublicKey string
+	// Optional database server address of the credential store.
+	Address string
+	// Optional database server port of the credential store.
+	Port uint32
+	// Optional database name of the credential store.
+	DatabaseName string
+	// Optional username of the credential store.
+	Username string
+	// Optional ssl mode of the credential store.
+	SslMode string
+	// Optional password HMAC of the credential store.
+	PasswordHmac []byte
 	// The subtype of the credential store.
 	Subtype string
 }
@@ -132,3 +132,12 @@ func (c *Credential) updateStatusQuery(status CredentialStatus) (query string, q
 	query = updateCredentialStatusQuery
 	return
 }
+
+func (c *Credential) updateRenewalErrorQuery(renewalErr string) (query string, queryValues []any) {
+	queryValues = []any{
+		renewalErr,
+		c.PublicId,
+	}
+	query = updateCredentialRenewalErrorQuery
+	return
+}
@@ -550,7 +550,13 @@ func (r *CredentialRenewalJob) Run(ctx context.Context) error {
 			return errors.Wrap(ctx, err, op)
 		}
 		if err := r.renewCred(ctx, c); err != nil {
-			event.WriteError(ctx, op, err, event.WithInfoMsg("error renewing credential", "credential id", c.PublicId))
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error renewing credential",
+				"credential id", c.PublicId,
+				"library id", c.LibraryId,
+				"session id", c.SessionId,
+				"lease id", c.ExternalId,
+				"expiration time", c.ExpirationTime.AsTime(),
+			))
 		}
 
 		r.numProcessed++
@@ -561,17 +567,30 @@ func (r *CredentialRenewalJob) Run(ctx context.Context) error {
 
 func (r *CredentialRenewalJob) renewCred(ctx context.Context, c *privateCredential) error {
 	const op = "vault.(CredentialRenewalJob).renewCred"
-	databaseWrapper, err := r.kms.GetWrapper(ctx, c.ProjectId, kms.KeyPurposeDatabase)
+	if _, err := renewCredential(ctx, r.writer, r.kms, c); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// renewCredential renews the Vault lease of c and updates the expiration
+// time of the credential in the repo. If Vault reports the lease as expired
+// the credential's status is set to expired. If the renewal fails for any
+// other reason, the error is recorded as the credential's last renewal error
+// and returned.
+func renewCredential(ctx context.Context, w db.Writer, k *kms.Kms, c *privateCredential) (CredentialStatus, error) {
+	const op = "vault.renewCredential"
+	databaseWrapper, err := k.GetWrapper(ctx, c.ProjectId, kms.KeyPurposeDatabase)
 	if err != nil {
-		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
 	}
 	if err = c.decrypt(ctx, databaseWrapper); err != nil {
-		return errors.Wrap(ctx, err, op)
+		return "", errors.Wrap(ctx, err, op)
 	}
 
 	vc, err := c.client(ctx)
 	if err != nil {
-		return errors.Wrap(ctx, err, op)
+		return "", errors.Wrap(ctx, err, op)
 	}
 	cred := c.toCredential()
 
@@ -583,30 +602,35 @@ func (r *CredentialRenewalJob) renewCred(ctx context.Context, c *privateCredenti
 		// Vault returned a 400 when attempting a renew lease, the lease is either expired
 		// or the leaseId is malformed.  Set status to "expired".
 		query, values := cred.updateStatusQuery(ExpiredCredential)
-		numRows, err := r.writer.Exec(ctx, query, values)
+		numRows, err := w.Exec(ctx, query, values)
 		if err != nil {
-			return errors.Wrap(ctx, err, op)
+			return "", errors.Wrap(ctx, err, op)
 		}
 		if numRows != 1 {
-			return errors.New(ctx, errors.Unknown, op, "credential expired but failed to update repo")
+			return "", errors.New(ctx, errors.Unknown, op, "credential expired but failed to update repo")
 		}
-		return nil
+		return ExpiredCredential, nil
 	}
 	if err != nil {
-		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to renew credential"))
+		err = errors.Wrap(ctx, err, op, errors.WithMsg("unable to renew credential"))
+		query, values := cred.updateRenewalErrorQuery(err.Error())
+		if _, dbErr := w.Exec(ctx, query, values); dbErr != nil {
+			event.WriteError(ctx, op, dbErr, event.WithInfoMsg("unable to record credential renewal error", "credential id", c.PublicId))
+		}
+		return "", err
 	}
 
 	cred.expiration = time.Duration(renewedCred.LeaseDuration) * time.Second
 	query, values := cred.updateExpirationQuery()
-	numRows, err := r.writer.Exec(ctx, query, values)
+	numRows, err := w.Exec(ctx, query, values)
 	if err != nil {
-		return errors.Wrap(ctx, err, op)
+		return "", errors.Wrap(ctx, err, op)
 	}
 	if numRows != 1 {
-		return errors.New(ctx, errors.Unknown, op, "credential renewed but failed to update repo")
+		return "", errors.New(ctx, errors.Unknown, op, "credential renewed but failed to update repo")
 	}
 
-	return nil
+	return ActiveCredential, nil
 }
 
 // NextRunIn queries the vault credential repo to determine when the next credential renewal job should run.
@@ -719,8 +743,18 @@ func (r *CredentialRevocationJob) Run(ctx context.Context) error {
 }
 
 func (r *CredentialRevocationJob) revokeCred(ctx context.Context, c *privateCredential) error {
-	const op = "vault.(CredentialRenewalJob).revokeCred"
-	databaseWrapper, err := r.kms.GetWrapper(ctx, c.ProjectId, kms.KeyPurposeDatabase)
+	const op = "vault.(CredentialRevocationJob).revokeCred"
+	if err := revokeCredential(ctx, r.writer, r.kms, c); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// revokeCredential revokes the Vault lease of c and sets the status of the
+// credential in the repo to revoked.
+func revokeCredential(ctx context.Context, w db.Writer, k *kms.Kms, c *privateCredential) error {
+	const op = "vault.revokeCredential"
+	databaseWrapper, err := k.GetWrapper(ctx, c.ProjectId, kms.KeyPurposeDatabase)
 	if err != nil {
 		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
 	}
@@ -746,7 +780,7 @@ func (r *CredentialRevocationJob) revokeCred(ctx context.Context, c *privateCred
 	}
 
 	query, values := cred.updateStatusQuery(RevokedCredential)
-	numRows, err := r.writer.Exec(ctx, query, values)
+	numRows, err := w.Exec(ctx, query, values)
 	if err != nil {
 		return errors.Wrap(ctx, err, op)
 	}
@@ -45,6 +45,8 @@ type options struct {
 	withCriticalOptions           string
 	withExtensions                string
 	withAdditionalValidPrincipals []string
+
+	withSessionId string
 }
 
 func getDefaultOptions() options {
@@ -238,3 +240,10 @@ func WithAdditionalValidPrincipals(p []string) Option {
 		o.withAdditionalValidPrincipals = p
 	}
 }
+
+// WithSessionId provides an optional session id.
+func WithSessionId(id string) Option {
+	return func(o *options) {
+		o.withSessionId = id
+	}
+}
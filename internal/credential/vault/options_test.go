@@ -131,4 +131,10 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withMappingOverride = unknownMapper(1)
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithSessionId", func(t *testing.T) {
+		opts := getOpts(WithSessionId("s_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withSessionId = "s_1234567890"
+		assert.Equal(t, opts, testOpts)
+	})
 }
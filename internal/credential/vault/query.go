@@ -155,11 +155,26 @@ select
 
 	updateCredentialExpirationQuery = `
 update credential_vault_credential
-   set last_renewal_time = now(),
-       expiration_time   = wt_add_seconds_to_now(?)
+   set last_renewal_time  = now(),
+       expiration_time    = wt_add_seconds_to_now(?),
+       last_renewal_error = null
  where public_id = ?;
 `
 
+	updateCredentialRenewalErrorQuery = `
+update credential_vault_credential
+   set last_renewal_error = ?
+ where public_id = ?;
+`
+
+	storeCredentialsWhereClause = `
+token_hmac in (
+  select token_hmac
+    from credential_vault_token
+   where store_id = ?
+)
+`
+
 	updateCredentialStatusQuery = `
 update credential_vault_credential
    set status = ?
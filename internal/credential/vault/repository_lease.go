@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// ListLeases returns the Vault leases of the credentials issued by the
+// credential store with storeId. WithSessionId can be used to only return
+// the leases issued for a session. WithLimit can be used to limit the number
+// of leases returned.
+func (r *Repository) ListLeases(ctx context.Context, storeId string, opt ...Option) ([]*Credential, error) {
+	const op = "vault.(Repository).ListLeases"
+	if storeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no store id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+
+	// Credentials without an expiration time do not have a lease.
+	where, args := "expiration_time != 'infinity' and "+storeCredentialsWhereClause, []any{storeId}
+	if opts.withSessionId != "" {
+		where += " and session_id = ?"
+		args = append(args, opts.withSessionId)
+	}
+
+	var creds []*Credential
+	if err := r.reader.SearchWhere(ctx, &creds, where, args, db.WithLimit(limit), db.WithOrder("create_time desc")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return creds, nil
+}
+
+// RenewLease renews the Vault lease of the credential with credentialId
+// issued by the credential store with storeId and returns the updated
+// credential. Only active credentials with a renewable lease can be renewed.
+// If Vault reports the lease as expired, the credential's status is set to
+// expired and the credential is returned without an error.
+func (r *Repository) RenewLease(ctx context.Context, storeId, credentialId string, _ ...Option) (*Credential, error) {
+	const op = "vault.(Repository).RenewLease"
+	pc, err := r.lookupLease(ctx, storeId, credentialId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case pc.Status != string(ActiveCredential):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("cannot renew credential with status %q", pc.Status))
+	case !pc.IsRenewable:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "credential lease is not renewable")
+	}
+	if _, err := renewCredential(ctx, r.writer, r.kms, pc); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return r.lookupCredential(ctx, credentialId)
+}
+
+// RevokeLease revokes the Vault lease of the credential with credentialId
+// issued by the credential store with storeId and returns the updated
+// credential. Credentials that have already been revoked or have expired
+// cannot be revoked.
+func (r *Repository) RevokeLease(ctx context.Context, storeId, credentialId string, _ ...Option) (*Credential, error) {
+	const op = "vault.(Repository).RevokeLease"
+	pc, err := r.lookupLease(ctx, storeId, credentialId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	switch CredentialStatus(pc.Status) {
+	case ActiveCredential, RevokeCredential:
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("cannot revoke credential with status %q", pc.Status))
+	}
+	if err := revokeCredential(ctx, r.writer, r.kms, pc); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return r.lookupCredential(ctx, credentialId)
+}
+
+// lookupLease returns the private credential with credentialId if it was
+// issued by the credential store with storeId and has a Vault lease.
+func (r *Repository) lookupLease(ctx context.Context, storeId, credentialId string) (*privateCredential, error) {
+	const op = "vault.(Repository).lookupLease"
+	switch {
+	case storeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no store id")
+	case credentialId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no credential id")
+	}
+	var creds []*privateCredential
+	if err := r.reader.SearchWhere(ctx, &creds, "public_id = ? and "+storeCredentialsWhereClause, []any{credentialId, storeId}, db.WithLimit(1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(creds) == 0 {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential lease %s not found in credential store %s", credentialId, storeId))
+	}
+	return creds[0], nil
+}
+
+func (r *Repository) lookupCredential(ctx context.Context, credentialId string) (*Credential, error) {
+	const op = "vault.(Repository).lookupCredential"
+	c := allocCredential()
+	c.PublicId = credentialId
+	if err := r.reader.LookupByPublicId(ctx, c); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", credentialId)))
+	}
+	return c, nil
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Leases(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	v := NewTestVaultServer(t, WithDockerNetwork(true))
+	v.MountDatabase(t)
+
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sche)
+	require.NoError(err)
+
+	_, token := v.CreateToken(t, WithPolicies([]string{"default", "boundary-controller", "database"}))
+	credStoreIn, err := NewCredentialStore(prj.GetPublicId(), v.Addr, []byte(token))
+	require.NoError(err)
+	j, err := newTokenRenewalJob(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+	require.NoError(sche.RegisterJob(ctx, j))
+	cs, err := repo.CreateCredentialStore(ctx, credStoreIn)
+	require.NoError(err)
+	otherCs := TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+
+	libIn, err := NewCredentialLibrary(cs.GetPublicId(), path.Join("database", "creds", "opened"))
+	require.NoError(err)
+	cl, err := repo.CreateCredentialLibrary(ctx, prj.GetPublicId(), libIn)
+	require.NoError(err)
+
+	at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+	hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+	newSession := func() *session.Session {
+		return session.TestSession(t, conn, wrapper, session.ComposedOf{
+			UserId:      at.GetIamUserId(),
+			HostId:      h.GetPublicId(),
+			TargetId:    tar.GetPublicId(),
+			HostSetId:   hs.GetPublicId(),
+			AuthTokenId: at.GetPublicId(),
+			ProjectId:   prj.GetPublicId(),
+			Endpoint:    "tcp://127.0.0.1:22",
+		})
+	}
+	sess1, sess2 := newSession(), newSession()
+
+	csToken := allocToken()
+	require.NoError(rw.LookupWhere(ctx, &csToken, "token_hmac = ?", []any{cs.outputToken.TokenHmac}))
+
+	_, cred1 := testVaultCred(t, conn, v, cl, sess1, csToken, ActiveCredential, 5*time.Minute)
+	_, cred2 := testVaultCred(t, conn, v, cl, sess2, csToken, ActiveCredential, 5*time.Minute)
+	_, expiredCred := testVaultCred(t, conn, v, cl, sess2, csToken, ExpiredCredential, 5*time.Minute)
+
+	t.Run("list", func(t *testing.T) {
+		got, err := repo.ListLeases(ctx, cs.GetPublicId())
+		require.NoError(err)
+		var ids []string
+		for _, c := range got {
+			ids = append(ids, c.GetPublicId())
+		}
+		assert.ElementsMatch([]string{cred1.GetPublicId(), cred2.GetPublicId(), expiredCred.GetPublicId()}, ids)
+
+		got, err = repo.ListLeases(ctx, cs.GetPublicId(), WithSessionId(sess1.GetPublicId()))
+		require.NoError(err)
+		require.Len(got, 1)
+		assert.Equal(cred1.GetPublicId(), got[0].GetPublicId())
+
+		got, err = repo.ListLeases(ctx, otherCs.GetPublicId())
+		require.NoError(err)
+		assert.Empty(got)
+
+		_, err = repo.ListLeases(ctx, "")
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+
+	t.Run("renew", func(t *testing.T) {
+		// Sleep to move clock
+		time.Sleep(2 * time.Second)
+		got, err := repo.RenewLease(ctx, cs.GetPublicId(), cred1.GetPublicId())
+		require.NoError(err)
+		assert.Equal(string(ActiveCredential), got.GetStatus())
+		assert.Empty(got.GetLastRenewalError())
+		assert.True(got.GetExpirationTime().AsTime().After(cred1.GetExpirationTime().AsTime()), "expected expiration time to be updated")
+		secret := v.LookupLease(t, cred1.ExternalId)
+		assert.NotNil(secret.Data["last_renewal"])
+
+		_, err = repo.RenewLease(ctx, cs.GetPublicId(), expiredCred.GetPublicId())
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+		_, err = repo.RenewLease(ctx, otherCs.GetPublicId(), cred1.GetPublicId())
+		assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error: %v", err)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		got, err := repo.RevokeLease(ctx, cs.GetPublicId(), cred2.GetPublicId())
+		require.NoError(err)
+		assert.Equal(string(RevokedCredential), got.GetStatus())
+
+		_, err = repo.RevokeLease(ctx, cs.GetPublicId(), cred2.GetPublicId())
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+		_, err = repo.RenewLease(ctx, cs.GetPublicId(), cred2.GetPublicId())
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+		_, err = repo.RevokeLease(ctx, cs.GetPublicId(), "")
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+}
@@ -13,9 +13,11 @@ import (
 	dcommon "github.com/hashicorp/boundary/internal/daemon/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	intglobals "github.com/hashicorp/boundary/internal/globals"
+	"github.com/hashicorp/boundary/internal/host"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
@@ -36,6 +38,7 @@ type workerServiceServer struct {
 	workerAuthRepoFn    common.WorkerAuthRepoStorageFactory
 	sessionRepoFn       session.RepositoryFactory
 	connectionRepoFn    common.ConnectionRepoFactory
+	hostHealthRepoFn    common.HostHealthRepoFactory
 	downstreams         common.Downstreamers
 	updateTimes         *sync.Map
 	kms                 *kms.Kms
@@ -71,6 +74,7 @@ func NewWorkerServiceServer(
 	workerAuthRepoFn common.WorkerAuthRepoStorageFactory,
 	sessionRepoFn session.RepositoryFactory,
 	connectionRepoFn common.ConnectionRepoFactory,
+	hostHealthRepoFn common.HostHealthRepoFactory,
 	downstreams common.Downstreamers,
 	updateTimes *sync.Map,
 	kms *kms.Kms,
@@ -82,6 +86,7 @@ func NewWorkerServiceServer(
 		workerAuthRepoFn:    workerAuthRepoFn,
 		sessionRepoFn:       sessionRepoFn,
 		connectionRepoFn:    connectionRepoFn,
+		hostHealthRepoFn:    hostHealthRepoFn,
 		downstreams:         downstreams,
 		updateTimes:         updateTimes,
 		kms:                 kms,
@@ -209,6 +214,19 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 		AuthorizedWorkers:           authorizedWorkerList,
 		AuthorizedDownstreamWorkers: authorizedDownstreams,
 	}
+	if dd := wrk.GetDrainDeadline(); dd != nil {
+		ret.DrainDeadline = dd.GetTimestamp()
+	}
+
+	if len(req.GetHostHealthCheckResults()) > 0 || req.GetRequestHostHealthChecks() {
+		// Host health checks are best effort, so failures are reported but
+		// do not fail the status call
+		healthChecks, err := ws.hostHealth(ctx, wrk, req.GetHostHealthCheckResults(), req.GetRequestHostHealthChecks())
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error processing host health checks", "worker_id", wrk.GetPublicId()))
+		}
+		ret.HostHealthChecks = healthChecks
+	}
 
 	stateReport := make([]*session.StateReport, 0, len(req.GetJobs()))
 	var monitoredSessionIds []string
@@ -333,6 +351,88 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 	return ret, nil
 }
 
+// hostHealth stores the results of the host health checks run by the worker
+// and, if requested, returns the hosts the worker should health check next.
+func (ws *workerServiceServer) hostHealth(ctx context.Context, w *server.Worker, results []*pbs.HostHealthCheckResult, requested bool) ([]*pbs.HostHealthCheck, error) {
+	const op = "workers.(workerServiceServer).hostHealth"
+	healthRepo, err := ws.hostHealthRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(results) > 0 {
+		hostResults := make([]*host.HealthCheckResult, 0, len(results))
+		for _, r := range results {
+			hostResults = append(hostResults, &host.HealthCheckResult{
+				HostId:  r.GetHostId(),
+				Port:    r.GetPort(),
+				Healthy: r.GetHealthy(),
+			})
+		}
+		if err := healthRepo.UpsertHealthCheckResults(ctx, w.GetPublicId(), hostResults); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if !requested {
+		return nil, nil
+	}
+	endpoints, err := healthRepo.ListHealthCheckEndpoints(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return healthChecksForWorker(ctx, w, endpoints), nil
+}
+
+// healthChecksForWorker returns the health check endpoints that the worker
+// is able to reach, which are those whose targets' egress worker filter, or
+// worker filter if there is no egress worker filter, match the worker.
+func healthChecksForWorker(ctx context.Context, w *server.Worker, endpoints []*host.HealthCheckEndpoint) []*pbs.HostHealthCheck {
+	const op = "workers.healthChecksForWorker"
+	filterInput := map[string]any{
+		"name": w.GetName(),
+		"tags": w.CanonicalTags(),
+	}
+	matches := make(map[string]bool)
+	type check struct {
+		hostId, address string
+		port            uint32
+	}
+	seen := make(map[check]bool)
+	var ret []*pbs.HostHealthCheck
+	for _, ep := range endpoints {
+		filter := ep.EgressWorkerFilter
+		if filter == "" {
+			filter = ep.WorkerFilter
+		}
+		if filter != "" {
+			match, ok := matches[filter]
+			if !ok {
+				eval, err := bexpr.CreateEvaluator(filter)
+				if err == nil {
+					match, err = eval.Evaluate(filterInput)
+				}
+				if err != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("error evaluating worker filter for host health checks", "host_id", ep.HostId))
+				}
+				matches[filter] = match
+			}
+			if !match {
+				continue
+			}
+		}
+		c := check{hostId: ep.HostId, address: ep.Address, port: ep.Port}
+		if seen[c] {
+			continue
+		}
+		seen[c] = true
+		ret = append(ret, &pbs.HostHealthCheck{
+			HostId:  ep.HostId,
+			Address: ep.Address,
+			Port:    ep.Port,
+		})
+	}
+	return ret
+}
+
 // ListHcpbWorkers looks up workers that are HCP Boundary-managed, currently by
 // seeing if they are KMS and have a known tag
 func (ws *workerServiceServer) ListHcpbWorkers(ctx context.Context, req *pbs.ListHcpbWorkersRequest) (*pbs.ListHcpbWorkersResponse, error) {
@@ -507,9 +607,16 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 
 	resp := &pbs.LookupSessionResponse{
 		Authorization: &targets.SessionAuthorizationData{
-			SessionId:   sessionInfo.GetPublicId(),
-			Certificate: sessionInfo.Certificate,
-			PrivateKey:  sessionInfo.CertificatePrivateKey,
+			SessionId:                sessionInfo.GetPublicId(),
+			Certificate:              sessionInfo.Certificate,
+			PrivateKey:               sessionInfo.CertificatePrivateKey,
+			TargetBandwidthLimitUp:   sessionInfo.TargetBandwidthLimitUp,
+			TargetBandwidthLimitDown: sessionInfo.TargetBandwidthLimitDown,
+			BandwidthLimitUp:         sessionInfo.BandwidthLimitUp,
+			BandwidthLimitDown:       sessionInfo.BandwidthLimitDown,
+			ConnectionRateLimit:      sessionInfo.ConnectionRateLimit,
+			IdleTimeout:              sessionInfo.IdleTimeout,
+			Reverse:                  sessionInfo.Reverse,
 		},
 		Status:          sessionInfo.States[0].Status.ProtoVal(),
 		Version:         sessionInfo.Version,
@@ -683,6 +790,50 @@ func (ws *workerServiceServer) ConnectConnection(ctx context.Context, req *pbs.C
 	}, nil
 }
 
+func (ws *workerServiceServer) AuthorizeShadow(ctx context.Context, req *pbs.AuthorizeShadowRequest) (*pbs.AuthorizeShadowResponse, error) {
+	const op = "workers.(workerServiceServer).AuthorizeShadow"
+	sessionRepo, err := ws.sessionRepoFn()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting session repo: %v", err)
+	}
+
+	serversRepo, err := ws.serversRepoFn()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting server repo: %v", err)
+	}
+	w, err := serversRepo.LookupWorker(ctx, req.GetWorkerId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error looking up worker: %v", err)
+	}
+	if w == nil {
+		return nil, status.Errorf(codes.NotFound, "worker not found with name %q", req.GetWorkerId())
+	}
+
+	shadow, err := sessionRepo.AttachShadow(ctx, req.GetSessionId(), req.GetShadowId(), req.GetShadowToken(), req.GetConnectionId())
+	switch {
+	case err == nil:
+	case errors.IsNotFoundError(err), errors.Match(errors.T(errors.Forbidden), err):
+		return nil, status.Errorf(codes.PermissionDenied, "shadow %q is not authorized for session %q", req.GetShadowId(), req.GetSessionId())
+	case errors.Match(errors.T(errors.Conflict), err):
+		return nil, status.Errorf(codes.FailedPrecondition, "connection %q cannot be shadowed", req.GetConnectionId())
+	default:
+		return nil, status.Errorf(codes.Internal, "error attaching shadow: %v", err)
+	}
+	event.WriteSysEvent(ctx, op, "session shadow attached",
+		"session_id", shadow.SessionId,
+		"shadow_id", shadow.PublicId,
+		"user_id", shadow.UserId,
+		"read_write", shadow.ReadWrite,
+		"connection_id", shadow.ConnectionId,
+		"worker_id", w.GetPublicId(),
+	)
+
+	return &pbs.AuthorizeShadowResponse{
+		UserId:    shadow.UserId,
+		ReadWrite: shadow.ReadWrite,
+	}, nil
+}
+
 func (ws *workerServiceServer) CloseConnection(ctx context.Context, req *pbs.CloseConnectionRequest) (*pbs.CloseConnectionResponse, error) {
 	const op = "workers.(workerServiceServer).CloseConnection"
 	numCloses := len(req.GetCloseRequestData())
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	intglobals "github.com/hashicorp/boundary/internal/globals"
+	"github.com/hashicorp/boundary/internal/host"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -65,6 +66,9 @@ func TestLookupSession(t *testing.T) {
 	connectionRepoFn := func() (*session.ConnectionRepository, error) {
 		return session.NewConnectionRepository(ctx, rw, rw, kms)
 	}
+	hostHealthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	fce := &fakeControllerExtension{
 		reader: rw,
 		writer: rw,
@@ -158,7 +162,7 @@ func TestLookupSession(t *testing.T) {
 	err = repo.AddSessionCredentials(ctx, sessWithCreds.ProjectId, sessWithCreds.GetPublicId(), workerCreds)
 	require.NoError(t, err)
 
-	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, nil, new(sync.Map), kms, new(atomic.Int64), fce)
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, hostHealthRepoFn, nil, new(sync.Map), kms, new(atomic.Int64), fce)
 	require.NotNil(t, s)
 
 	oldFn := connectionRouteFn
@@ -324,6 +328,9 @@ func TestAuthorizeConnection(t *testing.T) {
 	connectionRepoFn := func() (*session.ConnectionRepository, error) {
 		return session.NewConnectionRepository(ctx, rw, rw, kmsCache)
 	}
+	hostHealthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	fce := &fakeControllerExtension{
 		reader: rw,
 		writer: rw,
@@ -358,7 +365,7 @@ func TestAuthorizeConnection(t *testing.T) {
 	repo, err := sessionRepoFn()
 	require.NoError(t, err)
 
-	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, nil, new(sync.Map), kmsCache, new(atomic.Int64), fce)
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, hostHealthRepoFn, nil, new(sync.Map), kmsCache, new(atomic.Int64), fce)
 	require.NotNil(t, s)
 
 	cases := []struct {
@@ -480,6 +487,9 @@ func TestCancelSession(t *testing.T) {
 	connectionRepoFn := func() (*session.ConnectionRepository, error) {
 		return session.NewConnectionRepository(ctx, rw, rw, kms)
 	}
+	hostHealthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	fce := &fakeControllerExtension{
 		reader: rw,
 		writer: rw,
@@ -502,7 +512,7 @@ func TestCancelSession(t *testing.T) {
 		ProjectId:   prj.GetPublicId(),
 		Endpoint:    "tcp://127.0.0.1:22",
 	})
-	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, nil, new(sync.Map), kms, new(atomic.Int64), fce)
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, hostHealthRepoFn, nil, new(sync.Map), kms, new(atomic.Int64), fce)
 	require.NotNil(t, s)
 	cases := []struct {
 		name       string
@@ -579,6 +589,9 @@ func TestHcpbWorkers(t *testing.T) {
 	connectionRepoFn := func() (*session.ConnectionRepository, error) {
 		return session.NewConnectionRepository(ctx, rw, rw, kmsCache)
 	}
+	hostHealthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	var liveDur atomic.Int64
 	liveDur.Store(int64(1 * time.Second))
 	fce := &fakeControllerExtension{
@@ -617,7 +630,7 @@ func TestHcpbWorkers(t *testing.T) {
 	_, err = serverRepo.UpsertWorkerStatus(ctx, server.NewWorker(scope.Global.String(), server.WithAddress("unrelated_tag.pki.1")), server.WithKeyId(keyId))
 	require.NoError(err)
 
-	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, nil, new(sync.Map), kmsCache, &liveDur, fce)
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, hostHealthRepoFn, nil, new(sync.Map), kmsCache, &liveDur, fce)
 	require.NotNil(t, s)
 
 	res, err := s.ListHcpbWorkers(ctx, &pbs.ListHcpbWorkersRequest{})
@@ -630,3 +643,27 @@ func TestHcpbWorkers(t *testing.T) {
 	}
 	assert.ElementsMatch(expValues, gotValues)
 }
+
+func TestHealthChecksForWorker(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	w := server.NewWorker(scope.Global.String(),
+		server.WithName("test-worker"),
+		server.WithWorkerTags(&server.Tag{Key: "region", Value: "east"}),
+		server.WithTestUseInputTagsAsApiTags(true))
+
+	endpoints := []*host.HealthCheckEndpoint{
+		{HostId: "hst_1", Address: "10.0.0.1", Port: 22},
+		{HostId: "hst_1", Address: "10.0.0.1", Port: 22, WorkerFilter: `"east" in "/tags/region"`},
+		{HostId: "hst_2", Address: "10.0.0.2", Port: 22, WorkerFilter: `"west" in "/tags/region"`},
+		{HostId: "hst_3", Address: "10.0.0.3", Port: 443, WorkerFilter: `"west" in "/tags/region"`, EgressWorkerFilter: `"/name" == "test-worker"`},
+		{HostId: "hst_4", Address: "10.0.0.4", Port: 443, WorkerFilter: `"east" in "/tags/region"`, EgressWorkerFilter: `"/name" == "other"`},
+		{HostId: "hst_5", Address: "10.0.0.5", Port: 443, WorkerFilter: `not a filter`},
+	}
+	got := healthChecksForWorker(ctx, w, endpoints)
+	want := []*pbs.HostHealthCheck{
+		{HostId: "hst_1", Address: "10.0.0.1", Port: 22},
+		{HostId: "hst_3", Address: "10.0.0.3", Port: 443},
+	}
+	assert.Empty(t, cmp.Diff(want, got, protocmp.Transform()))
+}
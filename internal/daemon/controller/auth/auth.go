@@ -18,9 +18,11 @@ import (
 	"github.com/hashicorp/boundary/api/recovery"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/jwt"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/auth/saml"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -112,6 +114,8 @@ type verifier struct {
 	passwordAuthRepoFn common.PasswordAuthRepoFactory
 	oidcAuthRepoFn     common.OidcAuthRepoFactory
 	ldapAuthRepoFn     common.LdapAuthRepoFactory
+	jwtAuthRepoFn      common.JwtAuthRepoFactory
+	samlAuthRepoFn     common.SamlAuthRepoFactory
 	kms                *kms.Kms
 	requestInfo        *authpb.RequestInfo
 	res                *perms.Resource
@@ -137,6 +141,8 @@ func NewVerifierContextWithAccounts(ctx context.Context,
 	passwordAuthRepoFn common.PasswordAuthRepoFactory,
 	oidcAuthRepoFn common.OidcAuthRepoFactory,
 	ldapAuthRepoFn common.LdapAuthRepoFactory,
+	jwtAuthRepoFn common.JwtAuthRepoFactory,
+	samlAuthRepoFn common.SamlAuthRepoFactory,
 	kms *kms.Kms,
 	requestInfo *authpb.RequestInfo,
 ) context.Context {
@@ -147,6 +153,8 @@ func NewVerifierContextWithAccounts(ctx context.Context,
 		passwordAuthRepoFn: passwordAuthRepoFn,
 		oidcAuthRepoFn:     oidcAuthRepoFn,
 		ldapAuthRepoFn:     ldapAuthRepoFn,
+		jwtAuthRepoFn:      jwtAuthRepoFn,
+		samlAuthRepoFn:     samlAuthRepoFn,
 		kms:                kms,
 		requestInfo:        requestInfo,
 	})
@@ -163,7 +171,7 @@ func NewVerifierContext(ctx context.Context,
 	kms *kms.Kms,
 	requestInfo *authpb.RequestInfo,
 ) context.Context {
-	return NewVerifierContextWithAccounts(ctx, iamRepoFn, authTokenRepoFn, serversRepoFn, nil, nil, nil, kms, requestInfo)
+	return NewVerifierContextWithAccounts(ctx, iamRepoFn, authTokenRepoFn, serversRepoFn, nil, nil, nil, nil, nil, kms, requestInfo)
 }
 
 // Verify takes in a context that has expected parameters as values and runs an
@@ -271,6 +279,7 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 		Id:      opts.withId,
 		Pin:     opts.withPin,
 		Type:    opts.withType,
+		Item:    opts.withResourceItem,
 	}
 	// Global scope has no parent ID; account for this
 	if opts.withId == scope.Global.String() && opts.withType == resource.Scope {
@@ -560,7 +569,7 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 	userData.User.Email = util.Pointer(u.Email)
 	userData.User.FullName = util.Pointer(u.FullName)
 
-	if userData.Account.Id != nil && *userData.Account.Id != "" && v.passwordAuthRepoFn != nil && v.oidcAuthRepoFn != nil && v.ldapAuthRepoFn != nil {
+	if userData.Account.Id != nil && *userData.Account.Id != "" && v.passwordAuthRepoFn != nil && v.oidcAuthRepoFn != nil && v.ldapAuthRepoFn != nil && v.jwtAuthRepoFn != nil && v.samlAuthRepoFn != nil {
 		const domain = "auth"
 		var acct auth.Account
 		var err error
@@ -586,6 +595,20 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 				return
 			}
 			acct, err = repo.LookupAccount(ctx, *userData.Account.Id)
+		case jwt.Subtype:
+			repo, repoErr := v.jwtAuthRepoFn()
+			if repoErr != nil {
+				retErr = errors.Wrap(ctx, repoErr, op, errors.WithMsg("failed to get jwt auth repo"))
+				return
+			}
+			acct, err = repo.LookupAccount(ctx, *userData.Account.Id)
+		case saml.Subtype:
+			repo, repoErr := v.samlAuthRepoFn()
+			if repoErr != nil {
+				retErr = errors.Wrap(ctx, repoErr, op, errors.WithMsg("failed to get saml auth repo"))
+				return
+			}
+			acct, err = repo.LookupAccount(ctx, *userData.Account.Id)
 		default:
 			retErr = errors.Wrap(ctx, err, op, errors.WithMsg("unrecognized account id type"))
 			return
@@ -731,6 +754,9 @@ func (r *VerifyResults) fetchActions(id string, typ resource.Type, availableActi
 	if typ != resource.Unknown {
 		res.Type = typ
 	}
+	if opts.withResourceItem != nil {
+		res.Item = opts.withResourceItem
+	}
 
 	ret := make(action.ActionSet, len(availableActions))
 	for act := range availableActions {
@@ -33,6 +33,7 @@ type options struct {
 	withRecoveryTokenNotAllowed bool
 	withAnonymousUserNotAllowed bool
 	withResource                *perms.Resource
+	withResourceItem            any
 }
 
 func getDefaultOptions() options {
@@ -99,3 +100,11 @@ func WithResource(resource *perms.Resource) Option {
 		o.withResource = resource
 	}
 }
+
+// WithResourceItem specifies the API representation of the resource, against
+// which any grant filters are evaluated
+func WithResourceItem(item any) Option {
+	return func(o *options) {
+		o.withResourceItem = item
+	}
+}
@@ -31,6 +31,7 @@ func Test_GetOpts(t *testing.T) {
 		WithRecoveryTokenNotAllowed(true),
 		WithAnonymousUserNotAllowed(true),
 		WithResource(res),
+		WithResourceItem("item"),
 	)
 	exp := options{
 		withScopeId:                 "foo",
@@ -43,6 +44,7 @@ func Test_GetOpts(t *testing.T) {
 		withRecoveryTokenNotAllowed: true,
 		withAnonymousUserNotAllowed: true,
 		withResource:                res,
+		withResourceItem:            "item",
 	}
 	assert.Equal(t, exp, opts)
 }
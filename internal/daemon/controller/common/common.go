@@ -4,11 +4,17 @@
 package common
 
 import (
+	"github.com/hashicorp/boundary/internal/alias"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/jwt"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/auth/saml"
 	"github.com/hashicorp/boundary/internal/credential"
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
+	"github.com/hashicorp/boundary/internal/credential/postgres"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/host"
@@ -16,20 +22,29 @@ import (
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/scim"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
 	pluginstorage "github.com/hashicorp/boundary/internal/storage/plugin"
 )
 
 type (
+	AliasRepoFactory               func() (*alias.Repository, error)
 	AuthTokenRepoFactory           = oidc.AuthTokenRepoFactory
 	VaultCredentialRepoFactory     = func() (*vault.Repository, error)
 	StaticCredentialRepoFactory    = func() (*credstatic.Repository, error)
+	PluginCredentialRepoFactory    = func() (*credplugin.Repository, error)
+	SshCaCredentialRepoFactory     = func() (*sshca.Repository, error)
+	PostgresCredentialRepoFactory  = func() (*postgres.Repository, error)
 	CredentialStoreRepoFactory     func() (*credential.StoreRepository, error)
 	HostCatalogRepoFactory         func() (*host.CatalogRepository, error)
+	HostHealthRepoFactory          func() (*host.HealthRepository, error)
 	IamRepoFactory                 = iam.IamRepoFactory
 	OidcAuthRepoFactory            = oidc.OidcRepoFactory
 	LdapAuthRepoFactory            = ldap.RepoFactory
+	JwtAuthRepoFactory             = jwt.RepoFactory
+	SamlAuthRepoFactory            = saml.SamlRepoFactory
+	ScimRepoFactory                = scim.RepoFactory
 	PasswordAuthRepoFactory        func() (*password.Repository, error)
 	AuthMethodRepoFactory          func() (*auth.AuthMethodRepository, error)
 	ServersRepoFactory             func() (*server.Repository, error)
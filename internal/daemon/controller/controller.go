@@ -11,15 +11,21 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/hashicorp/boundary/internal/alias"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/jwt"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/auth/saml"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/census"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/cmd/config"
 	"github.com/hashicorp/boundary/internal/credential"
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
+	credpostgres "github.com/hashicorp/boundary/internal/credential/postgres"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/daemon/cluster"
@@ -34,6 +40,7 @@ import (
 	pluginhost "github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
+	iamjob "github.com/hashicorp/boundary/internal/iam/job"
 	"github.com/hashicorp/boundary/internal/kms"
 	kmsjob "github.com/hashicorp/boundary/internal/kms/job"
 	"github.com/hashicorp/boundary/internal/pagination/purge"
@@ -44,6 +51,7 @@ import (
 	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/scheduler/cleaner"
 	"github.com/hashicorp/boundary/internal/scheduler/job"
+	"github.com/hashicorp/boundary/internal/scim"
 	"github.com/hashicorp/boundary/internal/server"
 	serversjob "github.com/hashicorp/boundary/internal/server/job"
 	"github.com/hashicorp/boundary/internal/session"
@@ -129,14 +137,22 @@ type Controller struct {
 	rateLimiterMu sync.RWMutex
 
 	// Repo factory methods
+	AliasRepoFn               common.AliasRepoFactory
 	AuthTokenRepoFn           common.AuthTokenRepoFactory
 	VaultCredentialRepoFn     common.VaultCredentialRepoFactory
 	StaticCredentialRepoFn    common.StaticCredentialRepoFactory
+	PluginCredentialRepoFn    common.PluginCredentialRepoFactory
+	SshCaCredentialRepoFn     common.SshCaCredentialRepoFactory
+	PostgresCredentialRepoFn  common.PostgresCredentialRepoFactory
 	CredentialStoreRepoFn     common.CredentialStoreRepoFactory
 	HostCatalogRepoFn         common.HostCatalogRepoFactory
+	HostHealthRepoFn          common.HostHealthRepoFactory
 	IamRepoFn                 common.IamRepoFactory
 	OidcRepoFn                common.OidcAuthRepoFactory
 	LdapRepoFn                common.LdapAuthRepoFactory
+	JwtRepoFn                 common.JwtAuthRepoFactory
+	SamlRepoFn                common.SamlAuthRepoFactory
+	ScimRepoFn                common.ScimRepoFactory
 	PasswordAuthRepoFn        common.PasswordAuthRepoFactory
 	AuthMethodRepoFn          common.AuthMethodRepoFactory
 	ServersRepoFn             common.ServersRepoFactory
@@ -278,12 +294,15 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 			}
 			plg := loopback.NewWrappingPluginHostClient(lp)
 			opts := []plugin.Option{
-				plugin.WithDescription("Provides an initial loopback storage and host plugin in Boundary"),
+				plugin.WithDescription("Provides an initial loopback storage, host and credential plugin in Boundary"),
 				plugin.WithPublicId(conf.DevLoopbackPluginId),
 			}
 			if _, err = conf.RegisterPlugin(ctx, "loopback", plg, []plugin.PluginType{plugin.PluginTypeHost, plugin.PluginTypeStorage}, opts...); err != nil {
 				return nil, err
 			}
+			if _, err = conf.RegisterCredentialPlugin(ctx, "loopback", loopback.NewWrappingPluginCredentialClient(lp), opts...); err != nil {
+				return nil, err
+			}
 		case enabledPlugin == base.EnabledPluginHostAzure && !c.conf.SkipPlugins:
 			pluginType := strings.ToLower(enabledPlugin.String())
 			client, cleanup, err := external_plugins.CreateHostPlugin(
@@ -326,6 +345,9 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	if conf.HostPlugins == nil {
 		conf.HostPlugins = make(map[string]plgpb.HostPluginServiceClient)
 	}
+	if conf.CredentialPlugins == nil {
+		conf.CredentialPlugins = make(map[string]plgpb.CredentialPluginServiceClient)
+	}
 
 	// Set up repo stuff
 	dbase := db.New(c.conf.Database)
@@ -401,6 +423,9 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.PluginStorageBucketRepoFn = func() (*pluginstorage.Repository, error) {
 		return pluginstorage.NewRepository(ctx, dbase, dbase, c.kms)
 	}
+	c.AliasRepoFn = func() (*alias.Repository, error) {
+		return alias.NewRepository(ctx, dbase, dbase, c.kms)
+	}
 	c.AuthTokenRepoFn = func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, dbase, dbase, c.kms,
 			authtoken.WithTokenTimeToLiveDuration(c.conf.RawConfig.Controller.AuthTokenTimeToLiveDuration),
@@ -412,12 +437,24 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.StaticCredentialRepoFn = func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(ctx, dbase, dbase, c.kms)
 	}
+	c.PluginCredentialRepoFn = func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, dbase, dbase, c.kms, c.scheduler, c.conf.CredentialPlugins)
+	}
+	c.SshCaCredentialRepoFn = func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, dbase, dbase, c.kms)
+	}
+	c.PostgresCredentialRepoFn = func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, dbase, dbase, c.kms, c.scheduler)
+	}
 	c.CredentialStoreRepoFn = func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(ctx, dbase, dbase)
 	}
 	c.HostCatalogRepoFn = func() (*host.CatalogRepository, error) {
 		return host.NewCatalogRepository(ctx, dbase, dbase)
 	}
+	c.HostHealthRepoFn = func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, dbase, dbase)
+	}
 	c.ServersRepoFn = func() (*server.Repository, error) {
 		return server.NewRepository(ctx, dbase, dbase, c.kms)
 	}
@@ -427,6 +464,15 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.LdapRepoFn = func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, dbase, dbase, c.kms)
 	}
+	c.JwtRepoFn = func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, dbase, dbase, c.kms)
+	}
+	c.SamlRepoFn = func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, dbase, dbase, c.kms)
+	}
+	c.ScimRepoFn = func() (*scim.Repository, error) {
+		return scim.NewRepository(ctx, dbase, dbase, c.kms)
+	}
 	c.PasswordAuthRepoFn = func() (*password.Repository, error) {
 		return password.NewRepository(ctx, dbase, dbase, c.kms)
 	}
@@ -437,9 +483,17 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 		return target.NewRepository(ctx, dbase, dbase, c.kms, o...)
 	}
 	c.SessionRepoFn = func(opt ...session.Option) (*session.Repository, error) {
-		// Always add a secure random reader to the new session repository.
-		// Add it as the first option so that it can be overridden by users.
-		opt = append([]session.Option{session.WithRandomReader(c.conf.SecureRandomReader)}, opt...)
+		// Always add a secure random reader and the configured session quotas
+		// to the new session repository. Add them as the first options so
+		// that they can be overridden by users.
+		opt = append([]session.Option{
+			session.WithRandomReader(c.conf.SecureRandomReader),
+			session.WithQuotas(session.Quotas{
+				MaxActivePerUser:          c.conf.RawConfig.Controller.SessionQuotas.MaxActivePerUser,
+				MaxActivePerUserPerTarget: c.conf.RawConfig.Controller.SessionQuotas.MaxActivePerUserPerTarget,
+				MaxActivePerProject:       c.conf.RawConfig.Controller.SessionQuotas.MaxActivePerProject,
+			}),
+		}, opt...)
 		return session.NewRepository(ctx, dbase, dbase, c.kms, opt...)
 	}
 	c.ConnectionRepoFn = func() (*session.ConnectionRepository, error) {
@@ -585,6 +639,12 @@ func (c *Controller) registerJobs() error {
 	if err := vault.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
 		return err
 	}
+	if err := credplugin.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.conf.CredentialPlugins); err != nil {
+		return err
+	}
+	if err := credpostgres.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
+		return err
+	}
 	if err := pluginhost.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.conf.HostPlugins); err != nil {
 		return err
 	}
@@ -604,6 +664,9 @@ func (c *Controller) registerJobs() error {
 	if err := kmsjob.RegisterJobs(c.baseContext, c.scheduler, c.kms); err != nil {
 		return err
 	}
+	if err := iamjob.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
+		return err
+	}
 	if err := cleaner.RegisterJob(c.baseContext, c.scheduler, rw); err != nil {
 		return err
 	}
@@ -75,6 +75,8 @@ func newGrpcServer(
 	passwordAuthRepoFn common.PasswordAuthRepoFactory,
 	oidcAuthRepoFn common.OidcAuthRepoFactory,
 	ldapAuthRepoFn common.LdapAuthRepoFactory,
+	jwtAuthRepoFn common.JwtAuthRepoFactory,
+	samlAuthRepoFn common.SamlAuthRepoFactory,
 	kms *kms.Kms,
 	eventer *event.Eventer,
 ) (*grpc.Server, string, error) {
@@ -83,7 +85,7 @@ func newGrpcServer(
 	if err != nil {
 		return nil, "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate gateway ticket"))
 	}
-	unaryCtxInterceptor, err := requestCtxUnaryInterceptor(ctx, iamRepoFn, authTokenRepoFn, serversRepoFn, passwordAuthRepoFn, oidcAuthRepoFn, ldapAuthRepoFn, kms, ticket, eventer)
+	unaryCtxInterceptor, err := requestCtxUnaryInterceptor(ctx, iamRepoFn, authTokenRepoFn, serversRepoFn, passwordAuthRepoFn, oidcAuthRepoFn, ldapAuthRepoFn, jwtAuthRepoFn, samlAuthRepoFn, kms, ticket, eventer)
 	if err != nil {
 		return nil, "", err
 	}
@@ -96,6 +98,8 @@ func newGrpcServer(
 		passwordAuthRepoFn,
 		oidcAuthRepoFn,
 		ldapAuthRepoFn,
+		jwtAuthRepoFn,
+		samlAuthRepoFn,
 		kms,
 		ticket,
 		eventer,
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"mime"
 	"net/http"
 	"net/textproto"
 	"os"
@@ -22,6 +23,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/accounts"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/aliases"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/authmethods"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/authtokens"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/credentiallibraries"
@@ -48,6 +50,8 @@ import (
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	opsservices "github.com/hashicorp/boundary/internal/gen/ops/services"
 	"github.com/hashicorp/boundary/internal/ratelimit"
+	"github.com/hashicorp/boundary/internal/scim"
+	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
@@ -74,8 +78,14 @@ func createMuxWithEndpoints(c *Controller, props HandlerProperties) (http.Handle
 		return nil, nil, err
 	}
 
+	scimHandler, err := scim.NewHandler(c.baseContext, c.ScimRepoFn)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	mux := http.NewServeMux()
 	mux.Handle("/v1/", ratelimit.Handler(c.baseContext, c.getRateLimiter, grpcGwMux))
+	mux.Handle(scim.PathPrefix, scimHandler)
 	mux.Handle(uiPath, handleUi(c))
 
 	isUiRequest := func(req *http.Request) bool {
@@ -159,14 +169,14 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		services.RegisterHostSetServiceServer(s, hss)
 	}
 	if _, ok := currentServices[services.HostService_ServiceDesc.ServiceName]; !ok {
-		hs, err := hosts.NewService(c.baseContext, c.StaticHostRepoFn, c.PluginHostRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
+		hs, err := hosts.NewService(c.baseContext, c.StaticHostRepoFn, c.PluginHostRepoFn, c.HostHealthRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
 		if err != nil {
 			return fmt.Errorf("failed to create host handler service: %w", err)
 		}
 		services.RegisterHostServiceServer(s, hs)
 	}
 	if _, ok := currentServices[services.AccountService_ServiceDesc.ServiceName]; !ok {
-		accts, err := accounts.NewService(c.baseContext, c.PasswordAuthRepoFn, c.OidcRepoFn, c.LdapRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
+		accts, err := accounts.NewService(c.baseContext, c.PasswordAuthRepoFn, c.OidcRepoFn, c.LdapRepoFn, c.JwtRepoFn, c.SamlRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
 		if err != nil {
 			return fmt.Errorf("failed to create account handler service: %w", err)
 		}
@@ -181,6 +191,9 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			c.IamRepoFn,
 			c.AuthTokenRepoFn,
 			c.LdapRepoFn,
+			c.JwtRepoFn,
+			c.SamlRepoFn,
+			c.ScimRepoFn,
 			c.AuthMethodRepoFn,
 			c.conf.RawConfig.Controller.MaxPageSize,
 		)
@@ -204,7 +217,14 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		services.RegisterScopeServiceServer(s, os)
 	}
 	if _, ok := currentServices[services.UserService_ServiceDesc.ServiceName]; !ok {
-		us, err := users.NewService(c.baseContext, c.IamRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
+		us, err := users.NewService(
+			c.baseContext,
+			c.IamRepoFn,
+			c.AliasRepoFn,
+			c.TargetRepoFn,
+			c.StaticHostRepoFn,
+			c.PluginHostRepoFn,
+			c.conf.RawConfig.Controller.MaxPageSize)
 		if err != nil {
 			return fmt.Errorf("failed to create user handler service: %w", err)
 		}
@@ -258,10 +278,16 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			c.SessionRepoFn,
 			c.PluginHostRepoFn,
 			c.StaticHostRepoFn,
+			c.HostHealthRepoFn,
 			c.VaultCredentialRepoFn,
 			c.StaticCredentialRepoFn,
+			c.PluginCredentialRepoFn,
+			c.SshCaCredentialRepoFn,
+			c.PostgresCredentialRepoFn,
+			c.AliasRepoFn,
 			c.downstreamWorkers,
 			c.workerStatusGracePeriod,
+			server.WorkerSelectionStrategy(c.conf.RawConfig.Controller.WorkerSelectionStrategy),
 			c.conf.RawConfig.Controller.MaxPageSize,
 			c.ControllerExtension,
 		)
@@ -292,7 +318,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		services.RegisterSessionServiceServer(s, ss)
 	}
 	if _, ok := currentServices[services.ManagedGroupService_ServiceDesc.ServiceName]; !ok {
-		mgs, err := managed_groups.NewService(c.baseContext, c.OidcRepoFn, c.LdapRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
+		mgs, err := managed_groups.NewService(c.baseContext, c.OidcRepoFn, c.LdapRepoFn, c.JwtRepoFn, c.SamlRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
 		if err != nil {
 			return fmt.Errorf("failed to create managed groups handler service: %w", err)
 		}
@@ -304,6 +330,9 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			c.IamRepoFn,
 			c.VaultCredentialRepoFn,
 			c.StaticCredentialRepoFn,
+			c.PluginCredentialRepoFn,
+			c.SshCaCredentialRepoFn,
+			c.PostgresCredentialRepoFn,
 			c.CredentialStoreRepoFn,
 			c.conf.RawConfig.Controller.MaxPageSize,
 		)
@@ -317,6 +346,9 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			c.baseContext,
 			c.IamRepoFn,
 			c.VaultCredentialRepoFn,
+			c.PluginCredentialRepoFn,
+			c.SshCaCredentialRepoFn,
+			c.PostgresCredentialRepoFn,
 			c.conf.RawConfig.Controller.MaxPageSize,
 		)
 		if err != nil {
@@ -332,11 +364,19 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		}
 		services.RegisterWorkerServiceServer(s, ws)
 	}
+	if _, ok := currentServices[services.AliasService_ServiceDesc.ServiceName]; !ok {
+		as, err := aliases.NewService(c.baseContext, c.AliasRepoFn, c.IamRepoFn)
+		if err != nil {
+			return fmt.Errorf("failed to create alias handler service: %w", err)
+		}
+		services.RegisterAliasServiceServer(s, as)
+	}
 	if _, ok := currentServices[services.CredentialService_ServiceDesc.ServiceName]; !ok {
 		c, err := credentials.NewService(
 			c.baseContext,
 			c.IamRepoFn,
 			c.StaticCredentialRepoFn,
+			c.SessionRepoFn,
 			c.conf.RawConfig.Controller.MaxPageSize,
 		)
 		if err != nil {
@@ -404,6 +444,9 @@ func registerGrpcGatewayEndpoints(ctx context.Context, gwMux *runtime.ServeMux,
 	if err := services.RegisterWorkerServiceHandlerFromEndpoint(ctx, gwMux, gatewayTarget, dialOptions); err != nil {
 		return fmt.Errorf("failed to register worker service handler: %w", err)
 	}
+	if err := services.RegisterAliasServiceHandlerFromEndpoint(ctx, gwMux, gatewayTarget, dialOptions); err != nil {
+		return fmt.Errorf("failed to register alias service handler: %w", err)
+	}
 	if err := services.RegisterCredentialServiceHandlerFromEndpoint(ctx, gwMux, gatewayTarget, dialOptions); err != nil {
 		return fmt.Errorf("failed to register credential service handler: %w", err)
 	}
@@ -625,12 +668,12 @@ func wrapHandlerWithCallbackInterceptor(h http.Handler, c *Controller) http.Hand
 
 		req.URL.Path = strings.TrimSuffix(req.URL.Path, ":callback")
 
-		// How we get the parameters changes based on the method. Right now only
-		// GET is supported with query args, but this can support POST with JSON
-		// or URL-encoded args. In those cases, the MIME type would have to be
-		// checked; for URL-encoded it'd use ParseForm like Get, and for JSON
-		// you'd use a json.RawMessage for Attributes consisting of the body. Or
-		// something very similar to that.
+		// How we get the parameters changes based on the method. GET is
+		// supported with query args and POST with URL-encoded args, which is
+		// how SAML IdPs deliver their responses (the HTTP-POST binding). POST
+		// with JSON could be supported by using a json.RawMessage for
+		// Attributes consisting of the body, or something very similar to
+		// that.
 		var useForm bool
 		switch req.Method {
 		case http.MethodGet:
@@ -642,6 +685,23 @@ func wrapHandlerWithCallbackInterceptor(h http.Handler, c *Controller) http.Hand
 				return
 			}
 			useForm = true
+		case http.MethodPost:
+			mediaType, _, _ := mime.ParseMediaType(req.Header.Get("content-type"))
+			if mediaType != "application/x-www-form-urlencoded" {
+				break
+			}
+			if err := req.ParseForm(); err != nil {
+				if logCallbackErrors && c != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("callback error"))
+				}
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			// The form is posted by the user agent from the IdP's page, so it
+			// carries the IdP's origin, which the CORS handler would reject.
+			// The request is authenticated by its signed contents instead.
+			req.Header.Del("Origin")
+			useForm = true
 		}
 
 		attrs := &cmdAttrs{
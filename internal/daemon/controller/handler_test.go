@@ -284,6 +284,7 @@ func TestCallbackInterceptor(t *testing.T) {
 		name     string
 		path     string
 		args     url.Values
+		post     bool
 		wantJson *cmdAttrs
 	}{
 		{
@@ -322,19 +323,47 @@ func TestCallbackInterceptor(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "callback, with posted form",
+			path: "v1/auth-methods/amsaml_1234567890:authenticate:callback",
+			args: url.Values{
+				"SAMLResponse": []string{"fooBar"},
+				"RelayState":   []string{"barFoo"},
+			},
+			post: true,
+			wantJson: &cmdAttrs{
+				Command: "callback",
+				Attributes: map[string]any{
+					"SAMLResponse": "fooBar",
+					"RelayState":   "barFoo",
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			require := require.New(t)
 
-			req, err := http.NewRequest(http.MethodGet,
-				fmt.Sprintf("http://%s/%s", listener.Addr().String(), tc.path),
-				nil)
-			require.NoError(err)
+			var req *http.Request
+			var err error
+			switch {
+			case tc.post:
+				req, err = http.NewRequest(http.MethodPost,
+					fmt.Sprintf("http://%s/%s", listener.Addr().String(), tc.path),
+					strings.NewReader(tc.args.Encode()))
+				require.NoError(err)
+				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				req.Header.Set("Origin", "https://idp.example.com")
+			default:
+				req, err = http.NewRequest(http.MethodGet,
+					fmt.Sprintf("http://%s/%s", listener.Addr().String(), tc.path),
+					nil)
+				require.NoError(err)
 
-			if tc.args != nil {
-				req.URL.RawQuery = tc.args.Encode()
+				if tc.args != nil {
+					req.URL.RawQuery = tc.args.Encode()
+				}
 			}
 
 			resp, err := http.DefaultClient.Do(req)
@@ -12,11 +12,13 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/jwt"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	oidcstore "github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	pwstore "github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/auth/saml"
 	requestauth "github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
@@ -63,6 +65,18 @@ const (
 	dnAttrField       = "attributes.dn"
 	memberOfAttrField = "attributes.member_of_groups"
 
+	// jwt field names
+	jwtSubjectField     = "attributes.subject"
+	jwtFullNameField    = "attributes.full_name"
+	jwtEmailField       = "attributes.email"
+	jwtTokenClaimsField = "attributes.token_claims"
+
+	// saml field names
+	samlSubjectField             = "attributes.subject"
+	samlFullNameField            = "attributes.full_name"
+	samlEmailField               = "attributes.email"
+	samlAssertionAttributesField = "attributes.assertion_attributes"
+
 	domain = "auth"
 )
 
@@ -93,6 +107,18 @@ var (
 			action.Update,
 			action.Delete,
 		),
+		jwt.Subtype: action.NewActionSet(
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+		),
+		saml.Subtype: action.NewActionSet(
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+		),
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -123,13 +149,15 @@ type Service struct {
 	pwRepoFn    common.PasswordAuthRepoFactory
 	oidcRepoFn  common.OidcAuthRepoFactory
 	ldapRepoFn  common.LdapAuthRepoFactory
+	jwtRepoFn   common.JwtAuthRepoFactory
+	samlRepoFn  common.SamlAuthRepoFactory
 	maxPageSize uint
 }
 
 var _ pbs.AccountServiceServer = (*Service)(nil)
 
 // NewService returns a account service which handles account related requests to boundary.
-func NewService(ctx context.Context, pwRepo common.PasswordAuthRepoFactory, oidcRepo common.OidcAuthRepoFactory, ldapRepo common.LdapAuthRepoFactory, maxPageSize uint) (Service, error) {
+func NewService(ctx context.Context, pwRepo common.PasswordAuthRepoFactory, oidcRepo common.OidcAuthRepoFactory, ldapRepo common.LdapAuthRepoFactory, jwtRepo common.JwtAuthRepoFactory, samlRepo common.SamlAuthRepoFactory, maxPageSize uint) (Service, error) {
 	const op = "accounts.NewService"
 	switch {
 	case pwRepo == nil:
@@ -138,11 +166,15 @@ func NewService(ctx context.Context, pwRepo common.PasswordAuthRepoFactory, oidc
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing oidc repository")
 	case ldapRepo == nil:
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing ldap repository")
+	case jwtRepo == nil:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing jwt repository")
+	case samlRepo == nil:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing saml repository")
 	}
 	if maxPageSize == 0 {
 		maxPageSize = uint(globals.DefaultMaxPageSize)
 	}
-	return Service{pwRepoFn: pwRepo, oidcRepoFn: oidcRepo, ldapRepoFn: ldapRepo, maxPageSize: maxPageSize}, nil
+	return Service{pwRepoFn: pwRepo, oidcRepoFn: oidcRepo, ldapRepoFn: ldapRepo, jwtRepoFn: jwtRepo, samlRepoFn: samlRepo, maxPageSize: maxPageSize}, nil
 }
 
 // ListAccounts implements the interface pbs.AccountServiceServer.
@@ -283,6 +315,84 @@ func (s Service) ListAccounts(ctx context.Context, req *pbs.ListAccountsRequest)
 				return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "unexpected list token subtype: %T", st)
 			}
 		}
+	case jwt.Subtype:
+		repo, err := s.jwtRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if req.GetListToken() == "" {
+			sortBy = "created_time"
+			listResp, err = jwt.ListAccounts(ctx, grantsHash, pageSize, filterItemFn, repo, authMethodId)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			listToken, err := handlers.ParseListToken(ctx, req.GetListToken(), resource.Account, grantsHash)
+			if err != nil {
+				return nil, err
+			}
+			switch st := listToken.Subtype.(type) {
+			case *listtoken.PaginationToken:
+				sortBy = "created_time"
+				listResp, err = jwt.ListAccountsPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, authMethodId)
+				if err != nil {
+					return nil, err
+				}
+			case *listtoken.StartRefreshToken:
+				sortBy = "updated_time"
+				listResp, err = jwt.ListAccountsRefresh(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, authMethodId)
+				if err != nil {
+					return nil, err
+				}
+			case *listtoken.RefreshToken:
+				sortBy = "updated_time"
+				listResp, err = jwt.ListAccountsRefreshPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, authMethodId)
+				if err != nil {
+					return nil, err
+				}
+			default:
+				return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "unexpected list token subtype: %T", st)
+			}
+		}
+	case saml.Subtype:
+		repo, err := s.samlRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if req.GetListToken() == "" {
+			sortBy = "created_time"
+			listResp, err = saml.ListAccounts(ctx, grantsHash, pageSize, filterItemFn, repo, authMethodId)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			listToken, err := handlers.ParseListToken(ctx, req.GetListToken(), resource.Account, grantsHash)
+			if err != nil {
+				return nil, err
+			}
+			switch st := listToken.Subtype.(type) {
+			case *listtoken.PaginationToken:
+				sortBy = "created_time"
+				listResp, err = saml.ListAccountsPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, authMethodId)
+				if err != nil {
+					return nil, err
+				}
+			case *listtoken.StartRefreshToken:
+				sortBy = "updated_time"
+				listResp, err = saml.ListAccountsRefresh(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, authMethodId)
+				if err != nil {
+					return nil, err
+				}
+			case *listtoken.RefreshToken:
+				sortBy = "updated_time"
+				listResp, err = saml.ListAccountsRefreshPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, authMethodId)
+				if err != nil {
+					return nil, err
+				}
+			default:
+				return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "unexpected list token subtype: %T", st)
+			}
+		}
 	case password.Subtype:
 		repo, err := s.pwRepoFn()
 		if err != nil {
@@ -637,6 +747,46 @@ func (s Service) getFromRepo(ctx context.Context, id string) (auth.Account, []st
 			mgIds = append(mgIds, mg.GetManagedGroupId())
 		}
 		acct = a
+	case jwt.Subtype:
+		repo, err := s.jwtRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		a, err := repo.LookupAccount(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return nil, nil, handlers.NotFoundErrorf("Account %q doesn't exist.", id)
+			}
+			return nil, nil, err
+		}
+		mgs, err := repo.ListManagedGroupMembershipsByMember(ctx, a.GetPublicId())
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, mg := range mgs {
+			mgIds = append(mgIds, mg.GetManagedGroupId())
+		}
+		acct = a
+	case saml.Subtype:
+		repo, err := s.samlRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		a, err := repo.LookupAccount(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return nil, nil, handlers.NotFoundErrorf("Account %q doesn't exist.", id)
+			}
+			return nil, nil, err
+		}
+		mgs, err := repo.ListManagedGroupMembershipsByMember(ctx, a.GetPublicId())
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, mg := range mgs {
+			mgIds = append(mgIds, mg.GetManagedGroupId())
+		}
+		acct = a
 	default:
 		return nil, nil, handlers.NotFoundErrorf("Unrecognized id.")
 	}
@@ -912,6 +1062,106 @@ func (s Service) updateLdapInRepo(ctx context.Context, scopeId, amId, id string,
 	return out, nil
 }
 
+func (s Service) updateJwtInRepo(ctx context.Context, scopeId, amId, id string, mask []string, item *pb.Account) (*jwt.Account, error) {
+	const op = "accounts.(Service).updateJwtInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil account.")
+	}
+	u := jwt.AllocAccount()
+	u.PublicId = id
+	u.AuthMethodId = amId
+	if item.GetName() != nil {
+		u.Name = item.GetName().GetValue()
+	}
+	if item.GetDescription() != nil {
+		u.Description = item.GetDescription().GetValue()
+	}
+
+	// none of the attributes fields are updatable, so only the name and
+	// description can be included in the mask.
+	var dbMask []string
+	for _, v := range mask {
+		for _, m := range strings.Split(v, ",") {
+			switch m {
+			case globals.NameField:
+				dbMask = append(dbMask, jwt.NameField)
+			case globals.DescriptionField:
+				dbMask = append(dbMask, jwt.DescriptionField)
+			case globals.VersionField:
+				// no-op
+			default:
+				return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+			}
+		}
+	}
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+
+	repo, err := s.jwtRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateAccount(ctx, scopeId, u, item.GetVersion(), dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update account"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Account %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s Service) updateSamlInRepo(ctx context.Context, scopeId, amId, id string, mask []string, item *pb.Account) (*saml.Account, error) {
+	const op = "accounts.(Service).updateSamlInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil account.")
+	}
+	u := saml.AllocAccount()
+	u.PublicId = id
+	u.AuthMethodId = amId
+	if item.GetName() != nil {
+		u.Name = item.GetName().GetValue()
+	}
+	if item.GetDescription() != nil {
+		u.Description = item.GetDescription().GetValue()
+	}
+
+	// none of the attributes fields are updatable, so only the name and
+	// description can be included in the mask.
+	var dbMask []string
+	for _, v := range mask {
+		for _, m := range strings.Split(v, ",") {
+			switch m {
+			case globals.NameField:
+				dbMask = append(dbMask, saml.NameField)
+			case globals.DescriptionField:
+				dbMask = append(dbMask, saml.DescriptionField)
+			case globals.VersionField:
+				// no-op
+			default:
+				return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+			}
+		}
+	}
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+
+	repo, err := s.samlRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateAccount(ctx, scopeId, u, item.GetVersion(), dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update account"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Account %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
 func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string, req *pbs.UpdateAccountRequest) (auth.Account, error) {
 	const op = "accounts.(Service).updateInRepo"
 	var out auth.Account
@@ -943,6 +1193,24 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string,
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update account but no error returned from repository.")
 		}
 		out = a
+	case jwt.Subtype:
+		a, err := s.updateJwtInRepo(ctx, scopeId, authMethodId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if a == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update account but no error returned from repository.")
+		}
+		out = a
+	case saml.Subtype:
+		a, err := s.updateSamlInRepo(ctx, scopeId, authMethodId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if a == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update account but no error returned from repository.")
+		}
+		out = a
 	}
 	return out, nil
 }
@@ -970,6 +1238,18 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 			return false, iErr
 		}
 		rows, err = repo.DeleteAccount(ctx, id)
+	case jwt.Subtype:
+		repo, iErr := s.jwtRepoFn()
+		if iErr != nil {
+			return false, iErr
+		}
+		rows, err = repo.DeleteAccount(ctx, scopeId, id)
+	case saml.Subtype:
+		repo, iErr := s.samlRepoFn()
+		if iErr != nil {
+			return false, iErr
+		}
+		rows, err = repo.DeleteAccount(ctx, scopeId, id)
 	}
 	if err != nil {
 		if errors.IsNotFoundError(err) {
@@ -1044,6 +1324,16 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 		res.Error = err
 		return nil, res
 	}
+	jwtRepo, err := s.jwtRepoFn()
+	if err != nil {
+		res.Error = err
+		return nil, res
+	}
+	samlRepo, err := s.samlRepoFn()
+	if err != nil {
+		res.Error = err
+		return nil, res
+	}
 
 	var parentId string
 	opts := []requestauth.Option{requestauth.WithType(resource.Account), requestauth.WithAction(a)}
@@ -1085,6 +1375,28 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 				return nil, res
 			}
 			parentId = acct.GetAuthMethodId()
+		case jwt.Subtype:
+			acct, err := jwtRepo.LookupAccount(ctx, id)
+			if err != nil {
+				res.Error = err
+				return nil, res
+			}
+			if acct == nil {
+				res.Error = handlers.NotFoundError()
+				return nil, res
+			}
+			parentId = acct.GetAuthMethodId()
+		case saml.Subtype:
+			acct, err := samlRepo.LookupAccount(ctx, id)
+			if err != nil {
+				res.Error = err
+				return nil, res
+			}
+			if acct == nil {
+				res.Error = handlers.NotFoundError()
+				return nil, res
+			}
+			parentId = acct.GetAuthMethodId()
 		}
 		opts = append(opts, requestauth.WithId(id))
 	}
@@ -1124,6 +1436,28 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 			return nil, res
 		}
 		authMeth = am
+	case jwt.Subtype:
+		am, err := jwtRepo.LookupAuthMethod(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if am == nil {
+			res.Error = handlers.NotFoundError()
+			return nil, res
+		}
+		authMeth = am
+	case saml.Subtype:
+		am, err := samlRepo.LookupAuthMethod(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if am == nil {
+			res.Error = handlers.NotFoundError()
+			return nil, res
+		}
+		authMeth = am
 	}
 	opts = append(opts, requestauth.WithScopeId(authMeth.GetScopeId()), requestauth.WithPin(parentId))
 	return authMeth, requestauth.Verify(ctx, opts...)
@@ -1217,6 +1551,16 @@ func toProto(ctx context.Context, in auth.Account, opt ...handlers.Option) (*pb.
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error converting stored userinfo claims to protobuf struct"))
 			}
 		}
+		if s := i.GetAttributes(); s != "" {
+			m := make(map[string]any)
+			var err error
+			if err = json.Unmarshal([]byte(s), &m); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error unmarshaling stored attributes"))
+			}
+			if attrs.OidcAccountAttributes.Attributes, err = structpb.NewStruct(m); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error converting stored attributes to protobuf struct"))
+			}
+		}
 		out.Attrs = attrs
 	case *ldap.Account:
 		if outputFields.Has(globals.TypeField) {
@@ -1241,6 +1585,56 @@ func toProto(ctx context.Context, in auth.Account, opt ...handlers.Option) (*pb.
 			attrs.LdapAccountAttributes.MemberOfGroups = decodedGroups
 		}
 		out.Attrs = attrs
+	case *jwt.Account:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = jwt.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		attrs := &pb.Account_JwtAccountAttributes{
+			JwtAccountAttributes: &pb.JwtAccountAttributes{
+				Subject:  i.GetSubject(),
+				FullName: i.GetFullName(),
+				Email:    i.GetEmail(),
+			},
+		}
+		if s := i.GetTokenClaims(); s != "" {
+			m := make(map[string]any)
+			var err error
+			if err = json.Unmarshal([]byte(s), &m); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error unmarshaling stored token claims"))
+			}
+			if attrs.JwtAccountAttributes.TokenClaims, err = structpb.NewStruct(m); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error converting stored token claims to protobuf struct"))
+			}
+		}
+		out.Attrs = attrs
+	case *saml.Account:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = saml.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		attrs := &pb.Account_SamlAccountAttributes{
+			SamlAccountAttributes: &pb.SamlAccountAttributes{
+				Subject:  i.GetSubject(),
+				FullName: i.GetFullName(),
+				Email:    i.GetEmail(),
+			},
+		}
+		if s := i.GetAssertionAttributes(); s != "" {
+			m := make(map[string]any)
+			var err error
+			if err = json.Unmarshal([]byte(s), &m); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error unmarshaling stored assertion attributes"))
+			}
+			if attrs.SamlAccountAttributes.AssertionAttributes, err = structpb.NewStruct(m); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error converting stored assertion attributes to protobuf struct"))
+			}
+		}
+		out.Attrs = attrs
 	}
 	return &out, nil
 }
@@ -1279,7 +1673,7 @@ func validateGetRequest(ctx context.Context, req *pbs.GetAccountRequest) error {
 	if req == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix, globals.OidcAccountPrefix, globals.LdapAccountPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix, globals.OidcAccountPrefix, globals.LdapAccountPrefix, globals.JwtAccountPrefix, globals.SamlAccountPrefix)
 }
 
 func validateCreateRequest(ctx context.Context, req *pbs.CreateAccountRequest) error {
@@ -1359,6 +1753,10 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateAccountRequest) e
 					badFields[memberOfAttrField] = "This is a read only field."
 				}
 			}
+		case jwt.Subtype:
+			badFields[authMethodIdField] = "JWT accounts are created automatically when a token is first presented, so they cannot be created directly."
+		case saml.Subtype:
+			badFields[authMethodIdField] = "SAML accounts are created automatically when a user first authenticates, so they cannot be created directly."
 		default:
 			badFields[authMethodIdField] = "Unknown auth method type from ID."
 		}
@@ -1413,9 +1811,27 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateAccountRequest) e
 			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), memberOfAttrField) {
 				badFields[memberOfAttrField] = "Field cannot be updated."
 			}
+		case jwt.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != jwt.Subtype.String() {
+				badFields[typeField] = "Cannot modify the resource type."
+			}
+			for _, f := range []string{jwtSubjectField, jwtFullNameField, jwtEmailField, jwtTokenClaimsField} {
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), f) {
+					badFields[f] = "Field is read only."
+				}
+			}
+		case saml.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != saml.Subtype.String() {
+				badFields[typeField] = "Cannot modify the resource type."
+			}
+			for _, f := range []string{samlSubjectField, samlFullNameField, samlEmailField, samlAssertionAttributesField} {
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), f) {
+					badFields[f] = "Field is read only."
+				}
+			}
 		}
 		return badFields
-	}, globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix, globals.OidcAccountPrefix, globals.LdapAccountPrefix)
+	}, globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix, globals.OidcAccountPrefix, globals.LdapAccountPrefix, globals.JwtAccountPrefix, globals.SamlAccountPrefix)
 }
 
 func validateDeleteRequest(ctx context.Context, req *pbs.DeleteAccountRequest) error {
@@ -1423,7 +1839,7 @@ func validateDeleteRequest(ctx context.Context, req *pbs.DeleteAccountRequest) e
 	if req == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix, globals.OidcAccountPrefix, globals.LdapAccountPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix, globals.OidcAccountPrefix, globals.LdapAccountPrefix, globals.JwtAccountPrefix, globals.SamlAccountPrefix)
 }
 
 func validateListRequest(ctx context.Context, req *pbs.ListAccountsRequest) error {
@@ -1432,7 +1848,7 @@ func validateListRequest(ctx context.Context, req *pbs.ListAccountsRequest) erro
 		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix, globals.JwtAuthMethodPrefix, globals.SamlAuthMethodPrefix) {
 		badFields[authMethodIdField] = "Invalid formatted identifier."
 	}
 	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
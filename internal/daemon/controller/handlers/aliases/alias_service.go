@@ -0,0 +1,581 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package aliases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/alias"
+	"github.com/hashicorp/boundary/internal/alias/store"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common/scopeids"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/host/plugin"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/internal/util"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/aliases"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	maskManager handlers.MaskManager
+
+	// IdActions contains the set of actions that can be performed on
+	// individual resources
+	IdActions = action.NewActionSet(
+		action.NoOp,
+		action.Read,
+		action.Update,
+		action.Delete,
+	)
+
+	// CollectionActions contains the set of actions that can be performed on
+	// this collection
+	CollectionActions = action.NewActionSet(
+		action.Create,
+		action.List,
+	)
+)
+
+func init() {
+	var err error
+	if maskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&store.Alias{}},
+		handlers.MaskSource{&pb.Alias{}},
+	); err != nil {
+		panic(err)
+	}
+
+	action.RegisterResource(resource.Alias, IdActions, CollectionActions)
+}
+
+// Service handles request as described by the pbs.AliasServiceServer interface.
+type Service struct {
+	pbs.UnsafeAliasServiceServer
+
+	repoFn    common.AliasRepoFactory
+	iamRepoFn common.IamRepoFactory
+}
+
+var _ pbs.AliasServiceServer = (*Service)(nil)
+
+// NewService returns an alias service which handles alias related requests to boundary.
+func NewService(ctx context.Context, repoFn common.AliasRepoFactory, iamRepoFn common.IamRepoFactory) (Service, error) {
+	const op = "aliases.NewService"
+	if repoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing alias repository")
+	}
+	if iamRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
+	}
+	return Service{repoFn: repoFn, iamRepoFn: iamRepoFn}, nil
+}
+
+// ListAliases implements the interface pbs.AliasServiceServer.
+func (s Service) ListAliases(ctx context.Context, req *pbs.ListAliasesRequest) (*pbs.ListAliasesResponse, error) {
+	if err := validateListRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
+	if authResults.Error != nil {
+		// If it's forbidden, and it's a recursive request, and they're
+		// successfully authenticated but just not authorized, keep going as we
+		// may have authorization on downstream scopes. Or, if they've not
+		// authenticated, still process in case u_anon has permissions.
+		if (authResults.Error == handlers.ForbiddenError() || authResults.Error == handlers.UnauthenticatedError()) &&
+			req.GetRecursive() &&
+			authResults.AuthenticationFinished {
+		} else {
+			return nil, authResults.Error
+		}
+	}
+
+	scopeIds, scopeInfoMap, err := scopeids.GetListingScopeIds(
+		ctx, s.iamRepoFn, authResults, req.GetScopeId(), resource.Alias, req.GetRecursive())
+	if err != nil {
+		return nil, err
+	}
+	// If no scopes match, return an empty response
+	if len(scopeIds) == 0 {
+		return &pbs.ListAliasesResponse{}, nil
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	al, err := repo.ListAliases(ctx, scopeIds, alias.WithLimit(-1))
+	if err != nil {
+		return nil, err
+	}
+	if len(al) == 0 {
+		return &pbs.ListAliasesResponse{}, nil
+	}
+
+	filter, err := handlers.NewFilter(ctx, req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	finalItems := make([]*pb.Alias, 0, len(al))
+	res := perms.Resource{
+		Type: resource.Alias,
+	}
+	for _, item := range al {
+		res.Id = item.GetPublicId()
+		res.ScopeId = item.GetScopeId()
+		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+		if len(authorizedActions) == 0 {
+			continue
+		}
+
+		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+		outputOpts := make([]handlers.Option, 0, 3)
+		outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+		if outputFields.Has(globals.ScopeField) {
+			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+		}
+		if outputFields.Has(globals.AuthorizedActionsField) {
+			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+		}
+
+		item, err := toProto(ctx, item, outputOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if filter.Match(item) {
+			finalItems = append(finalItems, item)
+		}
+	}
+	return &pbs.ListAliasesResponse{Items: finalItems}, nil
+}
+
+// GetAlias implements the interface pbs.AliasServiceServer.
+func (s Service) GetAlias(ctx context.Context, req *pbs.GetAliasRequest) (*pbs.GetAliasResponse, error) {
+	const op = "aliases.(Service).GetAlias"
+
+	if err := validateGetRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	a, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, a.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(ctx, a, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.GetAliasResponse{Item: item}, nil
+}
+
+// CreateAlias implements the interface pbs.AliasServiceServer.
+func (s Service) CreateAlias(ctx context.Context, req *pbs.CreateAliasRequest) (*pbs.CreateAliasResponse, error) {
+	const op = "aliases.(Service).CreateAlias"
+
+	if err := validateCreateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetItem().GetScopeId(), action.Create)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	a, err := s.createInRepo(ctx, req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, a.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(ctx, a, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.CreateAliasResponse{
+		Item: item,
+		Uri:  fmt.Sprintf("aliases/%s", item.GetId()),
+	}, nil
+}
+
+// UpdateAlias implements the interface pbs.AliasServiceServer.
+func (s Service) UpdateAlias(ctx context.Context, req *pbs.UpdateAliasRequest) (*pbs.UpdateAliasResponse, error) {
+	const op = "aliases.(Service).UpdateAlias"
+
+	if err := validateUpdateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Update)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	a, err := s.updateInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, a.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(ctx, a, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.UpdateAliasResponse{Item: item}, nil
+}
+
+// DeleteAlias implements the interface pbs.AliasServiceServer.
+func (s Service) DeleteAlias(ctx context.Context, req *pbs.DeleteAliasRequest) (*pbs.DeleteAliasResponse, error) {
+	if err := validateDeleteRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Delete)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	_, err := s.deleteFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (*alias.Alias, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	a, err := repo.LookupAlias(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		return nil, handlers.NotFoundErrorf("Alias %q doesn't exist.", id)
+	}
+	return a, nil
+}
+
+func (s Service) createInRepo(ctx context.Context, item *pb.Alias) (*alias.Alias, error) {
+	const op = "aliases.(Service).createInRepo"
+	var opts []alias.Option
+	if item.GetName() != nil {
+		opts = append(opts, alias.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, alias.WithDescription(item.GetDescription().GetValue()))
+	}
+	if item.GetDestinationId() != "" {
+		opts = append(opts, alias.WithDestinationId(item.GetDestinationId()))
+	}
+	if item.GetHostId() != "" {
+		opts = append(opts, alias.WithHostId(item.GetHostId()))
+	}
+	a, err := alias.NewAlias(ctx, item.GetScopeId(), item.GetValue(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build alias for creation: %v.", err)
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateAlias(ctx, a)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create alias"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create alias but no error returned from repository.")
+	}
+	return out, nil
+}
+
+func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.Alias) (*alias.Alias, error) {
+	const op = "aliases.(Service).updateInRepo"
+	a := &alias.Alias{Alias: &store.Alias{
+		PublicId:      id,
+		ScopeId:       scopeId,
+		Name:          item.GetName().GetValue(),
+		Description:   item.GetDescription().GetValue(),
+		Value:         item.GetValue(),
+		DestinationId: item.GetDestinationId(),
+		HostId:        item.GetHostId(),
+	}}
+	dbMask := maskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, rowsUpdated, err := repo.UpdateAlias(ctx, a, item.GetVersion(), dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update alias"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Alias %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
+	const op = "aliases.(Service).deleteFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return false, err
+	}
+	rows, err := repo.DeleteAlias(ctx, id)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete alias"))
+	}
+	return rows > 0, nil
+}
+
+func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
+	res := auth.VerifyResults{}
+	repo, err := s.repoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+
+	var parentId string
+	opts := []auth.Option{auth.WithType(resource.Alias), auth.WithAction(a)}
+	switch a {
+	case action.List, action.Create:
+		parentId = id
+	default:
+		al, err := repo.LookupAlias(ctx, id)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if al == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		parentId = al.GetScopeId()
+		opts = append(opts, auth.WithId(id))
+	}
+	opts = append(opts, auth.WithScopeId(parentId))
+	return auth.Verify(ctx, opts...)
+}
+
+func toProto(_ context.Context, in *alias.Alias, opt ...handlers.Option) (*pb.Alias, error) {
+	opts := handlers.GetOpts(opt...)
+	if opts.WithOutputFields == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building alias proto")
+	}
+	outputFields := *opts.WithOutputFields
+
+	out := pb.Alias{}
+	if outputFields.Has(globals.IdField) {
+		out.Id = in.GetPublicId()
+	}
+	if outputFields.Has(globals.ScopeIdField) {
+		out.ScopeId = in.GetScopeId()
+	}
+	if outputFields.Has(globals.ScopeField) {
+		out.Scope = opts.WithScope
+	}
+	if outputFields.Has(globals.NameField) && in.GetName() != "" {
+		out.Name = wrapperspb.String(in.GetName())
+	}
+	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
+		out.Description = wrapperspb.String(in.GetDescription())
+	}
+	if outputFields.Has(globals.CreatedTimeField) {
+		out.CreatedTime = in.GetCreateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.UpdatedTimeField) {
+		out.UpdatedTime = in.GetUpdateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.VersionField) {
+		out.Version = in.GetVersion()
+	}
+	if outputFields.Has(globals.TypeField) {
+		out.Type = alias.TargetType
+	}
+	if outputFields.Has(globals.ValueField) {
+		out.Value = in.GetValue()
+	}
+	if outputFields.Has(globals.DestinationIdField) {
+		out.DestinationId = in.GetDestinationId()
+	}
+	if outputFields.Has(globals.HostIdField) {
+		out.HostId = in.GetHostId()
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		out.AuthorizedActions = opts.WithAuthorizedActions
+	}
+	return &out, nil
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//   - The path passed in is correctly formatted
+//   - All required parameters are set
+//   - There are no conflicting parameters provided
+func validateGetRequest(req *pbs.GetAliasRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.TargetAliasPrefix)
+}
+
+func validateListRequest(ctx context.Context, req *pbs.ListAliasesRequest) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != scope.Global.String() {
+		badFields[globals.ScopeIdField] = "Must be 'global' when listing."
+	}
+	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
+		badFields[globals.FilterField] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateDeleteRequest(req *pbs.DeleteAliasRequest) error {
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.TargetAliasPrefix)
+}
+
+// validateDestination validates the destination and host of an alias
+func validateDestination(item *pb.Alias, badFields map[string]string) {
+	if item.GetDestinationId() != "" && !handlers.ValidId(handlers.Id(item.GetDestinationId()), target.Prefixes()...) {
+		badFields[globals.DestinationIdField] = "Incorrectly formatted identifier."
+	}
+	if item.GetHostId() != "" {
+		switch globals.ResourceInfoFromPrefix(item.GetHostId()).Subtype {
+		case static.Subtype, plugin.Subtype:
+		default:
+			badFields[globals.HostIdField] = "Incorrectly formatted identifier."
+		}
+		if item.GetDestinationId() == "" {
+			badFields[globals.HostIdField] = "A host can only be set along with a destination ID."
+		}
+	}
+}
+
+func validateCreateRequest(req *pbs.CreateAliasRequest) error {
+	item := req.GetItem()
+	if util.IsNil(item) {
+		return handlers.InvalidArgumentErrorf("Request item is nil", nil)
+	}
+	return handlers.ValidateCreateRequest(item, func() map[string]string {
+		badFields := map[string]string{}
+		if item.GetScopeId() != scope.Global.String() {
+			badFields[globals.ScopeIdField] = "Must be 'global'."
+		}
+		if item.GetType() != alias.TargetType {
+			badFields[globals.TypeField] = fmt.Sprintf("Must be %q.", alias.TargetType)
+		}
+		switch value := alias.NormalizeValue(item.GetValue()); {
+		case value == "":
+			badFields[globals.ValueField] = "This field is required."
+		case !alias.ValidValue(value):
+			badFields[globals.ValueField] = "Must be a DNS-style name made of letters, digits, and hyphens, separated by dots."
+		}
+		if !strutil.Printable(item.GetName().GetValue()) {
+			badFields[globals.NameField] = "Contains non-printable characters."
+		}
+		if !strutil.Printable(item.GetDescription().GetValue()) {
+			badFields[globals.DescriptionField] = "Contains non-printable characters."
+		}
+		validateDestination(item, badFields)
+		return badFields
+	})
+}
+
+func validateUpdateRequest(req *pbs.UpdateAliasRequest) error {
+	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
+		badFields := map[string]string{}
+		item := req.GetItem()
+		if item.GetType() != "" {
+			badFields[globals.TypeField] = "This is a read only field."
+		}
+		if handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.ValueField) {
+			switch value := alias.NormalizeValue(item.GetValue()); {
+			case value == "":
+				badFields[globals.ValueField] = "This field cannot be removed."
+			case !alias.ValidValue(value):
+				badFields[globals.ValueField] = "Must be a DNS-style name made of letters, digits, and hyphens, separated by dots."
+			}
+		}
+		if !strutil.Printable(item.GetName().GetValue()) {
+			badFields[globals.NameField] = "Contains non-printable characters."
+		}
+		if !strutil.Printable(item.GetDescription().GetValue()) {
+			badFields[globals.DescriptionField] = "Contains non-printable characters."
+		}
+		if item.GetDestinationId() != "" && !handlers.ValidId(handlers.Id(item.GetDestinationId()), target.Prefixes()...) {
+			badFields[globals.DestinationIdField] = "Incorrectly formatted identifier."
+		}
+		if item.GetHostId() != "" {
+			switch globals.ResourceInfoFromPrefix(item.GetHostId()).Subtype {
+			case static.Subtype, plugin.Subtype:
+			default:
+				badFields[globals.HostIdField] = "Incorrectly formatted identifier."
+			}
+		}
+		return badFields
+	}, globals.TargetAliasPrefix)
+}
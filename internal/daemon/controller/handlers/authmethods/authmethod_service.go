@@ -12,9 +12,11 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/jwt"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/auth/saml"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	requestauth "github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
@@ -30,6 +32,7 @@ import (
 	"github.com/hashicorp/boundary/internal/pagination"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/scim"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -90,6 +93,9 @@ type Service struct {
 	iamRepoFn   common.IamRepoFactory
 	atRepoFn    common.AuthTokenRepoFactory
 	ldapRepoFn  common.LdapAuthRepoFactory
+	jwtRepoFn   common.JwtAuthRepoFactory
+	samlRepoFn  common.SamlAuthRepoFactory
+	scimRepoFn  common.ScimRepoFactory
 	amRepoFn    common.AuthMethodRepoFactory
 	maxPageSize uint
 }
@@ -105,6 +111,9 @@ func NewService(
 	iamRepoFn common.IamRepoFactory,
 	atRepoFn common.AuthTokenRepoFactory,
 	ldapRepoFn common.LdapAuthRepoFactory,
+	jwtRepoFn common.JwtAuthRepoFactory,
+	samlRepoFn common.SamlAuthRepoFactory,
+	scimRepoFn common.ScimRepoFactory,
 	amRepoFn common.AuthMethodRepoFactory,
 	maxPageSize uint,
 	opt ...handlers.Option,
@@ -122,6 +131,15 @@ func NewService(
 	if ldapRepoFn == nil {
 		return Service{}, fmt.Errorf("nil ldap repository provided")
 	}
+	if jwtRepoFn == nil {
+		return Service{}, fmt.Errorf("nil jwt repository provided")
+	}
+	if samlRepoFn == nil {
+		return Service{}, fmt.Errorf("nil saml repository provided")
+	}
+	if scimRepoFn == nil {
+		return Service{}, fmt.Errorf("nil scim repository provided")
+	}
 	if iamRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
 	}
@@ -141,6 +159,9 @@ func NewService(
 		iamRepoFn:   iamRepoFn,
 		atRepoFn:    atRepoFn,
 		ldapRepoFn:  ldapRepoFn,
+		jwtRepoFn:   jwtRepoFn,
+		samlRepoFn:  samlRepoFn,
+		scimRepoFn:  scimRepoFn,
 		amRepoFn:    amRepoFn,
 		maxPageSize: maxPageSize,
 	}
@@ -511,6 +532,60 @@ func (s Service) ChangeState(ctx context.Context, req *pbs.ChangeStateRequest) (
 	return &pbs.ChangeStateResponse{Item: item}, nil
 }
 
+// GenerateScimToken implements the interface pbs.AuthMethodServiceServer.
+func (s Service) GenerateScimToken(ctx context.Context, req *pbs.GenerateScimTokenRequest) (*pbs.GenerateScimTokenResponse, error) {
+	const op = "authmethods.(Service).GenerateScimToken"
+
+	if err := validateScimTokenRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.GenerateScimToken)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.scimRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	tok, err := repo.GenerateToken(ctx, authResults.Scope.GetId(), req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate scim token"))
+	}
+	return &pbs.GenerateScimTokenResponse{
+		Item: &pb.ScimToken{
+			AuthMethodId: tok.AuthMethodId,
+			Token:        tok.Token,
+			ScimPath:     scim.PathPrefix + tok.AuthMethodId,
+			CreatedTime:  tok.CreateTime.GetTimestamp(),
+		},
+	}, nil
+}
+
+// RevokeScimToken implements the interface pbs.AuthMethodServiceServer.
+func (s Service) RevokeScimToken(ctx context.Context, req *pbs.RevokeScimTokenRequest) (*pbs.RevokeScimTokenResponse, error) {
+	const op = "authmethods.(Service).RevokeScimToken"
+
+	if err := validateScimTokenRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RevokeScimToken)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.scimRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	rows, err := repo.RevokeToken(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to revoke scim token"))
+	}
+	if rows == 0 {
+		return nil, handlers.NotFoundErrorf("Auth method %q has no SCIM token.", req.GetId())
+	}
+	return &pbs.RevokeScimTokenResponse{}, nil
+}
+
 // DeleteAuthMethod implements the interface pbs.AuthMethodServiceServer.
 func (s Service) DeleteAuthMethod(ctx context.Context, req *pbs.DeleteAuthMethodRequest) (*pbs.DeleteAuthMethodResponse, error) {
 	if err := validateDeleteRequest(ctx, req); err != nil {
@@ -547,6 +622,14 @@ func (s Service) Authenticate(ctx context.Context, req *pbs.AuthenticateRequest)
 		if err := validateAuthenticateLdapRequest(req); err != nil {
 			return nil, err
 		}
+	case jwt.Subtype:
+		if err := validateAuthenticateJwtRequest(req); err != nil {
+			return nil, err
+		}
+	case saml.Subtype:
+		if err := validateAuthenticateSamlRequest(req); err != nil {
+			return nil, err
+		}
 	}
 
 	authResults := s.authResult(ctx, req.GetAuthMethodId(), action.Authenticate)
@@ -562,6 +645,10 @@ func (s Service) Authenticate(ctx context.Context, req *pbs.AuthenticateRequest)
 		return s.authenticateOidc(ctx, req, &authResults)
 	case ldap.Subtype:
 		return s.authenticateLdap(ctx, req, &authResults)
+	case jwt.Subtype:
+		return s.authenticateJwt(ctx, req, &authResults)
+	case saml.Subtype:
+		return s.authenticateSaml(ctx, req, &authResults)
 	}
 	return nil, errors.New(ctx, errors.Internal, op, "Invalid auth method subtype not caught in validation function.")
 }
@@ -591,6 +678,20 @@ func (s Service) getFromRepo(ctx context.Context, id string) (auth.AuthMethod, e
 		}
 		am, lookupErr = repo.LookupAuthMethod(ctx, id)
 
+	case jwt.Subtype:
+		repo, err := s.jwtRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		am, lookupErr = repo.LookupAuthMethod(ctx, id)
+
+	case saml.Subtype:
+		repo, err := s.samlRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		am, lookupErr = repo.LookupAuthMethod(ctx, id)
+
 	default:
 		return nil, handlers.NotFoundErrorf("Unrecognized id.")
 	}
@@ -639,6 +740,24 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Auth
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create auth method but no error returned from repository.")
 		}
 		out = am
+	case jwt.Subtype.String():
+		am, err := s.createJwtInRepo(ctx, scopeId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create auth method but no error returned from repository.")
+		}
+		out = am
+	case saml.Subtype.String():
+		am, err := s.createSamlInRepo(ctx, scopeId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create auth method but no error returned from repository.")
+		}
+		out = am
 	}
 	return out, nil
 }
@@ -688,6 +807,42 @@ func (s Service) updateInRepo(ctx context.Context, scopeId string, req *pbs.Upda
 			return nil, false, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update auth method but no error returned from repository.")
 		}
 		am = lam
+
+	case jwt.Subtype:
+		jam, err := s.updateJwtInRepo(ctx, scopeId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			_, apiErr := err.(*handlers.ApiError)
+			switch {
+			case apiErr:
+				return nil, false, err
+			case errors.Match(errors.T(errors.InvalidParameter), err):
+				return nil, false, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, err.Error())
+			default:
+				return nil, false, errors.Wrap(ctx, err, op)
+			}
+		}
+		if jam == nil {
+			return nil, false, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update auth method but no error returned from repository.")
+		}
+		am = jam
+
+	case saml.Subtype:
+		sam, err := s.updateSamlInRepo(ctx, scopeId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			_, apiErr := err.(*handlers.ApiError)
+			switch {
+			case apiErr:
+				return nil, false, err
+			case errors.Match(errors.T(errors.InvalidParameter), err):
+				return nil, false, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, err.Error())
+			default:
+				return nil, false, errors.Wrap(ctx, err, op)
+			}
+		}
+		if sam == nil {
+			return nil, false, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update auth method but no error returned from repository.")
+		}
+		am = sam
 	}
 
 	return am, dryRun, nil
@@ -717,6 +872,18 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 			return false, errors.Wrap(ctx, err, op)
 		}
 		rows, dErr = repo.DeleteAuthMethod(ctx, id)
+	case jwt.Subtype:
+		repo, err := s.jwtRepoFn()
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		rows, dErr = repo.DeleteAuthMethod(ctx, id)
+	case saml.Subtype:
+		repo, err := s.samlRepoFn()
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		rows, dErr = repo.DeleteAuthMethod(ctx, id)
 	default:
 		return false, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid auth method subtype: %q", globals.ResourceInfoFromPrefix(id).Subtype.String()))
 	}
@@ -842,6 +1009,38 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) reque
 				return res
 			}
 			authMeth = am
+		case jwt.Subtype:
+			repo, err := s.jwtRepoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			am, err := repo.LookupAuthMethod(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if am == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			authMeth = am
+		case saml.Subtype:
+			repo, err := s.samlRepoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			am, err := repo.LookupAuthMethod(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if am == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			authMeth = am
 		default:
 			res.Error = errors.New(ctx, errors.InvalidPublicId, op, "unrecognized auth method type")
 			return res
@@ -1005,6 +1204,34 @@ func toAuthMethodProto(ctx context.Context, in auth.AuthMethod, opt ...handlers.
 		out.Attrs = &pb.AuthMethod_LdapAuthMethodsAttributes{
 			LdapAuthMethodsAttributes: attrs,
 		}
+	case *jwt.AuthMethod:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = jwt.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		attrs, err := toJwtAuthMethodAttributes(ctx, i)
+		if err != nil {
+			return nil, err
+		}
+		out.Attrs = &pb.AuthMethod_JwtAuthMethodsAttributes{
+			JwtAuthMethodsAttributes: attrs,
+		}
+	case *saml.AuthMethod:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = saml.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		attrs, err := toSamlAuthMethodAttributes(ctx, i)
+		if err != nil {
+			return nil, err
+		}
+		out.Attrs = &pb.AuthMethod_SamlAuthMethodsAttributes{
+			SamlAuthMethodsAttributes: attrs,
+		}
 	}
 	return &out, nil
 }
@@ -1033,7 +1260,7 @@ func validateGetRequest(ctx context.Context, req *pbs.GetAuthMethodRequest) erro
 	if req == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "Missing request")
 	}
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix, globals.JwtAuthMethodPrefix, globals.SamlAuthMethodPrefix)
 }
 
 func validateCreateRequest(ctx context.Context, req *pbs.CreateAuthMethodRequest) error {
@@ -1148,6 +1375,32 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateAuthMethodRequest
 				badFields[urlsField] = "At least one URL is required"
 			}
 			validateLdapAttributes(ctx, req.GetItem().GetLdapAuthMethodsAttributes(), badFields)
+		case jwt.Subtype.String():
+			attrs := req.GetItem().GetJwtAuthMethodsAttributes()
+			if attrs == nil {
+				badFields[attributesField] = "Attributes are required for creating a JWT auth method."
+				break
+			}
+			if attrs.GetIssuer().GetValue() == "" {
+				badFields[issuerField] = "Field required for creating a JWT auth method."
+			}
+			if attrs.GetJwksUrl().GetValue() == "" && len(attrs.GetJwtValidationPublicKeys()) == 0 {
+				badFields[jwksUrlField] = fmt.Sprintf("Either this field or %s is required for creating a JWT auth method.", jwtValidationPublicKeysField)
+			}
+			validateJwtAttributes(ctx, attrs, badFields)
+		case saml.Subtype.String():
+			attrs := req.GetItem().GetSamlAuthMethodsAttributes()
+			if attrs == nil {
+				badFields[attributesField] = "Attributes are required for creating a SAML auth method."
+				break
+			}
+			if attrs.GetApiUrlPrefix().GetValue() == "" {
+				badFields[apiUrlPrefixField] = "Field required for creating a SAML auth method."
+			}
+			if attrs.GetIdpMetadataXml().GetValue() == "" {
+				badFields[idpMetadataXmlField] = "Field required for creating a SAML auth method."
+			}
+			validateSamlAttributes(ctx, attrs, badFields)
 		default:
 			badFields[typeField] = fmt.Sprintf("This is a required field and must be %q.", password.Subtype.String())
 		}
@@ -1293,11 +1546,21 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateAuthMethodRequest
 				badFields[typeField] = "Cannot modify the resource type."
 			}
 			validateLdapAttributes(ctx, req.GetItem().GetLdapAuthMethodsAttributes(), badFields)
+		case jwt.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != jwt.Subtype.String() {
+				badFields[typeField] = "Cannot modify the resource type."
+			}
+			validateJwtAttributes(ctx, req.GetItem().GetJwtAuthMethodsAttributes(), badFields)
+		case saml.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != saml.Subtype.String() {
+				badFields[typeField] = "Cannot modify the resource type."
+			}
+			validateSamlAttributes(ctx, req.GetItem().GetSamlAuthMethodsAttributes(), badFields)
 		default:
 			badFields["id"] = "Incorrectly formatted identifier."
 		}
 		return badFields
-	}, globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix)
+	}, globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix, globals.JwtAuthMethodPrefix, globals.SamlAuthMethodPrefix)
 }
 
 func validateDeleteRequest(ctx context.Context, req *pbs.DeleteAuthMethodRequest) error {
@@ -1305,7 +1568,7 @@ func validateDeleteRequest(ctx context.Context, req *pbs.DeleteAuthMethodRequest
 	if req == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "Missing request")
 	}
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix, globals.JwtAuthMethodPrefix, globals.SamlAuthMethodPrefix)
 }
 
 func validateListRequest(ctx context.Context, req *pbs.ListAuthMethodsRequest) error {
@@ -1327,6 +1590,14 @@ func validateListRequest(ctx context.Context, req *pbs.ListAuthMethodsRequest) e
 	return nil
 }
 
+func validateScimTokenRequest(ctx context.Context, req handlers.GetRequest) error {
+	const op = "authmethod.validateScimTokenRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "Missing request")
+	}
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix, globals.JwtAuthMethodPrefix, globals.SamlAuthMethodPrefix)
+}
+
 func validateChangeStateRequest(ctx context.Context, req *pbs.ChangeStateRequest) error {
 	const op = "authmethod.validateChangeStateRequest"
 	if req == nil {
@@ -1369,7 +1640,7 @@ func validateAuthenticateRequest(ctx context.Context, req *pbs.AuthenticateReque
 	} else {
 		st := globals.ResourceInfoFromPrefix(req.GetAuthMethodId()).Subtype
 		switch st {
-		case password.Subtype, oidc.Subtype, ldap.Subtype:
+		case password.Subtype, oidc.Subtype, ldap.Subtype, jwt.Subtype, saml.Subtype:
 		default:
 			badFields[authMethodIdField] = "Unknown auth method type."
 		}
@@ -1517,6 +1788,43 @@ func transformAuthenticateRequestAttributes(ctx context.Context, msg proto.Messa
 		authRequest.Attrs = &pbs.AuthenticateRequest_LdapLoginAttributes{
 			LdapLoginAttributes: newAttrs,
 		}
+	case jwt.Subtype:
+		newAttrs := &pbs.JwtLoginAttributes{}
+		if err := handlers.StructToProto(attrs, newAttrs); err != nil {
+			return err
+		}
+		authRequest.Attrs = &pbs.AuthenticateRequest_JwtLoginAttributes{
+			JwtLoginAttributes: newAttrs,
+		}
+	case saml.Subtype:
+		switch authRequest.GetCommand() {
+		case startCommand:
+			newAttrs := &pbs.SamlStartAttributes{}
+			if err := handlers.StructToProto(attrs, newAttrs); err != nil {
+				return err
+			}
+			authRequest.Attrs = &pbs.AuthenticateRequest_SamlStartAttributes{
+				SamlStartAttributes: newAttrs,
+			}
+		case callbackCommand:
+			newAttrs := &pb.SamlAuthMethodAuthenticateCallbackRequest{}
+			if err := handlers.StructToProto(attrs, newAttrs, handlers.WithDiscardUnknownFields(true)); err != nil {
+				return err
+			}
+			authRequest.Attrs = &pbs.AuthenticateRequest_SamlAuthMethodAuthenticateCallbackRequest{
+				SamlAuthMethodAuthenticateCallbackRequest: newAttrs,
+			}
+		case tokenCommand:
+			newAttrs := &pb.SamlAuthMethodAuthenticateTokenRequest{}
+			if err := handlers.StructToProto(attrs, newAttrs); err != nil {
+				return err
+			}
+			authRequest.Attrs = &pbs.AuthenticateRequest_SamlAuthMethodAuthenticateTokenRequest{
+				SamlAuthMethodAuthenticateTokenRequest: newAttrs,
+			}
+		default:
+			return fmt.Errorf("%s: unknown command %q", op, authRequest.GetCommand())
+		}
 	default:
 		return &subtypes.UnknownSubtypeIDError{
 			ID: authRequest.GetAuthMethodId(),
@@ -1561,6 +1869,21 @@ func transformAuthenticateResponseAttributes(ctx context.Context, msg proto.Mess
 		if err != nil {
 			return err
 		}
+	case *pbs.AuthenticateResponse_SamlAuthMethodAuthenticateStartResponse:
+		newAttrs, err = handlers.ProtoToStruct(ctx, attrs.SamlAuthMethodAuthenticateStartResponse)
+		if err != nil {
+			return err
+		}
+	case *pbs.AuthenticateResponse_SamlAuthMethodAuthenticateCallbackResponse:
+		newAttrs, err = handlers.ProtoToStruct(ctx, attrs.SamlAuthMethodAuthenticateCallbackResponse)
+		if err != nil {
+			return err
+		}
+	case *pbs.AuthenticateResponse_SamlAuthMethodAuthenticateTokenResponse:
+		newAttrs, err = handlers.ProtoToStruct(ctx, attrs.SamlAuthMethodAuthenticateTokenResponse)
+		if err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("%s: unknown attributes type %T", op, attrs)
 	}
@@ -0,0 +1,375 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package authmethods
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/jwt"
+	jwtstore "github.com/hashicorp/boundary/internal/auth/jwt/store"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/types/action"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
+	capjwt "github.com/hashicorp/cap/jwt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var jwtMaskManager handlers.MaskManager
+
+func init() {
+	var err error
+	if jwtMaskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{
+			&jwtstore.AuthMethod{},
+		},
+		handlers.MaskSource{&pb.AuthMethod{}, &pb.JwtAuthMethodAttributes{}},
+	); err != nil {
+		panic(err)
+	}
+
+	IdActions[jwt.Subtype] = action.NewActionSet(
+		action.NoOp,
+		action.Read,
+		action.Update,
+		action.Delete,
+		action.Authenticate,
+		action.GenerateScimToken,
+		action.RevokeScimToken,
+	)
+}
+
+const (
+	jwksUrlField                 = "attributes.jwks_url"
+	jwksCaCertsField             = "attributes.jwks_ca_certs"
+	jwtValidationPublicKeysField = "attributes.jwt_validation_public_keys"
+	boundClaimsField             = "attributes.bound_claims"
+	subjectClaimField            = "attributes.subject_claim"
+	tokenField                   = "attributes.token"
+)
+
+func (s Service) authenticateJwt(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticateJwt"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
+	}
+	if authResults == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil auth results.")
+	}
+	reqAttrs := req.GetJwtLoginAttributes()
+
+	jwtRepo, err := s.jwtRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	jwtFn := func() (jwt.Authenticator, error) {
+		return jwtRepo, nil
+	}
+
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	iamFn := func() (jwt.LookupUser, error) {
+		return iamRepo, nil
+	}
+
+	atRepo, err := s.atRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	atFn := func() (jwt.AuthTokenCreator, error) {
+		return atRepo, nil
+	}
+
+	rawTk, err := jwt.Authenticate(ctx, jwtFn, iamFn, atFn, req.GetAuthMethodId(), reqAttrs.GetToken())
+	if err != nil {
+		// let's not send back too much info about the error
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+	}
+	tk, err := s.ConvertInternalAuthTokenToApiAuthToken(
+		ctx,
+		rawTk,
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	return s.convertToAuthenticateResponse(ctx, req, authResults, tk)
+}
+
+// createJwtInRepo creates a jwt auth method in a repo and returns the result.
+// This method should never return a nil AuthMethod without returning an error.
+func (s Service) createJwtInRepo(ctx context.Context, scopeId string, item *pb.AuthMethod) (*jwt.AuthMethod, error) {
+	u, err := toStorageJwtAuthMethod(ctx, scopeId, item)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.jwtRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateAuthMethod(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create auth method: %w", err)
+	}
+	return out, nil
+}
+
+func (s Service) updateJwtInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.AuthMethod) (*jwt.AuthMethod, error) {
+	u, err := toStorageJwtAuthMethodUpdate(ctx, scopeId, item)
+	if err != nil {
+		return nil, err
+	}
+
+	version := item.GetVersion()
+	u.PublicId = id
+
+	dbMask := jwtMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+
+	repo, err := s.jwtRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, rowsUpdated, err := repo.UpdateAuthMethod(ctx, u, version, dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update auth method: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist or incorrect version provided or no changes were made to the existing AuthMethod.", id)
+	}
+	return out, nil
+}
+
+// toStorageJwtAuthMethod converts the api auth method into a jwt auth method
+// which is validated as a whole, so it can only be used when creating.
+func toStorageJwtAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMethod) (out *jwt.AuthMethod, err error) {
+	const op = "authmethod_service.toStorageJwtAuthMethod"
+	if in == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil auth method.")
+	}
+	attrs := in.GetJwtAuthMethodsAttributes()
+
+	var opts []jwt.Option
+	if in.GetName() != nil {
+		opts = append(opts, jwt.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, jwt.WithDescription(in.GetDescription().GetValue()))
+	}
+	if attrs.GetState() != "" {
+		opts = append(opts, jwt.WithOperationalState(jwt.AuthMethodState(attrs.GetState())))
+	}
+	if attrs.GetJwksUrl().GetValue() != "" {
+		u, err := url.Parse(attrs.GetJwksUrl().GetValue())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse %q into a url", attrs.GetJwksUrl().GetValue()))
+		}
+		opts = append(opts, jwt.WithJwksUrl(u))
+	}
+	if len(attrs.GetJwksCaCerts()) > 0 {
+		opts = append(opts, jwt.WithJwksCaCerts(attrs.GetJwksCaCerts()...))
+	}
+	if len(attrs.GetJwtValidationPublicKeys()) > 0 {
+		opts = append(opts, jwt.WithPublicKeys(attrs.GetJwtValidationPublicKeys()...))
+	}
+	if len(attrs.GetBoundAudiences()) > 0 {
+		opts = append(opts, jwt.WithBoundAudiences(attrs.GetBoundAudiences()...))
+	}
+	if len(attrs.GetSigningAlgorithms()) > 0 {
+		algs := make([]jwt.Alg, 0, len(attrs.GetSigningAlgorithms()))
+		for _, a := range attrs.GetSigningAlgorithms() {
+			algs = append(algs, jwt.Alg(a))
+		}
+		opts = append(opts, jwt.WithSigningAlgs(algs...))
+	}
+	if len(attrs.GetBoundClaims().GetFields()) > 0 {
+		opts = append(opts, jwt.WithBoundClaims(attrs.GetBoundClaims().AsMap()))
+	}
+	if attrs.GetSubjectClaim().GetValue() != "" {
+		opts = append(opts, jwt.WithSubjectClaim(attrs.GetSubjectClaim().GetValue()))
+	}
+	if attrs.GetFullNameClaim().GetValue() != "" {
+		opts = append(opts, jwt.WithFullNameClaim(attrs.GetFullNameClaim().GetValue()))
+	}
+	if attrs.GetEmailClaim().GetValue() != "" {
+		opts = append(opts, jwt.WithEmailClaim(attrs.GetEmailClaim().GetValue()))
+	}
+	u, err := jwt.NewAuthMethod(ctx, scopeId, attrs.GetIssuer().GetValue(), opts...)
+	if err != nil {
+		switch {
+		case errors.Match(errors.T(errors.InvalidParameter), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, err.Error())
+		default:
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method: %v.", err)
+		}
+	}
+	return u, nil
+}
+
+// toStorageJwtAuthMethodUpdate converts the api auth method into a jwt auth
+// method without validating it as a whole, since an update request only
+// carries the fields being changed. The repository validates the auth method
+// once the changes have been applied.
+func toStorageJwtAuthMethodUpdate(ctx context.Context, scopeId string, in *pb.AuthMethod) (*jwt.AuthMethod, error) {
+	const op = "authmethod_service.toStorageJwtAuthMethodUpdate"
+	if in == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil auth method.")
+	}
+	attrs := in.GetJwtAuthMethodsAttributes()
+
+	u := jwt.AllocAuthMethod()
+	u.ScopeId = scopeId
+	u.Name = in.GetName().GetValue()
+	u.Description = in.GetDescription().GetValue()
+	u.OperationalState = attrs.GetState()
+	u.Issuer = attrs.GetIssuer().GetValue()
+	u.JwksUrl = attrs.GetJwksUrl().GetValue()
+	u.SubjectClaim = attrs.GetSubjectClaim().GetValue()
+	u.FullNameClaim = attrs.GetFullNameClaim().GetValue()
+	u.EmailClaim = attrs.GetEmailClaim().GetValue()
+	u.JwksCaCerts = attrs.GetJwksCaCerts()
+	u.PublicKeys = attrs.GetJwtValidationPublicKeys()
+	u.BoundAudiences = attrs.GetBoundAudiences()
+	u.SigningAlgorithms = attrs.GetSigningAlgorithms()
+	if len(attrs.GetBoundClaims().GetFields()) > 0 {
+		b, err := json.Marshal(attrs.GetBoundClaims().AsMap())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		u.BoundClaims = string(b)
+	}
+	return &u, nil
+}
+
+func toJwtAuthMethodAttributes(ctx context.Context, in *jwt.AuthMethod) (*pb.JwtAuthMethodAttributes, error) {
+	const op = "authmethod_service.toJwtAuthMethodAttributes"
+	attrs := &pb.JwtAuthMethodAttributes{
+		State:  in.GetOperationalState(),
+		Issuer: wrapperspb.String(in.GetIssuer()),
+	}
+	if in.GetJwksUrl() != "" {
+		attrs.JwksUrl = wrapperspb.String(in.GetJwksUrl())
+	}
+	if in.GetSubjectClaim() != "" {
+		attrs.SubjectClaim = wrapperspb.String(in.GetSubjectClaim())
+	}
+	if in.GetFullNameClaim() != "" {
+		attrs.FullNameClaim = wrapperspb.String(in.GetFullNameClaim())
+	}
+	if in.GetEmailClaim() != "" {
+		attrs.EmailClaim = wrapperspb.String(in.GetEmailClaim())
+	}
+	attrs.JwksCaCerts = in.GetJwksCaCerts()
+	attrs.JwtValidationPublicKeys = in.GetPublicKeys()
+	attrs.BoundAudiences = in.GetBoundAudiences()
+	attrs.SigningAlgorithms = in.GetSigningAlgorithms()
+	claims, err := in.BoundClaimMap()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(claims) > 0 {
+		if attrs.BoundClaims, err = structpb.NewStruct(claims); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	return attrs, nil
+}
+
+// validateJwtAttributes implements a handlers.CustomValidatorFunc(...) to be
+// used when validating requests with jwt attributes.
+func validateJwtAttributes(ctx context.Context, attrs *pb.JwtAuthMethodAttributes, badFields map[string]string) {
+	if attrs == nil {
+		return
+	}
+	if attrs.GetState() != "" {
+		switch jwt.AuthMethodState(attrs.GetState()) {
+		case jwt.InactiveState, jwt.ActivePrivateState, jwt.ActivePublicState:
+		default:
+			badFields[stateField] = fmt.Sprintf("Unknown state %q.", attrs.GetState())
+		}
+	}
+	if attrs.GetJwksUrl().GetValue() != "" {
+		if len(attrs.GetJwtValidationPublicKeys()) > 0 {
+			badFields[jwtValidationPublicKeysField] = fmt.Sprintf("Cannot be set along with %s.", jwksUrlField)
+		}
+		u, err := url.Parse(attrs.GetJwksUrl().GetValue())
+		switch {
+		case err != nil:
+			badFields[jwksUrlField] = fmt.Sprintf("%q is not a valid url.", attrs.GetJwksUrl().GetValue())
+		case u.Scheme != "http" && u.Scheme != "https":
+			badFields[jwksUrlField] = fmt.Sprintf("%s scheme in url %q is not either http or https.", u.Scheme, u.String())
+		}
+	}
+	if attrs.GetIssuer() != nil && strings.TrimSpace(attrs.GetIssuer().GetValue()) == "" {
+		badFields[issuerField] = "Cannot be empty."
+	}
+	if attrs.GetSubjectClaim() != nil && strings.TrimSpace(attrs.GetSubjectClaim().GetValue()) == "" {
+		badFields[subjectClaimField] = "Cannot be empty."
+	}
+	for _, k := range attrs.GetJwtValidationPublicKeys() {
+		if _, err := capjwt.ParsePublicKeyPEM([]byte(k)); err != nil {
+			badFields[jwtValidationPublicKeysField] = fmt.Sprintf("Unable to parse public key: %s.", err.Error())
+			break
+		}
+	}
+	for _, a := range attrs.GetSigningAlgorithms() {
+		if err := capjwt.SupportedSigningAlgorithm(capjwt.Alg(a)); err != nil {
+			badFields[signingAlgorithmField] = fmt.Sprintf("Unsupported signing algorithm %q.", a)
+			break
+		}
+	}
+	for k := range attrs.GetBoundClaims().GetFields() {
+		if strings.TrimSpace(k) == "" {
+			badFields[boundClaimsField] = "Claim names cannot be empty."
+			break
+		}
+	}
+}
+
+func validateAuthenticateJwtRequest(req *pbs.AuthenticateRequest) error {
+	badFields := make(map[string]string)
+
+	attrs := req.GetJwtLoginAttributes()
+	switch {
+	case attrs == nil:
+		badFields["attributes"] = "This is a required field."
+	default:
+		if attrs.Token == "" {
+			badFields[tokenField] = "This is a required field."
+		}
+		if req.GetCommand() == "" {
+			req.Command = loginCommand
+		}
+		if req.Command != loginCommand {
+			badFields[commandField] = "Invalid command for this auth method type."
+		}
+		tokenType := req.GetType()
+		if tokenType == "" {
+			// Fall back to deprecated field if type is not set
+			tokenType = req.GetTokenType() //nolint:all
+		}
+		tType := strings.ToLower(strings.TrimSpace(tokenType))
+		if tType != "" && tType != "token" && tType != "cookie" {
+			badFields[tokenTypeField] = `The only accepted types are "token" and "cookie".`
+		}
+	}
+
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	oidcstore "github.com/hashicorp/boundary/internal/auth/oidc/store"
+	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -64,6 +65,8 @@ func init() {
 		action.Delete,
 		action.ChangeState,
 		action.Authenticate,
+		action.GenerateScimToken,
+		action.RevokeScimToken,
 	)
 }
 
@@ -171,8 +174,12 @@ func (s Service) authenticateOidcStart(ctx context.Context, req *pbs.Authenticat
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
 	}
 
-	var opts []oidc.Option
 	attrs := req.GetOidcStartAttributes()
+	if attrs.GetDeviceFlow() {
+		return s.authenticateOidcStartDevice(ctx, req)
+	}
+
+	var opts []oidc.Option
 	if attrs.GetCachedRoundtripPayload() != "" {
 		opts = append(opts, oidc.WithRoundtripPayload(attrs.GetCachedRoundtripPayload()))
 	}
@@ -196,6 +203,34 @@ func (s Service) authenticateOidcStart(ctx context.Context, req *pbs.Authenticat
 	}, nil
 }
 
+func (s Service) authenticateOidcStartDevice(ctx context.Context, req *pbs.AuthenticateRequest) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticateOidcStartDevice"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
+	}
+
+	da, tokenId, err := oidc.StartDeviceAuth(ctx, s.oidcRepoFn, req.GetAuthMethodId())
+	if err != nil {
+		// this event.WriteError(...) may cause a dup error to be emitted...
+		// it should be removed if that's the case.
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error starting the oidc device authorization flow"))
+		return nil, errors.New(ctx, errors.Internal, op, "Error starting the OIDC device authorization flow. See the controller's log for more information.")
+	}
+
+	return &pbs.AuthenticateResponse{
+		Command: req.GetCommand(),
+		Attrs: &pbs.AuthenticateResponse_OidcAuthMethodAuthenticateStartResponse{
+			OidcAuthMethodAuthenticateStartResponse: &pb.OidcAuthMethodAuthenticateStartResponse{
+				TokenId:                 tokenId,
+				UserCode:                da.UserCode,
+				VerificationUri:         da.VerificationUri,
+				VerificationUriComplete: da.VerificationUriComplete,
+				Interval:                uint32(da.Interval.Seconds()),
+			},
+		},
+	}, nil
+}
+
 // authenticateOidcCallback behaves differently than other service methods.
 // Because of the way it this is called by the end user, it should only return
 // an error if we are unable to lookup the auth method or the request
@@ -292,7 +327,13 @@ func (s Service) authenticateOidcToken(ctx context.Context, req *pbs.Authenticat
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "Empty token ID in request attributes.")
 	}
 
-	token, err := oidc.TokenRequest(ctx, s.kms, s.atRepoFn, req.GetAuthMethodId(), attrs.TokenId)
+	// For device authorization grants, the provider is polled before the
+	// pending token is looked up.
+	var token *authtoken.AuthToken
+	err := oidc.PollDeviceAuth(ctx, s.oidcRepoFn, oidc.IamRepoFactory(s.iamRepoFn), s.atRepoFn, req.GetAuthMethodId(), attrs.TokenId)
+	if err == nil {
+		token, err = oidc.TokenRequest(ctx, s.kms, s.atRepoFn, req.GetAuthMethodId(), attrs.TokenId)
+	}
 	if err != nil {
 		switch {
 		case errors.Match(errors.T(errors.Forbidden), err):
@@ -348,6 +389,10 @@ func validateAuthenticateOidcRequest(req *pbs.AuthenticateRequest) error {
 				if payload == nil {
 					break
 				}
+				if attrs.GetDeviceFlow() {
+					badFields[roundtripPayloadAttributesField] = "Cannot be used with device_flow."
+					break
+				}
 				m, err := json.Marshal(payload.AsMap())
 				if err != nil {
 					// We don't know what's in this payload so we swallow the
@@ -42,6 +42,8 @@ func init() {
 		action.Update,
 		action.Delete,
 		action.Authenticate,
+		action.GenerateScimToken,
+		action.RevokeScimToken,
 	)
 }
 
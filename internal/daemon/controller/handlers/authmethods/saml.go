@@ -0,0 +1,497 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package authmethods
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/saml"
+	samlstore "github.com/hashicorp/boundary/internal/auth/saml/store"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/types/action"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var samlMaskManager handlers.MaskManager
+
+func init() {
+	var err error
+	if samlMaskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{
+			&samlstore.AuthMethod{},
+		},
+		handlers.MaskSource{&pb.AuthMethod{}, &pb.SamlAuthMethodAttributes{}},
+	); err != nil {
+		panic(err)
+	}
+
+	IdActions[saml.Subtype] = action.NewActionSet(
+		action.NoOp,
+		action.Read,
+		action.Update,
+		action.Delete,
+		action.Authenticate,
+		action.GenerateScimToken,
+		action.RevokeScimToken,
+	)
+}
+
+const (
+	idpMetadataXmlField = "attributes.idp_metadata_xml"
+	spEntityIdField     = "attributes.sp_entity_id"
+	samlResponseField   = "attributes.SAMLResponse"
+	relayStateField     = "attributes.RelayState"
+)
+
+func (s Service) authenticateSaml(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticateSaml"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
+	}
+	if authResults == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil auth results.")
+	}
+	switch req.GetCommand() {
+	case startCommand:
+		return s.authenticateSamlStart(ctx, req)
+	case callbackCommand:
+		return s.authenticateSamlCallback(ctx, req)
+	case tokenCommand:
+		return s.authenticateSamlToken(ctx, req, authResults)
+	}
+
+	return &pbs.AuthenticateResponse{Command: req.GetCommand()}, nil
+}
+
+func (s Service) authenticateSamlStart(ctx context.Context, req *pbs.AuthenticateRequest) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticateSamlStart"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
+	}
+
+	var opts []saml.Option
+	if attrs := req.GetSamlStartAttributes(); attrs.GetCachedRoundtripPayload() != "" {
+		opts = append(opts, saml.WithRoundTripPayload(attrs.GetCachedRoundtripPayload()))
+	}
+
+	authUrl, tokenId, err := saml.StartAuth(ctx, s.samlRepoFn, req.GetAuthMethodId(), opts...)
+	if err != nil {
+		// this event.WriteError(...) may cause a dup error to be emitted...
+		// it should be removed if that's the case.
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error starting the saml authentication flow"))
+		return nil, errors.New(ctx, errors.Internal, op, "Error generating parameters for starting the SAML flow. See the controller's log for more information.")
+	}
+
+	return &pbs.AuthenticateResponse{
+		Command: req.GetCommand(),
+		Attrs: &pbs.AuthenticateResponse_SamlAuthMethodAuthenticateStartResponse{
+			SamlAuthMethodAuthenticateStartResponse: &pb.SamlAuthMethodAuthenticateStartResponse{
+				AuthUrl: authUrl.String(),
+				TokenId: tokenId,
+			},
+		},
+	}, nil
+}
+
+// authenticateSamlCallback behaves like authenticateOidcCallback: since it is
+// called by the user agent posting the identity provider's response, it only
+// returns an error if the auth method can't be looked up. All other errors
+// are returned through the response as a final redirect URL to an endpoint
+// that can properly show the error details.
+func (s Service) authenticateSamlCallback(ctx context.Context, req *pbs.AuthenticateRequest) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticateSamlCallback"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
+	}
+
+	repo, err := s.samlRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	am, err := repo.LookupAuthMethod(ctx, req.GetAuthMethodId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("Auth method %s not found.", req.GetAuthMethodId()))
+	}
+
+	errRedirectBase := fmt.Sprintf(saml.AuthenticationErrorsEndpoint, am.GetApiUrl())
+	errResponse := func(err error) (*pbs.AuthenticateResponse, error) {
+		u := make(url.Values)
+		pbErr := handlers.ToApiError(err)
+		out, err := handlers.JSONMarshaler().Marshal(pbErr)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to marshal the error for callback"))
+		}
+		u.Add("error", string(out))
+		errRedirect := fmt.Sprintf("%s?%s", errRedirectBase, u.Encode())
+		return &pbs.AuthenticateResponse{
+			Command: callbackCommand,
+			Attrs: &pbs.AuthenticateResponse_SamlAuthMethodAuthenticateCallbackResponse{
+				SamlAuthMethodAuthenticateCallbackResponse: &pb.SamlAuthMethodAuthenticateCallbackResponse{
+					FinalRedirectUrl: errRedirect,
+				},
+			},
+		}, nil
+	}
+
+	attrs := req.GetSamlAuthMethodAuthenticateCallbackRequest()
+	finalRedirectUrl, err := saml.Callback(
+		ctx,
+		s.samlRepoFn,
+		saml.IamRepoFactory(s.iamRepoFn),
+		saml.AuthTokenRepoFactory(s.atRepoFn),
+		am,
+		attrs.GetSamlResponse(),
+		attrs.GetRelayState())
+	if err != nil {
+		return errResponse(errors.New(ctx, errors.InvalidParameter, op, "Callback validation failed.", errors.WithWrap(err)))
+	}
+
+	return &pbs.AuthenticateResponse{
+		Command: req.GetCommand(),
+		Attrs: &pbs.AuthenticateResponse_SamlAuthMethodAuthenticateCallbackResponse{
+			SamlAuthMethodAuthenticateCallbackResponse: &pb.SamlAuthMethodAuthenticateCallbackResponse{
+				FinalRedirectUrl: finalRedirectUrl,
+			},
+		},
+	}, nil
+}
+
+func (s Service) authenticateSamlToken(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticateSamlToken"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
+	}
+	if authResults == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil auth results.")
+	}
+	attrs := req.GetSamlAuthMethodAuthenticateTokenRequest()
+	if attrs.GetTokenId() == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Empty token ID in request attributes.")
+	}
+
+	token, err := saml.TokenRequest(ctx, s.kms, saml.AuthTokenRepoFactory(s.atRepoFn), req.GetAuthMethodId(), attrs.GetTokenId())
+	if err != nil {
+		switch {
+		case errors.Match(errors.T(errors.Forbidden), err):
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Forbidden."))
+		case errors.Match(errors.T(errors.AuthAttemptExpired), err):
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Forbidden."))
+		default:
+			// this event.WriteError(...) may cause a dup error to be emitted...
+			// it should be removed if that's the case.
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error generating parameters for token request"))
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Error generating parameters for token request. See the controller's log for more information."))
+		}
+	}
+	if token == nil {
+		return &pbs.AuthenticateResponse{
+			Command: req.GetCommand(),
+			Attrs: &pbs.AuthenticateResponse_SamlAuthMethodAuthenticateTokenResponse{
+				SamlAuthMethodAuthenticateTokenResponse: &pb.SamlAuthMethodAuthenticateTokenResponse{
+					Status: "unknown",
+				},
+			},
+		}, nil
+	}
+
+	responseToken, err := s.ConvertInternalAuthTokenToApiAuthToken(
+		ctx,
+		token,
+	)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Internal, op, "Error converting response to proper format.", errors.WithWrap(err))
+	}
+	return s.convertToAuthenticateResponse(ctx, req, authResults, responseToken)
+}
+
+// createSamlInRepo creates a saml auth method in a repo and returns the
+// result. This method should never return a nil AuthMethod without returning
+// an error.
+func (s Service) createSamlInRepo(ctx context.Context, scopeId string, item *pb.AuthMethod) (*saml.AuthMethod, error) {
+	u, err := toStorageSamlAuthMethod(ctx, scopeId, item)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.samlRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateAuthMethod(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create auth method: %w", err)
+	}
+	return out, nil
+}
+
+func (s Service) updateSamlInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.AuthMethod) (*saml.AuthMethod, error) {
+	u, err := toStorageSamlAuthMethodUpdate(ctx, scopeId, item)
+	if err != nil {
+		return nil, err
+	}
+
+	version := item.GetVersion()
+	u.PublicId = id
+
+	dbMask := samlMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+
+	repo, err := s.samlRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, rowsUpdated, err := repo.UpdateAuthMethod(ctx, u, version, dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update auth method: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist or incorrect version provided or no changes were made to the existing AuthMethod.", id)
+	}
+	return out, nil
+}
+
+// toStorageSamlAuthMethod converts the api auth method into a saml auth
+// method which is validated as a whole, so it can only be used when creating.
+func toStorageSamlAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMethod) (out *saml.AuthMethod, err error) {
+	const op = "authmethod_service.toStorageSamlAuthMethod"
+	if in == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil auth method.")
+	}
+	attrs := in.GetSamlAuthMethodsAttributes()
+
+	var opts []saml.Option
+	if in.GetName() != nil {
+		opts = append(opts, saml.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, saml.WithDescription(in.GetDescription().GetValue()))
+	}
+	if attrs.GetState() != "" {
+		opts = append(opts, saml.WithOperationalState(saml.AuthMethodState(attrs.GetState())))
+	}
+	if attrs.GetSpEntityId().GetValue() != "" {
+		opts = append(opts, saml.WithSpEntityId(attrs.GetSpEntityId().GetValue()))
+	}
+	if len(attrs.GetAccountAttributeMaps()) > 0 {
+		m, err := toSamlAccountAttributeMap(ctx, attrs.GetAccountAttributeMaps())
+		if err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, err.Error())
+		}
+		opts = append(opts, saml.WithAccountAttributeMap(m))
+	}
+	apiUrl, err := url.Parse(attrs.GetApiUrlPrefix().GetValue())
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "Unable to parse %q into a url.", attrs.GetApiUrlPrefix().GetValue())
+	}
+	u, err := saml.NewAuthMethod(ctx, scopeId, apiUrl, attrs.GetIdpMetadataXml().GetValue(), opts...)
+	if err != nil {
+		switch {
+		case errors.Match(errors.T(errors.InvalidParameter), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, err.Error())
+		default:
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method: %v.", err)
+		}
+	}
+	return u, nil
+}
+
+// toStorageSamlAuthMethodUpdate converts the api auth method into a saml auth
+// method without validating it as a whole, since an update request only
+// carries the fields being changed. The repository validates the auth method
+// once the changes have been applied.
+func toStorageSamlAuthMethodUpdate(ctx context.Context, scopeId string, in *pb.AuthMethod) (*saml.AuthMethod, error) {
+	const op = "authmethod_service.toStorageSamlAuthMethodUpdate"
+	if in == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil auth method.")
+	}
+	attrs := in.GetSamlAuthMethodsAttributes()
+
+	u := saml.AllocAuthMethod()
+	u.ScopeId = scopeId
+	u.Name = in.GetName().GetValue()
+	u.Description = in.GetDescription().GetValue()
+	u.OperationalState = attrs.GetState()
+	u.ApiUrl = strings.TrimSuffix(attrs.GetApiUrlPrefix().GetValue(), "/")
+	u.IdpMetadata = attrs.GetIdpMetadataXml().GetValue()
+	u.SpEntityId = attrs.GetSpEntityId().GetValue()
+	if len(attrs.GetAccountAttributeMaps()) > 0 {
+		m, err := toSamlAccountAttributeMap(ctx, attrs.GetAccountAttributeMaps())
+		if err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, err.Error())
+		}
+		b, err := json.Marshal(m)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		u.AccountAttributeMaps = string(b)
+	}
+	return &u, nil
+}
+
+// toSamlAccountAttributeMap parses the "from=to" formatted account attribute
+// maps of the api into the map the saml package stores.
+func toSamlAccountAttributeMap(ctx context.Context, maps []string) (map[string]saml.AccountToAttribute, error) {
+	const op = "authmethod_service.toSamlAccountAttributeMap"
+	parsed, err := saml.ParseAccountAttributeMaps(ctx, maps...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	m := make(map[string]saml.AccountToAttribute, len(parsed))
+	for _, am := range parsed {
+		to, err := saml.ConvertToAccountToAttribute(ctx, am.To)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		m[am.From] = to
+	}
+	return m, nil
+}
+
+func toSamlAuthMethodAttributes(ctx context.Context, in *saml.AuthMethod) (*pb.SamlAuthMethodAttributes, error) {
+	const op = "authmethod_service.toSamlAuthMethodAttributes"
+	attrs := &pb.SamlAuthMethodAttributes{
+		State:          in.GetOperationalState(),
+		ApiUrlPrefix:   wrapperspb.String(in.GetApiUrl()),
+		IdpMetadataXml: wrapperspb.String(in.GetIdpMetadata()),
+		AcsUrl:         in.AcsUrl(),
+	}
+	if in.GetSpEntityId() != "" {
+		attrs.SpEntityId = wrapperspb.String(in.GetSpEntityId())
+	}
+	if md, err := saml.ParseIdpMetadata(in.GetIdpMetadata()); err == nil {
+		attrs.IdpEntityId = md.EntityId
+	}
+	m, err := in.AccountAttributeMap()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for from, to := range m {
+		attrs.AccountAttributeMaps = append(attrs.AccountAttributeMaps, fmt.Sprintf("%s=%s", from, to))
+	}
+	sort.Strings(attrs.AccountAttributeMaps)
+	return attrs, nil
+}
+
+// validateSamlAttributes implements a handlers.CustomValidatorFunc(...) to be
+// used when validating requests with saml attributes.
+func validateSamlAttributes(ctx context.Context, attrs *pb.SamlAuthMethodAttributes, badFields map[string]string) {
+	if attrs == nil {
+		return
+	}
+	if attrs.GetState() != "" {
+		switch saml.AuthMethodState(attrs.GetState()) {
+		case saml.InactiveState, saml.ActivePrivateState, saml.ActivePublicState:
+		default:
+			badFields[stateField] = fmt.Sprintf("Unknown state %q.", attrs.GetState())
+		}
+	}
+	if attrs.GetApiUrlPrefix() != nil {
+		u, err := url.Parse(attrs.GetApiUrlPrefix().GetValue())
+		switch {
+		case err != nil:
+			badFields[apiUrlPrefixField] = fmt.Sprintf("%q is not a valid url.", attrs.GetApiUrlPrefix().GetValue())
+		case u.Scheme != "http" && u.Scheme != "https":
+			badFields[apiUrlPrefixField] = fmt.Sprintf("%s scheme in url %q is not either http or https.", u.Scheme, u.String())
+		}
+	}
+	if attrs.GetIdpMetadataXml() != nil {
+		if _, err := saml.ParseIdpMetadata(attrs.GetIdpMetadataXml().GetValue()); err != nil {
+			badFields[idpMetadataXmlField] = fmt.Sprintf("Cannot parse IdP metadata. %v", err.Error())
+		}
+	}
+	if attrs.GetSpEntityId() != nil && strings.TrimSpace(attrs.GetSpEntityId().GetValue()) == "" {
+		badFields[spEntityIdField] = "Cannot be empty."
+	}
+	if attrs.GetAcsUrl() != "" {
+		badFields["attributes.acs_url"] = "Field is read only."
+	}
+	if attrs.GetIdpEntityId() != "" {
+		badFields["attributes.idp_entity_id"] = "Field is read only."
+	}
+	if len(attrs.GetAccountAttributeMaps()) > 0 {
+		m, err := toSamlAccountAttributeMap(ctx, attrs.GetAccountAttributeMaps())
+		if err != nil {
+			badFields[accountAttributesMapField] = fmt.Sprintf("Contains invalid map %q", err.Error())
+			return
+		}
+		foundTo := make(map[saml.AccountToAttribute]bool, len(m))
+		for from, to := range m {
+			if foundTo[to] {
+				badFields[accountAttributesMapField] = fmt.Sprintf("%s=%s contains invalid map - multiple maps to the same Boundary account field %s", from, to, to)
+				break
+			}
+			foundTo[to] = true
+		}
+	}
+}
+
+func validateAuthenticateSamlRequest(req *pbs.AuthenticateRequest) error {
+	badFields := make(map[string]string)
+
+	switch req.GetCommand() {
+	case startCommand:
+		attrs := req.GetSamlStartAttributes()
+		if attrs == nil {
+			break
+		}
+		// Ensure we pay no attention to cache information provided by the client
+		attrs.CachedRoundtripPayload = ""
+		if payload := attrs.GetRoundtripPayload(); payload != nil {
+			m, err := json.Marshal(payload.AsMap())
+			if err != nil {
+				// We don't know what's in this payload so we swallow the
+				// error, as it could be something sensitive.
+				badFields[roundtripPayloadAttributesField] = "Unable to marshal given value as JSON."
+			} else {
+				// Cache for later
+				attrs.CachedRoundtripPayload = string(m)
+			}
+		}
+	case callbackCommand:
+		attrs := req.GetSamlAuthMethodAuthenticateCallbackRequest()
+		if attrs == nil {
+			badFields["attributes"] = "Attributes field not supplied request"
+			return handlers.InvalidArgumentErrorf("This is a required field.", badFields)
+		}
+		if attrs.GetSamlResponse() == "" {
+			badFields[samlResponseField] = "SAMLResponse field not supplied in callback request."
+		}
+		if attrs.GetRelayState() == "" {
+			badFields[relayStateField] = "RelayState field not supplied in callback request."
+		}
+	case tokenCommand:
+		tokenType := req.GetType()
+		if tokenType == "" {
+			// Fall back to deprecated field if type is not set
+			tokenType = req.GetTokenType() //nolint:all
+		}
+		tType := strings.ToLower(strings.TrimSpace(tokenType))
+		if tType != "" && tType != "token" && tType != "cookie" {
+			badFields[tokenTypeField] = `The only accepted types are "token" and "cookie".`
+		}
+	default:
+		badFields[commandField] = "Invalid command for this auth method type."
+	}
+
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
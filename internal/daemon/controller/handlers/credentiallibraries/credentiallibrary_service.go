@@ -8,9 +8,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
+	pluginstore "github.com/hashicorp/boundary/internal/credential/plugin/store"
+	"github.com/hashicorp/boundary/internal/credential/postgres"
+	pgstore "github.com/hashicorp/boundary/internal/credential/postgres/store"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
+	sshcastore "github.com/hashicorp/boundary/internal/credential/sshca/store"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -45,6 +52,9 @@ const (
 	keyBitsField               = "attributes.key_bits"
 	criticalOptionsField       = "attributes.critical_options"
 	extensionsField            = "attributes.extensions"
+	ttlField                   = "attributes.ttl"
+	grantStatementsField       = "attributes.grant_statements"
+	roleNamePrefixField        = "attributes.role_name_prefix"
 	domain                     = "credential"
 )
 
@@ -59,6 +69,9 @@ const (
 var (
 	maskManager        handlers.MaskManager
 	sshCertMaskManager handlers.MaskManager
+	pluginMaskManager  handlers.MaskManager
+	sshcaMaskManager   handlers.MaskManager
+	pgMaskManager      handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -82,6 +95,12 @@ var (
 		globals.UnspecifiedCredentialType,
 	}
 
+	validCredentialTypesPlugin = []globals.CredentialType{
+		globals.UsernamePasswordCredentialType,
+		globals.SshPrivateKeyCredentialType,
+		globals.UnspecifiedCredentialType,
+	}
+
 	validKeyTypes = []string{
 		vault.KeyTypeEcdsa,
 		vault.KeyTypeEd25519,
@@ -105,6 +124,27 @@ func init() {
 	); err != nil {
 		panic(err)
 	}
+	if pluginMaskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&pluginstore.CredentialLibrary{}},
+		handlers.MaskSource{&pb.CredentialLibrary{}},
+	); err != nil {
+		panic(err)
+	}
+	if sshcaMaskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&sshcastore.CredentialLibrary{}},
+		handlers.MaskSource{&pb.CredentialLibrary{}, &pb.SshCaCredentialLibraryAttributes{}},
+	); err != nil {
+		panic(err)
+	}
+	if pgMaskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&pgstore.CredentialLibrary{}},
+		handlers.MaskSource{&pb.CredentialLibrary{}, &pb.PostgresCredentialLibraryAttributes{}},
+	); err != nil {
+		panic(err)
+	}
 
 	// TODO: refactor to remove IdActionsMap and CollectionActions package variables
 	action.RegisterResource(resource.CredentialLibrary, IdActions, CollectionActions)
@@ -114,9 +154,12 @@ func init() {
 type Service struct {
 	pbs.UnsafeCredentialLibraryServiceServer
 
-	iamRepoFn   common.IamRepoFactory
-	repoFn      common.VaultCredentialRepoFactory
-	maxPageSize uint
+	iamRepoFn    common.IamRepoFactory
+	repoFn       common.VaultCredentialRepoFactory
+	pluginRepoFn common.PluginCredentialRepoFactory
+	sshcaRepoFn  common.SshCaCredentialRepoFactory
+	pgRepoFn     common.PostgresCredentialRepoFactory
+	maxPageSize  uint
 }
 
 var _ pbs.CredentialLibraryServiceServer = (*Service)(nil)
@@ -126,6 +169,9 @@ func NewService(
 	ctx context.Context,
 	iamRepoFn common.IamRepoFactory,
 	repoFn common.VaultCredentialRepoFactory,
+	pluginRepoFn common.PluginCredentialRepoFactory,
+	sshcaRepoFn common.SshCaCredentialRepoFactory,
+	pgRepoFn common.PostgresCredentialRepoFactory,
 	maxPageSize uint,
 ) (Service, error) {
 	const op = "credentiallibraries.NewService"
@@ -135,13 +181,25 @@ func NewService(
 	if repoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing vault credential repository")
 	}
+	if pluginRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing plugin credential repository")
+	}
+	if sshcaRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing ssh ca credential repository")
+	}
+	if pgRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing postgres credential repository")
+	}
 	if maxPageSize == 0 {
 		maxPageSize = uint(globals.DefaultMaxPageSize)
 	}
 	return Service{
-		iamRepoFn:   iamRepoFn,
-		repoFn:      repoFn,
-		maxPageSize: maxPageSize,
+		iamRepoFn:    iamRepoFn,
+		repoFn:       repoFn,
+		pluginRepoFn: pluginRepoFn,
+		sshcaRepoFn:  sshcaRepoFn,
+		pgRepoFn:     pgRepoFn,
+		maxPageSize:  maxPageSize,
 	}, nil
 }
 
@@ -194,9 +252,32 @@ func (s Service) ListCredentialLibraries(ctx context.Context, req *pbs.ListCrede
 			return true, nil
 		}
 	}
-	repo, err := s.repoFn()
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+	var repo credential.LibraryService
+	switch globals.ResourceInfoFromPrefix(req.GetCredentialStoreId()).Subtype {
+	case credplugin.Subtype:
+		pluginRepo, err := s.pluginRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo = pluginRepo
+	case sshca.Subtype:
+		sshcaRepo, err := s.sshcaRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo = sshcaRepo
+	case postgres.Subtype:
+		pgRepo, err := s.pgRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo = pgRepo
+	default:
+		vaultRepo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo = vaultRepo
 	}
 	grantsHash, err := authResults.GrantsHash(ctx)
 	if err != nil {
@@ -370,6 +451,45 @@ func (s Service) UpdateCredentialLibrary(ctx context.Context, req *pbs.UpdateCre
 			return nil, err
 		}
 		currentCredentialType = globals.CredentialType(cur.GetCredentialType())
+	case credplugin.Subtype:
+		pluginRepo, err := s.pluginRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		cur, err := pluginRepo.LookupCredentialLibrary(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		if cur == nil {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist.", req.GetId())
+		}
+		currentCredentialType = cur.CredentialType()
+	case sshca.Subtype:
+		sshcaRepo, err := s.sshcaRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		cur, err := sshcaRepo.LookupCredentialLibrary(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		if cur == nil {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist.", req.GetId())
+		}
+		currentCredentialType = cur.CredentialType()
+	case postgres.Subtype:
+		pgRepo, err := s.pgRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		cur, err := pgRepo.LookupCredentialLibrary(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		if cur == nil {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist.", req.GetId())
+		}
+		currentCredentialType = cur.CredentialType()
 	default:
 		cur, err := repo.LookupCredentialLibrary(ctx, req.Id)
 		if err != nil {
@@ -454,6 +574,45 @@ func (s Service) getFromRepo(ctx context.Context, id string) (credential.Library
 			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("ssh certificate credential library %q not found", id))
 		}
 		return cs, err
+	case credplugin.Subtype:
+		pluginRepo, err := s.pluginRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs, err := pluginRepo.LookupCredentialLibrary(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if cs == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("plugin credential library %q not found", id))
+		}
+		return cs, err
+	case sshca.Subtype:
+		sshcaRepo, err := s.sshcaRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs, err := sshcaRepo.LookupCredentialLibrary(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if cs == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("ssh ca credential library %q not found", id))
+		}
+		return cs, err
+	case postgres.Subtype:
+		pgRepo, err := s.pgRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs, err := pgRepo.LookupCredentialLibrary(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if cs == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("postgres credential library %q not found", id))
+		}
+		return cs, err
 	}
 	return nil, errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential library subtype")
 }
@@ -479,6 +638,57 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Cred
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create ssh certificate credential library but no error returned from repository.")
 		}
 		out = rl
+	case credplugin.Subtype.String():
+		cl, err := toStoragePluginLibrary(ctx, item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.pluginRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		rl, err := repo.CreateCredentialLibrary(ctx, scopeId, cl)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create plugin credential library"))
+		}
+		if rl == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create plugin credential library but no error returned from repository.")
+		}
+		out = rl
+	case sshca.Subtype.String():
+		cl, err := toStorageSshCaLibrary(ctx, item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.sshcaRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		rl, err := repo.CreateCredentialLibrary(ctx, scopeId, cl)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create ssh ca credential library"))
+		}
+		if rl == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create ssh ca credential library but no error returned from repository.")
+		}
+		out = rl
+	case postgres.Subtype.String():
+		cl, err := toStoragePostgresLibrary(ctx, item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.pgRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		rl, err := repo.CreateCredentialLibrary(ctx, scopeId, cl)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create postgres credential library"))
+		}
+		if rl == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create postgres credential library but no error returned from repository.")
+		}
+		out = rl
 	default:
 		cl, err := toStorageVaultLibrary(ctx, item.GetCredentialStoreId(), item)
 		if err != nil {
@@ -555,6 +765,75 @@ func (s Service) updateInRepo(
 		if rowsUpdated == 0 {
 			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist or incorrect version provided.", id)
 		}
+	case credplugin.Subtype:
+		dbMasks = pluginMaskManager.Translate(masks, "attributes")
+		if len(dbMasks) == 0 {
+			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		cl, err := toStoragePluginLibrary(ctx, item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cl.PublicId = id
+		pluginRepo, err := s.pluginRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err = pluginRepo.UpdateCredentialLibrary(ctx, projId, cl, item.GetVersion(), dbMasks)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential library"))
+		}
+		if rowsUpdated == 0 {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist or incorrect version provided.", id)
+		}
+	case sshca.Subtype:
+		dbMasks = sshcaMaskManager.Translate(masks)
+		if getMapUpdate(criticalOptionsField, masks) {
+			dbMasks = append(dbMasks, sshca.CriticalOptionsField)
+		}
+		if getMapUpdate(extensionsField, masks) {
+			dbMasks = append(dbMasks, sshca.ExtensionsField)
+		}
+		if len(dbMasks) == 0 {
+			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		cl, err := toStorageSshCaLibrary(ctx, item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cl.PublicId = id
+		sshcaRepo, err := s.sshcaRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err = sshcaRepo.UpdateCredentialLibrary(ctx, projId, cl, item.GetVersion(), dbMasks)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential library"))
+		}
+		if rowsUpdated == 0 {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist or incorrect version provided.", id)
+		}
+	case postgres.Subtype:
+		dbMasks = pgMaskManager.Translate(masks)
+		if len(dbMasks) == 0 {
+			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		cl, err := toStoragePostgresLibrary(ctx, item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cl.PublicId = id
+		pgRepo, err := s.pgRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err = pgRepo.UpdateCredentialLibrary(ctx, projId, cl, item.GetVersion(), dbMasks)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential library"))
+		}
+		if rowsUpdated == 0 {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist or incorrect version provided.", id)
+		}
 	default:
 		dbMasks = append(dbMasks, maskManager.Translate(masks)...)
 		if len(dbMasks) == 0 {
@@ -586,6 +865,24 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	switch globals.ResourceInfoFromPrefix(id).Subtype {
 	case vault.SSHCertificateLibrarySubtype:
 		rows, err = repo.DeleteSSHCertificateCredentialLibrary(ctx, scopeId, id)
+	case credplugin.Subtype:
+		pluginRepo, pErr := s.pluginRepoFn()
+		if pErr != nil {
+			return false, pErr
+		}
+		rows, err = pluginRepo.DeleteCredentialLibrary(ctx, scopeId, id)
+	case sshca.Subtype:
+		sshcaRepo, sErr := s.sshcaRepoFn()
+		if sErr != nil {
+			return false, sErr
+		}
+		rows, err = sshcaRepo.DeleteCredentialLibrary(ctx, scopeId, id)
+	case postgres.Subtype:
+		pgRepo, pgErr := s.pgRepoFn()
+		if pgErr != nil {
+			return false, pgErr
+		}
+		rows, err = pgRepo.DeleteCredentialLibrary(ctx, scopeId, id)
 	default:
 		rows, err = repo.DeleteCredentialLibrary(ctx, scopeId, id)
 	}
@@ -606,6 +903,21 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 		res.Error = err
 		return res
 	}
+	pluginRepo, err := s.pluginRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+	sshcaRepo, err := s.sshcaRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+	pgRepo, err := s.pgRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.CredentialLibrary), auth.WithAction(a)}
@@ -638,6 +950,39 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 				return res
 			}
 			parentId = cl.GetStoreId()
+		case credplugin.Subtype:
+			cl, err := pluginRepo.LookupCredentialLibrary(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cl == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cl.GetStoreId()
+		case sshca.Subtype:
+			cl, err := sshcaRepo.LookupCredentialLibrary(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cl == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cl.GetStoreId()
+		case postgres.Subtype:
+			cl, err := pgRepo.LookupCredentialLibrary(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cl == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cl.GetStoreId()
 		default:
 			res.Error = errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential library subtype from id")
 			return res
@@ -663,6 +1008,39 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			return res
 		}
 		opts = append(opts, auth.WithScopeId(cs.GetProjectId()))
+	case credplugin.Subtype:
+		cs, _, err := pluginRepo.LookupCredentialStore(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if cs == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		opts = append(opts, auth.WithScopeId(cs.GetProjectId()))
+	case sshca.Subtype:
+		cs, err := sshcaRepo.LookupCredentialStore(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if cs == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		opts = append(opts, auth.WithScopeId(cs.GetProjectId()))
+	case postgres.Subtype:
+		cs, err := pgRepo.LookupCredentialStore(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if cs == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		opts = append(opts, auth.WithScopeId(cs.GetProjectId()))
 	default:
 		res.Error = errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential store subtype from id")
 		return res
@@ -841,11 +1219,116 @@ func toProto(ctx context.Context, in credential.Library, opt ...handlers.Option)
 				VaultSshCertificateCredentialLibraryAttributes: attrs,
 			}
 		}
-
+	case credplugin.Subtype:
+		pluginIn, ok := in.(*credplugin.CredentialLibrary)
+		if !ok {
+			return nil, errors.New(ctx, errors.Internal, op, "unable to cast to plugin credential library")
+		}
+		if outputFields.Has(globals.CredentialTypeField) && pluginIn.GetCredentialType() != string(globals.UnspecifiedCredentialType) {
+			out.CredentialType = pluginIn.GetCredentialType()
+		}
+		if outputFields.Has(globals.AttributesField) {
+			attrs := &structpb.Struct{}
+			if err := proto.Unmarshal(pluginIn.GetAttributes(), attrs); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			if len(attrs.GetFields()) > 0 {
+				out.Attrs = &pb.CredentialLibrary_Attributes{
+					Attributes: attrs,
+				}
+			}
+		}
+	case sshca.Subtype:
+		sshcaIn, ok := in.(*sshca.CredentialLibrary)
+		if !ok {
+			return nil, errors.New(ctx, errors.Internal, op, "unable to cast to ssh ca credential library")
+		}
+		out.CredentialType = sshcaIn.GetCredentialType()
+		if outputFields.Has(globals.AttributesField) {
+			attrs := &pb.SshCaCredentialLibraryAttributes{}
+			if sshcaIn.GetUsername() != "" {
+				attrs.Username = wrapperspb.String(sshcaIn.GetUsername())
+			}
+			if sshcaIn.GetKeyType() != "" {
+				attrs.KeyType = wrapperspb.String(sshcaIn.GetKeyType())
+			}
+			if sshcaIn.GetKeyBits() != 0 {
+				attrs.KeyBits = &wrapperspb.UInt32Value{Value: sshcaIn.GetKeyBits()}
+			}
+			if sshcaIn.GetTtl() != "" {
+				attrs.Ttl = wrapperspb.String(sshcaIn.GetTtl())
+			}
+			if sshcaIn.GetKeyId() != "" {
+				attrs.KeyId = wrapperspb.String(sshcaIn.GetKeyId())
+			}
+			if sshcaIn.GetCriticalOptions() != "" {
+				co := make(map[string]string)
+				json.Unmarshal([]byte(sshcaIn.GetCriticalOptions()), &co)
+				attrs.CriticalOptions = co
+			}
+			if sshcaIn.GetExtensions() != "" {
+				e := make(map[string]string)
+				json.Unmarshal([]byte(sshcaIn.GetExtensions()), &e)
+				attrs.Extensions = e
+			}
+			if sshcaIn.GetAdditionalValidPrincipals() != "" {
+				avp := strings.Split(sshcaIn.GetAdditionalValidPrincipals(), ",")
+				attrs.AdditionalValidPrincipals = make([]*wrapperspb.StringValue, len(avp))
+				for i, p := range avp {
+					attrs.AdditionalValidPrincipals[i] = &wrapperspb.StringValue{Value: p}
+				}
+			}
+			out.Attrs = &pb.CredentialLibrary_SshCaCredentialLibraryAttributes{
+				SshCaCredentialLibraryAttributes: attrs,
+			}
+		}
+	case postgres.Subtype:
+		pgIn, ok := in.(*postgres.CredentialLibrary)
+		if !ok {
+			return nil, errors.New(ctx, errors.Internal, op, "unable to cast to postgres credential library")
+		}
+		out.CredentialType = pgIn.GetCredentialType()
+		if outputFields.Has(globals.AttributesField) {
+			attrs := &pb.PostgresCredentialLibraryAttributes{
+				GrantStatements: wrapperspb.String(pgIn.GetGrantStatements()),
+			}
+			if pgIn.GetRoleNamePrefix() != "" {
+				attrs.RoleNamePrefix = wrapperspb.String(pgIn.GetRoleNamePrefix())
+			}
+			if pgIn.GetTtl() != "" {
+				attrs.Ttl = wrapperspb.String(pgIn.GetTtl())
+			}
+			out.Attrs = &pb.CredentialLibrary_PostgresCredentialLibraryAttributes{
+				PostgresCredentialLibraryAttributes: attrs,
+			}
+		}
 	}
 	return &out, nil
 }
 
+func toStoragePluginLibrary(ctx context.Context, storeId string, in *pb.CredentialLibrary) (out *credplugin.CredentialLibrary, err error) {
+	const op = "credentiallibraries.toStoragePluginLibrary"
+	var opts []credplugin.Option
+	if in.GetName() != nil {
+		opts = append(opts, credplugin.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, credplugin.WithDescription(in.GetDescription().GetValue()))
+	}
+	if attrs := in.GetAttributes(); attrs != nil {
+		opts = append(opts, credplugin.WithAttributes(attrs))
+	}
+	if ct := in.GetCredentialType(); ct != "" {
+		opts = append(opts, credplugin.WithCredentialType(globals.CredentialType(ct)))
+	}
+
+	cs, err := credplugin.NewCredentialLibrary(ctx, storeId, opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential library"))
+	}
+	return cs, err
+}
+
 func toStorageVaultLibrary(ctx context.Context, storeId string, in *pb.CredentialLibrary) (out *vault.CredentialLibrary, err error) {
 	const op = "credentiallibraries.toStorageVaultLibrary"
 	var opts []vault.Option
@@ -962,6 +1445,83 @@ func toStorageVaultSSHCertificateLibrary(ctx context.Context, storeId string, in
 	return cs, err
 }
 
+func toStorageSshCaLibrary(ctx context.Context, storeId string, in *pb.CredentialLibrary) (out *sshca.CredentialLibrary, err error) {
+	const op = "credentiallibraries.toStorageSshCaLibrary"
+	var opts []sshca.Option
+	if in.GetName() != nil {
+		opts = append(opts, sshca.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, sshca.WithDescription(in.GetDescription().GetValue()))
+	}
+
+	attrs := in.GetSshCaCredentialLibraryAttributes()
+	if attrs.GetKeyType() != nil {
+		opts = append(opts, sshca.WithKeyType(attrs.GetKeyType().GetValue()))
+	}
+	if attrs.GetKeyBits() != nil {
+		opts = append(opts, sshca.WithKeyBits(attrs.GetKeyBits().GetValue()))
+	}
+	if attrs.GetTtl() != nil {
+		opts = append(opts, sshca.WithTtl(attrs.GetTtl().GetValue()))
+	}
+	if attrs.GetKeyId() != nil {
+		opts = append(opts, sshca.WithKeyId(attrs.GetKeyId().GetValue()))
+	}
+	if attrs.GetCriticalOptions() != nil {
+		co, err := json.Marshal(attrs.GetCriticalOptions())
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sshca.WithCriticalOptions(string(co)))
+	}
+	if attrs.GetExtensions() != nil {
+		e, err := json.Marshal(attrs.GetExtensions())
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sshca.WithExtensions(string(e)))
+	}
+	if attrs.GetAdditionalValidPrincipals() != nil {
+		avp := make([]string, len(attrs.GetAdditionalValidPrincipals()))
+		for i, p := range attrs.GetAdditionalValidPrincipals() {
+			avp[i] = p.GetValue()
+		}
+		opts = append(opts, sshca.WithAdditionalValidPrincipals(avp))
+	}
+
+	cl, err := sshca.NewCredentialLibrary(storeId, attrs.GetUsername().GetValue(), opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential library"))
+	}
+	return cl, err
+}
+
+func toStoragePostgresLibrary(ctx context.Context, storeId string, in *pb.CredentialLibrary) (out *postgres.CredentialLibrary, err error) {
+	const op = "credentiallibraries.toStoragePostgresLibrary"
+	var opts []postgres.Option
+	if in.GetName() != nil {
+		opts = append(opts, postgres.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, postgres.WithDescription(in.GetDescription().GetValue()))
+	}
+
+	attrs := in.GetPostgresCredentialLibraryAttributes()
+	if attrs.GetRoleNamePrefix() != nil {
+		opts = append(opts, postgres.WithRoleNamePrefix(attrs.GetRoleNamePrefix().GetValue()))
+	}
+	if attrs.GetTtl() != nil {
+		opts = append(opts, postgres.WithTtl(attrs.GetTtl().GetValue()))
+	}
+
+	cl, err := postgres.NewCredentialLibrary(storeId, attrs.GetGrantStatements().GetValue(), opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential library"))
+	}
+	return cl, err
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //   - The path passed in is correctly formatted
@@ -972,6 +1532,12 @@ func validateGetRequest(req *pbs.GetCredentialLibraryRequest) error {
 	switch globals.ResourceInfoFromPrefix(req.GetId()).Subtype {
 	case vault.SSHCertificateLibrarySubtype:
 		prefix = globals.VaultSshCertificateCredentialLibraryPrefix
+	case credplugin.Subtype:
+		prefix = globals.PluginCredentialLibraryPrefix
+	case sshca.Subtype:
+		prefix = globals.SshCaCredentialLibraryPrefix
+	case postgres.Subtype:
+		prefix = globals.PostgresCredentialLibraryPrefix
 	default:
 		prefix = globals.VaultCredentialLibraryPrefix
 	}
@@ -1082,6 +1648,69 @@ func validateCreateRequest(req *pbs.CreateCredentialLibraryRequest) error {
 				}
 				validateKeyBits(badFields, attrs.GetKeyBits().GetValue(), attrs.GetKeyType().GetValue())
 			}
+		case credplugin.Subtype:
+			if t := req.GetItem().GetType(); t != "" && t != credplugin.Subtype.String() {
+				badFields[globals.TypeField] = fmt.Sprintf("Type must be %q for a plugin credential store.", credplugin.Subtype.String())
+			}
+			req.GetItem().Type = credplugin.Subtype.String()
+			isValidCred := false
+			ct := req.GetItem().GetCredentialType()
+			for _, t := range validCredentialTypesPlugin {
+				if ct == "" || ct == string(t) {
+					isValidCred = true
+					break
+				}
+			}
+			if !isValidCred {
+				badFields[globals.CredentialTypeField] = fmt.Sprintf("Unknown credential type %q", ct)
+			}
+			if req.GetItem().GetCredentialMappingOverrides() != nil {
+				badFields[globals.CredentialMappingOverridesField] = "This field is not supported for plugin credential libraries."
+			}
+		case sshca.Subtype:
+			if t := req.GetItem().GetType(); t != "" && t != sshca.Subtype.String() {
+				badFields[globals.TypeField] = fmt.Sprintf("Type must be %q for an ssh ca credential store.", sshca.Subtype.String())
+			}
+			req.GetItem().Type = sshca.Subtype.String()
+			if req.GetItem().GetCredentialType() != "" {
+				badFields[globals.CredentialTypeField] = "This field is read only and cannot be set."
+			}
+			if req.GetItem().GetCredentialMappingOverrides() != nil {
+				badFields[globals.CredentialMappingOverridesField] = "This field is not supported for ssh ca credential libraries."
+			}
+			attrs := req.GetItem().GetSshCaCredentialLibraryAttributes()
+			if attrs.GetUsername().GetValue() == "" {
+				badFields[sshCertUsernameField] = "This is a required field."
+			}
+			if (attrs.GetKeyType() == nil) != (attrs.GetKeyBits() == nil) {
+				if attrs.GetKeyType() != nil && attrs.GetKeyType().GetValue() != sshca.KeyTypeEd25519 {
+					badFields[keyTypeField] = fmt.Sprintf("If set, %q must also be set.", keyBitsField)
+				}
+				if attrs.GetKeyBits() != nil {
+					badFields[keyBitsField] = fmt.Sprintf("If set, %q must also be set.", keyTypeField)
+				}
+			}
+			if t := attrs.GetKeyType(); t != nil && !strutil.StrListContains(validKeyTypes, strings.ToLower(t.GetValue())) {
+				badFields[keyTypeField] = "If set, value must be 'ed25519', 'ecdsa', or 'rsa'."
+			}
+			validateKeyBits(badFields, attrs.GetKeyBits().GetValue(), attrs.GetKeyType().GetValue())
+			if ttl := attrs.GetTtl(); ttl != nil {
+				if _, err := time.ParseDuration(ttl.GetValue()); err != nil {
+					badFields[ttlField] = "If set, value must be a valid duration."
+				}
+			}
+		case postgres.Subtype:
+			if t := req.GetItem().GetType(); t != "" && t != postgres.Subtype.String() {
+				badFields[globals.TypeField] = fmt.Sprintf("Type must be %q for a postgres credential store.", postgres.Subtype.String())
+			}
+			req.GetItem().Type = postgres.Subtype.String()
+			if req.GetItem().GetCredentialType() != "" {
+				badFields[globals.CredentialTypeField] = "This field is read only and cannot be set."
+			}
+			if req.GetItem().GetCredentialMappingOverrides() != nil {
+				badFields[globals.CredentialMappingOverridesField] = "This field is not supported for postgres credential libraries."
+			}
+			validatePostgresAttributes(badFields, req.GetItem().GetPostgresCredentialLibraryAttributes(), nil)
 		default:
 			badFields[globals.CredentialStoreIdField] = "This field must be a valid credential store id."
 		}
@@ -1097,6 +1726,12 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCrede
 		prefix = globals.VaultCredentialLibraryPrefix
 	case vault.SSHCertificateLibrarySubtype:
 		prefix = globals.VaultSshCertificateCredentialLibraryPrefix
+	case credplugin.Subtype:
+		prefix = globals.PluginCredentialLibraryPrefix
+	case sshca.Subtype:
+		prefix = globals.SshCaCredentialLibraryPrefix
+	case postgres.Subtype:
+		prefix = globals.PostgresCredentialLibraryPrefix
 	}
 	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
@@ -1145,18 +1780,79 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCrede
 				}
 				validateKeyBits(badFields, attrs.GetKeyBits().GetValue(), attrs.GetKeyType().GetValue())
 			}
+		case credplugin.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != credplugin.Subtype.String() {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			if req.GetItem().GetCredentialType() != "" && req.GetItem().GetCredentialType() != string(currentCredentialType) {
+				badFields[globals.CredentialTypeField] = "Cannot modify credential type."
+			}
+			if req.GetItem().GetCredentialMappingOverrides() != nil {
+				badFields[globals.CredentialMappingOverridesField] = "This field is not supported for plugin credential libraries."
+			}
+		case sshca.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != sshca.Subtype.String() {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			if req.GetItem().GetCredentialType() != "" && req.GetItem().GetCredentialType() != string(currentCredentialType) {
+				badFields[globals.CredentialTypeField] = "Cannot modify credential type."
+			}
+			attrs := req.GetItem().GetSshCaCredentialLibraryAttributes()
+			if attrs != nil {
+				if u := attrs.GetUsername().GetValue(); handlers.MaskContains(req.GetUpdateMask().GetPaths(), sshCertUsernameField) && u == "" {
+					badFields[sshCertUsernameField] = "This is a required field and cannot be set to empty."
+				}
+				if t := attrs.GetKeyType(); t != nil && !strutil.StrListContains(validKeyTypes, strings.ToLower(t.GetValue())) {
+					badFields[keyTypeField] = "If set, value must be 'ed25519', 'ecdsa', or 'rsa'."
+				}
+				validateKeyBits(badFields, attrs.GetKeyBits().GetValue(), attrs.GetKeyType().GetValue())
+				if ttl := attrs.GetTtl(); ttl != nil {
+					if _, err := time.ParseDuration(ttl.GetValue()); err != nil {
+						badFields[ttlField] = "If set, value must be a valid duration."
+					}
+				}
+			}
+		case postgres.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != postgres.Subtype.String() {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			if req.GetItem().GetCredentialType() != "" && req.GetItem().GetCredentialType() != string(currentCredentialType) {
+				badFields[globals.CredentialTypeField] = "Cannot modify credential type."
+			}
+			if req.GetItem().GetCredentialMappingOverrides() != nil {
+				badFields[globals.CredentialMappingOverridesField] = "This field is not supported for postgres credential libraries."
+			}
+			validatePostgresAttributes(badFields, req.GetItem().GetPostgresCredentialLibraryAttributes(), req.GetUpdateMask().GetPaths())
 		}
 		return badFields
 	}, prefix)
 }
 
+// validatePostgresAttributes adds an entry to badFields for each invalid
+// attribute in attrs. On create, paths is nil and the grant statements are
+// required. On update, the grant statements are only required if they are
+// included in paths.
+func validatePostgresAttributes(badFields map[string]string, attrs *pb.PostgresCredentialLibraryAttributes, paths []string) {
+	if (paths == nil || handlers.MaskContains(paths, grantStatementsField)) && strings.TrimSpace(attrs.GetGrantStatements().GetValue()) == "" {
+		badFields[grantStatementsField] = "This is a required field."
+	}
+	if p := attrs.GetRoleNamePrefix(); p != nil && p.GetValue() != "" && !postgres.ValidRoleNamePrefix(p.GetValue()) {
+		badFields[roleNamePrefixField] = "If set, value must start with a lowercase letter or underscore and contain at most 32 lowercase letters, digits, or underscores."
+	}
+	if ttl := attrs.GetTtl(); ttl != nil && ttl.GetValue() != "" {
+		if _, err := time.ParseDuration(ttl.GetValue()); err != nil {
+			badFields[ttlField] = "If set, value must be a valid duration."
+		}
+	}
+}
+
 func validateDeleteRequest(req *pbs.DeleteCredentialLibraryRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialLibraryPrefix, globals.VaultSshCertificateCredentialLibraryPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialLibraryPrefix, globals.VaultSshCertificateCredentialLibraryPrefix, globals.PluginCredentialLibraryPrefix, globals.SshCaCredentialLibraryPrefix, globals.PostgresCredentialLibraryPrefix)
 }
 
 func validateListRequest(ctx context.Context, req *pbs.ListCredentialLibrariesRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetCredentialStoreId()), globals.VaultCredentialStorePrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetCredentialStoreId()), globals.VaultCredentialStorePrefix, globals.PluginCredentialStorePrefix, globals.SshCaCredentialStorePrefix, globals.PostgresCredentialStorePrefix) {
 		badFields[globals.CredentialStoreIdField] = "This field must be a valid credential store id."
 	}
 	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
@@ -17,6 +17,9 @@ import (
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
+	credpostgres "github.com/hashicorp/boundary/internal/credential/postgres"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -27,12 +30,14 @@ import (
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/plugin"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
 	scopepb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -101,6 +106,15 @@ func TestList(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
 
 	_, prjNoLibs := iam.TestScopes(t, iamRepo)
 	storeNoLibs := vault.TestCredentialStores(t, conn, wrapper, prjNoLibs.GetPublicId(), 1)[0]
@@ -202,7 +216,7 @@ func TestList(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, repoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, 1000)
 			require.NoError(t, err)
 			// Test non-anonymous listing
 			got, gErr := s.ListCredentialLibraries(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
@@ -261,6 +275,15 @@ func TestList_Attributes(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
@@ -321,7 +344,7 @@ func TestList_Attributes(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, repoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, 1000)
 			require.NoError(t, err)
 			// Test non-anonymous listing
 			got, gErr := s.ListCredentialLibraries(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
@@ -379,6 +402,15 @@ func TestCreate(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
@@ -893,7 +925,7 @@ func TestCreate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, repoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, 1000)
 			require.NoError(err)
 			got, gErr := s.CreateCredentialLibrary(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
 			if tc.wantErr || tc.err != nil {
@@ -950,12 +982,21 @@ func TestGet(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
 	unspecifiedLib := vault.TestCredentialLibraries(t, conn, wrapper, store.GetPublicId(), 1)[0]
-	s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, repoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, 1000)
 	require.NoError(t, err)
 	repo, err := repoFn()
 	require.NoError(t, err)
@@ -1161,13 +1202,22 @@ func TestDelete(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
 	vl := vault.TestCredentialLibraries(t, conn, wrapper, store.GetPublicId(), 1)[0]
 	vl2 := vault.TestSSHCertificateCredentialLibraries(t, conn, wrapper, store.GetPublicId(), 1)[0]
-	s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, repoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, 1000)
 	require.NoError(t, err)
 	cases := []struct {
 		name string
@@ -1226,11 +1276,20 @@ func TestUpdate(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(testCtx, rw, rw, kms, sche)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(testCtx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(testCtx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(testCtx, rw, rw, kms, sche)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(testCtx, iamRepoFn, repoFn, 1000)
+	s, err := NewService(testCtx, iamRepoFn, repoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, 1000)
 	require.NoError(t, err)
 	cs := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)
 	store, diffStore := cs[0], cs[1]
@@ -2004,6 +2063,15 @@ func TestCreate_SSHCertificateCredentialLibrary(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
@@ -2279,7 +2347,7 @@ func TestCreate_SSHCertificateCredentialLibrary(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, repoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, 1000)
 			require.NoError(err)
 			got, gErr := s.CreateCredentialLibrary(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
 			if tc.wantErr || tc.err != nil {
@@ -2336,11 +2404,20 @@ func TestUpdate_SSHCertificateCredentialLibrary(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(testCtx, rw, rw, kms, sche)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(testCtx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(testCtx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(testCtx, rw, rw, kms, sche)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(testCtx, iamRepoFn, repoFn, 1000)
+	s, err := NewService(testCtx, iamRepoFn, repoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, 1000)
 	require.NoError(t, err)
 	cs := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)
 	store, diffStore := cs[0], cs[1]
@@ -2711,6 +2788,118 @@ func TestUpdate_SSHCertificateCredentialLibrary(t *testing.T) {
 	}
 }
 
+func TestCreate_PluginCredentialLibrary(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	plg := plugin.TestPlugin(t, conn, "test", plugin.WithCredentialFlag(true))
+	store := credplugin.TestCredentialStore(t, conn, prj.GetPublicId(), plg.GetPublicId())
+
+	attrs, err := structpb.NewStruct(map[string]any{"role": "readonly"})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		req      *pbs.CreateCredentialLibraryRequest
+		res      *pbs.CreateCredentialLibraryResponse
+		idPrefix string
+		err      error
+	}{
+		{
+			name: "Invalid credential type",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				CredentialType:    "fake-type",
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Mapping overrides not supported",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				CredentialType:    string(globals.UsernamePasswordCredentialType),
+				CredentialMappingOverrides: func() *structpb.Struct {
+					v := map[string]any{usernameAttribute: "user"}
+					ret, err := structpb.NewStruct(v)
+					require.NoError(t, err)
+					return ret
+				}(),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create a valid plugin library",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Name:              wrapperspb.String("name"),
+				CredentialType:    string(globals.UsernamePasswordCredentialType),
+				Attrs:             &pb.CredentialLibrary_Attributes{Attributes: attrs},
+			}},
+			idPrefix: globals.PluginCredentialLibraryPrefix + "_",
+			res: &pbs.CreateCredentialLibraryResponse{
+				Uri: fmt.Sprintf("credential-libraries/%s_", globals.PluginCredentialLibraryPrefix),
+				Item: &pb.CredentialLibrary{
+					CredentialStoreId: store.GetPublicId(),
+					Scope:             &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
+					Version:           1,
+					Type:              credplugin.Subtype.String(),
+					Name:              wrapperspb.String("name"),
+					CredentialType:    string(globals.UsernamePasswordCredentialType),
+					Attrs:             &pb.CredentialLibrary_Attributes{Attributes: attrs},
+					AuthorizedActions: testAuthorizedActions,
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := NewService(ctx, iamRepoFn, repoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, 1000)
+			require.NoError(err, "Error when getting new credential library service.")
+
+			got, gErr := s.CreateCredentialLibrary(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CreateCredentialLibrary(...) got error %v, wanted %v", gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Contains(got.GetUri(), tc.res.Uri)
+			assert.True(strings.HasPrefix(got.GetItem().GetId(), tc.idPrefix))
+			assert.Empty(cmp.Diff(
+				got,
+				tc.res,
+				protocmp.Transform(),
+				protocmp.IgnoreFields(&pbs.CreateCredentialLibraryResponse{}, "uri"),
+				protocmp.IgnoreFields(&pb.CredentialLibrary{}, "id", "created_time", "updated_time"),
+			))
+		})
+	}
+}
+
 func TestListPagination(t *testing.T) {
 	// Set database read timeout to avoid duplicates in response
 	oldReadTimeout := globals.RefreshReadLookbackDuration
@@ -2735,6 +2924,15 @@ func TestListPagination(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
 	tokenRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -2790,7 +2988,7 @@ func TestListPagination(t *testing.T) {
 	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
 	ctx = auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
 
-	s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, repoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, 1000)
 	require.NoError(err)
 	// Start paginating, recursively
 	req := &pbs.ListCredentialLibrariesRequest{
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/boundary/internal/pagination"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
@@ -55,6 +56,7 @@ var (
 		action.Read,
 		action.Update,
 		action.Delete,
+		action.CheckIn,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -97,9 +99,10 @@ func init() {
 type Service struct {
 	pbs.UnsafeCredentialServiceServer
 
-	iamRepoFn   common.IamRepoFactory
-	repoFn      common.StaticCredentialRepoFactory
-	maxPageSize uint
+	iamRepoFn     common.IamRepoFactory
+	repoFn        common.StaticCredentialRepoFactory
+	sessionRepoFn session.RepositoryFactory
+	maxPageSize   uint
 }
 
 var _ pbs.CredentialServiceServer = (*Service)(nil)
@@ -109,6 +112,7 @@ func NewService(
 	ctx context.Context,
 	iamRepo common.IamRepoFactory,
 	repo common.StaticCredentialRepoFactory,
+	sessionRepo session.RepositoryFactory,
 	maxPageSize uint,
 ) (Service, error) {
 	const op = "credentials.NewService"
@@ -118,10 +122,13 @@ func NewService(
 	if repo == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static credential repository")
 	}
+	if sessionRepo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing session repository")
+	}
 	if maxPageSize == 0 {
 		maxPageSize = uint(globals.DefaultMaxPageSize)
 	}
-	return Service{iamRepoFn: iamRepo, repoFn: repo, maxPageSize: maxPageSize}, nil
+	return Service{iamRepoFn: iamRepo, repoFn: repo, sessionRepoFn: sessionRepo, maxPageSize: maxPageSize}, nil
 }
 
 // ListCredentials implements the interface pbs.CredentialServiceServer
@@ -392,6 +399,51 @@ func (s Service) DeleteCredential(ctx context.Context, req *pbs.DeleteCredential
 	return nil, nil
 }
 
+// CheckInCredential implements the interface pbs.CredentialServiceServer.
+func (s Service) CheckInCredential(ctx context.Context, req *pbs.CheckInCredentialRequest) (*pbs.CheckInCredentialResponse, error) {
+	const op = "credentials.(Service).CheckInCredential"
+
+	if err := validateCheckInRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.CheckIn)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	c, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	sessionRepo, err := s.sessionRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := sessionRepo.CheckInStaticCredential(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, c.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(c, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.CheckInCredentialResponse{Item: item}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (credential.Static, error) {
 	const op = "credentials.(Service).getFromRepo"
 	repo, err := s.repoFn()
@@ -967,6 +1019,21 @@ func validateUpdateRequest(req *pbs.UpdateCredentialRequest) error {
 	)
 }
 
+func validateCheckInRequest(req *pbs.CheckInCredentialRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()),
+		globals.UsernamePasswordCredentialPrefix,
+		globals.UsernamePasswordCredentialPreviousPrefix,
+		globals.SshPrivateKeyCredentialPrefix,
+		globals.JsonCredentialPrefix) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func validateDeleteRequest(req *pbs.DeleteCredentialRequest) error {
 	return handlers.ValidateDeleteRequest(
 		handlers.NoopValidatorFn,
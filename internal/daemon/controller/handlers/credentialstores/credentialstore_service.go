@@ -8,9 +8,16 @@ import (
 	"encoding/base64"
 	"encoding/pem"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
+	pluginstore "github.com/hashicorp/boundary/internal/credential/plugin/store"
+	"github.com/hashicorp/boundary/internal/credential/postgres"
+	pgstore "github.com/hashicorp/boundary/internal/credential/postgres/store"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
+	sshcastore "github.com/hashicorp/boundary/internal/credential/sshca/store"
 	"github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
@@ -25,14 +32,18 @@ import (
 	"github.com/hashicorp/boundary/internal/listtoken"
 	"github.com/hashicorp/boundary/internal/pagination"
 	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/plugin"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"github.com/mr-tron/base58"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -44,11 +55,22 @@ const (
 	caCertsField           = "attributes.ca_cert"
 	clientCertField        = "attributes.client_certificate"
 	clientCertKeyField     = "attributes.certificate_key"
+	sshcaKeyTypeField      = "attributes.key_type"
+	sshcaKeyBitsField      = "attributes.key_bits"
+	sshcaPublicKeyField    = "attributes.public_key"
+	pgAddressField         = "attributes.address"
+	pgUsernameField        = "attributes.username"
+	pgPasswordField        = "attributes.password"
+	pgPasswordHmacField    = "attributes.password_hmac"
+	pgSslModeField         = "attributes.ssl_mode"
 	domain                 = "credential"
 )
 
 var (
-	maskManager handlers.MaskManager
+	maskManager       handlers.MaskManager
+	pluginMaskManager handlers.MaskManager
+	sshcaMaskManager  handlers.MaskManager
+	pgMaskManager     handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -57,6 +79,9 @@ var (
 		action.Read,
 		action.Update,
 		action.Delete,
+		action.ListLeases,
+		action.RenewLease,
+		action.RevokeLease,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -72,6 +97,15 @@ var (
 	staticCollectionTypeMap = map[resource.Type]action.ActionSet{
 		resource.Credential: credentials.CollectionActions,
 	}
+	pluginCollectionTypeMap = map[resource.Type]action.ActionSet{
+		resource.CredentialLibrary: credentiallibraries.CollectionActions,
+	}
+	sshcaCollectionTypeMap = map[resource.Type]action.ActionSet{
+		resource.CredentialLibrary: credentiallibraries.CollectionActions,
+	}
+	pgCollectionTypeMap = map[resource.Type]action.ActionSet{
+		resource.CredentialLibrary: credentiallibraries.CollectionActions,
+	}
 	validateVaultWorkerFilterFn = vaultWorkerFilterUnsupported
 	vaultWorkerFilterToProto    = false
 )
@@ -89,6 +123,27 @@ func init() {
 	); err != nil {
 		panic(err)
 	}
+	if pluginMaskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&pluginstore.CredentialStore{}},
+		handlers.MaskSource{&pb.CredentialStore{}},
+	); err != nil {
+		panic(err)
+	}
+	if sshcaMaskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&sshcastore.CredentialStore{}},
+		handlers.MaskSource{&pb.CredentialStore{}},
+	); err != nil {
+		panic(err)
+	}
+	if pgMaskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&pgstore.CredentialStore{}},
+		handlers.MaskSource{&pb.CredentialStore{}, &pb.PostgresCredentialStoreAttributes{}},
+	); err != nil {
+		panic(err)
+	}
 
 	// TODO: refactor to remove IdActionsMap and CollectionActions package variables
 	action.RegisterResource(resource.CredentialStore, IdActions, CollectionActions)
@@ -101,6 +156,9 @@ type Service struct {
 	iamRepoFn    common.IamRepoFactory
 	vaultRepoFn  common.VaultCredentialRepoFactory
 	staticRepoFn common.StaticCredentialRepoFactory
+	pluginRepoFn common.PluginCredentialRepoFactory
+	sshcaRepoFn  common.SshCaCredentialRepoFactory
+	pgRepoFn     common.PostgresCredentialRepoFactory
 	storeRepoFn  common.CredentialStoreRepoFactory
 	maxPageSize  uint
 }
@@ -113,6 +171,9 @@ func NewService(
 	iamRepo common.IamRepoFactory,
 	vaultRepo common.VaultCredentialRepoFactory,
 	staticRepo common.StaticCredentialRepoFactory,
+	pluginRepo common.PluginCredentialRepoFactory,
+	sshcaRepo common.SshCaCredentialRepoFactory,
+	pgRepo common.PostgresCredentialRepoFactory,
 	storeRepoFn common.CredentialStoreRepoFactory,
 	maxPageSize uint,
 ) (Service, error) {
@@ -126,6 +187,15 @@ func NewService(
 	if staticRepo == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static credential repository")
 	}
+	if pluginRepo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing plugin credential repository")
+	}
+	if sshcaRepo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing ssh ca credential repository")
+	}
+	if pgRepo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing postgres credential repository")
+	}
 	if storeRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing credential store repo")
 	}
@@ -136,6 +206,9 @@ func NewService(
 		iamRepoFn:    iamRepo,
 		vaultRepoFn:  vaultRepo,
 		staticRepoFn: staticRepo,
+		pluginRepoFn: pluginRepo,
+		sshcaRepoFn:  sshcaRepo,
+		pgRepoFn:     pgRepo,
 		storeRepoFn:  storeRepoFn,
 		maxPageSize:  maxPageSize,
 	}, nil
@@ -304,7 +377,7 @@ func (s Service) GetCredentialStore(ctx context.Context, req *pbs.GetCredentialS
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	cs, err := s.getFromRepo(ctx, req.GetId())
+	cs, plg, err := s.getFromRepo(ctx, req.GetId())
 	if err != nil {
 		return nil, err
 	}
@@ -329,6 +402,9 @@ func (s Service) GetCredentialStore(ctx context.Context, req *pbs.GetCredentialS
 		}
 		outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
 	}
+	if plg != nil {
+		outputOpts = append(outputOpts, handlers.WithPlugin(plg))
+	}
 
 	item, err := toProto(ctx, cs, outputOpts...)
 	if err != nil {
@@ -349,7 +425,7 @@ func (s Service) CreateCredentialStore(ctx context.Context, req *pbs.CreateCrede
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	cs, err := s.createInRepo(ctx, authResults.Scope.GetId(), req.GetItem())
+	cs, plg, err := s.createInRepo(ctx, authResults.Scope.GetId(), req.GetItem())
 	if err != nil {
 		return nil, err
 	}
@@ -374,6 +450,9 @@ func (s Service) CreateCredentialStore(ctx context.Context, req *pbs.CreateCrede
 		}
 		outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
 	}
+	if plg != nil {
+		outputOpts = append(outputOpts, handlers.WithPlugin(plg))
+	}
 
 	item, err := toProto(ctx, cs, outputOpts...)
 	if err != nil {
@@ -397,7 +476,7 @@ func (s Service) UpdateCredentialStore(ctx context.Context, req *pbs.UpdateCrede
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	cs, err := s.updateInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	cs, plg, err := s.updateInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
 	if err != nil {
 		return nil, err
 	}
@@ -422,6 +501,9 @@ func (s Service) UpdateCredentialStore(ctx context.Context, req *pbs.UpdateCrede
 		}
 		outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
 	}
+	if plg != nil {
+		outputOpts = append(outputOpts, handlers.WithPlugin(plg))
+	}
 
 	item, err := toProto(ctx, cs, outputOpts...)
 	if err != nil {
@@ -447,127 +529,345 @@ func (s Service) DeleteCredentialStore(ctx context.Context, req *pbs.DeleteCrede
 	return nil, nil
 }
 
-func (s Service) getFromRepo(ctx context.Context, id string) (credential.Store, error) {
+// ListCredentialStoreLeases implements the interface pbs.CredentialStoreServiceServer.
+func (s Service) ListCredentialStoreLeases(ctx context.Context, req *pbs.ListCredentialStoreLeasesRequest) (*pbs.ListCredentialStoreLeasesResponse, error) {
+	if err := validateListLeasesRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListLeases)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.vaultRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	var opts []vault.Option
+	if req.GetSessionId() != "" {
+		opts = append(opts, vault.WithSessionId(req.GetSessionId()))
+	}
+	creds, err := repo.ListLeases(ctx, req.GetId(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*pb.CredentialLease, 0, len(creds))
+	for _, c := range creds {
+		items = append(items, leaseToProto(c))
+	}
+	return &pbs.ListCredentialStoreLeasesResponse{Items: items}, nil
+}
+
+// RenewCredentialStoreLease implements the interface pbs.CredentialStoreServiceServer.
+func (s Service) RenewCredentialStoreLease(ctx context.Context, req *pbs.RenewCredentialStoreLeaseRequest) (*pbs.RenewCredentialStoreLeaseResponse, error) {
+	if err := validateLeaseRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RenewLease)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.vaultRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	c, err := repo.RenewLease(ctx, req.GetId(), req.GetCredentialId())
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.RenewCredentialStoreLeaseResponse{Item: leaseToProto(c)}, nil
+}
+
+// RevokeCredentialStoreLease implements the interface pbs.CredentialStoreServiceServer.
+func (s Service) RevokeCredentialStoreLease(ctx context.Context, req *pbs.RevokeCredentialStoreLeaseRequest) (*pbs.RevokeCredentialStoreLeaseResponse, error) {
+	if err := validateLeaseRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RevokeLease)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.vaultRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	c, err := repo.RevokeLease(ctx, req.GetId(), req.GetCredentialId())
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.RevokeCredentialStoreLeaseResponse{Item: leaseToProto(c)}, nil
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (credential.Store, *plugins.PluginInfo, error) {
 	const op = "credentialstores.(Service).getFromRepo"
 
 	switch globals.ResourceInfoFromPrefix(id).Subtype {
 	case vault.Subtype:
 		repo, err := s.vaultRepoFn()
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		cs, err := repo.LookupCredentialStore(ctx, id)
 		if err != nil && !errors.IsNotFoundError(err) {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		if cs != nil {
-			return cs, nil
+			return cs, nil, nil
 		}
 
 	case static.Subtype:
 		repo, err := s.staticRepoFn()
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		cs, err := repo.LookupCredentialStore(ctx, id)
 		if err != nil && !errors.IsNotFoundError(err) {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		if cs != nil {
+			return cs, nil, nil
+		}
+
+	case credplugin.Subtype:
+		repo, err := s.pluginRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		cs, plg, err := repo.LookupCredentialStore(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		if cs != nil {
+			return cs, toPluginInfo(plg), nil
+		}
+
+	case sshca.Subtype:
+		repo, err := s.sshcaRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		cs, err := repo.LookupCredentialStore(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		if cs != nil {
+			return cs, nil, nil
+		}
+
+	case postgres.Subtype:
+		repo, err := s.pgRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		cs, err := repo.LookupCredentialStore(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		if cs != nil {
-			return cs, nil
+			return cs, nil, nil
 		}
 	}
 
-	return nil, handlers.NotFoundErrorf("credential store %q not found", id)
+	return nil, nil, handlers.NotFoundErrorf("credential store %q not found", id)
 }
 
-func (s Service) createInRepo(ctx context.Context, projId string, item *pb.CredentialStore) (credential.Store, error) {
+func (s Service) createInRepo(ctx context.Context, projId string, item *pb.CredentialStore) (credential.Store, *plugins.PluginInfo, error) {
 	const op = "credentialstores.(Service).createInRepo"
 
 	switch item.Type {
 	case vault.Subtype.String():
 		cs, err := toStorageVaultStore(ctx, projId, item)
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		repo, err := s.vaultRepoFn()
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		out, err := repo.CreateCredentialStore(ctx, cs)
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
 		}
-		return out, nil
+		return out, nil, nil
 
 	case static.Subtype.String():
 		cs, err := toStorageStaticStore(ctx, projId, item)
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		repo, err := s.staticRepoFn()
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		out, err := repo.CreateCredentialStore(ctx, cs)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+		}
+		return out, nil, nil
+
+	case credplugin.Subtype.String():
+		cs, err := toStoragePluginStore(ctx, projId, item.GetPluginId(), item)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.pluginRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		out, plg, err := repo.CreateCredentialStore(ctx, cs)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+		}
+		return out, toPluginInfo(plg), nil
+
+	case sshca.Subtype.String():
+		cs, err := toStorageSshCaStore(ctx, projId, item)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.sshcaRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		out, err := repo.CreateCredentialStore(ctx, cs)
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
 		}
-		return out, nil
+		return out, nil, nil
+
+	case postgres.Subtype.String():
+		cs, err := toStoragePostgresStore(ctx, projId, item)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.pgRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		out, err := repo.CreateCredentialStore(ctx, cs)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+		}
+		return out, nil, nil
 
 	default:
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential store, unknown type.")
+		return nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential store, unknown type.")
 	}
 }
 
-func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []string, item *pb.CredentialStore) (credential.Store, error) {
+func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []string, item *pb.CredentialStore) (credential.Store, *plugins.PluginInfo, error) {
 	const op = "credentialstores.(Service).updateInRepo"
 
 	var out credential.Store
+	var plg *plugins.PluginInfo
 	var rowsUpdated int
 
-	dbMask := maskManager.Translate(mask)
-	if len(dbMask) == 0 {
-		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
-	}
-
 	switch globals.ResourceInfoFromPrefix(id).Subtype {
 	case vault.Subtype:
+		dbMask := maskManager.Translate(mask)
+		if len(dbMask) == 0 {
+			return nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
 		cs, err := toStorageVaultStore(ctx, projId, item)
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		cs.PublicId = id
 
 		repo, err := s.vaultRepoFn()
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		out, rowsUpdated, err = repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
 		}
 
 	case static.Subtype:
+		dbMask := maskManager.Translate(mask)
+		if len(dbMask) == 0 {
+			return nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
 		cs, err := toStorageStaticStore(ctx, projId, item)
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		cs.PublicId = id
 
 		repo, err := s.staticRepoFn()
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err = repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+		}
+
+	case credplugin.Subtype:
+		dbMask := pluginMaskManager.Translate(mask, "attributes", "secrets")
+		if len(dbMask) == 0 {
+			return nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		cs, err := toStoragePluginStore(ctx, projId, "", item)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		cs.PublicId = id
+
+		repo, err := s.pluginRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		var p *plugin.Plugin
+		out, p, rowsUpdated, err = repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+		}
+		plg = toPluginInfo(p)
+
+	case sshca.Subtype:
+		dbMask := sshcaMaskManager.Translate(mask)
+		if len(dbMask) == 0 {
+			return nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		cs, err := toStorageSshCaStore(ctx, projId, item)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		cs.PublicId = id
+
+		repo, err := s.sshcaRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err = repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+		}
+
+	case postgres.Subtype:
+		dbMask := pgMaskManager.Translate(mask)
+		if len(dbMask) == 0 {
+			return nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		cs, err := toStoragePostgresStore(ctx, projId, item)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		cs.PublicId = id
+
+		repo, err := s.pgRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		out, rowsUpdated, err = repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
 		}
 	}
 	if rowsUpdated == 0 {
-		return nil, handlers.NotFoundErrorf("Credential Store %q doesn't exist or incorrect version provided.", id)
+		return nil, nil, handlers.NotFoundErrorf("Credential Store %q doesn't exist or incorrect version provided.", id)
 	}
-	return out, nil
+	return out, plg, nil
 }
 
 func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
@@ -600,6 +900,45 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 			}
 			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete credential store"))
 		}
+
+	case credplugin.Subtype:
+		repo, err := s.pluginRepoFn()
+		if err != nil {
+			return false, err
+		}
+		rows, err = repo.DeleteCredentialStore(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return false, nil
+			}
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete credential store"))
+		}
+
+	case sshca.Subtype:
+		repo, err := s.sshcaRepoFn()
+		if err != nil {
+			return false, err
+		}
+		rows, err = repo.DeleteCredentialStore(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return false, nil
+			}
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete credential store"))
+		}
+
+	case postgres.Subtype:
+		repo, err := s.pgRepoFn()
+		if err != nil {
+			return false, err
+		}
+		rows, err = repo.DeleteCredentialStore(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return false, nil
+			}
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete credential store"))
+		}
 	}
 	return rows > 0, nil
 }
@@ -621,6 +960,21 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 		res.Error = err
 		return res
 	}
+	pluginRepo, err := s.pluginRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+	sshcaRepo, err := s.sshcaRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+	pgRepo, err := s.pgRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.CredentialStore), auth.WithAction(a)}
@@ -662,6 +1016,42 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 				return res
 			}
 			parentId = cs.GetProjectId()
+
+		case credplugin.Subtype:
+			cs, _, err := pluginRepo.LookupCredentialStore(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cs == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cs.GetProjectId()
+
+		case sshca.Subtype:
+			cs, err := sshcaRepo.LookupCredentialStore(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cs == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cs.GetProjectId()
+
+		case postgres.Subtype:
+			cs, err := pgRepo.LookupCredentialStore(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cs == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cs.GetProjectId()
 		}
 		opts = append(opts, auth.WithId(id))
 	}
@@ -741,12 +1131,23 @@ func toProto(ctx context.Context, in credential.Store, opt ...handlers.Option) (
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
+	if outputFields.Has(globals.PluginField) {
+		out.Plugin = opts.WithPlugin
+	}
 	if outputFields.Has(globals.AuthorizedActionsField) {
 		out.AuthorizedActions = opts.WithAuthorizedActions
 	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
 		out.AuthorizedCollectionActions = opts.WithAuthorizedCollectionActions
 	}
+	if pluginIn, ok := in.(*credplugin.CredentialStore); ok {
+		if outputFields.Has(globals.PluginIdField) {
+			out.PluginId = pluginIn.GetPluginId()
+		}
+		if outputFields.Has(globals.SecretsHmacField) && len(pluginIn.GetSecretsHmac()) > 0 {
+			out.SecretsHmac = base58.Encode(pluginIn.GetSecretsHmac())
+		}
+	}
 	if outputFields.Has(globals.AttributesField) {
 		switch globals.ResourceInfoFromPrefix(in.GetPublicId()).Subtype {
 		case vault.Subtype:
@@ -788,11 +1189,69 @@ func toProto(ctx context.Context, in credential.Store, opt ...handlers.Option) (
 			out.Attrs = &pb.CredentialStore_VaultCredentialStoreAttributes{
 				VaultCredentialStoreAttributes: attrs,
 			}
+
+		case credplugin.Subtype:
+			pluginIn, ok := in.(*credplugin.CredentialStore)
+			if !ok {
+				return nil, errors.New(ctx, errors.Internal, op, "unable to cast to plugin credential store")
+			}
+			attrs := &structpb.Struct{}
+			if err := proto.Unmarshal(pluginIn.GetAttributes(), attrs); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			if len(attrs.GetFields()) > 0 {
+				out.Attrs = &pb.CredentialStore_Attributes{
+					Attributes: attrs,
+				}
+			}
+
+		case sshca.Subtype:
+			sshcaIn, ok := in.(*sshca.CredentialStore)
+			if !ok {
+				return nil, errors.New(ctx, errors.Internal, op, "unable to cast to ssh ca credential store")
+			}
+			out.Attrs = &pb.CredentialStore_SshCaCredentialStoreAttributes{
+				SshCaCredentialStoreAttributes: &pb.SshCaCredentialStoreAttributes{
+					KeyType:   wrapperspb.String(sshcaIn.GetKeyType()),
+					KeyBits:   wrapperspb.UInt32(sshcaIn.GetKeyBits()),
+					PublicKey: sshcaIn.GetPublicKey(),
+				},
+			}
+
+		case postgres.Subtype:
+			pgIn, ok := in.(*postgres.CredentialStore)
+			if !ok {
+				return nil, errors.New(ctx, errors.Internal, op, "unable to cast to postgres credential store")
+			}
+			attrs := &pb.PostgresCredentialStoreAttributes{
+				Address:      wrapperspb.String(pgIn.GetAddress()),
+				Port:         wrapperspb.UInt32(pgIn.GetPort()),
+				DatabaseName: wrapperspb.String(pgIn.GetDatabaseName()),
+				Username:     wrapperspb.String(pgIn.GetUsername()),
+				SslMode:      wrapperspb.String(pgIn.GetSslMode()),
+			}
+			if len(pgIn.GetPasswordHmac()) > 0 {
+				attrs.PasswordHmac = base64.RawURLEncoding.EncodeToString(pgIn.GetPasswordHmac())
+			}
+			out.Attrs = &pb.CredentialStore_PostgresCredentialStoreAttributes{
+				PostgresCredentialStoreAttributes: attrs,
+			}
 		}
 	}
 	return &out, nil
 }
 
+func toPluginInfo(plg *plugin.Plugin) *plugins.PluginInfo {
+	if plg == nil {
+		return nil
+	}
+	return &plugins.PluginInfo{
+		Id:          plg.GetPublicId(),
+		Name:        plg.GetName(),
+		Description: plg.GetDescription(),
+	}
+}
+
 func toStorageStaticStore(ctx context.Context, scopeId string, in *pb.CredentialStore) (out *static.CredentialStore, err error) {
 	const op = "credentialstores.toStorageStaticStore"
 	var opts []static.Option
@@ -866,13 +1325,109 @@ func toStorageVaultStore(ctx context.Context, scopeId string, in *pb.CredentialS
 	return cs, err
 }
 
+func toStoragePluginStore(ctx context.Context, scopeId, pluginId string, in *pb.CredentialStore) (out *credplugin.CredentialStore, err error) {
+	const op = "credentialstores.toStoragePluginStore"
+	var opts []credplugin.Option
+	if in.GetName() != nil {
+		opts = append(opts, credplugin.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, credplugin.WithDescription(in.GetDescription().GetValue()))
+	}
+	if attrs := in.GetAttributes(); attrs != nil {
+		opts = append(opts, credplugin.WithAttributes(attrs))
+	}
+	if secrets := in.GetSecrets(); secrets != nil {
+		opts = append(opts, credplugin.WithSecrets(secrets))
+	}
+
+	cs, err := credplugin.NewCredentialStore(ctx, scopeId, pluginId, opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential store for creation"))
+	}
+	return cs, err
+}
+
+func toStorageSshCaStore(ctx context.Context, scopeId string, in *pb.CredentialStore) (out *sshca.CredentialStore, err error) {
+	const op = "credentialstores.toStorageSshCaStore"
+	var opts []sshca.Option
+	if in.GetName() != nil {
+		opts = append(opts, sshca.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, sshca.WithDescription(in.GetDescription().GetValue()))
+	}
+	attrs := in.GetSshCaCredentialStoreAttributes()
+	if attrs.GetKeyType().GetValue() != "" {
+		opts = append(opts, sshca.WithKeyType(attrs.GetKeyType().GetValue()))
+	}
+	if attrs.GetKeyBits().GetValue() != 0 {
+		opts = append(opts, sshca.WithKeyBits(attrs.GetKeyBits().GetValue()))
+	}
+
+	cs, err := sshca.NewCredentialStore(scopeId, opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential store for creation"))
+	}
+	return cs, err
+}
+
+func toStoragePostgresStore(ctx context.Context, scopeId string, in *pb.CredentialStore) (out *postgres.CredentialStore, err error) {
+	const op = "credentialstores.toStoragePostgresStore"
+	var opts []postgres.Option
+	if in.GetName() != nil {
+		opts = append(opts, postgres.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, postgres.WithDescription(in.GetDescription().GetValue()))
+	}
+	attrs := in.GetPostgresCredentialStoreAttributes()
+	if attrs.GetPort().GetValue() != 0 {
+		opts = append(opts, postgres.WithPort(attrs.GetPort().GetValue()))
+	}
+	if attrs.GetDatabaseName().GetValue() != "" {
+		opts = append(opts, postgres.WithDatabaseName(attrs.GetDatabaseName().GetValue()))
+	}
+	if attrs.GetSslMode().GetValue() != "" {
+		opts = append(opts, postgres.WithSslMode(attrs.GetSslMode().GetValue()))
+	}
+
+	cs, err := postgres.NewCredentialStore(scopeId, attrs.GetAddress().GetValue(), attrs.GetUsername().GetValue(), credential.Password(attrs.GetPassword().GetValue()), opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential store for creation"))
+	}
+	return cs, err
+}
+
+func leaseToProto(in *vault.Credential) *pb.CredentialLease {
+	out := &pb.CredentialLease{
+		Id:                  in.GetPublicId(),
+		CredentialLibraryId: in.GetLibraryId(),
+		SessionId:           in.GetSessionId(),
+		LeaseId:             in.GetExternalId(),
+		Status:              in.GetStatus(),
+		Renewable:           in.GetIsRenewable(),
+		ExpirationTime:      in.GetExpirationTime().GetTimestamp(),
+		LastRenewalTime:     in.GetLastRenewalTime().GetTimestamp(),
+		LastRenewalError:    in.GetLastRenewalError(),
+		CreatedTime:         in.GetCreateTime().GetTimestamp(),
+		UpdatedTime:         in.GetUpdateTime().GetTimestamp(),
+	}
+	if in.GetStatus() == string(vault.ActiveCredential) {
+		if ttl := time.Until(in.GetExpirationTime().AsTime()); ttl > 0 {
+			out.TtlSeconds = uint32(ttl.Seconds())
+		}
+	}
+	return out
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //   - The path passed in is correctly formatted
 //   - All required parameters are set
 //   - There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetCredentialStoreRequest) error {
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix, globals.PluginCredentialStorePrefix, globals.SshCaCredentialStorePrefix, globals.PostgresCredentialStorePrefix)
 }
 
 func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialStoreRequest) error {
@@ -921,6 +1476,57 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialStoreRe
 			}
 		case static.Subtype.String():
 			// No additional validation required for static credential store
+		case sshca.Subtype.String():
+			attrs := req.GetItem().GetSshCaCredentialStoreAttributes()
+			if attrs.GetPublicKey() != "" {
+				badFields[sshcaPublicKeyField] = "This is a read only field."
+			}
+			keyType := attrs.GetKeyType().GetValue()
+			switch keyType {
+			case "", sshca.KeyTypeEd25519, sshca.KeyTypeEcdsa, sshca.KeyTypeRsa:
+			default:
+				badFields[sshcaKeyTypeField] = fmt.Sprintf("If set, value must be %q, %q, or %q.", sshca.KeyTypeEd25519, sshca.KeyTypeEcdsa, sshca.KeyTypeRsa)
+			}
+			switch keyBits := attrs.GetKeyBits().GetValue(); keyBits {
+			case sshca.KeyBitsDefault:
+			case sshca.KeyBitsEcdsa256, sshca.KeyBitsEcdsa384, sshca.KeyBitsEcdsa521:
+				if keyType != sshca.KeyTypeEcdsa {
+					badFields[sshcaKeyBitsField] = fmt.Sprintf("Invalid bit size %d for key type %q", keyBits, keyType)
+				}
+			case sshca.KeyBitsRsa2048, sshca.KeyBitsRsa3072, sshca.KeyBitsRsa4096:
+				if keyType != sshca.KeyTypeRsa {
+					badFields[sshcaKeyBitsField] = fmt.Sprintf("Invalid bit size %d for key type %q", keyBits, keyType)
+				}
+			default:
+				badFields[sshcaKeyBitsField] = fmt.Sprintf("Invalid bit size %d", keyBits)
+			}
+		case postgres.Subtype.String():
+			attrs := req.GetItem().GetPostgresCredentialStoreAttributes()
+			if attrs.GetAddress().GetValue() == "" {
+				badFields[pgAddressField] = "Field required for creating a postgres credential store."
+			}
+			if attrs.GetUsername().GetValue() == "" {
+				badFields[pgUsernameField] = "Field required for creating a postgres credential store."
+			}
+			if attrs.GetPassword().GetValue() == "" {
+				badFields[pgPasswordField] = "Field required for creating a postgres credential store."
+			}
+			if attrs.GetPasswordHmac() != "" {
+				badFields[pgPasswordHmacField] = "This is a read only field."
+			}
+			if sslMode := attrs.GetSslMode().GetValue(); sslMode != "" && !postgres.ValidSslMode(sslMode) {
+				badFields[pgSslModeField] = fmt.Sprintf("If set, value must be %q, %q, %q, or %q.", postgres.SslModeDisable, postgres.SslModeRequire, postgres.SslModeVerifyCa, postgres.SslModeVerifyFull)
+			}
+		case credplugin.Subtype.String():
+			if req.GetItem().GetPluginId() == "" {
+				badFields[globals.PluginIdField] = "Field required for creating a plugin credential store."
+			}
+			if req.GetItem().GetPlugin() != nil {
+				badFields[globals.PluginField] = "This is a read only field."
+			}
+			if req.GetItem().GetSecretsHmac() != "" {
+				badFields[globals.SecretsHmacField] = "This is a read only field."
+			}
 		default:
 			badFields[globals.TypeField] = "This is a required field and must be a known credential store type."
 		}
@@ -967,13 +1573,93 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateCredentialStoreRe
 					badFields[clientCertField] = fmt.Sprintf("Invalid values: %q", err.Error())
 				}
 			}
+		case sshca.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != sshca.Subtype.String() {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			attrs := req.GetItem().GetSshCaCredentialStoreAttributes()
+			if attrs.GetKeyType() != nil {
+				badFields[sshcaKeyTypeField] = "This field cannot be updated."
+			}
+			if attrs.GetKeyBits() != nil {
+				badFields[sshcaKeyBitsField] = "This field cannot be updated."
+			}
+			if attrs.GetPublicKey() != "" {
+				badFields[sshcaPublicKeyField] = "This is a read only field."
+			}
+		case postgres.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != postgres.Subtype.String() {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			attrs := req.GetItem().GetPostgresCredentialStoreAttributes()
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), pgAddressField) && attrs.GetAddress().GetValue() == "" {
+				badFields[pgAddressField] = "This is a required field and cannot be unset."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), pgUsernameField) && attrs.GetUsername().GetValue() == "" {
+				badFields[pgUsernameField] = "This is a required field and cannot be unset."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), pgPasswordField) && attrs.GetPassword().GetValue() == "" {
+				badFields[pgPasswordField] = "This is a required field and cannot be unset."
+			}
+			if attrs.GetPasswordHmac() != "" {
+				badFields[pgPasswordHmacField] = "This is a read only field."
+			}
+			if sslMode := attrs.GetSslMode().GetValue(); sslMode != "" && !postgres.ValidSslMode(sslMode) {
+				badFields[pgSslModeField] = fmt.Sprintf("If set, value must be %q, %q, %q, or %q.", postgres.SslModeDisable, postgres.SslModeRequire, postgres.SslModeVerifyCa, postgres.SslModeVerifyFull)
+			}
+		case credplugin.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != credplugin.Subtype.String() {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			if req.GetItem().GetPluginId() != "" {
+				badFields[globals.PluginIdField] = "This field cannot be updated."
+			}
+			if req.GetItem().GetPlugin() != nil {
+				badFields[globals.PluginField] = "This is a read only field."
+			}
+			if req.GetItem().GetSecretsHmac() != "" {
+				badFields[globals.SecretsHmacField] = "This is a read only field."
+			}
 		}
 		return badFields
-	}, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
+	}, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix, globals.PluginCredentialStorePrefix, globals.SshCaCredentialStorePrefix, globals.PostgresCredentialStorePrefix)
+}
+
+func validateListLeasesRequest(req *pbs.ListCredentialStoreLeasesRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.VaultCredentialStorePrefix) {
+		badFields[globals.IdField] = "Leases can only be listed for vault credential stores."
+	}
+	if req.GetSessionId() != "" && !handlers.ValidId(handlers.Id(req.GetSessionId()), globals.SessionPrefix) {
+		badFields[globals.SessionIdField] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+type leaseRequest interface {
+	GetId() string
+	GetCredentialId() string
+}
+
+func validateLeaseRequest(req leaseRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.VaultCredentialStorePrefix) {
+		badFields[globals.IdField] = "Leases can only be managed for vault credential stores."
+	}
+	if !handlers.ValidId(handlers.Id(req.GetCredentialId()), globals.VaultDynamicCredentialPrefix) {
+		badFields[globals.CredentialIdField] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
 }
 
 func validateDeleteRequest(req *pbs.DeleteCredentialStoreRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix, globals.PluginCredentialStorePrefix, globals.SshCaCredentialStorePrefix, globals.PostgresCredentialStorePrefix)
 }
 
 func validateListRequest(ctx context.Context, req *pbs.ListCredentialStoresRequest) error {
@@ -1000,6 +1686,15 @@ func calculateAuthorizedCollectionActions(ctx context.Context, authResults auth.
 
 	case static.Subtype:
 		collectionActions, err = auth.CalculateAuthorizedCollectionActions(ctx, authResults, staticCollectionTypeMap, authResults.Scope.Id, id)
+
+	case credplugin.Subtype:
+		collectionActions, err = auth.CalculateAuthorizedCollectionActions(ctx, authResults, pluginCollectionTypeMap, authResults.Scope.Id, id)
+
+	case sshca.Subtype:
+		collectionActions, err = auth.CalculateAuthorizedCollectionActions(ctx, authResults, sshcaCollectionTypeMap, authResults.Scope.Id, id)
+
+	case postgres.Subtype:
+		collectionActions, err = auth.CalculateAuthorizedCollectionActions(ctx, authResults, pgCollectionTypeMap, authResults.Scope.Id, id)
 	}
 	if err != nil {
 		return nil, err
@@ -20,6 +20,9 @@ import (
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/credential"
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
+	credpostgres "github.com/hashicorp/boundary/internal/credential/postgres"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
 	"github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/static/store"
 	"github.com/hashicorp/boundary/internal/credential/vault"
@@ -31,12 +34,16 @@ import (
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/plugin/loopback"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	scopepb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -49,7 +56,7 @@ import (
 )
 
 var (
-	testAuthorizedActions                = []string{"no-op", "read", "update", "delete"}
+	testAuthorizedActions                = []string{"no-op", "read", "update", "delete", "list-leases", "renew-lease", "revoke-lease"}
 	testAuthorizedVaultCollectionActions = map[string]*structpb.ListValue{
 		"credential-libraries": {
 			Values: []*structpb.Value{
@@ -125,6 +132,15 @@ func TestList(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
 	credStoreRepoFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -278,7 +294,7 @@ func TestList(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreRepoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, credStoreRepoFn, 1000)
 			require.NoError(t, err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -338,6 +354,15 @@ func TestCreateVault(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -652,7 +677,7 @@ func TestCreateVault(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, credStoreServiceFn, 1000)
 			require.NoError(err, "Error when getting new credential store service.")
 			defer cleanup(s)
 
@@ -728,6 +753,15 @@ func TestCreateStatic(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -838,7 +872,7 @@ func TestCreateStatic(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, credStoreServiceFn, 1000)
 			require.NoError(err, "Error when getting new credential store service.")
 			defer cleanup(s)
 
@@ -885,6 +919,326 @@ func TestCreateStatic(t *testing.T) {
 	}
 }
 
+func TestCreatePlugin(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+
+	plg := plugin.TestPlugin(t, conn, "test", plugin.WithCredentialFlag(true))
+	plgm := map[string]plgpb.CredentialPluginServiceClient{
+		plg.GetPublicId(): loopback.NewWrappingPluginCredentialClient(&loopback.TestPluginCredentialServer{
+			OnCreateCredentialStoreFn: func(_ context.Context, req *plgpb.OnCreateCredentialStoreRequest) (*plgpb.OnCreateCredentialStoreResponse, error) {
+				return &plgpb.OnCreateCredentialStoreResponse{
+					Persisted: &plgpb.CredentialStorePersisted{Secrets: req.GetStore().GetSecrets()},
+				}, nil
+			},
+			OnDeleteCredentialStoreFn: func(context.Context, *plgpb.OnDeleteCredentialStoreRequest) (*plgpb.OnDeleteCredentialStoreResponse, error) {
+				return &plgpb.OnDeleteCredentialStoreResponse{}, nil
+			},
+		}),
+	}
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	staticRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(ctx, rw, rw, kms)
+	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, plgm)
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	credStoreServiceFn := func() (*credential.StoreRepository, error) {
+		return credential.NewStoreRepository(context.Background(), rw, rw)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+
+	cleanup := func(s Service) {
+		ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+		r, err := s.ListCredentialStores(ctx, &pbs.ListCredentialStoresRequest{ScopeId: prj.GetPublicId()})
+		require.NoError(t, err)
+		for _, i := range r.GetItems() {
+			_, err := s.DeleteCredentialStore(ctx, &pbs.DeleteCredentialStoreRequest{Id: i.GetId()})
+			require.NoError(t, err)
+		}
+	}
+
+	attrs, err := structpb.NewStruct(map[string]any{"address": "https://example.com"})
+	require.NoError(t, err)
+	secrets, err := structpb.NewStruct(map[string]any{"token": "secret"})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		req      *pbs.CreateCredentialStoreRequest
+		res      *pbs.CreateCredentialStoreResponse
+		idPrefix string
+		err      error
+	}{
+		{
+			name: "Must specify plugin id",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    credplugin.Subtype.String(),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Can't specify secrets hmac",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId:     prj.GetPublicId(),
+				Type:        credplugin.Subtype.String(),
+				PluginId:    plg.GetPublicId(),
+				SecretsHmac: "hmac",
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create a valid plugin CredentialStore",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId:     prj.GetPublicId(),
+				Name:        &wrapperspb.StringValue{Value: "name"},
+				Description: &wrapperspb.StringValue{Value: "desc"},
+				Type:        credplugin.Subtype.String(),
+				PluginId:    plg.GetPublicId(),
+				Attrs:       &pb.CredentialStore_Attributes{Attributes: attrs},
+				Secrets:     secrets,
+			}},
+			idPrefix: globals.PluginCredentialStorePrefix + "_",
+			res: &pbs.CreateCredentialStoreResponse{
+				Uri: fmt.Sprintf("credential-stores/%s_", globals.PluginCredentialStorePrefix),
+				Item: &pb.CredentialStore{
+					ScopeId:     prj.GetPublicId(),
+					Scope:       &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
+					Version:     1,
+					Type:        credplugin.Subtype.String(),
+					PluginId:    plg.GetPublicId(),
+					Plugin:      &plugins.PluginInfo{Id: plg.GetPublicId(), Name: plg.GetName()},
+					Name:        &wrapperspb.StringValue{Value: "name"},
+					Description: &wrapperspb.StringValue{Value: "desc"},
+					Attrs:       &pb.CredentialStore_Attributes{Attributes: attrs},
+
+					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedCollectionActions: testAuthorizedVaultCollectionActions,
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, credStoreServiceFn, 1000)
+			require.NoError(err, "Error when getting new credential store service.")
+			defer cleanup(s)
+
+			got, gErr := s.CreateCredentialStore(auth.DisabledAuthTestContext(iamRepoFn, tc.req.GetItem().GetScopeId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CreateCredentialStore(...) got error %v, wanted %v", gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Contains(got.GetUri(), tc.res.Uri)
+			assert.True(strings.HasPrefix(got.GetItem().GetId(), tc.idPrefix))
+			assert.NotEmpty(got.GetItem().GetSecretsHmac())
+			assert.Nil(got.GetItem().GetSecrets())
+
+			cmpOptions := []cmp.Option{
+				protocmp.Transform(),
+				protocmp.SortRepeatedFields(got),
+				protocmp.IgnoreFields(&pbs.CreateCredentialStoreResponse{}, "uri"),
+				protocmp.IgnoreFields(&pb.CredentialStore{}, "id", "created_time", "updated_time", "secrets_hmac"),
+			}
+			assert.Empty(cmp.Diff(got, tc.res, cmpOptions...), "CreateCredentialStore(%q) got response %q, wanted %q", tc.req, got, tc.res)
+		})
+	}
+}
+
+func TestCreateSshCa(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	staticRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(ctx, rw, rw, kms)
+	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	credStoreServiceFn := func() (*credential.StoreRepository, error) {
+		return credential.NewStoreRepository(context.Background(), rw, rw)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+
+	cases := []struct {
+		name     string
+		req      *pbs.CreateCredentialStoreRequest
+		res      *pbs.CreateCredentialStoreResponse
+		idPrefix string
+		err      error
+	}{
+		{
+			name: "Can't specify public key",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    sshca.Subtype.String(),
+				Attrs: &pb.CredentialStore_SshCaCredentialStoreAttributes{
+					SshCaCredentialStoreAttributes: &pb.SshCaCredentialStoreAttributes{
+						PublicKey: "ssh-ed25519 AAAA",
+					},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Invalid key type",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    sshca.Subtype.String(),
+				Attrs: &pb.CredentialStore_SshCaCredentialStoreAttributes{
+					SshCaCredentialStoreAttributes: &pb.SshCaCredentialStoreAttributes{
+						KeyType: wrapperspb.String("dsa"),
+					},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Key bits do not match key type",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    sshca.Subtype.String(),
+				Attrs: &pb.CredentialStore_SshCaCredentialStoreAttributes{
+					SshCaCredentialStoreAttributes: &pb.SshCaCredentialStoreAttributes{
+						KeyType: wrapperspb.String(sshca.KeyTypeRsa),
+						KeyBits: wrapperspb.UInt32(sshca.KeyBitsEcdsa256),
+					},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create a default ssh ca CredentialStore",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId:     prj.GetPublicId(),
+				Name:        &wrapperspb.StringValue{Value: "name"},
+				Description: &wrapperspb.StringValue{Value: "desc"},
+				Type:        sshca.Subtype.String(),
+			}},
+			idPrefix: globals.SshCaCredentialStorePrefix + "_",
+			res: &pbs.CreateCredentialStoreResponse{
+				Uri: fmt.Sprintf("credential-stores/%s_", globals.SshCaCredentialStorePrefix),
+				Item: &pb.CredentialStore{
+					ScopeId:     prj.GetPublicId(),
+					Scope:       &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
+					Version:     1,
+					Type:        sshca.Subtype.String(),
+					Name:        &wrapperspb.StringValue{Value: "name"},
+					Description: &wrapperspb.StringValue{Value: "desc"},
+					Attrs: &pb.CredentialStore_SshCaCredentialStoreAttributes{
+						SshCaCredentialStoreAttributes: &pb.SshCaCredentialStoreAttributes{
+							KeyType: wrapperspb.String(sshca.KeyTypeEd25519),
+							KeyBits: wrapperspb.UInt32(sshca.KeyBitsDefault),
+						},
+					},
+					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedCollectionActions: testAuthorizedVaultCollectionActions,
+				},
+			},
+		},
+		{
+			name: "Create an rsa ssh ca CredentialStore",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    sshca.Subtype.String(),
+				Attrs: &pb.CredentialStore_SshCaCredentialStoreAttributes{
+					SshCaCredentialStoreAttributes: &pb.SshCaCredentialStoreAttributes{
+						KeyType: wrapperspb.String(sshca.KeyTypeRsa),
+						KeyBits: wrapperspb.UInt32(sshca.KeyBitsRsa3072),
+					},
+				},
+			}},
+			idPrefix: globals.SshCaCredentialStorePrefix + "_",
+			res: &pbs.CreateCredentialStoreResponse{
+				Uri: fmt.Sprintf("credential-stores/%s_", globals.SshCaCredentialStorePrefix),
+				Item: &pb.CredentialStore{
+					ScopeId: prj.GetPublicId(),
+					Scope:   &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
+					Version: 1,
+					Type:    sshca.Subtype.String(),
+					Attrs: &pb.CredentialStore_SshCaCredentialStoreAttributes{
+						SshCaCredentialStoreAttributes: &pb.SshCaCredentialStoreAttributes{
+							KeyType: wrapperspb.String(sshca.KeyTypeRsa),
+							KeyBits: wrapperspb.UInt32(sshca.KeyBitsRsa3072),
+						},
+					},
+					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedCollectionActions: testAuthorizedVaultCollectionActions,
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, credStoreServiceFn, 1000)
+			require.NoError(err, "Error when getting new credential store service.")
+
+			got, gErr := s.CreateCredentialStore(auth.DisabledAuthTestContext(iamRepoFn, tc.req.GetItem().GetScopeId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CreateCredentialStore(...) got error %v, wanted %v", gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Contains(got.GetUri(), tc.res.Uri)
+			assert.True(strings.HasPrefix(got.GetItem().GetId(), tc.idPrefix))
+			assert.True(strings.HasPrefix(got.GetItem().GetSshCaCredentialStoreAttributes().GetPublicKey(), "ssh-"))
+
+			cmpOptions := []cmp.Option{
+				protocmp.Transform(),
+				protocmp.SortRepeatedFields(got),
+				protocmp.IgnoreFields(&pbs.CreateCredentialStoreResponse{}, "uri"),
+				protocmp.IgnoreFields(&pb.CredentialStore{}, "id", "created_time", "updated_time"),
+				protocmp.IgnoreFields(&pb.SshCaCredentialStoreAttributes{}, "public_key"),
+			}
+			assert.Empty(cmp.Diff(got, tc.res, cmpOptions...), "CreateCredentialStore(%q) got response %q, wanted %q", tc.req, got, tc.res)
+		})
+	}
+}
+
 func TestGet(t *testing.T) {
 	ctx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -903,6 +1257,15 @@ func TestGet(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -912,7 +1275,7 @@ func TestGet(t *testing.T) {
 	vaultStore := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
 	staticStore := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
 	staticStorePrev := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId(), static.WithPublicId(fmt.Sprintf("%s_1234567890", globals.StaticCredentialStorePreviousPrefix)))
-	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, credStoreServiceFn, 1000)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -1050,6 +1413,15 @@ func TestDelete(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -1058,7 +1430,7 @@ func TestDelete(t *testing.T) {
 
 	vaultStore := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)[0]
 	staticStore := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
-	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, credStoreServiceFn, 1000)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -1108,6 +1480,120 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestLeases(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+	err := vault.RegisterJobs(context.Background(), sche, rw, rw, kms)
+	require.NoError(t, err)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	staticRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(ctx, rw, rw, kms)
+	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	credStoreServiceFn := func() (*credential.StoreRepository, error) {
+		return credential.NewStoreRepository(context.Background(), rw, rw)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+
+	vaultStore := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+	staticStore := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, credStoreServiceFn, 1000)
+	require.NoError(t, err)
+	authCtx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+
+	t.Run("list", func(t *testing.T) {
+		got, err := s.ListCredentialStoreLeases(authCtx, &pbs.ListCredentialStoreLeasesRequest{Id: vaultStore.GetPublicId()})
+		require.NoError(t, err)
+		assert.Empty(t, got.GetItems())
+
+		got, err = s.ListCredentialStoreLeases(authCtx, &pbs.ListCredentialStoreLeasesRequest{Id: vaultStore.GetPublicId(), SessionId: "s_1234567890"})
+		require.NoError(t, err)
+		assert.Empty(t, got.GetItems())
+	})
+
+	cases := []struct {
+		name string
+		fn   func() error
+		err  error
+	}{
+		{
+			name: "list static store",
+			fn: func() error {
+				_, err := s.ListCredentialStoreLeases(authCtx, &pbs.ListCredentialStoreLeasesRequest{Id: staticStore.GetPublicId()})
+				return err
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "list bad session id",
+			fn: func() error {
+				_, err := s.ListCredentialStoreLeases(authCtx, &pbs.ListCredentialStoreLeasesRequest{Id: vaultStore.GetPublicId(), SessionId: "bad_1234567890"})
+				return err
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "list not found",
+			fn: func() error {
+				_, err := s.ListCredentialStoreLeases(authCtx, &pbs.ListCredentialStoreLeasesRequest{Id: fmt.Sprintf("%s_1234567890", globals.VaultCredentialStorePrefix)})
+				return err
+			},
+			err: handlers.NotFoundError(),
+		},
+		{
+			name: "renew static store",
+			fn: func() error {
+				_, err := s.RenewCredentialStoreLease(authCtx, &pbs.RenewCredentialStoreLeaseRequest{Id: staticStore.GetPublicId(), CredentialId: fmt.Sprintf("%s_1234567890", globals.VaultDynamicCredentialPrefix)})
+				return err
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "renew bad credential id",
+			fn: func() error {
+				_, err := s.RenewCredentialStoreLease(authCtx, &pbs.RenewCredentialStoreLeaseRequest{Id: vaultStore.GetPublicId(), CredentialId: "bad_1234567890"})
+				return err
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "revoke missing credential id",
+			fn: func() error {
+				_, err := s.RevokeCredentialStoreLease(authCtx, &pbs.RevokeCredentialStoreLeaseRequest{Id: vaultStore.GetPublicId()})
+				return err
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.fn()
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tc.err), "got error %v, wanted %v", err, tc.err)
+		})
+	}
+}
+
 func TestUpdateVault(t *testing.T) {
 	testCtx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -1128,6 +1614,15 @@ func TestUpdateVault(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(testCtx, rw, rw, kms)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(testCtx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(testCtx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(testCtx, rw, rw, kms, sche)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -1135,7 +1630,7 @@ func TestUpdateVault(t *testing.T) {
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, credStoreServiceFn, 1000)
 	require.NoError(t, err)
 
 	fieldmask := func(paths ...string) *fieldmaskpb.FieldMask {
@@ -1475,6 +1970,15 @@ func TestUpdateStatic(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(testCtx, rw, rw, kms)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(testCtx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(testCtx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(testCtx, rw, rw, kms, sche)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -1482,7 +1986,7 @@ func TestUpdateStatic(t *testing.T) {
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, credStoreServiceFn, 1000)
 	require.NoError(t, err)
 
 	fieldmask := func(paths ...string) *fieldmaskpb.FieldMask {
@@ -1673,6 +2177,15 @@ func TestListPagination(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshcaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	pgRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
 	tokenRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -1726,7 +2239,7 @@ func TestListPagination(t *testing.T) {
 	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
 	ctx = auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
 
-	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, pluginRepoFn, sshcaRepoFn, pgRepoFn, credStoreServiceFn, 1000)
 	require.NoError(err)
 
 	// Start paginating, recursively
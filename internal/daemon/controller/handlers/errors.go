@@ -172,6 +172,65 @@ func invalidListTokenError(err error) *ApiError {
 	}
 }
 
+// sessionQuotaExceededError generates an ApiErr when a new session could not be
+// created because it would exceed a configured active session quota.
+func sessionQuotaExceededError(err error) *ApiError {
+	const op = "handlers.sessionQuotaExceededError"
+	ctx := context.TODO()
+
+	var domainErr *errors.Err
+	if !errors.As(err, &domainErr) {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("Unable to build session quota exceeded api error."))
+	}
+	// The quota error is usually wrapped by the callers of the repository, so
+	// use the message of the innermost error carrying the quota details.
+	msg := innermostMsg(domainErr, errors.SessionQuotaExceeded)
+
+	return &ApiError{
+		Status: http.StatusTooManyRequests,
+		Inner: &pb.Error{
+			Kind:    codes.ResourceExhausted.String(),
+			Message: msg,
+		},
+	}
+}
+
+func credentialCheckedOutError(err error) *ApiError {
+	const op = "handlers.credentialCheckedOutError"
+	ctx := context.TODO()
+
+	var domainErr *errors.Err
+	if !errors.As(err, &domainErr) {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("Unable to build credential checked out api error."))
+	}
+	msg := innermostMsg(domainErr, errors.CredentialCheckedOut)
+
+	return &ApiError{
+		Status: http.StatusConflict,
+		Inner: &pb.Error{
+			Kind:    codes.Aborted.String(),
+			Message: msg,
+		},
+	}
+}
+
+// innermostMsg returns the message of the innermost error in the chain with
+// the code c, falling back to the default message of the code.
+func innermostMsg(domainErr *errors.Err, c errors.Code) string {
+	msg := c.Info().Message
+	for e := domainErr; e != nil; {
+		if e.Code == c && e.Msg != "" {
+			msg = e.Msg
+		}
+		next, ok := e.Wrapped.(*errors.Err)
+		if !ok {
+			break
+		}
+		e = next
+	}
+	return msg
+}
+
 // ConflictErrorf generates an ApiErr when a pre-conditional check is violated.
 // Note, this deliberately doesn't translate to the similarly named '412
 // Precondition Failed' HTTP response status. The ApiErr returned is a 400 bad
@@ -220,6 +279,10 @@ func backendErrorToApiError(inErr error) *ApiError {
 		return InvalidArgumentErrorf(inErr.Error(), nil)
 	case errors.Match(errors.T(errors.InvalidListToken), inErr):
 		return invalidListTokenError(inErr)
+	case errors.Match(errors.T(errors.SessionQuotaExceeded), inErr):
+		return sessionQuotaExceededError(inErr)
+	case errors.Match(errors.T(errors.CredentialCheckedOut), inErr):
+		return credentialCheckedOutError(inErr)
 	case errors.Match(errors.T(errors.InvalidFieldMask), inErr), errors.Match(errors.T(errors.EmptyFieldMask), inErr):
 		return InvalidArgumentErrorf("Error in provided request", map[string]string{"update_mask": "Invalid update mask provided."})
 	case errors.IsUniqueError(inErr):
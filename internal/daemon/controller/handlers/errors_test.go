@@ -231,6 +231,32 @@ func TestApiErrorHandler(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Session quota exceeded error",
+			err: errors.Wrap(ctx,
+				errors.New(ctx, errors.SessionQuotaExceeded, errors.Op("test.op"), "user u_1234567890 has reached the maximum of 2 active sessions"),
+				errors.Op("test.outer")),
+			expected: ApiError{
+				Status: http.StatusTooManyRequests,
+				Inner: &pb.Error{
+					Kind:    codes.ResourceExhausted.String(),
+					Message: "user u_1234567890 has reached the maximum of 2 active sessions",
+				},
+			},
+		},
+		{
+			name: "Credential checked out error",
+			err: errors.Wrap(ctx,
+				errors.New(ctx, errors.CredentialCheckedOut, errors.Op("test.op"), "static credential credup_1234567890 is checked out by another session"),
+				errors.Op("test.outer")),
+			expected: ApiError{
+				Status: http.StatusConflict,
+				Inner: &pb.Error{
+					Kind:    codes.Aborted.String(),
+					Message: "static credential credup_1234567890 is checked out by another session",
+				},
+			},
+		},
 		{
 			name: "Wrapped forbidden domain error",
 			err:  fmt.Errorf("got error: %w", errors.E(ctx, errors.WithCode(errors.Forbidden), errors.WithMsg("test msg"))),
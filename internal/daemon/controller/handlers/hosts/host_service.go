@@ -80,6 +80,7 @@ type Service struct {
 
 	staticRepoFn common.StaticRepoFactory
 	pluginRepoFn common.PluginHostRepoFactory
+	healthRepoFn common.HostHealthRepoFactory
 	maxPageSize  uint
 }
 
@@ -87,7 +88,7 @@ var _ pbs.HostServiceServer = (*Service)(nil)
 
 // NewService returns a host Service which handles host related requests to boundary and uses the provided
 // repositories for storage and retrieval.
-func NewService(ctx context.Context, repoFn common.StaticRepoFactory, pluginRepoFn common.PluginHostRepoFactory, maxPageSize uint) (Service, error) {
+func NewService(ctx context.Context, repoFn common.StaticRepoFactory, pluginRepoFn common.PluginHostRepoFactory, healthRepoFn common.HostHealthRepoFactory, maxPageSize uint) (Service, error) {
 	const op = "hosts.NewService"
 	if repoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static repository")
@@ -95,10 +96,13 @@ func NewService(ctx context.Context, repoFn common.StaticRepoFactory, pluginRepo
 	if pluginRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing plugin host repository")
 	}
+	if healthRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing host health repository")
+	}
 	if maxPageSize == 0 {
 		maxPageSize = uint(globals.DefaultMaxPageSize)
 	}
-	return Service{staticRepoFn: repoFn, pluginRepoFn: pluginRepoFn, maxPageSize: maxPageSize}, nil
+	return Service{staticRepoFn: repoFn, pluginRepoFn: pluginRepoFn, healthRepoFn: healthRepoFn, maxPageSize: maxPageSize}, nil
 }
 
 func (s Service) ListHosts(ctx context.Context, req *pbs.ListHostsRequest) (*pbs.ListHostsResponse, error) {
@@ -313,6 +317,21 @@ func (s Service) GetHost(ctx context.Context, req *pbs.GetHostRequest) (*pbs.Get
 		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, h.GetPublicId(), idActions).Strings()))
 	}
 	outputOpts = append(outputOpts, handlers.WithHostSetIds(h.GetSetIds()))
+	if outputFields.Has(globals.HealthField) {
+		healthRepo, err := s.healthRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		health, err := healthRepo.ListHealth(ctx, []string{h.GetPublicId()}, 0)
+		if err != nil {
+			return nil, err
+		}
+		hh, ok := health[h.GetPublicId()]
+		if !ok {
+			hh = host.UnknownHealth
+		}
+		outputOpts = append(outputOpts, handlers.WithHostHealth(hh.String()))
+	}
 	item, err := toProto(ctx, h, outputOpts...)
 	if err != nil {
 		return nil, err
@@ -553,29 +572,40 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 	case action.List, action.Create:
 		parentId = id
 	default:
+		var h host.Host
+		var plg *plugin.Plugin
 		switch globals.ResourceInfoFromPrefix(id).Subtype {
 		case static.Subtype:
-			h, err := staticRepo.LookupHost(ctx, id)
+			sh, err := staticRepo.LookupHost(ctx, id)
 			if err != nil {
 				res.Error = err
 				return nil, res
 			}
-			if h == nil {
+			if sh == nil {
 				res.Error = handlers.NotFoundError()
 				return nil, res
 			}
-			parentId = h.GetCatalogId()
+			h = sh
 		case hostplugin.Subtype:
-			h, _, err := pluginRepo.LookupHost(ctx, id)
+			ph, pl, err := pluginRepo.LookupHost(ctx, id)
 			if err != nil {
 				res.Error = err
 				return nil, res
 			}
-			if h == nil {
+			if ph == nil {
 				res.Error = handlers.NotFoundError()
 				return nil, res
 			}
+			h, plg = ph, pl
+		}
+		if h != nil {
 			parentId = h.GetCatalogId()
+			resourceItem, err := FilterableHost(ctx, h, plg)
+			if err != nil {
+				res.Error = err
+				return nil, res
+			}
+			opts = append(opts, auth.WithResourceItem(resourceItem))
 		}
 		opts = append(opts, auth.WithId(id))
 	}
@@ -620,6 +650,24 @@ func toPluginInfo(plg *plugin.Plugin) *plugins.PluginInfo {
 	}
 }
 
+// FilterableHost returns the representation of the host that grant filters
+// are evaluated against. This is the full API representation of the host,
+// regardless of the output fields granted to the user.
+func FilterableHost(ctx context.Context, h host.Host, plg *plugin.Plugin) (any, error) {
+	opts := []handlers.Option{
+		handlers.WithOutputFields((&perms.OutputFields{}).AddFields([]string{"*"})),
+		handlers.WithHostSetIds(h.GetSetIds()),
+	}
+	if plg != nil {
+		opts = append(opts, handlers.WithPlugin(toPluginInfo(plg)))
+	}
+	pbItem, err := toProto(ctx, h, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return subtypes.Filterable(ctx, pbItem)
+}
+
 func newOutputOpts(ctx context.Context, item host.Host, plg *plugin.Plugin, authResults auth.VerifyResults) ([]handlers.Option, bool) {
 	res := perms.Resource{
 		ScopeId: authResults.Scope.Id,
@@ -628,6 +676,9 @@ func newOutputOpts(ctx context.Context, item host.Host, plg *plugin.Plugin, auth
 		Id:      item.GetPublicId(),
 	}
 	res.Id = item.GetPublicId()
+	// Errors building the filterable item leave it unset, in which case only
+	// grants without filters apply
+	res.Item, _ = FilterableHost(ctx, item, plg)
 	idActions := idActionsTypeMap[globals.ResourceInfoFromPrefix(res.Id).Subtype]
 	authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), idActions, auth.WithResource(&res)).Strings()
 	if len(authorizedActions) == 0 {
@@ -709,6 +760,9 @@ func toProto(ctx context.Context, in host.Host, opt ...handlers.Option) (*pb.Hos
 	if outputFields.Has(globals.PluginField) {
 		out.Plugin = opts.WithPlugin
 	}
+	if outputFields.Has(globals.HealthField) {
+		out.Health = opts.WithHostHealth
+	}
 	switch h := in.(type) {
 	case *hostplugin.Host:
 		if outputFields.Has(globals.IpAddressesField) {
@@ -112,6 +112,9 @@ func TestGet_Static(t *testing.T) {
 	pluginRepoFn := func() (*hostplugin.Repository, error) {
 		return hostplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	healthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
@@ -121,6 +124,18 @@ func TestGet_Static(t *testing.T) {
 	static.TestSetMembers(t, conn, s.GetPublicId(), []*static.Host{h})
 
 	pHost := staticHostToProto(h, proj, s)
+	pHost.Health = host.UnknownHealth.String()
+
+	healthyHost := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, s.GetPublicId(), []*static.Host{healthyHost})
+	healthRepo, err := healthRepoFn()
+	require.NoError(t, err)
+	w := server.TestKmsWorker(t, conn, wrapper)
+	require.NoError(t, healthRepo.UpsertHealthCheckResults(ctx, w.GetPublicId(), []*host.HealthCheckResult{
+		{HostId: healthyHost.GetPublicId(), Port: 22, Healthy: true},
+	}))
+	pHealthyHost := staticHostToProto(healthyHost, proj, s)
+	pHealthyHost.Health = host.Healthy.String()
 
 	cases := []struct {
 		name string
@@ -133,6 +148,11 @@ func TestGet_Static(t *testing.T) {
 			req:  &pbs.GetHostRequest{Id: h.GetPublicId()},
 			res:  &pbs.GetHostResponse{Item: pHost},
 		},
+		{
+			name: "Get a Healthy Host",
+			req:  &pbs.GetHostRequest{Id: healthyHost.GetPublicId()},
+			res:  &pbs.GetHostResponse{Item: pHealthyHost},
+		},
 		{
 			name: "Get a non existing Host Set",
 			req:  &pbs.GetHostRequest{Id: globals.StaticHostPrefix + "_DoesntExis"},
@@ -155,7 +175,7 @@ func TestGet_Static(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, healthRepoFn, 1000)
 			require.NoError(err, "Couldn't create a new host service.")
 
 			got, gErr := s.GetHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
@@ -203,6 +223,9 @@ func TestGet_Plugin(t *testing.T) {
 	pluginRepoFn := func() (*hostplugin.Repository, error) {
 		return hostplugin.NewRepository(ctx, rw, rw, kms, sche, plgm)
 	}
+	healthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
@@ -216,6 +239,7 @@ func TestGet_Plugin(t *testing.T) {
 	hostplugin.TestSetMembers(t, conn, hs.GetPublicId(), []*hostplugin.Host{h, hPrev})
 
 	pHost := pluginHostToProto(h, proj, hs, plg, "test", "test-ext-name")
+	pHost.Health = host.UnknownHealth.String()
 
 	cases := []struct {
 		name string
@@ -263,7 +287,7 @@ func TestGet_Plugin(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, healthRepoFn, 1000)
 			require.NoError(err, "Couldn't create a new host service.")
 
 			got, gErr := s.GetHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
@@ -307,6 +331,9 @@ func TestList_Static(t *testing.T) {
 	pluginRepoFn := func() (*hostplugin.Repository, error) {
 		return hostplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	healthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
@@ -383,7 +410,7 @@ func TestList_Static(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, healthRepoFn, 1000)
 			require.NoError(err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -439,6 +466,9 @@ func TestList_Plugin(t *testing.T) {
 	pluginRepoFn := func() (*hostplugin.Repository, error) {
 		return hostplugin.NewRepository(ctx, rw, rw, kms, sche, plgm)
 	}
+	healthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
@@ -517,7 +547,7 @@ func TestList_Plugin(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, healthRepoFn, 1000)
 			require.NoError(err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -588,6 +618,9 @@ func TestListPagination(t *testing.T) {
 	pluginRepoFn := func() (*hostplugin.Repository, error) {
 		return hostplugin.NewRepository(ctx, rw, rw, kms, sche, plgm)
 	}
+	healthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
@@ -634,7 +667,7 @@ func TestListPagination(t *testing.T) {
 	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
 	ctx = auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
 
-	s, err := hosts.NewService(ctx, staticRepoFn, pluginRepoFn, 1000)
+	s, err := hosts.NewService(ctx, staticRepoFn, pluginRepoFn, healthRepoFn, 1000)
 	require.NoError(t, err)
 
 	t.Run("static-hosts", func(t *testing.T) {
@@ -1066,6 +1099,9 @@ func TestDelete(t *testing.T) {
 	pluginRepoFn := func() (*hostplugin.Repository, error) {
 		return hostplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	healthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
@@ -1076,7 +1112,7 @@ func TestDelete(t *testing.T) {
 	pluginHc := hostplugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 	pluginH := hostplugin.TestHost(t, conn, pluginHc.GetPublicId(), "test")
 
-	s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+	s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, healthRepoFn, 1000)
 	require.NoError(t, err, "Couldn't create a new host set service.")
 
 	cases := []struct {
@@ -1158,13 +1194,16 @@ func TestDelete_twice(t *testing.T) {
 	pluginRepoFn := func() (*hostplugin.Repository, error) {
 		return hostplugin.NewRepository(testCtx, rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	healthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(testCtx, rw, rw)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(testCtx, rw, rw, kms)
 	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
 
-	s, err := hosts.NewService(testCtx, repoFn, pluginRepoFn, 1000)
+	s, err := hosts.NewService(testCtx, repoFn, pluginRepoFn, healthRepoFn, 1000)
 	require.NoError(err, "Couldn't create a new host set service.")
 	req := &pbs.DeleteHostRequest{
 		Id: h.GetPublicId(),
@@ -1196,6 +1235,9 @@ func TestCreate(t *testing.T) {
 	pluginRepoFn := func() (*hostplugin.Repository, error) {
 		return hostplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	healthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
@@ -1376,7 +1418,7 @@ func TestCreate(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, healthRepoFn, 1000)
 			require.NoError(err, "Failed to create a new host set service.")
 
 			got, gErr := s.CreateHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
@@ -1440,6 +1482,9 @@ func TestUpdate_Static(t *testing.T) {
 	pluginRepoFn := func() (*hostplugin.Repository, error) {
 		return hostplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	healthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
@@ -1466,7 +1511,7 @@ func TestUpdate_Static(t *testing.T) {
 
 	hCreated := h.GetCreateTime().GetTimestamp().AsTime()
 
-	tested, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+	tested, err := hosts.NewService(ctx, repoFn, pluginRepoFn, healthRepoFn, 1000)
 	require.NoError(t, err, "Failed to create a new host set service.")
 
 	cases := []struct {
@@ -1915,6 +1960,9 @@ func TestUpdate_Plugin(t *testing.T) {
 	pluginRepoFn := func() (*hostplugin.Repository, error) {
 		return hostplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	healthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
@@ -1923,7 +1971,7 @@ func TestUpdate_Plugin(t *testing.T) {
 	hc := hostplugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 	h := hostplugin.TestHost(t, conn, hc.GetPublicId(), "test")
 
-	tested, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+	tested, err := hosts.NewService(ctx, repoFn, pluginRepoFn, healthRepoFn, 1000)
 	require.NoError(t, err)
 
 	got, err := tested.UpdateHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), &pbs.UpdateHostRequest{
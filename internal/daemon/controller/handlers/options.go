@@ -36,6 +36,7 @@ type options struct {
 	WithManagedGroupIds             []string
 	WithMemberIds                   []string
 	WithHostSetIds                  []string
+	WithHostHealth                  string
 }
 
 func getDefaultOptions() options {
@@ -122,3 +123,11 @@ func WithHostSetIds(ids []string) Option {
 		o.WithHostSetIds = ids
 	}
 }
+
+// WithHostHealth provides an option when creating responses to include the
+// given host health if allowed
+func WithHostHealth(health string) Option {
+	return func(o *options) {
+		o.WithHostHealth = health
+	}
+}
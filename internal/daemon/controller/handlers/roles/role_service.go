@@ -1286,7 +1286,9 @@ func validateAddRolePrincipalsRequest(req *pbs.AddRolePrincipalsRequest) error {
 		if !handlers.ValidId(handlers.Id(id), globals.GroupPrefix) &&
 			!handlers.ValidId(handlers.Id(id), globals.UserPrefix) &&
 			!handlers.ValidId(handlers.Id(id), globals.OidcManagedGroupPrefix) &&
-			!handlers.ValidId(handlers.Id(id), globals.LdapManagedGroupPrefix) {
+			!handlers.ValidId(handlers.Id(id), globals.LdapManagedGroupPrefix) &&
+			!handlers.ValidId(handlers.Id(id), globals.JwtManagedGroupPrefix) &&
+			!handlers.ValidId(handlers.Id(id), globals.SamlManagedGroupPrefix) {
 			badFields["principal_ids"] = "Must only have valid user, group, and/or managed group ids."
 			break
 		}
@@ -1313,7 +1315,9 @@ func validateSetRolePrincipalsRequest(req *pbs.SetRolePrincipalsRequest) error {
 		if !handlers.ValidId(handlers.Id(id), globals.GroupPrefix) &&
 			!handlers.ValidId(handlers.Id(id), globals.UserPrefix) &&
 			!handlers.ValidId(handlers.Id(id), globals.OidcManagedGroupPrefix) &&
-			!handlers.ValidId(handlers.Id(id), globals.LdapManagedGroupPrefix) {
+			!handlers.ValidId(handlers.Id(id), globals.LdapManagedGroupPrefix) &&
+			!handlers.ValidId(handlers.Id(id), globals.JwtManagedGroupPrefix) &&
+			!handlers.ValidId(handlers.Id(id), globals.SamlManagedGroupPrefix) {
 			badFields["principal_ids"] = "Must only have valid user, group, and/or managed group ids."
 			break
 		}
@@ -1343,7 +1347,9 @@ func validateRemoveRolePrincipalsRequest(req *pbs.RemoveRolePrincipalsRequest) e
 		if !handlers.ValidId(handlers.Id(id), globals.GroupPrefix) &&
 			!handlers.ValidId(handlers.Id(id), globals.UserPrefix) &&
 			!handlers.ValidId(handlers.Id(id), globals.OidcManagedGroupPrefix) &&
-			!handlers.ValidId(handlers.Id(id), globals.LdapManagedGroupPrefix) {
+			!handlers.ValidId(handlers.Id(id), globals.LdapManagedGroupPrefix) &&
+			!handlers.ValidId(handlers.Id(id), globals.JwtManagedGroupPrefix) &&
+			!handlers.ValidId(handlers.Id(id), globals.SamlManagedGroupPrefix) {
 			badFields["principal_ids"] = "Must only have valid user, group, and/or managed group ids."
 			break
 		}
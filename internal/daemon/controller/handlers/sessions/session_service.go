@@ -25,7 +25,10 @@ import (
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/sessions"
+	targetspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/mr-tron/base58"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -37,6 +40,8 @@ var (
 		action.ReadSelf,
 		action.Cancel,
 		action.CancelSelf,
+		action.Shadow,
+		action.Approve,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -366,6 +371,142 @@ func (s Service) CancelSession(ctx context.Context, req *pbs.CancelSessionReques
 	return &pbs.CancelSessionResponse{Item: item}, nil
 }
 
+// ShadowSession implements the interface pbs.SessionServiceServer.
+func (s Service) ShadowSession(ctx context.Context, req *pbs.ShadowSessionRequest) (*pbs.ShadowSessionResponse, error) {
+	const op = "sessions.(Service).ShadowSession"
+
+	if err := validateShadowRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Shadow, false)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	ses, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if ses.UserId == authResults.UserId {
+		return nil, handlers.InvalidArgumentErrorf("Users cannot shadow their own sessions.", map[string]string{
+			globals.IdField: "This session belongs to the requesting user.",
+		})
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	shadow, err := session.NewShadow(ctx, ses.GetPublicId(), authResults.UserId, req.GetReadWrite())
+	if err != nil {
+		return nil, err
+	}
+	shadow, err = repo.CreateShadow(ctx, shadow)
+	if err != nil {
+		if errors.Match(errors.T(errors.Conflict), err) {
+			return nil, handlers.ConflictErrorf(fmt.Sprintf("Session %q is not active or has no connected connections to shadow.", ses.GetPublicId()))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create shadow"))
+	}
+
+	sad := &targetspb.SessionAuthorizationData{
+		SessionId:       ses.GetPublicId(),
+		TargetId:        ses.TargetId,
+		Scope:           authResults.Scope,
+		CreatedTime:     ses.CreateTime.GetTimestamp(),
+		Expiration:      ses.ExpirationTime.GetTimestamp(),
+		Type:            target.SubtypeFromId(ses.TargetId).String(),
+		Certificate:     ses.Certificate,
+		PrivateKey:      ses.CertificatePrivateKey,
+		HostId:          ses.HostId,
+		Endpoint:        ses.Endpoint,
+		WorkerInfo:      []*targetspb.WorkerInfo{{Address: shadow.WorkerAddress}},
+		ConnectionLimit: -1,
+	}
+	marshaledSad, err := proto.Marshal(sad)
+	if err != nil {
+		return nil, err
+	}
+
+	item := shadowToProto(shadow)
+	item.Token = shadow.Token
+	item.AuthorizationToken = base58.FastBase58Encoding(marshaledSad)
+	return &pbs.ShadowSessionResponse{Item: item}, nil
+}
+
+// ApproveSessionShadow implements the interface pbs.SessionServiceServer.
+func (s Service) ApproveSessionShadow(ctx context.Context, req *pbs.ApproveSessionShadowRequest) (*pbs.ApproveSessionShadowResponse, error) {
+	const op = "sessions.(Service).ApproveSessionShadow"
+
+	if err := validateApproveShadowRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ReadSelf, false)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	ses, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var outputFields *perms.OutputFields
+	authorizedActions := authResults.FetchActionSetForId(ctx, ses.GetPublicId(), IdActions)
+
+	// The owner of the session can always approve shadows of it, anyone else
+	// needs to be able to read the session and approve shadows of it.
+	if ses.UserId != authResults.UserId {
+		if !authorizedActions.HasAction(action.Read) || !authorizedActions.HasAction(action.Approve) {
+			return nil, handlers.ForbiddenError()
+		}
+		outputFields = authResults.FetchOutputFields(perms.Resource{
+			Id:      ses.GetPublicId(),
+			ScopeId: ses.ProjectId,
+			Type:    resource.Session,
+		}, action.Approve).SelfOrDefaults(authResults.UserId)
+	} else {
+		var ok bool
+		outputFields, ok = requests.OutputFields(ctx)
+		if !ok {
+			return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+		}
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := repo.ApproveShadow(ctx, ses.GetPublicId(), req.GetShadowId(), authResults.UserId); err != nil {
+		switch {
+		case errors.IsNotFoundError(err):
+			return nil, handlers.NotFoundErrorf("Shadow %q doesn't exist for session %q.", req.GetShadowId(), ses.GetPublicId())
+		case errors.Match(errors.T(errors.Forbidden), err):
+			return nil, handlers.ForbiddenError()
+		case errors.Match(errors.T(errors.Conflict), err):
+			return nil, handlers.ConflictErrorf(fmt.Sprintf("Shadow %q is not pending approval.", req.GetShadowId()))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to approve shadow"))
+	}
+	ses, err = s.getFromRepo(ctx, ses.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+	}
+
+	item, err := toProto(ctx, ses, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ApproveSessionShadowResponse{Item: item}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*session.Session, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -406,7 +547,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type, ignor
 			res.Error = handlers.NotFoundError()
 			return res
 		}
-	case action.Read, action.ReadSelf, action.Cancel, action.CancelSelf:
+	case action.Read, action.ReadSelf, action.Cancel, action.CancelSelf, action.Shadow:
 		repo, err := s.repoFn()
 		if err != nil {
 			res.Error = err
@@ -532,9 +673,33 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 		}
 	}
 
+	if len(in.Shadows) > 0 {
+		if outputFields.Has(globals.ShadowsField) {
+			for _, sh := range in.Shadows {
+				out.Shadows = append(out.Shadows, shadowToProto(sh))
+			}
+		}
+	}
+
 	return &out, nil
 }
 
+func shadowToProto(in *session.Shadow) *pb.SessionShadow {
+	out := &pb.SessionShadow{
+		Id:           in.GetPublicId(),
+		UserId:       in.UserId,
+		ReadWrite:    in.ReadWrite,
+		Status:       in.Status.String(),
+		ApproverId:   in.ApproverId,
+		ConnectionId: in.ConnectionId,
+		CreatedTime:  in.CreateTime.GetTimestamp(),
+	}
+	if in.AttachTime != nil {
+		out.AttachedTime = in.AttachTime.GetTimestamp()
+	}
+	return out
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //   - The path passed in is correctly formatted
@@ -573,6 +738,31 @@ func validateCancelRequest(req *pbs.CancelSessionRequest) error {
 	return nil
 }
 
+func validateShadowRequest(req *pbs.ShadowSessionRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.SessionPrefix) {
+		badFields[globals.IdField] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
+func validateApproveShadowRequest(req *pbs.ApproveSessionShadowRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.SessionPrefix) {
+		badFields[globals.IdField] = "Improperly formatted identifier."
+	}
+	if !handlers.ValidId(handlers.Id(req.GetShadowId()), session.ShadowPrefix) {
+		badFields[globals.ShadowIdField] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
 func newOutputOpts(ctx context.Context, item *session.Session, scopeIds map[string]*scopes.ScopeInfo, authResults auth.VerifyResults) ([]handlers.Option, bool) {
 	res := perms.Resource{
 		Type:    resource.Session,
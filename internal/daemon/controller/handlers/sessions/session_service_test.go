@@ -1269,3 +1269,119 @@ func TestCancel(t *testing.T) {
 		})
 	}
 }
+
+func TestShadow(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	rw := db.New(conn)
+
+	ctx := context.Background()
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	sessRepoFn := func(opt ...session.Option) (*session.Repository, error) {
+		return session.NewRepository(ctx, rw, rw, kms, opt...)
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kms)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
+
+	o, p := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kms, o.GetPublicId())
+	uId := at.GetIamUserId()
+	hc := static.TestCatalogs(t, conn, p.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(context.Background(), t, conn, p.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+
+	sess := session.TestSession(t, conn, wrap, session.ComposedOf{
+		UserId:      uId,
+		HostId:      h.GetPublicId(),
+		TargetId:    tar.GetPublicId(),
+		HostSetId:   hs.GetPublicId(),
+		AuthTokenId: at.GetPublicId(),
+		ProjectId:   p.GetPublicId(),
+		Endpoint:    "tcp://127.0.0.1:22",
+	})
+
+	s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, 1000)
+	require.NoError(t, err, "Couldn't create new session service.")
+
+	requestInfo := authpb.RequestInfo{
+		TokenFormat: uint32(auth.AuthTokenTypeBearer),
+		PublicId:    at.GetPublicId(),
+		Token:       at.GetToken(),
+	}
+	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
+	authCtx := auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
+
+	shadowCases := []struct {
+		name string
+		req  *pbs.ShadowSessionRequest
+		err  error
+	}{
+		{
+			name: "Wrong id prefix",
+			req:  &pbs.ShadowSessionRequest{Id: "j_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "space in id",
+			req:  &pbs.ShadowSessionRequest{Id: globals.SessionPrefix + "_1 23456789"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Shadow without the shadow action",
+			req:  &pbs.ShadowSessionRequest{Id: sess.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.PermissionDenied),
+		},
+	}
+	for _, tc := range shadowCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.ShadowSession(authCtx, tc.req)
+			require.Error(gErr)
+			assert.True(errors.Is(gErr, tc.err), "ShadowSession(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+			assert.Nil(got)
+		})
+	}
+
+	approveCases := []struct {
+		name string
+		req  *pbs.ApproveSessionShadowRequest
+		err  error
+	}{
+		{
+			name: "Wrong id prefix",
+			req:  &pbs.ApproveSessionShadowRequest{Id: "j_1234567890", ShadowId: session.ShadowPrefix + "_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Wrong shadow id prefix",
+			req:  &pbs.ApproveSessionShadowRequest{Id: sess.GetPublicId(), ShadowId: "j_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Approve a non existing shadow",
+			req:  &pbs.ApproveSessionShadowRequest{Id: sess.GetPublicId(), ShadowId: session.ShadowPrefix + "_DoesntExis"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+	}
+	for _, tc := range approveCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.ApproveSessionShadow(authCtx, tc.req)
+			require.Error(gErr)
+			assert.True(errors.Is(gErr, tc.err), "ApproveSessionShadow(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+			assert.Nil(got)
+		})
+	}
+}
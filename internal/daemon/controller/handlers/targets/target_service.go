@@ -10,19 +10,25 @@ import (
 	"math/rand"
 	"net"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/alias"
 	"github.com/hashicorp/boundary/internal/credential"
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
+	credpostgres "github.com/hashicorp/boundary/internal/credential/postgres"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
 	wl "github.com/hashicorp/boundary/internal/daemon/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	intglobals "github.com/hashicorp/boundary/internal/globals"
 	"github.com/hashicorp/boundary/internal/host"
@@ -36,6 +42,7 @@ import (
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -88,6 +95,8 @@ var (
 		action.SetCredentialSources,
 		action.RemoveCredentialSources,
 		action.AuthorizeSession,
+		action.Approve,
+		action.ReverseConnect,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -123,9 +132,15 @@ type Service struct {
 	sessionRepoFn           session.RepositoryFactory
 	pluginHostRepoFn        common.PluginHostRepoFactory
 	staticHostRepoFn        common.StaticRepoFactory
+	hostHealthRepoFn        common.HostHealthRepoFactory
 	vaultCredRepoFn         common.VaultCredentialRepoFactory
 	staticCredRepoFn        common.StaticCredentialRepoFactory
+	pluginCredRepoFn        common.PluginCredentialRepoFactory
+	sshCaCredRepoFn         common.SshCaCredentialRepoFactory
+	postgresCredRepoFn      common.PostgresCredentialRepoFactory
+	aliasRepoFn             common.AliasRepoFactory
 	downstreams             common.Downstreamers
+	workerSelection         server.WorkerSelectionStrategy
 	kmsCache                *kms.Kms
 	workerStatusGracePeriod *atomic.Int64
 	maxPageSize             uint
@@ -144,10 +159,16 @@ func NewService(
 	sessionRepoFn session.RepositoryFactory,
 	pluginHostRepoFn common.PluginHostRepoFactory,
 	staticHostRepoFn common.StaticRepoFactory,
+	hostHealthRepoFn common.HostHealthRepoFactory,
 	vaultCredRepoFn common.VaultCredentialRepoFactory,
 	staticCredRepoFn common.StaticCredentialRepoFactory,
+	pluginCredRepoFn common.PluginCredentialRepoFactory,
+	sshCaCredRepoFn common.SshCaCredentialRepoFactory,
+	postgresCredRepoFn common.PostgresCredentialRepoFactory,
+	aliasRepoFn common.AliasRepoFactory,
 	downstreams common.Downstreamers,
 	workerStatusGracePeriod *atomic.Int64,
+	workerSelection server.WorkerSelectionStrategy,
 	maxPageSize uint,
 	controllerExt intglobals.ControllerExtension,
 ) (Service, error) {
@@ -173,15 +194,33 @@ func NewService(
 	if staticHostRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static host repository")
 	}
+	if hostHealthRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing host health repository")
+	}
 	if vaultCredRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing vault credential repository")
 	}
 	if staticCredRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static credential repository")
 	}
+	if pluginCredRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing plugin credential repository")
+	}
+	if sshCaCredRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing ssh ca credential repository")
+	}
+	if postgresCredRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing postgres credential repository")
+	}
+	if aliasRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing alias repository")
+	}
 	if maxPageSize == 0 {
 		maxPageSize = uint(globals.DefaultMaxPageSize)
 	}
+	if workerSelection == server.UnknownWorkerSelection {
+		workerSelection = server.RandomWorkerSelection
+	}
 	return Service{
 		repoFn:                  repoFn,
 		iamRepoFn:               iamRepoFn,
@@ -189,9 +228,15 @@ func NewService(
 		sessionRepoFn:           sessionRepoFn,
 		pluginHostRepoFn:        pluginHostRepoFn,
 		staticHostRepoFn:        staticHostRepoFn,
+		hostHealthRepoFn:        hostHealthRepoFn,
 		vaultCredRepoFn:         vaultCredRepoFn,
 		staticCredRepoFn:        staticCredRepoFn,
+		pluginCredRepoFn:        pluginCredRepoFn,
+		sshCaCredRepoFn:         sshCaCredRepoFn,
+		postgresCredRepoFn:      postgresCredRepoFn,
+		aliasRepoFn:             aliasRepoFn,
 		downstreams:             downstreams,
+		workerSelection:         workerSelection,
 		kmsCache:                kmsCache,
 		workerStatusGracePeriod: workerStatusGracePeriod,
 		maxPageSize:             maxPageSize,
@@ -232,10 +277,14 @@ func (s Service) ListTargets(ctx context.Context, req *pbs.ListTargetsRequest) (
 	}
 
 	// Get all user permissions for the requested scope(s).
-	userPerms := authResults.ACL().ListPermissions(authzScopes, resource.Target, IdActions, authResults.UserId)
+	userPerms := authResults.ACL().ListPermissions(authzScopes, resource.Target, IdActions, authResults.UserId, perms.WithFilteredGrants(true))
 	if len(userPerms) == 0 {
 		return &pbs.ListTargetsResponse{}, nil
 	}
+	// If any of the permissions come from grants with filters, the targets
+	// returned by the repository may include ones the user has not been
+	// granted, so each must be checked against the grants
+	filteredGrants := slices.ContainsFunc(userPerms, func(p perms.Permission) bool { return p.Filtered })
 
 	pageSize := int(s.maxPageSize)
 	// Use the requested page size only if it is smaller than
@@ -255,7 +304,11 @@ func (s Service) ListTargets(ctx context.Context, req *pbs.ListTargetsRequest) (
 		// to a domain type. This would allow filtering to happen in the domain, and we could
 		// remove this callback altogether.
 		filterItemFn = func(ctx context.Context, item target.Target) (bool, error) {
-			pbItem, err := toProto(ctx, item, newOutputOpts(ctx, item, authResults, authzScopes)...)
+			outputOpts, err := newOutputOpts(ctx, item, authResults, authzScopes, filteredGrants)
+			if err != nil {
+				return false, err
+			}
+			pbItem, err := toProto(ctx, item, outputOpts...)
 			if err != nil {
 				return false, err
 			}
@@ -270,6 +323,20 @@ func (s Service) ListTargets(ctx context.Context, req *pbs.ListTargetsRequest) (
 			return true, nil
 		}
 	}
+	if filteredGrants {
+		requestFilterFn := filterItemFn
+		filterItemFn = func(ctx context.Context, item target.Target) (bool, error) {
+			resourceItem, err := FilterableTarget(ctx, item)
+			if err != nil {
+				return false, err
+			}
+			pr := perms.Resource{Id: item.GetPublicId(), ScopeId: item.GetProjectId(), Type: resource.Target, Item: resourceItem}
+			if len(authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&pr))) == 0 {
+				return false, nil
+			}
+			return requestFilterFn(ctx, item)
+		}
+	}
 
 	repo, err := s.repoFn(target.WithPermissions(userPerms))
 	if err != nil {
@@ -319,7 +386,11 @@ func (s Service) ListTargets(ctx context.Context, req *pbs.ListTargetsRequest) (
 
 	finalItems := make([]*pb.Target, 0, len(listResp.Items))
 	for _, item := range listResp.Items {
-		item, err := toProto(ctx, item, newOutputOpts(ctx, item, authResults, authzScopes)...)
+		outputOpts, err := newOutputOpts(ctx, item, authResults, authzScopes, filteredGrants)
+		if err != nil {
+			return nil, err
+		}
+		item, err := toProto(ctx, item, outputOpts...)
 		if err != nil {
 			return nil, err
 		}
@@ -618,7 +689,7 @@ func (s Service) AddTargetCredentialSources(ctx context.Context, req *pbs.AddTar
 		return nil, authResults.Error
 	}
 
-	t, ts, cl, err := s.addCredentialSourcesInRepo(ctx, req.GetId(), req.GetBrokeredCredentialSourceIds(), req.GetInjectedApplicationCredentialSourceIds(), req.GetVersion())
+	t, ts, cl, err := s.addCredentialSourcesInRepo(ctx, req.GetId(), req.GetBrokeredCredentialSourceIds(), req.GetInjectedApplicationCredentialSourceIds(), req.GetExclusiveCheckoutCredentialSourceIds(), req.GetVersion())
 	if err != nil {
 		return nil, err
 	}
@@ -659,7 +730,7 @@ func (s Service) SetTargetCredentialSources(ctx context.Context, req *pbs.SetTar
 		return nil, authResults.Error
 	}
 
-	t, ts, cl, err := s.setCredentialSourcesInRepo(ctx, req.GetId(), req.GetBrokeredCredentialSourceIds(), req.GetInjectedApplicationCredentialSourceIds(), req.GetVersion())
+	t, ts, cl, err := s.setCredentialSourcesInRepo(ctx, req.GetId(), req.GetBrokeredCredentialSourceIds(), req.GetInjectedApplicationCredentialSourceIds(), req.GetExclusiveCheckoutCredentialSourceIds(), req.GetVersion())
 	if err != nil {
 		return nil, err
 	}
@@ -729,6 +800,72 @@ func (s Service) RemoveTargetCredentialSources(ctx context.Context, req *pbs.Rem
 	return &pbs.RemoveTargetCredentialSourcesResponse{Item: item}, nil
 }
 
+// ListTargetAccessRequests implements the interface pbs.TargetServiceServer.
+func (s Service) ListTargetAccessRequests(ctx context.Context, req *pbs.ListTargetAccessRequestsRequest) (*pbs.ListTargetAccessRequestsResponse, error) {
+	if err := validateListAccessRequestsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Approve)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	sessionRepo, err := s.sessionRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	ars, err := sessionRepo.ListAccessRequests(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*pb.AccessRequest, 0, len(ars))
+	for _, ar := range ars {
+		items = append(items, accessRequestToProto(ar))
+	}
+	return &pbs.ListTargetAccessRequestsResponse{Items: items}, nil
+}
+
+// ApproveTargetAccessRequest implements the interface pbs.TargetServiceServer.
+func (s Service) ApproveTargetAccessRequest(ctx context.Context, req *pbs.ApproveTargetAccessRequestRequest) (*pbs.ApproveTargetAccessRequestResponse, error) {
+	if err := validateApproveAccessRequestRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Approve)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	sessionRepo, err := s.sessionRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAccessRequestTarget(ctx, sessionRepo, req.GetId(), req.GetAccessRequestId()); err != nil {
+		return nil, err
+	}
+	var ar *session.AccessRequest
+	if req.GetDeny() {
+		ar, err = sessionRepo.DenyAccessRequest(ctx, req.GetAccessRequestId(), authResults.UserId)
+	} else {
+		validFor := time.Duration(req.GetValidForSeconds()) * time.Second
+		ar, err = sessionRepo.ApproveAccessRequest(ctx, req.GetAccessRequestId(), authResults.UserId, validFor)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ApproveTargetAccessRequestResponse{Item: accessRequestToProto(ar)}, nil
+}
+
+// checkAccessRequestTarget returns a not found error unless the access request
+// exists and was made for the target.
+func checkAccessRequestTarget(ctx context.Context, sessionRepo *session.Repository, targetId, accessRequestId string) error {
+	ar, err := sessionRepo.LookupAccessRequest(ctx, accessRequestId)
+	if err != nil {
+		return err
+	}
+	if ar == nil || ar.TargetId != targetId {
+		return handlers.NotFoundErrorf("Access request %q not found.", accessRequestId)
+	}
+	return nil
+}
+
 // If set, use the worker_filter or egress_worker_filter to filter the selected workers
 // and ensure we have workers available to service this request. The second return
 // argument is always nil.
@@ -777,10 +914,32 @@ func DefaultPostSessionAuthorizationCallback(context.Context, intglobals.Control
 
 func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSessionRequest) (_ *pbs.AuthorizeSessionResponse, retErr error) {
 	const op = "targets.(Service).AuthorizeSession"
+	// Reverse sessions give hosts in the target's network a way to reach the
+	// client, so they are authorized by their own action.
+	authorizeAction := action.AuthorizeSession
+	if req.GetReverse() {
+		authorizeAction = action.ReverseConnect
+	}
+	// An alias can be given in place of the target's id, in which case the
+	// session is authorized to the alias's destination and, unless a host is
+	// requested explicitly, the alias's host. The alias is resolved before the
+	// request can be authorized, so an alias that doesn't resolve to a target
+	// fails the same way as a target the caller is not authorized for,
+	// without revealing whether the alias exists.
+	if req.GetName() == "" && !handlers.ValidId(handlers.Id(req.GetId()), target.Prefixes()...) {
+		if value := alias.NormalizeValue(req.GetId()); alias.ValidValue(value) {
+			resolved, err := s.resolveAlias(ctx, req, value)
+			if err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("unable to resolve alias", "alias", value))
+				return nil, unresolvedAliasError(ctx, req.GetId(), authorizeAction)
+			}
+			req = resolved
+		}
+	}
 	if err := validateAuthorizeSessionRequest(req); err != nil {
 		return nil, err
 	}
-	authResults := s.authResult(ctx, req.GetId(), action.AuthorizeSession,
+	authResults := s.authResult(ctx, req.GetId(), authorizeAction,
 		target.WithName(req.GetName()),
 		target.WithProjectId(req.GetScopeId()),
 		target.WithProjectName(req.GetScopeName()),
@@ -821,6 +980,11 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	if t.GetDefaultPort() == 0 {
 		return nil, handlers.ConflictErrorf("Target does not have default port defined.")
 	}
+	if req.GetReverse() && t.GetType() != tcp.Subtype {
+		return nil, handlers.InvalidArgumentErrorf("Errors in provided fields.", map[string]string{
+			globals.ReverseField: "Reverse sessions are only supported for tcp targets.",
+		})
+	}
 
 	// Get the target information
 	repo, err := s.repoFn()
@@ -855,6 +1019,49 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		return nil, err
 	}
 
+	// Targets which require approval only authorize a session when an
+	// approved access request is redeemed. Otherwise a pending access request
+	// is created and returned in place of a session.
+	var sessionOpts []session.Option
+	switch {
+	case !t.GetApprovalRequired():
+		if req.GetAccessRequestId() != "" {
+			return nil, handlers.InvalidArgumentErrorf("Errors in provided fields.", map[string]string{
+				globals.AccessRequestIdField: "The target does not require approval.",
+			})
+		}
+	case req.GetAccessRequestId() == "":
+		ar, err := session.NewAccessRequest(ctx, t.GetPublicId(), t.GetProjectId(), authResults.UserId, req.GetReason())
+		if err != nil {
+			return nil, err
+		}
+		ar, err = sessionRepo.CreateAccessRequest(ctx, ar)
+		if err != nil {
+			return nil, err
+		}
+		return &pbs.AuthorizeSessionResponse{Item: &pb.SessionAuthorization{
+			TargetId:      t.GetPublicId(),
+			Scope:         authResults.Scope,
+			UserId:        authResults.UserId,
+			Type:          t.GetType().String(),
+			AccessRequest: accessRequestToProto(ar),
+		}}, nil
+	default:
+		ar, err := sessionRepo.LookupAccessRequest(ctx, req.GetAccessRequestId())
+		if err != nil {
+			return nil, err
+		}
+		if ar == nil || ar.TargetId != t.GetPublicId() || ar.UserId != authResults.UserId {
+			return nil, handlers.NotFoundErrorf("Access request %q not found.", req.GetAccessRequestId())
+		}
+		if ar.Status != session.AccessRequestApproved {
+			return nil, handlers.ApiErrorWithCodeAndMessage(
+				codes.FailedPrecondition,
+				"Access request %q is %s and cannot be redeemed.", ar.PublicId, ar.Status)
+		}
+		sessionOpts = append(sessionOpts, session.WithAccessRequestId(ar.PublicId))
+	}
+
 	p := strconv.FormatUint(uint64(t.GetDefaultPort()), 10)
 	var h, hostId, hostSetId string
 
@@ -920,7 +1127,16 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		}
 
 		if chosenEndpoint == nil {
-			chosenEndpoint = endpoints[rand.Intn(len(endpoints))]
+			healthy, err := s.healthyEndpoints(ctx, endpoints, t.GetDefaultPort())
+			if err != nil {
+				return nil, err
+			}
+			if len(healthy) == 0 {
+				return nil, handlers.ApiErrorWithCodeAndMessage(
+					codes.FailedPrecondition,
+					"All hosts available to the target are unhealthy.")
+			}
+			chosenEndpoint = healthy[rand.Intn(len(healthy))]
 		}
 
 		hostId = chosenEndpoint.HostId
@@ -954,7 +1170,9 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	}
 
 	// Get workers and filter down to ones that can service this request
-	selectedWorkers, err := serversRepo.ListWorkers(ctx, []string{scope.Global.String()}, server.WithLiveness(time.Duration(s.workerStatusGracePeriod.Load())))
+	selectedWorkers, err := serversRepo.ListWorkers(ctx, []string{scope.Global.String()},
+		server.WithLiveness(time.Duration(s.workerStatusGracePeriod.Load())),
+		server.WithActiveWorkers(true))
 	if err != nil {
 		return nil, err
 	}
@@ -970,46 +1188,71 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		return nil, err
 	}
 
-	// Randomize the workers
-	rand.Shuffle(len(selectedWorkers), func(i, j int) {
-		selectedWorkers[i], selectedWorkers[j] = selectedWorkers[j], selectedWorkers[i]
-	})
+	// Order the workers using the target's strategy, falling back to the
+	// controller's. Locality is determined by the endpoint address and then
+	// the client address.
+	workerSelection := s.workerSelection
+	if ts := t.GetWorkerSelectionStrategy(); ts != "" {
+		workerSelection = server.WorkerSelectionStrategy(ts)
+	}
+	var clientIp string
+	if reqInfo, ok := event.RequestInfoFromContext(ctx); ok {
+		clientIp = reqInfo.ClientIp
+	}
+	selectedWorkers = server.OrderWorkers(selectedWorkers, workerSelection, h, clientIp)
 
-	var vaultReqs []credential.Request
+	var vaultReqs, pluginReqs, sshCaReqs, postgresReqs []credential.Request
 	var staticIds []string
 	var dynCreds []*session.DynamicCredential
 	var staticCreds []*session.StaticCredential
 	for _, cs := range credSources {
 		switch cs.Type() {
 		case target.LibraryCredentialSourceType:
-			vaultReqs = append(vaultReqs, credential.Request{
+			req := credential.Request{
 				SourceId: cs.Id(),
 				Purpose:  cs.CredentialPurpose(),
-			})
+			}
+			switch globals.ResourceInfoFromPrefix(cs.Id()).Subtype {
+			case credplugin.Subtype:
+				pluginReqs = append(pluginReqs, req)
+			case sshca.Subtype:
+				sshCaReqs = append(sshCaReqs, req)
+			case credpostgres.Subtype:
+				postgresReqs = append(postgresReqs, req)
+			default:
+				vaultReqs = append(vaultReqs, req)
+			}
 			dynCreds = append(dynCreds, session.NewDynamicCredential(cs.Id(), cs.CredentialPurpose()))
 		case target.StaticCredentialSourceType:
 			staticIds = append(staticIds, cs.Id())
-			staticCreds = append(staticCreds, session.NewStaticCredential(cs.Id(), cs.CredentialPurpose()))
+			staticCreds = append(staticCreds, session.NewStaticCredential(cs.Id(), cs.CredentialPurpose(), session.WithExclusiveCheckout(cs.ExclusiveCheckout())))
 		}
 	}
 
 	expTime := timestamppb.Now()
 	expTime.Seconds += int64(t.GetSessionMaxSeconds())
 	sessionComposition := session.ComposedOf{
-		UserId:              authResults.UserId,
-		HostId:              hostId,
-		TargetId:            t.GetPublicId(),
-		HostSetId:           hostSetId,
-		AuthTokenId:         authResults.AuthTokenId,
-		ProjectId:           authResults.Scope.Id,
-		Endpoint:            endpointUrl.String(),
-		ExpirationTime:      &timestamp.Timestamp{Timestamp: expTime},
-		ConnectionLimit:     t.GetSessionConnectionLimit(),
-		WorkerFilter:        t.GetWorkerFilter(),
-		EgressWorkerFilter:  t.GetEgressWorkerFilter(),
-		IngressWorkerFilter: t.GetIngressWorkerFilter(),
-		DynamicCredentials:  dynCreds,
-		StaticCredentials:   staticCreds,
+		UserId:                   authResults.UserId,
+		HostId:                   hostId,
+		TargetId:                 t.GetPublicId(),
+		HostSetId:                hostSetId,
+		AuthTokenId:              authResults.AuthTokenId,
+		ProjectId:                authResults.Scope.Id,
+		Endpoint:                 endpointUrl.String(),
+		ExpirationTime:           &timestamp.Timestamp{Timestamp: expTime},
+		ConnectionLimit:          t.GetSessionConnectionLimit(),
+		TargetBandwidthLimitUp:   t.GetBandwidthLimitUp(),
+		TargetBandwidthLimitDown: t.GetBandwidthLimitDown(),
+		BandwidthLimitUp:         t.GetSessionBandwidthLimitUp(),
+		BandwidthLimitDown:       t.GetSessionBandwidthLimitDown(),
+		ConnectionRateLimit:      t.GetSessionConnectionRateLimit(),
+		IdleTimeout:              t.GetIdleTimeout(),
+		Reverse:                  req.GetReverse(),
+		WorkerFilter:             t.GetWorkerFilter(),
+		EgressWorkerFilter:       t.GetEgressWorkerFilter(),
+		IngressWorkerFilter:      t.GetIngressWorkerFilter(),
+		DynamicCredentials:       dynCreds,
+		StaticCredentials:        staticCreds,
 	}
 	if protoWorker != nil {
 		sessionComposition.ProtocolWorkerId = protoWorker.GetPublicId()
@@ -1022,7 +1265,7 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	if err != nil {
 		return nil, err
 	}
-	sess, err = sessionRepo.CreateSession(ctx, wrapper, sess, wl.WorkerList(selectedWorkers).Addresses())
+	sess, err = sessionRepo.CreateSession(ctx, wrapper, sess, wl.WorkerList(selectedWorkers).Addresses(), sessionOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1071,6 +1314,62 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		}()
 	}
 
+	if len(pluginReqs) > 0 {
+		credRepo, err := s.pluginCredRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		pluginDynamic, err := credRepo.Issue(ctx, sess.GetPublicId(), pluginReqs, credential.WithTemplateData(authResults.UserData))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		dynamic = append(dynamic, pluginDynamic...)
+		defer func() {
+			if retErr != nil {
+				// Revoke issued credentials in case of errors.
+				// Use new context for deletion in case error is because of context cancellation.
+				deleteCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+				defer cancel()
+				err := credRepo.Revoke(deleteCtx, sess.PublicId)
+				retErr = stderrors.Join(retErr, err)
+			}
+		}()
+	}
+
+	if len(sshCaReqs) > 0 {
+		credRepo, err := s.sshCaCredRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		sshCaDynamic, err := credRepo.Issue(ctx, sess.GetPublicId(), sshCaReqs, credential.WithTemplateData(authResults.UserData))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		dynamic = append(dynamic, sshCaDynamic...)
+	}
+
+	if len(postgresReqs) > 0 {
+		credRepo, err := s.postgresCredRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		postgresDynamic, err := credRepo.Issue(ctx, sess.GetPublicId(), postgresReqs)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		dynamic = append(dynamic, postgresDynamic...)
+		defer func() {
+			if retErr != nil {
+				// Revoke issued credentials in case of errors.
+				// Use new context for deletion in case error is because of context cancellation.
+				deleteCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+				defer cancel()
+				err := credRepo.Revoke(deleteCtx, sess.PublicId)
+				retErr = stderrors.Join(retErr, err)
+			}
+		}()
+	}
+
 	if len(staticIds) > 0 {
 		credRepo, err := s.staticCredRepoFn()
 		if err != nil {
@@ -1151,20 +1450,27 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	}
 
 	sad := &pb.SessionAuthorizationData{
-		SessionId:         sess.PublicId,
-		TargetId:          t.GetPublicId(),
-		Scope:             authResults.Scope,
-		CreatedTime:       sess.CreateTime.GetTimestamp(),
-		Expiration:        sess.ExpirationTime.GetTimestamp(),
-		EndpointPort:      t.GetDefaultPort(),
-		Type:              t.GetType().String(),
-		Certificate:       sess.Certificate,
-		PrivateKey:        sess.CertificatePrivateKey,
-		HostId:            hostId,
-		Endpoint:          endpointUrl.String(),
-		WorkerInfo:        wl.WorkerList(selectedWorkers).WorkerInfos(),
-		ConnectionLimit:   t.GetSessionConnectionLimit(),
-		DefaultClientPort: t.GetDefaultClientPort(),
+		SessionId:                sess.PublicId,
+		TargetId:                 t.GetPublicId(),
+		Scope:                    authResults.Scope,
+		CreatedTime:              sess.CreateTime.GetTimestamp(),
+		Expiration:               sess.ExpirationTime.GetTimestamp(),
+		EndpointPort:             t.GetDefaultPort(),
+		Type:                     t.GetType().String(),
+		Certificate:              sess.Certificate,
+		PrivateKey:               sess.CertificatePrivateKey,
+		HostId:                   hostId,
+		Endpoint:                 endpointUrl.String(),
+		WorkerInfo:               wl.WorkerList(selectedWorkers).WorkerInfos(),
+		ConnectionLimit:          t.GetSessionConnectionLimit(),
+		DefaultClientPort:        t.GetDefaultClientPort(),
+		TargetBandwidthLimitUp:   t.GetBandwidthLimitUp(),
+		TargetBandwidthLimitDown: t.GetBandwidthLimitDown(),
+		BandwidthLimitUp:         t.GetSessionBandwidthLimitUp(),
+		BandwidthLimitDown:       t.GetSessionBandwidthLimitDown(),
+		ConnectionRateLimit:      t.GetSessionConnectionRateLimit(),
+		IdleTimeout:              t.GetIdleTimeout(),
+		Reverse:                  req.GetReverse(),
 	}
 	marshaledSad, err := proto.Marshal(sad)
 	if err != nil {
@@ -1226,6 +1532,26 @@ func (s Service) getFromRepo(ctx context.Context, id string) (target.Target, []t
 	return u, hs, cl, nil
 }
 
+// validateLimits checks that the bandwidth and connection rate limits of the
+// target, if set, are not negative.
+func validateLimits(item *pb.Target, badFields map[string]string) {
+	if v := item.GetBandwidthLimitUp(); v != nil && v.GetValue() < 0 {
+		badFields[globals.BandwidthLimitUpField] = "This must be 0 (unlimited) or greater than zero."
+	}
+	if v := item.GetBandwidthLimitDown(); v != nil && v.GetValue() < 0 {
+		badFields[globals.BandwidthLimitDownField] = "This must be 0 (unlimited) or greater than zero."
+	}
+	if v := item.GetSessionBandwidthLimitUp(); v != nil && v.GetValue() < 0 {
+		badFields[globals.SessionBandwidthLimitUpField] = "This must be 0 (unlimited) or greater than zero."
+	}
+	if v := item.GetSessionBandwidthLimitDown(); v != nil && v.GetValue() < 0 {
+		badFields[globals.SessionBandwidthLimitDownField] = "This must be 0 (unlimited) or greater than zero."
+	}
+	if v := item.GetSessionConnectionRateLimit(); v != nil && v.GetValue() < 0 {
+		badFields[globals.SessionConnectionRateLimitField] = "This must be 0 (unlimited) or greater than zero."
+	}
+}
+
 func (s Service) createInRepo(ctx context.Context, item *pb.Target) (target.Target, []target.HostSource, []target.CredentialSource, error) {
 	const op = "targets.(Service).createInRepo"
 	opts := []target.Option{target.WithName(item.GetName().GetValue())}
@@ -1247,6 +1573,30 @@ func (s Service) createInRepo(ctx context.Context, item *pb.Target) (target.Targ
 	if item.GetAddress() != nil {
 		opts = append(opts, target.WithAddress(strings.TrimSpace(item.GetAddress().GetValue())))
 	}
+	if item.GetApprovalRequired() != nil {
+		opts = append(opts, target.WithApprovalRequired(item.GetApprovalRequired().GetValue()))
+	}
+	if item.GetWorkerSelectionStrategy() != nil {
+		opts = append(opts, target.WithWorkerSelectionStrategy(item.GetWorkerSelectionStrategy().GetValue()))
+	}
+	if item.GetBandwidthLimitUp() != nil {
+		opts = append(opts, target.WithBandwidthLimitUp(item.GetBandwidthLimitUp().GetValue()))
+	}
+	if item.GetBandwidthLimitDown() != nil {
+		opts = append(opts, target.WithBandwidthLimitDown(item.GetBandwidthLimitDown().GetValue()))
+	}
+	if item.GetSessionBandwidthLimitUp() != nil {
+		opts = append(opts, target.WithSessionBandwidthLimitUp(item.GetSessionBandwidthLimitUp().GetValue()))
+	}
+	if item.GetSessionBandwidthLimitDown() != nil {
+		opts = append(opts, target.WithSessionBandwidthLimitDown(item.GetSessionBandwidthLimitDown().GetValue()))
+	}
+	if item.GetSessionConnectionRateLimit() != nil {
+		opts = append(opts, target.WithSessionConnectionRateLimit(item.GetSessionConnectionRateLimit().GetValue()))
+	}
+	if item.GetIdleTimeout() != nil {
+		opts = append(opts, target.WithIdleTimeout(item.GetIdleTimeout().GetValue()))
+	}
 
 	attr, err := subtypeRegistry.newAttribute(target.SubtypeFromType(item.GetType()), item.GetAttrs())
 	if err != nil {
@@ -1305,6 +1655,30 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 		dbMask = append(dbMask, "Address")
 		opts = append(opts, target.WithAddress(strings.TrimSpace(item.GetAddress().GetValue())))
 	}
+	if item.GetApprovalRequired() != nil {
+		opts = append(opts, target.WithApprovalRequired(item.GetApprovalRequired().GetValue()))
+	}
+	if item.GetWorkerSelectionStrategy() != nil {
+		opts = append(opts, target.WithWorkerSelectionStrategy(item.GetWorkerSelectionStrategy().GetValue()))
+	}
+	if item.GetBandwidthLimitUp() != nil {
+		opts = append(opts, target.WithBandwidthLimitUp(item.GetBandwidthLimitUp().GetValue()))
+	}
+	if item.GetBandwidthLimitDown() != nil {
+		opts = append(opts, target.WithBandwidthLimitDown(item.GetBandwidthLimitDown().GetValue()))
+	}
+	if item.GetSessionBandwidthLimitUp() != nil {
+		opts = append(opts, target.WithSessionBandwidthLimitUp(item.GetSessionBandwidthLimitUp().GetValue()))
+	}
+	if item.GetSessionBandwidthLimitDown() != nil {
+		opts = append(opts, target.WithSessionBandwidthLimitDown(item.GetSessionBandwidthLimitDown().GetValue()))
+	}
+	if item.GetSessionConnectionRateLimit() != nil {
+		opts = append(opts, target.WithSessionConnectionRateLimit(item.GetSessionConnectionRateLimit().GetValue()))
+	}
+	if item.GetIdleTimeout() != nil {
+		opts = append(opts, target.WithIdleTimeout(item.GetIdleTimeout().GetValue()))
+	}
 	subtype := target.SubtypeFromId(id)
 
 	attr, err := subtypeRegistry.newAttribute(subtype, item.GetAttrs())
@@ -1442,7 +1816,7 @@ func (s Service) removeHostSourcesInRepo(ctx context.Context, targetId string, h
 	return out, hs, cl, nil
 }
 
-func (s Service) addCredentialSourcesInRepo(ctx context.Context, targetId string, brokeredIds []string, injectedAppIds []string, version uint32) (target.Target, []target.HostSource, []target.CredentialSource, error) {
+func (s Service) addCredentialSourcesInRepo(ctx context.Context, targetId string, brokeredIds []string, injectedAppIds []string, exclusiveIds []string, version uint32) (target.Target, []target.HostSource, []target.CredentialSource, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, nil, nil, err
@@ -1455,6 +1829,9 @@ func (s Service) addCredentialSourcesInRepo(ctx context.Context, targetId string
 	if len(injectedAppIds) > 0 {
 		creds.InjectedApplicationCredentialIds = strutil.RemoveDuplicates(injectedAppIds, false)
 	}
+	if len(exclusiveIds) > 0 {
+		creds.ExclusiveCheckoutCredentialIds = strutil.RemoveDuplicates(exclusiveIds, false)
+	}
 
 	out, err := repo.AddTargetCredentialSources(ctx, targetId, version, creds)
 	if err != nil {
@@ -1470,7 +1847,7 @@ func (s Service) addCredentialSourcesInRepo(ctx context.Context, targetId string
 	return out, hs, credSources, nil
 }
 
-func (s Service) setCredentialSourcesInRepo(ctx context.Context, targetId string, brokeredIds []string, injectedAppIds []string, version uint32) (target.Target, []target.HostSource, []target.CredentialSource, error) {
+func (s Service) setCredentialSourcesInRepo(ctx context.Context, targetId string, brokeredIds []string, injectedAppIds []string, exclusiveIds []string, version uint32) (target.Target, []target.HostSource, []target.CredentialSource, error) {
 	const op = "targets.(Service).setCredentialSourcesInRepo"
 	repo, err := s.repoFn()
 	if err != nil {
@@ -1484,6 +1861,9 @@ func (s Service) setCredentialSourcesInRepo(ctx context.Context, targetId string
 	if len(injectedAppIds) > 0 {
 		ids.InjectedApplicationCredentialIds = strutil.RemoveDuplicates(injectedAppIds, false)
 	}
+	if len(exclusiveIds) > 0 {
+		ids.ExclusiveCheckoutCredentialIds = strutil.RemoveDuplicates(exclusiveIds, false)
+	}
 
 	_, _, _, err = repo.SetTargetCredentialSources(ctx, targetId, version, ids)
 	if err != nil {
@@ -1536,6 +1916,32 @@ func (s Service) removeCredentialSourcesInRepo(ctx context.Context, targetId str
 	return out, hs, credSources, nil
 }
 
+// healthyEndpoints returns the endpoints whose hosts are not known to be
+// unhealthy on the given port. Hosts which have not been health checked are
+// assumed to be healthy.
+func (s Service) healthyEndpoints(ctx context.Context, endpoints []*host.Endpoint, port uint32) ([]*host.Endpoint, error) {
+	const op = "targets.(Service).healthyEndpoints"
+	healthRepo, err := s.hostHealthRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	hostIds := make([]string, 0, len(endpoints))
+	for _, ep := range endpoints {
+		hostIds = append(hostIds, ep.HostId)
+	}
+	health, err := healthRepo.ListHealth(ctx, strutil.RemoveDuplicates(hostIds, false), port)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ret := make([]*host.Endpoint, 0, len(endpoints))
+	for _, ep := range endpoints {
+		if health[ep.HostId] != host.Unhealthy {
+			ret = append(ret, ep)
+		}
+	}
+	return ret, nil
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type, lookupOpt ...target.Option) auth.VerifyResults {
 	res := auth.VerifyResults{}
 
@@ -1581,7 +1987,12 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type, looku
 		}
 		id = t.GetPublicId()
 		parentId = t.GetProjectId()
-		opts = append(opts, auth.WithId(id))
+		resourceItem, err := FilterableTarget(ctx, t)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		opts = append(opts, auth.WithId(id), auth.WithResourceItem(resourceItem))
 	}
 	opts = append(opts, auth.WithScopeId(parentId))
 	ret := auth.Verify(ctx, opts...)
@@ -1589,6 +2000,17 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type, looku
 	return ret
 }
 
+// FilterableTarget returns the representation of the target that grant
+// filters are evaluated against. This is the full API representation of the
+// target, regardless of the output fields granted to the user.
+func FilterableTarget(ctx context.Context, t target.Target) (any, error) {
+	pbItem, err := toProto(ctx, t, handlers.WithOutputFields((&perms.OutputFields{}).AddFields([]string{"*"})))
+	if err != nil {
+		return nil, err
+	}
+	return subtypes.Filterable(ctx, pbItem)
+}
+
 func toProto(ctx context.Context, in target.Target, opt ...handlers.Option) (*pb.Target, error) {
 	const op = "target_service.toProto"
 	opts := handlers.GetOpts(opt...)
@@ -1661,6 +2083,30 @@ func toProto(ctx context.Context, in target.Target, opt ...handlers.Option) (*pb
 	if outputFields.Has(globals.AddressField) {
 		out.Address = wrapperspb.String(in.GetAddress())
 	}
+	if outputFields.Has(globals.ApprovalRequiredField) && in.GetApprovalRequired() {
+		out.ApprovalRequired = wrapperspb.Bool(true)
+	}
+	if outputFields.Has(globals.WorkerSelectionStrategyField) && in.GetWorkerSelectionStrategy() != "" {
+		out.WorkerSelectionStrategy = wrapperspb.String(in.GetWorkerSelectionStrategy())
+	}
+	if outputFields.Has(globals.BandwidthLimitUpField) && in.GetBandwidthLimitUp() != 0 {
+		out.BandwidthLimitUp = wrapperspb.Int64(in.GetBandwidthLimitUp())
+	}
+	if outputFields.Has(globals.BandwidthLimitDownField) && in.GetBandwidthLimitDown() != 0 {
+		out.BandwidthLimitDown = wrapperspb.Int64(in.GetBandwidthLimitDown())
+	}
+	if outputFields.Has(globals.SessionBandwidthLimitUpField) && in.GetSessionBandwidthLimitUp() != 0 {
+		out.SessionBandwidthLimitUp = wrapperspb.Int64(in.GetSessionBandwidthLimitUp())
+	}
+	if outputFields.Has(globals.SessionBandwidthLimitDownField) && in.GetSessionBandwidthLimitDown() != 0 {
+		out.SessionBandwidthLimitDown = wrapperspb.Int64(in.GetSessionBandwidthLimitDown())
+	}
+	if outputFields.Has(globals.SessionConnectionRateLimitField) && in.GetSessionConnectionRateLimit() != 0 {
+		out.SessionConnectionRateLimit = wrapperspb.Int32(in.GetSessionConnectionRateLimit())
+	}
+	if outputFields.Has(globals.IdleTimeoutField) && in.GetIdleTimeout() != 0 {
+		out.IdleTimeout = wrapperspb.UInt32(in.GetIdleTimeout())
+	}
 
 	var brokeredSources, injectedAppSources []*pb.CredentialSource
 	var brokeredSourceIds, injectedAppSourceIds []string
@@ -1672,12 +2118,14 @@ func toProto(ctx context.Context, in target.Target, opt ...handlers.Option) (*pb
 			brokeredSources = append(brokeredSources, &pb.CredentialSource{
 				Id:                cs.Id(),
 				CredentialStoreId: cs.CredentialStoreId(),
+				ExclusiveCheckout: cs.ExclusiveCheckout(),
 			})
 
 		case credential.InjectedApplicationPurpose:
 			injectedAppSources = append(injectedAppSources, &pb.CredentialSource{
 				Id:                cs.Id(),
 				CredentialStoreId: cs.CredentialStoreId(),
+				ExclusiveCheckout: cs.ExclusiveCheckout(),
 			})
 			injectedAppSourceIds = append(injectedAppSourceIds, cs.Id())
 
@@ -1706,6 +2154,22 @@ func toProto(ctx context.Context, in target.Target, opt ...handlers.Option) (*pb
 	return &out, nil
 }
 
+func accessRequestToProto(in *session.AccessRequest) *pb.AccessRequest {
+	return &pb.AccessRequest{
+		Id:                     in.GetPublicId(),
+		TargetId:               in.TargetId,
+		UserId:                 in.UserId,
+		Reason:                 in.Reason,
+		Status:                 in.Status.String(),
+		ApproverId:             in.ApproverId,
+		ApprovalExpirationTime: in.ApprovalExpirationTime.GetTimestamp(),
+		SessionId:              in.SessionId,
+		CreatedTime:            in.CreateTime.GetTimestamp(),
+		UpdatedTime:            in.UpdateTime.GetTimestamp(),
+		Version:                in.Version,
+	}
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //   - The path passed in is correctly formatted
@@ -1769,6 +2233,11 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 				badFields[globals.AddressField] = fmt.Sprintf("Error parsing address: %v.", err)
 			}
 		}
+		if strategy := req.GetItem().GetWorkerSelectionStrategy(); strategy != nil && !server.WorkerSelectionStrategy(strategy.GetValue()).Valid() {
+			badFields[globals.WorkerSelectionStrategyField] = fmt.Sprintf("Unknown worker selection strategy; must be one of %q, %q, or %q.",
+				server.RandomWorkerSelection, server.LeastConnectionsWorkerSelection, server.LocalityWorkerSelection)
+		}
+		validateLimits(req.GetItem(), badFields)
 		subtype := target.SubtypeFromType(req.GetItem().GetType())
 		_, err := subtypeRegistry.get(subtype)
 		if err != nil {
@@ -1845,6 +2314,11 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 				badFields[globals.AddressField] = fmt.Sprintf("Error parsing address: %v.", err)
 			}
 		}
+		if strategy := req.GetItem().GetWorkerSelectionStrategy(); strategy != nil && !server.WorkerSelectionStrategy(strategy.GetValue()).Valid() {
+			badFields[globals.WorkerSelectionStrategyField] = fmt.Sprintf("Unknown worker selection strategy; must be one of %q, %q, or %q.",
+				server.RandomWorkerSelection, server.LeastConnectionsWorkerSelection, server.LocalityWorkerSelection)
+		}
+		validateLimits(req.GetItem(), badFields)
 		subtype := target.SubtypeFromId(req.GetId())
 		_, err := subtypeRegistry.get(subtype)
 		if err != nil {
@@ -1886,8 +2360,17 @@ func validateListRequest(ctx context.Context, req *pbs.ListTargetsRequest) error
 	return nil
 }
 
-func newOutputOpts(ctx context.Context, item target.Target, authResults auth.VerifyResults, authzScopes map[string]*scopes.ScopeInfo) []handlers.Option {
+// newOutputOpts returns the options for building the proto of a listed target.
+// If withResourceItem is true, the target is evaluated against any grant
+// filters when determining its output fields and authorized actions.
+func newOutputOpts(ctx context.Context, item target.Target, authResults auth.VerifyResults, authzScopes map[string]*scopes.ScopeInfo, withResourceItem bool) ([]handlers.Option, error) {
 	pr := perms.Resource{Id: item.GetPublicId(), ScopeId: item.GetProjectId(), Type: resource.Target}
+	if withResourceItem {
+		var err error
+		if pr.Item, err = FilterableTarget(ctx, item); err != nil {
+			return nil, err
+		}
+	}
 	outputFields := authResults.FetchOutputFields(pr, action.List).SelfOrDefaults(authResults.UserId)
 
 	outputOpts := make([]handlers.Option, 0, 3)
@@ -1900,7 +2383,7 @@ func newOutputOpts(ctx context.Context, item target.Target, authResults auth.Ver
 		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&pr)).Strings()
 		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
 	}
-	return outputOpts
+	return outputOpts, nil
 }
 
 func validateAddHostSourcesRequest(req *pbs.AddTargetHostSourcesRequest) error {
@@ -1996,6 +2479,9 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
 			globals.VaultSshCertificateCredentialLibraryPrefix,
+			globals.PluginCredentialLibraryPrefix,
+			globals.SshCaCredentialLibraryPrefix,
+			globals.PostgresCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix) {
@@ -2003,6 +2489,7 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 			break
 		}
 	}
+	validateExclusiveCheckoutIds(badFields, req.GetBrokeredCredentialSourceIds(), req.GetInjectedApplicationCredentialSourceIds(), req.GetExclusiveCheckoutCredentialSourceIds())
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
 	}
@@ -2032,6 +2519,9 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
 			globals.VaultSshCertificateCredentialLibraryPrefix,
+			globals.PluginCredentialLibraryPrefix,
+			globals.SshCaCredentialLibraryPrefix,
+			globals.PostgresCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix) {
@@ -2039,12 +2529,33 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 			break
 		}
 	}
+	validateExclusiveCheckoutIds(badFields, req.GetBrokeredCredentialSourceIds(), req.GetInjectedApplicationCredentialSourceIds(), req.GetExclusiveCheckoutCredentialSourceIds())
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
 	}
 	return nil
 }
 
+// validateExclusiveCheckoutIds checks that every exclusive checkout id is a
+// static credential which is also provided as a brokered or injected
+// application credential source.
+func validateExclusiveCheckoutIds(badFields map[string]string, brokeredIds, injectedAppIds, exclusiveIds []string) {
+	for _, id := range exclusiveIds {
+		if !handlers.ValidId(handlers.Id(id),
+			globals.UsernamePasswordCredentialPrefix,
+			globals.UsernamePasswordCredentialPreviousPrefix,
+			globals.SshPrivateKeyCredentialPrefix,
+			globals.JsonCredentialPrefix) {
+			badFields[globals.ExclusiveCheckoutCredentialSourceIdsField] = fmt.Sprintf("Exclusive checkout is only supported for static credentials, got %q.", id)
+			return
+		}
+		if !slices.Contains(brokeredIds, id) && !slices.Contains(injectedAppIds, id) {
+			badFields[globals.ExclusiveCheckoutCredentialSourceIdsField] = fmt.Sprintf("Credential %q must also be provided as a brokered or injected application credential source.", id)
+			return
+		}
+	}
+}
+
 func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourcesRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetId()), target.Prefixes()...) {
@@ -2072,6 +2583,9 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
 			globals.VaultSshCertificateCredentialLibraryPrefix,
+			globals.PluginCredentialLibraryPrefix,
+			globals.SshCaCredentialLibraryPrefix,
+			globals.PostgresCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
@@ -2086,6 +2600,47 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 	return nil
 }
 
+// resolveAlias returns a copy of req which refers to the destination of the
+// alias with the given value. Aliases which don't exist and aliases without a
+// destination are both reported as not found.
+func (s Service) resolveAlias(ctx context.Context, req *pbs.AuthorizeSessionRequest, value string) (*pbs.AuthorizeSessionRequest, error) {
+	const op = "targets.(Service).resolveAlias"
+	repo, err := s.aliasRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	a, err := repo.LookupAliasByValue(ctx, value)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if a == nil || a.GetDestinationId() == "" {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("alias %q does not resolve to a target", value))
+	}
+	resolved := proto.Clone(req).(*pbs.AuthorizeSessionRequest)
+	resolved.Id = a.GetDestinationId()
+	if resolved.GetHostId() == "" {
+		resolved.HostId = a.GetHostId()
+	}
+	return resolved, nil
+}
+
+// unresolvedAliasError returns the error of a request authorizing a session
+// through the alias value that doesn't resolve to a target. It's the error an
+// unauthorized request gets: unauthenticated callers get an unauthenticated
+// error and anyone else a forbidden error.
+func unresolvedAliasError(ctx context.Context, value string, a action.Type) error {
+	res := auth.Verify(ctx,
+		auth.WithType(resource.Target),
+		auth.WithAction(a),
+		auth.WithId(value),
+		auth.WithScopeId(scope.Global.String()),
+	)
+	if res.Error != nil {
+		return res.Error
+	}
+	return handlers.ForbiddenError()
+}
+
 func validateAuthorizeSessionRequest(req *pbs.AuthorizeSessionRequest) error {
 	badFields := map[string]string{}
 	nameEmpty := req.GetName() == ""
@@ -2121,6 +2676,37 @@ func validateAuthorizeSessionRequest(req *pbs.AuthorizeSessionRequest) error {
 			badFields[globals.HostIdField] = "Incorrectly formatted identifier."
 		}
 	}
+	if req.GetAccessRequestId() != "" && !handlers.ValidId(handlers.Id(req.GetAccessRequestId()), session.AccessRequestPrefix) {
+		badFields[globals.AccessRequestIdField] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateListAccessRequestsRequest(req *pbs.ListTargetAccessRequestsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), target.Prefixes()...) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateApproveAccessRequestRequest(req *pbs.ApproveTargetAccessRequestRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), target.Prefixes()...) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if !handlers.ValidId(handlers.Id(req.GetAccessRequestId()), session.AccessRequestPrefix) {
+		badFields[globals.AccessRequestIdField] = "Incorrectly formatted identifier."
+	}
+	if req.GetDeny() && req.GetValidForSeconds() != 0 {
+		badFields[globals.ValidForSecondsField] = "Cannot be set when denying an access request."
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
 	}
@@ -20,11 +20,17 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/alias"
+	"github.com/hashicorp/boundary/internal/auth/jwt"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/auth/saml"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/credential"
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
+	credpostgres "github.com/hashicorp/boundary/internal/credential/postgres"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -35,6 +41,7 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
+	"github.com/hashicorp/boundary/internal/host"
 	hostplugin "github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
@@ -82,6 +89,8 @@ var testAuthorizedActions = []string{
 	"set-credential-sources",
 	"remove-credential-sources",
 	"authorize-session",
+	"approve",
+	"reverse-connect",
 }
 
 // Create a variable that we can overwrite in enterprise tests
@@ -107,6 +116,9 @@ func testService(t *testing.T, ctx context.Context, conn *db.DB, kms *kms.Kms, w
 	staticHostRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	hostHealthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	pluginHostRepoFn := func() (*hostplugin.Repository, error) {
 		return hostplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
@@ -116,7 +128,19 @@ func testService(t *testing.T, ctx context.Context, conn *db.DB, kms *kms.Kms, w
 	staticCredRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
-	return targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, nil, statusGracePeriod, 1000, nil)
+	pluginCredRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(context.Background(), rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshCaCredRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(context.Background(), rw, rw, kms)
+	}
+	postgresCredRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
+	aliasRepoFn := func() (*alias.Repository, error) {
+		return alias.NewRepository(context.Background(), rw, rw, kms)
+	}
+	return targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, hostHealthRepoFn, vaultCredRepoFn, staticCredRepoFn, pluginCredRepoFn, sshCaCredRepoFn, postgresCredRepoFn, aliasRepoFn, nil, statusGracePeriod, server.RandomWorkerSelection, 1000, nil)
 }
 
 func TestGet(t *testing.T) {
@@ -996,6 +1020,82 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Create a target with a worker selection strategy",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("strategy"),
+				Type:    tcp.Subtype.String(),
+				Attrs: &pb.Target_TcpTargetAttributes{
+					TcpTargetAttributes: &pb.TcpTargetAttributes{
+						DefaultPort: wrapperspb.UInt32(2),
+					},
+				},
+				WorkerSelectionStrategy: wrapperspb.String("least_connections"),
+			}},
+			res: &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", globals.TcpTargetPrefix),
+				Item: &pb.Target{
+					ScopeId: proj.GetPublicId(),
+					Scope:   &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
+					Name:    wrapperspb.String("strategy"),
+					Type:    tcp.Subtype.String(),
+					Attrs: &pb.Target_TcpTargetAttributes{
+						TcpTargetAttributes: &pb.TcpTargetAttributes{
+							DefaultPort: wrapperspb.UInt32(2),
+						},
+					},
+					SessionMaxSeconds:       wrapperspb.UInt32(28800),
+					SessionConnectionLimit:  wrapperspb.Int32(-1),
+					AuthorizedActions:       testAuthorizedActions,
+					Address:                 &wrapperspb.StringValue{},
+					WorkerSelectionStrategy: wrapperspb.String("least_connections"),
+				},
+			},
+		},
+		{
+			name: "Create a target with limits and an idle timeout",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("limits"),
+				Type:    tcp.Subtype.String(),
+				Attrs: &pb.Target_TcpTargetAttributes{
+					TcpTargetAttributes: &pb.TcpTargetAttributes{
+						DefaultPort: wrapperspb.UInt32(2),
+					},
+				},
+				BandwidthLimitUp:           wrapperspb.Int64(1000000),
+				BandwidthLimitDown:         wrapperspb.Int64(2000000),
+				SessionBandwidthLimitUp:    wrapperspb.Int64(100000),
+				SessionBandwidthLimitDown:  wrapperspb.Int64(200000),
+				SessionConnectionRateLimit: wrapperspb.Int32(10),
+				IdleTimeout:                wrapperspb.UInt32(600),
+			}},
+			res: &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", globals.TcpTargetPrefix),
+				Item: &pb.Target{
+					ScopeId: proj.GetPublicId(),
+					Scope:   &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
+					Name:    wrapperspb.String("limits"),
+					Type:    tcp.Subtype.String(),
+					Attrs: &pb.Target_TcpTargetAttributes{
+						TcpTargetAttributes: &pb.TcpTargetAttributes{
+							DefaultPort: wrapperspb.UInt32(2),
+						},
+					},
+					SessionMaxSeconds:          wrapperspb.UInt32(28800),
+					SessionConnectionLimit:     wrapperspb.Int32(-1),
+					AuthorizedActions:          testAuthorizedActions,
+					Address:                    &wrapperspb.StringValue{},
+					BandwidthLimitUp:           wrapperspb.Int64(1000000),
+					BandwidthLimitDown:         wrapperspb.Int64(2000000),
+					SessionBandwidthLimitUp:    wrapperspb.Int64(100000),
+					SessionBandwidthLimitDown:  wrapperspb.Int64(200000),
+					SessionConnectionRateLimit: wrapperspb.Int32(10),
+					IdleTimeout:                wrapperspb.UInt32(600),
+				},
+			},
+		},
 		{
 			name: "Create a target with no port",
 			req: &pbs.CreateTargetRequest{Item: &pb.Target{
@@ -1071,6 +1171,30 @@ func TestCreate(t *testing.T) {
 			res: nil,
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Unknown worker selection strategy",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				WorkerSelectionStrategy: wrapperspb.String("round_robin"),
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Negative bandwidth limit",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				SessionBandwidthLimitDown: wrapperspb.Int64(-1),
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Negative connection rate limit",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				SessionConnectionRateLimit: wrapperspb.Int32(-5),
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Deprecated worker filter",
 			req: &pbs.CreateTargetRequest{Item: &pb.Target{
@@ -2829,12 +2953,27 @@ func TestAuthorizeSession(t *testing.T) {
 	staticHostRepoFn := func() (*static.Repository, error) {
 		return staticRepo, nil
 	}
+	hostHealthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	vaultCredRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
 	staticCredRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(ctx, rw, rw, kms)
 	}
+	pluginCredRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshCaCredRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	postgresCredRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	aliasRepoFn := func() (*alias.Repository, error) {
+		return alias.NewRepository(ctx, rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -2847,6 +2986,12 @@ func TestAuthorizeSession(t *testing.T) {
 	ldapAuthRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kms)
 	}
+	jwtAuthRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kms)
+	}
+	samlAuthRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kms)
+	}
 
 	plg := plugin.TestPlugin(t, conn, "test")
 	plgm := map[string]plgpb.HostPluginServiceClient{
@@ -2895,6 +3040,8 @@ func TestAuthorizeSession(t *testing.T) {
 		passwordAuthRepoFn,
 		oidcAuthRepoFn,
 		ldapAuthRepoFn,
+		jwtAuthRepoFn,
+		samlAuthRepoFn,
 		kms,
 		&authpb.RequestInfo{
 			Token:       at.GetToken(),
@@ -2930,7 +3077,7 @@ func TestAuthorizeSession(t *testing.T) {
 	sec, tok := v.CreateToken(t, vault.WithPolicies([]string{"default", "boundary-controller", "pki"}))
 
 	vaultStore := vault.TestCredentialStore(t, conn, wrapper, proj.GetPublicId(), v.Addr, tok, sec.Auth.Accessor)
-	credService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, 1000)
+	credService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, pluginCredRepoFn, sshCaCredRepoFn, postgresCredRepoFn, 1000)
 	require.NoError(t, err)
 	clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credlibpb.CredentialLibrary{
 		CredentialStoreId: vaultStore.GetPublicId(),
@@ -2986,7 +3133,7 @@ func TestAuthorizeSession(t *testing.T) {
 
 	statusGracePeriod := new(atomic.Int64)
 	statusGracePeriod.Store(int64(server.DefaultLiveness))
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, nil, statusGracePeriod, 1000, nil)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, hostHealthRepoFn, vaultCredRepoFn, staticCredRepoFn, pluginCredRepoFn, sshCaCredRepoFn, postgresCredRepoFn, aliasRepoFn, nil, statusGracePeriod, server.RandomWorkerSelection, 1000, nil)
 	require.NoError(t, err)
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -3142,12 +3289,27 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 	staticHostRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	hostHealthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	vaultCredRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
 	staticCredRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(ctx, rw, rw, kms)
 	}
+	pluginCredRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshCaCredRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	postgresCredRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	aliasRepoFn := func() (*alias.Repository, error) {
+		return alias.NewRepository(ctx, rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -3174,7 +3336,7 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 
 	statusGracePeriod := new(atomic.Int64)
 	statusGracePeriod.Store(int64(server.DefaultLiveness))
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, nil, statusGracePeriod, 1000, nil)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, hostHealthRepoFn, vaultCredRepoFn, staticCredRepoFn, pluginCredRepoFn, sshCaCredRepoFn, postgresCredRepoFn, aliasRepoFn, nil, statusGracePeriod, server.RandomWorkerSelection, 1000, nil)
 	require.NoError(t, err)
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -3187,7 +3349,7 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 	sec, tok := v.CreateToken(t, vault.WithPolicies([]string{"default", "boundary-controller", "secret"}))
 
 	vaultStore := vault.TestCredentialStore(t, conn, wrapper, proj.GetPublicId(), v.Addr, tok, sec.Auth.Accessor)
-	credLibService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, 1000)
+	credLibService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, pluginCredRepoFn, sshCaCredRepoFn, postgresCredRepoFn, 1000)
 	require.NoError(t, err)
 
 	// Create secret in vault with default username and password fields
@@ -3247,7 +3409,7 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 	require.NoError(t, err)
 
 	staticStore := credstatic.TestCredentialStore(t, conn, wrapper, proj.GetPublicId())
-	credService, err := credentials.NewService(ctx, iamRepoFn, staticCredRepoFn, 1000)
+	credService, err := credentials.NewService(ctx, iamRepoFn, staticCredRepoFn, sessionRepoFn, 1000)
 	require.NoError(t, err)
 	upCredResp, err := credService.CreateCredential(ctx, &pbs.CreateCredentialRequest{Item: &credpb.Credential{
 		CredentialStoreId: staticStore.GetPublicId(),
@@ -3741,6 +3903,9 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	staticHostRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	hostHealthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
 	pluginHostRepoFn := func() (*hostplugin.Repository, error) {
 		return hostplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
@@ -3750,6 +3915,18 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	staticCredRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(ctx, rw, rw, kms)
 	}
+	pluginCredRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshCaCredRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	postgresCredRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	aliasRepoFn := func() (*alias.Repository, error) {
+		return alias.NewRepository(ctx, rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -3757,7 +3934,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 
 	statusGracePeriod := new(atomic.Int64)
 	statusGracePeriod.Store(int64(server.DefaultLiveness))
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, nil, statusGracePeriod, 1000, nil)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, hostHealthRepoFn, vaultCredRepoFn, staticCredRepoFn, pluginCredRepoFn, sshCaCredRepoFn, postgresCredRepoFn, aliasRepoFn, nil, statusGracePeriod, server.RandomWorkerSelection, 1000, nil)
 	require.NoError(t, err)
 
 	// Authorized user gets full permissions
@@ -3843,7 +4020,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	}
 
 	libraryExists := func(tar target.Target) (version uint32) {
-		credService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, 1000)
+		credService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, pluginCredRepoFn, sshCaCredRepoFn, postgresCredRepoFn, 1000)
 		require.NoError(t, err)
 		clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credlibpb.CredentialLibrary{
 			CredentialStoreId: store.GetPublicId(),
@@ -3868,7 +4045,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	}
 
 	misConfiguredlibraryExists := func(tar target.Target) (version uint32) {
-		credService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, 1000)
+		credService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, pluginCredRepoFn, sshCaCredRepoFn, postgresCredRepoFn, 1000)
 		require.NoError(t, err)
 		clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credlibpb.CredentialLibrary{
 			CredentialStoreId: store.GetPublicId(),
@@ -3893,7 +4070,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	}
 
 	expiredTokenLibrary := func(tar target.Target) (version uint32) {
-		credService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, 1000)
+		credService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, pluginCredRepoFn, sshCaCredRepoFn, postgresCredRepoFn, 1000)
 		require.NoError(t, err)
 		clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credlibpb.CredentialLibrary{
 			CredentialStoreId: expiredStore.GetPublicId(),
@@ -4010,6 +4187,511 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	}
 }
 
+func TestAuthorizeSession_ApprovalRequired(t *testing.T) {
+	ctx := context.Background()
+	// This prevents us from running tests in parallel.
+	targets.SetupSuiteTargetFilters(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+
+	repoFn := func(o ...target.Option) (*target.Repository, error) {
+		return target.NewRepository(ctx, rw, rw, kms)
+	}
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
+	sessionRepoFn := func(opts ...session.Option) (*session.Repository, error) {
+		return session.NewRepository(ctx, rw, rw, kms, opts...)
+	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(ctx, rw, rw, kms)
+	}
+	hostHealthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
+	pluginHostRepoFn := func() (*hostplugin.Repository, error) {
+		return hostplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	vaultCredRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	staticCredRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(ctx, rw, rw, kms)
+	}
+	pluginCredRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshCaCredRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	postgresCredRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	aliasRepoFn := func() (*alias.Repository, error) {
+		return alias.NewRepository(ctx, rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kms)
+	}
+	org, proj := iam.TestScopes(t, iamRepo)
+
+	statusGracePeriod := new(atomic.Int64)
+	statusGracePeriod.Store(int64(server.DefaultLiveness))
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, hostHealthRepoFn, vaultCredRepoFn, staticCredRepoFn, pluginCredRepoFn, sshCaCredRepoFn, postgresCredRepoFn, aliasRepoFn, nil, statusGracePeriod, server.RandomWorkerSelection, 1000, nil)
+	require.NoError(t, err)
+
+	r := iam.TestRole(t, conn, proj.GetPublicId())
+	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "ids=*;type=*;actions=*")
+	userCtx := func(at *authtoken.AuthToken) context.Context {
+		_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+		return auth.NewVerifierContext(requests.NewRequestContext(context.Background()),
+			iamRepoFn,
+			atRepoFn,
+			serversRepoFn,
+			kms,
+			&authpb.RequestInfo{
+				Token:       at.GetToken(),
+				TokenFormat: uint32(auth.AuthTokenTypeBearer),
+				PublicId:    at.GetPublicId(),
+			})
+	}
+	requesterCtx := userCtx(authtoken.TestAuthToken(t, conn, kms, org.GetPublicId()))
+	approverCtx := userCtx(authtoken.TestAuthToken(t, conn, kms, org.GetPublicId()))
+
+	server.TestKmsWorker(t, conn, wrapper)
+	tar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "approval-required",
+		target.WithDefaultPort(22),
+		target.WithAddress("127.0.0.1"),
+		target.WithApprovalRequired(true))
+
+	// Without an access request a pending one is created and no session is
+	// authorized.
+	res, err := s.AuthorizeSession(requesterCtx, &pbs.AuthorizeSessionRequest{
+		Id:     tar.GetPublicId(),
+		Reason: "investigating an incident",
+	})
+	require.NoError(t, err)
+	ar := res.GetItem().GetAccessRequest()
+	require.NotNil(t, ar)
+	assert.Empty(t, res.GetItem().GetSessionId())
+	assert.Empty(t, res.GetItem().GetAuthorizationToken())
+	assert.Equal(t, session.AccessRequestPending.String(), ar.GetStatus())
+	assert.Equal(t, "investigating an incident", ar.GetReason())
+
+	// A pending access request cannot be used to authorize a session.
+	_, err = s.AuthorizeSession(requesterCtx, &pbs.AuthorizeSessionRequest{
+		Id:              tar.GetPublicId(),
+		AccessRequestId: ar.GetId(),
+	})
+	require.Error(t, err)
+
+	// Users cannot approve their own access requests.
+	_, err = s.ApproveTargetAccessRequest(requesterCtx, &pbs.ApproveTargetAccessRequestRequest{
+		Id:              tar.GetPublicId(),
+		AccessRequestId: ar.GetId(),
+	})
+	require.Error(t, err)
+
+	listRes, err := s.ListTargetAccessRequests(approverCtx, &pbs.ListTargetAccessRequestsRequest{Id: tar.GetPublicId()})
+	require.NoError(t, err)
+	require.Len(t, listRes.GetItems(), 1)
+	assert.Equal(t, ar.GetId(), listRes.GetItems()[0].GetId())
+
+	approveRes, err := s.ApproveTargetAccessRequest(approverCtx, &pbs.ApproveTargetAccessRequestRequest{
+		Id:              tar.GetPublicId(),
+		AccessRequestId: ar.GetId(),
+		ValidForSeconds: 600,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, session.AccessRequestApproved.String(), approveRes.GetItem().GetStatus())
+	assert.NotNil(t, approveRes.GetItem().GetApprovalExpirationTime())
+
+	res, err = s.AuthorizeSession(requesterCtx, &pbs.AuthorizeSessionRequest{
+		Id:              tar.GetPublicId(),
+		AccessRequestId: ar.GetId(),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, res.GetItem().GetSessionId())
+	assert.NotEmpty(t, res.GetItem().GetAuthorizationToken())
+
+	// An access request can only be redeemed once.
+	_, err = s.AuthorizeSession(requesterCtx, &pbs.AuthorizeSessionRequest{
+		Id:              tar.GetPublicId(),
+		AccessRequestId: ar.GetId(),
+	})
+	require.Error(t, err)
+
+	// Denied access requests cannot be used either.
+	res, err = s.AuthorizeSession(requesterCtx, &pbs.AuthorizeSessionRequest{Id: tar.GetPublicId()})
+	require.NoError(t, err)
+	denied := res.GetItem().GetAccessRequest()
+	require.NotNil(t, denied)
+	denyRes, err := s.ApproveTargetAccessRequest(approverCtx, &pbs.ApproveTargetAccessRequestRequest{
+		Id:              tar.GetPublicId(),
+		AccessRequestId: denied.GetId(),
+		Deny:            true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, session.AccessRequestDenied.String(), denyRes.GetItem().GetStatus())
+	_, err = s.AuthorizeSession(requesterCtx, &pbs.AuthorizeSessionRequest{
+		Id:              tar.GetPublicId(),
+		AccessRequestId: denied.GetId(),
+	})
+	require.Error(t, err)
+}
+
+func TestAuthorizeSession_UnhealthyHosts(t *testing.T) {
+	ctx := context.Background()
+	// This prevents us from running tests in parallel.
+	targets.SetupSuiteTargetFilters(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+
+	repoFn := func(o ...target.Option) (*target.Repository, error) {
+		return target.NewRepository(ctx, rw, rw, kms)
+	}
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
+	sessionRepoFn := func(opts ...session.Option) (*session.Repository, error) {
+		return session.NewRepository(ctx, rw, rw, kms, opts...)
+	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(ctx, rw, rw, kms)
+	}
+	hostHealthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
+	pluginHostRepoFn := func() (*hostplugin.Repository, error) {
+		return hostplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	vaultCredRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	staticCredRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(ctx, rw, rw, kms)
+	}
+	pluginCredRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshCaCredRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	postgresCredRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	aliasRepoFn := func() (*alias.Repository, error) {
+		return alias.NewRepository(ctx, rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kms)
+	}
+	org, proj := iam.TestScopes(t, iamRepo)
+
+	statusGracePeriod := new(atomic.Int64)
+	statusGracePeriod.Store(int64(server.DefaultLiveness))
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, hostHealthRepoFn, vaultCredRepoFn, staticCredRepoFn, pluginCredRepoFn, sshCaCredRepoFn, postgresCredRepoFn, aliasRepoFn, nil, statusGracePeriod, server.RandomWorkerSelection, 1000, nil)
+	require.NoError(t, err)
+
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+	ctx = auth.NewVerifierContext(requests.NewRequestContext(context.Background()),
+		iamRepoFn,
+		atRepoFn,
+		serversRepoFn,
+		kms,
+		&authpb.RequestInfo{
+			Token:       at.GetToken(),
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+		})
+	r := iam.TestRole(t, conn, proj.GetPublicId())
+	_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "ids=*;type=*;actions=*")
+
+	w := server.TestKmsWorker(t, conn, wrapper)
+	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	hosts := static.TestHosts(t, conn, hc.GetPublicId(), 2)
+	static.TestSetMembers(t, conn, hs.GetPublicId(), hosts)
+	tar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "health-checked",
+		target.WithDefaultPort(22),
+		target.WithHostSources([]string{hs.GetPublicId()}))
+
+	healthRepo, err := hostHealthRepoFn()
+	require.NoError(t, err)
+	require.NoError(t, healthRepo.UpsertHealthCheckResults(ctx, w.GetPublicId(), []*host.HealthCheckResult{
+		{HostId: hosts[0].GetPublicId(), Port: 22, Healthy: false},
+	}))
+
+	// The unhealthy host is never chosen
+	for i := 0; i < 10; i++ {
+		res, err := s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: tar.GetPublicId()})
+		require.NoError(t, err)
+		assert.Equal(t, hosts[1].GetPublicId(), res.GetItem().GetHostId())
+	}
+
+	// Unless it is requested explicitly
+	res, err := s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: tar.GetPublicId(), HostId: hosts[0].GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, hosts[0].GetPublicId(), res.GetItem().GetHostId())
+
+	// With no healthy hosts no session can be authorized
+	require.NoError(t, healthRepo.UpsertHealthCheckResults(ctx, w.GetPublicId(), []*host.HealthCheckResult{
+		{HostId: hosts[1].GetPublicId(), Port: 22, Healthy: false},
+	}))
+	_, err = s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: tar.GetPublicId()})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unhealthy")
+}
+
+func TestAuthorizeSession_Alias(t *testing.T) {
+	ctx := context.Background()
+	// This prevents us from running tests in parallel.
+	targets.SetupSuiteTargetFilters(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+
+	repoFn := func(o ...target.Option) (*target.Repository, error) {
+		return target.NewRepository(ctx, rw, rw, kms)
+	}
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
+	sessionRepoFn := func(opts ...session.Option) (*session.Repository, error) {
+		return session.NewRepository(ctx, rw, rw, kms, opts...)
+	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(ctx, rw, rw, kms)
+	}
+	hostHealthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
+	pluginHostRepoFn := func() (*hostplugin.Repository, error) {
+		return hostplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	vaultCredRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	staticCredRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(ctx, rw, rw, kms)
+	}
+	pluginCredRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshCaCredRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	postgresCredRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	aliasRepoFn := func() (*alias.Repository, error) {
+		return alias.NewRepository(ctx, rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kms)
+	}
+	org, proj := iam.TestScopes(t, iamRepo)
+
+	statusGracePeriod := new(atomic.Int64)
+	statusGracePeriod.Store(int64(server.DefaultLiveness))
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, hostHealthRepoFn, vaultCredRepoFn, staticCredRepoFn, pluginCredRepoFn, sshCaCredRepoFn, postgresCredRepoFn, aliasRepoFn, nil, statusGracePeriod, server.RandomWorkerSelection, 1000, nil)
+	require.NoError(t, err)
+
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+	ctx = auth.NewVerifierContext(requests.NewRequestContext(context.Background()),
+		iamRepoFn,
+		atRepoFn,
+		serversRepoFn,
+		kms,
+		&authpb.RequestInfo{
+			Token:       at.GetToken(),
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+		})
+	r := iam.TestRole(t, conn, proj.GetPublicId())
+	_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+
+	_ = server.TestKmsWorker(t, conn, wrapper)
+	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	hosts := static.TestHosts(t, conn, hc.GetPublicId(), 2)
+	static.TestSetMembers(t, conn, hs.GetPublicId(), hosts)
+	tar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "aliased",
+		target.WithDefaultPort(22),
+		target.WithHostSources([]string{hs.GetPublicId()}))
+	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), fmt.Sprintf("ids=%s;actions=authorize-session", tar.GetPublicId()))
+
+	alias.TestAlias(t, conn, "target.example", alias.WithDestinationId(tar.GetPublicId()))
+	alias.TestAlias(t, conn, "host.example", alias.WithDestinationId(tar.GetPublicId()), alias.WithHostId(hosts[1].GetPublicId()))
+	alias.TestAlias(t, conn, "nowhere.example")
+	forbidden := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "forbidden", target.WithDefaultPort(22))
+	alias.TestAlias(t, conn, "forbidden.example", alias.WithDestinationId(forbidden.GetPublicId()))
+
+	t.Run("target", func(t *testing.T) {
+		res, err := s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: "Target.Example"})
+		require.NoError(t, err)
+		assert.Equal(t, tar.GetPublicId(), res.GetItem().GetTargetId())
+	})
+
+	t.Run("host", func(t *testing.T) {
+		res, err := s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: "host.example"})
+		require.NoError(t, err)
+		assert.Equal(t, tar.GetPublicId(), res.GetItem().GetTargetId())
+		assert.Equal(t, hosts[1].GetPublicId(), res.GetItem().GetHostId())
+
+		// An explicitly requested host takes precedence
+		res, err = s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: "host.example", HostId: hosts[0].GetPublicId()})
+		require.NoError(t, err)
+		assert.Equal(t, hosts[0].GetPublicId(), res.GetItem().GetHostId())
+	})
+
+	t.Run("unresolvable", func(t *testing.T) {
+		// Aliases that don't resolve fail like aliases of targets the caller
+		// is not authorized for, so their existence isn't revealed.
+		for _, v := range []string{"nowhere.example", "missing.example"} {
+			_, err := s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: v})
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, handlers.ForbiddenError()), "got error %v", err)
+		}
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		_, err := s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: "forbidden.example"})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, handlers.ForbiddenError()), "got error %v", err)
+	})
+}
+
+func TestAuthorizeSession_Reverse(t *testing.T) {
+	ctx := context.Background()
+	// This prevents us from running tests in parallel.
+	targets.SetupSuiteTargetFilters(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+
+	repoFn := func(o ...target.Option) (*target.Repository, error) {
+		return target.NewRepository(ctx, rw, rw, kms)
+	}
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
+	sessionRepoFn := func(opts ...session.Option) (*session.Repository, error) {
+		return session.NewRepository(ctx, rw, rw, kms, opts...)
+	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(ctx, rw, rw, kms)
+	}
+	hostHealthRepoFn := func() (*host.HealthRepository, error) {
+		return host.NewHealthRepository(ctx, rw, rw)
+	}
+	pluginHostRepoFn := func() (*hostplugin.Repository, error) {
+		return hostplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	vaultCredRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	staticCredRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(ctx, rw, rw, kms)
+	}
+	pluginCredRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	sshCaCredRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
+	postgresCredRepoFn := func() (*credpostgres.Repository, error) {
+		return credpostgres.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	aliasRepoFn := func() (*alias.Repository, error) {
+		return alias.NewRepository(ctx, rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kms)
+	}
+	org, proj := iam.TestScopes(t, iamRepo)
+
+	statusGracePeriod := new(atomic.Int64)
+	statusGracePeriod.Store(int64(server.DefaultLiveness))
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, hostHealthRepoFn, vaultCredRepoFn, staticCredRepoFn, pluginCredRepoFn, sshCaCredRepoFn, postgresCredRepoFn, aliasRepoFn, nil, statusGracePeriod, server.RandomWorkerSelection, 1000, nil)
+	require.NoError(t, err)
+
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+	ctx = auth.NewVerifierContext(requests.NewRequestContext(context.Background()),
+		iamRepoFn,
+		atRepoFn,
+		serversRepoFn,
+		kms,
+		&authpb.RequestInfo{
+			Token:       at.GetToken(),
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+		})
+	r := iam.TestRole(t, conn, proj.GetPublicId())
+	_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+
+	_ = server.TestKmsWorker(t, conn, wrapper)
+	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	reverseTar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "reverse",
+		target.WithDefaultPort(22),
+		target.WithHostSources([]string{hs.GetPublicId()}))
+	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), fmt.Sprintf("ids=%s;actions=reverse-connect", reverseTar.GetPublicId()))
+	forwardTar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "forward",
+		target.WithDefaultPort(22),
+		target.WithHostSources([]string{hs.GetPublicId()}))
+	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), fmt.Sprintf("ids=%s;actions=authorize-session", forwardTar.GetPublicId()))
+
+	t.Run("reverse", func(t *testing.T) {
+		res, err := s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: reverseTar.GetPublicId(), Reverse: true})
+		require.NoError(t, err)
+		sessionRepo, err := sessionRepoFn()
+		require.NoError(t, err)
+		sess, _, err := sessionRepo.LookupSession(ctx, res.GetItem().GetSessionId())
+		require.NoError(t, err)
+		assert.True(t, sess.Reverse)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		// Each action only authorizes its own kind of session
+		_, err := s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: reverseTar.GetPublicId()})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, handlers.ForbiddenError()), "got error %v", err)
+		_, err = s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: forwardTar.GetPublicId(), Reverse: true})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, handlers.ForbiddenError()), "got error %v", err)
+	})
+}
+
 func decodeJsonSecret(t *testing.T, in string) map[string]any {
 	t.Helper()
 	ret := make(map[string]any)
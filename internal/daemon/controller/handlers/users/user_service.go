@@ -5,28 +5,40 @@ package users
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/alias"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/hosts"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/host"
+	hostplugin "github.com/hashicorp/boundary/internal/host/plugin"
+	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/hashicorp/boundary/internal/listtoken"
 	"github.com/hashicorp/boundary/internal/pagination"
 	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/plugin"
 	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
+	aliaspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/aliases"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/users"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -43,6 +55,8 @@ var (
 		action.AddAccounts,
 		action.SetAccounts,
 		action.RemoveAccounts,
+		action.ExplainPermissions,
+		action.ListResolvableAliases,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -70,22 +84,53 @@ func init() {
 type Service struct {
 	pbs.UnsafeUserServiceServer
 
-	repoFn      common.IamRepoFactory
-	maxPageSize uint
+	repoFn           common.IamRepoFactory
+	aliasRepoFn      common.AliasRepoFactory
+	targetRepoFn     target.RepositoryFactory
+	staticHostRepoFn common.StaticRepoFactory
+	pluginHostRepoFn common.PluginHostRepoFactory
+	maxPageSize      uint
 }
 
 var _ pbs.UserServiceServer = (*Service)(nil)
 
 // NewService returns a user service which handles user related requests to boundary.
-func NewService(ctx context.Context, repo common.IamRepoFactory, maxPageSize uint) (Service, error) {
+func NewService(
+	ctx context.Context,
+	repo common.IamRepoFactory,
+	aliasRepoFn common.AliasRepoFactory,
+	targetRepoFn target.RepositoryFactory,
+	staticHostRepoFn common.StaticRepoFactory,
+	pluginHostRepoFn common.PluginHostRepoFactory,
+	maxPageSize uint,
+) (Service, error) {
 	const op = "users.NewService"
 	if repo == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
 	}
+	if aliasRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing alias repository")
+	}
+	if targetRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing target repository")
+	}
+	if staticHostRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static host repository")
+	}
+	if pluginHostRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing plugin host repository")
+	}
 	if maxPageSize == 0 {
 		maxPageSize = uint(globals.DefaultMaxPageSize)
 	}
-	return Service{repoFn: repo, maxPageSize: maxPageSize}, nil
+	return Service{
+		repoFn:           repo,
+		aliasRepoFn:      aliasRepoFn,
+		targetRepoFn:     targetRepoFn,
+		staticHostRepoFn: staticHostRepoFn,
+		pluginHostRepoFn: pluginHostRepoFn,
+		maxPageSize:      maxPageSize,
+	}, nil
 }
 
 // ListUsers implements the interface pbs.UserServiceServer.
@@ -472,6 +517,48 @@ func (s Service) RemoveUserAccounts(ctx context.Context, req *pbs.RemoveUserAcco
 	return &pbs.RemoveUserAccountsResponse{Item: item}, nil
 }
 
+// ExplainUserPermissions implements the interface pbs.UserServiceServer.
+func (s Service) ExplainUserPermissions(ctx context.Context, req *pbs.ExplainUserPermissionsRequest) (*pbs.ExplainUserPermissionsResponse, error) {
+	if err := validateExplainUserPermissionsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ExplainPermissions)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	item, err := s.explainInRepo(ctx, req.GetId(), req.GetResourceId(), action.Map[req.GetAction()])
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ExplainUserPermissionsResponse{Item: item}, nil
+}
+
+// ListResolvableAliases implements the interface pbs.UserServiceServer. Users
+// can only list the aliases they themselves can resolve, which are the aliases
+// whose destination they are allowed to authorize sessions to.
+func (s Service) ListResolvableAliases(ctx context.Context, req *pbs.ListResolvableAliasesRequest) (*pbs.ListResolvableAliasesResponse, error) {
+	if err := validateListResolvableAliasesRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListResolvableAliases)
+	if authResults.Error != nil {
+		// Users don't need a grant to list their own resolvable aliases.
+		if authResults.Error != handlers.ForbiddenError() ||
+			!authResults.AuthenticationFinished ||
+			authResults.UserId != req.GetId() {
+			return nil, authResults.Error
+		}
+	}
+	if authResults.UserId != req.GetId() {
+		return nil, handlers.ForbiddenError()
+	}
+	items, err := s.listResolvableAliasesFromRepo(ctx, authResults)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListResolvableAliasesResponse{Items: items}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*iam.User, []string, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -626,6 +713,207 @@ func (s Service) removeInRepo(ctx context.Context, userId string, accountIds []s
 	return out, accts, nil
 }
 
+// explainInRepo evaluates each of the user's grants individually against the
+// resource and action, in the same way the permissions engine does when
+// authorizing a request, and records which of them allow the action. Grant
+// filters are evaluated against the resource as it is when authorizing a
+// request to it.
+func (s Service) explainInRepo(ctx context.Context, userId, resourceId string, act action.Type) (*pb.PermissionExplanation, error) {
+	const op = "users.(Service).explainInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	res, err := repo.ResolveResource(ctx, resourceId)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Resource %q doesn't exist.", resourceId)
+		}
+		return nil, err
+	}
+	out := &pb.PermissionExplanation{
+		UserId:       userId,
+		ResourceId:   res.Id,
+		ResourceType: res.Type.String(),
+		ScopeId:      res.ScopeId,
+		Action:       act.String(),
+	}
+	if userId == globals.RecoveryUserId {
+		// The recovery user is allowed to perform any action without grants
+		out.Authorized = true
+		return out, nil
+	}
+
+	grantTuples, err := repo.GrantsForUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	principalRoles, err := repo.PrincipalRolesForUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	principals := make(map[string][]*pb.GrantPrincipal, len(principalRoles))
+	roleScopeIds := make(map[string]string, len(principalRoles))
+	for _, pr := range principalRoles {
+		principals[pr.GetRoleId()] = append(principals[pr.GetRoleId()], &pb.GrantPrincipal{
+			Id:      pr.GetPrincipalId(),
+			Type:    pr.GetType(),
+			ScopeId: pr.GetPrincipalScopeId(),
+		})
+		roleScopeIds[pr.GetRoleId()] = pr.GetRoleScopeId()
+	}
+
+	now := time.Now()
+	var itemLoaded bool
+	var inScope int
+	var inactive []string
+	for _, gt := range grantTuples {
+		if gt.ScopeId != res.ScopeId {
+			continue
+		}
+		inScope++
+		// As when authorizing a request, skip final validation so that grants
+		// in formats that have since been restricted don't cause an error.
+		g, err := perms.Parse(ctx, gt.ScopeId, gt.Grant, perms.WithUserId(userId), perms.WithSkipFinalValidation(true))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed to parse grant %#v", gt.Grant)))
+		}
+		if !g.ActiveAt(now) {
+			inactive = append(inactive, fmt.Sprintf("Grant %q from role %q is not in effect at this time.", g.CanonicalString(), gt.RoleId))
+			continue
+		}
+		if g.Filter() != "" && !itemLoaded {
+			if res.Item, err = s.filterableItem(ctx, res); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			itemLoaded = true
+		}
+		if !perms.NewACL(g).Allowed(*res, act, userId, perms.WithEvaluationTime(now)).Authorized {
+			if g.Filter() != "" {
+				inactive = append(inactive, fmt.Sprintf("Grant %q from role %q only applies to resources matching its filter, which %s %q does not match.", g.CanonicalString(), gt.RoleId, res.Type.String(), res.Id))
+			}
+			continue
+		}
+		out.MatchingGrants = append(out.MatchingGrants, &pb.GrantExplanation{
+			Grant:        g.CanonicalString(),
+			RoleId:       gt.RoleId,
+			RoleScopeId:  roleScopeIds[gt.RoleId],
+			GrantScopeId: gt.ScopeId,
+			Principals:   principals[gt.RoleId],
+		})
+	}
+
+	out.Authorized = len(out.MatchingGrants) > 0
+	if out.Authorized {
+		return out, nil
+	}
+	switch {
+	case len(grantTuples) == 0:
+		out.Reasons = append(out.Reasons, "The user is not assigned any roles containing grants.")
+	case inScope == 0:
+		out.Reasons = append(out.Reasons, fmt.Sprintf("None of the user's roles grant permissions in scope %q.", res.ScopeId))
+	default:
+		out.Reasons = append(out.Reasons, fmt.Sprintf("None of the %d grants applying to scope %q allow the %q action on %s %q.", inScope, res.ScopeId, act.String(), res.Type.String(), res.Id))
+	}
+	out.Reasons = append(out.Reasons, inactive...)
+	return out, nil
+}
+
+// filterableItem returns the representation of the resource that grant filters
+// are evaluated against, in the same way as when authorizing a request to it.
+// Only targets and hosts support filtered grants, so nil is returned for any
+// other resource, which no filtered grant matches.
+func (s Service) filterableItem(ctx context.Context, res *perms.Resource) (any, error) {
+	const op = "users.(Service).filterableItem"
+	switch res.Type {
+	case resource.Target:
+		repo, err := s.targetRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		t, err := repo.LookupTarget(ctx, res.Id)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if t == nil {
+			return nil, handlers.NotFoundErrorf("Resource %q doesn't exist.", res.Id)
+		}
+		return targets.FilterableTarget(ctx, t)
+	case resource.Host:
+		var h host.Host
+		var plg *plugin.Plugin
+		switch globals.ResourceInfoFromPrefix(res.Id).Subtype {
+		case static.Subtype:
+			repo, err := s.staticHostRepoFn()
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			sh, err := repo.LookupHost(ctx, res.Id)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			if sh != nil {
+				h = sh
+			}
+		case hostplugin.Subtype:
+			repo, err := s.pluginHostRepoFn()
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			ph, pl, err := repo.LookupHost(ctx, res.Id)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			if ph != nil {
+				h, plg = ph, pl
+			}
+		}
+		if h == nil {
+			return nil, handlers.NotFoundErrorf("Resource %q doesn't exist.", res.Id)
+		}
+		return hosts.FilterableHost(ctx, h, plg)
+	default:
+		return nil, nil
+	}
+}
+
+// listResolvableAliasesFromRepo returns the aliases with a destination the
+// user in authResults is allowed to authorize sessions to. Grants with filters
+// are not evaluated here since the target itself is not loaded, so aliases
+// only authorized by such grants are not included.
+func (s Service) listResolvableAliasesFromRepo(ctx context.Context, authResults auth.VerifyResults) ([]*aliaspb.Alias, error) {
+	const op = "users.(Service).listResolvableAliasesFromRepo"
+	repo, err := s.aliasRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	resolvable, err := repo.ListResolvableAliases(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	acl := authResults.ACL()
+	items := make([]*aliaspb.Alias, 0, len(resolvable))
+	for _, r := range resolvable {
+		res := perms.Resource{
+			Id:      r.DestinationId,
+			ScopeId: r.DestinationProjectId,
+			Type:    resource.Target,
+		}
+		if !acl.Allowed(res, action.AuthorizeSession, authResults.UserId).Authorized {
+			continue
+		}
+		items = append(items, &aliaspb.Alias{
+			Id:            r.PublicId,
+			ScopeId:       scope.Global.String(),
+			Type:          alias.TargetType,
+			Value:         r.Value,
+			DestinationId: r.DestinationId,
+			HostId:        r.HostId,
+		})
+	}
+	return items, nil
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 	repo, err := s.repoFn()
@@ -666,6 +954,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 }
 
 func toProto(ctx context.Context, in *iam.User, accts []string, opt ...handlers.Option) (*pb.User, error) {
+	const op = "users.toProto"
 	opts := handlers.GetOpts(opt...)
 	if opts.WithOutputFields == nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building user proto")
@@ -709,6 +998,16 @@ func toProto(ctx context.Context, in *iam.User, accts []string, opt ...handlers.
 	if outputFields.Has(globals.EmailField) {
 		out.Email = in.GetEmail()
 	}
+	if outputFields.Has(globals.AttributesField) && in.GetAttributes() != "" {
+		m := make(map[string]any)
+		if err := json.Unmarshal([]byte(in.GetAttributes()), &m); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error unmarshaling stored attributes"))
+		}
+		var err error
+		if out.Attributes, err = structpb.NewStruct(m); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error converting stored attributes to protobuf struct"))
+		}
+	}
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
@@ -788,6 +1087,8 @@ func validateAddUserAccountsRequest(req *pbs.AddUserAccountsRequest) error {
 			globals.PasswordAccountPrefix,
 			globals.OidcAccountPrefix,
 			globals.LdapAccountPrefix,
+			globals.JwtAccountPrefix,
+			globals.SamlAccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
@@ -799,6 +1100,40 @@ func validateAddUserAccountsRequest(req *pbs.AddUserAccountsRequest) error {
 	return nil
 }
 
+func validateExplainUserPermissionsRequest(req *pbs.ExplainUserPermissionsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.UserPrefix) {
+		badFields["id"] = "Incorrectly formatted identifier."
+	}
+	switch {
+	case req.GetResourceId() == "":
+		badFields["resource_id"] = "This field is required."
+	case globals.ResourceInfoFromPrefix(req.GetResourceId()).Type == resource.Unknown:
+		badFields["resource_id"] = "Unknown resource type."
+	}
+	switch {
+	case req.GetAction() == "":
+		badFields["action"] = "This field is required."
+	case action.Map[req.GetAction()] == action.Unknown:
+		badFields["action"] = "Unknown action."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateListResolvableAliasesRequest(req *pbs.ListResolvableAliasesRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.UserPrefix) {
+		badFields["id"] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func validateSetUserAccountsRequest(req *pbs.SetUserAccountsRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetId()), globals.UserPrefix) {
@@ -814,6 +1149,8 @@ func validateSetUserAccountsRequest(req *pbs.SetUserAccountsRequest) error {
 			globals.PasswordAccountPrefix,
 			globals.OidcAccountPrefix,
 			globals.LdapAccountPrefix,
+			globals.JwtAccountPrefix,
+			globals.SamlAccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
@@ -843,6 +1180,8 @@ func validateRemoveUserAccountsRequest(req *pbs.RemoveUserAccountsRequest) error
 			globals.PasswordAccountPrefix,
 			globals.OidcAccountPrefix,
 			globals.LdapAccountPrefix,
+			globals.JwtAccountPrefix,
+			globals.SamlAccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
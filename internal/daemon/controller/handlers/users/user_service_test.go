@@ -14,23 +14,33 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/alias"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/users"
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
+	hostplugin "github.com/hashicorp/boundary/internal/host/plugin"
+	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
 	"github.com/hashicorp/boundary/internal/types/scope"
+	aliaspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/aliases"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/users"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
@@ -42,26 +52,62 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-accounts", "set-accounts", "remove-accounts"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-accounts", "set-accounts", "remove-accounts", "explain-permissions", "list-resolvable-aliases"}
 
-func createDefaultUserAndRepo(t *testing.T, withAccts bool) (*iam.User, []string, func() (*iam.Repository, error)) {
+// testRepoFns holds the repository factories the user service needs besides
+// the IAM repository.
+type testRepoFns struct {
+	alias      common.AliasRepoFactory
+	target     target.RepositoryFactory
+	staticHost common.StaticRepoFactory
+	pluginHost common.PluginHostRepoFactory
+}
+
+func newTestRepoFns(t *testing.T, conn *db.DB, wrap wrapping.Wrapper, kmsCache *kms.Kms) testRepoFns {
+	t.Helper()
+	ctx := context.Background()
+	rw := db.New(conn)
+	sche := scheduler.TestScheduler(t, conn, wrap)
+	return testRepoFns{
+		alias: func() (*alias.Repository, error) {
+			return alias.NewRepository(ctx, rw, rw, kmsCache)
+		},
+		target: func(o ...target.Option) (*target.Repository, error) {
+			return target.NewRepository(ctx, rw, rw, kmsCache, o...)
+		},
+		staticHost: func() (*static.Repository, error) {
+			return static.NewRepository(ctx, rw, rw, kmsCache)
+		},
+		pluginHost: func() (*hostplugin.Repository, error) {
+			return hostplugin.NewRepository(ctx, rw, rw, kmsCache, sche, map[string]plgpb.HostPluginServiceClient{})
+		},
+	}
+}
+
+func newTestService(t *testing.T, repoFn common.IamRepoFactory, fns testRepoFns) (users.Service, error) {
+	t.Helper()
+	return users.NewService(context.Background(), repoFn, fns.alias, fns.target, fns.staticHost, fns.pluginHost, 1000)
+}
+
+func createDefaultUserAndRepo(t *testing.T, withAccts bool) (*iam.User, []string, func() (*iam.Repository, error), testRepoFns) {
 	t.Helper()
 	conn, _ := db.TestSetup(t, "postgres")
 	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
 	repo := iam.TestRepo(t, conn, wrap)
 	repoFn := func() (*iam.Repository, error) {
 		return repo, nil
 	}
+	repoFns := newTestRepoFns(t, conn, wrap, kmsCache)
 	o, _ := iam.TestScopes(t, repo)
 	u := iam.TestUser(t, repo, o.GetPublicId(), iam.WithDescription("default"), iam.WithName("default"))
 
 	switch withAccts {
 	case false:
-		return u, nil, repoFn
+		return u, nil, repoFn, repoFns
 	default:
 		require := require.New(t)
 		ctx := context.Background()
-		kmsCache := kms.TestKms(t, conn, wrap)
 		databaseWrap, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
 		require.NoError(err)
 		primaryAm := oidc.TestAuthMethod(t, conn, databaseWrap, o.PublicId, oidc.ActivePublicState, "alice-rp", "fido",
@@ -84,12 +130,12 @@ func createDefaultUserAndRepo(t *testing.T, withAccts bool) (*iam.User, []string
 		// reload the user with their accounts
 		u, accts, err := repo.LookupUser(ctx, u.PublicId)
 		require.NoError(err)
-		return u, accts, repoFn
+		return u, accts, repoFn, repoFns
 	}
 }
 
 func TestGet(t *testing.T) {
-	u, uAccts, repoFn := createDefaultUserAndRepo(t, true)
+	u, uAccts, repoFn, repoFns := createDefaultUserAndRepo(t, true)
 
 	toMerge := &pbs.GetUserRequest{
 		Id: u.GetPublicId(),
@@ -149,7 +195,7 @@ func TestGet(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetUserRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := users.NewService(context.Background(), repoFn, 1000)
+			s, err := newTestService(t, repoFn, repoFns)
 			require.NoError(err, "Couldn't create new user service.")
 
 			got, gErr := s.GetUser(auth.DisabledAuthTestContext(repoFn, u.GetScopeId()), req)
@@ -196,7 +242,7 @@ func TestList(t *testing.T) {
 	secondaryAm := password.TestAuthMethods(t, conn, oWithUsers.PublicId, 1)
 	require.Len(t, secondaryAm, 1)
 
-	s, err := users.NewService(context.Background(), repoFn, 1000)
+	s, err := newTestService(t, repoFn, newTestRepoFns(t, conn, wrap, kmsCache))
 	require.NoError(t, err)
 
 	var wantUsers []*pb.User
@@ -478,7 +524,7 @@ func TestListPagination(t *testing.T) {
 	}
 	slices.Reverse(allUsers)
 
-	a, err := users.NewService(ctx, iamRepoFn, 1000)
+	a, err := newTestService(t, iamRepoFn, newTestRepoFns(t, conn, wrapper, kms))
 	require.NoError(t, err, "Couldn't create new user service.")
 
 	// Run analyze to update postgres estimates
@@ -730,9 +776,9 @@ func TestListPagination(t *testing.T) {
 }
 
 func TestDelete(t *testing.T) {
-	u, _, repoFn := createDefaultUserAndRepo(t, false)
+	u, _, repoFn, repoFns := createDefaultUserAndRepo(t, false)
 
-	s, err := users.NewService(context.Background(), repoFn, 1000)
+	s, err := newTestService(t, repoFn, repoFns)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	cases := []struct {
@@ -777,9 +823,9 @@ func TestDelete(t *testing.T) {
 
 func TestDelete_twice(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
-	u, _, repoFn := createDefaultUserAndRepo(t, false)
+	u, _, repoFn, repoFns := createDefaultUserAndRepo(t, false)
 
-	s, err := users.NewService(context.Background(), repoFn, 1000)
+	s, err := newTestService(t, repoFn, repoFns)
 	require.NoError(err, "Error when getting new user service")
 	req := &pbs.DeleteUserRequest{
 		Id: u.GetPublicId(),
@@ -793,7 +839,7 @@ func TestDelete_twice(t *testing.T) {
 }
 
 func TestCreate(t *testing.T) {
-	defaultUser, _, repoFn := createDefaultUserAndRepo(t, false)
+	defaultUser, _, repoFn, repoFns := createDefaultUserAndRepo(t, false)
 	defaultCreated := defaultUser.GetCreateTime().GetTimestamp().AsTime()
 
 	cases := []struct {
@@ -871,7 +917,7 @@ func TestCreate(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := users.NewService(context.Background(), repoFn, 1000)
+			s, err := newTestService(t, repoFn, repoFns)
 			require.NoError(err, "Error when getting new user service.")
 
 			got, gErr := s.CreateUser(auth.DisabledAuthTestContext(repoFn, tc.req.GetItem().GetScopeId()), tc.req)
@@ -906,8 +952,8 @@ func TestCreate(t *testing.T) {
 }
 
 func TestUpdate(t *testing.T) {
-	u, _, repoFn := createDefaultUserAndRepo(t, false)
-	tested, err := users.NewService(context.Background(), repoFn, 1000)
+	u, _, repoFn, repoFns := createDefaultUserAndRepo(t, false)
+	tested, err := newTestService(t, repoFn, repoFns)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	created := u.GetCreateTime().GetTimestamp().AsTime()
@@ -1191,7 +1237,7 @@ func TestAddAccount(t *testing.T) {
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	s, err := users.NewService(ctx, repoFn, 1000)
+	s, err := newTestService(t, repoFn, newTestRepoFns(t, conn, wrap, kmsCache))
 	require.NoError(t, err, "Error when getting new user service.")
 
 	o, _ := iam.TestScopes(t, iamRepo)
@@ -1351,7 +1397,7 @@ func TestSetAccount(t *testing.T) {
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	s, err := users.NewService(ctx, repoFn, 1000)
+	s, err := newTestService(t, repoFn, newTestRepoFns(t, conn, wrap, kmsCache))
 	require.NoError(t, err, "Error when getting new user service.")
 
 	o, _ := iam.TestScopes(t, iamRepo)
@@ -1513,7 +1559,7 @@ func TestRemoveAccount(t *testing.T) {
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	s, err := users.NewService(ctx, repoFn, 1000)
+	s, err := newTestService(t, repoFn, newTestRepoFns(t, conn, wrap, kmsCache))
 	require.NoError(t, err, "Error when getting new user service.")
 
 	o, _ := iam.TestScopes(t, iamRepo)
@@ -1685,3 +1731,224 @@ func TestRemoveAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestExplainPermissions(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	ctx := context.Background()
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	repoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	s, err := newTestService(t, repoFn, newTestRepoFns(t, conn, wrap, kms.TestKms(t, conn, wrap)))
+	require.NoError(t, err, "Error when getting new user service.")
+
+	o, p := iam.TestScopes(t, iamRepo)
+	usr := iam.TestUser(t, iamRepo, o.GetPublicId())
+	grp := iam.TestGroup(t, conn, o.GetPublicId())
+	iam.TestGroupMember(t, conn, grp.GetPublicId(), usr.GetPublicId())
+	projGrp := iam.TestGroup(t, conn, p.GetPublicId())
+
+	userRole := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, userRole.GetPublicId(), "ids=*;type=group;actions=read")
+	iam.TestUserRole(t, conn, userRole.GetPublicId(), usr.GetPublicId())
+	groupRole := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, groupRole.GetPublicId(), "ids=*;type=group;actions=read,update")
+	iam.TestGroupRole(t, conn, groupRole.GetPublicId(), grp.GetPublicId())
+	expiredRole := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, expiredRole.GetPublicId(), "ids=*;type=group;actions=delete;valid_until=2020-01-01T00:00:00Z")
+	iam.TestUserRole(t, conn, expiredRole.GetPublicId(), usr.GetPublicId())
+	targetProj := iam.TestProject(t, iamRepo, o.GetPublicId())
+	filterRole := iam.TestRole(t, conn, targetProj.GetPublicId())
+	iam.TestRoleGrant(t, conn, filterRole.GetPublicId(), `ids=*;type=target;actions=read;filter="/item/name" matches "^dev-"`)
+	iam.TestUserRole(t, conn, filterRole.GetPublicId(), usr.GetPublicId())
+	devTarget := tcp.TestTarget(ctx, t, conn, targetProj.GetPublicId(), "dev-web", target.WithDefaultPort(22))
+	prodTarget := tcp.TestTarget(ctx, t, conn, targetProj.GetPublicId(), "prod-web", target.WithDefaultPort(22))
+
+	explain := func(t *testing.T, resourceId, act string) (*pb.PermissionExplanation, error) {
+		got, err := s.ExplainUserPermissions(auth.DisabledAuthTestContext(repoFn, o.GetPublicId()), &pbs.ExplainUserPermissionsRequest{
+			Id:         usr.GetPublicId(),
+			ResourceId: resourceId,
+			Action:     act,
+		})
+		return got.GetItem(), err
+	}
+
+	t.Run("multiple-matches", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := explain(t, grp.GetPublicId(), "read")
+		require.NoError(err)
+		assert.True(got.GetAuthorized())
+		assert.Equal("group", got.GetResourceType())
+		assert.Equal(o.GetPublicId(), got.GetScopeId())
+		assert.Empty(got.GetReasons())
+		require.Len(got.GetMatchingGrants(), 2)
+		principals := map[string]string{}
+		for _, g := range got.GetMatchingGrants() {
+			assert.Equal(o.GetPublicId(), g.GetRoleScopeId())
+			assert.Equal(o.GetPublicId(), g.GetGrantScopeId())
+			require.Len(g.GetPrincipals(), 1)
+			principals[g.GetRoleId()] = g.GetPrincipals()[0].GetId()
+		}
+		assert.Equal(usr.GetPublicId(), principals[userRole.GetPublicId()])
+		assert.Equal(grp.GetPublicId(), principals[groupRole.GetPublicId()])
+	})
+	t.Run("single-match", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := explain(t, grp.GetPublicId(), "update")
+		require.NoError(err)
+		assert.True(got.GetAuthorized())
+		require.Len(got.GetMatchingGrants(), 1)
+		assert.Equal(groupRole.GetPublicId(), got.GetMatchingGrants()[0].GetRoleId())
+		assert.Equal("group", got.GetMatchingGrants()[0].GetPrincipals()[0].GetType())
+	})
+	t.Run("expired-grant", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := explain(t, grp.GetPublicId(), "delete")
+		require.NoError(err)
+		assert.False(got.GetAuthorized())
+		assert.Empty(got.GetMatchingGrants())
+		require.Len(got.GetReasons(), 2)
+		assert.Contains(got.GetReasons()[1], "is not in effect at this time")
+	})
+	t.Run("filter-matches", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := explain(t, devTarget.GetPublicId(), "read")
+		require.NoError(err)
+		assert.True(got.GetAuthorized())
+		require.Len(got.GetMatchingGrants(), 1)
+		assert.Equal(filterRole.GetPublicId(), got.GetMatchingGrants()[0].GetRoleId())
+	})
+	t.Run("filter-does-not-match", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := explain(t, prodTarget.GetPublicId(), "read")
+		require.NoError(err)
+		assert.False(got.GetAuthorized())
+		assert.Empty(got.GetMatchingGrants())
+		require.Len(got.GetReasons(), 2)
+		assert.Contains(got.GetReasons()[1], "does not match")
+	})
+	t.Run("no-grants-in-scope", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := explain(t, projGrp.GetPublicId(), "read")
+		require.NoError(err)
+		assert.False(got.GetAuthorized())
+		assert.Equal(p.GetPublicId(), got.GetScopeId())
+		require.Len(got.GetReasons(), 1)
+		assert.Contains(got.GetReasons()[0], "grant permissions in scope")
+	})
+	t.Run("resource-not-found", func(t *testing.T) {
+		_, err := explain(t, "g_1234567890", "read")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, handlers.NotFoundError()))
+	})
+
+	failCases := []struct {
+		name string
+		req  *pbs.ExplainUserPermissionsRequest
+	}{
+		{
+			name: "Bad user Id",
+			req:  &pbs.ExplainUserPermissionsRequest{Id: "bad id", ResourceId: grp.GetPublicId(), Action: "read"},
+		},
+		{
+			name: "Missing resource Id",
+			req:  &pbs.ExplainUserPermissionsRequest{Id: usr.GetPublicId(), Action: "read"},
+		},
+		{
+			name: "Unknown resource type",
+			req:  &pbs.ExplainUserPermissionsRequest{Id: usr.GetPublicId(), ResourceId: "zzz_1234567890", Action: "read"},
+		},
+		{
+			name: "Missing action",
+			req:  &pbs.ExplainUserPermissionsRequest{Id: usr.GetPublicId(), ResourceId: grp.GetPublicId()},
+		},
+		{
+			name: "Unknown action",
+			req:  &pbs.ExplainUserPermissionsRequest{Id: usr.GetPublicId(), ResourceId: grp.GetPublicId(), Action: "fly"},
+		},
+	}
+	for _, tc := range failCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.ExplainUserPermissions(auth.DisabledAuthTestContext(repoFn, o.GetPublicId()), tc.req)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+		})
+	}
+}
+
+func TestListResolvableAliases(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	s, err := newTestService(t, iamRepoFn, newTestRepoFns(t, conn, wrap, kmsCache))
+	require.NoError(t, err)
+
+	o, p := iam.TestScopes(t, iamRepo)
+	allowed := tcp.TestTarget(ctx, t, conn, p.GetPublicId(), "allowed", target.WithDefaultPort(22))
+	denied := tcp.TestTarget(ctx, t, conn, p.GetPublicId(), "denied", target.WithDefaultPort(22))
+	allowedAlias := alias.TestAlias(t, conn, "allowed.example", alias.WithDestinationId(allowed.GetPublicId()))
+	alias.TestAlias(t, conn, "denied.example", alias.WithDestinationId(denied.GetPublicId()))
+	alias.TestAlias(t, conn, "nowhere.example")
+
+	authMethod := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	acct := password.TestAccount(t, conn, authMethod.GetPublicId(), "alias_user")
+	u := iam.TestUser(t, iamRepo, o.GetPublicId(), iam.WithAccountIds(acct.GetPublicId()))
+	other := iam.TestUser(t, iamRepo, o.GetPublicId())
+	role := iam.TestRole(t, conn, p.GetPublicId())
+	iam.TestRoleGrant(t, conn, role.GetPublicId(), fmt.Sprintf("ids=%s;actions=authorize-session", allowed.GetPublicId()))
+	iam.TestUserRole(t, conn, role.GetPublicId(), u.GetPublicId())
+
+	tokenRepo, err := tokenRepoFn()
+	require.NoError(t, err)
+	at, err := tokenRepo.CreateAuthToken(ctx, u, acct.GetPublicId())
+	require.NoError(t, err)
+	requestInfo := authpb.RequestInfo{
+		TokenFormat: uint32(auth.AuthTokenTypeBearer),
+		PublicId:    at.GetPublicId(),
+		Token:       at.GetToken(),
+	}
+	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
+	authCtx := auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+
+	t.Run("own-aliases", func(t *testing.T) {
+		got, err := s.ListResolvableAliases(authCtx, &pbs.ListResolvableAliasesRequest{Id: u.GetPublicId()})
+		require.NoError(t, err)
+		want := &pbs.ListResolvableAliasesResponse{
+			Items: []*aliaspb.Alias{
+				{
+					Id:            allowedAlias.GetPublicId(),
+					ScopeId:       scope.Global.String(),
+					Type:          alias.TargetType,
+					Value:         "allowed.example",
+					DestinationId: allowed.GetPublicId(),
+				},
+			},
+		}
+		assert.Empty(t, cmp.Diff(want, got, protocmp.Transform()))
+	})
+
+	t.Run("other-user", func(t *testing.T) {
+		_, err := s.ListResolvableAliases(authCtx, &pbs.ListResolvableAliasesRequest{Id: other.GetPublicId()})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, handlers.ForbiddenError()), "got error %v", err)
+	})
+
+	t.Run("bad-id", func(t *testing.T) {
+		_, err := s.ListResolvableAliases(authCtx, &pbs.ListResolvableAliasesRequest{Id: "bad id"})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+	})
+}
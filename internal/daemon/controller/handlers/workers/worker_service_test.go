@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -45,7 +46,7 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-worker-tags", "set-worker-tags", "remove-worker-tags"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-worker-tags", "set-worker-tags", "remove-worker-tags", "drain"}
 
 func structListValue(t *testing.T, ss ...string) *structpb.ListValue {
 	t.Helper()
@@ -128,6 +129,7 @@ func TestGet(t *testing.T) {
 		Description:           wrapperspb.String(deprecatedKmsWorker.GetDescription()),
 		Address:               deprecatedKmsWorker.GetAddress(),
 		ActiveConnectionCount: &wrapperspb.UInt32Value{Value: 0},
+		OperationalState:      deprecatedKmsWorker.GetOperationalState(),
 		AuthorizedActions:     strutil.StrListDelete(deprecatedKmsAuthzActions, action.Update.String()),
 		LastStatusTime:        deprecatedKmsWorker.GetLastStatusTime().GetTimestamp(),
 		ReleaseVersion:        deprecatedKmsWorker.ReleaseVersion,
@@ -171,6 +173,7 @@ func TestGet(t *testing.T) {
 		Address:               pkiWorker.GetAddress(),
 		AuthorizedActions:     testAuthorizedActions,
 		ActiveConnectionCount: &wrapperspb.UInt32Value{Value: 0},
+		OperationalState:      pkiWorker.GetOperationalState(),
 		LastStatusTime:        pkiWorker.GetLastStatusTime().GetTimestamp(),
 		ReleaseVersion:        pkiWorker.ReleaseVersion,
 		CanonicalTags: map[string]*structpb.ListValue{
@@ -227,6 +230,7 @@ func TestGet(t *testing.T) {
 		Address:               managedPkiWorker.GetAddress(),
 		AuthorizedActions:     managedPkiAuthzActions,
 		ActiveConnectionCount: &wrapperspb.UInt32Value{Value: 0},
+		OperationalState:      managedPkiWorker.GetOperationalState(),
 		LastStatusTime:        managedPkiWorker.GetLastStatusTime().GetTimestamp(),
 		ReleaseVersion:        managedPkiWorker.ReleaseVersion,
 		CanonicalTags: map[string]*structpb.ListValue{
@@ -350,6 +354,7 @@ func TestList(t *testing.T) {
 			Name:                               wrapperspb.String(w.GetName()),
 			AuthorizedActions:                  strutil.StrListDelete(kmsAuthzActions, action.Update.String()),
 			ActiveConnectionCount:              &wrapperspb.UInt32Value{Value: 0},
+			OperationalState:                   w.GetOperationalState(),
 			Address:                            w.GetAddress(),
 			Type:                               KmsWorkerType,
 			LastStatusTime:                     w.GetLastStatusTime().GetTimestamp(),
@@ -370,6 +375,7 @@ func TestList(t *testing.T) {
 			Version:                            w.GetVersion(),
 			Name:                               wrapperspb.String(w.GetName()),
 			ActiveConnectionCount:              &wrapperspb.UInt32Value{Value: 0},
+			OperationalState:                   w.GetOperationalState(),
 			AuthorizedActions:                  testAuthorizedActions,
 			Address:                            w.GetAddress(),
 			Type:                               PkiWorkerType,
@@ -647,6 +653,7 @@ func TestUpdate(t *testing.T) {
 						Description:                        wrapperspb.String("desc"),
 						CreatedTime:                        wkr.GetCreateTime().GetTimestamp(),
 						ActiveConnectionCount:              &wrapperspb.UInt32Value{Value: 0},
+						OperationalState:                   wkr.GetOperationalState(),
 						LastStatusTime:                     wkr.GetLastStatusTime().GetTimestamp(),
 						AuthorizedActions:                  testAuthorizedActions,
 						Type:                               PkiWorkerType,
@@ -675,6 +682,7 @@ func TestUpdate(t *testing.T) {
 						Name:                               wrapperspb.String("name"),
 						Description:                        wrapperspb.String("default"),
 						ActiveConnectionCount:              &wrapperspb.UInt32Value{Value: 0},
+						OperationalState:                   wkr.GetOperationalState(),
 						CreatedTime:                        wkr.GetCreateTime().GetTimestamp(),
 						LastStatusTime:                     wkr.GetLastStatusTime().GetTimestamp(),
 						AuthorizedActions:                  testAuthorizedActions,
@@ -746,6 +754,7 @@ func TestUpdate(t *testing.T) {
 						Scope:                              expectedScope,
 						Description:                        wrapperspb.String(wkr.Description),
 						ActiveConnectionCount:              &wrapperspb.UInt32Value{Value: 0},
+						OperationalState:                   wkr.GetOperationalState(),
 						CreatedTime:                        wkr.GetCreateTime().GetTimestamp(),
 						LastStatusTime:                     wkr.GetLastStatusTime().GetTimestamp(),
 						AuthorizedActions:                  testAuthorizedActions,
@@ -773,6 +782,7 @@ func TestUpdate(t *testing.T) {
 						Scope:                              expectedScope,
 						CreatedTime:                        wkr.GetCreateTime().GetTimestamp(),
 						ActiveConnectionCount:              &wrapperspb.UInt32Value{Value: 0},
+						OperationalState:                   wkr.GetOperationalState(),
 						LastStatusTime:                     wkr.GetLastStatusTime().GetTimestamp(),
 						AuthorizedActions:                  testAuthorizedActions,
 						Type:                               PkiWorkerType,
@@ -807,6 +817,7 @@ func TestUpdate(t *testing.T) {
 						Name:                               wrapperspb.String("updated"),
 						CreatedTime:                        wkr.GetCreateTime().GetTimestamp(),
 						ActiveConnectionCount:              &wrapperspb.UInt32Value{Value: 0},
+						OperationalState:                   wkr.GetOperationalState(),
 						LastStatusTime:                     wkr.GetLastStatusTime().GetTimestamp(),
 						AuthorizedActions:                  testAuthorizedActions,
 						Type:                               PkiWorkerType,
@@ -839,6 +850,7 @@ func TestUpdate(t *testing.T) {
 						Scope:                              expectedScope,
 						Name:                               wrapperspb.String("updated"),
 						ActiveConnectionCount:              &wrapperspb.UInt32Value{Value: 0},
+						OperationalState:                   wkr.GetOperationalState(),
 						Description:                        wrapperspb.String("notignored"),
 						CreatedTime:                        wkr.GetCreateTime().GetTimestamp(),
 						LastStatusTime:                     wkr.GetLastStatusTime().GetTimestamp(),
@@ -1546,6 +1558,7 @@ func TestCreateWorkerLed(t *testing.T) {
 					Name:                  &wrapperspb.StringValue{Value: "success"},
 					Description:           &wrapperspb.StringValue{Value: "success-description"},
 					ActiveConnectionCount: &wrapperspb.UInt32Value{Value: 0},
+					OperationalState:      server.UnknownOperationalState.String(),
 					Version:               1,
 					Type:                  PkiWorkerType,
 				},
@@ -1890,6 +1903,7 @@ func TestCreateControllerLed(t *testing.T) {
 					Name:                  &wrapperspb.StringValue{Value: "success"},
 					Description:           &wrapperspb.StringValue{Value: "success-description"},
 					ActiveConnectionCount: &wrapperspb.UInt32Value{Value: 0},
+					OperationalState:      server.UnknownOperationalState.String(),
 					Version:               1,
 					Type:                  PkiWorkerType,
 				},
@@ -2455,6 +2469,95 @@ func TestService_RemoveWorkerTags(t *testing.T) {
 	}
 }
 
+func TestService_DrainWorker(t *testing.T) {
+	ctx := context.Background()
+
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	_, proj := iam.TestScopes(t, iamRepo)
+	rw := db.New(conn)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, testKms)
+	}
+	workerAuthRepo, err := server.NewRepositoryStorage(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+	workerAuthRepoFn := func() (*server.WorkerAuthRepositoryStorage, error) {
+		return workerAuthRepo, nil
+	}
+	s, err := NewService(context.Background(), repoFn, iamRepoFn, workerAuthRepoFn, nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		req             func() *pbs.DrainWorkerRequest
+		wantTimeout     time.Duration
+		wantErrContains string
+	}{
+		{
+			name: "bad-id",
+			req: func() *pbs.DrainWorkerRequest {
+				return &pbs.DrainWorkerRequest{Id: "bad_id", Version: 1}
+			},
+			wantErrContains: "Incorrectly formatted identifier.",
+		},
+		{
+			name: "missing-version",
+			req: func() *pbs.DrainWorkerRequest {
+				worker := server.TestKmsWorker(t, conn, wrapper)
+				return &pbs.DrainWorkerRequest{Id: worker.PublicId}
+			},
+			wantErrContains: "Required field.",
+		},
+		{
+			name: "bad-version",
+			req: func() *pbs.DrainWorkerRequest {
+				worker := server.TestKmsWorker(t, conn, wrapper)
+				return &pbs.DrainWorkerRequest{Id: worker.PublicId, Version: worker.Version + 10}
+			},
+			wantErrContains: "unable to drain worker",
+		},
+		{
+			name: "default-timeout",
+			req: func() *pbs.DrainWorkerRequest {
+				worker := server.TestKmsWorker(t, conn, wrapper)
+				return &pbs.DrainWorkerRequest{Id: worker.PublicId, Version: worker.Version}
+			},
+			wantTimeout: server.DefaultDrainTimeout,
+		},
+		{
+			name: "custom-timeout",
+			req: func() *pbs.DrainWorkerRequest {
+				worker := server.TestPkiWorker(t, conn, wrapper)
+				return &pbs.DrainWorkerRequest{Id: worker.PublicId, Version: worker.Version, DrainTimeout: 60}
+			},
+			wantTimeout: time.Minute,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			req := tc.req()
+			before := time.Now()
+			got, err := s.DrainWorker(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), req)
+			if tc.wantErrContains != "" {
+				require.Error(err)
+				assert.Nil(got)
+				assert.Contains(err.Error(), tc.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(req.Version+1, got.GetItem().GetVersion())
+			deadline := got.GetItem().GetDrainDeadline().AsTime()
+			assert.WithinDuration(before.Add(tc.wantTimeout), deadline, time.Minute)
+		})
+	}
+}
+
 func TestReadCertificateAuthority(t *testing.T) {
 	require, assert := require.New(t), assert.New(t)
 	ctx := context.Background()
@@ -68,6 +68,8 @@ func requestCtxUnaryInterceptor(
 	passwordAuthRepoFn common.PasswordAuthRepoFactory,
 	oidcAuthRepoFn common.OidcAuthRepoFactory,
 	ldapAuthRepoFn common.LdapAuthRepoFactory,
+	jwtAuthRepoFn common.JwtAuthRepoFactory,
+	samlAuthRepoFn common.SamlAuthRepoFactory,
 	kms *kms.Kms,
 	ticket string,
 	eventer *event.Eventer,
@@ -100,6 +102,8 @@ func requestCtxUnaryInterceptor(
 			passwordAuthRepoFn,
 			oidcAuthRepoFn,
 			ldapAuthRepoFn,
+			jwtAuthRepoFn,
+			samlAuthRepoFn,
 			kms,
 			ticket,
 			eventer,
@@ -123,6 +127,8 @@ func requestCtxStreamInterceptor(
 	passwordAuthRepoFn common.PasswordAuthRepoFactory,
 	oidcAuthRepoFn common.OidcAuthRepoFactory,
 	ldapAuthRepoFn common.LdapAuthRepoFactory,
+	jwtAuthRepoFn common.JwtAuthRepoFactory,
+	samlAuthRepoFn common.SamlAuthRepoFactory,
 	kms *kms.Kms,
 	ticket string,
 	eventer *event.Eventer,
@@ -154,6 +160,8 @@ func requestCtxStreamInterceptor(
 			passwordAuthRepoFn,
 			oidcAuthRepoFn,
 			ldapAuthRepoFn,
+			jwtAuthRepoFn,
+			samlAuthRepoFn,
 			kms,
 			ticket,
 			eventer,
@@ -210,6 +218,8 @@ func sharedRequestInterceptorLogic(
 	passwordAuthRepoFn common.PasswordAuthRepoFactory,
 	oidcAuthRepoFn common.OidcAuthRepoFactory,
 	ldapAuthRepoFn common.LdapAuthRepoFactory,
+	jwtAuthRepoFn common.JwtAuthRepoFactory,
+	samlAuthRepoFn common.SamlAuthRepoFactory,
 	kms *kms.Kms,
 	ticket string,
 	eventer *event.Eventer,
@@ -242,7 +252,7 @@ func sharedRequestInterceptorLogic(
 		return nil, errors.New(interceptorCtx, errors.Internal, op, "Invalid context (bad ticket)")
 	}
 
-	interceptorCtx = auth.NewVerifierContextWithAccounts(interceptorCtx, iamRepoFn, authTokenRepoFn, serversRepoFn, passwordAuthRepoFn, oidcAuthRepoFn, ldapAuthRepoFn, kms, &requestInfo)
+	interceptorCtx = auth.NewVerifierContextWithAccounts(interceptorCtx, iamRepoFn, authTokenRepoFn, serversRepoFn, passwordAuthRepoFn, oidcAuthRepoFn, ldapAuthRepoFn, jwtAuthRepoFn, samlAuthRepoFn, kms, &requestInfo)
 
 	// Add general request information to the context. The information from
 	// the auth verifier context is pretty specifically curated to
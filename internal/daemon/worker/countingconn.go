@@ -6,10 +6,11 @@ package worker
 import (
 	"net"
 	"sync"
+	"time"
 )
 
 // countingConn is a `net.Conn` implementation that records the bytes that go
-// across Read() and Write(). All other `net.Conn` function calls are a
+// across Read() and Write(), and when they last did. All other `net.Conn` function calls are a
 // pass-through to the underlying `net.Conn`, meaning it's also safe to call
 // those functions directly on the underlying object, if you have access to it.
 type countingConn struct {
@@ -17,6 +18,7 @@ type countingConn struct {
 
 	bytesRead    int64
 	bytesWritten int64
+	lastActivity time.Time
 	// Use mutex for counters as net.Conn methods may be called concurrently
 	// https://github.com/golang/go/issues/27203#issuecomment-415854958
 	mu sync.Mutex
@@ -36,12 +38,23 @@ func (c *countingConn) BytesWritten() int64 {
 	return c.bytesWritten
 }
 
+// LastActivity reports when bytes were last read or written. It is the zero
+// time if no bytes have gone across the connection yet.
+func (c *countingConn) LastActivity() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lastActivity
+}
+
 // Read wraps the embedded conn's Read() and counts the number of bytes read
 // (the number of bytes the client sent to us).
 func (c *countingConn) Read(in []byte) (int, error) {
 	n, err := c.Conn.Read(in)
 	c.mu.Lock()
 	c.bytesRead += int64(n)
+	if n > 0 {
+		c.lastActivity = time.Now()
+	}
 	c.mu.Unlock()
 	return n, err
 }
@@ -52,6 +65,9 @@ func (c *countingConn) Write(in []byte) (int, error) {
 	n, err := c.Conn.Write(in)
 	c.mu.Lock()
 	c.bytesWritten += int64(n)
+	if n > 0 {
+		c.lastActivity = time.Now()
+	}
 	c.mu.Unlock()
 	return n, err
 }
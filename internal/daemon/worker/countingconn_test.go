@@ -74,6 +74,7 @@ func TestCountingConn(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			conn := countingConn{Conn: tt.underlyingConn}
+			require.True(t, conn.LastActivity().IsZero())
 
 			readBytes := make([]byte, tt.underlyingConn.bytesToRead)
 			read, err := conn.Read(readBytes)
@@ -89,6 +90,8 @@ func TestCountingConn(t *testing.T) {
 				require.Len(t, readBytes, tt.underlyingConn.bytesToRead)
 			}
 
+			require.False(t, conn.LastActivity().IsZero())
+
 			written, err := conn.Write(tt.writeBytes)
 			require.True(t, tt.underlyingConn.writeCalled)
 			if tt.underlyingConn.writeErr {
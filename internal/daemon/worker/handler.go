@@ -14,6 +14,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/common"
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	intsession "github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/util"
 	"github.com/hashicorp/boundary/sdk/pbs/proxy"
 	"github.com/hashicorp/boundary/sdk/wspb"
@@ -155,7 +157,13 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			return
 		}
 
+		if handshake.Command == proxy.HANDSHAKECOMMAND_HANDSHAKECOMMAND_SESSION_SHADOW {
+			w.handleShadow(connCtx, conn, sess, &handshake)
+			return
+		}
+
 		if handshake.Command == proxy.HANDSHAKECOMMAND_HANDSHAKECOMMAND_SESSION_CANCEL {
+			w.reverseListeners.close(sessionId)
 			if err := sess.RequestCancel(ctx); err != nil {
 				event.WriteError(ctx, op, err, event.WithInfoMsg("unable to cancel session"))
 				if err = conn.Close(websocket.StatusInternalError, "unable to cancel session"); err != nil && !stderrors.Is(err, io.EOF) {
@@ -169,6 +177,14 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			return
 		}
 
+		if w.draining.Load() {
+			event.WriteError(ctx, op, stderrors.New("worker is draining"), event.WithInfo("session_id", sessionId))
+			if err = conn.Close(websocket.StatusTryAgainLater, "unable to authorize connection: worker is draining"); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+			}
+			return
+		}
+
 		if sess.GetTofuToken() != "" {
 			if subtle.ConstantTimeCompare([]byte(sess.GetTofuToken()), []byte(handshake.GetTofuToken())) != 1 {
 				event.WriteError(ctx, op, stderrors.New("WARNING: mismatched tofu token"), event.WithInfo("session_id", sessionId))
@@ -185,7 +201,8 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 				}
 				return
 			}
-			if handshake.Command == proxy.HANDSHAKECOMMAND_HANDSHAKECOMMAND_UNSPECIFIED {
+			if handshake.Command == proxy.HANDSHAKECOMMAND_HANDSHAKECOMMAND_UNSPECIFIED ||
+				handshake.Command == proxy.HANDSHAKECOMMAND_HANDSHAKECOMMAND_SESSION_REVERSE_LISTEN {
 				err = sess.RequestActivate(ctx, handshake.GetTofuToken())
 				if err != nil {
 					event.WriteError(ctx, op, err, event.WithInfoMsg("unable to validate session"))
@@ -198,6 +215,11 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			}
 		}
 
+		if handshake.Command == proxy.HANDSHAKECOMMAND_HANDSHAKECOMMAND_SESSION_REVERSE_LISTEN {
+			w.handleReverseListen(connCtx, conn, sess)
+			return
+		}
+
 		if w.LastStatusSuccess() == nil || w.LastStatusSuccess().WorkerId == "" {
 			event.WriteError(ctx, op, stderrors.New("worker id is empty"))
 			if err = conn.Close(websocket.StatusInternalError, "worker id is empty"); err != nil {
@@ -207,6 +229,39 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 		}
 		workerId := w.LastStatusSuccess().WorkerId
 
+		targetBandwidthUp, targetBandwidthDown := sess.GetTargetBandwidthLimits()
+		bandwidthUp, bandwidthDown := sess.GetBandwidthLimits()
+		limits := proxyHandlers.Limits{
+			TargetBandwidthUp:   targetBandwidthUp,
+			TargetBandwidthDown: targetBandwidthDown,
+			BandwidthUp:         bandwidthUp,
+			BandwidthDown:       bandwidthDown,
+			ConnectionRate:      sess.GetConnectionRateLimit(),
+		}
+		// Connections of reverse sessions are only authorized once a
+		// connection is made to the session's listener, so the connections
+		// the client keeps waiting don't use up the session's connection
+		// limit.
+		var inbound net.Conn
+		if sess.GetReverse() {
+			if inbound, err = w.reverseListeners.accept(connCtx, sessionId); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("unable to accept reverse connection"), event.WithInfo("session_id", sessionId))
+				if err = conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to accept reverse connection"); err != nil && !stderrors.Is(err, io.EOF) {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+				}
+				return
+			}
+			defer inbound.Close()
+		}
+
+		if !w.limiters.AllowConnection(sessionId, limits) {
+			event.WriteError(ctx, op, stderrors.New("connection rate limit exceeded"), event.WithInfo("session_id", sessionId))
+			if err = conn.Close(websocket.StatusTryAgainLater, "unable to authorize connection: connection rate limit exceeded"); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+			}
+			return
+		}
+
 		var acResp *pbs.AuthorizeConnectionResponse
 		var connsLeft int32
 		acResp, connsLeft, err = sess.RequestAuthorizeConnection(ctx, workerId, connCancel)
@@ -219,9 +274,17 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 		}
 		event.WriteSysEvent(ctx, op, "connection successfully authorized", "session_id", sessionId, "connection_id", acResp.GetConnectionId())
 
-		// Wrapping the client websocket with a `net.Conn` implementation that
+		// Shadows of the connection attach to the client websocket, so the
+		// input of read-write shadows goes across the counting conn like the
+		// client's own.
+		sc := newShadowConn(websocket.NetConn(connCtx, conn, websocket.MessageBinary), acResp.GetConnectionId())
+		// Wrapping the shadowable conn with a `net.Conn` implementation that
 		// records the bytes that go across Read() and Write().
-		cc := &countingConn{Conn: websocket.NetConn(connCtx, conn, websocket.MessageBinary)}
+		cc := &countingConn{Conn: sc}
+		// Enforce the bandwidth limits of the session and its target on top
+		// of the counting conn so the recorded bytes are not affected.
+		lc, releaseLimits := w.limiters.LimitConn(connCtx, cc, sess.GetTargetId(), sessionId, limits)
+		defer releaseLimits()
 		err = sess.ApplyConnectionCounterCallbacks(acResp.GetConnectionId(), cc.BytesRead, cc.BytesWritten)
 		if err != nil {
 			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to set counter callbacks for session connection"))
@@ -232,6 +295,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			return
 		}
 
+		var idleClosed atomic.Bool
 		defer func() {
 			ccd := map[string]*session.ConnectionCloseData{
 				acResp.GetConnectionId(): {
@@ -240,6 +304,9 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 					BytesDown: cc.BytesWritten(),
 				},
 			}
+			if idleClosed.Load() {
+				ccd[acResp.GetConnectionId()].Reason = intsession.ConnectionIdleTimeout
+			}
 			if sessionManager.RequestCloseConnections(ctx, ccd) {
 				event.WriteSysEvent(ctx, op, "connection closed", "session_id", sessionId, "connection_id", acResp.GetConnectionId())
 			}
@@ -276,7 +343,15 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			}
 		}
 
-		pDialer, err := proxyHandlers.GetEndpointDialer(ctx, endpointUrl.Host, workerId, acResp, w.downstreamReceiver, proxyHandlers.WithDnsServerAddress(w.conf.WorkerDnsServer))
+		var pDialer *proxyHandlers.ProxyDialer
+		if sess.GetReverse() {
+			// Connections of reverse sessions are forwarded the connection
+			// accepted by the session's listener instead of dialing the
+			// endpoint.
+			pDialer, err = acceptedDialer(connCtx, inbound)
+		} else {
+			pDialer, err = proxyHandlers.GetEndpointDialer(ctx, endpointUrl.Host, workerId, acResp, w.downstreamReceiver, proxyHandlers.WithDnsServerAddress(w.conf.WorkerDnsServer))
+		}
 		if err != nil {
 			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to get endpoint dialer")
 			event.WriteError(ctx, op, err)
@@ -284,7 +359,15 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 		}
 
 		// Verify the protocol has a supported proxy before calling RequestAuthorizeConnection
-		handleProxyFn, err := proxyHandlers.GetHandler(workerId, acResp.GetProtocolContext())
+		var handleProxyFn proxyHandlers.Handler
+		switch {
+		case sess.GetReverse():
+			handleProxyFn, err = proxyHandlers.HandlerForProtocol(proxyHandlers.ReverseHandlerName)
+		case endpointUrl.Scheme == proxyHandlers.UdpHandlerName:
+			handleProxyFn, err = proxyHandlers.HandlerForProtocol(proxyHandlers.UdpHandlerName)
+		default:
+			handleProxyFn, err = proxyHandlers.GetHandler(workerId, acResp.GetProtocolContext())
+		}
 		if err != nil {
 			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to get proxy handler")
 			event.WriteError(ctx, op, err)
@@ -295,7 +378,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "error getting decryption function")
 			event.WriteError(ctx, op, err)
 		}
-		runProxy, err := handleProxyFn(ctx, ctx, decryptFn, cc, pDialer, acResp.GetConnectionId(), protocolCtx, w.recorderManager)
+		runProxy, err := handleProxyFn(ctx, ctx, decryptFn, lc, pDialer, acResp.GetConnectionId(), protocolCtx, w.recorderManager)
 		if err != nil {
 			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to setup proxying")
 			event.WriteError(ctx, op, err)
@@ -322,10 +405,141 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			return
 		}
 
+		removeShadowConn := w.shadowConns.add(sessionId, sc)
+		defer removeShadowConn()
+
+		if idleTimeout := sess.GetIdleTimeout(); idleTimeout > 0 {
+			go watchIdleConn(connCtx, cc, idleTimeout, func() {
+				event.WriteSysEvent(ctx, op, "closing idle connection", "session_id", sessionId, "connection_id", acResp.GetConnectionId())
+				idleClosed.Store(true)
+				connCancel()
+			})
+		}
+
 		runProxy()
 	}, nil
 }
 
+// handleReverseListen opens the listener of a reverse session, if it is not
+// open already, and sends its address to the client.
+func (w *Worker) handleReverseListen(ctx context.Context, conn *websocket.Conn, sess session.Session) {
+	const op = "worker.(Worker).handleReverseListen"
+	closeConn := func(code websocket.StatusCode, reason string) {
+		if err := conn.Close(code, reason); err != nil && !stderrors.Is(err, io.EOF) {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+		}
+	}
+
+	if !sess.GetReverse() {
+		event.WriteError(ctx, op, stderrors.New("session is not a reverse session"), event.WithInfo("session_id", sess.GetId()))
+		closeConn(websocket.StatusPolicyViolation, "session is not a reverse session")
+		return
+	}
+	allowed, err := reverseAllowedAddrs(ctx, sess.GetEndpoint())
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfo("session_id", sess.GetId()))
+		closeConn(websocket.StatusInternalError, "unable to open reverse listener")
+		return
+	}
+	addr, err := w.reverseListeners.listen(sess.GetId(), sess.GetExpiration(), allowed)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfo("session_id", sess.GetId()))
+		closeConn(websocket.StatusInternalError, "unable to open reverse listener")
+		return
+	}
+	event.WriteSysEvent(ctx, op, "reverse listener opened", "session_id", sess.GetId(), "address", addr)
+
+	handshakeResult := &proxy.HandshakeResult{
+		Expiration:             timestamppb.New(sess.GetExpiration()),
+		ConnectionLimit:        sess.GetConnectionLimit(),
+		ReverseListenerAddress: addr,
+	}
+	if err := wspb.Write(ctx, conn, handshakeResult); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error sending handshake result to client"))
+		closeConn(websocket.StatusProtocolError, "unable to send handshake result")
+		return
+	}
+	closeConn(websocket.StatusNormalClosure, "done")
+}
+
+// handleShadow attaches the client as a shadow of the most recent connection
+// of the session handled by this worker. It blocks until the shadow is
+// detached.
+func (w *Worker) handleShadow(ctx context.Context, conn *websocket.Conn, sess session.Session, handshake *proxy.ClientHandshake) {
+	const op = "worker.(Worker).handleShadow"
+	closeConn := func(code websocket.StatusCode, reason string) {
+		if err := conn.Close(code, reason); err != nil && !stderrors.Is(err, io.EOF) {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing shadow connection"))
+		}
+	}
+
+	switch {
+	case handshake.GetShadowId() == "":
+		event.WriteError(ctx, op, stderrors.New("missing shadow id"), event.WithInfo("session_id", sess.GetId()))
+		closeConn(websocket.StatusPolicyViolation, "missing shadow id")
+		return
+	case len(handshake.GetShadowToken()) < 20:
+		event.WriteError(ctx, op, stderrors.New("invalid shadow token"), event.WithInfo("session_id", sess.GetId()))
+		closeConn(websocket.StatusPolicyViolation, "invalid shadow token")
+		return
+	case sess.GetStatus() != pbs.SESSIONSTATUS_SESSIONSTATUS_ACTIVE:
+		event.WriteError(ctx, op, stderrors.New("session is not active"), event.WithInfo("session_id", sess.GetId()))
+		closeConn(websocket.StatusPolicyViolation, "session is not active")
+		return
+	}
+
+	if w.LastStatusSuccess() == nil || w.LastStatusSuccess().WorkerId == "" {
+		event.WriteError(ctx, op, stderrors.New("worker id is empty"))
+		closeConn(websocket.StatusInternalError, "worker id is empty")
+		return
+	}
+	workerId := w.LastStatusSuccess().WorkerId
+
+	sc := w.shadowConns.latest(sess.GetId())
+	if sc == nil {
+		event.WriteError(ctx, op, stderrors.New("no connection to shadow"), event.WithInfo("session_id", sess.GetId()))
+		closeConn(websocket.StatusPolicyViolation, "no connection to shadow")
+		return
+	}
+
+	resp, err := sess.RequestAuthorizeShadow(ctx, workerId, handshake.GetShadowId(), handshake.GetShadowToken(), sc.connectionId)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to authorize shadow", "session_id", sess.GetId(), "shadow_id", handshake.GetShadowId()))
+		closeConn(websocket.StatusPolicyViolation, "unable to authorize shadow")
+		return
+	}
+
+	handshakeResult := &proxy.HandshakeResult{
+		Expiration:      timestamppb.New(sess.GetExpiration()),
+		ConnectionLimit: sess.GetConnectionLimit(),
+		// Shadows do not consume connections of the session
+		ConnectionsLeft: -1,
+		ShadowReadWrite: resp.GetReadWrite(),
+	}
+	if err := wspb.Write(ctx, conn, handshakeResult); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error sending handshake result to shadow"))
+		closeConn(websocket.StatusProtocolError, "unable to send handshake result")
+		return
+	}
+	event.WriteSysEvent(ctx, op, "session shadow attached",
+		"session_id", sess.GetId(),
+		"shadow_id", handshake.GetShadowId(),
+		"user_id", resp.GetUserId(),
+		"read_write", resp.GetReadWrite(),
+		"connection_id", sc.connectionId,
+	)
+
+	select {
+	case <-sc.attach(websocket.NetConn(ctx, conn, websocket.MessageBinary), resp.GetReadWrite()):
+	case <-ctx.Done():
+	}
+	event.WriteSysEvent(ctx, op, "session shadow detached",
+		"session_id", sess.GetId(),
+		"shadow_id", handshake.GetShadowId(),
+		"connection_id", sc.connectionId,
+	)
+}
+
 // credDecryptFn returns a DecryptFn if the worker is a pki worker with
 // WorkerAuthStorage defined. An error is returned if there is an error
 // loading the node credentials.
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/event"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+)
+
+// maxConcurrentHostHealthChecks limits the number of hosts that are health
+// checked at the same time.
+const maxConcurrentHostHealthChecks = 16
+
+// hostHealthChecker runs the health checks of hosts requested by the
+// controller and holds their results until they are sent in a status
+// request.
+type hostHealthChecker struct {
+	conf *config.HostHealthCheck
+
+	l       sync.Mutex
+	running bool
+	lastRun time.Time
+	results []*pbs.HostHealthCheckResult
+}
+
+func newHostHealthChecker(conf *config.HostHealthCheck) *hostHealthChecker {
+	return &hostHealthChecker{conf: conf}
+}
+
+// due reports whether the health checks should be requested from the
+// controller, which is the case if the previous checks have finished and
+// the configured interval has passed since they started.
+func (c *hostHealthChecker) due() bool {
+	c.l.Lock()
+	defer c.l.Unlock()
+	return !c.running && time.Since(c.lastRun) >= c.conf.IntervalDuration
+}
+
+// takeResults returns the results of health checks which have not been sent
+// to the controller yet.
+func (c *hostHealthChecker) takeResults() []*pbs.HostHealthCheckResult {
+	c.l.Lock()
+	defer c.l.Unlock()
+	ret := c.results
+	c.results = nil
+	return ret
+}
+
+// returnResults returns results which could not be sent to the controller so
+// that they are sent in the next status request, unless they were superseded
+// by newer results in the meantime.
+func (c *hostHealthChecker) returnResults(results []*pbs.HostHealthCheckResult) {
+	if len(results) == 0 {
+		return
+	}
+	c.l.Lock()
+	defer c.l.Unlock()
+	if len(c.results) == 0 {
+		c.results = results
+	}
+}
+
+// run health checks the given hosts in the background.
+func (c *hostHealthChecker) run(ctx context.Context, checks []*pbs.HostHealthCheck) {
+	c.l.Lock()
+	defer c.l.Unlock()
+	if c.running {
+		return
+	}
+	c.running = true
+	c.lastRun = time.Now()
+	go func() {
+		results := c.checkAll(ctx, checks)
+		c.l.Lock()
+		defer c.l.Unlock()
+		c.running = false
+		c.results = results
+	}()
+}
+
+// checkAll health checks the given hosts and returns the results
+func (c *hostHealthChecker) checkAll(ctx context.Context, checks []*pbs.HostHealthCheck) []*pbs.HostHealthCheckResult {
+	const op = "worker.(hostHealthChecker).checkAll"
+	results := make([]*pbs.HostHealthCheckResult, len(checks))
+	sem := make(chan struct{}, maxConcurrentHostHealthChecks)
+	var wg sync.WaitGroup
+	for i, hc := range checks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, hc *pbs.HostHealthCheck) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			err := c.check(ctx, hc)
+			if err != nil {
+				event.WriteSysEvent(ctx, op, "host health check failed", "host_id", hc.GetHostId(), "port", hc.GetPort(), "error", err.Error())
+			}
+			results[i] = &pbs.HostHealthCheckResult{
+				HostId:  hc.GetHostId(),
+				Port:    hc.GetPort(),
+				Healthy: err == nil,
+			}
+		}(i, hc)
+	}
+	wg.Wait()
+	return results
+}
+
+// check runs a single health check, returning an error if the host is not
+// healthy.
+func (c *hostHealthChecker) check(ctx context.Context, hc *pbs.HostHealthCheck) error {
+	ctx, cancel := context.WithTimeout(ctx, c.conf.TimeoutDuration)
+	defer cancel()
+	addr := net.JoinHostPort(hc.GetAddress(), strconv.FormatUint(uint64(hc.GetPort()), 10))
+	switch c.conf.Type {
+	case "http":
+		u := url.URL{Scheme: "http", Host: addr, Path: c.conf.HttpPath}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return err
+		}
+		client := http.Client{
+			// A redirect is enough to know the host is serving requests
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+			return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+		}
+		return nil
+	default:
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostHealthChecker(t *testing.T) {
+	ctx := context.Background()
+
+	splitAddr := func(t *testing.T, addr string) (string, uint32) {
+		host, port, err := net.SplitHostPort(addr)
+		require.NoError(t, err)
+		p, err := strconv.ParseUint(port, 10, 32)
+		require.NoError(t, err)
+		return host, uint32(p)
+	}
+
+	// A port nothing is listening on
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedHost, closedPort := splitAddr(t, l.Addr().String())
+	require.NoError(t, l.Close())
+
+	t.Run("tcp", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		t.Cleanup(func() { l.Close() })
+		go func() {
+			for {
+				c, err := l.Accept()
+				if err != nil {
+					return
+				}
+				c.Close()
+			}
+		}()
+		h, p := splitAddr(t, l.Addr().String())
+
+		c := newHostHealthChecker(&config.HostHealthCheck{Type: "tcp", TimeoutDuration: time.Second})
+		assert.NoError(t, c.check(ctx, &pbs.HostHealthCheck{HostId: "hst_1", Address: h, Port: p}))
+		assert.Error(t, c.check(ctx, &pbs.HostHealthCheck{HostId: "hst_2", Address: closedHost, Port: closedPort}))
+	})
+
+	t.Run("http", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/healthz":
+				w.WriteHeader(http.StatusOK)
+			case "/moved":
+				http.Redirect(w, r, "/elsewhere", http.StatusFound)
+			default:
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}))
+		t.Cleanup(srv.Close)
+		h, p := splitAddr(t, srv.Listener.Addr().String())
+		hc := &pbs.HostHealthCheck{HostId: "hst_1", Address: h, Port: p}
+
+		c := newHostHealthChecker(&config.HostHealthCheck{Type: "http", HttpPath: "/healthz", TimeoutDuration: time.Second})
+		assert.NoError(t, c.check(ctx, hc))
+		c = newHostHealthChecker(&config.HostHealthCheck{Type: "http", HttpPath: "/moved", TimeoutDuration: time.Second})
+		assert.NoError(t, c.check(ctx, hc))
+		c = newHostHealthChecker(&config.HostHealthCheck{Type: "http", HttpPath: "/down", TimeoutDuration: time.Second})
+		assert.Error(t, c.check(ctx, hc))
+	})
+
+	t.Run("run", func(t *testing.T) {
+		c := newHostHealthChecker(&config.HostHealthCheck{Type: "tcp", IntervalDuration: time.Hour, TimeoutDuration: time.Second})
+		require.True(t, c.due())
+		c.run(ctx, []*pbs.HostHealthCheck{{HostId: "hst_1", Address: closedHost, Port: closedPort}})
+		// Checks are not due again until the interval has passed
+		assert.False(t, c.due())
+
+		var results []*pbs.HostHealthCheckResult
+		require.Eventually(t, func() bool {
+			results = c.takeResults()
+			return len(results) > 0
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Len(t, results, 1)
+		assert.Equal(t, "hst_1", results[0].GetHostId())
+		assert.Equal(t, closedPort, results[0].GetPort())
+		assert.False(t, results[0].GetHealthy())
+		assert.Empty(t, c.takeResults())
+
+		// Results which could not be sent are sent next time
+		c.returnResults(results)
+		assert.Len(t, c.takeResults(), 1)
+		assert.False(t, c.due())
+	})
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"time"
+)
+
+// watchIdleConn calls onIdle once no bytes have gone across cc in either
+// direction for timeout, and then returns. It returns without calling onIdle
+// if ctx is done first. The time watchIdleConn is called counts as activity.
+func watchIdleConn(ctx context.Context, cc *countingConn, timeout time.Duration, onIdle func()) {
+	start := time.Now()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+		last := cc.LastActivity()
+		if last.Before(start) {
+			last = start
+		}
+		remaining := time.Until(last.Add(timeout))
+		if remaining <= 0 {
+			onIdle()
+			return
+		}
+		timer.Reset(remaining)
+	}
+}
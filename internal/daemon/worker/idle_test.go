@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchIdleConn(t *testing.T) {
+	t.Parallel()
+	const timeout = 200 * time.Millisecond
+
+	t.Run("idle", func(t *testing.T) {
+		t.Parallel()
+		cc := &countingConn{Conn: &testNetConn{bytesToRead: 10}}
+		idle := make(chan struct{})
+		start := time.Now()
+		go watchIdleConn(context.Background(), cc, timeout, func() { close(idle) })
+		select {
+		case <-idle:
+			assert.GreaterOrEqual(t, time.Since(start), timeout)
+		case <-time.After(5 * time.Second):
+			t.Fatal("idle connection was not detected")
+		}
+	})
+
+	t.Run("active", func(t *testing.T) {
+		t.Parallel()
+		cc := &countingConn{Conn: &testNetConn{bytesToRead: 10}}
+		idle := make(chan struct{})
+		start := time.Now()
+		go watchIdleConn(context.Background(), cc, timeout, func() { close(idle) })
+
+		// Keep the connection active for a few timeouts
+		for i := 0; i < 6; i++ {
+			time.Sleep(timeout / 2)
+			_, err := cc.Read(make([]byte, 10))
+			require.NoError(t, err)
+		}
+		select {
+		case <-idle:
+			t.Fatal("active connection was considered idle")
+		default:
+		}
+
+		<-idle
+		assert.GreaterOrEqual(t, time.Since(start), 3*timeout)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		t.Parallel()
+		cc := &countingConn{Conn: &testNetConn{bytesToRead: 10}}
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			watchIdleConn(ctx, cc, timeout, func() { t.Error("unexpected call to onIdle") })
+			close(done)
+		}()
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("watcher did not return when its context was canceled")
+		}
+	})
+}
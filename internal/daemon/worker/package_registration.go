@@ -4,5 +4,7 @@
 package worker
 
 import (
+	_ "github.com/hashicorp/boundary/internal/daemon/worker/proxy/reverse"
 	_ "github.com/hashicorp/boundary/internal/daemon/worker/proxy/tcp"
+	_ "github.com/hashicorp/boundary/internal/daemon/worker/proxy/udp"
 )
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// limiterIdleTimeout is how long an unused limiter is kept around. Every
+// limiter refills completely within a minute, so after that a limiter is
+// indistinguishable from a new one and can be dropped.
+const limiterIdleTimeout = time.Minute
+
+// Limits are the bandwidth and connection rate limits applied to the
+// connections of a session. Bandwidth limits are in bytes per second, where up
+// is data sent from the client to the target and down is data sent from the
+// target to the client. The connection rate limit is in new connections per
+// minute. A value of 0 means unlimited.
+type Limits struct {
+	// TargetBandwidthUp and TargetBandwidthDown are shared by all of the
+	// sessions to the same target proxied by this worker.
+	TargetBandwidthUp   int64
+	TargetBandwidthDown int64
+	// BandwidthUp and BandwidthDown are shared by all of the connections of a
+	// session.
+	BandwidthUp    int64
+	BandwidthDown  int64
+	ConnectionRate int32
+}
+
+// LimiterRegistry tracks the rate limiters shared by the connections of the
+// sessions and targets proxied by a worker.
+type LimiterRegistry struct {
+	mu       sync.Mutex
+	targets  map[string]*limiterEntry
+	sessions map[string]*limiterEntry
+	now      func() time.Time
+}
+
+type limiterEntry struct {
+	up       *rate.Limiter
+	down     *rate.Limiter
+	conns    *rate.Limiter
+	active   int
+	lastUsed time.Time
+}
+
+// NewLimiterRegistry returns an empty LimiterRegistry.
+func NewLimiterRegistry() *LimiterRegistry {
+	return &LimiterRegistry{
+		targets:  map[string]*limiterEntry{},
+		sessions: map[string]*limiterEntry{},
+		now:      time.Now,
+	}
+}
+
+// AllowConnection reports whether a new connection can be made in the session
+// without exceeding the connection rate limit in l. If it can, the connection
+// is counted against the limit.
+func (r *LimiterRegistry) AllowConnection(sessionId string, l Limits) bool {
+	if l.ConnectionRate <= 0 {
+		return true
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	r.prune(now)
+	e := entryFor(r.sessions, sessionId, now)
+	limit := rate.Limit(float64(l.ConnectionRate) / limiterIdleTimeout.Seconds())
+	switch {
+	case e.conns == nil:
+		e.conns = rate.NewLimiter(limit, int(l.ConnectionRate))
+	case e.conns.Limit() != limit:
+		e.conns.SetLimitAt(now, limit)
+		e.conns.SetBurstAt(now, int(l.ConnectionRate))
+	}
+	return e.conns.AllowN(now, 1)
+}
+
+// LimitConn returns a net.Conn wrapping conn which enforces the bandwidth
+// limits in l. Reads from conn count against the up limits and writes to conn
+// count against the down limits. The returned function must be called once
+// the connection is closed. If l has no bandwidth limits conn is returned
+// as is.
+func (r *LimiterRegistry) LimitConn(ctx context.Context, conn net.Conn, targetId, sessionId string, l Limits) (net.Conn, func()) {
+	if l.TargetBandwidthUp <= 0 && l.TargetBandwidthDown <= 0 && l.BandwidthUp <= 0 && l.BandwidthDown <= 0 {
+		return conn, func() {}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	r.prune(now)
+
+	lc := &limitedConn{Conn: conn, ctx: ctx}
+	t := entryFor(r.targets, targetId, now)
+	t.up = updateLimiter(t.up, l.TargetBandwidthUp, now)
+	t.down = updateLimiter(t.down, l.TargetBandwidthDown, now)
+	t.active++
+	s := entryFor(r.sessions, sessionId, now)
+	s.up = updateLimiter(s.up, l.BandwidthUp, now)
+	s.down = updateLimiter(s.down, l.BandwidthDown, now)
+	s.active++
+	lc.up = nonNil(t.up, s.up)
+	lc.down = nonNil(t.down, s.down)
+
+	var once sync.Once
+	return lc, func() {
+		once.Do(func() {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			now := r.now()
+			t.active--
+			t.lastUsed = now
+			s.active--
+			s.lastUsed = now
+		})
+	}
+}
+
+// prune removes the limiters which are not used by any connection and
+// have been idle for long enough to have refilled. Must be called with the
+// lock held.
+func (r *LimiterRegistry) prune(now time.Time) {
+	for _, m := range []map[string]*limiterEntry{r.targets, r.sessions} {
+		for id, e := range m {
+			if e.active == 0 && now.Sub(e.lastUsed) > limiterIdleTimeout {
+				delete(m, id)
+			}
+		}
+	}
+}
+
+func entryFor(m map[string]*limiterEntry, id string, now time.Time) *limiterEntry {
+	e, ok := m[id]
+	if !ok {
+		e = &limiterEntry{}
+		m[id] = e
+	}
+	e.lastUsed = now
+	return e
+}
+
+// updateLimiter returns a limiter allowing bps bytes per second with a burst
+// of one second worth of data, reusing l if it is not nil. It returns nil if
+// bps is not positive.
+//
+// l is shared with the other connections of the session or target, which size
+// their reads and writes by its burst before waiting on it, so its burst is
+// only ever raised. Lowering it would make their waits fail.
+func updateLimiter(l *rate.Limiter, bps int64, now time.Time) *rate.Limiter {
+	if bps <= 0 {
+		return nil
+	}
+	burst := int(bps)
+	if l == nil {
+		return rate.NewLimiter(rate.Limit(bps), burst)
+	}
+	if l.Limit() != rate.Limit(bps) {
+		l.SetLimitAt(now, rate.Limit(bps))
+	}
+	if burst > l.Burst() {
+		l.SetBurstAt(now, burst)
+	}
+	return l
+}
+
+func nonNil(limiters ...*rate.Limiter) []*rate.Limiter {
+	var ret []*rate.Limiter
+	for _, l := range limiters {
+		if l != nil {
+			ret = append(ret, l)
+		}
+	}
+	return ret
+}
+
+// limitedConn is a net.Conn which waits on the up limiters after reading
+// and on the down limiters before writing.
+type limitedConn struct {
+	net.Conn
+
+	ctx  context.Context
+	up   []*rate.Limiter
+	down []*rate.Limiter
+}
+
+// Read reads at most as many bytes as the smallest burst of the up limiters
+// and then waits until the up limiters allow the bytes read.
+func (c *limitedConn) Read(in []byte) (int, error) {
+	if len(c.up) == 0 {
+		return c.Conn.Read(in)
+	}
+	if maxRead := minBurst(c.up); len(in) > maxRead {
+		in = in[:maxRead]
+	}
+	n, err := c.Conn.Read(in)
+	if n > 0 {
+		if werr := wait(c.ctx, c.up, n); werr != nil && err == nil {
+			err = werr
+		}
+	}
+	return n, err
+}
+
+// Write writes in chunks of at most the smallest burst of the down limiters,
+// waiting until the down limiters allow each chunk before writing it.
+func (c *limitedConn) Write(in []byte) (int, error) {
+	if len(c.down) == 0 {
+		return c.Conn.Write(in)
+	}
+	maxChunk := minBurst(c.down)
+	var written int
+	for len(in) > 0 {
+		chunk := in
+		if len(chunk) > maxChunk {
+			chunk = chunk[:maxChunk]
+		}
+		if err := wait(c.ctx, c.down, len(chunk)); err != nil {
+			return written, err
+		}
+		n, err := c.Conn.Write(chunk)
+		written += n
+		if err != nil {
+			return written, err
+		}
+		in = in[n:]
+	}
+	return written, nil
+}
+
+func minBurst(limiters []*rate.Limiter) int {
+	ret := limiters[0].Burst()
+	for _, l := range limiters[1:] {
+		if b := l.Burst(); b < ret {
+			ret = b
+		}
+	}
+	return ret
+}
+
+func wait(ctx context.Context, limiters []*rate.Limiter, n int) error {
+	for _, l := range limiters {
+		if err := l.WaitN(ctx, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
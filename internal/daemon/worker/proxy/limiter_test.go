@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestLimiterRegistry_AllowConnection(t *testing.T) {
+	now := time.Now()
+	r := NewLimiterRegistry()
+	r.now = func() time.Time { return now }
+
+	t.Run("unlimited", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			assert.True(t, r.AllowConnection("s_unlimited", Limits{}))
+		}
+		assert.Empty(t, r.sessions)
+	})
+
+	t.Run("limited", func(t *testing.T) {
+		l := Limits{ConnectionRate: 2}
+		assert.True(t, r.AllowConnection("s_limited", l))
+		assert.True(t, r.AllowConnection("s_limited", l))
+		assert.False(t, r.AllowConnection("s_limited", l))
+		// Other sessions have their own limit
+		assert.True(t, r.AllowConnection("s_other", l))
+
+		// One connection is allowed again after half a minute
+		now = now.Add(30 * time.Second)
+		assert.True(t, r.AllowConnection("s_limited", l))
+		assert.False(t, r.AllowConnection("s_limited", l))
+	})
+
+	t.Run("pruned", func(t *testing.T) {
+		require.Contains(t, r.sessions, "s_other")
+		now = now.Add(2 * limiterIdleTimeout)
+		assert.True(t, r.AllowConnection("s_limited", Limits{ConnectionRate: 1}))
+		assert.NotContains(t, r.sessions, "s_other")
+	})
+}
+
+func TestLimiterRegistry_LimitConn(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unlimited", func(t *testing.T) {
+		r := NewLimiterRegistry()
+		client, _ := net.Pipe()
+		t.Cleanup(func() { client.Close() })
+		conn, release := r.LimitConn(ctx, client, "t_1", "s_1", Limits{ConnectionRate: 10})
+		defer release()
+		assert.Equal(t, client, conn)
+		assert.Empty(t, r.targets)
+		assert.Empty(t, r.sessions)
+	})
+
+	t.Run("down", func(t *testing.T) {
+		r := NewLimiterRegistry()
+		client, server := net.Pipe()
+		t.Cleanup(func() {
+			client.Close()
+			server.Close()
+		})
+		conn, release := r.LimitConn(ctx, client, "t_1", "s_1", Limits{BandwidthDown: 1000})
+		go io.Copy(io.Discard, server)
+
+		// The first second worth of data is written right away and the rest
+		// has to wait for the limiter to refill.
+		start := time.Now()
+		n, err := conn.Write(make([]byte, 1500))
+		require.NoError(t, err)
+		assert.Equal(t, 1500, n)
+		assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+
+		require.Contains(t, r.sessions, "s_1")
+		assert.Equal(t, 1, r.sessions["s_1"].active)
+		release()
+		release()
+		assert.Equal(t, 0, r.sessions["s_1"].active)
+		assert.Equal(t, 0, r.targets["t_1"].active)
+	})
+
+	t.Run("up shared by target", func(t *testing.T) {
+		r := NewLimiterRegistry()
+		l := Limits{TargetBandwidthUp: 1000, BandwidthUp: 5000}
+		client1, server1 := net.Pipe()
+		client2, server2 := net.Pipe()
+		t.Cleanup(func() {
+			client1.Close()
+			server1.Close()
+			client2.Close()
+			server2.Close()
+		})
+		conn1, release1 := r.LimitConn(ctx, client1, "t_1", "s_1", l)
+		defer release1()
+		conn2, release2 := r.LimitConn(ctx, client2, "t_1", "s_2", l)
+		defer release2()
+		go server1.Write(make([]byte, 1000))
+		go server2.Write(make([]byte, 1000))
+
+		// Reads are capped at the smallest burst
+		buf := make([]byte, 4000)
+		start := time.Now()
+		n, err := io.ReadFull(conn1, buf[:1000])
+		require.NoError(t, err)
+		assert.Equal(t, 1000, n)
+		n, err = io.ReadFull(conn2, buf[:1000])
+		require.NoError(t, err)
+		assert.Equal(t, 1000, n)
+		// The second session waits on the limiter of the target
+		assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+		assert.Equal(t, 2, r.targets["t_1"].active)
+	})
+
+	t.Run("shared burst never lowered", func(t *testing.T) {
+		r := NewLimiterRegistry()
+		client1, server1 := net.Pipe()
+		client2, server2 := net.Pipe()
+		t.Cleanup(func() {
+			client1.Close()
+			server1.Close()
+			client2.Close()
+			server2.Close()
+		})
+		_, release1 := r.LimitConn(ctx, client1, "t_1", "s_1", Limits{TargetBandwidthDown: 2000})
+		defer release1()
+		// A connection made after the target's limit was lowered gets the
+		// lower rate, but the burst the first connection sizes its writes by
+		// is kept.
+		_, release2 := r.LimitConn(ctx, client2, "t_1", "s_2", Limits{TargetBandwidthDown: 1000})
+		defer release2()
+		down := r.targets["t_1"].down
+		assert.Equal(t, rate.Limit(1000), down.Limit())
+		assert.Equal(t, 2000, down.Burst())
+
+		// Raising the limit raises the burst
+		_, release3 := r.LimitConn(ctx, client2, "t_1", "s_3", Limits{TargetBandwidthDown: 3000})
+		defer release3()
+		assert.Equal(t, rate.Limit(3000), down.Limit())
+		assert.Equal(t, 3000, down.Burst())
+	})
+}
@@ -26,12 +26,14 @@ type Options struct {
 	WithInjectedApplicationCredentials []*serverpb.Credential
 	WithPostConnectionHook             func(net.Conn)
 	WithDnsServerAddress               string
+	WithNetwork                        string
 }
 
 func getDefaultOptions() Options {
 	return Options{
 		WithInjectedApplicationCredentials: nil,
 		WithPostConnectionHook:             nil,
+		WithNetwork:                        "tcp",
 	}
 }
 
@@ -61,3 +63,11 @@ func WithDnsServerAddress(with string) Option {
 		o.WithDnsServerAddress = with
 	}
 }
+
+// WithNetwork specifies the network, "tcp" or "udp", used by a dialing function
+// to reach the endpoint. Defaults to "tcp".
+func WithNetwork(with string) Option {
+	return func(o *Options) {
+		o.WithNetwork = with
+	}
+}
@@ -45,4 +45,15 @@ func Test_GetOpts(t *testing.T) {
 			runtime.FuncForPC(reflect.ValueOf(testOpts.WithPostConnectionHook).Pointer()).Name(),
 		)
 	})
+
+	t.Run("WithNetwork", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts()
+		assert.Equal("tcp", opts.WithNetwork)
+		opts = GetOpts(WithNetwork("udp"))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.WithNetwork = "udp"
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -14,7 +14,9 @@ import (
 )
 
 var (
-	TcpHandlerName = "tcp"
+	TcpHandlerName     = "tcp"
+	UdpHandlerName     = "udp"
+	ReverseHandlerName = "reverse"
 
 	// handlers is the map of registered handlers
 	handlers sync.Map
@@ -56,11 +58,17 @@ func RegisterHandler(protocol string, handler Handler) error {
 	return nil
 }
 
-// tcpOnly returns only the TCP protocol.
-func tcpOnly(string, proto.Message) (Handler, error) {
-	handler, ok := handlers.Load(TcpHandlerName)
+// HandlerForProtocol returns the handler registered for the provided protocol.
+// If the protocol is not registered nil, ErrUnknownProtocol is returned.
+func HandlerForProtocol(protocol string) (Handler, error) {
+	handler, ok := handlers.Load(protocol)
 	if !ok {
 		return nil, ErrUnknownProtocol
 	}
 	return handler.(Handler), nil
 }
+
+// tcpOnly returns only the TCP protocol.
+func tcpOnly(string, proto.Message) (Handler, error) {
+	return HandlerForProtocol(TcpHandlerName)
+}
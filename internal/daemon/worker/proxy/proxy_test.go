@@ -57,3 +57,22 @@ func TestAlwaysTcpGetHandler(t *testing.T) {
 	require.NoError(err)
 	require.NotNil(handler)
 }
+
+func TestHandlerForProtocol(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	fn := func(context.Context, context.Context, DecryptFn, net.Conn, *ProxyDialer, string, *anypb.Any, RecordingManager) (ProxyConnFn, error) {
+		return nil, nil
+	}
+	const protocol = "handler-for-protocol-test"
+	t.Cleanup(func() {
+		handlers.Delete(protocol)
+	})
+	_, err := HandlerForProtocol(protocol)
+	assert.ErrorIs(err, ErrUnknownProtocol)
+
+	require.NoError(RegisterHandler(protocol, fn))
+
+	handler, err := HandlerForProtocol(protocol)
+	require.NoError(err)
+	require.NotNil(handler)
+}
@@ -20,8 +20,8 @@ import (
 // returns a net.Conn which reaches the provided endpoint.
 var GetEndpointDialer = directDialer
 
-// directDialer returns a ProxyDialer which tcp dials directly to the provided
-// endpoint.
+// directDialer returns a ProxyDialer which dials directly to the provided
+// endpoint over tcp, or over the network set with WithNetwork when dialing.
 func directDialer(ctx context.Context, endpoint string, _ string, _ proto.Message, _ interface{}, opt ...Option) (*ProxyDialer, error) {
 	const op = "proxy.directDialer"
 	if len(endpoint) == 0 {
@@ -35,6 +35,7 @@ func directDialer(ctx context.Context, endpoint string, _ string, _ proto.Messag
 	}
 
 	d, err := NewProxyDialer(ctx, func(dialerOpt ...Option) (net.Conn, error) {
+		dialerOpts := GetOpts(dialerOpt...)
 		if dnsServer != "" {
 			host, port, err := net.SplitHostPort(endpoint)
 			if err != nil {
@@ -78,11 +79,10 @@ func directDialer(ctx context.Context, endpoint string, _ string, _ proto.Messag
 				}
 			}
 		}
-		remoteConn, err := net.Dial("tcp", endpoint)
+		remoteConn, err := net.Dial(dialerOpts.WithNetwork, endpoint)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		dialerOpts := GetOpts(dialerOpt...)
 		if dialerOpts.WithPostConnectionHook != nil {
 			dialerOpts.WithPostConnectionHook(remoteConn)
 		}
@@ -106,7 +106,7 @@ func (p *proxyAddr) Ip() string {
 	return p.ip
 }
 
-// Port returns the tcp port of the endpoint connected to
+// Port returns the tcp or udp port of the endpoint connected to
 func (p *proxyAddr) Port() uint32 {
 	return p.port
 }
@@ -161,6 +161,9 @@ func (d *ProxyDialer) Dial(ctx context.Context, opt ...Option) (net.Conn, error)
 		ip := addr.IP.String()
 		port := uint32(addr.Port)
 		d.latestAddr.Store(&proxyAddr{ip: ip, port: port})
+	case *net.UDPConn:
+		addr := v.RemoteAddr().(*net.UDPAddr)
+		d.latestAddr.Store(&proxyAddr{ip: addr.IP.String(), port: uint32(addr.Port)})
 	case portAndIpGetter:
 		d.latestAddr.Store(&proxyAddr{ip: v.GetIp(), port: v.GetPort()})
 	default:
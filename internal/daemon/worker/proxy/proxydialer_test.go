@@ -62,4 +62,20 @@ func TestProxyDialer(t *testing.T) {
 		assert.Equal(t, tcpAddr.IP.String(), d.LastConnectionAddr().Ip())
 		assert.EqualValues(t, tcpAddr.Port, d.LastConnectionAddr().Port())
 	})
+
+	t.Run("Successful udp Dial", func(t *testing.T) {
+		pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer pc.Close()
+		d, err := directDialer(ctx, pc.LocalAddr().String(), "", nil, nil)
+		require.NoError(t, err)
+		c, err := d.Dial(ctx, WithNetwork("udp"))
+		require.NoError(t, err)
+		defer c.Close()
+		assert.IsType(t, &net.UDPConn{}, c)
+
+		udpAddr := pc.LocalAddr().(*net.UDPAddr)
+		assert.Equal(t, udpAddr.IP.String(), d.LastConnectionAddr().Ip())
+		assert.EqualValues(t, udpAddr.Port, d.LastConnectionAddr().Port())
+	})
 }
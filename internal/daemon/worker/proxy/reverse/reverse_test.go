@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reverse
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	apiproxy "github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDialer returns a ProxyDialer which accepts a connection on a new
+// listener, like the dialers of reverse sessions, and a function making a
+// connection to that listener.
+func testDialer(t *testing.T) (*proxy.ProxyDialer, func() net.Conn) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		l.Close()
+	})
+	dialer, err := proxy.NewProxyDialer(context.Background(), func(...proxy.Option) (net.Conn, error) {
+		return l.Accept()
+	})
+	require.NoError(t, err)
+	return dialer, func() net.Conn {
+		c, err := net.Dial("tcp", l.Addr().String())
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			c.Close()
+		})
+		return c
+	}
+}
+
+func TestHandleProxy_Errors(t *testing.T) {
+	dialer, _ := testDialer(t)
+	c, _ := net.Pipe()
+
+	cases := []struct {
+		name   string
+		conn   net.Conn
+		dialer *proxy.ProxyDialer
+		connId string
+	}{
+		{
+			name:   "nil connection",
+			dialer: dialer,
+			connId: "someconnectionid",
+		},
+		{
+			name:   "nil dialer",
+			conn:   c,
+			connId: "someconnectionid",
+		},
+		{
+			name:   "no connection id",
+			conn:   c,
+			dialer: dialer,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			fn, err := handleProxy(ctx, ctx, nil, tc.conn, tc.dialer, tc.connId, nil, nil)
+			assert.Error(t, err)
+			assert.Nil(t, fn)
+		})
+	}
+}
+
+func TestHandleProxy(t *testing.T) {
+	require, assert := require.New(t), assert.New(t)
+	ctx := context.Background()
+
+	dialer, connect := testDialer(t)
+	clientConn, proxyConn := net.Pipe()
+	t.Cleanup(func() {
+		clientConn.Close()
+	})
+
+	type result struct {
+		fn  proxy.ProxyConnFn
+		err error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		fn, err := handleProxy(ctx, ctx, nil, proxyConn, dialer, "someconnectionid", nil, nil)
+		resCh <- result{fn: fn, err: err}
+	}()
+
+	// The client is told once a connection is made to the listener
+	inbound := connect()
+	accepted := make([]byte, 1)
+	_, err := io.ReadFull(clientConn, accepted)
+	require.NoError(err)
+	assert.Equal(apiproxy.ReverseConnectionAccepted, accepted[0])
+
+	var res result
+	select {
+	case res = <-resCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler did not return")
+	}
+	require.NoError(res.err)
+	assert.Equal("127.0.0.1", dialer.LastConnectionAddr().Ip())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		res.fn()
+	}()
+
+	// Write from the inbound connection to the client
+	_, err = inbound.Write([]byte("inbound write to client via proxy"))
+	require.NoError(err)
+	b := make([]byte, len("inbound write to client via proxy"))
+	_, err = io.ReadFull(clientConn, b)
+	require.NoError(err)
+	assert.Equal("inbound write to client via proxy", string(b))
+
+	// Write from the client to the inbound connection
+	_, err = clientConn.Write([]byte("client write to inbound via proxy"))
+	require.NoError(err)
+	b = make([]byte, len("client write to inbound via proxy"))
+	_, err = io.ReadFull(inbound, b)
+	require.NoError(err)
+	assert.Equal("client write to inbound via proxy", string(b))
+
+	// Closing the inbound connection ends the proxy
+	require.NoError(inbound.Close())
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("proxy was not closed")
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package udp
+
+import (
+	"context"
+	stderrors "errors"
+	"net"
+	"sync"
+	"syscall"
+	"time"
+
+	apiproxy "github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// flowIdleTimeout is how long a flow is kept open without datagrams being
+// sent in either direction. Clients close their flows well before this, so it
+// mostly cleans up after clients which went away without closing theirs.
+var flowIdleTimeout = 5 * time.Minute
+
+func init() {
+	err := proxy.RegisterHandler(proxy.UdpHandlerName, handleProxy)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// handleProxy creates a udp proxy between the incoming conn and a udp socket
+// connected to the endpoint by the ProxyDialer. Each incoming conn carries a
+// single flow of datagrams, framed with apiproxy.WriteDatagram.
+//
+// handleProxy returns a ProxyConnFn which relays datagrams between the
+// connections and blocks until the incoming conn is closed, an unrecoverable
+// error is received on either connection or the flow has been idle for too
+// long.
+func handleProxy(controlCtx context.Context, _ context.Context, _ proxy.DecryptFn, conn net.Conn, out *proxy.ProxyDialer, connId string, _ *anypb.Any, _ proxy.RecordingManager) (proxy.ProxyConnFn, error) {
+	const op = "udp.HandleProxy"
+	switch {
+	case conn == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "conn is nil")
+	case out == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "proxy dialer is nil")
+	case len(connId) == 0:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "connection id is empty")
+	}
+	remoteConn, err := out.Dial(controlCtx, proxy.WithNetwork("udp"))
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		var closeOnce sync.Once
+		closeAll := func() {
+			closeOnce.Do(func() {
+				_ = conn.Close()
+				_ = remoteConn.Close()
+			})
+		}
+		idle := time.AfterFunc(flowIdleTimeout, closeAll)
+		defer idle.Stop()
+
+		connWg := new(sync.WaitGroup)
+		connWg.Add(2)
+		go func() {
+			defer connWg.Done()
+			defer closeAll()
+			buf := make([]byte, apiproxy.MaxDatagramSize)
+			for {
+				n, err := remoteConn.Read(buf)
+				if err != nil {
+					if isTransient(err) {
+						continue
+					}
+					return
+				}
+				idle.Reset(flowIdleTimeout)
+				if err := apiproxy.WriteDatagram(conn, buf[:n]); err != nil {
+					return
+				}
+			}
+		}()
+		go func() {
+			defer connWg.Done()
+			defer closeAll()
+			buf := make([]byte, apiproxy.MaxDatagramSize)
+			for {
+				n, err := apiproxy.ReadDatagram(conn, buf)
+				if err != nil {
+					return
+				}
+				idle.Reset(flowIdleTimeout)
+				if _, err := remoteConn.Write(buf[:n]); err != nil && !isTransient(err) {
+					return
+				}
+			}
+		}()
+		connWg.Wait()
+	}, nil
+}
+
+// isTransient reports whether err only affects the datagram being sent or
+// received. A connected udp socket reports the ICMP errors received for
+// earlier datagrams, for instance when nothing is listening on the endpoint
+// yet, which must not end the flow.
+func isTransient(err error) bool {
+	return stderrors.Is(err, syscall.ECONNREFUSED)
+}
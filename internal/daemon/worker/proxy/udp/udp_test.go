@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package udp
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	apiproxy "github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDialer(t *testing.T, endpoint string) *proxy.ProxyDialer {
+	t.Helper()
+	dialer, err := proxy.NewProxyDialer(context.Background(), func(opt ...proxy.Option) (net.Conn, error) {
+		return net.Dial(proxy.GetOpts(opt...).WithNetwork, endpoint)
+	})
+	require.NoError(t, err)
+	return dialer
+}
+
+func TestHandleProxy_Errors(t *testing.T) {
+	c, _ := net.Pipe()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		pc.Close()
+	})
+	dialer := testDialer(t, pc.LocalAddr().String())
+
+	cases := []struct {
+		name      string
+		conn      net.Conn
+		dialer    *proxy.ProxyDialer
+		connId    string
+		wantError bool
+	}{
+		{
+			name:   "valid",
+			conn:   c,
+			dialer: dialer,
+			connId: "someconnectionid",
+		},
+		{
+			name:      "nil connection",
+			dialer:    dialer,
+			connId:    "someconnectionid",
+			wantError: true,
+		},
+		{
+			name:      "nil dialer",
+			conn:      c,
+			connId:    "someconnectionid",
+			wantError: true,
+		},
+		{
+			name:      "no connection id",
+			conn:      c,
+			dialer:    dialer,
+			wantError: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			fn, err := handleProxy(ctx, ctx, nil, tc.conn, tc.dialer, tc.connId, nil, nil)
+			if tc.wantError {
+				assert.Error(t, err)
+				assert.Nil(t, fn)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, fn)
+			assert.Equal(t, "127.0.0.1", tc.dialer.LastConnectionAddr().Ip())
+		})
+	}
+}
+
+func TestHandleProxy(t *testing.T) {
+	require, assert := require.New(t), assert.New(t)
+	ctx := context.Background()
+
+	endpoint, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(err)
+	t.Cleanup(func() {
+		endpoint.Close()
+	})
+	clientConn, proxyConn := net.Pipe()
+	t.Cleanup(func() {
+		clientConn.Close()
+	})
+
+	fn, err := handleProxy(ctx, ctx, nil, proxyConn, testDialer(t, endpoint.LocalAddr().String()), "someconnectionid", nil, nil)
+	require.NoError(err)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+
+	// Write from client to endpoint
+	require.NoError(apiproxy.WriteDatagram(clientConn, []byte("client write to endpoint via proxy")))
+	buf := make([]byte, apiproxy.MaxDatagramSize)
+	require.NoError(endpoint.SetReadDeadline(time.Now().Add(5 * time.Second)))
+	n, addr, err := endpoint.ReadFrom(buf)
+	require.NoError(err)
+	assert.Equal("client write to endpoint via proxy", string(buf[:n]))
+
+	// Datagram boundaries are kept from endpoint to client
+	_, err = endpoint.WriteTo([]byte("first"), addr)
+	require.NoError(err)
+	_, err = endpoint.WriteTo([]byte("second"), addr)
+	require.NoError(err)
+	n, err = apiproxy.ReadDatagram(clientConn, buf)
+	require.NoError(err)
+	assert.Equal("first", string(buf[:n]))
+	n, err = apiproxy.ReadDatagram(clientConn, buf)
+	require.NoError(err)
+	assert.Equal("second", string(buf[:n]))
+
+	// Closing the client conn ends the flow
+	require.NoError(clientConn.Close())
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("flow was not closed")
+	}
+}
+
+func TestHandleProxy_IdleTimeout(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	oldTimeout := flowIdleTimeout
+	flowIdleTimeout = 100 * time.Millisecond
+	t.Cleanup(func() {
+		flowIdleTimeout = oldTimeout
+	})
+
+	endpoint, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(err)
+	t.Cleanup(func() {
+		endpoint.Close()
+	})
+	clientConn, proxyConn := net.Pipe()
+	t.Cleanup(func() {
+		clientConn.Close()
+	})
+
+	fn, err := handleProxy(ctx, ctx, nil, proxyConn, testDialer(t, endpoint.LocalAddr().String()), "someconnectionid", nil, nil)
+	require.NoError(err)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("idle flow was not closed")
+	}
+	_, err = apiproxy.ReadDatagram(clientConn, make([]byte, 10))
+	require.Error(err)
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+)
+
+// reverseListener is the listener opened on the worker for a reverse session.
+// The connections it accepts are handed to the connections of the session
+// made by the client, which forward them to the client. Only connections from
+// the allowed addresses are accepted; any other connection is closed right
+// away.
+type reverseListener struct {
+	ln      net.Listener
+	allowed []netip.Addr
+	conns   chan net.Conn
+	closed  chan struct{}
+	expired *time.Timer
+	once    sync.Once
+}
+
+func (l *reverseListener) acceptLoop() {
+	for {
+		c, err := l.ln.Accept()
+		if err != nil {
+			l.close()
+			return
+		}
+		if !l.isAllowed(c.RemoteAddr()) {
+			_ = c.Close()
+			continue
+		}
+		select {
+		case l.conns <- c:
+		case <-l.closed:
+			_ = c.Close()
+			return
+		}
+	}
+}
+
+// isAllowed reports whether addr is one of the addresses the listener accepts
+// connections from.
+func (l *reverseListener) isAllowed(addr net.Addr) bool {
+	ap, err := netip.ParseAddrPort(addr.String())
+	if err != nil {
+		return false
+	}
+	for _, a := range l.allowed {
+		if a == ap.Addr().Unmap() {
+			return true
+		}
+	}
+	return false
+}
+
+// accept returns the next connection made to the listener, waiting until one
+// is made, the listener is closed or ctx is done.
+func (l *reverseListener) accept(ctx context.Context) (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (l *reverseListener) close() {
+	l.once.Do(func() {
+		close(l.closed)
+		l.expired.Stop()
+		_ = l.ln.Close()
+	})
+}
+
+// reverseListeners tracks the listeners of the reverse sessions handled by
+// the worker.
+type reverseListeners struct {
+	// host is the host the listeners are opened on. Empty means all
+	// interfaces.
+	host string
+
+	mu        sync.Mutex
+	listeners map[string]*reverseListener
+}
+
+func newReverseListeners(host string) *reverseListeners {
+	return &reverseListeners{
+		host:      host,
+		listeners: make(map[string]*reverseListener),
+	}
+}
+
+// listen opens the listener of the session, if it is not open already, and
+// returns its address. The listener only accepts connections from the allowed
+// addresses and is closed once the session expires.
+func (r *reverseListeners) listen(sessionId string, expiration time.Time, allowed []netip.Addr) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if l, ok := r.listeners[sessionId]; ok {
+		return l.ln.Addr().String(), nil
+	}
+	if time.Until(expiration) <= 0 {
+		return "", stderrors.New("session is expired")
+	}
+	if len(allowed) == 0 {
+		return "", stderrors.New("no addresses are allowed to connect")
+	}
+	ln, err := net.Listen("tcp", net.JoinHostPort(r.host, "0"))
+	if err != nil {
+		return "", fmt.Errorf("error opening reverse listener: %w", err)
+	}
+	l := &reverseListener{
+		ln:      ln,
+		allowed: allowed,
+		conns:   make(chan net.Conn),
+		closed:  make(chan struct{}),
+	}
+	l.expired = time.AfterFunc(time.Until(expiration), func() { r.close(sessionId) })
+	r.listeners[sessionId] = l
+	go l.acceptLoop()
+	return ln.Addr().String(), nil
+}
+
+// accept waits for the next connection made to the listener of the session,
+// until ctx is done.
+func (r *reverseListeners) accept(ctx context.Context, sessionId string) (net.Conn, error) {
+	r.mu.Lock()
+	l, ok := r.listeners[sessionId]
+	r.mu.Unlock()
+	if !ok {
+		return nil, stderrors.New("no reverse listener is open for the session")
+	}
+	return l.accept(ctx)
+}
+
+// acceptedDialer returns a ProxyDialer whose Dial returns c, the connection
+// accepted for a connection of a reverse session. Dialing it again fails.
+func acceptedDialer(ctx context.Context, c net.Conn) (*proxy.ProxyDialer, error) {
+	var once sync.Once
+	return proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		var dialed net.Conn
+		once.Do(func() { dialed = c })
+		if dialed == nil {
+			return nil, stderrors.New("reverse connection already dialed")
+		}
+		return dialed, nil
+	})
+}
+
+// reverseAllowedAddrs returns the addresses allowed to connect to the
+// listener of a reverse session, which are the addresses of the host of the
+// session's endpoint.
+func reverseAllowedAddrs(ctx context.Context, endpoint string) ([]netip.Addr, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing endpoint: %w", err)
+	}
+	host := u.Hostname()
+	if host == "" {
+		return nil, stderrors.New("endpoint has no host")
+	}
+	if a, err := netip.ParseAddr(host); err == nil {
+		return []netip.Addr{a.Unmap()}, nil
+	}
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving endpoint host: %w", err)
+	}
+	for i, a := range addrs {
+		addrs[i] = a.Unmap()
+	}
+	return addrs, nil
+}
+
+// close closes the listener of the session, if it has one.
+func (r *reverseListeners) close(sessionId string) {
+	r.mu.Lock()
+	l, ok := r.listeners[sessionId]
+	delete(r.listeners, sessionId)
+	r.mu.Unlock()
+	if ok {
+		l.close()
+	}
+}
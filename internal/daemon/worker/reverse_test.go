@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReverseListeners(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const sessionId = "s_1234567890"
+	loopback := []netip.Addr{netip.MustParseAddr("127.0.0.1")}
+
+	t.Run("forwards-connections", func(t *testing.T) {
+		require, assert := require.New(t), assert.New(t)
+		r := newReverseListeners("127.0.0.1")
+		_, err := r.accept(ctx, sessionId)
+		require.Error(err)
+
+		addr, err := r.listen(sessionId, time.Now().Add(time.Hour), loopback)
+		require.NoError(err)
+		t.Cleanup(func() { r.close(sessionId) })
+		again, err := r.listen(sessionId, time.Now().Add(time.Hour), loopback)
+		require.NoError(err)
+		assert.Equal(addr, again)
+
+		inbound, err := net.Dial("tcp", addr)
+		require.NoError(err)
+		defer inbound.Close()
+		c, err := r.accept(ctx, sessionId)
+		require.NoError(err)
+		d, err := acceptedDialer(ctx, c)
+		require.NoError(err)
+		accepted, err := d.Dial(ctx)
+		require.NoError(err)
+		defer accepted.Close()
+		assert.Equal("127.0.0.1", d.LastConnectionAddr().Ip())
+		_, err = d.Dial(ctx)
+		require.Error(err)
+
+		_, err = inbound.Write([]byte("hello"))
+		require.NoError(err)
+		b := make([]byte, 5)
+		_, err = accepted.Read(b)
+		require.NoError(err)
+		assert.Equal("hello", string(b))
+	})
+
+	t.Run("dial-canceled", func(t *testing.T) {
+		require := require.New(t)
+		r := newReverseListeners("127.0.0.1")
+		_, err := r.listen(sessionId, time.Now().Add(time.Hour), loopback)
+		require.NoError(err)
+		t.Cleanup(func() { r.close(sessionId) })
+
+		acceptCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err = r.accept(acceptCtx, sessionId)
+		require.ErrorIs(err, context.Canceled)
+	})
+
+	t.Run("rejects-other-addresses", func(t *testing.T) {
+		require := require.New(t)
+		r := newReverseListeners("127.0.0.1")
+		addr, err := r.listen(sessionId, time.Now().Add(time.Hour), []netip.Addr{netip.MustParseAddr("192.0.2.1")})
+		require.NoError(err)
+		t.Cleanup(func() { r.close(sessionId) })
+
+		inbound, err := net.Dial("tcp", addr)
+		require.NoError(err)
+		defer inbound.Close()
+		// The listener closes the connection instead of handing it out.
+		require.NoError(inbound.SetReadDeadline(time.Now().Add(5 * time.Second)))
+		_, err = inbound.Read(make([]byte, 1))
+		require.ErrorIs(err, io.EOF)
+
+		acceptCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		_, err = r.accept(acceptCtx, sessionId)
+		require.ErrorIs(err, context.DeadlineExceeded)
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		require := require.New(t)
+		r := newReverseListeners("127.0.0.1")
+		addr, err := r.listen(sessionId, time.Now().Add(time.Hour), loopback)
+		require.NoError(err)
+		r.mu.Lock()
+		l := r.listeners[sessionId]
+		r.mu.Unlock()
+		closed := make(chan error, 1)
+		go func() {
+			_, err := l.accept(ctx)
+			closed <- err
+		}()
+
+		r.close(sessionId)
+		require.ErrorIs(<-closed, net.ErrClosed)
+		_, err = net.Dial("tcp", addr)
+		require.Error(err)
+		_, err = r.accept(ctx, sessionId)
+		require.Error(err)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		require := require.New(t)
+		r := newReverseListeners("127.0.0.1")
+		_, err := r.listen(sessionId, time.Now().Add(-time.Second), loopback)
+		require.Error(err)
+
+		addr, err := r.listen(sessionId, time.Now().Add(100*time.Millisecond), loopback)
+		require.NoError(err)
+		require.Eventually(func() bool {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			_, ok := r.listeners[sessionId]
+			return !ok
+		}, 5*time.Second, 10*time.Millisecond)
+		_, err = net.Dial("tcp", addr)
+		require.Error(err)
+	})
+}
@@ -48,6 +48,9 @@ type ConnectionCloseData struct {
 	SessionId string
 	BytesUp   int64
 	BytesDown int64
+	// Reason the connection was closed. If not set the reason is reported
+	// as unknown.
+	Reason session.ClosedReason
 }
 
 // Session is the local representation of a session.  After initial loading
@@ -70,6 +73,27 @@ type Session interface {
 
 	GetTofuToken() string
 	GetConnectionLimit() int32
+	// GetTargetBandwidthLimits returns the maximum bytes per second sent up
+	// (from clients to the target) and down (from the target to clients),
+	// shared by all of the target's sessions on this worker. 0 means
+	// unlimited.
+	GetTargetBandwidthLimits() (up int64, down int64)
+	// GetBandwidthLimits returns the maximum bytes per second sent up (from
+	// the client to the target) and down (from the target to the client) in
+	// this session. 0 means unlimited.
+	GetBandwidthLimits() (up int64, down int64)
+	// GetConnectionRateLimit returns the maximum number of new connections
+	// per minute in this session. 0 means unlimited.
+	GetConnectionRateLimit() int32
+	// GetIdleTimeout returns how long a connection in this session can go
+	// without sending data in either direction before it is closed. 0 means
+	// connections are never closed for being idle.
+	GetIdleTimeout() time.Duration
+	// GetReverse returns whether connections made to a listener on the
+	// worker are forwarded to the client, instead of the client connecting to
+	// the endpoint.
+	GetReverse() bool
+	GetTargetId() string
 	GetEndpoint() string
 	GetHostKeys() ([]crypto.Signer, error)
 	GetCredentials() []*pbs.Credential
@@ -121,6 +145,12 @@ type Session interface {
 	// authorized.  The local connection's status is updated with the result of the
 	// call.
 	RequestConnectConnection(ctx context.Context, info *pbs.ConnectConnectionRequest) error
+
+	// RequestAuthorizeShadow sends an AuthorizeShadow request to the
+	// controller. It is called by the worker handler when a client asks to
+	// shadow one of this session's connections, and returns whether the shadow
+	// is allowed to write to the connection.
+	RequestAuthorizeShadow(ctx context.Context, workerId, shadowId, shadowToken, connectionId string) (*pbs.AuthorizeShadowResponse, error)
 }
 
 type sess struct {
@@ -233,6 +263,42 @@ func (s *sess) GetConnectionLimit() int32 {
 	return s.resp.GetConnectionLimit()
 }
 
+func (s *sess) GetTargetBandwidthLimits() (int64, int64) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetAuthorization().GetTargetBandwidthLimitUp(), s.resp.GetAuthorization().GetTargetBandwidthLimitDown()
+}
+
+func (s *sess) GetBandwidthLimits() (int64, int64) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetAuthorization().GetBandwidthLimitUp(), s.resp.GetAuthorization().GetBandwidthLimitDown()
+}
+
+func (s *sess) GetConnectionRateLimit() int32 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetAuthorization().GetConnectionRateLimit()
+}
+
+func (s *sess) GetIdleTimeout() time.Duration {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return time.Duration(s.resp.GetAuthorization().GetIdleTimeout()) * time.Second
+}
+
+func (s *sess) GetReverse() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetAuthorization().GetReverse()
+}
+
+func (s *sess) GetTargetId() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetTargetId()
+}
+
 func (s *sess) GetEndpoint() string {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -347,6 +413,31 @@ func (s *sess) RequestAuthorizeConnection(ctx context.Context, workerId string,
 	return resp, resp.GetConnectionsLeft(), err
 }
 
+func (s *sess) RequestAuthorizeShadow(ctx context.Context, workerId, shadowId, shadowToken, connectionId string) (*pbs.AuthorizeShadowResponse, error) {
+	switch {
+	case workerId == "":
+		return nil, errors.New("worker id is empty")
+	case shadowId == "":
+		return nil, errors.New("shadow id is empty")
+	case shadowToken == "":
+		return nil, errors.New("shadow token is empty")
+	case connectionId == "":
+		return nil, errors.New("connection id is empty")
+	}
+
+	resp, err := s.client.AuthorizeShadow(ctx, &pbs.AuthorizeShadowRequest{
+		SessionId:    s.GetId(),
+		WorkerId:     workerId,
+		ShadowId:     shadowId,
+		ShadowToken:  shadowToken,
+		ConnectionId: connectionId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error authorizing shadow: %w", err)
+	}
+	return resp, nil
+}
+
 func (s *sess) RequestConnectConnection(ctx context.Context, info *pbs.ConnectConnectionRequest) error {
 	st, err := connectConnection(ctx, s.client, info)
 	if err != nil {
@@ -548,9 +639,13 @@ func closeConnections(ctx context.Context, sessClient pbs.SessionServiceClient,
 func makeCloseConnectionRequest(closeInfo map[string]*ConnectionCloseData) *pbs.CloseConnectionRequest {
 	closeData := make([]*pbs.CloseConnectionRequestData, 0, len(closeInfo))
 	for connId, data := range closeInfo {
+		reason := data.Reason
+		if reason == "" {
+			reason = session.UnknownReason
+		}
 		closeData = append(closeData, &pbs.CloseConnectionRequestData{
 			ConnectionId: connId,
-			Reason:       session.UnknownReason.String(),
+			Reason:       reason.String(),
 			BytesUp:      data.BytesUp,
 			BytesDown:    data.BytesDown,
 		})
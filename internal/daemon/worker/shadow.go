@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"io"
+	"net"
+	"sync"
+)
+
+// shadowBufferSize is the number of writes buffered for a shadow before it is
+// considered too slow to keep up with the shadowed connection and detached.
+const shadowBufferSize = 64
+
+// shadowConn is a `net.Conn` implementation that shares the data going in both
+// directions of the connection with any attached shadows, and merges the data
+// written by read-write shadows with the data read from the client. All other
+// `net.Conn` function calls are a pass-through to the underlying `net.Conn`.
+type shadowConn struct {
+	net.Conn
+
+	connectionId string
+
+	// Data read from the client and written by read-write shadows is sent
+	// through the pipe so the proxy sees a single stream.
+	pr       *io.PipeReader
+	pw       *io.PipeWriter
+	readOnce sync.Once
+
+	mu      sync.Mutex
+	shadows map[*shadow]struct{}
+	closed  bool
+}
+
+// shadow is a client attached to a shadowConn.
+type shadow struct {
+	conn      net.Conn
+	readWrite bool
+	out       chan []byte
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newShadowConn(conn net.Conn, connectionId string) *shadowConn {
+	pr, pw := io.Pipe()
+	return &shadowConn{
+		Conn:         conn,
+		connectionId: connectionId,
+		pr:           pr,
+		pw:           pw,
+		shadows:      make(map[*shadow]struct{}),
+	}
+}
+
+// Read returns the data read from the client and written by read-write
+// shadows. The client is only read from once Read has been called so that the
+// connection is not drained before the proxy is running.
+func (c *shadowConn) Read(in []byte) (int, error) {
+	c.readOnce.Do(func() {
+		go func() {
+			_ = c.pw.CloseWithError(c.copyInput(c.Conn, nil))
+		}()
+	})
+	return c.pr.Read(in)
+}
+
+// Write writes to the client and then to every attached shadow.
+func (c *shadowConn) Write(in []byte) (int, error) {
+	n, err := c.Conn.Write(in)
+	if n > 0 {
+		c.mirror(in[:n], nil)
+	}
+	return n, err
+}
+
+// copyInput copies the data read from src, which is either the client or the
+// read-write shadow from, to the proxy and mirrors it to every other attached
+// shadow. It returns io.EOF once src is drained.
+func (c *shadowConn) copyInput(src io.Reader, from *shadow) error {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			c.mirror(buf[:n], from)
+			if _, werr := c.pw.Write(buf[:n]); werr != nil {
+				return werr
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// mirror sends a copy of the data to every attached shadow other than from.
+// Shadows that cannot keep up with the connection are detached rather than
+// slowing down the client.
+func (c *shadowConn) mirror(data []byte, from *shadow) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for s := range c.shadows {
+		if s == from {
+			continue
+		}
+		buf := make([]byte, len(data))
+		copy(buf, data)
+		select {
+		case s.out <- buf:
+		default:
+			delete(c.shadows, s)
+			s.close()
+		}
+	}
+}
+
+// Close closes the underlying connection and detaches all shadows.
+func (c *shadowConn) Close() error {
+	c.mu.Lock()
+	c.closed = true
+	for s := range c.shadows {
+		delete(c.shadows, s)
+		s.close()
+	}
+	c.mu.Unlock()
+	_ = c.pr.Close()
+	return c.Conn.Close()
+}
+
+// attach attaches the provided connection as a shadow. The returned channel is
+// closed once the shadow is detached, either because the shadowed connection
+// was closed, the shadow could not keep up with it, or the shadow's connection
+// returned an error. Data written by read-write shadows is handled like data
+// read from the client, while data written by read-only shadows is discarded.
+func (c *shadowConn) attach(conn net.Conn, readWrite bool) <-chan struct{} {
+	s := &shadow{
+		conn:      conn,
+		readWrite: readWrite,
+		out:       make(chan []byte, shadowBufferSize),
+		done:      make(chan struct{}),
+	}
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		s.close()
+		return s.done
+	}
+	c.shadows[s] = struct{}{}
+	c.mu.Unlock()
+
+	detach := func() {
+		c.mu.Lock()
+		delete(c.shadows, s)
+		c.mu.Unlock()
+		s.close()
+	}
+
+	go func() {
+		for {
+			select {
+			case buf := <-s.out:
+				if _, err := s.conn.Write(buf); err != nil {
+					detach()
+					return
+				}
+			case <-s.done:
+				return
+			}
+		}
+	}()
+	go func() {
+		if readWrite {
+			_ = c.copyInput(s.conn, s)
+		} else {
+			_, _ = io.Copy(io.Discard, s.conn)
+		}
+		detach()
+	}()
+	return s.done
+}
+
+func (s *shadow) close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		_ = s.conn.Close()
+	})
+}
+
+// shadowConns tracks the shadowable connections handled by the worker.
+type shadowConns struct {
+	mu sync.Mutex
+	// conns are the connections of each session in the order they were added
+	conns map[string][]*shadowConn
+}
+
+func newShadowConns() *shadowConns {
+	return &shadowConns{
+		conns: make(map[string][]*shadowConn),
+	}
+}
+
+// add tracks the connection of the session until the returned function is
+// called.
+func (r *shadowConns) add(sessionId string, c *shadowConn) func() {
+	r.mu.Lock()
+	r.conns[sessionId] = append(r.conns[sessionId], c)
+	r.mu.Unlock()
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		conns := r.conns[sessionId]
+		for i, v := range conns {
+			if v == c {
+				conns = append(conns[:i], conns[i+1:]...)
+				break
+			}
+		}
+		if len(conns) == 0 {
+			delete(r.conns, sessionId)
+			return
+		}
+		r.conns[sessionId] = conns
+	}
+}
+
+// latest returns the most recently added connection of the session, or nil if
+// the session has no shadowable connections.
+func (r *shadowConns) latest(sessionId string) *shadowConn {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	conns := r.conns[sessionId]
+	if len(conns) == 0 {
+		return nil
+	}
+	return conns[len(conns)-1]
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShadowConn(t *testing.T) {
+	t.Parallel()
+
+	readFull := func(t *testing.T, c net.Conn, n int) string {
+		t.Helper()
+		buf := make([]byte, n)
+		require.NoError(t, c.SetReadDeadline(time.Now().Add(5*time.Second)))
+		_, err := io.ReadFull(c, buf)
+		require.NoError(t, err)
+		return string(buf)
+	}
+
+	t.Run("read-only", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		client, workerSide := net.Pipe()
+		sc := newShadowConn(workerSide, "sc_1234567890")
+		defer sc.Close()
+
+		shadowClient, shadowWorkerSide := net.Pipe()
+		done := sc.attach(shadowWorkerSide, false)
+
+		go func() { _, _ = sc.Write([]byte("hello")) }()
+		assert.Equal("hello", readFull(t, client, 5))
+		assert.Equal("hello", readFull(t, shadowClient, 5))
+
+		// Data written by a read-only shadow never reaches the proxy, while
+		// data read from the client is mirrored to the shadow
+		go func() { _, _ = shadowClient.Write([]byte("ignored")) }()
+		go func() { _, _ = client.Write([]byte("world")) }()
+		buf := make([]byte, 5)
+		_, err := io.ReadFull(sc, buf)
+		require.NoError(err)
+		assert.Equal("world", string(buf))
+		assert.Equal("world", readFull(t, shadowClient, 5))
+
+		require.NoError(shadowClient.Close())
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("shadow was not detached")
+		}
+	})
+	t.Run("read-write", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, workerSide := net.Pipe()
+		sc := newShadowConn(workerSide, "sc_1234567890")
+		defer sc.Close()
+		cc := &countingConn{Conn: sc}
+
+		shadowClient, shadowWorkerSide := net.Pipe()
+		sc.attach(shadowWorkerSide, true)
+		otherClient, otherWorkerSide := net.Pipe()
+		sc.attach(otherWorkerSide, false)
+
+		// The input of a read-write shadow is read like the client's own
+		// and mirrored to the other shadows
+		go func() { _, _ = shadowClient.Write([]byte("typed")) }()
+		buf := make([]byte, 5)
+		_, err := io.ReadFull(cc, buf)
+		require.NoError(err)
+		assert.Equal("typed", string(buf))
+		assert.Equal(int64(5), cc.BytesRead())
+		assert.Equal("typed", readFull(t, otherClient, 5))
+	})
+	t.Run("close-detaches", func(t *testing.T) {
+		_, workerSide := net.Pipe()
+		sc := newShadowConn(workerSide, "sc_1234567890")
+		_, shadowWorkerSide := net.Pipe()
+		done := sc.attach(shadowWorkerSide, false)
+		require.NoError(t, sc.Close())
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("shadow was not detached")
+		}
+
+		// Attaching to a closed connection detaches immediately
+		_, shadowWorkerSide = net.Pipe()
+		select {
+		case <-sc.attach(shadowWorkerSide, false):
+		case <-time.After(5 * time.Second):
+			t.Fatal("shadow was not detached")
+		}
+	})
+}
+
+func TestShadowConns(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	r := newShadowConns()
+	assert.Nil(r.latest("s_1234567890"))
+
+	first := newShadowConn(nil, "sc_1")
+	second := newShadowConn(nil, "sc_2")
+	removeFirst := r.add("s_1234567890", first)
+	removeSecond := r.add("s_1234567890", second)
+	assert.Equal(second, r.latest("s_1234567890"))
+	assert.Nil(r.latest("s_0987654321"))
+
+	removeSecond()
+	assert.Equal(first, r.latest("s_1234567890"))
+	removeFirst()
+	assert.Nil(r.latest("s_1234567890"))
+	assert.Empty(r.conns)
+}
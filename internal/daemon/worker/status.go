@@ -197,6 +197,12 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 		event.WriteError(cancelCtx, op, errors.New("worker name and keyId are both empty; at least one is needed to identify a worker"),
 			event.WithInfoMsg("error making status request to controller"))
 	}
+	var requestHostHealthChecks bool
+	var hostHealthCheckResults []*pbs.HostHealthCheckResult
+	if w.hostHealthChecker != nil {
+		hostHealthCheckResults = w.hostHealthChecker.takeResults()
+		requestHostHealthChecks = w.hostHealthChecker.due()
+	}
 	versionInfo := version.Get()
 	connectionState := w.downstreamConnManager.Connected()
 	result, err := client.Status(statusCtx, &pbs.StatusRequest{
@@ -214,9 +220,14 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 		ConnectedUnmappedWorkerKeyIdentifiers: connectionState.UnmappedKeyIds(),
 		ConnectedWorkerPublicIds:              connectionState.WorkerIds(),
 		UpdateTags:                            w.updateTags.Load(),
+		RequestHostHealthChecks:               requestHostHealthChecks,
+		HostHealthCheckResults:                hostHealthCheckResults,
 	})
 	if err != nil {
 		event.WriteError(cancelCtx, op, err, event.WithInfoMsg("error making status request to controller"))
+		if w.hostHealthChecker != nil {
+			w.hostHealthChecker.returnResults(hostHealthCheckResults)
+		}
 		// Check for last successful status. Ignore nil last status, this probably
 		// means that we've never connected to a controller, and as such probably
 		// don't have any sessions to worry about anyway.
@@ -283,6 +294,10 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 
 	w.updateTags.Store(false)
 
+	if requestHostHealthChecks {
+		w.hostHealthChecker.run(w.baseContext, result.GetHostHealthChecks())
+	}
+
 	if authorized := result.GetAuthorizedDownstreamWorkers(); authorized != nil {
 		connectionState.DisconnectMissingWorkers(authorized.GetWorkerPublicIds())
 		connectionState.DisconnectMissingUnmappedKeyIds(authorized.GetUnmappedWorkerKeyIdentifiers())
@@ -315,6 +330,12 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 
 	w.lastStatusSuccess.Store(&LastStatusInformation{StatusResponse: result, StatusTime: time.Now(), LastCalculatedUpstreams: addrs})
 
+	// The controller asks the worker to drain by sending a deadline for its
+	// remaining connections
+	if result.GetDrainDeadline() != nil {
+		w.Drain(result.GetDrainDeadline().AsTime())
+	}
+
 	var nonActiveMonitoredSessionIds []string
 
 	for _, request := range result.GetJobsRequests() {
@@ -435,6 +456,9 @@ func (w *Worker) cleanupConnections(cancelCtx context.Context, ignoreSessionStat
 			s.GetStatus() == pbs.SESSIONSTATUS_SESSIONSTATUS_CANCELING,
 			s.GetStatus() == pbs.SESSIONSTATUS_SESSIONSTATUS_TERMINATED,
 			time.Until(s.GetExpiration()) < 0:
+			// Reverse sessions stop accepting connections once they are no
+			// longer valid.
+			w.reverseListeners.close(s.GetId())
 			// Cancel connections without regard to individual connection
 			// state.
 			closedIds := s.CancelAllLocalConnections()
@@ -126,6 +126,17 @@ type Worker struct {
 
 	sessionManager session.Manager
 
+	// shadowConns are the connections that can be shadowed, by session id
+	shadowConns *shadowConns
+
+	// reverseListeners are the listeners of the reverse sessions, by session
+	// id
+	reverseListeners *reverseListeners
+
+	// limiters enforce the bandwidth and connection rate limits of the
+	// sessions and targets proxied by this worker
+	limiters *proxy.LimiterRegistry
+
 	recorderManager recorderManager
 
 	everAuthenticated       *ua.Uint32
@@ -134,6 +145,10 @@ type Worker struct {
 	operationalState        *atomic.Value
 	upstreamConnectionState *atomic.Value
 
+	// draining is set once the worker has been asked to drain; no new
+	// connections are accepted after that point
+	draining *ua.Bool
+
 	controllerMultihopConn *atomic.Value
 
 	controllerUpstreamMsgConn atomic.Pointer[handlers.UpstreamMessageServiceClientProducer]
@@ -181,6 +196,10 @@ type Worker struct {
 	statusLock sync.Mutex
 
 	downstreamConnManager *cluster.DownstreamManager
+
+	// hostHealthChecker runs health checks against hosts; it is nil if host
+	// health checks are not configured
+	hostHealthChecker *hostHealthChecker
 }
 
 func New(ctx context.Context, conf *Config) (*Worker, error) {
@@ -210,6 +229,9 @@ func New(ctx context.Context, conf *Config) (*Worker, error) {
 		successfulStatusGracePeriod: new(atomic.Int64),
 		statusCallTimeoutDuration:   new(atomic.Int64),
 		upstreamConnectionState:     new(atomic.Value),
+		shadowConns:                 newShadowConns(),
+		limiters:                    proxy.NewLimiterRegistry(),
+		draining:                    ua.NewBool(false),
 	}
 
 	w.operationalState.Store(server.UnknownOperationalState)
@@ -267,6 +289,7 @@ func New(ctx context.Context, conf *Config) (*Worker, error) {
 	}
 
 	w.parseAndStoreTags(conf.RawConfig.Worker.Tags)
+	w.reverseListeners = newReverseListeners(conf.RawConfig.Worker.ReverseListenAddress)
 
 	if conf.SecureRandomReader == nil {
 		conf.SecureRandomReader = rand.Reader
@@ -302,6 +325,10 @@ func New(ctx context.Context, conf *Config) (*Worker, error) {
 	// FIXME: This is really ugly, but works.
 	session.CloseCallTimeout = w.statusCallTimeoutDuration
 
+	if conf.RawConfig.Worker.HostHealthCheck != nil {
+		w.hostHealthChecker = newHostHealthChecker(conf.RawConfig.Worker.HostHealthCheck)
+	}
+
 	if recorderManagerFactory != nil {
 		var err error
 		w.recorderManager, err = recorderManagerFactory(w)
@@ -572,6 +599,12 @@ func (w *Worker) Start() error {
 // are no longer any active connections.
 func (w *Worker) GracefulShutdown() error {
 	const op = "worker.(Worker).GracefulShutdown"
+	if !w.started.Load() {
+		// a drained worker running alongside a controller has already shut
+		// itself down, so no more status updates will be sent.
+		event.WriteSysEvent(w.baseContext, op, "already shut down, skipping")
+		return nil
+	}
 	event.WriteSysEvent(w.baseContext, op, "worker entering graceful shutdown")
 	w.operationalState.Store(server.ShutdownOperationalState)
 
@@ -599,6 +632,54 @@ func (w *Worker) GracefulShutdown() error {
 	return nil
 }
 
+// Drain sets the worker state to "draining". The worker stops accepting new
+// connections and lets existing ones finish. Once there are no connections
+// left, or the deadline passes and the remaining connections have been closed,
+// the worker triggers its own shutdown. When the worker runs in the same
+// process as a controller, only the worker is shut down, since shutting down
+// the server would take the controller down with it. Calling Drain on a worker
+// that is already draining has no effect.
+func (w *Worker) Drain(deadline time.Time) {
+	const op = "worker.(Worker).Drain"
+	if !w.draining.CAS(false, true) {
+		return
+	}
+	event.WriteSysEvent(w.baseContext, op, "worker entering drain mode", "deadline", deadline.String())
+	w.operationalState.Store(server.DrainingOperationalState)
+
+	go func() {
+		timer := time.NewTimer(time.Until(deadline))
+		defer timer.Stop()
+		ticker := time.NewTicker(time.Millisecond * 250)
+		defer ticker.Stop()
+	waitLoop:
+		for proxy.ProxyState.CurrentProxiedConnections() > 0 {
+			select {
+			case <-w.baseContext.Done():
+				return
+			case <-timer.C:
+				event.WriteSysEvent(w.baseContext, op, "drain deadline reached, closing remaining connections")
+				if w.sessionManager != nil {
+					w.cleanupConnections(w.baseContext, true, w.sessionManager)
+				}
+				break waitLoop
+			case <-ticker.C:
+			}
+		}
+		event.WriteSysEvent(w.baseContext, op, "worker has drained")
+		if w.conf.RawConfig.Controller != nil {
+			if err := w.Shutdown(); err != nil {
+				event.WriteError(w.baseContext, op, err, event.WithInfoMsg("error shutting down drained worker"))
+			}
+			return
+		}
+		select {
+		case <-w.baseContext.Done():
+		case w.conf.ServerSideShutdownCh <- struct{}{}:
+		}
+	}()
+}
+
 // Shutdown shuts down the workers. skipListeners can be used to not stop
 // listeners, useful for tests if we want to stop and start a worker. In order
 // to create new listeners we'd have to migrate listener setup logic here --
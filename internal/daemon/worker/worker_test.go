@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/worker/session"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-secure-stdlib/configutil/v2"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
@@ -378,3 +379,73 @@ type fakeManager struct {
 func (f *fakeManager) LoadLocalSession(ctx context.Context, id string, workerId string) (session.Session, error) {
 	return f.session, nil
 }
+
+func TestWorker_Drain(t *testing.T) {
+	conf := &Config{
+		Server: &base.Server{
+			Listeners: []*base.ServerListener{
+				{Config: &listenerutil.ListenerConfig{Purpose: []string{"api"}}},
+				{Config: &listenerutil.ListenerConfig{Purpose: []string{"proxy"}}},
+				{Config: &listenerutil.ListenerConfig{Purpose: []string{"cluster"}}},
+			},
+			Logger:               hclog.Default(),
+			ServerSideShutdownCh: make(chan struct{}),
+		},
+	}
+	conf.RawConfig = &config.Config{SharedConfig: &configutil.SharedConfig{DisableMlock: true}}
+	w, err := New(context.Background(), conf)
+	require.NoError(t, err)
+	defer w.baseCancel()
+	w.operationalState.Store(server.ActiveOperationalState)
+
+	w.Drain(time.Now().Add(time.Hour))
+	assert.Equal(t, server.DrainingOperationalState, w.operationalState.Load())
+
+	// With no connections the worker shuts itself down right away
+	select {
+	case <-conf.ServerSideShutdownCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("worker did not shut down after draining")
+	}
+
+	// Draining again has no effect
+	w.Drain(time.Now().Add(time.Hour))
+	select {
+	case <-conf.ServerSideShutdownCh:
+		t.Fatal("worker shut down twice")
+	case <-time.After(500 * time.Millisecond):
+	}
+}
+
+func TestWorker_DrainWithController(t *testing.T) {
+	conf := &Config{
+		Server: &base.Server{
+			Listeners: []*base.ServerListener{
+				{Config: &listenerutil.ListenerConfig{Purpose: []string{"api"}}},
+				{Config: &listenerutil.ListenerConfig{Purpose: []string{"proxy"}}},
+				{Config: &listenerutil.ListenerConfig{Purpose: []string{"cluster"}}},
+			},
+			Logger:               hclog.Default(),
+			ServerSideShutdownCh: make(chan struct{}),
+		},
+	}
+	conf.RawConfig = &config.Config{
+		SharedConfig: &configutil.SharedConfig{DisableMlock: true},
+		Controller:   &config.Controller{},
+	}
+	w, err := New(context.Background(), conf)
+	require.NoError(t, err)
+	defer w.baseCancel()
+	w.operationalState.Store(server.ActiveOperationalState)
+
+	w.Drain(time.Now().Add(time.Hour))
+	assert.Equal(t, server.DrainingOperationalState, w.operationalState.Load())
+
+	// The server also runs a controller, so draining the worker must not
+	// shut it down
+	select {
+	case <-conf.ServerSideShutdownCh:
+		t.Fatal("draining the worker shut down the server")
+	case <-time.After(time.Second):
+	}
+}
@@ -0,0 +1,140 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  alter table target_tcp
+    add column approval_required bool not null default false;
+
+  alter table target_ssh
+    add column approval_required bool not null default false;
+
+  -- replaces target_all_subtypes defined in oss/71/07_targets.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'tcp' as type,
+    approval_required
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    'ssh' as type,
+    approval_required
+  from
+    target_ssh;
+
+  create table session_access_request_status_enm (
+    name text primary key
+      constraint only_predefined_session_access_request_statuses_allowed
+      check (
+        name in ('pending', 'approved', 'denied', 'redeemed')
+      )
+  );
+  comment on table session_access_request_status_enm is
+    'session_access_request_status_enm is an enumeration table for the status of a session access request.';
+
+  insert into session_access_request_status_enm (name)
+  values
+    ('pending'),
+    ('approved'),
+    ('denied'),
+    ('redeemed');
+
+  create table session_access_request (
+    public_id wt_public_id primary key,
+    target_id wt_public_id not null
+      constraint target_fkey
+        references target (public_id)
+        on delete cascade
+        on update cascade,
+    project_id wt_scope_id not null
+      constraint iam_scope_project_fkey
+        references iam_scope_project (scope_id)
+        on delete cascade
+        on update cascade,
+    -- the user requesting access to the target
+    user_id wt_user_id not null
+      constraint iam_user_requester_fkey
+        references iam_user (public_id)
+        on delete cascade
+        on update cascade,
+    reason text
+      constraint reason_must_not_be_empty
+        check(length(trim(reason)) > 0),
+    status text not null default 'pending'
+      constraint session_access_request_status_enm_fkey
+        references session_access_request_status_enm (name)
+        on delete restrict
+        on update cascade,
+    -- the user that approved or denied the request, null while pending
+    approver_id text
+      constraint iam_user_approver_fkey
+        references iam_user (public_id)
+        on delete set null
+        on update cascade,
+    -- after this time an approved request can no longer be redeemed
+    approval_expiration_time timestamp with time zone,
+    -- the session created when the request was redeemed
+    session_id wt_public_id
+      constraint session_fkey
+        references session (public_id)
+        on delete set null
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    constraint approver_must_not_be_requester
+      check(approver_id is null or approver_id != user_id),
+    constraint approval_expiration_time_required_when_approved
+      check(status != 'approved' or approval_expiration_time is not null)
+  );
+  comment on table session_access_request is
+    'session_access_request tracks requests by users to authorize sessions to targets which require approval.';
+
+  create trigger immutable_columns before update on session_access_request
+    for each row execute procedure immutable_columns('public_id', 'target_id', 'project_id', 'user_id', 'reason', 'create_time');
+
+  create trigger update_version_column after update of status, approver_id, approval_expiration_time, session_id on session_access_request
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on session_access_request
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on session_access_request
+    for each row execute procedure default_create_time();
+
+  create index session_access_request_target_id_status_ix
+    on session_access_request (target_id, status);
+
+commit;
@@ -0,0 +1,85 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table target_worker_selection_strategy_enm (
+    name text primary key
+      constraint only_predefined_worker_selection_strategies_allowed
+      check (
+        name in ('random', 'least_connections', 'locality')
+      )
+  );
+  comment on table target_worker_selection_strategy_enm is
+    'target_worker_selection_strategy_enm is an enumeration table for the strategies used to order the workers that can handle a session to a target.';
+
+  insert into target_worker_selection_strategy_enm (name)
+  values
+    ('random'),
+    ('least_connections'),
+    ('locality');
+
+  -- a null worker_selection_strategy means the controller's default strategy
+  -- is used
+  alter table target_tcp
+    add column worker_selection_strategy text
+      constraint target_worker_selection_strategy_enm_fkey
+        references target_worker_selection_strategy_enm (name)
+        on delete restrict
+        on update cascade;
+
+  alter table target_ssh
+    add column worker_selection_strategy text
+      constraint target_worker_selection_strategy_enm_fkey
+        references target_worker_selection_strategy_enm (name)
+        on delete restrict
+        on update cascade;
+
+  -- replaces target_all_subtypes defined in oss/84/01_session_access_request.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'tcp' as type,
+    approval_required,
+    worker_selection_strategy
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    'ssh' as type,
+    approval_required,
+    worker_selection_strategy
+  from
+    target_ssh;
+
+commit;
@@ -0,0 +1,86 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- host_health contains the most recent result of a health check of a host
+  -- on a port, as reported by each worker that checked it.
+  create table host_health (
+    host_id wt_public_id not null
+      constraint host_fkey
+        references host (public_id)
+        on delete cascade
+        on update cascade,
+    port integer not null
+      constraint port_must_be_valid
+        check (port > 0 and port <= 65535),
+    worker_id wt_public_id not null
+      constraint server_worker_fkey
+        references server_worker (public_id)
+        on delete cascade
+        on update cascade,
+    healthy boolean not null,
+    check_time wt_timestamp not null,
+    primary key (host_id, port, worker_id)
+  );
+  comment on table host_health is
+    'host_health entries are the most recent results of health checks of hosts reported by workers.';
+
+  create index host_health_check_time_ix
+    on host_health (check_time);
+
+  -- host_health_check_endpoint lists the address and port of every host in a
+  -- host set associated with a target, along with the filters that determine
+  -- which workers are able to reach the host. Plugin hosts use their first ip
+  -- address or, if they have none, their first dns name.
+  create view host_health_check_endpoint as
+  with
+  static_endpoint (host_id, set_id, address) as (
+    select m.host_id,
+           m.set_id,
+           h.address
+      from static_host_set_member m
+      join static_host h
+        on h.public_id = m.host_id
+  ),
+  plugin_endpoint (host_id, set_id, address) as (
+    select m.host_id,
+           m.set_id,
+           coalesce(
+             (select host(ip.address)
+                from host_ip_address ip
+               where ip.host_id = m.host_id
+            order by ip.address
+               limit 1),
+             (select dns.name
+                from host_dns_name dns
+               where dns.host_id = m.host_id
+            order by dns.name
+               limit 1)
+           )
+      from host_plugin_set_member m
+  ),
+  endpoint (host_id, set_id, address) as (
+    select host_id, set_id, address
+      from static_endpoint
+     union
+    select host_id, set_id, address
+      from plugin_endpoint
+  )
+  select distinct
+         e.host_id,
+         e.address,
+         t.default_port as port,
+         t.worker_filter,
+         t.egress_worker_filter
+    from endpoint e
+    join target_host_set ths
+      on ths.host_set_id = e.set_id
+    join target_all_subtypes t
+      on t.public_id = ths.target_id
+   where e.address is not null
+     and t.default_port > 0;
+  comment on view host_health_check_endpoint is
+    'host_health_check_endpoint lists the addresses and ports at which the hosts of targets are health checked.';
+
+commit;
@@ -0,0 +1,88 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table alias_target (
+    public_id wt_public_id primary key,
+    -- aliases are unique across the cluster, so they only exist in the global
+    -- scope
+    scope_id wt_scope_id not null
+      constraint iam_scope_global_fkey
+        references iam_scope_global (scope_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description wt_description,
+    value text not null
+      constraint alias_target_value_uq
+        unique
+      constraint value_must_be_a_dns_name
+        check(
+          length(value) <= 253
+          and value ~ '^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*$'
+        ),
+    -- the target the alias resolves to; an alias whose target was deleted
+    -- remains but can no longer be resolved
+    destination_id wt_public_id
+      constraint target_fkey
+        references target (public_id)
+        on delete set null
+        on update cascade,
+    -- the host of the target sessions are authorized to when none is
+    -- requested explicitly
+    host_id wt_public_id
+      constraint host_fkey
+        references host (public_id)
+        on delete set null
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    constraint alias_target_scope_id_name_uq
+      unique(scope_id, name),
+    constraint host_id_requires_destination_id
+      check(host_id is null or destination_id is not null)
+  );
+  comment on table alias_target is
+    'alias_target is a table where each row is an alias which resolves to a target.';
+
+  -- clear_alias_target_host_id clears the host of an alias when its
+  -- destination is removed, including when the target is deleted.
+  create function clear_alias_target_host_id() returns trigger
+  as $$
+  begin
+    if new.destination_id is null then
+      new.host_id = null;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function clear_alias_target_host_id is
+    'clear_alias_target_host_id is a before update trigger function for alias_target which removes the host id when the destination id is removed.';
+
+  create trigger clear_alias_target_host_id before update of destination_id on alias_target
+    for each row execute procedure clear_alias_target_host_id();
+
+  create trigger immutable_columns before update on alias_target
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger update_version_column after update on alias_target
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on alias_target
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on alias_target
+    for each row execute procedure default_create_time();
+
+  create index alias_target_destination_id_ix
+    on alias_target (destination_id);
+
+  -- allow operations on aliases to be oplogged
+  insert into oplog_ticket
+    (name, version)
+  values
+    ('alias_target', 1);
+
+commit;
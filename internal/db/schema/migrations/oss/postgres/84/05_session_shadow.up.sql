@@ -0,0 +1,83 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table session_shadow_status_enm (
+    name text primary key
+      constraint only_predefined_session_shadow_statuses_allowed
+      check (
+        name in ('pending', 'approved')
+      )
+  );
+  comment on table session_shadow_status_enm is
+    'session_shadow_status_enm is an enumeration table for the status of a session shadow.';
+
+  insert into session_shadow_status_enm (name)
+  values
+    ('pending'),
+    ('approved');
+
+  create table session_shadow (
+    public_id wt_public_id primary key,
+    session_id wt_public_id not null
+      constraint session_fkey
+        references session (public_id)
+        on delete cascade
+        on update cascade,
+    -- the user shadowing the session
+    user_id wt_user_id not null
+      constraint iam_user_shadower_fkey
+        references iam_user (public_id)
+        on delete cascade
+        on update cascade,
+    read_write bool not null default false,
+    status text not null default 'pending'
+      constraint session_shadow_status_enm_fkey
+        references session_shadow_status_enm (name)
+        on delete restrict
+        on update cascade,
+    -- the user that approved a read-write shadow, null for read-only shadows
+    approver_id text
+      constraint iam_user_approver_fkey
+        references iam_user (public_id)
+        on delete set null
+        on update cascade,
+    -- sha256 of the token the shadow presents to the worker when attaching
+    token_hash bytea not null
+      constraint token_hash_must_not_be_empty
+        check(length(token_hash) > 0),
+    -- the connection most recently shadowed and when the shadow attached to it
+    connection_id wt_public_id
+      constraint session_connection_fkey
+        references session_connection (public_id)
+        on delete set null
+        on update cascade,
+    attach_time timestamp with time zone,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    constraint approver_must_not_be_shadower
+      check(approver_id is null or approver_id != user_id),
+    constraint read_only_shadows_are_approved
+      check(read_write or status = 'approved')
+  );
+  comment on table session_shadow is
+    'session_shadow records the users attached to sessions as shadows of their connections.';
+
+  create trigger immutable_columns before update on session_shadow
+    for each row execute procedure immutable_columns('public_id', 'session_id', 'user_id', 'read_write', 'token_hash', 'create_time');
+
+  create trigger update_version_column after update of status, approver_id, connection_id, attach_time on session_shadow
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on session_shadow
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on session_shadow
+    for each row execute procedure default_create_time();
+
+  create index session_shadow_session_id_ix
+    on session_shadow (session_id);
+
+commit;
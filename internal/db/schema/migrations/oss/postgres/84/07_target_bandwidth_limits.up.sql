@@ -0,0 +1,126 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- bandwidth limits are in bytes per second and the connection rate limit is
+  -- in new connections per minute. A value of 0 means unlimited.
+  alter table target_tcp
+    add column bandwidth_limit_up bigint not null default 0
+      constraint bandwidth_limit_up_must_not_be_negative
+        check(bandwidth_limit_up >= 0),
+    add column bandwidth_limit_down bigint not null default 0
+      constraint bandwidth_limit_down_must_not_be_negative
+        check(bandwidth_limit_down >= 0),
+    add column session_bandwidth_limit_up bigint not null default 0
+      constraint session_bandwidth_limit_up_must_not_be_negative
+        check(session_bandwidth_limit_up >= 0),
+    add column session_bandwidth_limit_down bigint not null default 0
+      constraint session_bandwidth_limit_down_must_not_be_negative
+        check(session_bandwidth_limit_down >= 0),
+    add column session_connection_rate_limit int not null default 0
+      constraint session_connection_rate_limit_must_not_be_negative
+        check(session_connection_rate_limit >= 0);
+
+  alter table target_ssh
+    add column bandwidth_limit_up bigint not null default 0
+      constraint bandwidth_limit_up_must_not_be_negative
+        check(bandwidth_limit_up >= 0),
+    add column bandwidth_limit_down bigint not null default 0
+      constraint bandwidth_limit_down_must_not_be_negative
+        check(bandwidth_limit_down >= 0),
+    add column session_bandwidth_limit_up bigint not null default 0
+      constraint session_bandwidth_limit_up_must_not_be_negative
+        check(session_bandwidth_limit_up >= 0),
+    add column session_bandwidth_limit_down bigint not null default 0
+      constraint session_bandwidth_limit_down_must_not_be_negative
+        check(session_bandwidth_limit_down >= 0),
+    add column session_connection_rate_limit int not null default 0
+      constraint session_connection_rate_limit_must_not_be_negative
+        check(session_connection_rate_limit >= 0);
+
+  -- replaces target_all_subtypes defined in oss/84/02_target_worker_selection_strategy.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'tcp' as type,
+    approval_required,
+    worker_selection_strategy,
+    bandwidth_limit_up,
+    bandwidth_limit_down,
+    session_bandwidth_limit_up,
+    session_bandwidth_limit_down,
+    session_connection_rate_limit
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    'ssh' as type,
+    approval_required,
+    worker_selection_strategy,
+    bandwidth_limit_up,
+    bandwidth_limit_down,
+    session_bandwidth_limit_up,
+    session_bandwidth_limit_down,
+    session_connection_rate_limit
+  from
+    target_ssh;
+
+  -- the limits of the target are copied to the session when it is authorized
+  -- so they can be delivered to the worker with the session authorization data
+  alter table session
+    add column target_bandwidth_limit_up bigint not null default 0
+      constraint target_bandwidth_limit_up_must_not_be_negative
+        check(target_bandwidth_limit_up >= 0),
+    add column target_bandwidth_limit_down bigint not null default 0
+      constraint target_bandwidth_limit_down_must_not_be_negative
+        check(target_bandwidth_limit_down >= 0),
+    add column bandwidth_limit_up bigint not null default 0
+      constraint bandwidth_limit_up_must_not_be_negative
+        check(bandwidth_limit_up >= 0),
+    add column bandwidth_limit_down bigint not null default 0
+      constraint bandwidth_limit_down_must_not_be_negative
+        check(bandwidth_limit_down >= 0),
+    add column connection_rate_limit int not null default 0
+      constraint connection_rate_limit_must_not_be_negative
+        check(connection_rate_limit >= 0);
+
+  -- replaces trigger defined in oss/59/01_target_ingress_egress_worker_filters.up.sql
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns before update on session
+    for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit',
+      'create_time', 'endpoint', 'worker_filter', 'egress_worker_filter', 'ingress_worker_filter',
+      'target_bandwidth_limit_up', 'target_bandwidth_limit_down', 'bandwidth_limit_up', 'bandwidth_limit_down',
+      'connection_rate_limit');
+
+commit;
@@ -0,0 +1,112 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- idle_timeout is the number of seconds after which a connection is closed
+  -- if no data has been sent in either direction. A value of 0 means that
+  -- connections are never closed for being idle.
+  alter table target_tcp
+    add column idle_timeout int not null default 0
+      constraint idle_timeout_must_not_be_negative
+        check(idle_timeout >= 0);
+
+  alter table target_ssh
+    add column idle_timeout int not null default 0
+      constraint idle_timeout_must_not_be_negative
+        check(idle_timeout >= 0);
+
+  -- replaces target_all_subtypes defined in oss/84/07_target_bandwidth_limits.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'tcp' as type,
+    approval_required,
+    worker_selection_strategy,
+    bandwidth_limit_up,
+    bandwidth_limit_down,
+    session_bandwidth_limit_up,
+    session_bandwidth_limit_down,
+    session_connection_rate_limit,
+    idle_timeout
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    'ssh' as type,
+    approval_required,
+    worker_selection_strategy,
+    bandwidth_limit_up,
+    bandwidth_limit_down,
+    session_bandwidth_limit_up,
+    session_bandwidth_limit_down,
+    session_connection_rate_limit,
+    idle_timeout
+  from
+    target_ssh;
+
+  alter table session
+    add column idle_timeout int not null default 0
+      constraint idle_timeout_must_not_be_negative
+        check(idle_timeout >= 0);
+
+  -- replaces trigger defined in oss/84/07_target_bandwidth_limits.up.sql
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns before update on session
+    for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit',
+      'create_time', 'endpoint', 'worker_filter', 'egress_worker_filter', 'ingress_worker_filter',
+      'target_bandwidth_limit_up', 'target_bandwidth_limit_down', 'bandwidth_limit_up', 'bandwidth_limit_down',
+      'connection_rate_limit', 'idle_timeout');
+
+  -- replaces check constraint defined in oss/0/51_connection.up.sql
+  alter table session_connection_closed_reason_enm
+    drop constraint only_predefined_session_connection_closed_reasons_allowed;
+  alter table session_connection_closed_reason_enm
+    add constraint only_predefined_session_connection_closed_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'canceled',
+          'network error',
+          'system error',
+          'idle timeout'
+        )
+      );
+
+  insert into session_connection_closed_reason_enm (name)
+  values
+    ('idle timeout');
+
+commit;
@@ -0,0 +1,205 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table target_udp (
+    public_id wt_public_id primary key
+      constraint target_fkey
+        references target(public_id)
+        on delete cascade
+        on update cascade,
+    project_id wt_scope_id not null,
+    name text not null, -- name is not optional for a target subtype
+    description text,
+    default_port int, -- default_port can be null
+    default_client_port int,
+     -- max duration of the session in seconds.
+     -- default is 8 hours
+    session_max_seconds int not null default 28800
+      constraint session_max_seconds_must_be_greater_than_0
+      check(session_max_seconds > 0),
+    -- limit on number of session connections allowed. -1 equals no limit
+    session_connection_limit int not null default -1
+      constraint session_connection_limit_must_be_greater_than_0_or_negative_1
+      check(session_connection_limit > 0 or session_connection_limit = -1),
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    worker_filter wt_bexprfilter,
+    egress_worker_filter wt_bexprfilter,
+    ingress_worker_filter wt_bexprfilter,
+    approval_required bool not null default false,
+    worker_selection_strategy text
+      constraint target_worker_selection_strategy_enm_fkey
+        references target_worker_selection_strategy_enm (name)
+        on delete restrict
+        on update cascade,
+    bandwidth_limit_up bigint not null default 0
+      constraint bandwidth_limit_up_must_not_be_negative
+        check(bandwidth_limit_up >= 0),
+    bandwidth_limit_down bigint not null default 0
+      constraint bandwidth_limit_down_must_not_be_negative
+        check(bandwidth_limit_down >= 0),
+    session_bandwidth_limit_up bigint not null default 0
+      constraint session_bandwidth_limit_up_must_not_be_negative
+        check(session_bandwidth_limit_up >= 0),
+    session_bandwidth_limit_down bigint not null default 0
+      constraint session_bandwidth_limit_down_must_not_be_negative
+        check(session_bandwidth_limit_down >= 0),
+    session_connection_rate_limit int not null default 0
+      constraint session_connection_rate_limit_must_not_be_negative
+        check(session_connection_rate_limit >= 0),
+    idle_timeout int not null default 0
+      constraint idle_timeout_must_not_be_negative
+        check(idle_timeout >= 0),
+    constraint target_udp_project_id_name_uq
+      unique(project_id, name) -- name must be unique within a project scope.
+  );
+  comment on table target_udp is
+    'target_udp is a table where each row is a resource that represents a udp target. '
+    'It is a target subtype.';
+
+  create trigger insert_target_subtype before insert on target_udp
+    for each row execute procedure insert_target_subtype();
+
+  create trigger delete_target_subtype after delete on target_udp
+    for each row execute procedure delete_target_subtype();
+
+  create trigger immutable_columns before update on target_udp
+    for each row execute procedure immutable_columns('public_id', 'project_id', 'create_time');
+
+  create trigger update_version_column after update on target_udp
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on target_udp
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on target_udp
+    for each row execute procedure default_create_time();
+
+  create trigger update_udp_target_filter_validate before update on target_udp
+    for each row execute procedure validate_filter_values_on_update();
+
+  create trigger insert_udp_target_filter_validate before insert on target_udp
+    for each row execute procedure validate_filter_values_on_insert();
+
+  create trigger update_target_table_update_time before update on target_udp
+    for each row execute procedure update_target_table_update_time();
+
+  insert into oplog_ticket
+    (name,         version)
+  values
+    ('target_udp', 1);
+
+  create table target_udp_deleted (
+    public_id wt_public_id primary key,
+    delete_time wt_timestamp not null
+  );
+  comment on table target_udp_deleted is
+    'target_udp_deleted holds the ID and delete_time of every deleted UDP target. '
+    'It is automatically trimmed of records older than 30 days by a job.';
+
+  create trigger insert_deleted_id after delete on target_udp
+    for each row execute function insert_deleted_id('target_udp_deleted');
+
+  create index target_udp_deleted_delete_time_idx on target_udp_deleted (delete_time);
+
+  -- replaces target_all_subtypes_deleted_view defined in oss/81/01_deleted_tables_and_triggers.up.sql
+  create or replace view target_all_subtypes_deleted_view
+  as
+    select public_id, delete_time from target_tcp_deleted
+    union
+    select public_id, delete_time from target_ssh_deleted
+    union
+    select public_id, delete_time from target_udp_deleted;
+
+  -- replaces target_all_subtypes defined in oss/84/08_target_idle_timeout.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'tcp' as type,
+    approval_required,
+    worker_selection_strategy,
+    bandwidth_limit_up,
+    bandwidth_limit_down,
+    session_bandwidth_limit_up,
+    session_bandwidth_limit_down,
+    session_connection_rate_limit,
+    idle_timeout
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    'ssh' as type,
+    approval_required,
+    worker_selection_strategy,
+    bandwidth_limit_up,
+    bandwidth_limit_down,
+    session_bandwidth_limit_up,
+    session_bandwidth_limit_down,
+    session_connection_rate_limit,
+    idle_timeout
+  from
+    target_ssh
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'udp' as type,
+    approval_required,
+    worker_selection_strategy,
+    bandwidth_limit_up,
+    bandwidth_limit_down,
+    session_bandwidth_limit_up,
+    session_bandwidth_limit_down,
+    session_connection_rate_limit,
+    idle_timeout
+  from
+    target_udp;
+
+commit;
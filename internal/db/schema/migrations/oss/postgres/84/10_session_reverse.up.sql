@@ -0,0 +1,20 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  alter table session
+    add column reverse bool not null default false;
+  comment on column session.reverse is
+    'reverse is true when connections made to a listener on the worker are '
+    'forwarded to the client, instead of the client connecting to the endpoint.';
+
+  -- replaces trigger defined in oss/84/08_target_idle_timeout.up.sql
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns before update on session
+    for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit',
+      'create_time', 'endpoint', 'worker_filter', 'egress_worker_filter', 'ingress_worker_filter',
+      'target_bandwidth_limit_up', 'target_bandwidth_limit_down', 'bandwidth_limit_up', 'bandwidth_limit_down',
+      'connection_rate_limit', 'idle_timeout', 'reverse');
+
+commit;
@@ -0,0 +1,475 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table auth_saml_method_state_enm (
+    name text primary key
+      constraint name_only_predefined_saml_method_states_allowed
+        check (name in ('inactive', 'active-private', 'active-public'))
+  );
+  comment on table auth_saml_method_state_enm is
+    'auth_saml_method_state_enm entries enumerate the valid auth saml method states';
+
+  insert into auth_saml_method_state_enm(name)
+    values
+      ('inactive'),
+      ('active-private'),
+      ('active-public');
+
+  -- The account_attribute_maps object is stored as jsonb, since it is only
+  -- ever read and written along with the auth method itself.
+  create table auth_saml_method (
+    public_id wt_public_id primary key,
+    scope_id wt_scope_id not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    state text not null
+      constraint auth_saml_method_state_enm_fkey
+        references auth_saml_method_state_enm(name)
+        on delete restrict
+        on update cascade,
+    api_url text not null
+      constraint api_url_must_not_be_empty
+        check(length(trim(api_url)) > 0)
+      constraint api_url_invalid_protocol
+        check(api_url ~ 'https?:\/\/*'),
+    idp_metadata text not null
+      constraint idp_metadata_must_not_be_empty
+        check(length(trim(idp_metadata)) > 0),
+    sp_entity_id text
+      constraint sp_entity_id_must_not_be_empty
+        check(length(trim(sp_entity_id)) > 0)
+      constraint sp_entity_id_too_long
+        check(length(trim(sp_entity_id)) < 1024),
+    account_attribute_maps jsonb
+      constraint account_attribute_maps_must_be_object
+        check(jsonb_typeof(account_attribute_maps) = 'object'),
+    constraint auth_method_fkey
+      foreign key (scope_id, public_id)
+        references auth_method (scope_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_saml_method_scope_id_name_uq
+      unique(scope_id, name),
+    constraint auth_saml_method_scope_id_public_id_uq
+      unique(scope_id, public_id)
+  );
+  comment on table auth_saml_method is
+    'auth_saml_method entries are the current saml auth methods configured for '
+    'existing scopes. They authenticate users with SP-initiated logins at the '
+    'identity provider described by their metadata.';
+
+  create trigger insert_auth_method_subtype before insert on auth_saml_method
+    for each row execute procedure insert_auth_method_subtype();
+
+  create trigger delete_auth_method_subtype after delete on auth_saml_method
+    for each row execute procedure delete_auth_method_subtype();
+
+  create trigger update_auth_method_subtype before update on auth_saml_method
+    for each row execute procedure update_auth_method_subtype();
+
+  create trigger update_time_column before update on auth_saml_method
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_saml_method
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_saml_method
+    for each row execute procedure default_create_time();
+
+  create trigger update_version_column after update on auth_saml_method
+    for each row execute procedure update_version_column();
+
+  create trigger update_auth_method_table_update_time before update on auth_saml_method
+    for each row execute procedure update_auth_method_table_update_time();
+
+  create trigger update_auth_method_table_is_active_public_state before update on auth_saml_method
+    for each row execute procedure update_auth_method_table_is_active_public_state();
+
+  create table auth_saml_account (
+    public_id wt_public_id primary key,
+    auth_method_id wt_public_id not null,
+    -- The scope_id type is not wt_scope_id because the domain check is
+    -- executed before the insert trigger which retrieves the scope_id causing
+    -- an insert to fail.
+    scope_id text not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    subject text not null
+      constraint subject_must_not_be_empty
+        check(length(trim(subject)) > 0)
+      constraint subject_must_be_less_than_256_chars
+        check(length(subject) <= 255),
+    full_name wt_full_name,
+    email wt_email,
+    assertion_attributes text
+      constraint assertion_attributes_must_not_be_empty
+        check(length(trim(assertion_attributes)) > 0),
+    constraint auth_saml_method_fkey
+      foreign key (scope_id, auth_method_id)
+        references auth_saml_method (scope_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_account_fkey
+      foreign key (scope_id, auth_method_id, public_id)
+        references auth_account (scope_id, auth_method_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_saml_account_auth_method_id_name_uq
+      unique(auth_method_id, name),
+    constraint auth_saml_account_auth_method_id_subject_uq
+      unique(auth_method_id, subject),
+    constraint auth_saml_account_auth_method_id_public_id_uq
+      unique(auth_method_id, public_id)
+  );
+  comment on table auth_saml_account is
+    'auth_saml_account entries are subtypes of auth_account and represent a saml account.';
+
+  -- insert_auth_saml_account_subtype is intended as a before insert trigger on
+  -- auth_saml_account. Like insert_auth_saml_account_subtype, it does nothing
+  -- when the base auth_account already exists, since saml accounts have
+  -- predictable public ids and are upserted on every authentication.
+  create function insert_auth_saml_account_subtype() returns trigger
+  as $$
+  begin
+    select auth_method.scope_id
+      into new.scope_id
+    from auth_method
+    where auth_method.public_id = new.auth_method_id;
+
+    insert into auth_account
+      (public_id, auth_method_id, scope_id)
+    values
+      (new.public_id, new.auth_method_id, new.scope_id)
+    on conflict do nothing;
+
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger insert_auth_saml_account_subtype before insert on auth_saml_account
+    for each row execute procedure insert_auth_saml_account_subtype();
+
+  create trigger delete_auth_account_subtype after delete on auth_saml_account
+    for each row execute procedure delete_auth_account_subtype();
+
+  create trigger update_time_column before update on auth_saml_account
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_saml_account
+    for each row execute procedure immutable_columns('public_id', 'auth_method_id', 'scope_id', 'create_time', 'subject');
+
+  create trigger default_create_time_column before insert on auth_saml_account
+    for each row execute procedure default_create_time();
+
+  create trigger update_version_column after update on auth_saml_account
+    for each row execute procedure update_version_column();
+
+  create table auth_saml_managed_group (
+    public_id wt_public_id primary key,
+    auth_method_id wt_public_id not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    filter wt_bexprfilter not null,
+    constraint auth_saml_method_fkey
+      foreign key (auth_method_id)
+        references auth_saml_method (public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_managed_group_fkey
+      foreign key (auth_method_id, public_id)
+        references auth_managed_group (auth_method_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_saml_managed_group_auth_method_id_name_uq
+      unique(auth_method_id, name)
+  );
+  comment on table auth_saml_managed_group is
+    'auth_saml_managed_group entries are subtypes of auth_managed_group and represent a saml managed group.';
+
+  create trigger immutable_columns before update on auth_saml_managed_group
+    for each row execute procedure immutable_columns('public_id', 'auth_method_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_saml_managed_group
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on auth_saml_managed_group
+    for each row execute procedure update_time_column();
+
+  create trigger update_version_column after update on auth_saml_managed_group
+    for each row execute procedure update_version_column();
+
+  create trigger insert_managed_group_subtype before insert on auth_saml_managed_group
+    for each row execute procedure insert_managed_group_subtype();
+
+  create trigger delete_managed_group_subtype after delete on auth_saml_managed_group
+    for each row execute procedure delete_managed_group_subtype();
+
+  create table auth_saml_managed_group_member_account (
+    create_time wt_timestamp,
+    managed_group_id wt_public_id
+      references auth_saml_managed_group(public_id)
+      on delete cascade
+      on update cascade,
+    member_id wt_public_id
+      references auth_saml_account(public_id)
+      on delete cascade
+      on update cascade,
+    primary key (managed_group_id, member_id)
+  );
+  comment on table auth_saml_managed_group_member_account is
+    'auth_saml_managed_group_member_account is the join table for managed saml groups and accounts.';
+
+  create function auth_immutable_managed_saml_group_member_account() returns trigger
+  as $$
+  begin
+    raise exception 'managed saml group members are immutable';
+  end;
+  $$ language plpgsql;
+
+  create trigger default_create_time_column before insert on auth_saml_managed_group_member_account
+    for each row execute procedure default_create_time();
+
+  create trigger auth_immutable_managed_saml_group_member_account before update on auth_saml_managed_group_member_account
+    for each row execute procedure auth_immutable_managed_saml_group_member_account();
+
+  -- recreate view defined in postgres/84/11_auth_saml.up.sql so the view
+  -- includes saml managed groups.
+  drop view auth_managed_group_member_account;
+  create view auth_managed_group_member_account as
+  select
+    oidc.create_time,
+    oidc.managed_group_id,
+    oidc.member_id
+  from
+    auth_oidc_managed_group_member_account oidc
+  union
+  select
+    ldap.create_time,
+    ldap.managed_group_id,
+    ldap.member_id
+  from
+    auth_ldap_managed_group_member_account ldap
+  union
+  select
+    jwt.create_time,
+    jwt.managed_group_id,
+    jwt.member_id
+  from
+    auth_jwt_managed_group_member_account jwt
+  union
+  select
+    saml.create_time,
+    saml.managed_group_id,
+    saml.member_id
+  from
+    auth_saml_managed_group_member_account saml;
+
+  -- Deleted tables, see 81/01_deleted_tables_and_triggers.up.sql
+  create table auth_saml_method_deleted (
+    public_id wt_public_id primary key,
+    delete_time wt_timestamp not null
+  );
+  comment on table auth_saml_method_deleted is
+    'auth_saml_method_deleted holds the ID and delete_time of every deleted SAML auth method. '
+    'It is automatically trimmed of records older than 30 days by a job.';
+
+  create table auth_saml_account_deleted (
+    public_id wt_public_id primary key,
+    delete_time wt_timestamp not null
+  );
+  comment on table auth_saml_account_deleted is
+    'auth_saml_account_deleted holds the ID and delete_time of every deleted SAML account. '
+    'It is automatically trimmed of records older than 30 days by a job.';
+
+  create table auth_saml_managed_group_deleted (
+    public_id wt_public_id primary key,
+    delete_time wt_timestamp not null
+  );
+  comment on table auth_saml_managed_group_deleted is
+    'auth_saml_managed_group_deleted holds the ID and delete_time of every deleted SAML managed group. '
+    'It is automatically trimmed of records older than 30 days by a job.';
+
+  create trigger insert_deleted_id after delete on auth_saml_method
+    for each row execute function insert_deleted_id('auth_saml_method_deleted');
+  create index auth_saml_method_deleted_delete_time_idx on auth_saml_method_deleted (delete_time);
+
+  create trigger insert_deleted_id after delete on auth_saml_account
+    for each row execute function insert_deleted_id('auth_saml_account_deleted');
+  create index auth_saml_account_deleted_delete_time_idx on auth_saml_account_deleted (delete_time);
+
+  create trigger insert_deleted_id after delete on auth_saml_managed_group
+    for each row execute function insert_deleted_id('auth_saml_managed_group_deleted');
+  create index auth_saml_managed_group_deleted_delete_time_idx on auth_saml_managed_group_deleted (delete_time);
+
+  -- Indexes for the create time and update time pagination queries, see
+  -- 81/14_account_table_updates.up.sql and 81/15_managed_group_table_updates.up.sql
+  create index auth_saml_account_create_time_public_id_idx
+      on auth_saml_account (create_time desc, public_id desc);
+  create index auth_saml_account_update_time_public_id_idx
+      on auth_saml_account (update_time desc, public_id desc);
+
+  create index auth_saml_managed_group_create_time_public_id_idx
+      on auth_saml_managed_group (create_time desc, public_id desc);
+  create index auth_saml_managed_group_update_time_public_id_idx
+      on auth_saml_managed_group (update_time desc, public_id desc);
+
+  insert into oplog_ticket (name, version)
+  values
+    ('auth_saml_method', 1),
+    ('auth_saml_account', 1),
+    ('auth_saml_managed_group', 1);
+
+  -- saml_auth_method_with_is_primary is useful for reading a saml auth method
+  -- along with whether it is the primary auth method of its scope.
+  create view saml_auth_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.state,
+    am.api_url,
+    am.idp_metadata,
+    am.sp_entity_id,
+    am.account_attribute_maps
+  from
+    auth_saml_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+  comment on view saml_auth_method_with_is_primary is
+    'saml auth method with whether it is the primary auth method of its scope';
+
+  -- replaces view defined in 84/11_auth_jwt.up.sql
+  drop view whx_user_dimension_source;
+  create view whx_user_dimension_source as
+       select -- id is the first column in the target view
+              u.public_id                       as user_id,
+              coalesce(u.name, 'None')          as user_name,
+              coalesce(u.description, 'None')   as user_description,
+              coalesce(aa.public_id, 'None')    as auth_account_id,
+              case
+                   when apa.public_id is not null then 'password auth account'
+                   when ala.public_id is not null then 'ldap auth account'
+                   when aoa.public_id is not null then 'oidc auth account'
+                   when aja.public_id is not null then 'jwt auth account'
+                   when asa.public_id is not null then 'saml auth account'
+                   else 'None'
+                   end                          as auth_account_type,
+              case
+                   when apa.public_id is not null then coalesce(apa.name, 'None')
+                   when ala.public_id is not null then coalesce(ala.name, 'None')
+                   when aoa.public_id is not null then coalesce(aoa.name, 'None')
+                   when aja.public_id is not null then coalesce(aja.name, 'None')
+                   when asa.public_id is not null then coalesce(asa.name, 'None')
+                   else 'None'
+                   end                          as auth_account_name,
+              case
+                   when apa.public_id is not null then coalesce(apa.description, 'None')
+                   when ala.public_id is not null then coalesce(ala.description, 'None')
+                   when aoa.public_id is not null then coalesce(aoa.description, 'None')
+                   when aja.public_id is not null then coalesce(aja.description, 'None')
+                   when asa.public_id is not null then coalesce(asa.description, 'None')
+                   else 'None'
+                   end                          as auth_account_description,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when ala.public_id is not null then ala.login_name
+                  when aoa.public_id is not null then aoa.subject
+                  when aja.public_id is not null then aja.subject
+                  when asa.public_id is not null then asa.subject
+                  else 'None'
+                  end                           as auth_account_external_id,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when  ala.public_id is not null
+                    and ala.full_name is not null then ala.full_name
+                  when  aoa.public_id is not null
+                    and aoa.full_name is not null then aoa.full_name
+                  when  aja.public_id is not null
+                    and aja.full_name is not null then aja.full_name
+                  when  asa.public_id is not null
+                    and asa.full_name is not null then asa.full_name
+                  else 'None'
+                  end                           as auth_account_full_name,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when  ala.public_id is not null
+                    and ala.email is not null then ala.email
+                  when  aoa.public_id is not null
+                    and aoa.email is not null then aoa.email
+                  when  aja.public_id is not null
+                    and aja.email is not null then aja.email
+                  when  asa.public_id is not null
+                    and asa.email is not null then asa.email
+                  else 'None'
+                  end                           as auth_account_email,
+              coalesce(am.public_id, 'None')    as auth_method_id,
+              case
+                   when apa.public_id is not null then 'password auth method'
+                   when ala.public_id is not null then 'ldap auth method'
+                   when aoa.public_id is not null then 'oidc auth method'
+                   when aja.public_id is not null then 'jwt auth method'
+                   when asa.public_id is not null then 'saml auth method'
+                   else 'None'
+                   end                          as auth_method_type,
+              case
+                   when apm.public_id is not null then coalesce(apm.name, 'None')
+                   when alm.public_id is not null then coalesce(alm.name, 'None')
+                   when aom.public_id is not null then coalesce(aom.name, 'None')
+                   when ajm.public_id is not null then coalesce(ajm.name, 'None')
+                   when asm.public_id is not null then coalesce(asm.name, 'None')
+                   else 'None'
+                   end                          as auth_method_name,
+              case
+                   when apm.public_id is not null then coalesce(apm.description, 'None')
+                   when alm.public_id is not null then coalesce(alm.description, 'None')
+                   when aom.public_id is not null then coalesce(aom.description, 'None')
+                   when ajm.public_id is not null then coalesce(ajm.description, 'None')
+                   when asm.public_id is not null then coalesce(asm.description, 'None')
+                   else 'None'
+                   end                          as auth_method_description,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when alm.public_id is not null then 'Not Applicable'
+                  when aom.public_id is not null then aom.issuer
+                  when ajm.public_id is not null then ajm.issuer
+                  when asm.public_id is not null then 'Not Applicable'
+                  else 'None'
+                  end                           as auth_method_external_id,
+              org.public_id                     as user_organization_id,
+              coalesce(org.name, 'None')        as user_organization_name,
+              coalesce(org.description, 'None') as user_organization_description
+         from iam_user as u
+    left join auth_account as aa on           u.public_id       = aa.iam_user_id
+    left join auth_method as am on            aa.auth_method_id = am.public_id
+    left join auth_password_account as apa on aa.public_id      = apa.public_id
+    left join auth_password_method as apm on  am.public_id      = apm.public_id
+    left join auth_oidc_account as aoa on     aa.public_id      = aoa.public_id
+    left join auth_oidc_method as aom on      am.public_id      = aom.public_id
+    left join auth_ldap_account as ala on     aa.public_id      = ala.public_id
+    left join auth_ldap_method as alm on      am.public_id      = alm.public_id
+    left join auth_jwt_account as aja on      aa.public_id      = aja.public_id
+    left join auth_jwt_method as ajm on       am.public_id      = ajm.public_id
+    left join auth_saml_account as asa on     aa.public_id      = asa.public_id
+    left join auth_saml_method as asm on      am.public_id      = asm.public_id
+         join iam_scope as org on             u.scope_id        = org.public_id
+  ;
+
+commit;
@@ -0,0 +1,110 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table scim_token (
+    auth_method_id wt_public_id primary key,
+    scope_id wt_scope_id not null,
+    token bytea not null
+      constraint token_must_not_be_empty
+        check(length(token) > 0),
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    create_time wt_timestamp,
+    constraint auth_method_fkey
+      foreign key (scope_id, auth_method_id)
+        references auth_method (scope_id, public_id)
+        on delete cascade
+        on update cascade
+  );
+  comment on table scim_token is
+    'scim_token entries are the encrypted bearer tokens SCIM clients use to provision '
+    'users and groups into an auth method. An auth method has at most one token.';
+
+  create trigger immutable_columns before update on scim_token
+    for each row execute procedure immutable_columns('auth_method_id', 'scope_id', 'create_time');
+
+  create trigger default_create_time_column before insert on scim_token
+    for each row execute procedure default_create_time();
+
+  create table scim_user (
+    auth_method_id wt_public_id not null
+      constraint auth_method_fkey
+        references auth_method (public_id)
+        on delete cascade
+        on update cascade,
+    user_id wt_user_id not null
+      constraint iam_user_fkey
+        references iam_user (public_id)
+        on delete cascade
+        on update cascade,
+    account_id wt_public_id
+      constraint auth_account_fkey
+        references auth_account (public_id)
+        on delete set null
+        on update cascade,
+    external_id text
+      constraint external_id_must_not_be_empty
+        check(length(trim(external_id)) > 0),
+    active boolean not null default true,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    primary key (auth_method_id, user_id),
+    constraint scim_user_user_id_uq
+      unique(user_id),
+    constraint scim_user_auth_method_id_external_id_uq
+      unique(auth_method_id, external_id)
+  );
+  comment on table scim_user is
+    'scim_user entries map the users provisioned by a SCIM client into an auth method '
+    'onto iam users and the account the user authenticates with.';
+
+  create trigger immutable_columns before update on scim_user
+    for each row execute procedure immutable_columns('auth_method_id', 'user_id', 'create_time');
+
+  create trigger default_create_time_column before insert on scim_user
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on scim_user
+    for each row execute procedure update_time_column();
+
+  create table scim_group (
+    auth_method_id wt_public_id not null
+      constraint auth_method_fkey
+        references auth_method (public_id)
+        on delete cascade
+        on update cascade,
+    group_id wt_public_id not null
+      constraint iam_group_fkey
+        references iam_group (public_id)
+        on delete cascade
+        on update cascade,
+    external_id text
+      constraint external_id_must_not_be_empty
+        check(length(trim(external_id)) > 0),
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    primary key (auth_method_id, group_id),
+    constraint scim_group_group_id_uq
+      unique(group_id),
+    constraint scim_group_auth_method_id_external_id_uq
+      unique(auth_method_id, external_id)
+  );
+  comment on table scim_group is
+    'scim_group entries map the groups provisioned by a SCIM client into an auth method '
+    'onto iam groups.';
+
+  create trigger immutable_columns before update on scim_group
+    for each row execute procedure immutable_columns('auth_method_id', 'group_id', 'create_time');
+
+  create trigger default_create_time_column before insert on scim_group
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on scim_group
+    for each row execute procedure update_time_column();
+
+commit;
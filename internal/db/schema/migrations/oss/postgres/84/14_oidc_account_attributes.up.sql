@@ -0,0 +1,50 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- Account claim maps can now map claims to arbitrary attributes, which are
+  -- named attributes.<name>.
+  alter table auth_oidc_account_claim_map
+    drop constraint to_claim_valid_values;
+  alter table auth_oidc_account_claim_map
+    add constraint to_claim_valid_values
+      check (
+        to_claim in ('sub', 'name', 'email') -- intentionally case-sensitive matching
+        or to_claim ~ '^attributes\.[A-Za-z0-9_-]{1,128}$'
+      );
+
+  alter table auth_oidc_account
+    add column attributes text
+      constraint attributes_must_not_be_empty
+        check(length(trim(attributes)) > 0);
+  comment on column auth_oidc_account.attributes is
+    'attributes is a json object of the claims mapped to attributes by the auth method''s account claim maps.';
+
+  alter table iam_user
+    add column attributes text
+      constraint attributes_must_not_be_empty
+        check(length(trim(attributes)) > 0);
+  comment on column iam_user.attributes is
+    'attributes is a json object of the attributes of the account the user last authenticated with.';
+
+  drop view iam_user_acct_info;
+  create view iam_user_acct_info as
+  select
+      u.public_id,
+      u.scope_id,
+      u.name,
+      u.description,
+      u.create_time,
+      u.update_time,
+      u.version,
+      u.attributes,
+      i.primary_account_id,
+      i.login_name,
+      i.full_name,
+      i.email
+  from
+    iam_user u
+  left outer join iam_acct_info i on u.public_id = i.iam_user_id;
+
+commit;
@@ -0,0 +1,14 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  alter table credential_vault_credential
+    add column last_renewal_error text
+      constraint last_renewal_error_must_not_be_empty
+        check(length(trim(last_renewal_error)) > 0);
+  comment on column credential_vault_credential.last_renewal_error is
+    'last_renewal_error is the error returned by the last failed attempt to renew the lease with Vault. '
+    'It is cleared when the lease is successfully renewed.';
+
+commit;
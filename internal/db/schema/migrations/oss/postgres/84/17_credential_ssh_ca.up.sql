@@ -0,0 +1,371 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table credential_ssh_ca_store (
+    public_id wt_public_id primary key,
+    project_id wt_scope_id not null
+      constraint iam_scope_project_fkey
+        references iam_scope_project (scope_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    key_type text not null,
+    key_bits int not null,
+    public_key text not null
+      constraint public_key_must_not_be_empty
+        check(length(trim(public_key)) > 0),
+    ct_private_key bytea not null -- encrypted value
+      constraint ct_private_key_must_not_be_empty
+        check(length(ct_private_key) > 0),
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    constraint credential_store_fkey
+      foreign key (project_id, public_id)
+      references credential_store (project_id, public_id)
+      on delete cascade
+      on update cascade,
+    -- credential_vault_ssh_cert_valid_key_type_key_bits is defined in
+    -- 63/01_credential_vault_ssh_cert_library.up.sql
+    constraint credential_vault_ssh_cert_valid_key_type_key_bits_fkey
+      foreign key (key_type, key_bits)
+      references credential_vault_ssh_cert_valid_key_type_key_bits (key_type, key_bits),
+    constraint credential_ssh_ca_store_project_id_name_uq
+      unique(project_id, name)
+  );
+  comment on table credential_ssh_ca_store is
+    'credential_ssh_ca_store is a table where each row is a resource that represents an ssh certificate authority credential store. '
+    'The private key of the certificate authority is encrypted with a kms database key of the owning project. '
+    'It is a credential_store subtype and an aggregate root.';
+
+  create trigger update_version_column after update on credential_ssh_ca_store
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_ssh_ca_store
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_ssh_ca_store
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_ssh_ca_store
+    for each row execute procedure immutable_columns('public_id', 'project_id', 'key_type', 'key_bits', 'public_key', 'create_time');
+
+  create trigger insert_credential_store_subtype before insert on credential_ssh_ca_store
+    for each row execute procedure insert_credential_store_subtype();
+
+  create trigger delete_credential_store_subtype after delete on credential_ssh_ca_store
+    for each row execute procedure delete_credential_store_subtype();
+
+  create trigger update_credential_store_table_update_time before update on credential_ssh_ca_store
+    for each row execute procedure update_credential_store_table_update_time();
+
+  create table credential_ssh_ca_library (
+    public_id wt_public_id primary key,
+    store_id wt_public_id not null
+      constraint credential_ssh_ca_store_fkey
+        references credential_ssh_ca_store (public_id)
+        on delete cascade
+        on update cascade,
+    project_id wt_public_id not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    username text not null
+      constraint username_must_not_be_empty
+        check(length(trim(username)) > 0),
+    key_type text not null,
+    key_bits int not null,
+    ttl text,
+    key_id text,
+    critical_options bytea,
+    extensions bytea,
+    additional_valid_principals text,
+    credential_type text not null default 'ssh_certificate'
+      constraint credential_type_must_be_ssh_certificate
+        check(credential_type = 'ssh_certificate'),
+    constraint credential_ssh_ca_library_store_id_name_uq
+      unique(store_id, name),
+    constraint credential_ssh_ca_library_store_id_public_id_uq
+      unique(store_id, public_id),
+    constraint credential_library_fkey
+      foreign key (project_id, store_id, public_id, credential_type)
+      references credential_library (project_id, store_id, public_id, credential_type)
+      on delete cascade
+      on update cascade,
+    constraint credential_vault_ssh_cert_valid_key_type_key_bits_fkey
+      foreign key (key_type, key_bits)
+      references credential_vault_ssh_cert_valid_key_type_key_bits (key_type, key_bits)
+  );
+  comment on table credential_ssh_ca_library is
+    'credential_ssh_ca_library is a table where each row is a resource that represents an ssh certificate authority credential library. '
+    'It issues ssh certificates signed by the certificate authority of the owning credential store. '
+    'It is a credential_library subtype.';
+
+  create trigger update_version_column after update on credential_ssh_ca_library
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_ssh_ca_library
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_ssh_ca_library
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_ssh_ca_library
+    for each row execute procedure immutable_columns('public_id', 'store_id', 'project_id', 'credential_type', 'create_time');
+
+  create trigger insert_credential_library_subtype before insert on credential_ssh_ca_library
+    for each row execute procedure insert_credential_library_subtype();
+
+  create trigger delete_credential_library_subtype after delete on credential_ssh_ca_library
+    for each row execute procedure delete_credential_library_subtype();
+
+  create trigger update_credential_library_table_update_time before update on credential_ssh_ca_library
+    for each row execute procedure update_credential_library_table_update_time();
+
+  create table credential_ssh_ca_credential (
+    public_id wt_public_id primary key,
+    library_id wt_public_id not null
+      constraint credential_ssh_ca_library_fkey
+        references credential_ssh_ca_library (public_id)
+        on delete cascade
+        on update cascade,
+    session_id wt_public_id not null
+      constraint session_fkey
+        references session (public_id)
+        on delete cascade
+        on update cascade,
+    store_id wt_public_id not null
+      constraint credential_ssh_ca_store_fkey
+        references credential_ssh_ca_store (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    serial bigint not null
+      constraint serial_must_be_positive
+        check(serial > 0),
+    certificate_key_id text,
+    valid_principals text not null
+      constraint valid_principals_must_not_be_empty
+        check(length(trim(valid_principals)) > 0),
+    expiration_time timestamp with time zone not null
+      constraint create_time_must_be_before_expiration_time
+        check(create_time < expiration_time),
+    constraint credential_dynamic_fkey
+      foreign key (library_id, public_id)
+      references credential_dynamic (library_id, public_id)
+      on delete cascade
+      on update cascade,
+    constraint credential_ssh_ca_credential_store_id_serial_uq
+      unique(store_id, serial)
+  );
+  comment on table credential_ssh_ca_credential is
+    'credential_ssh_ca_credential is a table where each row records an ssh certificate '
+    'signed by an ssh certificate authority credential store for a session.';
+
+  create trigger default_create_time_column before insert on credential_ssh_ca_credential
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_ssh_ca_credential
+    for each row execute procedure immutable_columns('public_id', 'library_id', 'session_id', 'store_id', 'create_time',
+                                                     'serial', 'certificate_key_id', 'valid_principals', 'expiration_time');
+
+  create trigger insert_credential_dynamic_subtype before insert on credential_ssh_ca_credential
+    for each row execute procedure insert_credential_dynamic_subtype();
+
+  create trigger delete_credential_dynamic_subtype after delete on credential_ssh_ca_credential
+    for each row execute procedure delete_credential_dynamic_subtype();
+
+  insert into oplog_ticket
+    (name,                      version)
+  values
+    ('credential_ssh_ca_store',   1),
+    ('credential_ssh_ca_library', 1);
+
+  create table credential_ssh_ca_store_deleted (
+    public_id wt_public_id primary key,
+    delete_time wt_timestamp not null
+  );
+  comment on table credential_ssh_ca_store_deleted is
+    'credential_ssh_ca_store_deleted holds the ID and delete_time '
+    'of every deleted ssh certificate authority credential store. '
+    'It is automatically trimmed of records older than 30 days by a job.';
+
+  create trigger insert_deleted_id after delete on credential_ssh_ca_store
+    for each row execute function insert_deleted_id('credential_ssh_ca_store_deleted');
+
+  create index credential_ssh_ca_store_deleted_delete_time_idx on credential_ssh_ca_store_deleted (delete_time);
+
+  create table credential_ssh_ca_library_deleted (
+    public_id wt_public_id primary key,
+    delete_time wt_timestamp not null
+  );
+  comment on table credential_ssh_ca_library_deleted is
+    'credential_ssh_ca_library_deleted holds the ID and delete_time of every deleted ssh certificate authority credential library. '
+    'It is automatically trimmed of records older than 30 days by a job.';
+
+  create trigger insert_deleted_id after delete on credential_ssh_ca_library
+    for each row execute function insert_deleted_id('credential_ssh_ca_library_deleted');
+
+  create index credential_ssh_ca_library_deleted_delete_time_idx on credential_ssh_ca_library_deleted (delete_time);
+
+  -- The whx_credential_dimension_source view shows the current values in the
+  -- operational tables of the credential dimension.
+  -- Replaces whx_credential_dimension_source defined in 84/15_credential_plugin.up.sql
+  create or replace view whx_credential_dimension_source as
+    with vault_generic_library as (
+      select vcl.public_id                                        as public_id,
+             'vault generic credential library'                   as type,
+             coalesce(vcl.name,        'None')                    as name,
+             coalesce(vcl.description, 'None')                    as description,
+             vcl.vault_path                                       as vault_path,
+             vcl.http_method                                      as http_method,
+             case
+               when vcl.http_method = 'GET' then 'Not Applicable'
+               else coalesce(vcl.http_request_body::text, 'None')
+             end                                                  as http_request_body,
+             'Not Applicable'                                     as username,
+             'Not Applicable'                                     as key_type_and_bits
+        from credential_vault_library as vcl
+    ),
+    vault_ssh_cert_library as (
+      select vsccl.public_id                                      as public_id,
+             'vault ssh certificate credential library'           as type,
+             coalesce(vsccl.name,        'None')                  as name,
+             coalesce(vsccl.description, 'None')                  as description,
+             vsccl.vault_path                                     as vault_path,
+             'Not Applicable'                                     as http_method,
+             'Not Applicable'                                     as http_request_body,
+             vsccl.username                                       as username,
+             case
+               when vsccl.key_type = 'ed25519' then vsccl.key_type
+               else vsccl.key_type || '-' || vsccl.key_bits::text
+             end                                                  as key_type_and_bits
+        from credential_vault_ssh_cert_library as vsccl
+    ),
+    plugin_library as (
+      select pcl.public_id                                        as public_id,
+             'plugin credential library'                          as type,
+             coalesce(pcl.name,        'None')                    as name,
+             coalesce(pcl.description, 'None')                    as description,
+             'Not Applicable'                                     as vault_path,
+             'Not Applicable'                                     as http_method,
+             'Not Applicable'                                     as http_request_body,
+             'Not Applicable'                                     as username,
+             'Not Applicable'                                     as key_type_and_bits
+        from credential_plugin_library as pcl
+    ),
+    ssh_ca_library as (
+      select sccl.public_id                                       as public_id,
+             'ssh ca credential library'                          as type,
+             coalesce(sccl.name,        'None')                   as name,
+             coalesce(sccl.description, 'None')                   as description,
+             'Not Applicable'                                     as vault_path,
+             'Not Applicable'                                     as http_method,
+             'Not Applicable'                                     as http_request_body,
+             sccl.username                                        as username,
+             case
+               when sccl.key_type = 'ed25519' then sccl.key_type
+               else sccl.key_type || '-' || sccl.key_bits::text
+             end                                                  as key_type_and_bits
+        from credential_ssh_ca_library as sccl
+    ),
+    final as (
+          select s.public_id                                                                                  as session_id,
+                 scd.credential_purpose                                                                       as credential_purpose,
+                 cl.public_id                                                                                 as credential_library_id,
+                 coalesce(vcl.type,              vsccl.type,              pcl.type,              sccl.type)              as credential_library_type,
+                 coalesce(vcl.name,              vsccl.name,              pcl.name,              sccl.name)              as credential_library_name,
+                 coalesce(vcl.description,       vsccl.description,       pcl.description,       sccl.description)       as credential_library_description,
+                 coalesce(vcl.vault_path,        vsccl.vault_path,        pcl.vault_path,        sccl.vault_path)        as credential_library_vault_path,
+                 coalesce(vcl.http_method,       vsccl.http_method,       pcl.http_method,       sccl.http_method)       as credential_library_vault_http_method,
+                 coalesce(vcl.http_request_body, vsccl.http_request_body, pcl.http_request_body, sccl.http_request_body) as credential_library_vault_http_request_body,
+                 coalesce(vcl.username,          vsccl.username,          pcl.username,          sccl.username)          as credential_library_username,
+                 coalesce(vcl.key_type_and_bits, vsccl.key_type_and_bits, pcl.key_type_and_bits, sccl.key_type_and_bits) as credential_library_key_type_and_bits,
+                 cs.public_id                                                                                 as credential_store_id,
+                 case
+                   when vcs.public_id  is not null then 'vault credential store'
+                   when pcs.public_id  is not null then 'plugin credential store'
+                   when sccs.public_id is not null then 'ssh ca credential store'
+                   else 'None'
+                 end                                                                                          as credential_store_type,
+                 coalesce(vcs.name,              pcs.name,        sccs.name,        'None')                   as credential_store_name,
+                 coalesce(vcs.description,       pcs.description, sccs.description, 'None')                   as credential_store_description,
+                 coalesce(vcs.namespace,         'None')                                                      as credential_store_vault_namespace,
+                 coalesce(vcs.vault_address,     'None')                                                      as credential_store_vault_address,
+                 t.public_id                                                                                  as target_id,
+                 case
+                   when tt.type = 'tcp' then 'tcp target'
+                   when tt.type = 'ssh' then 'ssh target'
+                   when tt.type = 'udp' then 'udp target'
+                   else 'Unknown'
+                 end                                                                                          as target_type,
+                 coalesce(tt.name,               'None')                                                      as target_name,
+                 coalesce(tt.description,        'None')                                                      as target_description,
+                 coalesce(tt.default_port,       0)                                                           as target_default_port_number,
+                 tt.session_max_seconds                                                                       as target_session_max_seconds,
+                 tt.session_connection_limit                                                                  as target_session_connection_limit,
+                 p.public_id                                                                                  as project_id,
+                 coalesce(p.name,                'None')                                                      as project_name,
+                 coalesce(p.description,         'None')                                                      as project_description,
+                 o.public_id                                                                                  as organization_id,
+                 coalesce(o.name,                'None')                                                      as organization_name,
+                 coalesce(o.description,         'None')                                                      as organization_description
+            from session_credential_dynamic as scd
+            join session                 as s     on scd.session_id = s.public_id
+            join credential_library      as cl    on scd.library_id = cl.public_id
+            join credential_store        as cs    on cl.store_id    = cs.public_id
+            join target                  as t     on s.target_id    = t.public_id
+            join iam_scope               as p     on p.public_id    = t.project_id and p.type = 'project'
+            join iam_scope               as o     on p.parent_id    = o.public_id  and o.type = 'org'
+       left join vault_generic_library   as vcl   on cl.public_id   = vcl.public_id
+       left join vault_ssh_cert_library  as vsccl on cl.public_id   = vsccl.public_id
+       left join plugin_library          as pcl   on cl.public_id   = pcl.public_id
+       left join ssh_ca_library          as sccl  on cl.public_id   = sccl.public_id
+       left join credential_vault_store  as vcs   on cs.public_id   = vcs.public_id
+       left join credential_plugin_store as pcs   on cs.public_id   = pcs.public_id
+       left join credential_ssh_ca_store as sccs  on cs.public_id   = sccs.public_id
+       left join target_all_subtypes     as tt    on t.public_id    = tt.public_id
+    )
+    select session_id,
+           credential_purpose,
+           credential_library_id,
+           credential_library_type,
+           credential_library_name,
+           credential_library_description,
+           credential_library_vault_path,
+           credential_library_vault_http_method,
+           credential_library_vault_http_request_body,
+           credential_library_username,
+           credential_library_key_type_and_bits,
+           credential_store_id,
+           credential_store_type,
+           credential_store_name,
+           credential_store_description,
+           credential_store_vault_namespace,
+           credential_store_vault_address,
+           target_id,
+           target_type,
+           target_name,
+           target_description,
+           target_default_port_number,
+           target_session_max_seconds,
+           target_session_connection_limit,
+           project_id,
+           project_name,
+           project_description,
+           organization_id,
+           organization_name,
+           organization_description
+      from final;
+
+commit;
@@ -0,0 +1,91 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  alter table target_static_credential
+    add column exclusive_checkout boolean not null default false;
+  comment on column target_static_credential.exclusive_checkout is
+    'exclusive_checkout indicates the static credential must be checked out by a session of the target '
+    'and can only be held by one session at a time.';
+
+  -- replaces view from 33/02_target.up.sql
+  drop view target_credential_source;
+  -- target_credential_source provides the store id along with the other data stored in
+  -- target_credential_library and target_static_credential
+  create view target_credential_source
+  as
+    select
+      tcl.target_id,
+      tcl.credential_library_id as credential_source_id,
+      tcl.credential_purpose,
+      cl.store_id,
+      'library' as type,
+      false as exclusive_checkout
+    from
+      target_credential_library tcl,
+      credential_library cl
+    where
+      cl.public_id = tcl.credential_library_id
+    union
+    select
+      tcs.target_id,
+      tcs.credential_static_id as credential_source_id,
+      tcs.credential_purpose,
+      cst.store_id,
+      'static' as type,
+      tcs.exclusive_checkout
+    from
+      target_static_credential tcs,
+      credential_static cst
+    where
+      cst.public_id = tcs.credential_static_id;
+  comment on view target_credential_source is
+    'target_credential_source is a view where each row contains a credential source and the id of the parent credential store. '
+    'No encrypted data is returned. This view can be used to retrieve data which will be returned external to boundary.';
+
+  create table session_credential_static_checkout (
+    credential_static_id wt_public_id primary key
+      constraint credential_static_fkey
+        references credential_static (public_id)
+        on delete cascade
+        on update cascade,
+    session_id wt_public_id not null
+      constraint session_fkey
+        references session (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp
+  );
+  comment on table session_credential_static_checkout is
+    'session_credential_static_checkout is a table where each row represents a static credential '
+    'that is exclusively checked out by a session. A static credential can only be checked out by one session at a time.';
+
+  create index session_credential_static_checkout_session_id_ix
+    on session_credential_static_checkout (session_id);
+
+  create trigger default_create_time_column before insert on session_credential_static_checkout
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on session_credential_static_checkout
+    for each row execute procedure immutable_columns('credential_static_id', 'session_id', 'create_time');
+
+  -- check_in_session_credentials releases all static credentials checked out
+  -- by a session when the session enters the canceling or terminated states.
+  create function check_in_session_credentials() returns trigger
+  as $$
+  begin
+    if new.state in ('canceling', 'terminated') then
+      delete from session_credential_static_checkout
+        where session_id = new.session_id;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function check_in_session_credentials is
+    'check_in_session_credentials releases the static credentials checked out by a session when the session ends.';
+
+  create trigger check_in_session_credentials after insert on session_state
+    for each row execute procedure check_in_session_credentials();
+
+commit;
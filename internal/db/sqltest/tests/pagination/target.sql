@@ -2,7 +2,7 @@
 -- SPDX-License-Identifier: BUSL-1.1
 
 begin;
-  select plan(8);
+  select plan(9);
 
   -- Verify the trigger functions exist and are declared properly
   select has_function('update_target_table_update_time');
@@ -10,6 +10,7 @@ begin;
   select isnt_strict('update_target_table_update_time');
   select has_trigger('target_ssh', 'update_target_table_update_time');
   select has_trigger('target_tcp', 'update_target_table_update_time');
+  select has_trigger('target_udp', 'update_target_table_update_time');
   select has_index('target', 'target_create_time_public_id_idx', array['create_time', 'public_id']);
   select has_index('target', 'target_update_time_public_id_idx', array['update_time', 'public_id']);
 
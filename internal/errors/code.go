@@ -67,7 +67,9 @@ const (
 	Closed                   = 134 // Closed represents an error when an operation cannot be completed because the thing being operated on is closed
 	ChecksumMismatch         = 135 // ChecksumMismatch represents an error when a checksum is mismatched
 
-	InvalidListToken Code = 136 // InvalidListToken represents an error where the provided list token is invalid
+	InvalidListToken     Code = 136 // InvalidListToken represents an error where the provided list token is invalid
+	SessionQuotaExceeded Code = 137 // SessionQuotaExceeded represents an error when creating a session would exceed a configured active session quota
+	CredentialCheckedOut Code = 138 // CredentialCheckedOut represents an error when a static credential is exclusively checked out by another session
 
 	AuthAttemptExpired Code = 198 // AuthAttemptExpired represents an expired authentication attempt
 	AuthMethodInactive Code = 199 // AuthMethodInactive represents an error that means the auth method is not active.
@@ -420,6 +420,16 @@ func TestCode_Both_String_Info(t *testing.T) {
 			c:    InvalidListToken,
 			want: InvalidListToken,
 		},
+		{
+			name: "SessionQuotaExceeded",
+			c:    SessionQuotaExceeded,
+			want: SessionQuotaExceeded,
+		},
+		{
+			name: "CredentialCheckedOut",
+			c:    CredentialCheckedOut,
+			want: CredentialCheckedOut,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -351,4 +351,12 @@ var errorCodeInfo = map[Code]Info{
 		Message: "invalid list token",
 		Kind:    Parameter,
 	},
+	SessionQuotaExceeded: {
+		Message: "session quota exceeded",
+		Kind:    State,
+	},
+	CredentialCheckedOut: {
+		Message: "credential checked out",
+		Kind:    State,
+	},
 }
@@ -22,13 +22,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AuthMethodService_GetAuthMethod_FullMethodName    = "/controller.api.services.v1.AuthMethodService/GetAuthMethod"
-	AuthMethodService_ListAuthMethods_FullMethodName  = "/controller.api.services.v1.AuthMethodService/ListAuthMethods"
-	AuthMethodService_CreateAuthMethod_FullMethodName = "/controller.api.services.v1.AuthMethodService/CreateAuthMethod"
-	AuthMethodService_UpdateAuthMethod_FullMethodName = "/controller.api.services.v1.AuthMethodService/UpdateAuthMethod"
-	AuthMethodService_DeleteAuthMethod_FullMethodName = "/controller.api.services.v1.AuthMethodService/DeleteAuthMethod"
-	AuthMethodService_ChangeState_FullMethodName      = "/controller.api.services.v1.AuthMethodService/ChangeState"
-	AuthMethodService_Authenticate_FullMethodName     = "/controller.api.services.v1.AuthMethodService/Authenticate"
+	AuthMethodService_GetAuthMethod_FullMethodName     = "/controller.api.services.v1.AuthMethodService/GetAuthMethod"
+	AuthMethodService_ListAuthMethods_FullMethodName   = "/controller.api.services.v1.AuthMethodService/ListAuthMethods"
+	AuthMethodService_CreateAuthMethod_FullMethodName  = "/controller.api.services.v1.AuthMethodService/CreateAuthMethod"
+	AuthMethodService_UpdateAuthMethod_FullMethodName  = "/controller.api.services.v1.AuthMethodService/UpdateAuthMethod"
+	AuthMethodService_DeleteAuthMethod_FullMethodName  = "/controller.api.services.v1.AuthMethodService/DeleteAuthMethod"
+	AuthMethodService_ChangeState_FullMethodName       = "/controller.api.services.v1.AuthMethodService/ChangeState"
+	AuthMethodService_GenerateScimToken_FullMethodName = "/controller.api.services.v1.AuthMethodService/GenerateScimToken"
+	AuthMethodService_RevokeScimToken_FullMethodName   = "/controller.api.services.v1.AuthMethodService/RevokeScimToken"
+	AuthMethodService_Authenticate_FullMethodName      = "/controller.api.services.v1.AuthMethodService/Authenticate"
 )
 
 // AuthMethodServiceClient is the client API for AuthMethodService service.
@@ -63,6 +65,14 @@ type AuthMethodServiceClient interface {
 	DeleteAuthMethod(ctx context.Context, in *DeleteAuthMethodRequest, opts ...grpc.CallOption) (*DeleteAuthMethodResponse, error)
 	// ChangeState changes the state of an Auth Method from Boundary.
 	ChangeState(ctx context.Context, in *ChangeStateRequest, opts ...grpc.CallOption) (*ChangeStateResponse, error)
+	// GenerateScimToken generates the bearer token a SCIM client uses to
+	// provision users and groups into an Auth Method, replacing any token
+	// previously generated for it. The token is only returned in this response.
+	GenerateScimToken(ctx context.Context, in *GenerateScimTokenRequest, opts ...grpc.CallOption) (*GenerateScimTokenResponse, error)
+	// RevokeScimToken revokes the SCIM token of an Auth Method, after which
+	// SCIM requests for the Auth Method are rejected until a new token is
+	// generated.
+	RevokeScimToken(ctx context.Context, in *RevokeScimTokenRequest, opts ...grpc.CallOption) (*RevokeScimTokenResponse, error)
 	// Authenticate validates credentials provided and returns an Auth Token.
 	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
 }
@@ -129,6 +139,24 @@ func (c *authMethodServiceClient) ChangeState(ctx context.Context, in *ChangeSta
 	return out, nil
 }
 
+func (c *authMethodServiceClient) GenerateScimToken(ctx context.Context, in *GenerateScimTokenRequest, opts ...grpc.CallOption) (*GenerateScimTokenResponse, error) {
+	out := new(GenerateScimTokenResponse)
+	err := c.cc.Invoke(ctx, AuthMethodService_GenerateScimToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authMethodServiceClient) RevokeScimToken(ctx context.Context, in *RevokeScimTokenRequest, opts ...grpc.CallOption) (*RevokeScimTokenResponse, error) {
+	out := new(RevokeScimTokenResponse)
+	err := c.cc.Invoke(ctx, AuthMethodService_RevokeScimToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authMethodServiceClient) Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error) {
 	out := new(AuthenticateResponse)
 	err := c.cc.Invoke(ctx, AuthMethodService_Authenticate_FullMethodName, in, out, opts...)
@@ -170,6 +198,14 @@ type AuthMethodServiceServer interface {
 	DeleteAuthMethod(context.Context, *DeleteAuthMethodRequest) (*DeleteAuthMethodResponse, error)
 	// ChangeState changes the state of an Auth Method from Boundary.
 	ChangeState(context.Context, *ChangeStateRequest) (*ChangeStateResponse, error)
+	// GenerateScimToken generates the bearer token a SCIM client uses to
+	// provision users and groups into an Auth Method, replacing any token
+	// previously generated for it. The token is only returned in this response.
+	GenerateScimToken(context.Context, *GenerateScimTokenRequest) (*GenerateScimTokenResponse, error)
+	// RevokeScimToken revokes the SCIM token of an Auth Method, after which
+	// SCIM requests for the Auth Method are rejected until a new token is
+	// generated.
+	RevokeScimToken(context.Context, *RevokeScimTokenRequest) (*RevokeScimTokenResponse, error)
 	// Authenticate validates credentials provided and returns an Auth Token.
 	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
 	mustEmbedUnimplementedAuthMethodServiceServer()
@@ -197,6 +233,12 @@ func (UnimplementedAuthMethodServiceServer) DeleteAuthMethod(context.Context, *D
 func (UnimplementedAuthMethodServiceServer) ChangeState(context.Context, *ChangeStateRequest) (*ChangeStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeState not implemented")
 }
+func (UnimplementedAuthMethodServiceServer) GenerateScimToken(context.Context, *GenerateScimTokenRequest) (*GenerateScimTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateScimToken not implemented")
+}
+func (UnimplementedAuthMethodServiceServer) RevokeScimToken(context.Context, *RevokeScimTokenRequest) (*RevokeScimTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeScimToken not implemented")
+}
 func (UnimplementedAuthMethodServiceServer) Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
 }
@@ -321,6 +363,42 @@ func _AuthMethodService_ChangeState_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthMethodService_GenerateScimToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateScimTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthMethodServiceServer).GenerateScimToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthMethodService_GenerateScimToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthMethodServiceServer).GenerateScimToken(ctx, req.(*GenerateScimTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthMethodService_RevokeScimToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeScimTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthMethodServiceServer).RevokeScimToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthMethodService_RevokeScimToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthMethodServiceServer).RevokeScimToken(ctx, req.(*RevokeScimTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthMethodService_Authenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AuthenticateRequest)
 	if err := dec(in); err != nil {
@@ -370,6 +448,14 @@ var AuthMethodService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeState",
 			Handler:    _AuthMethodService_ChangeState_Handler,
 		},
+		{
+			MethodName: "GenerateScimToken",
+			Handler:    _AuthMethodService_GenerateScimToken_Handler,
+		},
+		{
+			MethodName: "RevokeScimToken",
+			Handler:    _AuthMethodService_RevokeScimToken_Handler,
+		},
 		{
 			MethodName: "Authenticate",
 			Handler:    _AuthMethodService_Authenticate_Handler,
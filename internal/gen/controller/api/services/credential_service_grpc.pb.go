@@ -22,11 +22,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	CredentialService_GetCredential_FullMethodName    = "/controller.api.services.v1.CredentialService/GetCredential"
-	CredentialService_ListCredentials_FullMethodName  = "/controller.api.services.v1.CredentialService/ListCredentials"
-	CredentialService_CreateCredential_FullMethodName = "/controller.api.services.v1.CredentialService/CreateCredential"
-	CredentialService_UpdateCredential_FullMethodName = "/controller.api.services.v1.CredentialService/UpdateCredential"
-	CredentialService_DeleteCredential_FullMethodName = "/controller.api.services.v1.CredentialService/DeleteCredential"
+	CredentialService_GetCredential_FullMethodName     = "/controller.api.services.v1.CredentialService/GetCredential"
+	CredentialService_ListCredentials_FullMethodName   = "/controller.api.services.v1.CredentialService/ListCredentials"
+	CredentialService_CreateCredential_FullMethodName  = "/controller.api.services.v1.CredentialService/CreateCredential"
+	CredentialService_UpdateCredential_FullMethodName  = "/controller.api.services.v1.CredentialService/UpdateCredential"
+	CredentialService_DeleteCredential_FullMethodName  = "/controller.api.services.v1.CredentialService/DeleteCredential"
+	CredentialService_CheckInCredential_FullMethodName = "/controller.api.services.v1.CredentialService/CheckInCredential"
 )
 
 // CredentialServiceClient is the client API for CredentialService service.
@@ -59,6 +60,12 @@ type CredentialServiceClient interface {
 	// DeleteCredential removes an Credential from Boundary. If the Credential id
 	// is malformed or not provided an error is returned.
 	DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*DeleteCredentialResponse, error)
+	// CheckInCredential releases the exclusive checkout of a static Credential
+	// held by a Session, allowing another Session to check it out. Checkouts are
+	// released automatically when the Session ends. If the Credential id is
+	// missing, malformed or references a non existing resource, an error is
+	// returned.
+	CheckInCredential(ctx context.Context, in *CheckInCredentialRequest, opts ...grpc.CallOption) (*CheckInCredentialResponse, error)
 }
 
 type credentialServiceClient struct {
@@ -114,6 +121,15 @@ func (c *credentialServiceClient) DeleteCredential(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *credentialServiceClient) CheckInCredential(ctx context.Context, in *CheckInCredentialRequest, opts ...grpc.CallOption) (*CheckInCredentialResponse, error) {
+	out := new(CheckInCredentialResponse)
+	err := c.cc.Invoke(ctx, CredentialService_CheckInCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CredentialServiceServer is the server API for CredentialService service.
 // All implementations must embed UnimplementedCredentialServiceServer
 // for forward compatibility
@@ -144,6 +160,12 @@ type CredentialServiceServer interface {
 	// DeleteCredential removes an Credential from Boundary. If the Credential id
 	// is malformed or not provided an error is returned.
 	DeleteCredential(context.Context, *DeleteCredentialRequest) (*DeleteCredentialResponse, error)
+	// CheckInCredential releases the exclusive checkout of a static Credential
+	// held by a Session, allowing another Session to check it out. Checkouts are
+	// released automatically when the Session ends. If the Credential id is
+	// missing, malformed or references a non existing resource, an error is
+	// returned.
+	CheckInCredential(context.Context, *CheckInCredentialRequest) (*CheckInCredentialResponse, error)
 	mustEmbedUnimplementedCredentialServiceServer()
 }
 
@@ -166,6 +188,9 @@ func (UnimplementedCredentialServiceServer) UpdateCredential(context.Context, *U
 func (UnimplementedCredentialServiceServer) DeleteCredential(context.Context, *DeleteCredentialRequest) (*DeleteCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
 }
+func (UnimplementedCredentialServiceServer) CheckInCredential(context.Context, *CheckInCredentialRequest) (*CheckInCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInCredential not implemented")
+}
 func (UnimplementedCredentialServiceServer) mustEmbedUnimplementedCredentialServiceServer() {}
 
 // UnsafeCredentialServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -269,6 +294,24 @@ func _CredentialService_DeleteCredential_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CredentialService_CheckInCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).CheckInCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialService_CheckInCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).CheckInCredential(ctx, req.(*CheckInCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CredentialService_ServiceDesc is the grpc.ServiceDesc for CredentialService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -296,6 +339,10 @@ var CredentialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCredential",
 			Handler:    _CredentialService_DeleteCredential_Handler,
 		},
+		{
+			MethodName: "CheckInCredential",
+			Handler:    _CredentialService_CheckInCredential_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/credential_service.proto",
@@ -22,11 +22,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	CredentialStoreService_GetCredentialStore_FullMethodName    = "/controller.api.services.v1.CredentialStoreService/GetCredentialStore"
-	CredentialStoreService_ListCredentialStores_FullMethodName  = "/controller.api.services.v1.CredentialStoreService/ListCredentialStores"
-	CredentialStoreService_CreateCredentialStore_FullMethodName = "/controller.api.services.v1.CredentialStoreService/CreateCredentialStore"
-	CredentialStoreService_UpdateCredentialStore_FullMethodName = "/controller.api.services.v1.CredentialStoreService/UpdateCredentialStore"
-	CredentialStoreService_DeleteCredentialStore_FullMethodName = "/controller.api.services.v1.CredentialStoreService/DeleteCredentialStore"
+	CredentialStoreService_GetCredentialStore_FullMethodName         = "/controller.api.services.v1.CredentialStoreService/GetCredentialStore"
+	CredentialStoreService_ListCredentialStores_FullMethodName       = "/controller.api.services.v1.CredentialStoreService/ListCredentialStores"
+	CredentialStoreService_CreateCredentialStore_FullMethodName      = "/controller.api.services.v1.CredentialStoreService/CreateCredentialStore"
+	CredentialStoreService_UpdateCredentialStore_FullMethodName      = "/controller.api.services.v1.CredentialStoreService/UpdateCredentialStore"
+	CredentialStoreService_DeleteCredentialStore_FullMethodName      = "/controller.api.services.v1.CredentialStoreService/DeleteCredentialStore"
+	CredentialStoreService_ListCredentialStoreLeases_FullMethodName  = "/controller.api.services.v1.CredentialStoreService/ListCredentialStoreLeases"
+	CredentialStoreService_RenewCredentialStoreLease_FullMethodName  = "/controller.api.services.v1.CredentialStoreService/RenewCredentialStoreLease"
+	CredentialStoreService_RevokeCredentialStoreLease_FullMethodName = "/controller.api.services.v1.CredentialStoreService/RevokeCredentialStoreLease"
 )
 
 // CredentialStoreServiceClient is the client API for CredentialStoreService service.
@@ -59,6 +62,20 @@ type CredentialStoreServiceClient interface {
 	// DeleteCredentialStore removes a Credential Store from Boundary. If the Credential Store id
 	// is malformed or not provided an error is returned.
 	DeleteCredentialStore(ctx context.Context, in *DeleteCredentialStoreRequest, opts ...grpc.CallOption) (*DeleteCredentialStoreResponse, error)
+	// ListCredentialStoreLeases returns the Vault leases of the credentials
+	// issued by a Vault Credential Store. The leases can optionally be limited
+	// to the ones issued for a Session. If the Credential Store id is missing,
+	// malformed, references a non existing resource, or does not reference a
+	// Vault Credential Store, an error is returned.
+	ListCredentialStoreLeases(ctx context.Context, in *ListCredentialStoreLeasesRequest, opts ...grpc.CallOption) (*ListCredentialStoreLeasesResponse, error)
+	// RenewCredentialStoreLease renews the Vault lease of a credential issued
+	// by a Vault Credential Store. Only active credentials with a renewable
+	// lease can be renewed.
+	RenewCredentialStoreLease(ctx context.Context, in *RenewCredentialStoreLeaseRequest, opts ...grpc.CallOption) (*RenewCredentialStoreLeaseResponse, error)
+	// RevokeCredentialStoreLease revokes the Vault lease of a credential issued
+	// by a Vault Credential Store. Credentials that have already been revoked or
+	// have expired cannot be revoked.
+	RevokeCredentialStoreLease(ctx context.Context, in *RevokeCredentialStoreLeaseRequest, opts ...grpc.CallOption) (*RevokeCredentialStoreLeaseResponse, error)
 }
 
 type credentialStoreServiceClient struct {
@@ -114,6 +131,33 @@ func (c *credentialStoreServiceClient) DeleteCredentialStore(ctx context.Context
 	return out, nil
 }
 
+func (c *credentialStoreServiceClient) ListCredentialStoreLeases(ctx context.Context, in *ListCredentialStoreLeasesRequest, opts ...grpc.CallOption) (*ListCredentialStoreLeasesResponse, error) {
+	out := new(ListCredentialStoreLeasesResponse)
+	err := c.cc.Invoke(ctx, CredentialStoreService_ListCredentialStoreLeases_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialStoreServiceClient) RenewCredentialStoreLease(ctx context.Context, in *RenewCredentialStoreLeaseRequest, opts ...grpc.CallOption) (*RenewCredentialStoreLeaseResponse, error) {
+	out := new(RenewCredentialStoreLeaseResponse)
+	err := c.cc.Invoke(ctx, CredentialStoreService_RenewCredentialStoreLease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialStoreServiceClient) RevokeCredentialStoreLease(ctx context.Context, in *RevokeCredentialStoreLeaseRequest, opts ...grpc.CallOption) (*RevokeCredentialStoreLeaseResponse, error) {
+	out := new(RevokeCredentialStoreLeaseResponse)
+	err := c.cc.Invoke(ctx, CredentialStoreService_RevokeCredentialStoreLease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CredentialStoreServiceServer is the server API for CredentialStoreService service.
 // All implementations must embed UnimplementedCredentialStoreServiceServer
 // for forward compatibility
@@ -144,6 +188,20 @@ type CredentialStoreServiceServer interface {
 	// DeleteCredentialStore removes a Credential Store from Boundary. If the Credential Store id
 	// is malformed or not provided an error is returned.
 	DeleteCredentialStore(context.Context, *DeleteCredentialStoreRequest) (*DeleteCredentialStoreResponse, error)
+	// ListCredentialStoreLeases returns the Vault leases of the credentials
+	// issued by a Vault Credential Store. The leases can optionally be limited
+	// to the ones issued for a Session. If the Credential Store id is missing,
+	// malformed, references a non existing resource, or does not reference a
+	// Vault Credential Store, an error is returned.
+	ListCredentialStoreLeases(context.Context, *ListCredentialStoreLeasesRequest) (*ListCredentialStoreLeasesResponse, error)
+	// RenewCredentialStoreLease renews the Vault lease of a credential issued
+	// by a Vault Credential Store. Only active credentials with a renewable
+	// lease can be renewed.
+	RenewCredentialStoreLease(context.Context, *RenewCredentialStoreLeaseRequest) (*RenewCredentialStoreLeaseResponse, error)
+	// RevokeCredentialStoreLease revokes the Vault lease of a credential issued
+	// by a Vault Credential Store. Credentials that have already been revoked or
+	// have expired cannot be revoked.
+	RevokeCredentialStoreLease(context.Context, *RevokeCredentialStoreLeaseRequest) (*RevokeCredentialStoreLeaseResponse, error)
 	mustEmbedUnimplementedCredentialStoreServiceServer()
 }
 
@@ -166,6 +224,15 @@ func (UnimplementedCredentialStoreServiceServer) UpdateCredentialStore(context.C
 func (UnimplementedCredentialStoreServiceServer) DeleteCredentialStore(context.Context, *DeleteCredentialStoreRequest) (*DeleteCredentialStoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredentialStore not implemented")
 }
+func (UnimplementedCredentialStoreServiceServer) ListCredentialStoreLeases(context.Context, *ListCredentialStoreLeasesRequest) (*ListCredentialStoreLeasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialStoreLeases not implemented")
+}
+func (UnimplementedCredentialStoreServiceServer) RenewCredentialStoreLease(context.Context, *RenewCredentialStoreLeaseRequest) (*RenewCredentialStoreLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewCredentialStoreLease not implemented")
+}
+func (UnimplementedCredentialStoreServiceServer) RevokeCredentialStoreLease(context.Context, *RevokeCredentialStoreLeaseRequest) (*RevokeCredentialStoreLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCredentialStoreLease not implemented")
+}
 func (UnimplementedCredentialStoreServiceServer) mustEmbedUnimplementedCredentialStoreServiceServer() {
 }
 
@@ -270,6 +337,60 @@ func _CredentialStoreService_DeleteCredentialStore_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CredentialStoreService_ListCredentialStoreLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialStoreLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialStoreServiceServer).ListCredentialStoreLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialStoreService_ListCredentialStoreLeases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialStoreServiceServer).ListCredentialStoreLeases(ctx, req.(*ListCredentialStoreLeasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialStoreService_RenewCredentialStoreLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewCredentialStoreLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialStoreServiceServer).RenewCredentialStoreLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialStoreService_RenewCredentialStoreLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialStoreServiceServer).RenewCredentialStoreLease(ctx, req.(*RenewCredentialStoreLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialStoreService_RevokeCredentialStoreLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCredentialStoreLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialStoreServiceServer).RevokeCredentialStoreLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialStoreService_RevokeCredentialStoreLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialStoreServiceServer).RevokeCredentialStoreLease(ctx, req.(*RevokeCredentialStoreLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CredentialStoreService_ServiceDesc is the grpc.ServiceDesc for CredentialStoreService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -297,6 +418,18 @@ var CredentialStoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCredentialStore",
 			Handler:    _CredentialStoreService_DeleteCredentialStore_Handler,
 		},
+		{
+			MethodName: "ListCredentialStoreLeases",
+			Handler:    _CredentialStoreService_ListCredentialStoreLeases_Handler,
+		},
+		{
+			MethodName: "RenewCredentialStoreLease",
+			Handler:    _CredentialStoreService_RenewCredentialStoreLease_Handler,
+		},
+		{
+			MethodName: "RevokeCredentialStoreLease",
+			Handler:    _CredentialStoreService_RevokeCredentialStoreLease_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/credential_store_service.proto",
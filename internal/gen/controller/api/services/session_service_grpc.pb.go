@@ -22,9 +22,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SessionService_GetSession_FullMethodName    = "/controller.api.services.v1.SessionService/GetSession"
-	SessionService_ListSessions_FullMethodName  = "/controller.api.services.v1.SessionService/ListSessions"
-	SessionService_CancelSession_FullMethodName = "/controller.api.services.v1.SessionService/CancelSession"
+	SessionService_GetSession_FullMethodName           = "/controller.api.services.v1.SessionService/GetSession"
+	SessionService_ListSessions_FullMethodName         = "/controller.api.services.v1.SessionService/ListSessions"
+	SessionService_CancelSession_FullMethodName        = "/controller.api.services.v1.SessionService/CancelSession"
+	SessionService_ShadowSession_FullMethodName        = "/controller.api.services.v1.SessionService/ShadowSession"
+	SessionService_ApproveSessionShadow_FullMethodName = "/controller.api.services.v1.SessionService/ApproveSessionShadow"
 )
 
 // SessionServiceClient is the client API for SessionService service.
@@ -45,6 +47,16 @@ type SessionServiceClient interface {
 	// is returned if the request attempts to cancel a Session that does
 	// not exist.
 	CancelSession(ctx context.Context, in *CancelSessionRequest, opts ...grpc.CallOption) (*CancelSessionResponse, error)
+	// ShadowSession attaches the requesting User as a shadow of an active
+	// Session, allowing them to receive the data proxied for one of its
+	// connections. Read-only shadows can attach immediately; read-write shadows
+	// must first be approved. The returned shadow contains the tokens needed to
+	// attach to the worker handling the connection.
+	ShadowSession(ctx context.Context, in *ShadowSessionRequest, opts ...grpc.CallOption) (*ShadowSessionResponse, error)
+	// ApproveSessionShadow approves a pending read-write shadow of a Session.
+	// The User that owns the Session can always approve shadows of it; other
+	// Users require the approve action. A User cannot approve their own shadow.
+	ApproveSessionShadow(ctx context.Context, in *ApproveSessionShadowRequest, opts ...grpc.CallOption) (*ApproveSessionShadowResponse, error)
 }
 
 type sessionServiceClient struct {
@@ -82,6 +94,24 @@ func (c *sessionServiceClient) CancelSession(ctx context.Context, in *CancelSess
 	return out, nil
 }
 
+func (c *sessionServiceClient) ShadowSession(ctx context.Context, in *ShadowSessionRequest, opts ...grpc.CallOption) (*ShadowSessionResponse, error) {
+	out := new(ShadowSessionResponse)
+	err := c.cc.Invoke(ctx, SessionService_ShadowSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ApproveSessionShadow(ctx context.Context, in *ApproveSessionShadowRequest, opts ...grpc.CallOption) (*ApproveSessionShadowResponse, error) {
+	out := new(ApproveSessionShadowResponse)
+	err := c.cc.Invoke(ctx, SessionService_ApproveSessionShadow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 // All implementations must embed UnimplementedSessionServiceServer
 // for forward compatibility
@@ -100,6 +130,16 @@ type SessionServiceServer interface {
 	// is returned if the request attempts to cancel a Session that does
 	// not exist.
 	CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error)
+	// ShadowSession attaches the requesting User as a shadow of an active
+	// Session, allowing them to receive the data proxied for one of its
+	// connections. Read-only shadows can attach immediately; read-write shadows
+	// must first be approved. The returned shadow contains the tokens needed to
+	// attach to the worker handling the connection.
+	ShadowSession(context.Context, *ShadowSessionRequest) (*ShadowSessionResponse, error)
+	// ApproveSessionShadow approves a pending read-write shadow of a Session.
+	// The User that owns the Session can always approve shadows of it; other
+	// Users require the approve action. A User cannot approve their own shadow.
+	ApproveSessionShadow(context.Context, *ApproveSessionShadowRequest) (*ApproveSessionShadowResponse, error)
 	mustEmbedUnimplementedSessionServiceServer()
 }
 
@@ -116,6 +156,12 @@ func (UnimplementedSessionServiceServer) ListSessions(context.Context, *ListSess
 func (UnimplementedSessionServiceServer) CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSession not implemented")
 }
+func (UnimplementedSessionServiceServer) ShadowSession(context.Context, *ShadowSessionRequest) (*ShadowSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShadowSession not implemented")
+}
+func (UnimplementedSessionServiceServer) ApproveSessionShadow(context.Context, *ApproveSessionShadowRequest) (*ApproveSessionShadowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveSessionShadow not implemented")
+}
 func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
 
 // UnsafeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -183,6 +229,42 @@ func _SessionService_CancelSession_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_ShadowSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShadowSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ShadowSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ShadowSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ShadowSession(ctx, req.(*ShadowSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_ApproveSessionShadow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveSessionShadowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ApproveSessionShadow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ApproveSessionShadow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ApproveSessionShadow(ctx, req.(*ApproveSessionShadowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -202,6 +284,14 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSession",
 			Handler:    _SessionService_CancelSession_Handler,
 		},
+		{
+			MethodName: "ShadowSession",
+			Handler:    _SessionService_ShadowSession_Handler,
+		},
+		{
+			MethodName: "ApproveSessionShadow",
+			Handler:    _SessionService_ApproveSessionShadow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/session_service.proto",
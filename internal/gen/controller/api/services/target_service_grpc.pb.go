@@ -34,6 +34,8 @@ const (
 	TargetService_AddTargetCredentialSources_FullMethodName    = "/controller.api.services.v1.TargetService/AddTargetCredentialSources"
 	TargetService_SetTargetCredentialSources_FullMethodName    = "/controller.api.services.v1.TargetService/SetTargetCredentialSources"
 	TargetService_RemoveTargetCredentialSources_FullMethodName = "/controller.api.services.v1.TargetService/RemoveTargetCredentialSources"
+	TargetService_ListTargetAccessRequests_FullMethodName      = "/controller.api.services.v1.TargetService/ListTargetAccessRequests"
+	TargetService_ApproveTargetAccessRequest_FullMethodName    = "/controller.api.services.v1.TargetService/ApproveTargetAccessRequest"
 )
 
 // TargetServiceClient is the client API for TargetService service.
@@ -123,6 +125,16 @@ type TargetServiceClient interface {
 	// Credential Source is attempted to be removed from the Target when the
 	// Target does not have the Credential Source.
 	RemoveTargetCredentialSources(ctx context.Context, in *RemoveTargetCredentialSourcesRequest, opts ...grpc.CallOption) (*RemoveTargetCredentialSourcesResponse, error)
+	// ListTargetAccessRequests returns the access requests made for the
+	// specified Target. If the Target ID is missing, malformed, or references a
+	// non-existing resource, an error is returned.
+	ListTargetAccessRequests(ctx context.Context, in *ListTargetAccessRequestsRequest, opts ...grpc.CallOption) (*ListTargetAccessRequestsResponse, error)
+	// ApproveTargetAccessRequest approves or denies a pending access request for
+	// the specified Target. An approved access request can be redeemed by the
+	// requesting User when authorizing a Session until it expires. A denied
+	// access request cannot be redeemed. A User cannot approve or deny their own
+	// access request.
+	ApproveTargetAccessRequest(ctx context.Context, in *ApproveTargetAccessRequestRequest, opts ...grpc.CallOption) (*ApproveTargetAccessRequestResponse, error)
 }
 
 type targetServiceClient struct {
@@ -241,6 +253,24 @@ func (c *targetServiceClient) RemoveTargetCredentialSources(ctx context.Context,
 	return out, nil
 }
 
+func (c *targetServiceClient) ListTargetAccessRequests(ctx context.Context, in *ListTargetAccessRequestsRequest, opts ...grpc.CallOption) (*ListTargetAccessRequestsResponse, error) {
+	out := new(ListTargetAccessRequestsResponse)
+	err := c.cc.Invoke(ctx, TargetService_ListTargetAccessRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetServiceClient) ApproveTargetAccessRequest(ctx context.Context, in *ApproveTargetAccessRequestRequest, opts ...grpc.CallOption) (*ApproveTargetAccessRequestResponse, error) {
+	out := new(ApproveTargetAccessRequestResponse)
+	err := c.cc.Invoke(ctx, TargetService_ApproveTargetAccessRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TargetServiceServer is the server API for TargetService service.
 // All implementations must embed UnimplementedTargetServiceServer
 // for forward compatibility
@@ -328,6 +358,16 @@ type TargetServiceServer interface {
 	// Credential Source is attempted to be removed from the Target when the
 	// Target does not have the Credential Source.
 	RemoveTargetCredentialSources(context.Context, *RemoveTargetCredentialSourcesRequest) (*RemoveTargetCredentialSourcesResponse, error)
+	// ListTargetAccessRequests returns the access requests made for the
+	// specified Target. If the Target ID is missing, malformed, or references a
+	// non-existing resource, an error is returned.
+	ListTargetAccessRequests(context.Context, *ListTargetAccessRequestsRequest) (*ListTargetAccessRequestsResponse, error)
+	// ApproveTargetAccessRequest approves or denies a pending access request for
+	// the specified Target. An approved access request can be redeemed by the
+	// requesting User when authorizing a Session until it expires. A denied
+	// access request cannot be redeemed. A User cannot approve or deny their own
+	// access request.
+	ApproveTargetAccessRequest(context.Context, *ApproveTargetAccessRequestRequest) (*ApproveTargetAccessRequestResponse, error)
 	mustEmbedUnimplementedTargetServiceServer()
 }
 
@@ -371,6 +411,12 @@ func (UnimplementedTargetServiceServer) SetTargetCredentialSources(context.Conte
 func (UnimplementedTargetServiceServer) RemoveTargetCredentialSources(context.Context, *RemoveTargetCredentialSourcesRequest) (*RemoveTargetCredentialSourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTargetCredentialSources not implemented")
 }
+func (UnimplementedTargetServiceServer) ListTargetAccessRequests(context.Context, *ListTargetAccessRequestsRequest) (*ListTargetAccessRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTargetAccessRequests not implemented")
+}
+func (UnimplementedTargetServiceServer) ApproveTargetAccessRequest(context.Context, *ApproveTargetAccessRequestRequest) (*ApproveTargetAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveTargetAccessRequest not implemented")
+}
 func (UnimplementedTargetServiceServer) mustEmbedUnimplementedTargetServiceServer() {}
 
 // UnsafeTargetServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -600,6 +646,42 @@ func _TargetService_RemoveTargetCredentialSources_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TargetService_ListTargetAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTargetAccessRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).ListTargetAccessRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TargetService_ListTargetAccessRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).ListTargetAccessRequests(ctx, req.(*ListTargetAccessRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetService_ApproveTargetAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveTargetAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).ApproveTargetAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TargetService_ApproveTargetAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).ApproveTargetAccessRequest(ctx, req.(*ApproveTargetAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TargetService_ServiceDesc is the grpc.ServiceDesc for TargetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -655,6 +737,14 @@ var TargetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveTargetCredentialSources",
 			Handler:    _TargetService_RemoveTargetCredentialSources_Handler,
 		},
+		{
+			MethodName: "ListTargetAccessRequests",
+			Handler:    _TargetService_ListTargetAccessRequests_Handler,
+		},
+		{
+			MethodName: "ApproveTargetAccessRequest",
+			Handler:    _TargetService_ApproveTargetAccessRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/target_service.proto",
@@ -22,14 +22,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	UserService_GetUser_FullMethodName            = "/controller.api.services.v1.UserService/GetUser"
-	UserService_ListUsers_FullMethodName          = "/controller.api.services.v1.UserService/ListUsers"
-	UserService_CreateUser_FullMethodName         = "/controller.api.services.v1.UserService/CreateUser"
-	UserService_UpdateUser_FullMethodName         = "/controller.api.services.v1.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName         = "/controller.api.services.v1.UserService/DeleteUser"
-	UserService_AddUserAccounts_FullMethodName    = "/controller.api.services.v1.UserService/AddUserAccounts"
-	UserService_SetUserAccounts_FullMethodName    = "/controller.api.services.v1.UserService/SetUserAccounts"
-	UserService_RemoveUserAccounts_FullMethodName = "/controller.api.services.v1.UserService/RemoveUserAccounts"
+	UserService_GetUser_FullMethodName                = "/controller.api.services.v1.UserService/GetUser"
+	UserService_ListUsers_FullMethodName              = "/controller.api.services.v1.UserService/ListUsers"
+	UserService_CreateUser_FullMethodName             = "/controller.api.services.v1.UserService/CreateUser"
+	UserService_UpdateUser_FullMethodName             = "/controller.api.services.v1.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName             = "/controller.api.services.v1.UserService/DeleteUser"
+	UserService_AddUserAccounts_FullMethodName        = "/controller.api.services.v1.UserService/AddUserAccounts"
+	UserService_SetUserAccounts_FullMethodName        = "/controller.api.services.v1.UserService/SetUserAccounts"
+	UserService_RemoveUserAccounts_FullMethodName     = "/controller.api.services.v1.UserService/RemoveUserAccounts"
+	UserService_ExplainUserPermissions_FullMethodName = "/controller.api.services.v1.UserService/ExplainUserPermissions"
+	UserService_ListResolvableAliases_FullMethodName  = "/controller.api.services.v1.UserService/ListResolvableAliases"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -84,6 +86,17 @@ type UserServiceClient interface {
 	// will be removed from. If the provided Account ids is not associated with the
 	// provided User, an error is returned.
 	RemoveUserAccounts(ctx context.Context, in *RemoveUserAccountsRequest, opts ...grpc.CallOption) (*RemoveUserAccountsResponse, error)
+	// ExplainUserPermissions evaluates whether the specified User is allowed to
+	// perform an action on a resource. The response contains the grants that
+	// allow the action along with the Roles, Scopes and principals they come
+	// from, or the reasons no grant matched. The provided request must include
+	// the User ID, the resource ID and the action.
+	ExplainUserPermissions(ctx context.Context, in *ExplainUserPermissionsRequest, opts ...grpc.CallOption) (*ExplainUserPermissionsResponse, error)
+	// ListResolvableAliases returns the aliases which resolve to targets the
+	// specified User is allowed to authorize sessions to. A User can only list
+	// their own resolvable aliases. The provided request must include the User
+	// ID.
+	ListResolvableAliases(ctx context.Context, in *ListResolvableAliasesRequest, opts ...grpc.CallOption) (*ListResolvableAliasesResponse, error)
 }
 
 type userServiceClient struct {
@@ -166,6 +179,24 @@ func (c *userServiceClient) RemoveUserAccounts(ctx context.Context, in *RemoveUs
 	return out, nil
 }
 
+func (c *userServiceClient) ExplainUserPermissions(ctx context.Context, in *ExplainUserPermissionsRequest, opts ...grpc.CallOption) (*ExplainUserPermissionsResponse, error) {
+	out := new(ExplainUserPermissionsResponse)
+	err := c.cc.Invoke(ctx, UserService_ExplainUserPermissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListResolvableAliases(ctx context.Context, in *ListResolvableAliasesRequest, opts ...grpc.CallOption) (*ListResolvableAliasesResponse, error) {
+	out := new(ListResolvableAliasesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListResolvableAliases_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -218,6 +249,17 @@ type UserServiceServer interface {
 	// will be removed from. If the provided Account ids is not associated with the
 	// provided User, an error is returned.
 	RemoveUserAccounts(context.Context, *RemoveUserAccountsRequest) (*RemoveUserAccountsResponse, error)
+	// ExplainUserPermissions evaluates whether the specified User is allowed to
+	// perform an action on a resource. The response contains the grants that
+	// allow the action along with the Roles, Scopes and principals they come
+	// from, or the reasons no grant matched. The provided request must include
+	// the User ID, the resource ID and the action.
+	ExplainUserPermissions(context.Context, *ExplainUserPermissionsRequest) (*ExplainUserPermissionsResponse, error)
+	// ListResolvableAliases returns the aliases which resolve to targets the
+	// specified User is allowed to authorize sessions to. A User can only list
+	// their own resolvable aliases. The provided request must include the User
+	// ID.
+	ListResolvableAliases(context.Context, *ListResolvableAliasesRequest) (*ListResolvableAliasesResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -249,6 +291,12 @@ func (UnimplementedUserServiceServer) SetUserAccounts(context.Context, *SetUserA
 func (UnimplementedUserServiceServer) RemoveUserAccounts(context.Context, *RemoveUserAccountsRequest) (*RemoveUserAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserAccounts not implemented")
 }
+func (UnimplementedUserServiceServer) ExplainUserPermissions(context.Context, *ExplainUserPermissionsRequest) (*ExplainUserPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainUserPermissions not implemented")
+}
+func (UnimplementedUserServiceServer) ListResolvableAliases(context.Context, *ListResolvableAliasesRequest) (*ListResolvableAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResolvableAliases not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -406,6 +454,42 @@ func _UserService_RemoveUserAccounts_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ExplainUserPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainUserPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExplainUserPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ExplainUserPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExplainUserPermissions(ctx, req.(*ExplainUserPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListResolvableAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResolvableAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListResolvableAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListResolvableAliases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListResolvableAliases(ctx, req.(*ListResolvableAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -445,6 +529,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveUserAccounts",
 			Handler:    _UserService_RemoveUserAccounts_Handler,
 		},
+		{
+			MethodName: "ExplainUserPermissions",
+			Handler:    _UserService_ExplainUserPermissions_Handler,
+		},
+		{
+			MethodName: "ListResolvableAliases",
+			Handler:    _UserService_ListResolvableAliases_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/user_service.proto",
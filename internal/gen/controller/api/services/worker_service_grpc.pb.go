@@ -31,6 +31,7 @@ const (
 	WorkerService_AddWorkerTags_FullMethodName                    = "/controller.api.services.v1.WorkerService/AddWorkerTags"
 	WorkerService_SetWorkerTags_FullMethodName                    = "/controller.api.services.v1.WorkerService/SetWorkerTags"
 	WorkerService_RemoveWorkerTags_FullMethodName                 = "/controller.api.services.v1.WorkerService/RemoveWorkerTags"
+	WorkerService_DrainWorker_FullMethodName                      = "/controller.api.services.v1.WorkerService/DrainWorker"
 	WorkerService_ReadCertificateAuthority_FullMethodName         = "/controller.api.services.v1.WorkerService/ReadCertificateAuthority"
 	WorkerService_ReinitializeCertificateAuthority_FullMethodName = "/controller.api.services.v1.WorkerService/ReinitializeCertificateAuthority"
 )
@@ -85,6 +86,12 @@ type WorkerServiceClient interface {
 	// RemoveWorkerTags removes api tags from an existing Worker. If missing, malformed,
 	// or referencing a non-existing resource, an error is returned.
 	RemoveWorkerTags(ctx context.Context, in *RemoveWorkerTagsRequest, opts ...grpc.CallOption) (*RemoveWorkerTagsResponse, error)
+	// DrainWorker asks an existing Worker to drain. The Worker is no longer
+	// selected for new sessions, stops accepting new connections, lets its
+	// existing connections finish until the drain timeout passes, and then shuts
+	// down. If missing, malformed, or referencing a non-existing resource, an
+	// error is returned.
+	DrainWorker(ctx context.Context, in *DrainWorkerRequest, opts ...grpc.CallOption) (*DrainWorkerResponse, error)
 	// ReadCertificateAuthority returns the current and next set of root certificates
 	ReadCertificateAuthority(ctx context.Context, in *ReadCertificateAuthorityRequest, opts ...grpc.CallOption) (*ReadCertificateAuthorityResponse, error)
 	// ReinitializeCas removes both current and next root certs and replaces them with a new set
@@ -180,6 +187,15 @@ func (c *workerServiceClient) RemoveWorkerTags(ctx context.Context, in *RemoveWo
 	return out, nil
 }
 
+func (c *workerServiceClient) DrainWorker(ctx context.Context, in *DrainWorkerRequest, opts ...grpc.CallOption) (*DrainWorkerResponse, error) {
+	out := new(DrainWorkerResponse)
+	err := c.cc.Invoke(ctx, WorkerService_DrainWorker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workerServiceClient) ReadCertificateAuthority(ctx context.Context, in *ReadCertificateAuthorityRequest, opts ...grpc.CallOption) (*ReadCertificateAuthorityResponse, error) {
 	out := new(ReadCertificateAuthorityResponse)
 	err := c.cc.Invoke(ctx, WorkerService_ReadCertificateAuthority_FullMethodName, in, out, opts...)
@@ -248,6 +264,12 @@ type WorkerServiceServer interface {
 	// RemoveWorkerTags removes api tags from an existing Worker. If missing, malformed,
 	// or referencing a non-existing resource, an error is returned.
 	RemoveWorkerTags(context.Context, *RemoveWorkerTagsRequest) (*RemoveWorkerTagsResponse, error)
+	// DrainWorker asks an existing Worker to drain. The Worker is no longer
+	// selected for new sessions, stops accepting new connections, lets its
+	// existing connections finish until the drain timeout passes, and then shuts
+	// down. If missing, malformed, or referencing a non-existing resource, an
+	// error is returned.
+	DrainWorker(context.Context, *DrainWorkerRequest) (*DrainWorkerResponse, error)
 	// ReadCertificateAuthority returns the current and next set of root certificates
 	ReadCertificateAuthority(context.Context, *ReadCertificateAuthorityRequest) (*ReadCertificateAuthorityResponse, error)
 	// ReinitializeCas removes both current and next root certs and replaces them with a new set
@@ -286,6 +308,9 @@ func (UnimplementedWorkerServiceServer) SetWorkerTags(context.Context, *SetWorke
 func (UnimplementedWorkerServiceServer) RemoveWorkerTags(context.Context, *RemoveWorkerTagsRequest) (*RemoveWorkerTagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveWorkerTags not implemented")
 }
+func (UnimplementedWorkerServiceServer) DrainWorker(context.Context, *DrainWorkerRequest) (*DrainWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainWorker not implemented")
+}
 func (UnimplementedWorkerServiceServer) ReadCertificateAuthority(context.Context, *ReadCertificateAuthorityRequest) (*ReadCertificateAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadCertificateAuthority not implemented")
 }
@@ -467,6 +492,24 @@ func _WorkerService_RemoveWorkerTags_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkerService_DrainWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainWorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).DrainWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_DrainWorker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).DrainWorker(ctx, req.(*DrainWorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkerService_ReadCertificateAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReadCertificateAuthorityRequest)
 	if err := dec(in); err != nil {
@@ -546,6 +589,10 @@ var WorkerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveWorkerTags",
 			Handler:    _WorkerService_RemoveWorkerTags_Handler,
 		},
+		{
+			MethodName: "DrainWorker",
+			Handler:    _WorkerService_DrainWorker_Handler,
+		},
 		{
 			MethodName: "ReadCertificateAuthority",
 			Handler:    _WorkerService_ReadCertificateAuthority_Handler,
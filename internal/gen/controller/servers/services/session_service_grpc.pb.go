@@ -28,6 +28,7 @@ const (
 	SessionService_AuthorizeConnection_FullMethodName = "/controller.servers.services.v1.SessionService/AuthorizeConnection"
 	SessionService_ConnectConnection_FullMethodName   = "/controller.servers.services.v1.SessionService/ConnectConnection"
 	SessionService_CloseConnection_FullMethodName     = "/controller.servers.services.v1.SessionService/CloseConnection"
+	SessionService_AuthorizeShadow_FullMethodName     = "/controller.servers.services.v1.SessionService/AuthorizeShadow"
 )
 
 // SessionServiceClient is the client API for SessionService service.
@@ -47,6 +48,9 @@ type SessionServiceClient interface {
 	ConnectConnection(ctx context.Context, in *ConnectConnectionRequest, opts ...grpc.CallOption) (*ConnectConnectionResponse, error)
 	// CloseConnections updates a connection to set it to closed
 	CloseConnection(ctx context.Context, in *CloseConnectionRequest, opts ...grpc.CallOption) (*CloseConnectionResponse, error)
+	// AuthorizeShadow allows a worker to authorize a shadow attaching to one of
+	// a session's connections.
+	AuthorizeShadow(ctx context.Context, in *AuthorizeShadowRequest, opts ...grpc.CallOption) (*AuthorizeShadowResponse, error)
 }
 
 type sessionServiceClient struct {
@@ -111,6 +115,15 @@ func (c *sessionServiceClient) CloseConnection(ctx context.Context, in *CloseCon
 	return out, nil
 }
 
+func (c *sessionServiceClient) AuthorizeShadow(ctx context.Context, in *AuthorizeShadowRequest, opts ...grpc.CallOption) (*AuthorizeShadowResponse, error) {
+	out := new(AuthorizeShadowResponse)
+	err := c.cc.Invoke(ctx, SessionService_AuthorizeShadow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 // All implementations must embed UnimplementedSessionServiceServer
 // for forward compatibility
@@ -128,6 +141,9 @@ type SessionServiceServer interface {
 	ConnectConnection(context.Context, *ConnectConnectionRequest) (*ConnectConnectionResponse, error)
 	// CloseConnections updates a connection to set it to closed
 	CloseConnection(context.Context, *CloseConnectionRequest) (*CloseConnectionResponse, error)
+	// AuthorizeShadow allows a worker to authorize a shadow attaching to one of
+	// a session's connections.
+	AuthorizeShadow(context.Context, *AuthorizeShadowRequest) (*AuthorizeShadowResponse, error)
 	mustEmbedUnimplementedSessionServiceServer()
 }
 
@@ -153,6 +169,9 @@ func (UnimplementedSessionServiceServer) ConnectConnection(context.Context, *Con
 func (UnimplementedSessionServiceServer) CloseConnection(context.Context, *CloseConnectionRequest) (*CloseConnectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseConnection not implemented")
 }
+func (UnimplementedSessionServiceServer) AuthorizeShadow(context.Context, *AuthorizeShadowRequest) (*AuthorizeShadowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeShadow not implemented")
+}
 func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
 
 // UnsafeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -274,6 +293,24 @@ func _SessionService_CloseConnection_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_AuthorizeShadow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeShadowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).AuthorizeShadow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_AuthorizeShadow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).AuthorizeShadow(ctx, req.(*AuthorizeShadowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +342,10 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloseConnection",
 			Handler:    _SessionService_CloseConnection_Handler,
 		},
+		{
+			MethodName: "AuthorizeShadow",
+			Handler:    _SessionService_AuthorizeShadow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/servers/services/v1/session_service.proto",
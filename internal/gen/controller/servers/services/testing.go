@@ -16,6 +16,7 @@ type mockSessionServiceClient struct {
 	AuthorizeConnectionFn func(context.Context, *AuthorizeConnectionRequest) (*AuthorizeConnectionResponse, error)
 	ConnectConnectionFn   func(context.Context, *ConnectConnectionRequest) (*ConnectConnectionResponse, error)
 	CloseConnectionFn     func(context.Context, *CloseConnectionRequest) (*CloseConnectionResponse, error)
+	AuthorizeShadowFn     func(context.Context, *AuthorizeShadowRequest) (*AuthorizeShadowResponse, error)
 }
 
 // NewMockSessionServiceClient returns a mock SessionServiceClient which allows
@@ -60,6 +61,13 @@ func (c *mockSessionServiceClient) ConnectConnection(ctx context.Context, req *C
 	panic("not implemented")
 }
 
+func (c *mockSessionServiceClient) AuthorizeShadow(ctx context.Context, req *AuthorizeShadowRequest, _ ...grpc.CallOption) (*AuthorizeShadowResponse, error) {
+	if c.AuthorizeShadowFn != nil {
+		return c.AuthorizeShadowFn(ctx, req)
+	}
+	panic("not implemented")
+}
+
 func (c *mockSessionServiceClient) CloseConnection(ctx context.Context, req *CloseConnectionRequest, _ ...grpc.CallOption) (*CloseConnectionResponse, error) {
 	if c.CloseConnectionFn != nil {
 		return c.CloseConnectionFn(ctx, req)
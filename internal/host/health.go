@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package host
+
+import "time"
+
+// HealthStatus is the health of a host as determined by the health checks
+// workers run against it.
+type HealthStatus string
+
+const (
+	// UnknownHealth means no worker has recently reported the result of a
+	// health check of the host.
+	UnknownHealth HealthStatus = "unknown"
+
+	// Healthy means a worker has recently reported a successful health check
+	// of the host.
+	Healthy HealthStatus = "healthy"
+
+	// Unhealthy means workers have recently reported the result of health
+	// checks of the host and none of them were successful.
+	Unhealthy HealthStatus = "unhealthy"
+)
+
+func (s HealthStatus) String() string {
+	return string(s)
+}
+
+// HealthCheckStaleness is the age after which the result of a health check
+// is no longer considered when determining the health of a host.
+var HealthCheckStaleness = 5 * time.Minute
+
+// HealthCheckEndpoint is an address and port at which a host is health
+// checked, along with the filters of the target which determine the workers
+// that are able to reach it.
+type HealthCheckEndpoint struct {
+	HostId             string
+	Address            string
+	Port               uint32
+	WorkerFilter       string
+	EgressWorkerFilter string
+}
+
+// HealthCheckResult is the result of a health check of a host on a port.
+type HealthCheckResult struct {
+	HostId  string
+	Port    uint32
+	Healthy bool
+}
+
+// portHealth is whether any worker recently reported a host to be healthy on
+// a port.
+type portHealth struct {
+	HostId  string
+	Port    uint32
+	Healthy bool
+}
+
+// aggregateHealth returns the health of each host given the health of the
+// host on each checked port. A host is healthy only if it is healthy on every
+// port it was checked on.
+func aggregateHealth(results []*portHealth) map[string]HealthStatus {
+	ret := make(map[string]HealthStatus, len(results))
+	for _, r := range results {
+		switch {
+		case !r.Healthy:
+			ret[r.HostId] = Unhealthy
+		case ret[r.HostId] != Unhealthy:
+			ret[r.HostId] = Healthy
+		}
+	}
+	return ret
+}
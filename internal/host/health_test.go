@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package host
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_aggregateHealth(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		results []*portHealth
+		want    map[string]HealthStatus
+	}{
+		{
+			name: "empty",
+			want: map[string]HealthStatus{},
+		},
+		{
+			name: "healthy",
+			results: []*portHealth{
+				{HostId: "h_1", Port: 22, Healthy: true},
+				{HostId: "h_1", Port: 443, Healthy: true},
+			},
+			want: map[string]HealthStatus{"h_1": Healthy},
+		},
+		{
+			name: "unhealthy-on-one-port",
+			results: []*portHealth{
+				{HostId: "h_1", Port: 22, Healthy: true},
+				{HostId: "h_1", Port: 443, Healthy: false},
+				{HostId: "h_1", Port: 8080, Healthy: true},
+			},
+			want: map[string]HealthStatus{"h_1": Unhealthy},
+		},
+		{
+			name: "multiple-hosts",
+			results: []*portHealth{
+				{HostId: "h_1", Port: 22, Healthy: false},
+				{HostId: "h_2", Port: 22, Healthy: true},
+			},
+			want: map[string]HealthStatus{"h_1": Unhealthy, "h_2": Healthy},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, aggregateHealth(tt.results))
+		})
+	}
+}
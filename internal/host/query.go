@@ -283,5 +283,35 @@ final as (
   select *
     from final
 order by update_time desc, public_id desc;
+`
+
+	upsertHealthCheckResultQuery = `
+insert into host_health
+  (host_id, port, worker_id, healthy, check_time)
+select @host_id, @port, @worker_id, @healthy, now()
+ where exists (select 1 from host where public_id = @host_id)
+on conflict (host_id, port, worker_id) do update
+   set healthy    = excluded.healthy,
+       check_time = excluded.check_time;
+`
+
+	listHealthTemplate = `
+  select host_id,
+         port,
+         bool_or(healthy) as healthy
+    from host_health
+   where host_id in @host_ids
+     and check_time > now() - make_interval(secs => @staleness_seconds)
+     %s -- search condition for the port is constructed
+group by host_id, port;
+`
+
+	listHealthCheckEndpointsQuery = `
+select host_id,
+       address,
+       port,
+       worker_filter,
+       egress_worker_filter
+  from host_health_check_endpoint;
 `
 )
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package host
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/util"
+)
+
+// HealthRepository stores the results of the health checks workers run
+// against hosts and determines the health of hosts from them.
+type HealthRepository struct {
+	reader db.Reader
+	writer db.Writer
+}
+
+// NewHealthRepository returns a new host health repository.
+func NewHealthRepository(ctx context.Context, reader db.Reader, writer db.Writer) (*HealthRepository, error) {
+	const op = "host.NewHealthRepository"
+	switch {
+	case util.IsNil(reader):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing DB reader")
+	case util.IsNil(writer):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing DB writer")
+	}
+	return &HealthRepository{
+		reader: reader,
+		writer: writer,
+	}, nil
+}
+
+// UpsertHealthCheckResults records the results of the health checks run by
+// the worker, replacing the results of any previous checks the worker ran
+// against the same hosts and ports. Results for hosts which no longer exist
+// are ignored.
+func (r *HealthRepository) UpsertHealthCheckResults(ctx context.Context, workerId string, results []*HealthCheckResult) error {
+	const op = "host.(HealthRepository).UpsertHealthCheckResults"
+	if workerId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing worker id")
+	}
+	if len(results) == 0 {
+		return nil
+	}
+	for _, res := range results {
+		switch {
+		case res.HostId == "":
+			return errors.New(ctx, errors.InvalidParameter, op, "missing host id")
+		case res.Port == 0:
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("missing port for host %q", res.HostId))
+		}
+	}
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(_ db.Reader, w db.Writer) error {
+		for _, res := range results {
+			if _, err := w.Exec(ctx, upsertHealthCheckResultQuery, []any{
+				sql.Named("host_id", res.HostId),
+				sql.Named("port", res.Port),
+				sql.Named("worker_id", workerId),
+				sql.Named("healthy", res.Healthy),
+			}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to upsert health of host %q", res.HostId)))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// ListHealth returns the health of the hosts with the given ids. Hosts that
+// have not been health checked recently are not included in the result and
+// should be treated as UnknownHealth. If a port is provided, only health
+// checks on that port are considered; otherwise a host is only healthy if it
+// is healthy on every port it was checked on.
+func (r *HealthRepository) ListHealth(ctx context.Context, hostIds []string, port uint32) (map[string]HealthStatus, error) {
+	const op = "host.(HealthRepository).ListHealth"
+	if len(hostIds) == 0 {
+		return map[string]HealthStatus{}, nil
+	}
+	args := []any{
+		sql.Named("host_ids", hostIds),
+		sql.Named("staleness_seconds", HealthCheckStaleness.Seconds()),
+	}
+	var portCondition string
+	if port != 0 {
+		portCondition = "and port = @port"
+		args = append(args, sql.Named("port", port))
+	}
+	rows, err := r.reader.Query(ctx, fmt.Sprintf(listHealthTemplate, portCondition), args)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to query host health"))
+	}
+	defer rows.Close()
+	var results []*portHealth
+	for rows.Next() {
+		if err := r.reader.ScanRows(ctx, rows, &results); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan host health"))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to query host health"))
+	}
+	return aggregateHealth(results), nil
+}
+
+// ListHealthCheckEndpoints returns the addresses and ports at which the hosts
+// in the host sets of targets should be health checked.
+func (r *HealthRepository) ListHealthCheckEndpoints(ctx context.Context) ([]*HealthCheckEndpoint, error) {
+	const op = "host.(HealthRepository).ListHealthCheckEndpoints"
+	rows, err := r.reader.Query(ctx, listHealthCheckEndpointsQuery, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to query health check endpoints"))
+	}
+	defer rows.Close()
+	var endpoints []*HealthCheckEndpoint
+	for rows.Next() {
+		if err := r.reader.ScanRows(ctx, rows, &endpoints); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan health check endpoints"))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to query health check endpoints"))
+	}
+	return endpoints, nil
+}
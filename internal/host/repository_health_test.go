@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package host_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthRepository(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+
+	catalog := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hosts := static.TestHosts(t, conn, catalog.GetPublicId(), 2)
+	set := static.TestSets(t, conn, catalog.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, set.GetPublicId(), hosts)
+	tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test target",
+		target.WithDefaultPort(22),
+		target.WithWorkerFilter(`"dev" in "/tags/env"`),
+		target.WithHostSources([]string{set.GetPublicId()}))
+	w1 := server.TestKmsWorker(t, conn, wrapper)
+	w2 := server.TestKmsWorker(t, conn, wrapper)
+
+	repo, err := host.NewHealthRepository(ctx, rw, rw)
+	require.NoError(t, err)
+
+	t.Run("endpoints", func(t *testing.T) {
+		eps, err := repo.ListHealthCheckEndpoints(ctx)
+		require.NoError(t, err)
+		require.Len(t, eps, 2)
+		for _, ep := range eps {
+			assert.Equal(t, uint32(22), ep.Port)
+			assert.Equal(t, `"dev" in "/tags/env"`, ep.WorkerFilter)
+			assert.NotEmpty(t, ep.Address)
+		}
+	})
+
+	t.Run("health", func(t *testing.T) {
+		h1, h2 := hosts[0].GetPublicId(), hosts[1].GetPublicId()
+
+		health, err := repo.ListHealth(ctx, []string{h1, h2}, 0)
+		require.NoError(t, err)
+		assert.Empty(t, health)
+
+		require.NoError(t, repo.UpsertHealthCheckResults(ctx, w1.GetPublicId(), []*host.HealthCheckResult{
+			{HostId: h1, Port: 22, Healthy: true},
+			{HostId: h2, Port: 22, Healthy: false},
+			{HostId: "hst_doesnotexist", Port: 22, Healthy: true},
+		}))
+		health, err = repo.ListHealth(ctx, []string{h1, h2}, 22)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]host.HealthStatus{h1: host.Healthy, h2: host.Unhealthy}, health)
+
+		// A healthy report from any worker makes the host healthy
+		require.NoError(t, repo.UpsertHealthCheckResults(ctx, w2.GetPublicId(), []*host.HealthCheckResult{
+			{HostId: h2, Port: 22, Healthy: true},
+		}))
+		health, err = repo.ListHealth(ctx, []string{h2}, 22)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]host.HealthStatus{h2: host.Healthy}, health)
+
+		// Results from the same worker replace previous results
+		require.NoError(t, repo.UpsertHealthCheckResults(ctx, w1.GetPublicId(), []*host.HealthCheckResult{
+			{HostId: h1, Port: 22, Healthy: false},
+			{HostId: h1, Port: 443, Healthy: true},
+		}))
+		health, err = repo.ListHealth(ctx, []string{h1}, 443)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]host.HealthStatus{h1: host.Healthy}, health)
+		health, err = repo.ListHealth(ctx, []string{h1}, 0)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]host.HealthStatus{h1: host.Unhealthy}, health)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		err := repo.UpsertHealthCheckResults(ctx, "", []*host.HealthCheckResult{{HostId: "h", Port: 22}})
+		assert.Error(t, err)
+		err = repo.UpsertHealthCheckResults(ctx, w1.GetPublicId(), []*host.HealthCheckResult{{HostId: "h"}})
+		assert.Error(t, err)
+	})
+}
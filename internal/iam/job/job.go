@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package job implements scheduler jobs for IAM resources.
+package job
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/util"
+)
+
+// RegisterJobs registers iam related jobs with the provided scheduler.
+func RegisterJobs(ctx context.Context, s *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms) error {
+	const op = "iamjob.RegisterJobs"
+	if s == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scheduler")
+	}
+	if util.IsNil(r) {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing reader")
+	}
+	if util.IsNil(w) {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing writer")
+	}
+	if kms == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	repo, err := iam.NewRepository(ctx, r, w, kms)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	purgeExpiredGrantsJob, err := newPurgeExpiredGrantsJob(ctx, repo)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err := s.RegisterJob(ctx, purgeExpiredGrantsJob); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package job
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RegisterJobs(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	extWrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, extWrapper)
+	s := scheduler.TestScheduler(t, conn, extWrapper)
+	ctx := context.Background()
+
+	err := RegisterJobs(ctx, nil, rw, rw, kmsCache)
+	require.Error(t, err)
+	err = RegisterJobs(ctx, s, nil, rw, kmsCache)
+	require.Error(t, err)
+	err = RegisterJobs(ctx, s, rw, nil, kmsCache)
+	require.Error(t, err)
+	err = RegisterJobs(ctx, s, rw, rw, nil)
+	require.Error(t, err)
+	err = RegisterJobs(ctx, s, rw, rw, kmsCache)
+	require.NoError(t, err)
+}
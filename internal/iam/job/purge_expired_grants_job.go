@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/scheduler"
+)
+
+// purgeExpiredGrantsJob deletes role grants whose valid_until time has
+// passed, so that time-limited access is removed from roles automatically.
+type purgeExpiredGrantsJob struct {
+	repo *iam.Repository
+
+	// the number of role grants deleted in the most recent run
+	purgedInRun int
+}
+
+func newPurgeExpiredGrantsJob(ctx context.Context, repo *iam.Repository) (*purgeExpiredGrantsJob, error) {
+	const op = "iamjob.newPurgeExpiredGrantsJob"
+	if repo == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing repository")
+	}
+	return &purgeExpiredGrantsJob{
+		repo: repo,
+	}, nil
+}
+
+// Status reports the job’s current status. The status is periodically persisted by
+// the scheduler when a job is running, and will be used to verify a job is making progress.
+func (p *purgeExpiredGrantsJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: p.purgedInRun,
+		Total:     p.purgedInRun,
+	}
+}
+
+// Run performs the required work depending on the implementation.
+// The context is used to notify the job that it should exit early.
+func (p *purgeExpiredGrantsJob) Run(ctx context.Context) error {
+	const op = "iamjob.(purgeExpiredGrantsJob).Run"
+	p.purgedInRun = 0
+	var err error
+	p.purgedInRun, err = p.repo.DeleteExpiredRoleGrants(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// NextRunIn returns the duration until the next job run should be scheduled.
+// Expired grants are already ignored when authorizing requests, so they do
+// not need to be purged promptly.
+func (p *purgeExpiredGrantsJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return 10 * time.Minute, nil
+}
+
+// Name is the unique name of the job.
+func (p *purgeExpiredGrantsJob) Name() string {
+	return "iam_purge_expired_grants"
+}
+
+// Description is the human readable description of the job.
+func (p *purgeExpiredGrantsJob) Description() string {
+	return "Delete role grants whose valid_until time has passed"
+}
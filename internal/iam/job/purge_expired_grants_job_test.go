@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package job
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_purgeExpiredGrantsJob(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := iam.TestRepo(t, conn, wrapper)
+	ctx := context.Background()
+
+	_, err := newPurgeExpiredGrantsJob(ctx, nil)
+	require.Error(t, err)
+	job, err := newPurgeExpiredGrantsJob(ctx, repo)
+	require.NoError(t, err)
+	require.NotNil(t, job)
+
+	_, proj := iam.TestScopes(t, repo)
+	role := iam.TestRole(t, conn, proj.PublicId)
+	expired := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	iam.TestRoleGrant(t, conn, role.PublicId, "ids=*;type=target;actions=read;valid_until="+expired)
+	iam.TestRoleGrant(t, conn, role.PublicId, "ids=*;type=host-catalog;actions=read")
+
+	require.NoError(t, job.Run(ctx))
+	assert.Equal(t, 1, job.Status().Completed)
+
+	_, _, grants, _, err := repo.LookupRole(ctx, role.PublicId)
+	require.NoError(t, err)
+	require.Len(t, grants, 1)
+	assert.Equal(t, "ids=*;type=host-catalog;actions=read", grants[0].CanonicalGrant)
+
+	// Nothing left to purge
+	require.NoError(t, job.Run(ctx))
+	assert.Equal(t, 0, job.Status().Completed)
+}
@@ -238,6 +238,144 @@ const (
       from final;
     `
 
+	principalRolesForUserQuery = `
+    principal_id in (
+      select public_id
+        from iam_user
+       where public_id in (?)
+      union
+      select group_id
+        from iam_group_member_user
+       where member_id in (?)
+      union
+      select managed_group_id
+        from auth_managed_group_member_account
+       where member_id in (
+             select public_id
+               from auth_account
+              where iam_user_id in (?)
+       )
+    )
+    `
+
+	resolveResourceQuery = `
+    with
+    resource (scope_id, pin) as (
+      select coalesce(parent_id, public_id),
+             null
+        from iam_scope
+       where public_id = @resource_id
+      union all
+      select scope_id,
+             null
+        from iam_user
+       where public_id = @resource_id
+      union all
+      select scope_id,
+             null
+        from iam_group
+       where public_id = @resource_id
+      union all
+      select scope_id,
+             null
+        from iam_role
+       where public_id = @resource_id
+      union all
+      select scope_id,
+             null
+        from auth_method
+       where public_id = @resource_id
+      union all
+      select scope_id,
+             auth_method_id
+        from auth_account
+       where public_id = @resource_id
+      union all
+      select auth_method.scope_id,
+             auth_managed_group.auth_method_id
+        from auth_managed_group
+        join auth_method
+          on auth_method.public_id = auth_managed_group.auth_method_id
+       where auth_managed_group.public_id = @resource_id
+      union all
+      select auth_account.scope_id,
+             null
+        from auth_token
+        join auth_account
+          on auth_account.public_id = auth_token.auth_account_id
+       where auth_token.public_id = @resource_id
+      union all
+      select project_id,
+             null
+        from target
+       where public_id = @resource_id
+      union all
+      select project_id,
+             null
+        from host_catalog
+       where public_id = @resource_id
+      union all
+      select host_catalog.project_id,
+             host.catalog_id
+        from host
+        join host_catalog
+          on host_catalog.public_id = host.catalog_id
+       where host.public_id = @resource_id
+      union all
+      select host_catalog.project_id,
+             host_set.catalog_id
+        from host_set
+        join host_catalog
+          on host_catalog.public_id = host_set.catalog_id
+       where host_set.public_id = @resource_id
+      union all
+      select project_id,
+             null
+        from credential_store
+       where public_id = @resource_id
+      union all
+      select credential_store.project_id,
+             credential_library.store_id
+        from credential_library
+        join credential_store
+          on credential_store.public_id = credential_library.store_id
+       where credential_library.public_id = @resource_id
+      union all
+      select credential_store.project_id,
+             credential_static.store_id
+        from credential_static
+        join credential_store
+          on credential_store.public_id = credential_static.store_id
+       where credential_static.public_id = @resource_id
+      union all
+      select project_id,
+             null
+        from session
+       where public_id = @resource_id
+      union all
+      select scope_id,
+             null
+        from server_worker
+       where public_id = @resource_id
+      union all
+      select scope_id,
+             null
+        from storage_plugin_storage_bucket
+       where public_id = @resource_id
+      union all
+      select storage_plugin_storage_bucket.scope_id,
+             null
+        from recording_session
+        join storage_plugin_storage_bucket
+          on storage_plugin_storage_bucket.public_id = recording_session.storage_bucket_id
+       where recording_session.public_id = @resource_id
+    )
+    select scope_id,
+           coalesce(pin, '') as pin
+      from resource
+     limit 1;
+    `
+
 	estimateCountRoles = `
 		select reltuples::bigint as estimate from pg_class where oid in ('iam_role'::regclass)
 	`
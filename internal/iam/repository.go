@@ -213,7 +213,9 @@ func (r *Repository) update(ctx context.Context, resource Resource, version uint
 }
 
 // delete will delete an iam resource in the db repository with an oplog entry
-func (r *Repository) delete(ctx context.Context, resource Resource, _ ...Option) (int, error) {
+//
+// Supported options: WithReaderWriter
+func (r *Repository) delete(ctx context.Context, resource Resource, opt ...Option) (int, error) {
 	const op = "iam.(Repository).delete"
 	if resource == nil {
 		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing resource")
@@ -228,6 +230,8 @@ func (r *Repository) delete(ctx context.Context, resource Resource, _ ...Option)
 	}
 	metadata["op-type"] = []string{oplog.OpType_OP_TYPE_DELETE.String()}
 
+	opts := getOpts(opt...)
+
 	scope, err := resource.GetScope(ctx, r.reader)
 	if err != nil {
 		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get scope"))
@@ -239,27 +243,30 @@ func (r *Repository) delete(ctx context.Context, resource Resource, _ ...Option)
 
 	var rowsDeleted int
 	var deleteResource any
-	_, err = r.writer.DoTx(
-		ctx,
-		db.StdRetryCnt,
-		db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
-			deleteResource = resourceCloner.Clone()
-			rowsDeleted, err = w.Delete(
-				ctx,
-				deleteResource,
-				db.WithOplog(oplogWrapper, metadata),
-			)
-			if err != nil {
-				return errors.Wrap(ctx, err, op)
-			}
-			if rowsDeleted > 1 {
-				// return err, which will result in a rollback of the delete
-				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
-			}
-			return nil
-		},
-	)
+	deleteFn := func(_ db.Reader, w db.Writer) error {
+		deleteResource = resourceCloner.Clone()
+		rowsDeleted, err = w.Delete(
+			ctx,
+			deleteResource,
+			db.WithOplog(oplogWrapper, metadata),
+		)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if rowsDeleted > 1 {
+			// return err, which will result in a rollback of the delete
+			return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+		}
+		return nil
+	}
+	if opts.withReader != nil && opts.withWriter != nil {
+		if !opts.withWriter.IsTx(ctx) {
+			return db.NoRowsAffected, errors.New(ctx, errors.Internal, op, "writer is not in transaction")
+		}
+		err = deleteFn(opts.withReader, opts.withWriter)
+	} else {
+		_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, deleteFn)
+	}
 	if err != nil {
 		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
 	}
@@ -464,6 +464,30 @@ func (r *Repository) ListPrincipalRoles(ctx context.Context, roleId string, opt
 	return principals, nil
 }
 
+// PrincipalRolesForUser returns the principal roles through which the user is
+// assigned roles: those assigned directly to the user, to groups the user is a
+// member of, and to managed groups any of the user's accounts are a member of.
+// As with GrantsForUser, roles assigned to the u_anon user are included, and
+// roles assigned to the u_auth user are included unless the user is the
+// anonymous user.
+func (r *Repository) PrincipalRolesForUser(ctx context.Context, userId string, _ ...Option) ([]*PrincipalRole, error) {
+	const op = "iam.(Repository).PrincipalRolesForUser"
+	if userId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+
+	userIds := []string{globals.AnonymousUserId}
+	if userId != globals.AnonymousUserId {
+		userIds = append(userIds, globals.AnyAuthenticatedUserId, userId)
+	}
+
+	var roles []*PrincipalRole
+	if err := r.list(ctx, &roles, principalRolesForUserQuery, []any{userIds, userIds, userIds}, WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup principal roles"))
+	}
+	return roles, nil
+}
+
 type PrincipalSet struct {
 	AddUserRoles            []any
 	AddGroupRoles           []any
@@ -615,6 +639,16 @@ func splitPrincipals(ctx context.Context, principals []string) (users, groups, m
 				managedGroups = make([]string, 0, len(principals))
 			}
 			managedGroups = append(managedGroups, principal)
+		case strings.HasPrefix(principal, globals.JwtManagedGroupPrefix):
+			if managedGroups == nil {
+				managedGroups = make([]string, 0, len(principals))
+			}
+			managedGroups = append(managedGroups, principal)
+		case strings.HasPrefix(principal, globals.SamlManagedGroupPrefix):
+			if managedGroups == nil {
+				managedGroups = make([]string, 0, len(principals))
+			}
+			managedGroups = append(managedGroups, principal)
 		default:
 			return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid principal ID %q", principal))
 		}
@@ -681,3 +681,53 @@ func TestRepository_SetPrincipalRoles(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_PrincipalRolesForUser(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	ctx := context.Background()
+	org, proj := TestScopes(t, repo)
+
+	user := TestUser(t, repo, org.PublicId)
+	otherUser := TestUser(t, repo, org.PublicId)
+	group := TestGroup(t, conn, org.PublicId)
+	TestGroupMember(t, conn, group.PublicId, user.PublicId)
+
+	userRole := TestRole(t, conn, proj.PublicId)
+	TestUserRole(t, conn, userRole.PublicId, user.PublicId)
+	groupRole := TestRole(t, conn, org.PublicId)
+	TestGroupRole(t, conn, groupRole.PublicId, group.PublicId)
+	otherRole := TestRole(t, conn, org.PublicId)
+	TestUserRole(t, conn, otherRole.PublicId, otherUser.PublicId)
+	authRole := TestRole(t, conn, org.PublicId)
+	TestUserRole(t, conn, authRole.PublicId, globals.AnyAuthenticatedUserId)
+
+	t.Run("missing-user-id", func(t *testing.T) {
+		_, err := repo.PrincipalRolesForUser(ctx, "")
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("user", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.PrincipalRolesForUser(ctx, user.PublicId)
+		require.NoError(err)
+		principals := map[string]string{}
+		for _, pr := range got {
+			principals[pr.GetRoleId()] = pr.GetPrincipalId()
+		}
+		assert.Equal(user.PublicId, principals[userRole.PublicId])
+		assert.Equal(group.PublicId, principals[groupRole.PublicId])
+		assert.Equal(globals.AnyAuthenticatedUserId, principals[authRole.PublicId])
+		assert.NotContains(principals, otherRole.PublicId)
+	})
+	t.Run("anonymous", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.PrincipalRolesForUser(ctx, globals.AnonymousUserId)
+		require.NoError(err)
+		for _, pr := range got {
+			assert.Equal(globals.AnonymousUserId, pr.GetPrincipalId())
+		}
+	})
+}
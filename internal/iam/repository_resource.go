@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package iam
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// ResolveResource returns the resource identified by resourceId as it would be
+// presented to the permissions engine when authorizing an action on it: its
+// type, the scope containing it and, for resources which are not top level
+// (such as hosts or accounts), the ID of the resource they belong to as the
+// pin. A RecordNotFound error is returned if no resource exists with the id.
+func (r *Repository) ResolveResource(ctx context.Context, resourceId string, _ ...Option) (*perms.Resource, error) {
+	const op = "iam.(Repository).ResolveResource"
+	if resourceId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing resource id")
+	}
+	typ := globals.ResourceInfoFromPrefix(resourceId).Type
+	if typ == resource.Unknown {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown resource type for id %q", resourceId))
+	}
+
+	rows, err := r.reader.Query(ctx, resolveResourceQuery, []any{sql.Named("resource_id", resourceId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	ret := &perms.Resource{
+		Id:   resourceId,
+		Type: typ,
+	}
+	var found bool
+	for rows.Next() {
+		if err := rows.Scan(&ret.ScopeId, &ret.Pin); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		found = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if !found {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("resource %q not found", resourceId))
+	}
+	return ret, nil
+}
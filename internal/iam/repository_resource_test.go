@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ResolveResource(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	ctx := context.Background()
+	org, proj := TestScopes(t, repo)
+
+	user := TestUser(t, repo, org.PublicId)
+	group := TestGroup(t, conn, proj.PublicId)
+	role := TestRole(t, conn, proj.PublicId)
+
+	tests := []struct {
+		name        string
+		id          string
+		want        *perms.Resource
+		wantIsError errors.Code
+	}{
+		{
+			name: "global",
+			id:   scope.Global.String(),
+			want: &perms.Resource{Id: scope.Global.String(), ScopeId: scope.Global.String(), Type: resource.Scope},
+		},
+		{
+			name: "org",
+			id:   org.PublicId,
+			want: &perms.Resource{Id: org.PublicId, ScopeId: scope.Global.String(), Type: resource.Scope},
+		},
+		{
+			name: "project",
+			id:   proj.PublicId,
+			want: &perms.Resource{Id: proj.PublicId, ScopeId: org.PublicId, Type: resource.Scope},
+		},
+		{
+			name: "user",
+			id:   user.PublicId,
+			want: &perms.Resource{Id: user.PublicId, ScopeId: org.PublicId, Type: resource.User},
+		},
+		{
+			name: "group",
+			id:   group.PublicId,
+			want: &perms.Resource{Id: group.PublicId, ScopeId: proj.PublicId, Type: resource.Group},
+		},
+		{
+			name: "role",
+			id:   role.PublicId,
+			want: &perms.Resource{Id: role.PublicId, ScopeId: proj.PublicId, Type: resource.Role},
+		},
+		{
+			name:        "missing-id",
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name:        "unknown-prefix",
+			id:          "zzz_1234567890",
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name:        "not-found",
+			id:          globals.UserPrefix + "_1234567890",
+			wantIsError: errors.RecordNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.ResolveResource(ctx, tt.id)
+			if tt.wantIsError != 0 {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsError), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package iam
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/db"
@@ -389,6 +390,50 @@ func (r *Repository) ListRoleGrants(ctx context.Context, roleId string, opt ...O
 	return roleGrants, nil
 }
 
+// DeleteExpiredRoleGrants deletes the role grants whose valid_until time has
+// passed. Grants are deleted through DeleteRoleGrants so each affected role's
+// version is incremented and the deletion is recorded in the oplog. It returns
+// the number of role grants deleted. No options are currently supported.
+func (r *Repository) DeleteExpiredRoleGrants(ctx context.Context, _ ...Option) (int, error) {
+	const op = "iam.(Repository).DeleteExpiredRoleGrants"
+	var roleGrants []*RoleGrant
+	if err := r.reader.SearchWhere(ctx, &roleGrants, "canonical_grant like ?", []any{"%valid_until=%"}, db.WithLimit(-1)); err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to search for role grants"))
+	}
+
+	now := time.Now()
+	expired := make(map[string][]string)
+	for _, rg := range roleGrants {
+		// Use a fake scope, just want to get out the time window
+		perm, err := perms.Parse(ctx, "o_abcd1234", rg.CanonicalGrant, perms.WithSkipFinalValidation(true))
+		if err != nil {
+			return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("parsing grant string for role %s", rg.RoleId)))
+		}
+		if perm.ExpiredAt(now) {
+			expired[rg.RoleId] = append(expired[rg.RoleId], rg.CanonicalGrant)
+		}
+	}
+
+	var totalRowsDeleted int
+	for roleId, grants := range expired {
+		role := allocRole()
+		role.PublicId = roleId
+		if err := r.reader.LookupByPublicId(ctx, &role); err != nil {
+			if errors.IsNotFoundError(err) {
+				// The role was deleted after we searched for its grants
+				continue
+			}
+			return totalRowsDeleted, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to look up role %s", roleId)))
+		}
+		rowsDeleted, err := r.DeleteRoleGrants(ctx, roleId, role.Version, grants)
+		if err != nil {
+			return totalRowsDeleted, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to delete expired grants for role %s", roleId)))
+		}
+		totalRowsDeleted += rowsDeleted
+	}
+	return totalRowsDeleted, nil
+}
+
 // ListRoleGrantScopes returns the grant scopes for the roleId and supports the WithLimit
 // option.
 func (r *Repository) ListRoleGrantScopes(ctx context.Context, roleId string, opt ...Option) ([]*RoleGrantScope, error) {
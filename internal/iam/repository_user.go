@@ -20,7 +20,8 @@ import (
 	"github.com/hashicorp/go-dbw"
 )
 
-// CreateUser will create a user in the repository and return the written user
+// CreateUser will create a user in the repository and return the written user.
+// Supported options: WithPublicId, WithReaderWriter
 func (r *Repository) CreateUser(ctx context.Context, user *User, opt ...Option) (*User, error) {
 	const op = "iam.(Repository).CreateUser"
 	if user == nil {
@@ -56,7 +57,7 @@ func (r *Repository) CreateUser(ctx context.Context, user *User, opt ...Option)
 	// r.lookupUser(...). I'm adding this comment so a future version of myself
 	// doesn't come along and decide to start using r.lookupUser(...) here which
 	// would just be an unnecessary database lookup.  You're welcome future me.
-	resource, err := r.create(ctx, u)
+	resource, err := r.create(ctx, u, opt...)
 	if err != nil {
 		if errors.IsUniqueError(err) {
 			return nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("user %s already exists in org %s", user.Name, user.ScopeId))
@@ -130,25 +131,104 @@ func (r *Repository) UpdateUser(ctx context.Context, user *User, version uint32,
 	var rowsUpdated int
 	var returnedUser *User
 	var currentAccountIds []string
+	updateFn := func(reader db.Reader, w db.Writer) error {
+		returnedUser = u.Clone().(*User)
+		rowsUpdated, err = w.Update(
+			ctx,
+			returnedUser,
+			dbMask,
+			nullFields,
+			dbOpts...,
+		)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if rowsUpdated > 1 {
+			// return err, which will result in a rollback of the update
+			return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+		}
+		txRepo := &Repository{
+			reader: reader,
+			writer: w,
+			kms:    r.kms,
+			// intentionally not setting the defaultLimit
+		}
+		returnedUser, err = txRepo.lookupUser(ctx, user.PublicId)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve current user after update"))
+		}
+		currentAccountIds, err = txRepo.ListUserAccounts(ctx, user.PublicId)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve current account ids after update"))
+		}
+		return nil
+	}
+	if opts.withReader != nil && opts.withWriter != nil {
+		if !opts.withWriter.IsTx(ctx) {
+			return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.Internal, op, "writer is not in transaction")
+		}
+		err = updateFn(opts.withReader, opts.withWriter)
+	} else {
+		_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, updateFn)
+	}
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("user %s already exists in org %s", user.Name, user.ScopeId))
+		}
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for %s", user.PublicId)))
+	}
+	return returnedUser, currentAccountIds, rowsUpdated, nil
+}
+
+// SetUserAttributes sets the attributes of the user, which are the marshaled
+// attributes of the account the user last authenticated with. An empty
+// attributes string clears the user's attributes. Unlike UpdateUser, the
+// update is not subject to a version check since it is performed when users
+// authenticate.
+func (r *Repository) SetUserAttributes(ctx context.Context, userId, attributes string) (*User, error) {
+	const op = "iam.(Repository).SetUserAttributes"
+	if userId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	var dbMask, nullFields []string
+	switch attributes {
+	case "":
+		nullFields = []string{"Attributes"}
+	default:
+		dbMask = []string{"Attributes"}
+	}
+
+	u := AllocUser()
+	u.PublicId = userId
+	u.Attributes = attributes
+	scope, err := u.GetScope(ctx, r.reader)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get scope"))
+	}
+	u.ScopeId = scope.GetPublicId()
+	metadata, err := r.stdMetadata(ctx, &u)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	metadata["op-type"] = []string{oplog.OpType_OP_TYPE_UPDATE.String()}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scope.GetPublicId(), kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var returnedUser *User
 	_, err = r.writer.DoTx(
 		ctx,
 		db.StdRetryCnt,
 		db.ExpBackoff{},
 		func(reader db.Reader, w db.Writer) error {
-			returnedUser = u.Clone().(*User)
-			rowsUpdated, err = w.Update(
-				ctx,
-				returnedUser,
-				dbMask,
-				nullFields,
-				dbOpts...,
-			)
+			updatedUser := u.Clone().(*User)
+			rowsUpdated, err := w.Update(ctx, updatedUser, dbMask, nullFields, db.WithOplog(oplogWrapper, metadata))
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
-			if rowsUpdated > 1 {
-				// return err, which will result in a rollback of the update
-				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("user %s not found", userId))
 			}
 			txRepo := &Repository{
 				reader: reader,
@@ -156,24 +236,17 @@ func (r *Repository) UpdateUser(ctx context.Context, user *User, version uint32,
 				kms:    r.kms,
 				// intentionally not setting the defaultLimit
 			}
-			returnedUser, err = txRepo.lookupUser(ctx, user.PublicId)
+			returnedUser, err = txRepo.lookupUser(ctx, userId)
 			if err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve current user after update"))
 			}
-			currentAccountIds, err = txRepo.ListUserAccounts(ctx, user.PublicId)
-			if err != nil {
-				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve current account ids after update"))
-			}
 			return nil
 		},
 	)
 	if err != nil {
-		if errors.IsUniqueError(err) {
-			return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("user %s already exists in org %s", user.Name, user.ScopeId))
-		}
-		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for %s", user.PublicId)))
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for %s", userId)))
 	}
-	return returnedUser, currentAccountIds, rowsUpdated, nil
+	return returnedUser, nil
 }
 
 // LookupUser will look up a user and its associated account ids in the
@@ -194,8 +267,9 @@ func (r *Repository) LookupUser(ctx context.Context, userId string, _ ...Option)
 	return user, currentAccountIds, nil
 }
 
-// DeleteUser will delete a user from the repository
-func (r *Repository) DeleteUser(ctx context.Context, withPublicId string, _ ...Option) (int, error) {
+// DeleteUser will delete a user from the repository. WithReaderWriter is the
+// only supported option.
+func (r *Repository) DeleteUser(ctx context.Context, withPublicId string, opt ...Option) (int, error) {
 	const op = "iam.(Repository).DeleteUser"
 	if withPublicId == "" {
 		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
@@ -205,7 +279,7 @@ func (r *Repository) DeleteUser(ctx context.Context, withPublicId string, _ ...O
 	if err := r.reader.LookupByPublicId(ctx, &user); err != nil {
 		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for %s", withPublicId)))
 	}
-	rowsDeleted, err := r.delete(ctx, &user)
+	rowsDeleted, err := r.delete(ctx, &user, opt...)
 	if err != nil {
 		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for %s", withPublicId)))
 	}
@@ -384,9 +458,9 @@ func (r *Repository) ListUserAccounts(ctx context.Context, userId string, opt ..
 
 // AddUserAccounts will associate a user with existing accounts and
 // return a list of all associated account ids for the user. The accounts must
-// not already be associated with different users.  No options are currently
-// supported.
-func (r *Repository) AddUserAccounts(ctx context.Context, userId string, userVersion uint32, accountIds []string, _ ...Option) ([]string, error) {
+// not already be associated with different users. WithReaderWriter is the only
+// supported option.
+func (r *Repository) AddUserAccounts(ctx context.Context, userId string, userVersion uint32, accountIds []string, opt ...Option) ([]string, error) {
 	const op = "iam.(Repository).AddUserAccounts"
 	if userId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
@@ -407,54 +481,58 @@ func (r *Repository) AddUserAccounts(ctx context.Context, userId string, userVer
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
 	}
 
+	opts := getOpts(opt...)
 	var currentAccountIds []string
-	_, err = r.writer.DoTx(
-		ctx,
-		db.StdRetryCnt,
-		db.ExpBackoff{},
-		func(reader db.Reader, w db.Writer) error {
-			userTicket, err := w.GetTicket(ctx, user)
-			if err != nil {
-				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
-			}
-			updatedUser := AllocUser()
-			updatedUser.PublicId = userId
-			updatedUser.Version = userVersion + 1
-			var userOplogMsg oplog.Message
-			rowsUpdated, err := w.Update(ctx, &updatedUser, []string{"Version"}, nil, db.NewOplogMsg(&userOplogMsg), db.WithVersion(&userVersion))
-			if err != nil {
-				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get user version"))
-			}
-			if rowsUpdated != 1 {
-				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated user and %d rows updated", rowsUpdated))
-			}
-			if err := associateUserWithAccounts(ctx, r.kms, reader, w, user.PublicId, accountIds); err != nil {
-				return errors.Wrap(ctx, err, op)
-			}
-			metadata := oplog.Metadata{
-				"op-type":            []string{oplog.OpType_OP_TYPE_UPDATE.String()},
-				"scope-id":           []string{user.ScopeId},
-				"scope-type":         []string{scope.Org.String()},
-				"resource-public-id": []string{user.PublicId},
-			}
-			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, userTicket, metadata, []*oplog.Message{&userOplogMsg}); err != nil {
-				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
-			}
-			// we need a new repo, that's using the same reader/writer as this TxHandler
-			txRepo := &Repository{
-				reader: reader,
-				writer: w,
-				kms:    r.kms,
-				// intentionally not setting the defaultLimit, so we'll get all
-				// the account ids without a limit
-			}
-			currentAccountIds, err = txRepo.ListUserAccounts(ctx, user.PublicId)
-			if err != nil {
-				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve current account ids after adds"))
-			}
-			return nil
-		},
-	)
+	addFn := func(reader db.Reader, w db.Writer) error {
+		userTicket, err := w.GetTicket(ctx, user)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+		}
+		updatedUser := AllocUser()
+		updatedUser.PublicId = userId
+		updatedUser.Version = userVersion + 1
+		var userOplogMsg oplog.Message
+		rowsUpdated, err := w.Update(ctx, &updatedUser, []string{"Version"}, nil, db.NewOplogMsg(&userOplogMsg), db.WithVersion(&userVersion))
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get user version"))
+		}
+		if rowsUpdated != 1 {
+			return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated user and %d rows updated", rowsUpdated))
+		}
+		if err := associateUserWithAccounts(ctx, r.kms, reader, w, user.PublicId, accountIds); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		metadata := oplog.Metadata{
+			"op-type":            []string{oplog.OpType_OP_TYPE_UPDATE.String()},
+			"scope-id":           []string{user.ScopeId},
+			"scope-type":         []string{scope.Org.String()},
+			"resource-public-id": []string{user.PublicId},
+		}
+		if err := w.WriteOplogEntryWith(ctx, oplogWrapper, userTicket, metadata, []*oplog.Message{&userOplogMsg}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+		}
+		// we need a new repo, that's using the same reader/writer as this TxHandler
+		txRepo := &Repository{
+			reader: reader,
+			writer: w,
+			kms:    r.kms,
+			// intentionally not setting the defaultLimit, so we'll get all
+			// the account ids without a limit
+		}
+		currentAccountIds, err = txRepo.ListUserAccounts(ctx, user.PublicId)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve current account ids after adds"))
+		}
+		return nil
+	}
+	if opts.withReader != nil && opts.withWriter != nil {
+		if !opts.withWriter.IsTx(ctx) {
+			return nil, errors.New(ctx, errors.Internal, op, "writer is not in transaction")
+		}
+		err = addFn(opts.withReader, opts.withWriter)
+	} else {
+		_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, addFn)
+	}
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -497,6 +497,47 @@ func TestRepository_UpdateUser(t *testing.T) {
 	}
 }
 
+func TestRepository_SetUserAttributes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	repo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, repo)
+
+	t.Run("missing-user-id", func(t *testing.T) {
+		_, err := repo.SetUserAttributes(ctx, "", `{"department":"eng"}`)
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("not-found", func(t *testing.T) {
+		id, err := db.NewPublicId(ctx, globals.UserPrefix)
+		require.NoError(t, err)
+		_, err = repo.SetUserAttributes(ctx, id, `{"department":"eng"}`)
+		require.Error(t, err)
+	})
+	t.Run("set-and-clear", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		u := iam.TestUser(t, repo, org.PublicId)
+
+		got, err := repo.SetUserAttributes(ctx, u.PublicId, `{"department":"eng"}`)
+		require.NoError(err)
+		assert.Equal(`{"department":"eng"}`, got.Attributes)
+		assert.Equal(u.Version+1, got.Version)
+		err = db.TestVerifyOplog(t, rw, u.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second))
+		require.NoError(err)
+
+		got, err = repo.SetUserAttributes(ctx, u.PublicId, "")
+		require.NoError(err)
+		assert.Empty(got.Attributes)
+
+		found, _, err := repo.LookupUser(ctx, u.PublicId)
+		require.NoError(err)
+		assert.Empty(found.Attributes)
+	})
+}
+
 func TestRepository_DeleteUser(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -5,6 +5,7 @@ package perms
 
 import (
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/types/action"
@@ -29,6 +30,12 @@ type AclGrant struct {
 
 	// The set of output fields granted
 	OutputFields *OutputFields
+
+	// The time window the grant is in effect, if restricted
+	window *timeWindow
+
+	// The filter restricting the resources the grant applies to, if any
+	filter *grantFilter
 }
 
 // Actions returns the actions as a slice from the internal map, along with the
@@ -65,6 +72,7 @@ type Permission struct {
 	ResourceIds []string // Any specific resource ids that have been referred in the grant's `id` field, if applicable.
 	OnlySelf    bool     // The grant only allows actions against the user's own resources.
 	All         bool     // We got a wildcard in the grant string's `id` field.
+	Filtered    bool     // Some of the resources are only granted if they match a grant filter, so each must be checked with Allowed.
 }
 
 // UserPermissions is a set of Permissions for a User.
@@ -88,6 +96,11 @@ type Resource struct {
 	// Pin if defined would constrain the resource within the collection of the
 	// pin id.
 	Pin string `json:"pin,omitempty"`
+
+	// Item is the API representation of the resource, against which grant
+	// filters are evaluated. Grants with filters never match a resource
+	// without an Item.
+	Item any `json:"-"`
 }
 
 // NewACL creates an ACL from the grants provided. Note that this converts the
@@ -119,12 +132,23 @@ func aclGrantFromGrant(grant Grant, id string) AclGrant {
 		typ:          grant.typ,
 		actions:      grant.actions,
 		OutputFields: grant.OutputFields,
+		window:       grant.window,
+		filter:       grant.filter,
 	}
 }
 
 // Allowed determines if the grants for an ACL allow an action for a resource.
+// Grants which are not in effect at the evaluation time are ignored, as are
+// grants whose filter does not match the resource's Item.
+// Supported options:
+//   - WithSkipAnonymousUserRestrictions
+//   - WithEvaluationTime
 func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option) (results ACLResults) {
 	opts := getOpts(opt...)
+	now := opts.withEvaluationTime
+	if now.IsZero() {
+		now = time.Now()
+	}
 
 	// First, get the grants within the specified scope
 	grants := a.scopeMap[r.ScopeId]
@@ -137,6 +161,9 @@ func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option
 	}
 	// Now, go through and check the cases indicated above
 	for _, grant := range grants {
+		if !grant.window.activeAt(now) {
+			continue
+		}
 		var outputFieldsOnly bool
 		switch {
 		case len(grant.actions) == 0:
@@ -262,6 +289,10 @@ func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option
 			found = true
 		}
 
+		if found && !grant.filter.matches(r.Item) {
+			found = false
+		}
+
 		if found {
 			if !outputFieldsOnly {
 				results.Authorized = true
@@ -281,12 +312,25 @@ func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option
 // There must be a grant for a given resource for one of the provided "id actions"
 // or for action.All in order for a Permission to be created for the scope.
 // The set of "id actions" is resource dependant, but will generally include all
-// actions that can be taken on an individual resource.
+// actions that can be taken on an individual resource. Grants which are not in
+// effect at the evaluation time are ignored. Grants with filters are ignored
+// unless WithFilteredGrants is provided, in which case the resulting
+// Permissions are marked Filtered and the caller must check each resource with
+// Allowed.
+// Supported options:
+//   - WithEvaluationTime
+//   - WithFilteredGrants
 func (a ACL) ListPermissions(requestedScopes map[string]*scopes.ScopeInfo,
 	requestedType resource.Type,
 	idActions action.ActionSet,
 	userId string,
+	opt ...Option,
 ) []Permission {
+	opts := getOpts(opt...)
+	now := opts.withEvaluationTime
+	if now.IsZero() {
+		now = time.Now()
+	}
 	perms := make([]Permission, 0, len(requestedScopes))
 	for scopeId := range requestedScopes {
 		p := Permission{
@@ -305,6 +349,12 @@ func (a ACL) ListPermissions(requestedScopes map[string]*scopes.ScopeInfo,
 		// Get grants for a specific scope id from the source of truth.
 		grants := a.scopeMap[scopeId]
 		for _, grant := range grants {
+			if !grant.window.activeAt(now) {
+				continue
+			}
+			if grant.filter != nil && !opts.withFilteredGrants {
+				continue
+			}
 			// This grant doesn't match what we're looking for, ignore.
 			if grant.typ != requestedType && grant.typ != resource.All && globals.ResourceInfoFromPrefix(grant.id).Type != requestedType {
 				continue
@@ -335,6 +385,9 @@ func (a ACL) ListPermissions(requestedScopes map[string]*scopes.ScopeInfo,
 				}
 			}
 			p.OnlySelf = p.OnlySelf && excludeList.OnlySelf()
+			if grant.filter != nil && grant.id != "" {
+				p.Filtered = true
+			}
 
 			switch grant.id {
 			case "*":
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package perms
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
+	"github.com/hashicorp/go-bexpr"
+)
+
+// filterItem is the structure grant filters are evaluated against, so that
+// grant filters use the same "/item/..." namespace as list filters
+type filterItem struct {
+	Item any `json:"item"`
+}
+
+// grantFilter is a boolean expression that restricts the resources a grant
+// applies to, based on the attributes of those resources
+type grantFilter struct {
+	// raw is the filter as provided in the grant
+	raw string
+
+	eval *bexpr.Evaluator
+}
+
+// newGrantFilter compiles the given filter expression
+func newGrantFilter(ctx context.Context, f string) (*grantFilter, error) {
+	const op = "perms.newGrantFilter"
+	switch {
+	case f == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "empty filter provided")
+	case strings.Contains(f, ";"):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "filter cannot contain a semicolon")
+	}
+	e, err := bexpr.CreateEvaluator(f, bexpr.WithTagName("json"), bexpr.WithHookFn(filter.WellKnownTypeFilterHook))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("couldn't build filter"), errors.WithCode(errors.InvalidParameter))
+	}
+	return &grantFilter{raw: f, eval: e}, nil
+}
+
+// matches reports whether the given item satisfies the filter. A nil filter
+// matches everything; a nil item matches no filter. As with list filters, an
+// error evaluating the filter is interpreted as not a match since it usually
+// means the filter refers to fields not present on the item.
+func (f *grantFilter) matches(item any) bool {
+	if f == nil {
+		return true
+	}
+	if item == nil {
+		return false
+	}
+	m, err := f.eval.Evaluate(filterItem{Item: item})
+	return err == nil && m
+}
+
+// String returns the filter as provided in the grant
+func (f *grantFilter) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.raw
+}
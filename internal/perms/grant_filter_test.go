@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package perms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_ParseGrantFilter(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		input         string
+		wantCanonical string
+		wantFilter    string
+		wantErr       string
+	}{
+		{
+			name:          "text",
+			input:         `ids=*;type=target;actions=authorize-session;filter="/item/attributes/env" == "dev"`,
+			wantCanonical: `ids=*;type=target;actions=authorize-session;filter="/item/attributes/env" == "dev"`,
+			wantFilter:    `"/item/attributes/env" == "dev"`,
+		},
+		{
+			name:          "text-filter-first",
+			input:         `filter="/item/name" matches "^dev-";ids=*;type=target;actions=read`,
+			wantCanonical: `ids=*;type=target;actions=read;filter="/item/name" matches "^dev-"`,
+			wantFilter:    `"/item/name" matches "^dev-"`,
+		},
+		{
+			name:          "json",
+			input:         `{"ids":["*"],"type":"target","actions":["read"],"filter":"\"/item/type\" == \"tcp\""}`,
+			wantCanonical: `ids=*;type=target;actions=read;filter="/item/type" == "tcp"`,
+			wantFilter:    `"/item/type" == "tcp"`,
+		},
+		{
+			name:    "empty-filter",
+			input:   `ids=*;type=target;actions=read;filter=`,
+			wantErr: "empty filter provided",
+		},
+		{
+			name:    "bad-filter",
+			input:   `ids=*;type=target;actions=read;filter="/item/name" ===`,
+			wantErr: "couldn't build filter",
+		},
+		{
+			name:    "json-semicolon",
+			input:   `{"ids":["*"],"type":"target","actions":["read"],"filter":"\"/item/name\" == \"a;b\""}`,
+			wantErr: "filter cannot contain a semicolon",
+		},
+		{
+			name:    "json-not-string",
+			input:   `{"ids":["*"],"type":"target","actions":["read"],"filter":["a"]}`,
+			wantErr: `unable to interpret "filter" as string`,
+		},
+		{
+			name:    "collection-grant",
+			input:   `type=target;actions=list;filter="/item/type" == "tcp"`,
+			wantErr: `contains a filter but no "ids" field`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			g, err := Parse(ctx, "p_1234567890", tt.input)
+			if tt.wantErr != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantCanonical, g.CanonicalString())
+			assert.Equal(tt.wantFilter, g.Filter())
+
+			// The canonical string must round trip
+			again, err := Parse(ctx, "p_1234567890", g.CanonicalString())
+			require.NoError(err)
+			assert.Equal(g.CanonicalString(), again.CanonicalString())
+
+			// As must the JSON representation
+			js, err := g.MarshalJSON(ctx)
+			require.NoError(err)
+			again, err = Parse(ctx, "p_1234567890", string(js))
+			require.NoError(err)
+			assert.Equal(g.CanonicalString(), again.CanonicalString())
+		})
+	}
+}
+
+func Test_ACLAllowedGrantFilter(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	item := func(env string) any {
+		attrs, err := structpb.NewStruct(map[string]any{"env": env})
+		require.NoError(t, err)
+		return struct {
+			Type       string           `json:"type"`
+			Attributes *structpb.Struct `json:"attributes"`
+		}{
+			Type:       "tcp",
+			Attributes: attrs,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		grants  []string
+		item    any
+		allowed bool
+	}{
+		{
+			name:    "matching",
+			grants:  []string{`ids=*;type=target;actions=authorize-session;filter="/item/attributes/env" == "dev"`},
+			item:    item("dev"),
+			allowed: true,
+		},
+		{
+			name:    "not-matching",
+			grants:  []string{`ids=*;type=target;actions=authorize-session;filter="/item/attributes/env" == "dev"`},
+			item:    item("prod"),
+			allowed: false,
+		},
+		{
+			name:    "no-item",
+			grants:  []string{`ids=*;type=target;actions=authorize-session;filter="/item/attributes/env" == "dev"`},
+			allowed: false,
+		},
+		{
+			name:    "missing-field",
+			grants:  []string{`ids=*;type=target;actions=authorize-session;filter="/item/attributes/region" == "us"`},
+			item:    item("dev"),
+			allowed: false,
+		},
+		{
+			name: "unfiltered-grant-still-applies",
+			grants: []string{
+				`ids=*;type=target;actions=authorize-session;filter="/item/attributes/env" == "dev"`,
+				`ids=ttcp_1234567890;actions=authorize-session`,
+			},
+			item:    item("prod"),
+			allowed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var grants []Grant
+			for _, gs := range tt.grants {
+				g, err := Parse(ctx, "p_1234567890", gs)
+				require.NoError(t, err)
+				grants = append(grants, g)
+			}
+			acl := NewACL(grants...)
+			r := Resource{ScopeId: "p_1234567890", Id: "ttcp_1234567890", Type: resource.Target, Item: tt.item}
+			res := acl.Allowed(r, action.AuthorizeSession, "u_1234567890")
+			assert.Equal(t, tt.allowed, res.Authorized)
+		})
+	}
+}
+
+func Test_ACLListPermissionsGrantFilter(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	g, err := Parse(ctx, "p_1234567890", `ids=*;type=target;actions=read;filter="/item/type" == "tcp"`)
+	require.NoError(t, err)
+	acl := NewACL(g)
+	scopeInfo := map[string]*scopes.ScopeInfo{"p_1234567890": {Id: "p_1234567890", Type: scope.Project.String()}}
+
+	// Filtered grants are not included by default
+	perms := acl.ListPermissions(scopeInfo, resource.Target, action.NewActionSet(action.Read), "u_1234567890")
+	assert.Empty(t, perms)
+
+	perms = acl.ListPermissions(scopeInfo, resource.Target, action.NewActionSet(action.Read), "u_1234567890", WithFilteredGrants(true))
+	require.Len(t, perms, 1)
+	assert.True(t, perms[0].All)
+	assert.True(t, perms[0].Filtered)
+}
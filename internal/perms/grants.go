@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/hashicorp/boundary/globals"
@@ -75,6 +76,12 @@ type Grant struct {
 	// The set of output fields granted
 	OutputFields *OutputFields
 
+	// The time window the grant is in effect, if restricted
+	window *timeWindow
+
+	// The filter restricting the resources the grant applies to, if any
+	filter *grantFilter
+
 	// This is used as a temporary staging area before validating permissions to
 	// allow the same validation code across grant string formats
 	actionsBeingParsed []string
@@ -101,6 +108,34 @@ func (g Grant) Actions() ([]action.Type, []string) {
 	return g.actions.Actions()
 }
 
+// ValidUntil returns the time the grant stops being in effect, or the zero
+// time if the grant does not expire
+func (g Grant) ValidUntil() time.Time {
+	if g.window == nil {
+		return time.Time{}
+	}
+	return g.window.validUntil
+}
+
+// ExpiredAt reports whether the grant has permanently stopped being in effect
+// by the given time. Grants which are only outside of their days or hours
+// are not expired.
+func (g Grant) ExpiredAt(t time.Time) bool {
+	return g.window.expiredAt(t)
+}
+
+// ActiveAt reports whether the grant's time window, if any, places it in
+// effect at the given time
+func (g Grant) ActiveAt(t time.Time) bool {
+	return g.window.activeAt(t)
+}
+
+// Filter returns the filter restricting the resources the grant applies to,
+// or an empty string if the grant is not filtered
+func (g Grant) Filter() string {
+	return g.filter.String()
+}
+
 // hasActionOrSubaction checks whether a grant's action set contains the given
 // action or contains an action that is a subaction of the passed-in parameter.
 // This is used for validation checking of parsed grants. N.B.: this is the
@@ -117,10 +152,12 @@ func (g Grant) hasActionOrSubaction(act action.Type) bool {
 
 func (g Grant) clone() *Grant {
 	ret := &Grant{
-		scope: g.scope,
-		id:    g.id,
-		ids:   g.ids,
-		typ:   g.typ,
+		scope:  g.scope,
+		id:     g.id,
+		ids:    g.ids,
+		typ:    g.typ,
+		window: g.window.clone(),
+		filter: g.filter,
 	}
 	if g.ids != nil {
 		ret.ids = make([]string, len(g.ids))
@@ -174,6 +211,12 @@ func (g Grant) CanonicalString() string {
 		builder = append(builder, fmt.Sprintf("output_fields=%s", strings.Join(outFields, ",")))
 	}
 
+	builder = append(builder, g.window.canonicalSegments()...)
+
+	if g.filter != nil {
+		builder = append(builder, fmt.Sprintf("filter=%s", g.filter.String()))
+	}
+
 	return strings.Join(builder, ";")
 }
 
@@ -201,6 +244,23 @@ func (g Grant) MarshalJSON(ctx context.Context) ([]byte, error) {
 	if outFields, hasSetFields := g.OutputFields.Fields(); hasSetFields {
 		res["output_fields"] = outFields
 	}
+	if g.window != nil {
+		if !g.window.validFrom.IsZero() {
+			res["valid_from"] = g.window.validFrom.Format(time.RFC3339)
+		}
+		if !g.window.validUntil.IsZero() {
+			res["valid_until"] = g.window.validUntil.Format(time.RFC3339)
+		}
+		if days := g.window.dayStrings(); len(days) > 0 {
+			res["days"] = days
+		}
+		if g.window.hours {
+			res["hours"] = g.window.hoursString()
+		}
+	}
+	if g.filter != nil {
+		res["filter"] = g.filter.String()
+	}
 	b, err := json.Marshal(res)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
@@ -307,6 +367,72 @@ func (g *Grant) unmarshalJSON(ctx context.Context, data []byte) error {
 			g.OutputFields = g.OutputFields.AddFields(fields)
 		}
 	}
+	for _, k := range []string{"valid_from", "valid_until", "hours"} {
+		rawVal, ok := raw[k]
+		if !ok {
+			continue
+		}
+		val, ok := rawVal.(string)
+		if !ok {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q as string", k))
+		}
+		if err := g.setWindowField(ctx, k, val); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	if rawDays, ok := raw["days"]; ok {
+		interfaceDays, ok := rawDays.([]any)
+		if !ok {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q as array", "days"))
+		}
+		days := make([]string, 0, len(interfaceDays))
+		for _, v := range interfaceDays {
+			day, ok := v.(string)
+			if !ok {
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %v in days array as string", v))
+			}
+			days = append(days, day)
+		}
+		if g.window == nil {
+			g.window = new(timeWindow)
+		}
+		if err := g.window.setDays(ctx, days); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	if rawFilter, ok := raw["filter"]; ok {
+		f, ok := rawFilter.(string)
+		if !ok {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q as string", "filter"))
+		}
+		var err error
+		if g.filter, err = newGrantFilter(ctx, f); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	return nil
+}
+
+// setWindowField parses a single-valued time window field of the grant
+func (g *Grant) setWindowField(ctx context.Context, key, value string) error {
+	const op = "perms.(Grant).setWindowField"
+	if g.window == nil {
+		g.window = new(timeWindow)
+	}
+	var err error
+	switch key {
+	case "valid_from":
+		err = g.window.setValidFrom(ctx, value)
+	case "valid_until":
+		err = g.window.setValidUntil(ctx, value)
+	case "hours":
+		err = g.window.setHours(ctx, value)
+	default:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown time window field %q", key))
+	}
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
 	return nil
 }
 
@@ -314,6 +440,16 @@ func (g *Grant) unmarshalText(ctx context.Context, grantString string) error {
 	const op = "perms.(Grant).unmarshalText"
 	segments := strings.Split(grantString, ";")
 	for _, segment := range segments {
+		// Filters contain equal signs of their own so they are handled before
+		// splitting the segment
+		if f, ok := strings.CutPrefix(segment, "filter="); ok {
+			var err error
+			if g.filter, err = newGrantFilter(ctx, f); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			continue
+		}
+
 		kv := strings.Split(segment, "=")
 
 		// Ensure we don't accept "foo=bar=baz", "=foo", or "foo="
@@ -367,6 +503,19 @@ func (g *Grant) unmarshalText(ctx context.Context, grantString string) error {
 			default:
 				g.OutputFields = g.OutputFields.AddFields(strings.Split(kv[1], ","))
 			}
+
+		case "valid_from", "valid_until", "hours":
+			if err := g.setWindowField(ctx, kv[0], kv[1]); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
+		case "days":
+			if g.window == nil {
+				g.window = new(timeWindow)
+			}
+			if err := g.window.setDays(ctx, strings.Split(kv[1], ",")); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 		}
 	}
 
@@ -422,6 +571,14 @@ func Parse(ctx context.Context, scopeId, grantString string, opt ...Option) (Gra
 	if len(grant.ids) > 1 && slices.Contains(grant.ids, "*") {
 		return Grant{}, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("input grant string %q contains both wildcard and non-wildcard values in %q field", grantString, "ids"))
 	}
+	if err := grant.window.validate(ctx); err != nil {
+		return Grant{}, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("input grant string %q contains an invalid time window", grantString)))
+	}
+	// Filters are evaluated against individual resources, so they cannot be
+	// used with collection grants
+	if grant.filter != nil && grant.id == "" && len(grant.ids) == 0 {
+		return Grant{}, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("input grant string %q contains a filter but no %q field", grantString, "ids"))
+	}
 
 	opts := getOpts(opt...)
 
@@ -588,8 +745,13 @@ func Parse(ctx context.Context, scopeId, grantString string, opt ...Option) (Gra
 				// Create a dummy resource and pass it through Allowed and
 				// ensure that we get allowed. We need to use the templated
 				// grant, if any, so we send in a clone with an updated ID.
+				// The time window and filter are removed so that a grant
+				// which is not currently in effect, or that only applies to
+				// some resources, can still be validated.
 				grantForValidation := grant.clone()
 				grantForValidation.id = grantIds[i]
+				grantForValidation.window = nil
+				grantForValidation.filter = nil
 				acl := NewACL(*grantForValidation)
 				r := Resource{
 					ScopeId: scopeId,
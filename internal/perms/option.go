@@ -3,6 +3,8 @@
 
 package perms
 
+import "time"
+
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -23,6 +25,8 @@ type options struct {
 	withAccountId                     string
 	withSkipFinalValidation           bool
 	withSkipAnonymousUserRestrictions bool
+	withEvaluationTime                time.Time
+	withFilteredGrants                bool
 }
 
 func getDefaultOptions() options {
@@ -60,3 +64,19 @@ func WithSkipAnonymousUserRestrictions(with bool) Option {
 		o.withSkipAnonymousUserRestrictions = with
 	}
 }
+
+// WithEvaluationTime sets the time at which the time windows of grants are
+// evaluated. If not provided, the current time is used.
+func WithEvaluationTime(t time.Time) Option {
+	return func(o *options) {
+		o.withEvaluationTime = t
+	}
+}
+
+// WithFilteredGrants causes ListPermissions to include grants with filters,
+// marking the resulting Permissions as Filtered
+func WithFilteredGrants(with bool) Option {
+	return func(o *options) {
+		o.withFilteredGrants = with
+	}
+}
@@ -43,4 +43,11 @@ func Test_GetOpts(t *testing.T) {
 		opts = getOpts(WithSkipAnonymousUserRestrictions(true))
 		assert.True(opts.withSkipAnonymousUserRestrictions)
 	})
+	t.Run("with-filtered-grants", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts()
+		assert.False(opts.withFilteredGrants)
+		opts = getOpts(WithFilteredGrants(true))
+		assert.True(opts.withFilteredGrants)
+	})
 }
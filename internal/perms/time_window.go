@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package perms
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// weekdayNames maps the values accepted in a grant's days field to the
+// equivalent weekday
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// timeWindow restricts when a grant is in effect. All fields are optional; a
+// zero timeWindow is always active. All times are evaluated in UTC.
+type timeWindow struct {
+	// validFrom, if set, is the time the grant becomes active
+	validFrom time.Time
+
+	// validUntil, if set, is the time the grant stops being active
+	validUntil time.Time
+
+	// days, if set, is the set of days of the week the grant is active
+	days map[time.Weekday]bool
+
+	// hours, if set, is the time of day range the grant is active, stored as
+	// minutes since midnight. If hoursStart is after hoursEnd the range wraps
+	// around midnight.
+	hours      bool
+	hoursStart int
+	hoursEnd   int
+}
+
+// isZero reports whether the time window places no restrictions on the grant
+func (w *timeWindow) isZero() bool {
+	return w == nil ||
+		(w.validFrom.IsZero() && w.validUntil.IsZero() && len(w.days) == 0 && !w.hours)
+}
+
+// activeAt reports whether a grant with this time window is in effect at t
+func (w *timeWindow) activeAt(t time.Time) bool {
+	if w.isZero() {
+		return true
+	}
+	t = t.UTC()
+	if !w.validFrom.IsZero() && t.Before(w.validFrom) {
+		return false
+	}
+	if !w.validUntil.IsZero() && !t.Before(w.validUntil) {
+		return false
+	}
+	if len(w.days) > 0 && !w.days[t.Weekday()] {
+		return false
+	}
+	if w.hours {
+		m := t.Hour()*60 + t.Minute()
+		switch {
+		case w.hoursStart < w.hoursEnd:
+			if m < w.hoursStart || m >= w.hoursEnd {
+				return false
+			}
+		default:
+			// The range wraps around midnight, e.g. 22:00-06:00
+			if m < w.hoursStart && m >= w.hoursEnd {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// expiredAt reports whether the time window has permanently ended by t
+func (w *timeWindow) expiredAt(t time.Time) bool {
+	return w != nil && !w.validUntil.IsZero() && !t.Before(w.validUntil)
+}
+
+func (w *timeWindow) clone() *timeWindow {
+	if w == nil {
+		return nil
+	}
+	ret := *w
+	if w.days != nil {
+		ret.days = make(map[time.Weekday]bool, len(w.days))
+		for k, v := range w.days {
+			ret.days[k] = v
+		}
+	}
+	return &ret
+}
+
+// dayStrings returns the days of the time window in week order
+func (w *timeWindow) dayStrings() []string {
+	if w == nil || len(w.days) == 0 {
+		return nil
+	}
+	days := make([]time.Weekday, 0, len(w.days))
+	for d := range w.days {
+		days = append(days, d)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i] < days[j] })
+	ret := make([]string, 0, len(days))
+	for _, d := range days {
+		ret = append(ret, strings.ToLower(d.String()[:3]))
+	}
+	return ret
+}
+
+// hoursString returns the time of day range of the time window in HH:MM-HH:MM
+// format
+func (w *timeWindow) hoursString() string {
+	if w == nil || !w.hours {
+		return ""
+	}
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", w.hoursStart/60, w.hoursStart%60, w.hoursEnd/60, w.hoursEnd%60)
+}
+
+// canonicalSegments returns the grant string segments describing the time
+// window
+func (w *timeWindow) canonicalSegments() []string {
+	if w.isZero() {
+		return nil
+	}
+	var ret []string
+	if !w.validFrom.IsZero() {
+		ret = append(ret, fmt.Sprintf("valid_from=%s", w.validFrom.Format(time.RFC3339)))
+	}
+	if !w.validUntil.IsZero() {
+		ret = append(ret, fmt.Sprintf("valid_until=%s", w.validUntil.Format(time.RFC3339)))
+	}
+	if days := w.dayStrings(); len(days) > 0 {
+		ret = append(ret, fmt.Sprintf("days=%s", strings.Join(days, ",")))
+	}
+	if w.hours {
+		ret = append(ret, fmt.Sprintf("hours=%s", w.hoursString()))
+	}
+	return ret
+}
+
+// setValidFrom parses and sets the time the grant becomes active
+func (w *timeWindow) setValidFrom(ctx context.Context, s string) error {
+	const op = "perms.(timeWindow).setValidFrom"
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to parse %q as an RFC 3339 timestamp", s))
+	}
+	w.validFrom = t.UTC()
+	return nil
+}
+
+// setValidUntil parses and sets the time the grant stops being active
+func (w *timeWindow) setValidUntil(ctx context.Context, s string) error {
+	const op = "perms.(timeWindow).setValidUntil"
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to parse %q as an RFC 3339 timestamp", s))
+	}
+	w.validUntil = t.UTC()
+	return nil
+}
+
+// setDays parses and sets the days of the week the grant is active
+func (w *timeWindow) setDays(ctx context.Context, days []string) error {
+	const op = "perms.(timeWindow).setDays"
+	if len(days) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no days provided")
+	}
+	w.days = make(map[time.Weekday]bool, len(days))
+	for _, d := range days {
+		wd, ok := weekdayNames[strings.ToLower(d)]
+		if !ok {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown day %q", d))
+		}
+		w.days[wd] = true
+	}
+	return nil
+}
+
+// setHours parses and sets the time of day range the grant is active, in
+// HH:MM-HH:MM format
+func (w *timeWindow) setHours(ctx context.Context, s string) error {
+	const op = "perms.(timeWindow).setHours"
+	start, end, ok := strings.Cut(s, "-")
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("hours %q not in HH:MM-HH:MM format", s))
+	}
+	var err error
+	if w.hoursStart, err = parseMinuteOfDay(start); err != nil {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("hours %q not in HH:MM-HH:MM format", s))
+	}
+	if w.hoursEnd, err = parseMinuteOfDay(end); err != nil {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("hours %q not in HH:MM-HH:MM format", s))
+	}
+	if w.hoursStart == w.hoursEnd {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("hours %q has the same start and end time", s))
+	}
+	w.hours = true
+	return nil
+}
+
+// validate checks that the time window is coherent
+func (w *timeWindow) validate(ctx context.Context) error {
+	const op = "perms.(timeWindow).validate"
+	if w == nil {
+		return nil
+	}
+	if !w.validFrom.IsZero() && !w.validUntil.IsZero() && !w.validUntil.After(w.validFrom) {
+		return errors.New(ctx, errors.InvalidParameter, op, "valid_until must be after valid_from")
+	}
+	return nil
+}
+
+func parseMinuteOfDay(s string) (int, error) {
+	h, m, ok := strings.Cut(s, ":")
+	if !ok || len(h) != 2 || len(m) != 2 {
+		return 0, fmt.Errorf("invalid time of day %q", s)
+	}
+	hour, err := strconv.Atoi(h)
+	if err != nil || hour < 0 || hour > 23 {
+		return 0, fmt.Errorf("invalid hour in %q", s)
+	}
+	minute, err := strconv.Atoi(m)
+	if err != nil || minute < 0 || minute > 59 {
+		return 0, fmt.Errorf("invalid minute in %q", s)
+	}
+	return hour*60 + minute, nil
+}
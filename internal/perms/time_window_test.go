@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package perms
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseTimeWindow(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		input         string
+		wantCanonical string
+		wantErr       string
+	}{
+		{
+			name:          "text",
+			input:         "ids=*;type=target;actions=read;valid_from=2024-01-01T00:00:00Z;valid_until=2024-02-01T00:00:00Z;days=fri,mon;hours=09:00-17:30",
+			wantCanonical: "ids=*;type=target;actions=read;valid_from=2024-01-01T00:00:00Z;valid_until=2024-02-01T00:00:00Z;days=mon,fri;hours=09:00-17:30",
+		},
+		{
+			name:          "text-converted-to-utc",
+			input:         "ids=*;type=target;actions=read;valid_until=2024-02-01T02:00:00+02:00",
+			wantCanonical: "ids=*;type=target;actions=read;valid_until=2024-02-01T00:00:00Z",
+		},
+		{
+			name:          "json",
+			input:         `{"ids":["*"],"type":"target","actions":["read"],"valid_until":"2024-02-01T00:00:00Z","days":["sat","sun"],"hours":"22:00-06:00"}`,
+			wantCanonical: "ids=*;type=target;actions=read;valid_until=2024-02-01T00:00:00Z;days=sun,sat;hours=22:00-06:00",
+		},
+		{
+			name:    "bad-timestamp",
+			input:   "ids=*;type=target;actions=read;valid_until=tomorrow",
+			wantErr: `unable to parse "tomorrow" as an RFC 3339 timestamp`,
+		},
+		{
+			name:    "bad-day",
+			input:   "ids=*;type=target;actions=read;days=mon,funday",
+			wantErr: `unknown day "funday"`,
+		},
+		{
+			name:    "bad-hours-format",
+			input:   "ids=*;type=target;actions=read;hours=9-17",
+			wantErr: `hours "9-17" not in HH:MM-HH:MM format`,
+		},
+		{
+			name:    "bad-hours-range",
+			input:   "ids=*;type=target;actions=read;hours=24:00-06:00",
+			wantErr: `hours "24:00-06:00" not in HH:MM-HH:MM format`,
+		},
+		{
+			name:    "empty-hours-range",
+			input:   "ids=*;type=target;actions=read;hours=09:00-09:00",
+			wantErr: `hours "09:00-09:00" has the same start and end time`,
+		},
+		{
+			name:    "until-before-from",
+			input:   "ids=*;type=target;actions=read;valid_from=2024-02-01T00:00:00Z;valid_until=2024-01-01T00:00:00Z",
+			wantErr: "valid_until must be after valid_from",
+		},
+		{
+			name:    "json-bad-days",
+			input:   `{"ids":["*"],"type":"target","actions":["read"],"days":"mon"}`,
+			wantErr: `unable to interpret "days" as array`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			g, err := Parse(ctx, "p_1234567890", tt.input)
+			if tt.wantErr != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantCanonical, g.CanonicalString())
+
+			// The canonical string must round trip
+			again, err := Parse(ctx, "p_1234567890", g.CanonicalString())
+			require.NoError(err)
+			assert.Equal(g.CanonicalString(), again.CanonicalString())
+
+			// As must the JSON representation
+			js, err := g.MarshalJSON(ctx)
+			require.NoError(err)
+			again, err = Parse(ctx, "p_1234567890", string(js))
+			require.NoError(err)
+			assert.Equal(g.CanonicalString(), again.CanonicalString())
+		})
+	}
+}
+
+func Test_ACLAllowedTimeWindow(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// 2024-01-03 is a Wednesday
+	wednesday := func(hour, min int) time.Time {
+		return time.Date(2024, time.January, 3, hour, min, 0, 0, time.UTC)
+	}
+	saturday := time.Date(2024, time.January, 6, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		grant   string
+		at      time.Time
+		allowed bool
+	}{
+		{
+			name:    "no-window",
+			grant:   "ids=*;type=target;actions=read",
+			at:      wednesday(12, 0),
+			allowed: true,
+		},
+		{
+			name:    "before-valid-from",
+			grant:   "ids=*;type=target;actions=read;valid_from=2024-01-04T00:00:00Z",
+			at:      wednesday(12, 0),
+			allowed: false,
+		},
+		{
+			name:    "after-valid-from",
+			grant:   "ids=*;type=target;actions=read;valid_from=2024-01-03T00:00:00Z",
+			at:      wednesday(12, 0),
+			allowed: true,
+		},
+		{
+			name:    "at-valid-until",
+			grant:   "ids=*;type=target;actions=read;valid_until=2024-01-03T12:00:00Z",
+			at:      wednesday(12, 0),
+			allowed: false,
+		},
+		{
+			name:    "before-valid-until",
+			grant:   "ids=*;type=target;actions=read;valid_until=2024-01-03T12:00:00Z",
+			at:      wednesday(11, 59),
+			allowed: true,
+		},
+		{
+			name:    "on-allowed-day",
+			grant:   "ids=*;type=target;actions=read;days=mon,tue,wed,thu,fri",
+			at:      wednesday(12, 0),
+			allowed: true,
+		},
+		{
+			name:    "on-disallowed-day",
+			grant:   "ids=*;type=target;actions=read;days=mon,tue,wed,thu,fri",
+			at:      saturday,
+			allowed: false,
+		},
+		{
+			name:    "within-hours",
+			grant:   "ids=*;type=target;actions=read;hours=09:00-17:00",
+			at:      wednesday(9, 0),
+			allowed: true,
+		},
+		{
+			name:    "at-end-of-hours",
+			grant:   "ids=*;type=target;actions=read;hours=09:00-17:00",
+			at:      wednesday(17, 0),
+			allowed: false,
+		},
+		{
+			name:    "within-wrapping-hours",
+			grant:   "ids=*;type=target;actions=read;hours=22:00-06:00",
+			at:      wednesday(2, 30),
+			allowed: true,
+		},
+		{
+			name:    "outside-wrapping-hours",
+			grant:   "ids=*;type=target;actions=read;hours=22:00-06:00",
+			at:      wednesday(12, 0),
+			allowed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := Parse(ctx, "p_1234567890", tt.grant)
+			require.NoError(t, err)
+			acl := NewACL(g)
+			r := Resource{ScopeId: "p_1234567890", Id: "ttcp_1234567890", Type: resource.Target}
+			res := acl.Allowed(r, action.Read, "u_1234567890", WithEvaluationTime(tt.at))
+			assert.Equal(t, tt.allowed, res.Authorized)
+
+			perms := acl.ListPermissions(map[string]*scopes.ScopeInfo{"p_1234567890": {Id: "p_1234567890", Type: scope.Project.String()}},
+				resource.Target, action.NewActionSet(action.Read), "u_1234567890", WithEvaluationTime(tt.at))
+			assert.Equal(t, tt.allowed, len(perms) > 0)
+		})
+	}
+}
+
+func TestGrant_ExpiredAt(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now()
+
+	g, err := Parse(ctx, "p_1234567890", "ids=*;type=target;actions=read")
+	require.NoError(t, err)
+	assert.False(t, g.ExpiredAt(now))
+	assert.True(t, g.ValidUntil().IsZero())
+
+	g, err = Parse(ctx, "p_1234567890", "ids=*;type=target;actions=read;valid_until="+now.Add(-time.Minute).Format(time.RFC3339))
+	require.NoError(t, err)
+	assert.True(t, g.ExpiredAt(now))
+	assert.False(t, g.ValidUntil().IsZero())
+
+	g, err = Parse(ctx, "p_1234567890", "ids=*;type=target;actions=read;valid_until="+now.Add(time.Hour).Format(time.RFC3339))
+	require.NoError(t, err)
+	assert.False(t, g.ExpiredAt(now))
+
+	// Grants outside of their hours are not expired
+	g, err = Parse(ctx, "p_1234567890", "ids=*;type=target;actions=read;hours=00:00-00:01")
+	require.NoError(t, err)
+	assert.False(t, g.ExpiredAt(now))
+	assert.False(t, g.ActiveAt(time.Date(2024, time.January, 3, 12, 0, 0, 0, time.UTC)))
+	assert.True(t, g.ActiveAt(time.Date(2024, time.January, 3, 0, 0, 30, 0, time.UTC)))
+}
@@ -11,8 +11,9 @@ import (
 )
 
 var (
-	_ plgpb.HostPluginServiceClient    = (*WrappingPluginHostClient)(nil)
-	_ plgpb.StoragePluginServiceClient = (*WrappingPluginStorageClient)(nil)
+	_ plgpb.HostPluginServiceClient       = (*WrappingPluginHostClient)(nil)
+	_ plgpb.StoragePluginServiceClient    = (*WrappingPluginStorageClient)(nil)
+	_ plgpb.CredentialPluginServiceClient = (*WrappingPluginCredentialClient)(nil)
 )
 
 // WrappingPluginHostClient provides a wrapper around a Server implementation that
@@ -106,3 +107,37 @@ func (tpc *WrappingPluginStorageClient) PutObject(ctx context.Context, req *plgp
 func (tpc *WrappingPluginStorageClient) DeleteObjects(ctx context.Context, req *plgpb.DeleteObjectsRequest, opts ...grpc.CallOption) (*plgpb.DeleteObjectsResponse, error) {
 	return tpc.Server.DeleteObjects(ctx, req)
 }
+
+// WrappingPluginCredentialClient provides a wrapper around a Server implementation that
+// can be used when loading a plugin in-memory. Supports CredentialPluginServiceClient
+type WrappingPluginCredentialClient struct {
+	Server plgpb.CredentialPluginServiceServer
+}
+
+func NewWrappingPluginCredentialClient(s plgpb.CredentialPluginServiceServer) *WrappingPluginCredentialClient {
+	return &WrappingPluginCredentialClient{Server: s}
+}
+
+func (tpc *WrappingPluginCredentialClient) OnCreateCredentialStore(ctx context.Context, req *plgpb.OnCreateCredentialStoreRequest, opts ...grpc.CallOption) (*plgpb.OnCreateCredentialStoreResponse, error) {
+	return tpc.Server.OnCreateCredentialStore(ctx, req)
+}
+
+func (tpc *WrappingPluginCredentialClient) OnUpdateCredentialStore(ctx context.Context, req *plgpb.OnUpdateCredentialStoreRequest, opts ...grpc.CallOption) (*plgpb.OnUpdateCredentialStoreResponse, error) {
+	return tpc.Server.OnUpdateCredentialStore(ctx, req)
+}
+
+func (tpc *WrappingPluginCredentialClient) OnDeleteCredentialStore(ctx context.Context, req *plgpb.OnDeleteCredentialStoreRequest, opts ...grpc.CallOption) (*plgpb.OnDeleteCredentialStoreResponse, error) {
+	return tpc.Server.OnDeleteCredentialStore(ctx, req)
+}
+
+func (tpc *WrappingPluginCredentialClient) IssueCredential(ctx context.Context, req *plgpb.IssueCredentialRequest, opts ...grpc.CallOption) (*plgpb.IssueCredentialResponse, error) {
+	return tpc.Server.IssueCredential(ctx, req)
+}
+
+func (tpc *WrappingPluginCredentialClient) RenewCredential(ctx context.Context, req *plgpb.RenewCredentialRequest, opts ...grpc.CallOption) (*plgpb.RenewCredentialResponse, error) {
+	return tpc.Server.RenewCredential(ctx, req)
+}
+
+func (tpc *WrappingPluginCredentialClient) RevokeCredential(ctx context.Context, req *plgpb.RevokeCredentialRequest, opts ...grpc.CallOption) (*plgpb.RevokeCredentialResponse, error) {
+	return tpc.Server.RevokeCredential(ctx, req)
+}
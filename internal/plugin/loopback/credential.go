@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package loopback
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ plgpb.CredentialPluginServiceServer = (*TestPluginCredentialServer)(nil)
+
+// TestPluginCredentialServer provides a credential plugin service server where each method can be overwritten for tests.
+type TestPluginCredentialServer struct {
+	OnCreateCredentialStoreFn func(context.Context, *plgpb.OnCreateCredentialStoreRequest) (*plgpb.OnCreateCredentialStoreResponse, error)
+	OnUpdateCredentialStoreFn func(context.Context, *plgpb.OnUpdateCredentialStoreRequest) (*plgpb.OnUpdateCredentialStoreResponse, error)
+	OnDeleteCredentialStoreFn func(context.Context, *plgpb.OnDeleteCredentialStoreRequest) (*plgpb.OnDeleteCredentialStoreResponse, error)
+	IssueCredentialFn         func(context.Context, *plgpb.IssueCredentialRequest) (*plgpb.IssueCredentialResponse, error)
+	RenewCredentialFn         func(context.Context, *plgpb.RenewCredentialRequest) (*plgpb.RenewCredentialResponse, error)
+	RevokeCredentialFn        func(context.Context, *plgpb.RevokeCredentialRequest) (*plgpb.RevokeCredentialResponse, error)
+	plgpb.UnimplementedCredentialPluginServiceServer
+}
+
+func (t TestPluginCredentialServer) OnCreateCredentialStore(ctx context.Context, req *plgpb.OnCreateCredentialStoreRequest) (*plgpb.OnCreateCredentialStoreResponse, error) {
+	if t.OnCreateCredentialStoreFn == nil {
+		return t.UnimplementedCredentialPluginServiceServer.OnCreateCredentialStore(ctx, req)
+	}
+	return t.OnCreateCredentialStoreFn(ctx, req)
+}
+
+func (t TestPluginCredentialServer) OnUpdateCredentialStore(ctx context.Context, req *plgpb.OnUpdateCredentialStoreRequest) (*plgpb.OnUpdateCredentialStoreResponse, error) {
+	if t.OnUpdateCredentialStoreFn == nil {
+		return t.UnimplementedCredentialPluginServiceServer.OnUpdateCredentialStore(ctx, req)
+	}
+	return t.OnUpdateCredentialStoreFn(ctx, req)
+}
+
+func (t TestPluginCredentialServer) OnDeleteCredentialStore(ctx context.Context, req *plgpb.OnDeleteCredentialStoreRequest) (*plgpb.OnDeleteCredentialStoreResponse, error) {
+	if t.OnDeleteCredentialStoreFn == nil {
+		return t.UnimplementedCredentialPluginServiceServer.OnDeleteCredentialStore(ctx, req)
+	}
+	return t.OnDeleteCredentialStoreFn(ctx, req)
+}
+
+func (t TestPluginCredentialServer) IssueCredential(ctx context.Context, req *plgpb.IssueCredentialRequest) (*plgpb.IssueCredentialResponse, error) {
+	if t.IssueCredentialFn == nil {
+		return t.UnimplementedCredentialPluginServiceServer.IssueCredential(ctx, req)
+	}
+	return t.IssueCredentialFn(ctx, req)
+}
+
+func (t TestPluginCredentialServer) RenewCredential(ctx context.Context, req *plgpb.RenewCredentialRequest) (*plgpb.RenewCredentialResponse, error) {
+	if t.RenewCredentialFn == nil {
+		return t.UnimplementedCredentialPluginServiceServer.RenewCredential(ctx, req)
+	}
+	return t.RenewCredentialFn(ctx, req)
+}
+
+func (t TestPluginCredentialServer) RevokeCredential(ctx context.Context, req *plgpb.RevokeCredentialRequest) (*plgpb.RevokeCredentialResponse, error) {
+	if t.RevokeCredentialFn == nil {
+		return t.UnimplementedCredentialPluginServiceServer.RevokeCredential(ctx, req)
+	}
+	return t.RevokeCredentialFn(ctx, req)
+}
+
+const (
+	// loopbackCredentialSecretAttrField is the credential library attribute
+	// holding the secret data the loopback plugin returns when issuing a
+	// credential from the library.
+	loopbackCredentialSecretAttrField = "secret"
+
+	// loopbackCredentialLeaseAttrField is the credential library attribute
+	// holding the lease duration, in seconds, of credentials issued from the
+	// library. Credentials with a lease are renewable.
+	loopbackCredentialLeaseAttrField = "lease_duration_seconds"
+)
+
+// LoopbackCredentialInfo records a credential issued by the loopback
+// credential plugin.
+type LoopbackCredentialInfo struct {
+	LibraryId  string
+	SessionId  string
+	Renewals   int
+	Revoked    bool
+	Renewable  bool
+	LeaseInSec int64
+}
+
+// LoopbackCredential provides a credential plugin with functionality useful
+// for certain kinds of testing. It issues the secret stored in the
+// "secret" attribute of a credential library and records the renewals and
+// revocations of the credentials it issued.
+type LoopbackCredential struct {
+	mu     sync.Mutex
+	issued map[string]*LoopbackCredentialInfo
+}
+
+// IssuedCredentials returns a copy of the credentials issued by the plugin
+// keyed by external id.
+func (l *LoopbackCredential) IssuedCredentials() map[string]LoopbackCredentialInfo {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ret := make(map[string]LoopbackCredentialInfo, len(l.issued))
+	for k, v := range l.issued {
+		ret[k] = *v
+	}
+	return ret
+}
+
+func (l *LoopbackCredential) onCreateCredentialStore(ctx context.Context, req *plgpb.OnCreateCredentialStoreRequest) (*plgpb.OnCreateCredentialStoreResponse, error) {
+	const op = "loopback.(loopbackPlugin).onCreateCredentialStore"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	if store := req.GetStore(); store != nil {
+		if secrets := store.GetSecrets(); secrets != nil {
+			return &plgpb.OnCreateCredentialStoreResponse{
+				Persisted: &plgpb.CredentialStorePersisted{
+					Secrets: secrets,
+				},
+			}, nil
+		}
+	}
+	return &plgpb.OnCreateCredentialStoreResponse{}, nil
+}
+
+func (l *LoopbackCredential) onUpdateCredentialStore(ctx context.Context, req *plgpb.OnUpdateCredentialStoreRequest) (*plgpb.OnUpdateCredentialStoreResponse, error) {
+	const op = "loopback.(loopbackPlugin).onUpdateCredentialStore"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	if store := req.GetNewStore(); store != nil {
+		if secrets := store.GetSecrets(); secrets != nil {
+			return &plgpb.OnUpdateCredentialStoreResponse{
+				Persisted: &plgpb.CredentialStorePersisted{
+					Secrets: secrets,
+				},
+			}, nil
+		}
+	}
+	return &plgpb.OnUpdateCredentialStoreResponse{}, nil
+}
+
+func (l *LoopbackCredential) onDeleteCredentialStore(ctx context.Context, req *plgpb.OnDeleteCredentialStoreRequest) (*plgpb.OnDeleteCredentialStoreResponse, error) {
+	const op = "loopback.(loopbackPlugin).onDeleteCredentialStore"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	return &plgpb.OnDeleteCredentialStoreResponse{}, nil
+}
+
+func (l *LoopbackCredential) issueCredential(ctx context.Context, req *plgpb.IssueCredentialRequest) (*plgpb.IssueCredentialResponse, error) {
+	const op = "loopback.(loopbackPlugin).issueCredential"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	lib := req.GetLibrary()
+	if lib == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "library is nil")
+	}
+	attrs := lib.GetAttributes().AsMap()
+	secret, ok := attrs[loopbackCredentialSecretAttrField].(map[string]any)
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("library attribute %q is not an object", loopbackCredentialSecretAttrField))
+	}
+	secretPb, err := structpb.NewStruct(secret)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var lease int64
+	if v, ok := attrs[loopbackCredentialLeaseAttrField].(float64); ok {
+		lease = int64(v)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	externalId := fmt.Sprintf("%s/%s/%d", lib.GetId(), req.GetSessionId(), len(l.issued))
+	l.issued[externalId] = &LoopbackCredentialInfo{
+		LibraryId:  lib.GetId(),
+		SessionId:  req.GetSessionId(),
+		Renewable:  lease > 0,
+		LeaseInSec: lease,
+	}
+	return &plgpb.IssueCredentialResponse{
+		ExternalId:           externalId,
+		Secret:               secretPb,
+		LeaseDurationSeconds: lease,
+		Renewable:            lease > 0,
+	}, nil
+}
+
+func (l *LoopbackCredential) renewCredential(ctx context.Context, req *plgpb.RenewCredentialRequest) (*plgpb.RenewCredentialResponse, error) {
+	const op = "loopback.(loopbackPlugin).renewCredential"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	info, ok := l.issued[req.GetExternalId()]
+	switch {
+	case !ok:
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential %q not found", req.GetExternalId()))
+	case info.Revoked:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("credential %q is revoked", req.GetExternalId()))
+	case !info.Renewable:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("credential %q is not renewable", req.GetExternalId()))
+	}
+	info.Renewals++
+	return &plgpb.RenewCredentialResponse{LeaseDurationSeconds: info.LeaseInSec}, nil
+}
+
+func (l *LoopbackCredential) revokeCredential(ctx context.Context, req *plgpb.RevokeCredentialRequest) (*plgpb.RevokeCredentialResponse, error) {
+	const op = "loopback.(loopbackPlugin).revokeCredential"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if info, ok := l.issued[req.GetExternalId()]; ok {
+		info.Revoked = true
+	}
+	return &plgpb.RevokeCredentialResponse{}, nil
+}
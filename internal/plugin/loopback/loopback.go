@@ -12,19 +12,22 @@ import (
 const loopbackPluginHostInfoAttrField = "host_info"
 
 var (
-	_ plgpb.HostPluginServiceServer    = (*LoopbackPlugin)(nil)
-	_ plgpb.StoragePluginServiceServer = (*LoopbackPlugin)(nil)
-	_ plgpb.HostPluginServiceServer    = (*TestPluginServer)(nil)
-	_ plgpb.StoragePluginServiceServer = (*TestPluginServer)(nil)
+	_ plgpb.HostPluginServiceServer       = (*LoopbackPlugin)(nil)
+	_ plgpb.StoragePluginServiceServer    = (*LoopbackPlugin)(nil)
+	_ plgpb.CredentialPluginServiceServer = (*LoopbackPlugin)(nil)
+	_ plgpb.HostPluginServiceServer       = (*TestPluginServer)(nil)
+	_ plgpb.StoragePluginServiceServer    = (*TestPluginServer)(nil)
+	_ plgpb.CredentialPluginServiceServer = (*TestPluginServer)(nil)
 )
 
-// TestPluginServer provides a host and storage plugin service server where each method can be overwritten for tests.
+// TestPluginServer provides a host, storage and credential plugin service server where each method can be overwritten for tests.
 type TestPluginServer struct {
 	TestPluginHostServer
 	TestPluginStorageServer
+	TestPluginCredentialServer
 }
 
-// LoopbackPlugin provides a host and storage plugin with functionality useful for certain
+// LoopbackPlugin provides a host, storage and credential plugin with functionality useful for certain
 // kinds of testing.
 //
 // It is not (currently) thread-safe.
@@ -36,6 +39,7 @@ type LoopbackPlugin struct {
 
 	*LoopbackHost
 	*LoopbackStorage
+	*LoopbackCredential
 }
 
 // NewLoopbackPlugin returns a new loopback plugin.
@@ -93,6 +97,9 @@ func NewLoopbackPlugin(opt ...TestOption) (*LoopbackPlugin, error) {
 			errs:              make([]PluginMockError, 0),
 			putObjectResponse: make([]PluginMockPutObjectResponse, 0),
 		},
+		LoopbackCredential: &LoopbackCredential{
+			issued: make(map[string]*LoopbackCredentialInfo),
+		},
 	}
 
 	// Set host methods
@@ -112,6 +119,14 @@ func NewLoopbackPlugin(opt ...TestOption) (*LoopbackPlugin, error) {
 	ret.GetObjectFn = ret.getObject
 	ret.PutObjectFn = ret.putObject
 	ret.DeleteObjectsFn = ret.deleteObjects
+
+	// Set credential methods
+	ret.OnCreateCredentialStoreFn = ret.onCreateCredentialStore
+	ret.OnUpdateCredentialStoreFn = ret.onUpdateCredentialStore
+	ret.OnDeleteCredentialStoreFn = ret.onDeleteCredentialStore
+	ret.IssueCredentialFn = ret.issueCredential
+	ret.RenewCredentialFn = ret.renewCredential
+	ret.RevokeCredentialFn = ret.revokeCredential
 	if len(opts.withMockBuckets) > 0 {
 		ret.buckets = opts.withMockBuckets
 	}
@@ -13,9 +13,10 @@ import (
 type PluginType int
 
 const (
-	PluginTypeUnknown PluginType = 0
-	PluginTypeHost    PluginType = 1
-	PluginTypeStorage PluginType = 2
+	PluginTypeUnknown    PluginType = 0
+	PluginTypeHost       PluginType = 1
+	PluginTypeStorage    PluginType = 2
+	PluginTypeCredential PluginType = 3
 )
 
 type pluginHostSupported struct {
@@ -36,6 +37,15 @@ func (*pluginStorageSupported) TableName() string {
 	return "plugin_storage_supported"
 }
 
+type pluginCredentialSupported struct {
+	PublicId string `gorm:"primary_key"`
+}
+
+func (*pluginCredentialSupported) isPluginSupported() {}
+func (*pluginCredentialSupported) TableName() string {
+	return "plugin_credential_supported"
+}
+
 // pluginSupportedTable is a special type created just to insert
 // plugin flags in a safe way. the only structs that implement
 // this should be above. new plugins should be added above.
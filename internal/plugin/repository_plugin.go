@@ -159,6 +159,10 @@ func (r *Repository) AddSupportFlag(ctx context.Context, plugin *Plugin, flag Pl
 		p = &pluginStorageSupported{
 			PublicId: plugin.GetPublicId(),
 		}
+	case PluginTypeCredential:
+		p = &pluginCredentialSupported{
+			PublicId: plugin.GetPublicId(),
+		}
 	default:
 		return errors.New(ctx, errors.InvalidParameter, op, "plugin type does not exist")
 	}
@@ -59,14 +59,16 @@ type TestOption func(*testOptions)
 
 // options = how options are represented
 type testOptions struct {
-	withHostFlag    bool
-	withStorageFlag bool
+	withHostFlag       bool
+	withStorageFlag    bool
+	withCredentialFlag bool
 }
 
 func getDefaultTestOptions() testOptions {
 	return testOptions{
-		withHostFlag:    true,
-		withStorageFlag: false,
+		withHostFlag:       true,
+		withStorageFlag:    false,
+		withCredentialFlag: false,
 	}
 }
 
@@ -84,9 +86,16 @@ func WithStorageFlag(flag bool) TestOption {
 	}
 }
 
+// WithCredentialFlag determines whether or not to enable the credential flag for a test plugin
+func WithCredentialFlag(flag bool) TestOption {
+	return func(o *testOptions) {
+		o.withCredentialFlag = flag
+	}
+}
+
 // TestPlugin creates a plugin and inserts it into the database. enables the "host" flag by default
 //
-// Supported options: WithHostFlag, WithStorageFlag
+// Supported options: WithHostFlag, WithStorageFlag, WithCredentialFlag
 func TestPlugin(t testing.TB, conn *db.DB, name string, opt ...TestOption) *Plugin {
 	opts := getTestOpts(opt...)
 	t.Helper()
@@ -113,5 +122,10 @@ func TestPlugin(t testing.TB, conn *db.DB, name string, opt ...TestOption) *Plug
 		repo.AddSupportFlag(context.Background(), p, PluginTypeStorage)
 	}
 
+	if opts.withCredentialFlag {
+		// add the credential supported flag
+		repo.AddSupportFlag(context.Background(), p, PluginTypeCredential)
+	}
+
 	return p
 }
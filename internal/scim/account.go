@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth/jwt"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/auth/saml"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// accountName describes where the name a provisioned user's account is looked
+// up by is stored for an auth method subtype. For password and ldap auth
+// methods the name is the account's login name, which must be lowercase. For
+// all other auth methods it is the account's subject.
+type accountName struct {
+	table     string
+	column    string
+	lowercase bool
+}
+
+var accountNames = map[globals.Subtype]accountName{
+	password.Subtype: {table: "auth_password_account", column: "login_name", lowercase: true},
+	oidc.Subtype:     {table: "auth_oidc_account", column: "subject"},
+	ldap.Subtype:     {table: "auth_ldap_account", column: "login_name", lowercase: true},
+	jwt.Subtype:      {table: "auth_jwt_account", column: "subject"},
+	saml.Subtype:     {table: "auth_saml_account", column: "subject"},
+}
+
+// accountNameFor returns the name of the account a user with the given SCIM
+// userName authenticates with in the auth method.
+func accountNameFor(ctx context.Context, authMethodId, userName string) (string, error) {
+	const op = "scim.accountNameFor"
+	n, ok := accountNames[globals.ResourceInfoFromPrefix(authMethodId).Subtype]
+	if !ok {
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("users cannot be provisioned into auth method %q", authMethodId))
+	}
+	if n.lowercase {
+		return strings.ToLower(userName), nil
+	}
+	return userName, nil
+}
+
+// lookupAccount returns the id of the account named name in the auth method
+// and the id of the user the account is associated with, if any. Empty ids are
+// returned if no such account exists.
+func lookupAccount(ctx context.Context, reader db.Reader, authMethodId, name string) (string, string, error) {
+	const op = "scim.lookupAccount"
+	n, ok := accountNames[globals.ResourceInfoFromPrefix(authMethodId).Subtype]
+	if !ok {
+		return "", "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("users cannot be provisioned into auth method %q", authMethodId))
+	}
+	rows, err := reader.Query(ctx, fmt.Sprintf(lookupAccountQuery, n.table, n.column), []any{authMethodId, name})
+	if err != nil {
+		return "", "", errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var accountId string
+	var userId sql.NullString
+	for rows.Next() {
+		if err := rows.Scan(&accountId, &userId); err != nil {
+			return "", "", errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", "", errors.Wrap(ctx, err, op)
+	}
+	return accountId, userId.String, nil
+}
+
+// createAccount creates the account named name in the auth method using the
+// provided transaction and returns its id.
+func (r *Repository) createAccount(ctx context.Context, reader db.Reader, w db.Writer, scopeId, authMethodId, name string) (string, error) {
+	const op = "scim.(Repository).createAccount"
+	switch globals.ResourceInfoFromPrefix(authMethodId).Subtype {
+	case password.Subtype:
+		repo, err := password.NewRepository(ctx, reader, w, r.kms)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		a, err := password.NewAccount(ctx, authMethodId, password.WithLoginName(name))
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		if a, err = repo.CreateAccount(ctx, scopeId, a, password.WithReaderWriter(reader, w)); err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		return a.GetPublicId(), nil
+	case oidc.Subtype:
+		repo, err := oidc.NewRepository(ctx, reader, w, r.kms)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		a, err := oidc.NewAccount(ctx, authMethodId, name)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		if a, err = repo.CreateAccount(ctx, scopeId, a, oidc.WithReaderWriter(reader, w)); err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		return a.GetPublicId(), nil
+	case ldap.Subtype:
+		repo, err := ldap.NewRepository(ctx, reader, w, r.kms)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		a, err := ldap.NewAccount(ctx, scopeId, authMethodId, name)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		if a, err = repo.CreateAccount(ctx, a, ldap.WithReaderWriter(reader, w)); err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		return a.GetPublicId(), nil
+	case jwt.Subtype:
+		repo, err := jwt.NewRepository(ctx, reader, w, r.kms)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		a, err := jwt.NewAccount(ctx, authMethodId, name)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		if a, err = repo.CreateAccount(ctx, scopeId, a, jwt.WithReaderWriter(reader, w)); err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		return a.GetPublicId(), nil
+	case saml.Subtype:
+		repo, err := saml.NewRepository(ctx, reader, w, r.kms)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		a, err := saml.NewAccount(ctx, authMethodId, name)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		if a, err = repo.CreateAccount(ctx, scopeId, a, saml.WithReaderWriter(reader, w)); err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		return a.GetPublicId(), nil
+	default:
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("users cannot be provisioned into auth method %q", authMethodId))
+	}
+}
+
+// deleteAccount deletes the account from the auth method using the provided
+// transaction, which also deletes the auth tokens issued for it.
+func (r *Repository) deleteAccount(ctx context.Context, reader db.Reader, w db.Writer, scopeId, authMethodId, accountId string) error {
+	const op = "scim.(Repository).deleteAccount"
+	var err error
+	switch globals.ResourceInfoFromPrefix(authMethodId).Subtype {
+	case password.Subtype:
+		var repo *password.Repository
+		if repo, err = password.NewRepository(ctx, reader, w, r.kms); err == nil {
+			_, err = repo.DeleteAccount(ctx, scopeId, accountId, password.WithReaderWriter(reader, w))
+		}
+	case oidc.Subtype:
+		var repo *oidc.Repository
+		if repo, err = oidc.NewRepository(ctx, reader, w, r.kms); err == nil {
+			_, err = repo.DeleteAccount(ctx, scopeId, accountId, oidc.WithReaderWriter(reader, w))
+		}
+	case ldap.Subtype:
+		var repo *ldap.Repository
+		if repo, err = ldap.NewRepository(ctx, reader, w, r.kms); err == nil {
+			_, err = repo.DeleteAccount(ctx, accountId, ldap.WithReaderWriter(reader, w))
+		}
+	case jwt.Subtype:
+		var repo *jwt.Repository
+		if repo, err = jwt.NewRepository(ctx, reader, w, r.kms); err == nil {
+			_, err = repo.DeleteAccount(ctx, scopeId, accountId, jwt.WithReaderWriter(reader, w))
+		}
+	case saml.Subtype:
+		var repo *saml.Repository
+		if repo, err = saml.NewRepository(ctx, reader, w, r.kms); err == nil {
+			_, err = repo.DeleteAccount(ctx, scopeId, accountId, saml.WithReaderWriter(reader, w))
+		}
+	default:
+		err = errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("users cannot be provisioned into auth method %q", authMethodId))
+	}
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package scim provides a SCIM 2.0 (RFC 7643 and RFC 7644) service provider
+// which lets an identity provider push users and groups into an auth method.
+//
+// SCIM users map onto iam users in the auth method's scope and the account
+// each user authenticates with in the auth method. SCIM groups map onto iam
+// groups in the same scope, whose members are the provisioned users.
+// Deactivating a user deletes its account, which also deletes its auth tokens,
+// while keeping the user and its grants until the user is deleted.
+//
+// # Tokens
+//
+// SCIM clients authenticate with a bearer token generated for the auth method.
+// An auth method has at most one token, which is encrypted at rest with the
+// database wrapper of the auth method's scope and is only returned when it is
+// generated.
+//
+// # Handler
+//
+// NewHandler returns the http.Handler serving the Users, Groups,
+// ServiceProviderConfig and ResourceTypes endpoints under
+// /scim/v2/<auth method id>/.
+package scim
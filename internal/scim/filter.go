@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// attributes are the values of a resource that filters are evaluated against,
+// keyed by the lowercase attribute path, e.g. "username" or "members.value".
+// Values are strings, bools or, for multi-valued attributes, string slices.
+type attributes map[string]any
+
+// caseExactAttributes are compared case sensitively. All other string
+// attributes are compared case insensitively.
+var caseExactAttributes = map[string]bool{
+	"id":            true,
+	"externalid":    true,
+	"members.value": true,
+}
+
+// filter is a parsed SCIM filter expression as defined in RFC 7644 section
+// 3.4.2.2.
+type filter interface {
+	matches(attributes) bool
+}
+
+type (
+	andFilter     struct{ left, right filter }
+	orFilter      struct{ left, right filter }
+	notFilter     struct{ f filter }
+	presentFilter struct{ attr string }
+	compareFilter struct {
+		attr  string
+		op    string
+		value any
+	}
+)
+
+func (f andFilter) matches(a attributes) bool { return f.left.matches(a) && f.right.matches(a) }
+func (f orFilter) matches(a attributes) bool  { return f.left.matches(a) || f.right.matches(a) }
+func (f notFilter) matches(a attributes) bool { return !f.f.matches(a) }
+
+func (f presentFilter) matches(a attributes) bool {
+	switch v := a[f.attr].(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case []string:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
+
+func (f compareFilter) matches(a attributes) bool {
+	switch v := a[f.attr].(type) {
+	case []string:
+		if f.op == "ne" {
+			return !(compareFilter{attr: f.attr, op: "eq", value: f.value}).matches(a)
+		}
+		for _, s := range v {
+			if compareString(s, f.op, f.value, caseExactAttributes[f.attr]) {
+				return true
+			}
+		}
+		return false
+	case string:
+		return compareString(v, f.op, f.value, caseExactAttributes[f.attr])
+	case bool:
+		b, ok := f.value.(bool)
+		switch f.op {
+		case "eq":
+			return ok && v == b
+		case "ne":
+			return !ok || v != b
+		}
+		return false
+	case nil:
+		switch f.op {
+		case "eq":
+			return f.value == nil
+		case "ne":
+			return f.value != nil
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+func compareString(attr, op string, value any, caseExact bool) bool {
+	s, ok := value.(string)
+	if !ok {
+		return op == "ne"
+	}
+	if !caseExact {
+		attr, s = strings.ToLower(attr), strings.ToLower(s)
+	}
+	switch op {
+	case "eq":
+		return attr == s
+	case "ne":
+		return attr != s
+	case "co":
+		return strings.Contains(attr, s)
+	case "sw":
+		return strings.HasPrefix(attr, s)
+	case "ew":
+		return strings.HasSuffix(attr, s)
+	case "gt":
+		return attr > s
+	case "ge":
+		return attr >= s
+	case "lt":
+		return attr < s
+	case "le":
+		return attr <= s
+	}
+	return false
+}
+
+var compareOps = map[string]bool{
+	"eq": true, "ne": true, "co": true, "sw": true, "ew": true,
+	"gt": true, "ge": true, "lt": true, "le": true,
+}
+
+// parseFilter parses a SCIM filter expression. Attribute paths, operators and
+// keywords are case insensitive and attribute paths may be qualified with the
+// URN of their schema. Complex attribute filter groupings, e.g.
+// emails[type eq "work"], are not supported.
+func parseFilter(s string) (filter, error) {
+	tokens, err := lexFilter(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q", p.tokens[p.pos].text)
+	}
+	return f, nil
+}
+
+type filterToken struct {
+	text string
+	// quoted is set for string literals, whose text is the unquoted value.
+	quoted bool
+}
+
+func lexFilter(s string) ([]filterToken, error) {
+	var tokens []filterToken
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, filterToken{text: string(c)})
+			i++
+		case c == '"':
+			end := i + 1
+			for ; end < len(s) && s[end] != '"'; end++ {
+				if s[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(s) {
+				return nil, fmt.Errorf("unterminated string starting at offset %d", i)
+			}
+			var v string
+			if err := json.Unmarshal([]byte(s[i:end+1]), &v); err != nil {
+				return nil, fmt.Errorf("invalid string starting at offset %d: %w", i, err)
+			}
+			tokens = append(tokens, filterToken{text: v, quoted: true})
+			i = end + 1
+		default:
+			end := i
+			for ; end < len(s) && !unicode.IsSpace(rune(s[end])) && s[end] != '(' && s[end] != ')' && s[end] != '"'; end++ {
+			}
+			if s[i:end] == "" {
+				return nil, fmt.Errorf("unexpected %q at offset %d", c, i)
+			}
+			if strings.ContainsAny(s[i:end], "[]") {
+				return nil, fmt.Errorf("complex attribute filter groupings are not supported: %q", s[i:end])
+			}
+			tokens = append(tokens, filterToken{text: s[i:end]})
+			i = end
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty filter")
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (p *filterParser) peekKeyword(k string) bool {
+	return p.pos < len(p.tokens) && !p.tokens[p.pos].quoted && strings.EqualFold(p.tokens[p.pos].text, k)
+}
+
+func (p *filterParser) next() (filterToken, error) {
+	if p.pos >= len(p.tokens) {
+		return filterToken{}, fmt.Errorf("unexpected end of filter")
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	return t, nil
+}
+
+func (p *filterParser) parseOr() (filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orFilter{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filter, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = andFilter{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseNot() (filter, error) {
+	if !p.peekKeyword("not") {
+		return p.parsePrimary()
+	}
+	p.pos++
+	if !p.peekKeyword("(") {
+		return nil, fmt.Errorf(`expected "(" after "not"`)
+	}
+	f, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	return notFilter{f: f}, nil
+}
+
+func (p *filterParser) parsePrimary() (filter, error) {
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if !t.quoted && t.text == "(" {
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.peekKeyword(")") {
+			return nil, fmt.Errorf(`expected ")"`)
+		}
+		p.pos++
+		return f, nil
+	}
+	if t.quoted || t.text == ")" {
+		return nil, fmt.Errorf("expected an attribute path, got %q", t.text)
+	}
+	attr := normalizeAttributePath(t.text)
+
+	opToken, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	op := strings.ToLower(opToken.text)
+	if opToken.quoted || (op != "pr" && !compareOps[op]) {
+		return nil, fmt.Errorf("unsupported operator %q", opToken.text)
+	}
+	if op == "pr" {
+		return presentFilter{attr: attr}, nil
+	}
+
+	valueToken, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	switch {
+	case valueToken.quoted:
+		value = valueToken.text
+	case strings.EqualFold(valueToken.text, "true"):
+		value = true
+	case strings.EqualFold(valueToken.text, "false"):
+		value = false
+	case strings.EqualFold(valueToken.text, "null"):
+		value = nil
+	default:
+		if _, err := strconv.ParseFloat(valueToken.text, 64); err != nil {
+			return nil, fmt.Errorf("invalid value %q", valueToken.text)
+		}
+		// Numbers are compared by their textual representation, since none
+		// of the supported attributes are numeric.
+		value = valueToken.text
+	}
+	return compareFilter{attr: attr, op: op, value: value}, nil
+}
+
+// normalizeAttributePath lowercases the attribute path and strips the URN of
+// the attribute's schema, if any.
+func normalizeAttributePath(path string) string {
+	path = strings.ToLower(path)
+	if strings.HasPrefix(path, "urn:") {
+		if i := strings.LastIndex(path, ":"); i >= 0 {
+			path = path[i+1:]
+		}
+	}
+	return path
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseFilter(t *testing.T) {
+	t.Parallel()
+	user := attributes{
+		"id":          "u_1234567890",
+		"externalid":  "Ext-1",
+		"username":    "Alice@Example.com",
+		"displayname": "",
+		"active":      true,
+	}
+	group := attributes{
+		"id":            "g_1234567890",
+		"displayname":   "Engineering",
+		"members.value": []string{"u_1234567890", "u_0987654321"},
+	}
+
+	tests := []struct {
+		name      string
+		filter    string
+		attrs     attributes
+		want      bool
+		wantErrIs string
+	}{
+		{name: "eq-case-insensitive", filter: `userName eq "alice@example.com"`, attrs: user, want: true},
+		{name: "eq-case-exact", filter: `externalId eq "ext-1"`, attrs: user, want: false},
+		{name: "eq-case-exact-match", filter: `externalId eq "Ext-1"`, attrs: user, want: true},
+		{name: "urn-qualified", filter: `urn:ietf:params:scim:schemas:core:2.0:User:userName eq "Alice@Example.com"`, attrs: user, want: true},
+		{name: "ne", filter: `userName ne "bob"`, attrs: user, want: true},
+		{name: "co", filter: `userName co "example"`, attrs: user, want: true},
+		{name: "sw", filter: `userName sw "bob"`, attrs: user, want: false},
+		{name: "ew", filter: `userName ew ".com"`, attrs: user, want: true},
+		{name: "gt", filter: `userName gt "a"`, attrs: user, want: true},
+		{name: "bool", filter: `active eq true`, attrs: user, want: true},
+		{name: "bool-false", filter: `active eq false`, attrs: user, want: false},
+		{name: "present", filter: `externalId pr`, attrs: user, want: true},
+		{name: "not-present-empty", filter: `displayName pr`, attrs: user, want: false},
+		{name: "and", filter: `userName sw "alice" and active eq true`, attrs: user, want: true},
+		{name: "or", filter: `userName eq "bob" or externalId eq "Ext-1"`, attrs: user, want: true},
+		{name: "not", filter: `not (userName eq "bob")`, attrs: user, want: true},
+		{name: "precedence", filter: `userName eq "bob" and active eq true or externalId pr`, attrs: user, want: true},
+		{name: "parens", filter: `userName eq "bob" and (active eq true or externalId pr)`, attrs: user, want: false},
+		{name: "multi-valued", filter: `members.value eq "u_0987654321"`, attrs: group, want: true},
+		{name: "multi-valued-ne", filter: `members.value ne "u_0987654321"`, attrs: group, want: false},
+		{name: "missing-attribute", filter: `title eq "x"`, attrs: user, want: false},
+		{name: "unknown-operator", filter: `userName xx "alice"`, wantErrIs: "unsupported operator"},
+		{name: "missing-value", filter: `userName eq`, wantErrIs: "unexpected end"},
+		{name: "unbalanced-parens", filter: `(userName eq "alice"`, wantErrIs: `expected ")"`},
+		{name: "trailing-tokens", filter: `userName eq "alice" "bob"`, wantErrIs: "unexpected"},
+		{name: "unterminated-string", filter: `userName eq "alice`, wantErrIs: "unterminated"},
+		{name: "complex-attribute", filter: `emails[type eq "work"]`, wantErrIs: "not supported"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			f, err := parseFilter(tt.filter)
+			if tt.wantErrIs != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErrIs)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, f.matches(tt.attrs))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+)
+
+const defaultGroupTableName = "scim_group"
+
+// Group is a group provisioned by a SCIM client into an auth method. It is
+// stored as an iam group in the auth method's scope whose members are users
+// provisioned into the same auth method.
+type Group struct {
+	// Id of the iam group, which is also the group's SCIM id
+	Id string
+	// AuthMethodId of the auth method the group is provisioned into
+	AuthMethodId string
+	// ExternalId is the id the SCIM client assigned to the group
+	ExternalId string
+	// DisplayName is the name of the iam group
+	DisplayName string
+	// MemberIds are the ids of the users that are members of the group
+	MemberIds []string
+	// CreateTime of the iam group
+	CreateTime time.Time
+	// UpdateTime of the iam group
+	UpdateTime time.Time
+	// Version of the iam group
+	Version uint32
+}
+
+// scimGroup is the stored mapping of a provisioned group onto an iam group.
+type scimGroup struct {
+	AuthMethodId string               `gorm:"primary_key"`
+	GroupId      string               `gorm:"primary_key"`
+	ExternalId   string               `gorm:"default:null"`
+	CreateTime   *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	UpdateTime   *timestamp.Timestamp `gorm:"default:current_timestamp"`
+}
+
+// TableName returns the table name.
+func (g *scimGroup) TableName() string {
+	return defaultGroupTableName
+}
@@ -0,0 +1,726 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+const (
+	// PathPrefix is the path the SCIM handler is served under. The base URL
+	// of an auth method's SCIM endpoint is PathPrefix followed by the auth
+	// method's id.
+	PathPrefix = "/scim/v2/"
+
+	// ContentType is the media type of SCIM requests and responses.
+	ContentType = "application/scim+json"
+
+	// maxResults is the maximum number of resources returned by a list
+	// request.
+	maxResults = 1000
+
+	userSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	groupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	serviceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	resourceTypeSchema          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	listResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	patchOpSchema               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	errorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	usersEndpoint                 = "Users"
+	groupsEndpoint                = "Groups"
+	serviceProviderConfigEndpoint = "ServiceProviderConfig"
+	resourceTypesEndpoint         = "ResourceTypes"
+)
+
+// The scimType values of error responses, as defined in RFC 7644 section
+// 3.12.
+const (
+	scimTypeInvalidFilter = "invalidFilter"
+	scimTypeUniqueness    = "uniqueness"
+	scimTypeMutability    = "mutability"
+	scimTypeInvalidSyntax = "invalidSyntax"
+	scimTypeInvalidPath   = "invalidPath"
+	scimTypeNoTarget      = "noTarget"
+	scimTypeInvalidValue  = "invalidValue"
+)
+
+// scimError is a SCIM error response.
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+
+	status int
+}
+
+func newError(status int, scimType, detail string) *scimError {
+	return &scimError{
+		Schemas:  []string{errorSchema},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+		status:   status,
+	}
+}
+
+func (e *scimError) Error() string {
+	return fmt.Sprintf("scim error %d %s: %s", e.status, e.ScimType, e.Detail)
+}
+
+type meta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location"`
+}
+
+type userResource struct {
+	Schemas     []string `json:"schemas"`
+	Id          string   `json:"id,omitempty"`
+	ExternalId  string   `json:"externalId,omitempty"`
+	UserName    string   `json:"userName"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+	Meta        *meta    `json:"meta,omitempty"`
+}
+
+type groupResource struct {
+	Schemas     []string `json:"schemas"`
+	Id          string   `json:"id,omitempty"`
+	ExternalId  string   `json:"externalId,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []member `json:"members,omitempty"`
+	Meta        *meta    `json:"meta,omitempty"`
+}
+
+type listResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type handler struct {
+	repoFn RepoFactory
+}
+
+// NewHandler returns the http.Handler serving the SCIM 2.0 endpoints of the
+// auth methods SCIM tokens have been generated for. It expects to be served
+// under PathPrefix.
+func NewHandler(ctx context.Context, repoFn RepoFactory) (http.Handler, error) {
+	const op = "scim.NewHandler"
+	if repoFn == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing repo factory")
+	}
+	return &handler{repoFn: repoFn}, nil
+}
+
+// request is an authenticated request for the SCIM endpoint of an auth
+// method.
+type request struct {
+	*http.Request
+	repo         *Repository
+	scopeId      string
+	authMethodId string
+	endpoint     string
+	id           string
+	baseUrl      string
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	const op = "scim.(handler).ServeHTTP"
+	ctx := r.Context()
+
+	// The path is /scim/v2/<auth method id>/<endpoint>[/<id>]
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, PathPrefix), "/"), "/")
+	authMethodId := parts[0]
+	if globals.ResourceInfoFromPrefix(authMethodId).Type != resource.AuthMethod || len(parts) < 2 || len(parts) > 3 {
+		writeError(ctx, w, newError(http.StatusNotFound, "", "not found"))
+		return
+	}
+
+	repo, err := h.repoFn()
+	if err != nil {
+		event.WriteError(ctx, op, err)
+		writeError(ctx, w, newError(http.StatusInternalServerError, "", "internal error"))
+		return
+	}
+	tok, err := authenticate(ctx, repo, authMethodId, r)
+	if err != nil {
+		event.WriteError(ctx, op, err)
+		writeError(ctx, w, newError(http.StatusInternalServerError, "", "internal error"))
+		return
+	}
+	if tok == nil {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+		writeError(ctx, w, newError(http.StatusUnauthorized, "", "missing or invalid bearer token"))
+		return
+	}
+
+	if max, ok := ctx.Value(globals.ContextMaxRequestSizeTypeKey).(int64); ok && max > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, max)
+	}
+
+	scheme := "https"
+	if r.TLS == nil && !strings.EqualFold(r.Header.Get("X-Forwarded-Proto"), "https") {
+		scheme = "http"
+	}
+	req := &request{
+		Request:      r,
+		repo:         repo,
+		scopeId:      tok.ScopeId,
+		authMethodId: authMethodId,
+		endpoint:     parts[1],
+		baseUrl:      (&url.URL{Scheme: scheme, Host: r.Host, Path: PathPrefix + authMethodId}).String(),
+	}
+	if len(parts) == 3 {
+		req.id = parts[2]
+	}
+
+	var status int
+	var body any
+	switch req.endpoint {
+	case usersEndpoint:
+		status, body, err = h.serveUsers(ctx, req)
+	case groupsEndpoint:
+		status, body, err = h.serveGroups(ctx, req)
+	case serviceProviderConfigEndpoint:
+		status, body, err = serveServiceProviderConfig(req)
+	case resourceTypesEndpoint:
+		status, body, err = serveResourceTypes(req)
+	default:
+		err = newError(http.StatusNotFound, "", fmt.Sprintf("unknown endpoint %q", req.endpoint))
+	}
+	if err != nil {
+		writeError(ctx, w, toScimError(ctx, op, err))
+		return
+	}
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+	writeJson(ctx, w, status, body)
+}
+
+// authenticate returns the SCIM token of the auth method if the request
+// carries it as its bearer token. A nil token and nil error are returned if
+// it does not.
+func authenticate(ctx context.Context, repo *Repository, authMethodId string, r *http.Request) (*Token, error) {
+	const op = "scim.authenticate"
+	authHeader := r.Header.Get("Authorization")
+	if len(authHeader) <= len("bearer ") || !strings.EqualFold(authHeader[:len("bearer ")], "bearer ") {
+		return nil, nil
+	}
+	tok, err := repo.ValidateToken(ctx, authMethodId, strings.TrimSpace(authHeader[len("bearer "):]))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return tok, nil
+}
+
+func (h *handler) serveUsers(ctx context.Context, req *request) (int, any, error) {
+	switch {
+	case req.id == "" && req.Method == http.MethodGet:
+		return h.listUsers(ctx, req)
+
+	case req.id == "" && req.Method == http.MethodPost:
+		var in userResource
+		if err := decodeBody(req, &in); err != nil {
+			return 0, nil, err
+		}
+		u, err := req.repo.CreateUser(ctx, req.scopeId, fromUserResource(req.authMethodId, &in))
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusCreated, toUserResource(req.baseUrl, u), nil
+
+	case req.id == "":
+		return 0, nil, newError(http.StatusMethodNotAllowed, "", fmt.Sprintf("method %s is not allowed", req.Method))
+	}
+
+	current, err := req.repo.LookupUser(ctx, req.authMethodId, req.id)
+	if err != nil {
+		return 0, nil, err
+	}
+	if current == nil {
+		return 0, nil, newError(http.StatusNotFound, "", fmt.Sprintf("user %q not found", req.id))
+	}
+	switch req.Method {
+	case http.MethodGet:
+		return http.StatusOK, toUserResource(req.baseUrl, current), nil
+
+	case http.MethodPut:
+		var in userResource
+		if err := decodeBody(req, &in); err != nil {
+			return 0, nil, err
+		}
+		u := fromUserResource(req.authMethodId, &in)
+		u.Id = current.Id
+		if u, err = req.repo.UpdateUser(ctx, req.scopeId, u); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, toUserResource(req.baseUrl, u), nil
+
+	case http.MethodPatch:
+		var in patchRequest
+		if err := decodePatch(req, &in); err != nil {
+			return 0, nil, err
+		}
+		if err := applyUserPatch(current, in.Operations); err != nil {
+			return 0, nil, err
+		}
+		u, err := req.repo.UpdateUser(ctx, req.scopeId, current)
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, toUserResource(req.baseUrl, u), nil
+
+	case http.MethodDelete:
+		if _, err := req.repo.DeleteUser(ctx, req.scopeId, req.authMethodId, req.id); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusNoContent, nil, nil
+	}
+	return 0, nil, newError(http.StatusMethodNotAllowed, "", fmt.Sprintf("method %s is not allowed", req.Method))
+}
+
+func (h *handler) serveGroups(ctx context.Context, req *request) (int, any, error) {
+	excludeMembers := false
+	for _, a := range strings.Split(req.URL.Query().Get("excludedAttributes"), ",") {
+		if normalizeAttributePath(strings.TrimSpace(a)) == "members" {
+			excludeMembers = true
+		}
+	}
+
+	switch {
+	case req.id == "" && req.Method == http.MethodGet:
+		groups, err := req.repo.ListGroups(ctx, req.authMethodId)
+		if err != nil {
+			return 0, nil, err
+		}
+		f, err := listFilter(req)
+		if err != nil {
+			return 0, nil, err
+		}
+		var resources []any
+		for _, g := range groups {
+			if f == nil || f.matches(groupAttributes(g)) {
+				resources = append(resources, toGroupResource(req.baseUrl, g, excludeMembers))
+			}
+		}
+		return paginate(req, resources)
+
+	case req.id == "" && req.Method == http.MethodPost:
+		var in groupResource
+		if err := decodeBody(req, &in); err != nil {
+			return 0, nil, err
+		}
+		g, err := req.repo.CreateGroup(ctx, req.scopeId, fromGroupResource(req.authMethodId, &in))
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusCreated, toGroupResource(req.baseUrl, g, false), nil
+
+	case req.id == "":
+		return 0, nil, newError(http.StatusMethodNotAllowed, "", fmt.Sprintf("method %s is not allowed", req.Method))
+	}
+
+	current, err := req.repo.LookupGroup(ctx, req.authMethodId, req.id)
+	if err != nil {
+		return 0, nil, err
+	}
+	if current == nil {
+		return 0, nil, newError(http.StatusNotFound, "", fmt.Sprintf("group %q not found", req.id))
+	}
+	switch req.Method {
+	case http.MethodGet:
+		return http.StatusOK, toGroupResource(req.baseUrl, current, excludeMembers), nil
+
+	case http.MethodPut:
+		var in groupResource
+		if err := decodeBody(req, &in); err != nil {
+			return 0, nil, err
+		}
+		g := fromGroupResource(req.authMethodId, &in)
+		g.Id = current.Id
+		if g, err = req.repo.UpdateGroup(ctx, g); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, toGroupResource(req.baseUrl, g, false), nil
+
+	case http.MethodPatch:
+		var in patchRequest
+		if err := decodePatch(req, &in); err != nil {
+			return 0, nil, err
+		}
+		if err := applyGroupPatch(current, in.Operations); err != nil {
+			return 0, nil, err
+		}
+		g, err := req.repo.UpdateGroup(ctx, current)
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, toGroupResource(req.baseUrl, g, excludeMembers), nil
+
+	case http.MethodDelete:
+		if _, err := req.repo.DeleteGroup(ctx, req.authMethodId, req.id); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusNoContent, nil, nil
+	}
+	return 0, nil, newError(http.StatusMethodNotAllowed, "", fmt.Sprintf("method %s is not allowed", req.Method))
+}
+
+func serveServiceProviderConfig(req *request) (int, any, error) {
+	if req.Method != http.MethodGet || req.id != "" {
+		return 0, nil, newError(http.StatusMethodNotAllowed, "", fmt.Sprintf("method %s is not allowed", req.Method))
+	}
+	supported := func(s bool) map[string]any { return map[string]any{"supported": s} }
+	return http.StatusOK, map[string]any{
+		"schemas": []string{serviceProviderConfigSchema},
+		"patch":   supported(true),
+		"bulk": map[string]any{
+			"supported":      false,
+			"maxOperations":  0,
+			"maxPayloadSize": 0,
+		},
+		"filter": map[string]any{
+			"supported":  true,
+			"maxResults": maxResults,
+		},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]any{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "Bearer Token",
+				"description": "Authentication with the SCIM token generated for the auth method",
+				"primary":     true,
+			},
+		},
+		"meta": map[string]any{
+			"resourceType": serviceProviderConfigEndpoint,
+			"location":     req.baseUrl + "/" + serviceProviderConfigEndpoint,
+		},
+	}, nil
+}
+
+func serveResourceTypes(req *request) (int, any, error) {
+	if req.Method != http.MethodGet {
+		return 0, nil, newError(http.StatusMethodNotAllowed, "", fmt.Sprintf("method %s is not allowed", req.Method))
+	}
+	resourceType := func(name, endpoint, schema string) map[string]any {
+		return map[string]any{
+			"schemas":  []string{resourceTypeSchema},
+			"id":       name,
+			"name":     name,
+			"endpoint": "/" + endpoint,
+			"schema":   schema,
+			"meta": map[string]any{
+				"resourceType": "ResourceType",
+				"location":     req.baseUrl + "/" + resourceTypesEndpoint + "/" + name,
+			},
+		}
+	}
+	types := map[string]any{
+		"User":  resourceType("User", usersEndpoint, userSchema),
+		"Group": resourceType("Group", groupsEndpoint, groupSchema),
+	}
+	if req.id != "" {
+		t, ok := types[req.id]
+		if !ok {
+			return 0, nil, newError(http.StatusNotFound, "", fmt.Sprintf("resource type %q not found", req.id))
+		}
+		return http.StatusOK, t, nil
+	}
+	return http.StatusOK, &listResponse{
+		Schemas:      []string{listResponseSchema},
+		TotalResults: 2,
+		StartIndex:   1,
+		ItemsPerPage: 2,
+		Resources:    []any{types["User"], types["Group"]},
+	}, nil
+}
+
+// listUsers returns the page of the users of the auth method requested by
+// req. Without a filter the page is read directly from the database; with one
+// the users are read in batches and only the matching users in the requested
+// page are kept.
+func (h *handler) listUsers(ctx context.Context, req *request) (int, any, error) {
+	startIndex, count, err := pageParams(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	f, err := listFilter(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	page := []any{}
+	var total int
+	if f == nil {
+		users, n, err := req.repo.ListUsers(ctx, req.authMethodId, startIndex-1, count)
+		if err != nil {
+			return 0, nil, err
+		}
+		for _, u := range users {
+			page = append(page, toUserResource(req.baseUrl, u))
+		}
+		total = n
+	} else {
+		for offset := 0; ; offset += maxResults {
+			users, _, err := req.repo.ListUsers(ctx, req.authMethodId, offset, maxResults)
+			if err != nil {
+				return 0, nil, err
+			}
+			for _, u := range users {
+				if !f.matches(userAttributes(u)) {
+					continue
+				}
+				total++
+				if total >= startIndex && len(page) < count {
+					page = append(page, toUserResource(req.baseUrl, u))
+				}
+			}
+			if len(users) < maxResults {
+				break
+			}
+		}
+	}
+	return http.StatusOK, &listResponse{
+		Schemas:      []string{listResponseSchema},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}, nil
+}
+
+func listFilter(req *request) (filter, error) {
+	s := req.URL.Query().Get("filter")
+	if s == "" {
+		return nil, nil
+	}
+	f, err := parseFilter(s)
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, scimTypeInvalidFilter, err.Error())
+	}
+	return f, nil
+}
+
+// paginate returns the page of the resources selected by the startIndex and
+// count query parameters.
+func paginate(req *request, resources []any) (int, any, error) {
+	startIndex, count, err := pageParams(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	page := []any{}
+	if startIndex <= len(resources) {
+		end := startIndex - 1 + count
+		if end > len(resources) {
+			end = len(resources)
+		}
+		page = resources[startIndex-1 : end]
+	}
+	return http.StatusOK, &listResponse{
+		Schemas:      []string{listResponseSchema},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}, nil
+}
+
+// pageParams returns the startIndex and count query parameters of the list
+// request, which are 1-based as defined in RFC 7644 section 3.4.2.4. The count
+// is capped at maxResults.
+func pageParams(req *request) (int, int, error) {
+	startIndex, count := 1, maxResults
+	q := req.URL.Query()
+	if s := q.Get("startIndex"); s != "" {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, 0, newError(http.StatusBadRequest, scimTypeInvalidValue, "startIndex must be an integer")
+		}
+		if i > 1 {
+			startIndex = i
+		}
+	}
+	if s := q.Get("count"); s != "" {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, 0, newError(http.StatusBadRequest, scimTypeInvalidValue, "count must be an integer")
+		}
+		switch {
+		case i < 0:
+			count = 0
+		case i < maxResults:
+			count = i
+		}
+	}
+	return startIndex, count, nil
+}
+
+func userAttributes(u *User) attributes {
+	return attributes{
+		"id":                u.Id,
+		"externalid":        u.ExternalId,
+		"username":          u.UserName,
+		"displayname":       u.DisplayName,
+		"active":            u.Active,
+		"meta.created":      u.CreateTime.UTC().Format(time.RFC3339),
+		"meta.lastmodified": u.UpdateTime.UTC().Format(time.RFC3339),
+	}
+}
+
+func groupAttributes(g *Group) attributes {
+	return attributes{
+		"id":                g.Id,
+		"externalid":        g.ExternalId,
+		"displayname":       g.DisplayName,
+		"members":           g.MemberIds,
+		"members.value":     g.MemberIds,
+		"meta.created":      g.CreateTime.UTC().Format(time.RFC3339),
+		"meta.lastmodified": g.UpdateTime.UTC().Format(time.RFC3339),
+	}
+}
+
+func toUserResource(baseUrl string, u *User) *userResource {
+	active := u.Active
+	return &userResource{
+		Schemas:     []string{userSchema},
+		Id:          u.Id,
+		ExternalId:  u.ExternalId,
+		UserName:    u.UserName,
+		DisplayName: u.DisplayName,
+		Active:      &active,
+		Meta: &meta{
+			ResourceType: "User",
+			Created:      u.CreateTime,
+			LastModified: u.UpdateTime,
+			Location:     baseUrl + "/" + usersEndpoint + "/" + u.Id,
+		},
+	}
+}
+
+func fromUserResource(authMethodId string, in *userResource) *User {
+	u := &User{
+		AuthMethodId: authMethodId,
+		ExternalId:   in.ExternalId,
+		UserName:     in.UserName,
+		DisplayName:  in.DisplayName,
+		Active:       true,
+	}
+	if in.Active != nil {
+		u.Active = *in.Active
+	}
+	return u
+}
+
+func toGroupResource(baseUrl string, g *Group, excludeMembers bool) *groupResource {
+	r := &groupResource{
+		Schemas:     []string{groupSchema},
+		Id:          g.Id,
+		ExternalId:  g.ExternalId,
+		DisplayName: g.DisplayName,
+		Meta: &meta{
+			ResourceType: "Group",
+			Created:      g.CreateTime,
+			LastModified: g.UpdateTime,
+			Location:     baseUrl + "/" + groupsEndpoint + "/" + g.Id,
+		},
+	}
+	if !excludeMembers {
+		for _, id := range g.MemberIds {
+			r.Members = append(r.Members, member{
+				Value: id,
+				Ref:   baseUrl + "/" + usersEndpoint + "/" + id,
+			})
+		}
+	}
+	return r
+}
+
+func fromGroupResource(authMethodId string, in *groupResource) *Group {
+	ids := make([]string, 0, len(in.Members))
+	for _, m := range in.Members {
+		ids = append(ids, m.Value)
+	}
+	return &Group{
+		AuthMethodId: authMethodId,
+		ExternalId:   in.ExternalId,
+		DisplayName:  in.DisplayName,
+		MemberIds:    addMembers(nil, ids),
+	}
+}
+
+func decodeBody(req *request, v any) error {
+	dec := json.NewDecoder(req.Body)
+	if err := dec.Decode(v); err != nil {
+		return newError(http.StatusBadRequest, scimTypeInvalidSyntax, fmt.Sprintf("unable to decode request body: %v", err))
+	}
+	return nil
+}
+
+func decodePatch(req *request, in *patchRequest) error {
+	if err := decodeBody(req, in); err != nil {
+		return err
+	}
+	for _, s := range in.Schemas {
+		if s == patchOpSchema {
+			return nil
+		}
+	}
+	return newError(http.StatusBadRequest, scimTypeInvalidSyntax, fmt.Sprintf("request must have the %s schema", patchOpSchema))
+}
+
+// toScimError converts errors returned by the repository into SCIM errors.
+// Unexpected errors are written as events and returned as internal errors.
+func toScimError(ctx context.Context, op event.Op, err error) *scimError {
+	var se *scimError
+	switch {
+	case errors.As(err, &se):
+		return se
+	case errors.Match(errors.T(errors.NotUnique), err):
+		return newError(http.StatusConflict, scimTypeUniqueness, err.Error())
+	case errors.Match(errors.T(errors.RecordNotFound), err):
+		return newError(http.StatusNotFound, "", err.Error())
+	case errors.Match(errors.T(errors.InvalidParameter), err),
+		errors.Match(errors.T(errors.NotNull), err),
+		errors.Match(errors.T(errors.CheckConstraint), err):
+		return newError(http.StatusBadRequest, scimTypeInvalidValue, err.Error())
+	default:
+		event.WriteError(ctx, op, err)
+		return newError(http.StatusInternalServerError, "", "internal error")
+	}
+}
+
+func writeError(ctx context.Context, w http.ResponseWriter, e *scimError) {
+	writeJson(ctx, w, e.status, e)
+}
+
+func writeJson(ctx context.Context, w http.ResponseWriter, status int, body any) {
+	const op = "scim.writeJson"
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to write response"))
+	}
+}
@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// patchOperation is a single operation of a SCIM PATCH request as defined in
+// RFC 7644 section 3.5.2.
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// patchRequest is the body of a SCIM PATCH request.
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+// applyUserPatch applies the operations to the user. Attributes the user does
+// not store, such as name or emails, are ignored.
+func applyUserPatch(u *User, ops []patchOperation) error {
+	for _, op := range ops {
+		path := normalizeAttributePath(op.Path)
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			if path == "" {
+				var values map[string]json.RawMessage
+				if err := json.Unmarshal(op.Value, &values); err != nil {
+					return newError(http.StatusBadRequest, scimTypeInvalidValue, "the value of an operation without a path must be an object")
+				}
+				for k, v := range values {
+					if err := setUserAttribute(u, normalizeAttributePath(k), v); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if err := setUserAttribute(u, path, op.Value); err != nil {
+				return err
+			}
+		case "remove":
+			switch path {
+			case "":
+				return newError(http.StatusBadRequest, scimTypeNoTarget, "a remove operation requires a path")
+			case "username":
+				return newError(http.StatusBadRequest, scimTypeMutability, "userName cannot be removed")
+			case "displayname":
+				u.DisplayName = ""
+			case "externalid":
+				u.ExternalId = ""
+			case "active":
+				u.Active = false
+			}
+		default:
+			return newError(http.StatusBadRequest, scimTypeInvalidSyntax, fmt.Sprintf("unsupported operation %q", op.Op))
+		}
+	}
+	return nil
+}
+
+func setUserAttribute(u *User, path string, value json.RawMessage) error {
+	var err error
+	switch path {
+	case "username":
+		err = unmarshalString(value, &u.UserName)
+		if err == nil && u.UserName == "" {
+			return newError(http.StatusBadRequest, scimTypeInvalidValue, "userName must not be empty")
+		}
+	case "displayname":
+		err = unmarshalString(value, &u.DisplayName)
+	case "externalid":
+		err = unmarshalString(value, &u.ExternalId)
+	case "active":
+		err = unmarshalBool(value, &u.Active)
+	}
+	if err != nil {
+		return newError(http.StatusBadRequest, scimTypeInvalidValue, fmt.Sprintf("invalid value for %q: %v", path, err))
+	}
+	return nil
+}
+
+// applyGroupPatch applies the operations to the group. Member paths may
+// select members with a filter, e.g. members[value eq "u_1234567890"].
+func applyGroupPatch(g *Group, ops []patchOperation) error {
+	for _, op := range ops {
+		path, memberFilter, err := parsePatchPath(op.Path)
+		if err != nil {
+			return err
+		}
+		switch opName := strings.ToLower(op.Op); opName {
+		case "add", "replace":
+			if path == "" {
+				var values map[string]json.RawMessage
+				if err := json.Unmarshal(op.Value, &values); err != nil {
+					return newError(http.StatusBadRequest, scimTypeInvalidValue, "the value of an operation without a path must be an object")
+				}
+				for k, v := range values {
+					if err := setGroupAttribute(g, opName, normalizeAttributePath(k), v); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if memberFilter != nil {
+				return newError(http.StatusBadRequest, scimTypeInvalidPath, fmt.Sprintf("a member filter is not supported for %s operations", opName))
+			}
+			if err := setGroupAttribute(g, opName, path, op.Value); err != nil {
+				return err
+			}
+		case "remove":
+			switch path {
+			case "":
+				return newError(http.StatusBadRequest, scimTypeNoTarget, "a remove operation requires a path")
+			case "displayname":
+				return newError(http.StatusBadRequest, scimTypeMutability, "displayName cannot be removed")
+			case "externalid":
+				g.ExternalId = ""
+			case "members":
+				switch {
+				case memberFilter != nil:
+					g.MemberIds = removeMembers(g.MemberIds, func(id string) bool {
+						return memberFilter.matches(attributes{"value": id})
+					})
+				case len(op.Value) > 0 && string(op.Value) != "null":
+					ids, err := unmarshalMembers(op.Value)
+					if err != nil {
+						return err
+					}
+					remove := make(map[string]bool, len(ids))
+					for _, id := range ids {
+						remove[id] = true
+					}
+					g.MemberIds = removeMembers(g.MemberIds, func(id string) bool { return remove[id] })
+				default:
+					g.MemberIds = nil
+				}
+			default:
+				return newError(http.StatusBadRequest, scimTypeInvalidPath, fmt.Sprintf("unsupported path %q", op.Path))
+			}
+		default:
+			return newError(http.StatusBadRequest, scimTypeInvalidSyntax, fmt.Sprintf("unsupported operation %q", op.Op))
+		}
+	}
+	return nil
+}
+
+func setGroupAttribute(g *Group, op, path string, value json.RawMessage) error {
+	switch path {
+	case "displayname":
+		if err := unmarshalString(value, &g.DisplayName); err != nil {
+			return newError(http.StatusBadRequest, scimTypeInvalidValue, fmt.Sprintf("invalid value for %q: %v", path, err))
+		}
+		if g.DisplayName == "" {
+			return newError(http.StatusBadRequest, scimTypeInvalidValue, "displayName must not be empty")
+		}
+	case "externalid":
+		if err := unmarshalString(value, &g.ExternalId); err != nil {
+			return newError(http.StatusBadRequest, scimTypeInvalidValue, fmt.Sprintf("invalid value for %q: %v", path, err))
+		}
+	case "members":
+		ids, err := unmarshalMembers(value)
+		if err != nil {
+			return err
+		}
+		if op == "replace" {
+			g.MemberIds = nil
+		}
+		g.MemberIds = addMembers(g.MemberIds, ids)
+	default:
+		return newError(http.StatusBadRequest, scimTypeInvalidPath, fmt.Sprintf("unsupported path %q", path))
+	}
+	return nil
+}
+
+// parsePatchPath splits a patch path into the normalized attribute path and,
+// for paths like members[value eq "u_1234567890"], the filter selecting the
+// values the operation applies to.
+func parsePatchPath(path string) (string, filter, error) {
+	open := strings.Index(path, "[")
+	if open < 0 {
+		return normalizeAttributePath(path), nil, nil
+	}
+	if !strings.HasSuffix(path, "]") {
+		return "", nil, newError(http.StatusBadRequest, scimTypeInvalidPath, fmt.Sprintf("invalid path %q", path))
+	}
+	f, err := parseFilter(path[open+1 : len(path)-1])
+	if err != nil {
+		return "", nil, newError(http.StatusBadRequest, scimTypeInvalidPath, fmt.Sprintf("invalid path %q: %v", path, err))
+	}
+	return normalizeAttributePath(path[:open]), f, nil
+}
+
+// member is a reference to a group member.
+type member struct {
+	Value string `json:"value"`
+	Ref   string `json:"$ref,omitempty"`
+}
+
+func unmarshalMembers(value json.RawMessage) ([]string, error) {
+	var members []member
+	if err := json.Unmarshal(value, &members); err != nil {
+		// Some clients send a single member instead of a list.
+		var m member
+		if err := json.Unmarshal(value, &m); err != nil {
+			return nil, newError(http.StatusBadRequest, scimTypeInvalidValue, "members must be a list of objects with a value")
+		}
+		members = []member{m}
+	}
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		if m.Value == "" {
+			return nil, newError(http.StatusBadRequest, scimTypeInvalidValue, "members must have a value")
+		}
+		ids = append(ids, m.Value)
+	}
+	return ids, nil
+}
+
+func addMembers(current, ids []string) []string {
+	seen := make(map[string]bool, len(current)+len(ids))
+	ret := make([]string, 0, len(current)+len(ids))
+	for _, l := range [][]string{current, ids} {
+		for _, id := range l {
+			if !seen[id] {
+				seen[id] = true
+				ret = append(ret, id)
+			}
+		}
+	}
+	return ret
+}
+
+func removeMembers(current []string, remove func(string) bool) []string {
+	ret := make([]string, 0, len(current))
+	for _, id := range current {
+		if !remove(id) {
+			ret = append(ret, id)
+		}
+	}
+	return ret
+}
+
+func unmarshalString(value json.RawMessage, s *string) error {
+	if string(value) == "null" {
+		*s = ""
+		return nil
+	}
+	return json.Unmarshal(value, s)
+}
+
+// unmarshalBool accepts JSON booleans and, since some clients send them,
+// strings like "True" or "false".
+func unmarshalBool(value json.RawMessage, b *bool) error {
+	if err := json.Unmarshal(value, b); err == nil {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return fmt.Errorf("not a boolean")
+	}
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return fmt.Errorf("not a boolean")
+	}
+	*b = v
+	return nil
+}
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_applyUserPatch(t *testing.T) {
+	t.Parallel()
+	newUser := func() *User {
+		return &User{
+			Id:          "u_1234567890",
+			ExternalId:  "ext-1",
+			UserName:    "alice",
+			DisplayName: "Alice",
+			Active:      true,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		ops          string
+		want         *User
+		wantScimType string
+	}{
+		{
+			name: "replace-active-string",
+			ops:  `[{"op":"Replace","path":"active","value":"False"}]`,
+			want: func() *User { u := newUser(); u.Active = false; return u }(),
+		},
+		{
+			name: "replace-without-path",
+			ops:  `[{"op":"replace","value":{"active":false,"displayName":"Alice Liddell"}}]`,
+			want: func() *User {
+				u := newUser()
+				u.Active = false
+				u.DisplayName = "Alice Liddell"
+				return u
+			}(),
+		},
+		{
+			name: "add-username-urn-path",
+			ops:  `[{"op":"add","path":"urn:ietf:params:scim:schemas:core:2.0:User:userName","value":"alice2"}]`,
+			want: func() *User { u := newUser(); u.UserName = "alice2"; return u }(),
+		},
+		{
+			name: "ignored-attribute",
+			ops:  `[{"op":"replace","path":"name.givenName","value":"Alice"}]`,
+			want: newUser(),
+		},
+		{
+			name: "remove-external-id",
+			ops:  `[{"op":"remove","path":"externalId"}]`,
+			want: func() *User { u := newUser(); u.ExternalId = ""; return u }(),
+		},
+		{
+			name:         "remove-username",
+			ops:          `[{"op":"remove","path":"userName"}]`,
+			wantScimType: scimTypeMutability,
+		},
+		{
+			name:         "remove-without-path",
+			ops:          `[{"op":"remove"}]`,
+			wantScimType: scimTypeNoTarget,
+		},
+		{
+			name:         "empty-username",
+			ops:          `[{"op":"replace","path":"userName","value":""}]`,
+			wantScimType: scimTypeInvalidValue,
+		},
+		{
+			name:         "invalid-active",
+			ops:          `[{"op":"replace","path":"active","value":"maybe"}]`,
+			wantScimType: scimTypeInvalidValue,
+		},
+		{
+			name:         "unsupported-op",
+			ops:          `[{"op":"move","path":"active","value":true}]`,
+			wantScimType: scimTypeInvalidSyntax,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			var ops []patchOperation
+			require.NoError(json.Unmarshal([]byte(tt.ops), &ops))
+			u := newUser()
+			err := applyUserPatch(u, ops)
+			if tt.wantScimType != "" {
+				require.Error(err)
+				var se *scimError
+				require.ErrorAs(err, &se)
+				assert.Equal(tt.wantScimType, se.ScimType)
+				assert.Equal("400", se.Status)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, u)
+		})
+	}
+}
+
+func Test_applyGroupPatch(t *testing.T) {
+	t.Parallel()
+	newGroup := func() *Group {
+		return &Group{
+			Id:          "g_1234567890",
+			DisplayName: "Engineering",
+			MemberIds:   []string{"u_1111111111", "u_2222222222"},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		ops          string
+		wantName     string
+		wantMembers  []string
+		wantScimType string
+	}{
+		{
+			name:        "add-members",
+			ops:         `[{"op":"add","path":"members","value":[{"value":"u_3333333333"},{"value":"u_1111111111"}]}]`,
+			wantName:    "Engineering",
+			wantMembers: []string{"u_1111111111", "u_2222222222", "u_3333333333"},
+		},
+		{
+			name:        "add-single-member-object",
+			ops:         `[{"op":"add","path":"members","value":{"value":"u_3333333333"}}]`,
+			wantName:    "Engineering",
+			wantMembers: []string{"u_1111111111", "u_2222222222", "u_3333333333"},
+		},
+		{
+			name:        "replace-members",
+			ops:         `[{"op":"replace","path":"members","value":[{"value":"u_3333333333"}]}]`,
+			wantName:    "Engineering",
+			wantMembers: []string{"u_3333333333"},
+		},
+		{
+			name:        "remove-member-filter",
+			ops:         `[{"op":"remove","path":"members[value eq \"u_1111111111\"]"}]`,
+			wantName:    "Engineering",
+			wantMembers: []string{"u_2222222222"},
+		},
+		{
+			name:        "remove-member-value",
+			ops:         `[{"op":"remove","path":"members","value":[{"value":"u_2222222222"}]}]`,
+			wantName:    "Engineering",
+			wantMembers: []string{"u_1111111111"},
+		},
+		{
+			name:        "remove-all-members",
+			ops:         `[{"op":"remove","path":"members"}]`,
+			wantName:    "Engineering",
+			wantMembers: []string{},
+		},
+		{
+			name:         "replace-display-name-without-path",
+			ops:          `[{"op":"replace","value":{"id":"g_1234567890","displayName":"Platform"}}]`,
+			wantScimType: scimTypeInvalidPath,
+		},
+		{
+			name:        "replace-display-name",
+			ops:         `[{"op":"replace","path":"displayName","value":"Platform"}]`,
+			wantName:    "Platform",
+			wantMembers: []string{"u_1111111111", "u_2222222222"},
+		},
+		{
+			name:         "remove-display-name",
+			ops:          `[{"op":"remove","path":"displayName"}]`,
+			wantScimType: scimTypeMutability,
+		},
+		{
+			name:         "add-member-filter",
+			ops:          `[{"op":"add","path":"members[value eq \"u_1111111111\"]","value":[]}]`,
+			wantScimType: scimTypeInvalidPath,
+		},
+		{
+			name:         "member-without-value",
+			ops:          `[{"op":"add","path":"members","value":[{"display":"bob"}]}]`,
+			wantScimType: scimTypeInvalidValue,
+		},
+		{
+			name:         "invalid-path",
+			ops:          `[{"op":"remove","path":"members[value eq"}]`,
+			wantScimType: scimTypeInvalidPath,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			var ops []patchOperation
+			require.NoError(json.Unmarshal([]byte(tt.ops), &ops))
+			g := newGroup()
+			err := applyGroupPatch(g, ops)
+			if tt.wantScimType != "" {
+				require.Error(err)
+				var se *scimError
+				require.ErrorAs(err, &se)
+				assert.Equal(tt.wantScimType, se.ScimType)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantName, g.DisplayName)
+			assert.ElementsMatch(tt.wantMembers, g.MemberIds)
+		})
+	}
+}
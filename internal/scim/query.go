@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+const (
+	deleteTokenQuery = `
+delete from scim_token
+ where auth_method_id = ?;
+`
+
+	updateUserQuery = `
+update scim_user
+   set account_id  = ?,
+       external_id = ?,
+       active      = ?
+ where auth_method_id = ?
+   and user_id = ?;
+`
+
+	countUsersQuery = `
+select count(*)
+  from scim_user
+ where auth_method_id = ?;
+`
+
+	listUserIdsQuery = `
+  select su.user_id
+    from scim_user su
+    join iam_user u
+      on u.public_id = su.user_id
+   where su.auth_method_id = ?
+order by u.create_time, u.public_id
+   limit ? offset ?;
+`
+
+	updateGroupQuery = `
+update scim_group
+   set external_id = ?
+ where auth_method_id = ?
+   and group_id = ?;
+`
+
+	tokenRewrapQuery = `
+update scim_token
+   set token  = ?,
+       key_id = ?
+ where auth_method_id = ?;
+`
+)
+
+const (
+	// lookupAccountQuery is formatted with the table and column of the
+	// account subtype holding the account's login name or subject.
+	lookupAccountQuery = `
+select s.public_id, a.iam_user_id
+  from %s s
+  join auth_account a
+    on a.public_id = s.public_id
+ where s.auth_method_id = ?
+   and s.%s = ?;
+`
+)
@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+)
+
+// ListGroups returns the groups provisioned into the auth method, ordered by
+// their creation time.
+func (r *Repository) ListGroups(ctx context.Context, authMethodId string) ([]*Group, error) {
+	const op = "scim.(Repository).ListGroups"
+	if authMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	var rows []*scimGroup
+	if err := r.reader.SearchWhere(ctx, &rows, "auth_method_id = ?", []any{authMethodId}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	groupIds := make([]string, 0, len(rows))
+	for _, row := range rows {
+		groupIds = append(groupIds, row.GroupId)
+	}
+	var iamGroups []*iam.Group
+	if err := r.reader.SearchWhere(ctx, &iamGroups, "public_id in (?)", []any{groupIds}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var members []*iam.GroupMember
+	if err := r.reader.SearchWhere(ctx, &members, "group_id in (?)", []any{groupIds}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	iamGroupsById := make(map[string]*iam.Group, len(iamGroups))
+	for _, g := range iamGroups {
+		iamGroupsById[g.GetPublicId()] = g
+	}
+	membersByGroupId := make(map[string][]*iam.GroupMember, len(iamGroups))
+	for _, m := range members {
+		membersByGroupId[m.GetGroupId()] = append(membersByGroupId[m.GetGroupId()], m)
+	}
+	groups := make([]*Group, 0, len(rows))
+	for _, row := range rows {
+		if g, ok := iamGroupsById[row.GroupId]; ok {
+			groups = append(groups, toGroup(row, g, membersByGroupId[row.GroupId]))
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].CreateTime.Equal(groups[j].CreateTime) {
+			return groups[i].Id < groups[j].Id
+		}
+		return groups[i].CreateTime.Before(groups[j].CreateTime)
+	})
+	return groups, nil
+}
+
+// LookupGroup returns the group provisioned into the auth method. A nil group
+// and nil error are returned if no such group exists.
+func (r *Repository) LookupGroup(ctx context.Context, authMethodId, groupId string) (*Group, error) {
+	const op = "scim.(Repository).LookupGroup"
+	switch {
+	case authMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case groupId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing group id")
+	}
+	row := &scimGroup{}
+	if err := r.reader.LookupWhere(ctx, row, "auth_method_id = ? and group_id = ?", []any{authMethodId, groupId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(groupId))
+	}
+	iamRepo, err := iam.NewRepository(ctx, r.reader, r.writer, r.kms)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	g, members, err := iamRepo.LookupGroup(ctx, groupId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if g == nil {
+		return nil, nil
+	}
+	return toGroup(row, g, members), nil
+}
+
+// CreateGroup provisions the group into the auth method in the scope. An iam
+// group named after the group's DisplayName is created whose members are the
+// group's members, which must be users provisioned into the same auth method.
+func (r *Repository) CreateGroup(ctx context.Context, scopeId string, g *Group) (_ *Group, retErr error) {
+	const op = "scim.(Repository).CreateGroup"
+	switch {
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case g == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing group")
+	case g.AuthMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case g.DisplayName == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing display name")
+	case g.Id != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "id must be empty")
+	}
+	if err := r.validateMembers(ctx, g.AuthMethodId, g.MemberIds); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	iamRepo, err := iam.NewRepository(ctx, r.reader, r.writer, r.kms)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	newGroup, err := iam.NewGroup(ctx, scopeId, iam.WithName(g.DisplayName))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	iamGroup, err := iamRepo.CreateGroup(ctx, newGroup)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer func() {
+		if retErr != nil {
+			// Don't leave a half provisioned group behind.
+			_, _ = iamRepo.DeleteGroup(ctx, iamGroup.GetPublicId())
+		}
+	}()
+	if len(g.MemberIds) > 0 {
+		if _, _, err := iamRepo.SetGroupMembers(ctx, iamGroup.GetPublicId(), iamGroup.GetVersion(), g.MemberIds); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	row := &scimGroup{
+		AuthMethodId: g.AuthMethodId,
+		GroupId:      iamGroup.GetPublicId(),
+		ExternalId:   g.ExternalId,
+	}
+	if err := r.writer.Create(ctx, row); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(g.DisplayName))
+	}
+	return r.LookupGroup(ctx, g.AuthMethodId, iamGroup.GetPublicId())
+}
+
+// UpdateGroup replaces the group provisioned into the auth method with g,
+// including its members.
+func (r *Repository) UpdateGroup(ctx context.Context, g *Group) (*Group, error) {
+	const op = "scim.(Repository).UpdateGroup"
+	switch {
+	case g == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing group")
+	case g.AuthMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case g.Id == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing id")
+	case g.DisplayName == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing display name")
+	}
+	current, err := r.LookupGroup(ctx, g.AuthMethodId, g.Id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if current == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("group %q not found", g.Id))
+	}
+	if err := r.validateMembers(ctx, g.AuthMethodId, g.MemberIds); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	iamRepo, err := iam.NewRepository(ctx, r.reader, r.writer, r.kms)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	iamGroup, _, err := iamRepo.LookupGroup(ctx, g.Id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if iamGroup == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("group %q not found", g.Id))
+	}
+	if g.DisplayName != current.DisplayName {
+		iamGroup.Name = g.DisplayName
+		if iamGroup, _, _, err = iamRepo.UpdateGroup(ctx, iamGroup, iamGroup.GetVersion(), []string{"Name"}); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if !sameMembers(current.MemberIds, g.MemberIds) {
+		if _, _, err := iamRepo.SetGroupMembers(ctx, g.Id, iamGroup.GetVersion(), g.MemberIds); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if _, err := r.writer.Exec(ctx, updateGroupQuery, []any{
+		sql.NullString{String: g.ExternalId, Valid: g.ExternalId != ""},
+		g.AuthMethodId,
+		g.Id,
+	}); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(g.Id))
+	}
+	return r.LookupGroup(ctx, g.AuthMethodId, g.Id)
+}
+
+// DeleteGroup deletes the group provisioned into the auth method and returns
+// the number of groups deleted.
+func (r *Repository) DeleteGroup(ctx context.Context, authMethodId, groupId string) (int, error) {
+	const op = "scim.(Repository).DeleteGroup"
+	current, err := r.LookupGroup(ctx, authMethodId, groupId)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if current == nil {
+		return db.NoRowsAffected, nil
+	}
+	iamRepo, err := iam.NewRepository(ctx, r.reader, r.writer, r.kms)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	rowsDeleted, err := iamRepo.DeleteGroup(ctx, groupId)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return rowsDeleted, nil
+}
+
+// validateMembers makes sure all the user ids are users provisioned into the
+// auth method.
+func (r *Repository) validateMembers(ctx context.Context, authMethodId string, userIds []string) error {
+	const op = "scim.(Repository).validateMembers"
+	if len(userIds) == 0 {
+		return nil
+	}
+	var rows []*scimUser
+	if err := r.reader.SearchWhere(ctx, &rows, "auth_method_id = ? and user_id in (?)", []any{authMethodId, userIds}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	found := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		found[row.UserId] = true
+	}
+	for _, id := range userIds {
+		if !found[id] {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("member %q is not a user provisioned into auth method %q", id, authMethodId))
+		}
+	}
+	return nil
+}
+
+func sameMembers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[string]bool, len(a))
+	for _, id := range a {
+		set[id] = true
+	}
+	for _, id := range b {
+		if !set[id] {
+			return false
+		}
+	}
+	return true
+}
+
+func toGroup(row *scimGroup, g *iam.Group, members []*iam.GroupMember) *Group {
+	memberIds := make([]string, 0, len(members))
+	for _, m := range members {
+		memberIds = append(memberIds, m.GetMemberId())
+	}
+	sort.Strings(memberIds)
+	return &Group{
+		Id:           g.GetPublicId(),
+		AuthMethodId: row.AuthMethodId,
+		ExternalId:   row.ExternalId,
+		DisplayName:  g.GetName(),
+		MemberIds:    memberIds,
+		CreateTime:   g.GetCreateTime().AsTime(),
+		UpdateTime:   g.GetUpdateTime().AsTime(),
+		Version:      g.GetVersion(),
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/go-secure-stdlib/base62"
+)
+
+// GenerateToken generates a new SCIM token for the auth method, replacing the
+// auth method's current token if it has one. The returned token contains the
+// plaintext token value, which cannot be retrieved again afterwards.
+func (r *Repository) GenerateToken(ctx context.Context, scopeId, authMethodId string) (*Token, error) {
+	const op = "scim.(Repository).GenerateToken"
+	switch {
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case authMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+
+	value, err := base62.Random(tokenLength)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	tok := &Token{
+		AuthMethodId: authMethodId,
+		ScopeId:      scopeId,
+		Token:        value,
+	}
+	if err := tok.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var newToken *Token
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, deleteTokenQuery, []any{authMethodId}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete current token"))
+			}
+			newToken = &Token{
+				AuthMethodId: tok.AuthMethodId,
+				ScopeId:      tok.ScopeId,
+				CtToken:      tok.CtToken,
+				KeyId:        tok.KeyId,
+			}
+			if err := w.Create(ctx, newToken); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(authMethodId))
+	}
+	newToken.Token = value
+	return newToken, nil
+}
+
+// RevokeToken deletes the SCIM token of the auth method and returns the number
+// of tokens deleted, which is zero if the auth method has no token.
+func (r *Repository) RevokeToken(ctx context.Context, authMethodId string) (int, error) {
+	const op = "scim.(Repository).RevokeToken"
+	if authMethodId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	rowsDeleted, err := r.writer.Exec(ctx, deleteTokenQuery, []any{authMethodId})
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(authMethodId))
+	}
+	return rowsDeleted, nil
+}
+
+// ValidateToken returns the SCIM token of the auth method if it matches the
+// provided token value. A nil token and nil error are returned if the auth
+// method has no token or the value does not match. The returned token does
+// not contain the plaintext token value.
+func (r *Repository) ValidateToken(ctx context.Context, authMethodId, value string) (*Token, error) {
+	const op = "scim.(Repository).ValidateToken"
+	switch {
+	case authMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case value == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token")
+	}
+
+	tok := &Token{}
+	if err := r.reader.LookupWhere(ctx, tok, "auth_method_id = ?", []any{authMethodId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(authMethodId))
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, tok.ScopeId, kms.KeyPurposeDatabase, kms.WithKeyId(tok.KeyId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := tok.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if subtle.ConstantTimeCompare([]byte(tok.Token), []byte(value)) != 1 {
+		return nil, nil
+	}
+	tok.Token = ""
+	return tok, nil
+}
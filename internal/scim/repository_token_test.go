@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Token(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+
+	rootWrapper := db.TestWrapper(t)
+
+	testConn, _ := db.TestSetup(t, "postgres")
+	testRw := db.New(testConn)
+
+	testKms := kms.TestKms(t, testConn, rootWrapper)
+
+	testRepo, err := NewRepository(testCtx, testRw, testRw, testKms)
+	require.NoError(t, err)
+
+	iamRepo := iam.TestRepo(t, testConn, rootWrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	ams := password.TestAuthMethods(t, testConn, org.PublicId, 2)
+	testAm, otherAm := ams[0], ams[1]
+
+	t.Run("generate-missing-scope", func(t *testing.T) {
+		_, err := testRepo.GenerateToken(testCtx, "", testAm.PublicId)
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("generate-missing-auth-method", func(t *testing.T) {
+		_, err := testRepo.GenerateToken(testCtx, org.PublicId, "")
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("validate-without-token", func(t *testing.T) {
+		tok, err := testRepo.ValidateToken(testCtx, testAm.PublicId, "not-a-token")
+		require.NoError(t, err)
+		assert.Nil(t, tok)
+	})
+	t.Run("generate-validate-revoke", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+
+		first, err := testRepo.GenerateToken(testCtx, org.PublicId, testAm.PublicId)
+		require.NoError(err)
+		assert.Equal(testAm.PublicId, first.AuthMethodId)
+		assert.Equal(org.PublicId, first.ScopeId)
+		assert.Len(first.Token, tokenLength)
+		assert.NotEmpty(first.KeyId)
+
+		got, err := testRepo.ValidateToken(testCtx, testAm.PublicId, first.Token)
+		require.NoError(err)
+		require.NotNil(got)
+		assert.Equal(org.PublicId, got.ScopeId)
+		assert.Empty(got.Token)
+
+		got, err = testRepo.ValidateToken(testCtx, otherAm.PublicId, first.Token)
+		require.NoError(err)
+		assert.Nil(got, "token must not be valid for another auth method")
+
+		// Generating a new token replaces the current one.
+		second, err := testRepo.GenerateToken(testCtx, org.PublicId, testAm.PublicId)
+		require.NoError(err)
+		assert.NotEqual(first.Token, second.Token)
+
+		got, err = testRepo.ValidateToken(testCtx, testAm.PublicId, first.Token)
+		require.NoError(err)
+		assert.Nil(got)
+		got, err = testRepo.ValidateToken(testCtx, testAm.PublicId, second.Token)
+		require.NoError(err)
+		assert.NotNil(got)
+
+		rows, err := testRepo.RevokeToken(testCtx, testAm.PublicId)
+		require.NoError(err)
+		assert.Equal(1, rows)
+
+		got, err = testRepo.ValidateToken(testCtx, testAm.PublicId, second.Token)
+		require.NoError(err)
+		assert.Nil(got)
+
+		rows, err = testRepo.RevokeToken(testCtx, testAm.PublicId)
+		require.NoError(err)
+		assert.Equal(0, rows)
+	})
+}
@@ -0,0 +1,382 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+)
+
+// ListUsers returns the page of users provisioned into the auth method that
+// starts at the 0-based offset and holds at most limit users, ordered by their
+// creation time, along with the number of users provisioned into the auth
+// method.
+func (r *Repository) ListUsers(ctx context.Context, authMethodId string, offset, limit int) ([]*User, int, error) {
+	const op = "scim.(Repository).ListUsers"
+	switch {
+	case authMethodId == "":
+		return nil, 0, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case offset < 0:
+		return nil, 0, errors.New(ctx, errors.InvalidParameter, op, "negative offset")
+	case limit < 0:
+		return nil, 0, errors.New(ctx, errors.InvalidParameter, op, "negative limit")
+	}
+	var total int
+	countRows, err := r.reader.Query(ctx, countUsersQuery, []any{authMethodId})
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	defer countRows.Close()
+	for countRows.Next() {
+		if err := r.reader.ScanRows(ctx, countRows, &total); err != nil {
+			return nil, 0, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := countRows.Err(); err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	if total == 0 || limit == 0 || offset >= total {
+		return nil, total, nil
+	}
+
+	idRows, err := r.reader.Query(ctx, listUserIdsQuery, []any{authMethodId, limit, offset})
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	defer idRows.Close()
+	var userIds []string
+	for idRows.Next() {
+		var id string
+		if err := idRows.Scan(&id); err != nil {
+			return nil, 0, errors.Wrap(ctx, err, op)
+		}
+		userIds = append(userIds, id)
+	}
+	if err := idRows.Err(); err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	if len(userIds) == 0 {
+		return nil, total, nil
+	}
+
+	var rows []*scimUser
+	if err := r.reader.SearchWhere(ctx, &rows, "auth_method_id = ? and user_id in (?)", []any{authMethodId, userIds}, db.WithLimit(-1)); err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	var iamUsers []*iam.User
+	if err := r.reader.SearchWhere(ctx, &iamUsers, "public_id in (?)", []any{userIds}, db.WithLimit(-1)); err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	rowsByUserId := make(map[string]*scimUser, len(rows))
+	for _, row := range rows {
+		rowsByUserId[row.UserId] = row
+	}
+	iamUsersById := make(map[string]*iam.User, len(iamUsers))
+	for _, u := range iamUsers {
+		iamUsersById[u.GetPublicId()] = u
+	}
+	users := make([]*User, 0, len(userIds))
+	for _, id := range userIds {
+		row, ok := rowsByUserId[id]
+		if !ok {
+			continue
+		}
+		if u, ok := iamUsersById[id]; ok {
+			users = append(users, toUser(row, u))
+		}
+	}
+	return users, total, nil
+}
+
+// LookupUser returns the user provisioned into the auth method. A nil user
+// and nil error are returned if no such user exists.
+func (r *Repository) LookupUser(ctx context.Context, authMethodId, userId string) (*User, error) {
+	const op = "scim.(Repository).LookupUser"
+	switch {
+	case authMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case userId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	row := &scimUser{}
+	if err := r.reader.LookupWhere(ctx, row, "auth_method_id = ? and user_id = ?", []any{authMethodId, userId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(userId))
+	}
+	iamRepo, err := iam.NewRepository(ctx, r.reader, r.writer, r.kms)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	u, _, err := iamRepo.LookupUser(ctx, userId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if u == nil {
+		return nil, nil
+	}
+	return toUser(row, u), nil
+}
+
+// CreateUser provisions the user into the auth method in the scope. An iam
+// user named after the user's UserName is always created for the user, so
+// the iam users SCIM manages, and deletes, are only ever the ones it created.
+// If the user is active, it is given the account named after its UserName in
+// the auth method. An existing account with that name is adopted only if it
+// is not associated with any user, for example because it was created before
+// the user was provisioned; otherwise the user cannot be created.
+func (r *Repository) CreateUser(ctx context.Context, scopeId string, u *User) (*User, error) {
+	const op = "scim.(Repository).CreateUser"
+	switch {
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case u == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user")
+	case u.AuthMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case u.UserName == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user name")
+	case u.Id != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "id must be empty")
+	}
+	name, err := accountNameFor(ctx, u.AuthMethodId, u.UserName)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	newUser, err := iam.NewUser(ctx, scopeId, iam.WithName(u.UserName), iam.WithDescription(u.DisplayName))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var userId string
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			_, accountUserId, err := lookupAccount(ctx, reader, u.AuthMethodId, name)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if accountUserId != "" {
+				return errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("account %q is already associated with a user", name))
+			}
+			iamRepo, err := iam.NewRepository(ctx, reader, w, r.kms)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			iamUser, err := iamRepo.CreateUser(ctx, newUser, iam.WithReaderWriter(reader, w))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			userId = iamUser.GetPublicId()
+
+			var accountId string
+			if u.Active {
+				if accountId, err = r.ensureAccount(ctx, reader, w, iamRepo, scopeId, u.AuthMethodId, name, userId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			row := &scimUser{
+				AuthMethodId: u.AuthMethodId,
+				UserId:       userId,
+				AccountId:    accountId,
+				ExternalId:   u.ExternalId,
+				Active:       u.Active,
+			}
+			if err := w.Create(ctx, row); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(u.UserName))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return r.LookupUser(ctx, u.AuthMethodId, userId)
+}
+
+// UpdateUser replaces the user provisioned into the auth method in the scope
+// with u. Renaming the user or deactivating it deletes its account, which
+// also deletes the auth tokens issued for the account. Activating the user or
+// renaming an active user creates a new account.
+func (r *Repository) UpdateUser(ctx context.Context, scopeId string, u *User) (*User, error) {
+	const op = "scim.(Repository).UpdateUser"
+	switch {
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case u == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user")
+	case u.AuthMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case u.Id == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing id")
+	case u.UserName == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user name")
+	}
+	current, err := r.LookupUser(ctx, u.AuthMethodId, u.Id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if current == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("user %q not found", u.Id))
+	}
+	name, err := accountNameFor(ctx, u.AuthMethodId, u.UserName)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	currentName, err := accountNameFor(ctx, u.AuthMethodId, current.UserName)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			iamRepo, err := iam.NewRepository(ctx, reader, w, r.kms)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			iamUser, _, err := iamRepo.LookupUser(ctx, u.Id)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if iamUser == nil {
+				return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("user %q not found", u.Id))
+			}
+			var fieldMask []string
+			if u.UserName != current.UserName {
+				iamUser.Name = u.UserName
+				fieldMask = append(fieldMask, "Name")
+			}
+			if u.DisplayName != current.DisplayName {
+				iamUser.Description = u.DisplayName
+				fieldMask = append(fieldMask, "Description")
+			}
+			if len(fieldMask) > 0 {
+				if _, _, _, err = iamRepo.UpdateUser(ctx, iamUser, iamUser.GetVersion(), fieldMask, iam.WithReaderWriter(reader, w)); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+
+			accountId := current.AccountId
+			if accountId != "" && (!u.Active || name != currentName) {
+				if err := r.deleteAccount(ctx, reader, w, scopeId, u.AuthMethodId, accountId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				accountId = ""
+			}
+			if u.Active && accountId == "" {
+				if accountId, err = r.ensureAccount(ctx, reader, w, iamRepo, scopeId, u.AuthMethodId, name, u.Id); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+
+			if _, err := w.Exec(ctx, updateUserQuery, []any{
+				sql.NullString{String: accountId, Valid: accountId != ""},
+				sql.NullString{String: u.ExternalId, Valid: u.ExternalId != ""},
+				u.Active,
+				u.AuthMethodId,
+				u.Id,
+			}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(u.Id))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return r.LookupUser(ctx, u.AuthMethodId, u.Id)
+}
+
+// DeleteUser deletes the user provisioned into the auth method in the scope,
+// along with its account, and returns the number of users deleted. Only iam
+// users created by CreateUser are provisioned, so only those are deleted.
+func (r *Repository) DeleteUser(ctx context.Context, scopeId, authMethodId, userId string) (int, error) {
+	const op = "scim.(Repository).DeleteUser"
+	if scopeId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	current, err := r.LookupUser(ctx, authMethodId, userId)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if current == nil {
+		return db.NoRowsAffected, nil
+	}
+	var rowsDeleted int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			if current.AccountId != "" {
+				if err := r.deleteAccount(ctx, reader, w, scopeId, authMethodId, current.AccountId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			iamRepo, err := iam.NewRepository(ctx, reader, w, r.kms)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted, err = iamRepo.DeleteUser(ctx, userId, iam.WithReaderWriter(reader, w)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return rowsDeleted, nil
+}
+
+// ensureAccount makes sure the user is associated with the account named name
+// in the auth method, creating the account if it does not exist, and returns
+// the account's id. All changes are made using the provided transaction.
+func (r *Repository) ensureAccount(ctx context.Context, reader db.Reader, w db.Writer, iamRepo *iam.Repository, scopeId, authMethodId, name, userId string) (string, error) {
+	const op = "scim.(Repository).ensureAccount"
+	accountId, accountUserId, err := lookupAccount(ctx, reader, authMethodId, name)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case accountUserId == userId:
+		return accountId, nil
+	case accountUserId != "":
+		return "", errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("account %q is associated with another user", name))
+	case accountId == "":
+		if accountId, err = r.createAccount(ctx, reader, w, scopeId, authMethodId, name); err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+	}
+	// Look the user up again, since its version has to be current to add the
+	// account to it.
+	u, _, err := iamRepo.LookupUser(ctx, userId)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	if u == nil {
+		return "", errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("user %q not found", userId))
+	}
+	if _, err := iamRepo.AddUserAccounts(ctx, userId, u.GetVersion(), []string{accountId}, iam.WithReaderWriter(reader, w)); err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return accountId, nil
+}
+
+func toUser(row *scimUser, u *iam.User) *User {
+	return &User{
+		Id:           u.GetPublicId(),
+		AuthMethodId: row.AuthMethodId,
+		ExternalId:   row.ExternalId,
+		UserName:     u.GetName(),
+		DisplayName:  u.GetDescription(),
+		Active:       row.Active,
+		AccountId:    row.AccountId,
+		CreateTime:   u.GetCreateTime().AsTime(),
+		UpdateTime:   u.GetUpdateTime().AsTime(),
+		Version:      u.GetVersion(),
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+)
+
+const (
+	defaultTokenTableName = "scim_token"
+
+	// tokenLength is the number of base62 characters in a generated token.
+	tokenLength = 32
+)
+
+// Token is the bearer token a SCIM client uses to provision users and groups
+// into an auth method. The token value is only set when the token is
+// generated.
+type Token struct {
+	// AuthMethodId of the auth method the token provisions into
+	AuthMethodId string `json:"auth_method_id,omitempty" gorm:"primary_key"`
+	// ScopeId of the auth method
+	ScopeId string `json:"scope_id,omitempty" gorm:"default:null"`
+	// Token is the plaintext token value
+	Token string `json:"token,omitempty" gorm:"-" wrapping:"pt,token"`
+	// CtToken is the encrypted token value stored in the database
+	CtToken []byte `json:"-" gorm:"column:token" wrapping:"ct,token"`
+	// KeyId of the kms database key used to encrypt the token
+	KeyId string `json:"key_id,omitempty"`
+	// CreateTime from the RDBMS
+	CreateTime *timestamp.Timestamp `json:"create_time,omitempty" gorm:"default:current_timestamp"`
+}
+
+// TableName returns the table name.
+func (t *Token) TableName() string {
+	return defaultTokenTableName
+}
+
+func (t *Token) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "scim.(Token).encrypt"
+	if err := structwrapping.WrapStruct(ctx, cipher, t, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	var err error
+	t.KeyId, err = cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get cipher key id"))
+	}
+	return nil
+}
+
+func (t *Token) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "scim.(Token).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, t, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+)
+
+const defaultUserTableName = "scim_user"
+
+// User is a user provisioned by a SCIM client into an auth method. It is
+// stored as an iam user in the auth method's scope and, while active, the
+// account the user authenticates with in the auth method.
+type User struct {
+	// Id of the iam user, which is also the user's SCIM id
+	Id string
+	// AuthMethodId of the auth method the user is provisioned into
+	AuthMethodId string
+	// ExternalId is the id the SCIM client assigned to the user
+	ExternalId string
+	// UserName is the name of the iam user. It is also the login name or
+	// subject of the user's account.
+	UserName string
+	// DisplayName is the description of the iam user
+	DisplayName string
+	// Active reports whether the user has an account to authenticate with
+	Active bool
+	// AccountId of the user's account; empty if the user is not active
+	AccountId string
+	// CreateTime of the iam user
+	CreateTime time.Time
+	// UpdateTime of the iam user
+	UpdateTime time.Time
+	// Version of the iam user
+	Version uint32
+}
+
+// scimUser is the stored mapping of a provisioned user onto an iam user and
+// account.
+type scimUser struct {
+	AuthMethodId string `gorm:"primary_key"`
+	UserId       string `gorm:"primary_key"`
+	AccountId    string `gorm:"default:null"`
+	ExternalId   string `gorm:"default:null"`
+	Active       bool
+	CreateTime   *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	UpdateTime   *timestamp.Timestamp `gorm:"default:current_timestamp"`
+}
+
+// TableName returns the table name.
+func (u *scimUser) TableName() string {
+	return defaultUserTableName
+}
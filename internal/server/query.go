@@ -14,6 +14,14 @@ const (
 	and
 		worker_id = ?`
 
+	clearWorkerDrainDeadlineSql = `
+	update server_worker
+		set drain_deadline = null
+	where
+		public_id = ?
+	and
+		drain_deadline is not null`
+
 	deleteWorkerAuthQuery = `
 		delete from worker_auth_authorized
  		where worker_key_identifier = @worker_key_identifier;
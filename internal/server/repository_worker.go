@@ -8,8 +8,10 @@ import (
 	stderrors "errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/server/store"
@@ -244,7 +246,9 @@ func ListWorkers(ctx context.Context, reader db.Reader, scopeIds []string, opt .
 	}
 
 	if opts.withActiveWorkers {
-		where = append(where, "operational_state = ?")
+		// Workers asked to drain are excluded even before they report that
+		// they are draining.
+		where = append(where, "operational_state = ?", "drain_deadline is null")
 		whereArgs = append(whereArgs, ActiveOperationalState.String())
 	}
 
@@ -386,6 +390,15 @@ func (r *Repository) UpsertWorkerStatus(ctx context.Context, worker *Worker, opt
 				}
 			}
 
+			// A worker reporting that it has shut down has finished any drain
+			// that was requested of it, so clear the deadline to ensure it is
+			// not drained again once it comes back.
+			if workerClone.GetOperationalState() == ShutdownOperationalState.String() {
+				if _, err := w.Exec(ctx, clearWorkerDrainDeadlineSql, []any{workerClone.GetPublicId()}); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("error clearing worker drain deadline"))
+				}
+			}
+
 			// If we've been told to update tags, we need to clean out old
 			// ones and add new ones. Within the current transaction, simply
 			// delete all tags for the given worker, then add the new ones
@@ -682,6 +695,50 @@ func (r *Repository) CreateWorker(ctx context.Context, worker *Worker, opt ...Op
 	return returnedWorker, nil
 }
 
+// DrainWorker marks the worker as draining. The worker will not be selected
+// for new sessions and is told, via its status response, to let its existing
+// connections finish until the deadline and then shut down. No options are
+// currently supported.
+func (r *Repository) DrainWorker(ctx context.Context, workerId string, workerVersion uint32, deadline time.Time, _ ...Option) (*Worker, error) {
+	const op = "server.(Repository).DrainWorker"
+	switch {
+	case workerId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "worker public id is empty")
+	case workerVersion == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	case deadline.IsZero():
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing drain deadline")
+	}
+
+	var ret *Worker
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(reader db.Reader, w db.Writer) error {
+		worker := NewWorker(scope.Global.String())
+		worker.PublicId = workerId
+		worker.Version = workerVersion + 1
+		worker.DrainDeadline = timestamp.New(deadline)
+		rowsUpdated, err := w.Update(ctx, worker, []string{"Version", "DrainDeadline"}, nil, db.WithVersion(&workerVersion))
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to set worker drain deadline"))
+		}
+		if rowsUpdated != 1 {
+			return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated worker drain deadline and %d rows updated", rowsUpdated))
+		}
+
+		wAgg := &workerAggregate{PublicId: workerId}
+		if err := reader.LookupById(ctx, wAgg); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if ret, err = wAgg.toWorker(ctx); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for %s", workerId)))
+	}
+	return ret, nil
+}
+
 // AddWorkerTags adds specified api tags to the repo worker and returns its new tags.
 // No options are currently supported.
 func (r *Repository) AddWorkerTags(ctx context.Context, workerId string, workerVersion uint32, tags []*Tag, _ ...Option) ([]*Tag, error) {
@@ -829,6 +829,52 @@ func TestListWorkers_WithActiveWorkers(t *testing.T) {
 	}
 }
 
+func TestRepository_DrainWorker(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	serversRepo, err := server.NewRepository(ctx, rw, rw, kms)
+	require.NoError(err)
+
+	worker := server.TestKmsWorker(t, conn, wrapper)
+	server.TestKmsWorker(t, conn, wrapper)
+	deadline := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	_, err = serversRepo.DrainWorker(ctx, "", worker.GetVersion(), deadline)
+	assert.Error(err)
+	_, err = serversRepo.DrainWorker(ctx, worker.GetPublicId(), 0, deadline)
+	assert.Error(err)
+	_, err = serversRepo.DrainWorker(ctx, worker.GetPublicId(), worker.GetVersion(), time.Time{})
+	assert.Error(err)
+	_, err = serversRepo.DrainWorker(ctx, worker.GetPublicId(), worker.GetVersion()+10, deadline)
+	assert.Error(err)
+
+	drained, err := serversRepo.DrainWorker(ctx, worker.GetPublicId(), worker.GetVersion(), deadline)
+	require.NoError(err)
+	assert.Equal(worker.GetVersion()+1, drained.GetVersion())
+	assert.True(deadline.Equal(drained.GetDrainDeadline().AsTime()))
+
+	// The drained worker is no longer considered active
+	got, err := serversRepo.ListWorkers(ctx, []string{scope.Global.String()}, server.WithActiveWorkers(true))
+	require.NoError(err)
+	require.Len(got, 1)
+	assert.NotEqual(worker.GetPublicId(), got[0].GetPublicId())
+
+	// Reporting shutdown clears the deadline
+	shutdown, err := serversRepo.UpsertWorkerStatus(ctx,
+		server.NewWorker(scope.Global.String(),
+			server.WithName(worker.GetName()),
+			server.WithAddress(worker.GetAddress()),
+			server.WithOperationalState(server.ShutdownOperationalState.String())),
+		server.WithPublicId(worker.GetPublicId()))
+	require.NoError(err)
+	assert.Nil(shutdown.GetDrainDeadline())
+}
+
 func TestListWorkers_WithLiveness(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -6,6 +6,7 @@ package server
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/fatih/structs"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
@@ -25,10 +26,15 @@ const (
 	KmsWorkerType            WorkerType       = "kms"
 	PkiWorkerType            WorkerType       = "pki"
 	ActiveOperationalState   OperationalState = "active"
+	DrainingOperationalState OperationalState = "draining"
 	ShutdownOperationalState OperationalState = "shutdown"
 	UnknownOperationalState  OperationalState = "unknown"
 )
 
+// DefaultDrainTimeout is the amount of time a draining worker is given to let
+// its existing connections finish when no timeout is specified.
+const DefaultDrainTimeout = time.Hour
+
 func (t WorkerType) Valid() bool {
 	switch t {
 	case KmsWorkerType, PkiWorkerType:
@@ -51,7 +57,7 @@ type workerAuthWorkerId struct {
 
 func ValidOperationalState(s string) bool {
 	switch s {
-	case ActiveOperationalState.String(), ShutdownOperationalState.String():
+	case ActiveOperationalState.String(), DrainingOperationalState.String(), ShutdownOperationalState.String():
 		return true
 	}
 	return false
@@ -59,7 +65,7 @@ func ValidOperationalState(s string) bool {
 
 func (t OperationalState) String() string {
 	switch t {
-	case ActiveOperationalState, ShutdownOperationalState:
+	case ActiveOperationalState, DrainingOperationalState, ShutdownOperationalState:
 		return string(t)
 	}
 	return string(UnknownOperationalState)
@@ -231,6 +237,7 @@ type workerAggregate struct {
 	ApiTags               string
 	ActiveConnectionCount uint32
 	OperationalState      string
+	DrainDeadline         *timestamp.Timestamp
 	// Config Fields
 	LastStatusTime   *timestamp.Timestamp
 	WorkerConfigTags string
@@ -252,6 +259,7 @@ func (a *workerAggregate) toWorker(ctx context.Context) (*Worker, error) {
 			Type:             a.Type,
 			ReleaseVersion:   a.ReleaseVersion,
 			OperationalState: a.OperationalState,
+			DrainDeadline:    a.DrainDeadline,
 		},
 		activeConnectionCount: a.ActiveConnectionCount,
 	}
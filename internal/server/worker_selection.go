@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package server
+
+import (
+	"math/rand"
+	"net"
+	"sort"
+	"strconv"
+)
+
+// WorkerSelectionStrategy determines the order in which the workers able to
+// handle a session are provided to the client when authorizing the session.
+type WorkerSelectionStrategy string
+
+const (
+	// UnknownWorkerSelection means no strategy was specified
+	UnknownWorkerSelection WorkerSelectionStrategy = ""
+
+	// RandomWorkerSelection orders workers randomly
+	RandomWorkerSelection WorkerSelectionStrategy = "random"
+
+	// LeastConnectionsWorkerSelection orders workers by their number of active
+	// connections relative to their capacity, least loaded first
+	LeastConnectionsWorkerSelection WorkerSelectionStrategy = "least_connections"
+
+	// LocalityWorkerSelection orders workers whose subnets contain the address
+	// of the endpoint first, followed by workers whose subnets contain the
+	// address of the client, followed by all other workers. Within each of
+	// these groups workers are ordered as with
+	// LeastConnectionsWorkerSelection.
+	LocalityWorkerSelection WorkerSelectionStrategy = "locality"
+)
+
+const (
+	// WorkerCapacityTagKey is the tag key used to set the number of
+	// connections a worker can handle, relative to other workers. Workers
+	// without a valid capacity tag have a capacity of 1.
+	WorkerCapacityTagKey = "capacity"
+
+	// WorkerSubnetTagKey is the tag key used to set the subnets, in CIDR
+	// notation, that a worker is close to.
+	WorkerSubnetTagKey = "subnet"
+)
+
+// Valid returns true if the strategy is known. UnknownWorkerSelection is not
+// valid.
+func (s WorkerSelectionStrategy) Valid() bool {
+	switch s {
+	case RandomWorkerSelection, LeastConnectionsWorkerSelection, LocalityWorkerSelection:
+		return true
+	}
+	return false
+}
+
+func (s WorkerSelectionStrategy) String() string {
+	return string(s)
+}
+
+// workerRank is a worker along with the values it is ordered by
+type workerRank struct {
+	worker *Worker
+
+	// tier is the locality tier of the worker, lowest first
+	tier int
+
+	// load is the number of active connections relative to capacity
+	load float64
+}
+
+// OrderWorkers returns the workers ordered according to the strategy. The
+// localityAddrs are the addresses, in order of preference, that are matched
+// against the subnets of workers when using LocalityWorkerSelection;
+// addresses which are not IP addresses are ignored. An unknown strategy orders
+// workers randomly. Ties between workers are broken randomly.
+func OrderWorkers(workers []*Worker, strategy WorkerSelectionStrategy, localityAddrs ...string) []*Worker {
+	ranks := make([]workerRank, 0, len(workers))
+	for _, w := range workers {
+		ranks = append(ranks, workerRank{worker: w})
+	}
+	rand.Shuffle(len(ranks), func(i, j int) {
+		ranks[i], ranks[j] = ranks[j], ranks[i]
+	})
+
+	switch strategy {
+	case LeastConnectionsWorkerSelection, LocalityWorkerSelection:
+		var ips []net.IP
+		if strategy == LocalityWorkerSelection {
+			for _, addr := range localityAddrs {
+				if ip := net.ParseIP(addr); ip != nil {
+					ips = append(ips, ip)
+				}
+			}
+		}
+		for i := range ranks {
+			ranks[i].load = float64(ranks[i].worker.ActiveConnectionCount()) / float64(workerCapacity(ranks[i].worker))
+			ranks[i].tier = localityTier(ranks[i].worker, ips)
+		}
+		sort.SliceStable(ranks, func(i, j int) bool {
+			if ranks[i].tier != ranks[j].tier {
+				return ranks[i].tier < ranks[j].tier
+			}
+			return ranks[i].load < ranks[j].load
+		})
+	}
+
+	ret := make([]*Worker, 0, len(ranks))
+	for _, r := range ranks {
+		ret = append(ret, r.worker)
+	}
+	return ret
+}
+
+// workerCapacity returns the capacity of the worker from its tags, or 1 if it
+// does not have a valid capacity tag
+func workerCapacity(w *Worker) uint64 {
+	for _, v := range w.CanonicalTags()[WorkerCapacityTagKey] {
+		if c, err := strconv.ParseUint(v, 10, 32); err == nil && c > 0 {
+			return c
+		}
+	}
+	return 1
+}
+
+// localityTier returns the index of the first of the ips that is contained in
+// one of the worker's subnets, or len(ips) if none are
+func localityTier(w *Worker, ips []net.IP) int {
+	if len(ips) == 0 {
+		return 0
+	}
+	var subnets []*net.IPNet
+	for _, v := range w.CanonicalTags()[WorkerSubnetTagKey] {
+		if _, subnet, err := net.ParseCIDR(v); err == nil {
+			subnets = append(subnets, subnet)
+		}
+	}
+	for i, ip := range ips {
+		for _, subnet := range subnets {
+			if subnet.Contains(ip) {
+				return i
+			}
+		}
+	}
+	return len(ips)
+}
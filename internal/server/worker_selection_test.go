@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package server
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerSelectionStrategy_Valid(t *testing.T) {
+	assert.True(t, RandomWorkerSelection.Valid())
+	assert.True(t, LeastConnectionsWorkerSelection.Valid())
+	assert.True(t, LocalityWorkerSelection.Valid())
+	assert.False(t, UnknownWorkerSelection.Valid())
+	assert.False(t, WorkerSelectionStrategy("round_robin").Valid())
+}
+
+func TestOrderWorkers(t *testing.T) {
+	newWorker := func(name string, conns uint32, tags ...*Tag) *Worker {
+		w := NewWorker(scope.Global.String(), WithName(name))
+		w.activeConnectionCount = conns
+		w.configTags = tags
+		return w
+	}
+	names := func(ws []*Worker) []string {
+		var ret []string
+		for _, w := range ws {
+			ret = append(ret, w.GetName())
+		}
+		return ret
+	}
+
+	t.Run("random", func(t *testing.T) {
+		workers := []*Worker{newWorker("a", 0), newWorker("b", 0), newWorker("c", 0)}
+		got := OrderWorkers(workers, RandomWorkerSelection)
+		assert.ElementsMatch(t, []string{"a", "b", "c"}, names(got))
+	})
+	t.Run("least-connections", func(t *testing.T) {
+		workers := []*Worker{newWorker("busy", 10), newWorker("idle", 0), newWorker("some", 3)}
+		got := OrderWorkers(workers, LeastConnectionsWorkerSelection)
+		assert.Equal(t, []string{"idle", "some", "busy"}, names(got))
+	})
+	t.Run("least-connections-weighted", func(t *testing.T) {
+		workers := []*Worker{
+			newWorker("large", 50, &Tag{Key: WorkerCapacityTagKey, Value: "100"}),
+			newWorker("small", 5),
+			newWorker("medium", 8, &Tag{Key: WorkerCapacityTagKey, Value: "10"}),
+			newWorker("invalid", 2, &Tag{Key: WorkerCapacityTagKey, Value: "lots"}),
+		}
+		got := OrderWorkers(workers, LeastConnectionsWorkerSelection)
+		assert.Equal(t, []string{"large", "medium", "invalid", "small"}, names(got))
+	})
+	t.Run("locality", func(t *testing.T) {
+		workers := []*Worker{
+			newWorker("elsewhere", 0),
+			newWorker("client-near", 0, &Tag{Key: WorkerSubnetTagKey, Value: "192.168.0.0/16"}),
+			newWorker("host-near-busy", 5, &Tag{Key: WorkerSubnetTagKey, Value: "10.0.0.0/8"}),
+			newWorker("host-near", 1, &Tag{Key: WorkerSubnetTagKey, Value: "10.1.0.0/16"}),
+		}
+		got := OrderWorkers(workers, LocalityWorkerSelection, "10.1.2.3", "192.168.1.1")
+		assert.Equal(t, []string{"host-near", "host-near-busy", "client-near", "elsewhere"}, names(got))
+	})
+	t.Run("locality-hostname", func(t *testing.T) {
+		workers := []*Worker{
+			newWorker("near", 3, &Tag{Key: WorkerSubnetTagKey, Value: "10.0.0.0/8"}),
+			newWorker("idle", 0),
+		}
+		// Addresses which are not IPs are ignored, leaving only load
+		got := OrderWorkers(workers, LocalityWorkerSelection, "db.example.com")
+		assert.Equal(t, []string{"idle", "near"}, names(got))
+	})
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, OrderWorkers(nil, LocalityWorkerSelection))
+	})
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultAccessRequestTableName = "session_access_request"
+)
+
+// AccessRequestStatus of an access request
+type AccessRequestStatus string
+
+const (
+	AccessRequestPending  AccessRequestStatus = "pending"
+	AccessRequestApproved AccessRequestStatus = "approved"
+	AccessRequestDenied   AccessRequestStatus = "denied"
+	AccessRequestRedeemed AccessRequestStatus = "redeemed"
+
+	// AccessRequestExpired is never stored in the database. It is reported
+	// for approved access requests whose approval expiration time has passed.
+	AccessRequestExpired AccessRequestStatus = "expired"
+)
+
+// String representation of the access request's status
+func (s AccessRequestStatus) String() string {
+	return string(s)
+}
+
+// AccessRequest is a request by a user to authorize a session to a target
+// which requires approval. An approved access request can be redeemed by the
+// requesting user once, before its approval expiration time, to authorize a
+// session.
+type AccessRequest struct {
+	// PublicId is used to access the access request via an API
+	PublicId string `json:"public_id,omitempty" gorm:"primary_key"`
+	// TargetId of the target access is requested for
+	TargetId string `json:"target_id,omitempty" gorm:"default:null"`
+	// ProjectId of the target access is requested for
+	ProjectId string `json:"project_id,omitempty" gorm:"default:null"`
+	// UserId of the user requesting access
+	UserId string `json:"user_id,omitempty" gorm:"default:null"`
+	// Reason given by the user for requesting access
+	Reason string `json:"reason,omitempty" gorm:"default:null"`
+	// Status of the access request
+	Status AccessRequestStatus `json:"status,omitempty" gorm:"default:null"`
+	// ApproverId of the user who approved or denied the access request
+	ApproverId string `json:"approver_id,omitempty" gorm:"default:null"`
+	// ApprovalExpirationTime - after this time an approved access request can
+	// no longer be redeemed
+	ApprovalExpirationTime *timestamp.Timestamp `json:"approval_expiration_time,omitempty" gorm:"default:null"`
+	// SessionId of the session created when the access request was redeemed
+	SessionId string `json:"session_id,omitempty" gorm:"default:null"`
+	// CreateTime from the RDBMS
+	CreateTime *timestamp.Timestamp `json:"create_time,omitempty" gorm:"default:current_timestamp"`
+	// UpdateTime from the RDBMS
+	UpdateTime *timestamp.Timestamp `json:"update_time,omitempty" gorm:"default:current_timestamp"`
+	// Version of the access request
+	Version uint32 `json:"version,omitempty" gorm:"default:null"`
+
+	tableName string `gorm:"-"`
+}
+
+var (
+	_ Cloneable       = (*AccessRequest)(nil)
+	_ db.VetForWriter = (*AccessRequest)(nil)
+)
+
+// NewAccessRequest creates a new in memory pending access request. No options
+// are currently supported.
+func NewAccessRequest(ctx context.Context, targetId, projectId, userId, reason string, _ ...Option) (*AccessRequest, error) {
+	const op = "session.NewAccessRequest"
+	r := AccessRequest{
+		TargetId:  targetId,
+		ProjectId: projectId,
+		UserId:    userId,
+		Reason:    reason,
+		Status:    AccessRequestPending,
+	}
+	if err := r.validateNewAccessRequest(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &r, nil
+}
+
+// AllocAccessRequest will allocate an AccessRequest.
+func AllocAccessRequest() AccessRequest {
+	return AccessRequest{}
+}
+
+// GetPublicId returns the public id of the access request.
+func (r *AccessRequest) GetPublicId() string {
+	return r.PublicId
+}
+
+// Clone creates a clone of the AccessRequest.
+func (r *AccessRequest) Clone() any {
+	clone := &AccessRequest{
+		PublicId:   r.PublicId,
+		TargetId:   r.TargetId,
+		ProjectId:  r.ProjectId,
+		UserId:     r.UserId,
+		Reason:     r.Reason,
+		Status:     r.Status,
+		ApproverId: r.ApproverId,
+		SessionId:  r.SessionId,
+		Version:    r.Version,
+	}
+	if r.ApprovalExpirationTime != nil {
+		clone.ApprovalExpirationTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: r.ApprovalExpirationTime.Timestamp.Seconds,
+				Nanos:   r.ApprovalExpirationTime.Timestamp.Nanos,
+			},
+		}
+	}
+	if r.CreateTime != nil {
+		clone.CreateTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: r.CreateTime.Timestamp.Seconds,
+				Nanos:   r.CreateTime.Timestamp.Nanos,
+			},
+		}
+	}
+	if r.UpdateTime != nil {
+		clone.UpdateTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: r.UpdateTime.Timestamp.Seconds,
+				Nanos:   r.UpdateTime.Timestamp.Nanos,
+			},
+		}
+	}
+	return clone
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the access
+// request before it's written.
+func (r *AccessRequest) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, opt ...db.Option) error {
+	const op = "session.(AccessRequest).VetForWrite"
+	opts := db.GetOpts(opt...)
+	if r.PublicId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	switch opType {
+	case db.CreateOp:
+		if err := r.validateNewAccessRequest(ctx); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	case db.UpdateOp:
+		switch {
+		case contains(opts.WithFieldMaskPaths, "PublicId"):
+			return errors.New(ctx, errors.InvalidParameter, op, "public id is immutable")
+		case contains(opts.WithFieldMaskPaths, "TargetId"):
+			return errors.New(ctx, errors.InvalidParameter, op, "target id is immutable")
+		case contains(opts.WithFieldMaskPaths, "ProjectId"):
+			return errors.New(ctx, errors.InvalidParameter, op, "project id is immutable")
+		case contains(opts.WithFieldMaskPaths, "UserId"):
+			return errors.New(ctx, errors.InvalidParameter, op, "user id is immutable")
+		case contains(opts.WithFieldMaskPaths, "Reason"):
+			return errors.New(ctx, errors.InvalidParameter, op, "reason is immutable")
+		case contains(opts.WithFieldMaskPaths, "CreateTime"):
+			return errors.New(ctx, errors.InvalidParameter, op, "create time is immutable")
+		case contains(opts.WithFieldMaskPaths, "UpdateTime"):
+			return errors.New(ctx, errors.InvalidParameter, op, "update time is immutable")
+		}
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (r *AccessRequest) TableName() string {
+	if r.tableName != "" {
+		return r.tableName
+	}
+	return defaultAccessRequestTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (r *AccessRequest) SetTableName(n string) {
+	r.tableName = n
+}
+
+// expired reports whether the access request was approved but its approval
+// has expired as of now.
+func (r *AccessRequest) expired(now time.Time) bool {
+	if r.Status != AccessRequestApproved || r.ApprovalExpirationTime == nil {
+		return false
+	}
+	return !r.ApprovalExpirationTime.AsTime().After(now)
+}
+
+// validateNewAccessRequest checks everything but the access request's PublicId
+func (r *AccessRequest) validateNewAccessRequest(ctx context.Context) error {
+	const op = "session.(AccessRequest).validateNewAccessRequest"
+	if r.TargetId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	if r.ProjectId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	}
+	if r.UserId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	if r.Status != AccessRequestPending {
+		return errors.New(ctx, errors.InvalidParameter, op, "new access request must be pending")
+	}
+	if r.ApproverId != "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "approver id is not empty")
+	}
+	if r.SessionId != "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "session id is not empty")
+	}
+	return nil
+}
@@ -20,6 +20,12 @@ const (
 
 	// ConnectionStatePrefix for connection state PK ids
 	ConnectionStatePrefix = "scs"
+
+	// AccessRequestPrefix for access request PK ids
+	AccessRequestPrefix = "sar"
+
+	// ShadowPrefix for session shadow PK ids
+	ShadowPrefix = "ssd"
 )
 
 func newId(ctx context.Context) (string, error) {
@@ -57,3 +63,21 @@ func newConnectionStateId(ctx context.Context) (string, error) {
 	}
 	return id, nil
 }
+
+func newAccessRequestId(ctx context.Context) (string, error) {
+	const op = "session.newAccessRequestId"
+	id, err := db.NewPublicId(ctx, AccessRequestPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newShadowId(ctx context.Context) (string, error) {
+	const op = "session.newShadowId"
+	id, err := db.NewPublicId(ctx, ShadowPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
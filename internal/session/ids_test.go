@@ -36,4 +36,14 @@ func Test_Ids(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(t, strings.HasPrefix(id, ConnectionStatePrefix+"_"))
 	})
+	t.Run("sar", func(t *testing.T) {
+		id, err := newAccessRequestId(ctx)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(id, AccessRequestPrefix+"_"))
+	})
+	t.Run("ssd", func(t *testing.T) {
+		id, err := newShadowId(ctx)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(id, ShadowPrefix+"_"))
+	})
 }
@@ -42,6 +42,9 @@ type options struct {
 	withIgnoreDecryptionFailures bool
 	withRandomReader             io.Reader
 	withStartPageAfterItem       pagination.Item
+	withQuotas                   Quotas
+	withAccessRequestId          string
+	withExclusiveCheckout        bool
 }
 
 func getDefaultOptions() options {
@@ -159,3 +162,27 @@ func WithStartPageAfterItem(item pagination.Item) Option {
 		o.withStartPageAfterItem = item
 	}
 }
+
+// WithQuotas is used to configure the active session quotas enforced by the
+// repository when creating new sessions.
+func WithQuotas(q Quotas) Option {
+	return func(o *options) {
+		o.withQuotas = q
+	}
+}
+
+// WithAccessRequestId is used to redeem an approved access request when
+// creating a new session.
+func WithAccessRequestId(id string) Option {
+	return func(o *options) {
+		o.withAccessRequestId = id
+	}
+}
+
+// WithExclusiveCheckout is used to mark a static credential as requiring an
+// exclusive checkout when the session is created.
+func WithExclusiveCheckout(exclusive bool) Option {
+	return func(o *options) {
+		o.withExclusiveCheckout = exclusive
+	}
+}
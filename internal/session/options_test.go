@@ -94,4 +94,30 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withRandomReader = reader
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithQuotas", func(t *testing.T) {
+		assert := assert.New(t)
+		q := Quotas{
+			MaxActivePerUser:          10,
+			MaxActivePerUserPerTarget: 2,
+			MaxActivePerProject:       100,
+		}
+		opts := getOpts(WithQuotas(q))
+		testOpts := getDefaultOptions()
+		testOpts.withQuotas = q
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAccessRequestId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithAccessRequestId("sar_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withAccessRequestId = "sar_1234567890"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithExclusiveCheckout", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithExclusiveCheckout(true))
+		testOpts := getDefaultOptions()
+		testOpts.withExclusiveCheckout = true
+		assert.Equal(opts, testOpts)
+	})
 }
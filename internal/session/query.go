@@ -460,6 +460,118 @@ order by update_time desc, public_id desc;
 `
 	estimateCountSessions = `
     select reltuples::bigint as estimate from pg_class where oid in ('session'::regclass)
+`
+	quotaLockQuery = `
+select pg_advisory_xact_lock(hashtext(@key));
+`
+
+	activeSessionCountsQuery = `
+select
+	count(*) filter (where s.user_id = @user_id)                              as user_count,
+	count(*) filter (where s.user_id = @user_id and s.target_id = @target_id) as user_target_count,
+	count(*) filter (where s.project_id = @project_id)                        as project_count
+from
+	session s
+	join session_state ss on s.public_id = ss.session_id
+where
+	ss.end_time is null and
+	ss.state in ('pending', 'active') and
+	(s.user_id = @user_id or s.project_id = @project_id);
+`
+	approveAccessRequestQuery = `
+update session_access_request
+   set status                   = 'approved',
+       approver_id              = @approver_id,
+       approval_expiration_time = now() + make_interval(secs => @valid_for_seconds)
+ where public_id = @public_id
+   and status    = 'pending';
+`
+	denyAccessRequestQuery = `
+update session_access_request
+   set status      = 'denied',
+       approver_id = @approver_id
+ where public_id = @public_id
+   and status    = 'pending';
+`
+	redeemAccessRequestQuery = `
+update session_access_request
+   set status     = 'redeemed',
+       session_id = @session_id
+ where public_id                = @public_id
+   and target_id                = @target_id
+   and user_id                  = @user_id
+   and status                   = 'approved'
+   and approval_expiration_time > now();
+`
+	checkOutStaticCredentialQuery = `
+insert into session_credential_static_checkout
+  (credential_static_id, session_id)
+values
+  (@credential_static_id, @session_id)
+on conflict (credential_static_id) do nothing;
+`
+	staticCredentialCheckedOutQuery = `
+select session_id
+  from session_credential_static_checkout
+ where credential_static_id = @credential_static_id
+   and session_id          != @session_id;
+`
+	checkInStaticCredentialQuery = `
+delete from session_credential_static_checkout
+ where credential_static_id = @credential_static_id;
+`
+	// shadowableWorkerAddressQuery returns the address of the worker handling
+	// the session's most recent connected connection, if the session is
+	// active.
+	shadowableWorkerAddressQuery = `
+select w.address
+  from session_connection sc
+  join session_connection_state scs
+    on scs.connection_id = sc.public_id
+  join session_state ss
+    on ss.session_id = sc.session_id
+  join server_worker w
+    on w.public_id = sc.worker_id
+ where sc.session_id = @session_id
+   and scs.end_time is null
+   and scs.state     = 'connected'
+   and ss.end_time is null
+   and ss.state      = 'active'
+ order by sc.create_time desc
+ limit 1;
+`
+	approveShadowQuery = `
+update session_shadow
+   set status      = 'approved',
+       approver_id = @approver_id
+ where public_id  = @public_id
+   and session_id = @session_id
+   and status     = 'pending';
+`
+	// attachShadowQuery records the connection an approved shadow is attaching
+	// to. It only succeeds if the connection belongs to the shadowed session,
+	// is connected, and the session is active.
+	attachShadowQuery = `
+update session_shadow
+   set connection_id = @connection_id,
+       attach_time   = now()
+ where public_id  = @public_id
+   and session_id = @session_id
+   and status     = 'approved'
+   and exists (
+     select 1
+       from session_connection sc
+       join session_connection_state scs
+         on scs.connection_id = sc.public_id
+       join session_state ss
+         on ss.session_id = sc.session_id
+      where sc.public_id  = @connection_id
+        and sc.session_id = @session_id
+        and scs.end_time is null
+        and scs.state     = 'connected'
+        and ss.end_time is null
+        and ss.state      = 'active'
+   );
 `
 )
 
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// Quotas defines the maximum number of active sessions that may exist at the
+// same time. A session counts against a quota while it is pending or active.
+// A zero value for any of the limits means that limit is not enforced.
+type Quotas struct {
+	// MaxActivePerUser is the maximum number of active sessions a single user
+	// may hold across all targets.
+	MaxActivePerUser int
+	// MaxActivePerUserPerTarget is the maximum number of active sessions a
+	// single user may hold against a single target.
+	MaxActivePerUserPerTarget int
+	// MaxActivePerProject is the maximum number of active sessions that may
+	// exist in a single project, regardless of user.
+	MaxActivePerProject int
+}
+
+// enabled returns true if any of the quotas are set.
+func (q Quotas) enabled() bool {
+	return q.MaxActivePerUser > 0 || q.MaxActivePerUserPerTarget > 0 || q.MaxActivePerProject > 0
+}
+
+// activeSessionCounts is the result of the activeSessionCountsQuery
+type activeSessionCounts struct {
+	UserCount       int
+	UserTargetCount int
+	ProjectCount    int
+}
+
+// checkQuotas verifies that creating the new session would not exceed any of
+// the configured quotas. It must be called within the transaction that
+// creates the session. Before counting, it takes transaction scoped advisory
+// locks on the project and the user the quotas apply to, so concurrent
+// transactions creating sessions for them count one after the other instead
+// of all seeing the same counts. An error with the code
+// errors.SessionQuotaExceeded is returned when a quota would be exceeded.
+func (q Quotas) checkQuotas(ctx context.Context, reader db.Reader, w db.Writer, newSession *Session) error {
+	const op = "session.(Quotas).checkQuotas"
+	if !q.enabled() {
+		return nil
+	}
+	// The locks are always taken in the same order, so transactions waiting
+	// on each other can't deadlock.
+	var lockKeys []string
+	if q.MaxActivePerProject > 0 {
+		lockKeys = append(lockKeys, "session_quota:project:"+newSession.ProjectId)
+	}
+	if q.MaxActivePerUser > 0 || q.MaxActivePerUserPerTarget > 0 {
+		lockKeys = append(lockKeys, "session_quota:user:"+newSession.UserId)
+	}
+	for _, k := range lockKeys {
+		if _, err := w.Exec(ctx, quotaLockQuery, []any{sql.Named("key", k)}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lock quota"))
+		}
+	}
+
+	rows, err := reader.Query(ctx, activeSessionCountsQuery, []any{
+		sql.Named("user_id", newSession.UserId),
+		sql.Named("target_id", newSession.TargetId),
+		sql.Named("project_id", newSession.ProjectId),
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var counts activeSessionCounts
+	for rows.Next() {
+		if err := reader.ScanRows(ctx, rows, &counts); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	switch {
+	case q.MaxActivePerUserPerTarget > 0 && counts.UserTargetCount >= q.MaxActivePerUserPerTarget:
+		return errors.New(ctx, errors.SessionQuotaExceeded, op,
+			fmt.Sprintf("user %s has reached the maximum of %d active sessions for target %s", newSession.UserId, q.MaxActivePerUserPerTarget, newSession.TargetId))
+	case q.MaxActivePerUser > 0 && counts.UserCount >= q.MaxActivePerUser:
+		return errors.New(ctx, errors.SessionQuotaExceeded, op,
+			fmt.Sprintf("user %s has reached the maximum of %d active sessions", newSession.UserId, q.MaxActivePerUser))
+	case q.MaxActivePerProject > 0 && counts.ProjectCount >= q.MaxActivePerProject:
+		return errors.New(ctx, errors.SessionQuotaExceeded, op,
+			fmt.Sprintf("project %s has reached the maximum of %d active sessions", newSession.ProjectId, q.MaxActivePerProject))
+	}
+	return nil
+}
@@ -36,6 +36,7 @@ type Repository struct {
 	defaultLimit int
 	permissions  *perms.UserPermissions
 	randomReader io.Reader
+	quotas       Quotas
 }
 
 // RepositoryFactory is a function that creates a Repository.
@@ -45,6 +46,7 @@ type RepositoryFactory func(opt ...Option) (*Repository, error)
 //   - WithLimit, which sets a default limit on results returned by repo operations.
 //   - WithPermissions
 //   - WithRandomReader
+//   - WithQuotas
 func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
 	const op = "session.NewRepository"
 	if util.IsNil(r) {
@@ -70,6 +72,12 @@ func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms,
 		}
 	}
 
+	if opts.withQuotas.MaxActivePerUser < 0 ||
+		opts.withQuotas.MaxActivePerUserPerTarget < 0 ||
+		opts.withQuotas.MaxActivePerProject < 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "session quotas must not be negative")
+	}
+
 	return &Repository{
 		reader:       r,
 		writer:       w,
@@ -77,6 +85,7 @@ func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms,
 		defaultLimit: opts.withLimit,
 		permissions:  opts.withPermissions,
 		randomReader: opts.withRandomReader,
+		quotas:       opts.withQuotas,
 	}, nil
 }
 
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// DefaultAccessRequestApprovalTtl is how long an approved access request can be
+// redeemed for when no other duration is provided by the approver.
+const DefaultAccessRequestApprovalTtl = time.Hour
+
+// CreateAccessRequest inserts into the repository and returns the new
+// AccessRequest with a status of "pending". The PublicId of the access request
+// must be empty. No options are currently supported.
+func (r *Repository) CreateAccessRequest(ctx context.Context, accessRequest *AccessRequest, _ ...Option) (*AccessRequest, error) {
+	const op = "session.(Repository).CreateAccessRequest"
+	if accessRequest == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing access request")
+	}
+	if accessRequest.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id is not empty")
+	}
+	if err := accessRequest.validateNewAccessRequest(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	id, err := newAccessRequestId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var returnedRequest *AccessRequest
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedRequest = accessRequest.Clone().(*AccessRequest)
+			returnedRequest.PublicId = id
+			if err := w.Create(ctx, returnedRequest); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for target %s", accessRequest.TargetId)))
+	}
+	return returnedRequest, nil
+}
+
+// LookupAccessRequest will look up an access request in the repository. If
+// the access request is not found, it will return nil, nil. Approved access
+// requests whose approval has expired are returned with a status of
+// "expired". No options are currently supported.
+func (r *Repository) LookupAccessRequest(ctx context.Context, publicId string, _ ...Option) (*AccessRequest, error) {
+	const op = "session.(Repository).LookupAccessRequest"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	accessRequest, err := lookupAccessRequest(ctx, r.reader, publicId)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return accessRequest, nil
+}
+
+// ListAccessRequests returns the access requests for the target, ordered by
+// create time descending. Approved access requests whose approval has expired
+// are returned with a status of "expired".
+// Supported Options:
+//   - WithLimit
+func (r *Repository) ListAccessRequests(ctx context.Context, targetId string, opt ...Option) ([]*AccessRequest, error) {
+	const op = "session.(Repository).ListAccessRequests"
+	if targetId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var accessRequests []*AccessRequest
+	if err := r.reader.SearchWhere(ctx, &accessRequests, "target_id = ?", []any{targetId}, db.WithLimit(limit), db.WithOrder("create_time desc")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	now := time.Now()
+	for _, ar := range accessRequests {
+		if ar.expired(now) {
+			ar.Status = AccessRequestExpired
+		}
+	}
+	return accessRequests, nil
+}
+
+// ApproveAccessRequest approves a pending access request on behalf of the
+// approver. The approved access request can be redeemed by the requesting
+// user until validFor has elapsed. If validFor is zero,
+// DefaultAccessRequestApprovalTtl is used. A user cannot approve their own
+// access request. No options are currently supported.
+func (r *Repository) ApproveAccessRequest(ctx context.Context, publicId, approverId string, validFor time.Duration, _ ...Option) (*AccessRequest, error) {
+	const op = "session.(Repository).ApproveAccessRequest"
+	if validFor < 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "approval duration must not be negative")
+	}
+	if validFor == 0 {
+		validFor = DefaultAccessRequestApprovalTtl
+	}
+	accessRequest, err := r.decideAccessRequest(ctx, publicId, approverId, approveAccessRequestQuery, []any{
+		sql.Named("public_id", publicId),
+		sql.Named("approver_id", approverId),
+		sql.Named("valid_for_seconds", validFor.Seconds()),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return accessRequest, nil
+}
+
+// DenyAccessRequest denies a pending access request on behalf of the approver.
+// A denied access request cannot be redeemed. A user cannot deny their own
+// access request. No options are currently supported.
+func (r *Repository) DenyAccessRequest(ctx context.Context, publicId, approverId string, _ ...Option) (*AccessRequest, error) {
+	const op = "session.(Repository).DenyAccessRequest"
+	accessRequest, err := r.decideAccessRequest(ctx, publicId, approverId, denyAccessRequestQuery, []any{
+		sql.Named("public_id", publicId),
+		sql.Named("approver_id", approverId),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return accessRequest, nil
+}
+
+// decideAccessRequest runs the query to approve or deny a pending access
+// request and returns the updated access request.
+func (r *Repository) decideAccessRequest(ctx context.Context, publicId, approverId, query string, args []any) (*AccessRequest, error) {
+	const op = "session.(Repository).decideAccessRequest"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	if approverId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing approver id")
+	}
+
+	var updatedRequest *AccessRequest
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			current, err := lookupAccessRequest(ctx, reader, publicId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if current.UserId == approverId {
+				return errors.New(ctx, errors.Forbidden, op, "users cannot approve or deny their own access requests")
+			}
+			if current.Status != AccessRequestPending {
+				return errors.New(ctx, errors.Conflict, op, fmt.Sprintf("access request %s is %s, not pending", publicId, current.Status))
+			}
+			rowsUpdated, err := w.Exec(ctx, query, args)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.Conflict, op, fmt.Sprintf("access request %s is no longer pending", publicId))
+			}
+			if updatedRequest, err = lookupAccessRequest(ctx, reader, publicId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return updatedRequest, nil
+}
+
+// redeemAccessRequest marks the approved access request as redeemed by the
+// session. It must be called within the transaction that creates the session.
+func redeemAccessRequest(ctx context.Context, w db.Writer, accessRequestId string, s *Session) error {
+	const op = "session.redeemAccessRequest"
+	rowsUpdated, err := w.Exec(ctx, redeemAccessRequestQuery, []any{
+		sql.Named("public_id", accessRequestId),
+		sql.Named("session_id", s.PublicId),
+		sql.Named("target_id", s.TargetId),
+		sql.Named("user_id", s.UserId),
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if rowsUpdated != 1 {
+		return errors.New(ctx, errors.Conflict, op, fmt.Sprintf("access request %s is not an unexpired approval for user %s and target %s", accessRequestId, s.UserId, s.TargetId))
+	}
+	return nil
+}
+
+func lookupAccessRequest(ctx context.Context, reader db.Reader, publicId string) (*AccessRequest, error) {
+	const op = "session.lookupAccessRequest"
+	accessRequest := AllocAccessRequest()
+	accessRequest.PublicId = publicId
+	if err := reader.LookupByPublicId(ctx, &accessRequest); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", publicId)))
+	}
+	if accessRequest.expired(time.Now()) {
+		accessRequest.Status = AccessRequestExpired
+	}
+	return &accessRequest, nil
+}
@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateAccessRequest(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+
+	tests := []struct {
+		name        string
+		req         func() *AccessRequest
+		wantIsError errors.Code
+	}{
+		{
+			name: "valid",
+			req: func() *AccessRequest {
+				ar, err := NewAccessRequest(ctx, composedOf.TargetId, composedOf.ProjectId, composedOf.UserId, "on call")
+				require.NoError(t, err)
+				return ar
+			},
+		},
+		{
+			name: "valid-without-reason",
+			req: func() *AccessRequest {
+				ar, err := NewAccessRequest(ctx, composedOf.TargetId, composedOf.ProjectId, composedOf.UserId, "")
+				require.NoError(t, err)
+				return ar
+			},
+		},
+		{
+			name:        "nil-request",
+			req:         func() *AccessRequest { return nil },
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name: "public-id-set",
+			req: func() *AccessRequest {
+				ar, err := NewAccessRequest(ctx, composedOf.TargetId, composedOf.ProjectId, composedOf.UserId, "")
+				require.NoError(t, err)
+				ar.PublicId = "sar_1234567890"
+				return ar
+			},
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name: "missing-user-id",
+			req: func() *AccessRequest {
+				ar, err := NewAccessRequest(ctx, composedOf.TargetId, composedOf.ProjectId, composedOf.UserId, "")
+				require.NoError(t, err)
+				ar.UserId = ""
+				return ar
+			},
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name: "not-pending",
+			req: func() *AccessRequest {
+				ar, err := NewAccessRequest(ctx, composedOf.TargetId, composedOf.ProjectId, composedOf.UserId, "")
+				require.NoError(t, err)
+				ar.Status = AccessRequestApproved
+				return ar
+			},
+			wantIsError: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.CreateAccessRequest(ctx, tt.req())
+			if tt.wantIsError != 0 {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsError), err))
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.NotEmpty(got.PublicId)
+			assert.Equal(AccessRequestPending, got.Status)
+			assert.NotNil(got.CreateTime)
+
+			found, err := repo.LookupAccessRequest(ctx, got.PublicId)
+			require.NoError(err)
+			assert.Equal(got.TargetId, found.TargetId)
+			assert.Equal(got.UserId, found.UserId)
+			assert.Equal(AccessRequestPending, found.Status)
+		})
+	}
+}
+
+func TestRepository_DecideAccessRequest(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+	approver := iam.TestUser(t, iamRepo, scope.Global.String())
+
+	newRequest := func(t *testing.T) *AccessRequest {
+		ar, err := NewAccessRequest(ctx, composedOf.TargetId, composedOf.ProjectId, composedOf.UserId, "incident")
+		require.NoError(t, err)
+		ar, err = repo.CreateAccessRequest(ctx, ar)
+		require.NoError(t, err)
+		return ar
+	}
+
+	t.Run("approve", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := newRequest(t)
+		got, err := repo.ApproveAccessRequest(ctx, ar.PublicId, approver.PublicId, 10*time.Minute)
+		require.NoError(err)
+		assert.Equal(AccessRequestApproved, got.Status)
+		assert.Equal(approver.PublicId, got.ApproverId)
+		require.NotNil(got.ApprovalExpirationTime)
+		assert.WithinDuration(time.Now().Add(10*time.Minute), got.ApprovalExpirationTime.AsTime(), time.Minute)
+		assert.Greater(got.Version, ar.Version)
+
+		// A decided request cannot be decided again
+		_, err = repo.DenyAccessRequest(ctx, ar.PublicId, approver.PublicId)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Conflict), err))
+	})
+	t.Run("approve-default-ttl", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := newRequest(t)
+		got, err := repo.ApproveAccessRequest(ctx, ar.PublicId, approver.PublicId, 0)
+		require.NoError(err)
+		require.NotNil(got.ApprovalExpirationTime)
+		assert.WithinDuration(time.Now().Add(DefaultAccessRequestApprovalTtl), got.ApprovalExpirationTime.AsTime(), time.Minute)
+	})
+	t.Run("deny", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := newRequest(t)
+		got, err := repo.DenyAccessRequest(ctx, ar.PublicId, approver.PublicId)
+		require.NoError(err)
+		assert.Equal(AccessRequestDenied, got.Status)
+		assert.Equal(approver.PublicId, got.ApproverId)
+		assert.Nil(got.ApprovalExpirationTime)
+	})
+	t.Run("self-approval", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := newRequest(t)
+		_, err := repo.ApproveAccessRequest(ctx, ar.PublicId, composedOf.UserId, 0)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Forbidden), err))
+		_, err = repo.DenyAccessRequest(ctx, ar.PublicId, composedOf.UserId)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Forbidden), err))
+	})
+	t.Run("negative-ttl", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := newRequest(t)
+		_, err := repo.ApproveAccessRequest(ctx, ar.PublicId, approver.PublicId, -time.Minute)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("not-found", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		id, err := newAccessRequestId(ctx)
+		require.NoError(err)
+		_, err = repo.ApproveAccessRequest(ctx, id, approver.PublicId, 0)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.RecordNotFound), err))
+	})
+	t.Run("list", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.ListAccessRequests(ctx, composedOf.TargetId)
+		require.NoError(err)
+		assert.NotEmpty(got)
+		for i := 1; i < len(got); i++ {
+			assert.False(got[i].CreateTime.AsTime().After(got[i-1].CreateTime.AsTime()))
+		}
+
+		got, err = repo.ListAccessRequests(ctx, composedOf.TargetId, WithLimit(1))
+		require.NoError(err)
+		assert.Len(got, 1)
+	})
+}
+
+func TestRepository_CreateSession_WithAccessRequest(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+	approver := iam.TestUser(t, iamRepo, scope.Global.String())
+	workerAddresses := []string{"1.2.3.4"}
+
+	newSession := func(t *testing.T) *Session {
+		s, err := New(ctx, composedOf)
+		require.NoError(t, err)
+		return s
+	}
+	newRequest := func(t *testing.T) *AccessRequest {
+		ar, err := NewAccessRequest(ctx, composedOf.TargetId, composedOf.ProjectId, composedOf.UserId, "")
+		require.NoError(t, err)
+		ar, err = repo.CreateAccessRequest(ctx, ar)
+		require.NoError(t, err)
+		return ar
+	}
+
+	t.Run("pending", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := newRequest(t)
+		_, err := repo.CreateSession(ctx, wrapper, newSession(t), workerAddresses, WithAccessRequestId(ar.PublicId))
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Conflict), err))
+	})
+	t.Run("denied", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := newRequest(t)
+		_, err := repo.DenyAccessRequest(ctx, ar.PublicId, approver.PublicId)
+		require.NoError(err)
+		_, err = repo.CreateSession(ctx, wrapper, newSession(t), workerAddresses, WithAccessRequestId(ar.PublicId))
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Conflict), err))
+	})
+	t.Run("approved", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := newRequest(t)
+		_, err := repo.ApproveAccessRequest(ctx, ar.PublicId, approver.PublicId, 0)
+		require.NoError(err)
+		s, err := repo.CreateSession(ctx, wrapper, newSession(t), workerAddresses, WithAccessRequestId(ar.PublicId))
+		require.NoError(err)
+
+		redeemed, err := repo.LookupAccessRequest(ctx, ar.PublicId)
+		require.NoError(err)
+		assert.Equal(AccessRequestRedeemed, redeemed.Status)
+		assert.Equal(s.PublicId, redeemed.SessionId)
+
+		// An access request can only be redeemed once
+		_, err = repo.CreateSession(ctx, wrapper, newSession(t), workerAddresses, WithAccessRequestId(ar.PublicId))
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Conflict), err))
+	})
+}
@@ -576,6 +576,7 @@ func TestUpdateBytesUpDown(t *testing.T) {
 		ConnectionCanceled,
 		ConnectionNetworkError,
 		ConnectionSystemError,
+		ConnectionIdleTimeout,
 	}
 	cws := make([]CloseWith, 0, len(conns))
 	for i := 0; i < len(conns); i++ {
@@ -5,6 +5,8 @@ package session
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -93,3 +95,91 @@ func (r *Repository) ListSessionCredentials(ctx context.Context, sessProjectId,
 
 	return ret, nil
 }
+
+// CheckInStaticCredential releases the exclusive checkout of the static
+// credential, allowing another session to check it out. Checkouts are
+// released automatically when the session holding the credential is canceled
+// or terminated; this is used to force the check in of a credential held by a
+// session which has not ended. It returns the number of checkouts released.
+// All options are ignored.
+func (r *Repository) CheckInStaticCredential(ctx context.Context, credentialId string, _ ...Option) (int, error) {
+	const op = "session.(Repository).CheckInStaticCredential"
+	if credentialId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+
+	var rowsDeleted int
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			rowsDeleted, err = w.Exec(ctx, checkInStaticCredentialQuery, []any{
+				sql.Named("credential_static_id", credentialId),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to check in credential %s", credentialId)))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, err
+	}
+	return rowsDeleted, nil
+}
+
+// checkOutStaticCredentials checks out the static credentials requiring an
+// exclusive checkout for the session. An error with the CredentialCheckedOut
+// code is returned if any of the credentials, whether or not the session
+// requires an exclusive checkout of it, is already checked out by another
+// session, since the checkout is held on the credential regardless of the
+// target the credential was brokered through.
+func checkOutStaticCredentials(ctx context.Context, w db.Writer, sessionId string, creds []*StaticCredential) error {
+	const op = "session.checkOutStaticCredentials"
+	checkedOut := make(map[string]bool, len(creds))
+	for _, c := range creds {
+		if checkedOut[c.CredentialStaticId] {
+			continue
+		}
+		if !c.ExclusiveCheckout {
+			held, err := staticCredentialCheckedOut(ctx, w, sessionId, c.CredentialStaticId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if held {
+				return errors.New(ctx, errors.CredentialCheckedOut, op, fmt.Sprintf("static credential %s is checked out by another session", c.CredentialStaticId))
+			}
+			continue
+		}
+		rowsInserted, err := w.Exec(ctx, checkOutStaticCredentialQuery, []any{
+			sql.Named("credential_static_id", c.CredentialStaticId),
+			sql.Named("session_id", sessionId),
+		})
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to check out credential %s", c.CredentialStaticId)))
+		}
+		if rowsInserted != 1 {
+			return errors.New(ctx, errors.CredentialCheckedOut, op, fmt.Sprintf("static credential %s is checked out by another session", c.CredentialStaticId))
+		}
+		checkedOut[c.CredentialStaticId] = true
+	}
+	return nil
+}
+
+// staticCredentialCheckedOut reports whether the static credential is checked
+// out by a session other than sessionId.
+func staticCredentialCheckedOut(ctx context.Context, w db.Writer, sessionId, credentialId string) (bool, error) {
+	const op = "session.staticCredentialCheckedOut"
+	rows, err := w.Query(ctx, staticCredentialCheckedOutQuery, []any{
+		sql.Named("credential_static_id", credentialId),
+		sql.Named("session_id", sessionId),
+	})
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to look up checkout of credential %s", credentialId)))
+	}
+	defer rows.Close()
+	held := rows.Next()
+	if err := rows.Err(); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return held, nil
+}
@@ -22,9 +22,12 @@ import (
 
 // CreateSession inserts into the repository and returns the new Session with
 // its State of "Pending".  The following fields must be empty when creating a
-// session: WorkerId, and PublicId.  No options are
-// currently supported.
-func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.Wrapper, newSession *Session, workerAddresses []string, _ ...Option) (*Session, error) {
+// session: WorkerId, and PublicId.  If the repository was configured with
+// session quotas and creating the session would exceed one of them, an error
+// with the code errors.SessionQuotaExceeded is returned.
+// Supported Options:
+//   - WithAccessRequestId
+func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.Wrapper, newSession *Session, workerAddresses []string, opt ...Option) (*Session, error) {
 	const op = "session.(Repository).CreateSession"
 	if newSession == nil {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session")
@@ -62,6 +65,7 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 	if len(workerAddresses) == 0 {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing addresses")
 	}
+	opts := getOpts(opt...)
 
 	id, err := newId(ctx)
 	if err != nil {
@@ -85,6 +89,10 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 		db.StdRetryCnt,
 		db.ExpBackoff{},
 		func(read db.Reader, w db.Writer) error {
+			if err := r.quotas.checkQuotas(ctx, read, w, newSession); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
 			returnedSession = newSession.Clone().(*Session)
 			returnedSession.DynamicCredentials = nil
 			returnedSession.StaticCredentials = nil
@@ -92,6 +100,12 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 				return errors.Wrap(ctx, err, op)
 			}
 
+			if opts.withAccessRequestId != "" {
+				if err := redeemAccessRequest(ctx, w, opts.withAccessRequestId, returnedSession); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+
 			if newSession.HostSetId != "" && newSession.HostId != "" {
 				hs, err := NewSessionHostSetHost(ctx, newSession.PublicId, newSession.HostSetId, newSession.HostId)
 				if err != nil {
@@ -144,6 +158,10 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 					return errors.Wrap(ctx, err, op)
 				}
 				returnedSession.StaticCredentials = c
+
+				if err := checkOutStaticCredentials(ctx, w, newSession.PublicId, newSession.StaticCredentials); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
 			}
 
 			// TODO: after upgrading to gorm v2 this batch insert can be replaced, since gorm v2 supports batch inserts
@@ -250,6 +268,12 @@ func (r *Repository) LookupSession(ctx context.Context, sessionId string, opt ..
 				return errors.Wrap(ctx, err, op)
 			}
 			session.Connections = connections
+
+			shadows, err := fetchShadows(ctx, read, sessionId, db.WithOrder("create_time desc"))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			session.Shadows = shadows
 			if session.ProjectId == "" || session.UserId == "" {
 				// Skip decryption if Project ID or UserId is missing,
 				// since it will just lead to errors, and the session
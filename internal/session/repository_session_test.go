@@ -6,6 +6,7 @@ package session
 import (
 	"context"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -696,6 +697,179 @@ func TestRepository_CreateSession(t *testing.T) {
 	}
 }
 
+func TestRepository_CreateSession_Quotas(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	workerAddresses := []string{"1.2.3.4"}
+
+	tests := []struct {
+		name   string
+		quotas Quotas
+	}{
+		{
+			name:   "max-active-per-user",
+			quotas: Quotas{MaxActivePerUser: 1},
+		},
+		{
+			name:   "max-active-per-user-per-target",
+			quotas: Quotas{MaxActivePerUserPerTarget: 1},
+		},
+		{
+			name:   "max-active-per-project",
+			quotas: Quotas{MaxActivePerProject: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			repo, err := NewRepository(ctx, rw, rw, kmsCache, WithQuotas(tt.quotas))
+			require.NoError(err)
+			composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+			newSession := func() *Session {
+				s, err := New(ctx, composedOf)
+				require.NoError(err)
+				return s
+			}
+
+			first, err := repo.CreateSession(ctx, wrapper, newSession(), workerAddresses)
+			require.NoError(err)
+
+			_, err = repo.CreateSession(ctx, wrapper, newSession(), workerAddresses)
+			require.Error(err)
+			assert.True(errors.Match(errors.T(errors.SessionQuotaExceeded), err))
+
+			// Canceled sessions no longer count against the quotas
+			_, err = repo.CancelSession(ctx, first.PublicId, first.Version)
+			require.NoError(err)
+			_, err = repo.CreateSession(ctx, wrapper, newSession(), workerAddresses)
+			require.NoError(err)
+		})
+	}
+}
+
+func TestRepository_CreateSession_QuotasConcurrent(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	workerAddresses := []string{"1.2.3.4"}
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, WithQuotas(Quotas{MaxActivePerUser: 1}))
+	require.NoError(err)
+	composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+
+	const attempts = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, attempts)
+	for i := 0; i < attempts; i++ {
+		s, err := New(ctx, composedOf)
+		require.NoError(err)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := repo.CreateSession(ctx, wrapper, s, workerAddresses)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	var created int
+	for err := range errs {
+		if err == nil {
+			created++
+			continue
+		}
+		assert.True(errors.Match(errors.T(errors.SessionQuotaExceeded), err), err.Error())
+	}
+	assert.Equal(1, created)
+}
+
+func TestRepository_CreateSession_ExclusiveCheckout(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	workerAddresses := []string{"1.2.3.4"}
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+	targetRepo, err := target.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+
+	composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+	tar, err := targetRepo.LookupTarget(ctx, composedOf.TargetId)
+	require.NoError(err)
+	staticStore := credstatic.TestCredentialStore(t, conn, wrapper, composedOf.ProjectId)
+	upCred := credstatic.TestUsernamePasswordCredentials(t, conn, wrapper, "admin", "p", staticStore.GetPublicId(), composedOf.ProjectId, 1)[0]
+	tar, err = targetRepo.AddTargetCredentialSources(ctx, tar.GetPublicId(), tar.GetVersion(), target.CredentialSources{
+		BrokeredCredentialIds:          []string{upCred.GetPublicId()},
+		ExclusiveCheckoutCredentialIds: []string{upCred.GetPublicId()},
+	})
+	require.NoError(err)
+	require.Len(tar.GetCredentialSources(), 1)
+	assert.True(tar.GetCredentialSources()[0].ExclusiveCheckout())
+
+	newSessionWith := func(opt ...Option) *Session {
+		c := composedOf
+		c.StaticCredentials = []*StaticCredential{
+			NewStaticCredential(upCred.GetPublicId(), cred.BrokeredPurpose, opt...),
+		}
+		s, err := New(ctx, c)
+		require.NoError(err)
+		return s
+	}
+	newSession := func() *Session {
+		return newSessionWith(WithExclusiveCheckout(true))
+	}
+
+	first, err := repo.CreateSession(ctx, wrapper, newSession(), workerAddresses)
+	require.NoError(err)
+
+	_, err = repo.CreateSession(ctx, wrapper, newSession(), workerAddresses)
+	require.Error(err)
+	assert.True(errors.Match(errors.T(errors.CredentialCheckedOut), err))
+
+	// A session brokering the credential through a target which doesn't
+	// require an exclusive checkout can't use it while it's checked out either.
+	_, err = repo.CreateSession(ctx, wrapper, newSessionWith(), workerAddresses)
+	require.Error(err)
+	assert.True(errors.Match(errors.T(errors.CredentialCheckedOut), err))
+
+	// Canceling the session checks in the credential
+	_, err = repo.CancelSession(ctx, first.PublicId, first.Version)
+	require.NoError(err)
+	_, err = repo.CreateSession(ctx, wrapper, newSession(), workerAddresses)
+	require.NoError(err)
+
+	// Forcing a check in releases the credential
+	cnt, err := repo.CheckInStaticCredential(ctx, upCred.GetPublicId())
+	require.NoError(err)
+	assert.Equal(1, cnt)
+	_, err = repo.CreateSession(ctx, wrapper, newSession(), workerAddresses)
+	require.NoError(err)
+
+	cnt, err = repo.CheckInStaticCredential(ctx, upCred.GetPublicId())
+	require.NoError(err)
+	assert.Equal(1, cnt)
+	cnt, err = repo.CheckInStaticCredential(ctx, upCred.GetPublicId())
+	require.NoError(err)
+	assert.Equal(0, cnt)
+}
+
 func TestRepository_updateState(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-secure-stdlib/base62"
+)
+
+// shadowTokenLength is the length of the random token returned to the user
+// when a shadow is created.
+const shadowTokenLength = 32
+
+// CreateShadow inserts into the repository and returns the new Shadow. The
+// returned Shadow contains the token the shadow must present to the worker
+// when attaching, and the address of the worker handling the session's most
+// recent connected connection. The token is not stored and cannot be
+// retrieved again. The session must be active and have a connected connection
+// to shadow. The PublicId of the shadow must be empty. No options are
+// currently supported.
+func (r *Repository) CreateShadow(ctx context.Context, shadow *Shadow, _ ...Option) (*Shadow, error) {
+	const op = "session.(Repository).CreateShadow"
+	if shadow == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing shadow")
+	}
+	if shadow.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id is not empty")
+	}
+	if err := shadow.validateNewShadow(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	id, err := newShadowId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	token, err := base62.Random(shadowTokenLength)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var returnedShadow *Shadow
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			workerAddress, err := shadowableWorkerAddress(ctx, reader, shadow.SessionId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			returnedShadow = shadow.Clone().(*Shadow)
+			returnedShadow.PublicId = id
+			returnedShadow.TokenHash = hashShadowToken(token)
+			if err := w.Create(ctx, returnedShadow); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			returnedShadow.Token = token
+			returnedShadow.WorkerAddress = workerAddress
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for session %s", shadow.SessionId)))
+	}
+	return returnedShadow, nil
+}
+
+// ApproveShadow approves a pending read-write shadow of the session on behalf
+// of the approver. A user cannot approve their own shadow. No options are
+// currently supported.
+func (r *Repository) ApproveShadow(ctx context.Context, sessionId, publicId, approverId string, _ ...Option) (*Shadow, error) {
+	const op = "session.(Repository).ApproveShadow"
+	switch {
+	case sessionId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	case publicId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	case approverId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing approver id")
+	}
+
+	var updatedShadow *Shadow
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			current, err := lookupShadow(ctx, reader, sessionId, publicId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if current.UserId == approverId {
+				return errors.New(ctx, errors.Forbidden, op, "users cannot approve their own shadows")
+			}
+			if current.Status != ShadowPending {
+				return errors.New(ctx, errors.Conflict, op, fmt.Sprintf("shadow %s is %s, not pending", publicId, current.Status))
+			}
+			rowsUpdated, err := w.Exec(ctx, approveShadowQuery, []any{
+				sql.Named("public_id", publicId),
+				sql.Named("session_id", sessionId),
+				sql.Named("approver_id", approverId),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.Conflict, op, fmt.Sprintf("shadow %s is no longer pending", publicId))
+			}
+			if updatedShadow, err = lookupShadow(ctx, reader, sessionId, publicId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return updatedShadow, nil
+}
+
+// AttachShadow authorizes the shadow to attach to the session's connection and
+// records the attachment. The token must match the one returned when the
+// shadow was created, the shadow must be approved, and the connection must be
+// a connected connection of the active session. No options are currently
+// supported.
+func (r *Repository) AttachShadow(ctx context.Context, sessionId, publicId, token, connectionId string, _ ...Option) (*Shadow, error) {
+	const op = "session.(Repository).AttachShadow"
+	switch {
+	case sessionId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	case publicId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	case token == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token")
+	case connectionId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing connection id")
+	}
+
+	var attachedShadow *Shadow
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			current, err := lookupShadow(ctx, reader, sessionId, publicId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if subtle.ConstantTimeCompare(current.TokenHash, hashShadowToken(token)) != 1 {
+				return errors.New(ctx, errors.Forbidden, op, "shadow token does not match")
+			}
+			if current.Status != ShadowApproved {
+				return errors.New(ctx, errors.Forbidden, op, fmt.Sprintf("shadow %s is %s, not approved", publicId, current.Status))
+			}
+			rowsUpdated, err := w.Exec(ctx, attachShadowQuery, []any{
+				sql.Named("public_id", publicId),
+				sql.Named("session_id", sessionId),
+				sql.Named("connection_id", connectionId),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.Conflict, op, fmt.Sprintf("connection %s is not a connected connection of active session %s", connectionId, sessionId))
+			}
+			if attachedShadow, err = lookupShadow(ctx, reader, sessionId, publicId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return attachedShadow, nil
+}
+
+func lookupShadow(ctx context.Context, reader db.Reader, sessionId, publicId string) (*Shadow, error) {
+	const op = "session.lookupShadow"
+	shadow := AllocShadow()
+	shadow.PublicId = publicId
+	if err := reader.LookupByPublicId(ctx, &shadow); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", publicId)))
+	}
+	if shadow.SessionId != sessionId {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("shadow %s not found for session %s", publicId, sessionId))
+	}
+	return &shadow, nil
+}
+
+func fetchShadows(ctx context.Context, r db.Reader, sessionId string, opt ...db.Option) ([]*Shadow, error) {
+	const op = "session.fetchShadows"
+	var shadows []*Shadow
+	if err := r.SearchWhere(ctx, &shadows, "session_id = ?", []any{sessionId}, opt...); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(shadows) == 0 {
+		return nil, nil
+	}
+	return shadows, nil
+}
+
+func shadowableWorkerAddress(ctx context.Context, r db.Reader, sessionId string) (string, error) {
+	const op = "session.shadowableWorkerAddress"
+	rows, err := r.Query(ctx, shadowableWorkerAddressQuery, []any{sql.Named("session_id", sessionId)})
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var address string
+	for rows.Next() {
+		if err := rows.Scan(&address); err != nil {
+			return "", errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	if address == "" {
+		return "", errors.New(ctx, errors.Conflict, op, fmt.Sprintf("session %s is not active or has no connected connections to shadow", sessionId))
+	}
+	return address, nil
+}
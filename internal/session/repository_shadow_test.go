@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testConnectedSession returns an active session with a single connected
+// connection handled by the worker.
+func testConnectedSession(t *testing.T, conn *db.DB, repo *Repository, connRepo *ConnectionRepository, iamRepo *iam.Repository, workerId string) (*Session, *Connection) {
+	t.Helper()
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+	s := TestDefaultSession(t, conn, wrapper, iamRepo)
+	s, _, err := repo.ActivateSession(ctx, s.PublicId, s.Version, TestTofu(t))
+	require.NoError(t, err)
+	c, _, err := connRepo.AuthorizeConnection(ctx, s.PublicId, workerId)
+	require.NoError(t, err)
+	_, _, err = connRepo.ConnectConnection(ctx, ConnectWith{
+		ConnectionId:       c.PublicId,
+		ClientTcpAddress:   "127.0.0.1",
+		ClientTcpPort:      22,
+		EndpointTcpAddress: "127.0.0.1",
+		EndpointTcpPort:    2222,
+		UserClientIp:       "127.0.0.1",
+	})
+	require.NoError(t, err)
+	return s, c
+}
+
+func TestRepository_CreateShadow(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	connRepo, err := NewConnectionRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	worker := server.TestKmsWorker(t, conn, wrapper)
+	shadower := iam.TestUser(t, iamRepo, scope.Global.String())
+
+	t.Run("read-only", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, _ := testConnectedSession(t, conn, repo, connRepo, iamRepo, worker.PublicId)
+		sh, err := NewShadow(ctx, s.PublicId, shadower.PublicId, false)
+		require.NoError(err)
+		got, err := repo.CreateShadow(ctx, sh)
+		require.NoError(err)
+		assert.NotEmpty(got.PublicId)
+		assert.Equal(ShadowApproved, got.Status)
+		assert.Len(got.Token, shadowTokenLength)
+		assert.Equal(hashShadowToken(got.Token), got.TokenHash)
+		assert.Equal(worker.Address, got.WorkerAddress)
+
+		found, _, err := repo.LookupSession(ctx, s.PublicId)
+		require.NoError(err)
+		require.Len(found.Shadows, 1)
+		assert.Equal(got.PublicId, found.Shadows[0].PublicId)
+		assert.Equal(shadower.PublicId, found.Shadows[0].UserId)
+		assert.Empty(found.Shadows[0].Token)
+	})
+	t.Run("read-write", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, _ := testConnectedSession(t, conn, repo, connRepo, iamRepo, worker.PublicId)
+		sh, err := NewShadow(ctx, s.PublicId, shadower.PublicId, true)
+		require.NoError(err)
+		got, err := repo.CreateShadow(ctx, sh)
+		require.NoError(err)
+		assert.Equal(ShadowPending, got.Status)
+		assert.True(got.ReadWrite)
+	})
+	t.Run("no-connected-connection", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s := TestDefaultSession(t, conn, wrapper, iamRepo)
+		sh, err := NewShadow(ctx, s.PublicId, shadower.PublicId, false)
+		require.NoError(err)
+		got, err := repo.CreateShadow(ctx, sh)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Conflict), err))
+		assert.Nil(got)
+	})
+	t.Run("nil-shadow", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.CreateShadow(ctx, nil)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		assert.Nil(got)
+	})
+	t.Run("public-id-set", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		sh, err := NewShadow(ctx, "s_1234567890", shadower.PublicId, false)
+		require.NoError(err)
+		sh.PublicId = "ssd_1234567890"
+		got, err := repo.CreateShadow(ctx, sh)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		assert.Nil(got)
+	})
+}
+
+func TestRepository_ApproveShadow(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	connRepo, err := NewConnectionRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	worker := server.TestKmsWorker(t, conn, wrapper)
+	shadower := iam.TestUser(t, iamRepo, scope.Global.String())
+	s, _ := testConnectedSession(t, conn, repo, connRepo, iamRepo, worker.PublicId)
+
+	newShadow := func(t *testing.T, readWrite bool) *Shadow {
+		sh, err := NewShadow(ctx, s.PublicId, shadower.PublicId, readWrite)
+		require.NoError(t, err)
+		sh, err = repo.CreateShadow(ctx, sh)
+		require.NoError(t, err)
+		return sh
+	}
+
+	t.Run("approve", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		sh := newShadow(t, true)
+		got, err := repo.ApproveShadow(ctx, s.PublicId, sh.PublicId, s.UserId)
+		require.NoError(err)
+		assert.Equal(ShadowApproved, got.Status)
+		assert.Equal(s.UserId, got.ApproverId)
+		assert.Greater(got.Version, sh.Version)
+
+		// An approved shadow cannot be approved again
+		_, err = repo.ApproveShadow(ctx, s.PublicId, sh.PublicId, s.UserId)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Conflict), err))
+	})
+	t.Run("read-only-already-approved", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		sh := newShadow(t, false)
+		_, err := repo.ApproveShadow(ctx, s.PublicId, sh.PublicId, s.UserId)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Conflict), err))
+	})
+	t.Run("own-shadow", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		sh := newShadow(t, true)
+		_, err := repo.ApproveShadow(ctx, s.PublicId, sh.PublicId, shadower.PublicId)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Forbidden), err))
+	})
+	t.Run("wrong-session", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		sh := newShadow(t, true)
+		_, err := repo.ApproveShadow(ctx, "s_1234567890", sh.PublicId, s.UserId)
+		require.Error(err)
+		assert.True(errors.IsNotFoundError(err))
+	})
+	t.Run("missing-approver", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		sh := newShadow(t, true)
+		_, err := repo.ApproveShadow(ctx, s.PublicId, sh.PublicId, "")
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+}
+
+func TestRepository_AttachShadow(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	connRepo, err := NewConnectionRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	worker := server.TestKmsWorker(t, conn, wrapper)
+	shadower := iam.TestUser(t, iamRepo, scope.Global.String())
+	s, c := testConnectedSession(t, conn, repo, connRepo, iamRepo, worker.PublicId)
+
+	newShadow := func(t *testing.T, readWrite bool) *Shadow {
+		sh, err := NewShadow(ctx, s.PublicId, shadower.PublicId, readWrite)
+		require.NoError(t, err)
+		sh, err = repo.CreateShadow(ctx, sh)
+		require.NoError(t, err)
+		return sh
+	}
+
+	t.Run("attach", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		sh := newShadow(t, false)
+		got, err := repo.AttachShadow(ctx, s.PublicId, sh.PublicId, sh.Token, c.PublicId)
+		require.NoError(err)
+		assert.Equal(c.PublicId, got.ConnectionId)
+		assert.NotNil(got.AttachTime)
+	})
+	t.Run("wrong-token", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		sh := newShadow(t, false)
+		_, err := repo.AttachShadow(ctx, s.PublicId, sh.PublicId, "wrong token", c.PublicId)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Forbidden), err))
+	})
+	t.Run("pending", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		sh := newShadow(t, true)
+		_, err := repo.AttachShadow(ctx, s.PublicId, sh.PublicId, sh.Token, c.PublicId)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Forbidden), err))
+
+		_, err = repo.ApproveShadow(ctx, s.PublicId, sh.PublicId, s.UserId)
+		require.NoError(err)
+		got, err := repo.AttachShadow(ctx, s.PublicId, sh.PublicId, sh.Token, c.PublicId)
+		require.NoError(err)
+		assert.True(got.ReadWrite)
+	})
+	t.Run("other-session-connection", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		sh := newShadow(t, false)
+		_, other := testConnectedSession(t, conn, repo, connRepo, iamRepo, worker.PublicId)
+		_, err := repo.AttachShadow(ctx, s.PublicId, sh.PublicId, sh.Token, other.PublicId)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Conflict), err))
+	})
+	t.Run("missing-connection-id", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		sh := newShadow(t, false)
+		_, err := repo.AttachShadow(ctx, s.PublicId, sh.PublicId, sh.Token, "")
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+}
@@ -87,6 +87,7 @@ func TestNewRepository(t *testing.T) {
 					WithLimit(100),
 					WithPermissions(&perms.UserPermissions{}),
 					WithRandomReader(testReader),
+					WithQuotas(Quotas{MaxActivePerUser: 5}),
 				},
 			},
 			want: &Repository{
@@ -96,9 +97,24 @@ func TestNewRepository(t *testing.T) {
 				defaultLimit: 100,
 				permissions:  &perms.UserPermissions{},
 				randomReader: testReader,
+				quotas:       Quotas{MaxActivePerUser: 5},
 			},
 			wantErr: false,
 		},
+		{
+			name: "negative-quota",
+			args: args{
+				r: rw,
+				w: rw,
+				k: testKms,
+				opts: []Option{
+					WithQuotas(Quotas{MaxActivePerProject: -1}),
+				},
+			},
+			want:          nil,
+			wantErr:       true,
+			wantErrString: "session.NewRepository: session quotas must not be negative: parameter violation: error #100",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -51,6 +51,21 @@ type ComposedOf struct {
 	ExpirationTime *timestamp.Timestamp
 	// Max connections for the session
 	ConnectionLimit int32
+	// Bandwidth limits in bytes per second shared by all of the target's
+	// sessions on a worker. 0 means unlimited.
+	TargetBandwidthLimitUp   int64
+	TargetBandwidthLimitDown int64
+	// Bandwidth limits in bytes per second for the session. 0 means unlimited.
+	BandwidthLimitUp   int64
+	BandwidthLimitDown int64
+	// Max new connections per minute for the session. 0 means unlimited.
+	ConnectionRateLimit int32
+	// Seconds of inactivity after which a connection is closed. 0 means
+	// disabled.
+	IdleTimeout uint32
+	// Reverse sessions forward connections made to a listener on the worker
+	// back to the client.
+	Reverse bool
 	// Ingress and egress worker filters. Active filters when the session was created, used to
 	// validate the session via the same set of rules at consumption time as
 	// existed at creation time. Round tripping it through here saves a lookup
@@ -107,6 +122,19 @@ type Session struct {
 	Endpoint string `json:"-" gorm:"default:null"`
 	// Maximum number of connections in a session
 	ConnectionLimit int32 `json:"connection_limit,omitempty" gorm:"default:null"`
+	// Bandwidth limits in bytes per second shared by all of the target's
+	// sessions on a worker
+	TargetBandwidthLimitUp   int64 `json:"target_bandwidth_limit_up,omitempty" gorm:"default:null"`
+	TargetBandwidthLimitDown int64 `json:"target_bandwidth_limit_down,omitempty" gorm:"default:null"`
+	// Bandwidth limits in bytes per second for the session
+	BandwidthLimitUp   int64 `json:"bandwidth_limit_up,omitempty" gorm:"default:null"`
+	BandwidthLimitDown int64 `json:"bandwidth_limit_down,omitempty" gorm:"default:null"`
+	// Maximum number of new connections per minute in a session
+	ConnectionRateLimit int32 `json:"connection_rate_limit,omitempty" gorm:"default:null"`
+	// Seconds of inactivity after which a connection in the session is closed
+	IdleTimeout uint32 `json:"idle_timeout,omitempty" gorm:"default:null"`
+	// Whether connections to a listener on the worker are forwarded to the client
+	Reverse bool `json:"reverse,omitempty" gorm:"default:null"`
 
 	// Worker filters
 	WorkerFilter        string `json:"-" gorm:"default:null"`
@@ -138,6 +166,9 @@ type Session struct {
 	// Connections for the session are for read only and are ignored during write operations
 	Connections []*Connection `gorm:"-"`
 
+	// Shadows of the session are for read only and are ignored during write operations
+	Shadows []*Shadow `gorm:"-"`
+
 	tableName string `gorm:"-"`
 }
 
@@ -190,21 +221,28 @@ var (
 func New(ctx context.Context, c ComposedOf, _ ...Option) (*Session, error) {
 	const op = "session.New"
 	s := Session{
-		UserId:              c.UserId,
-		HostId:              c.HostId,
-		TargetId:            c.TargetId,
-		HostSetId:           c.HostSetId,
-		AuthTokenId:         c.AuthTokenId,
-		ProjectId:           c.ProjectId,
-		Endpoint:            c.Endpoint,
-		ExpirationTime:      c.ExpirationTime,
-		ConnectionLimit:     c.ConnectionLimit,
-		WorkerFilter:        c.WorkerFilter,
-		EgressWorkerFilter:  c.EgressWorkerFilter,
-		IngressWorkerFilter: c.IngressWorkerFilter,
-		DynamicCredentials:  c.DynamicCredentials,
-		StaticCredentials:   c.StaticCredentials,
-		ProtocolWorkerId:    c.ProtocolWorkerId,
+		UserId:                   c.UserId,
+		HostId:                   c.HostId,
+		TargetId:                 c.TargetId,
+		HostSetId:                c.HostSetId,
+		AuthTokenId:              c.AuthTokenId,
+		ProjectId:                c.ProjectId,
+		Endpoint:                 c.Endpoint,
+		ExpirationTime:           c.ExpirationTime,
+		ConnectionLimit:          c.ConnectionLimit,
+		TargetBandwidthLimitUp:   c.TargetBandwidthLimitUp,
+		TargetBandwidthLimitDown: c.TargetBandwidthLimitDown,
+		BandwidthLimitUp:         c.BandwidthLimitUp,
+		BandwidthLimitDown:       c.BandwidthLimitDown,
+		ConnectionRateLimit:      c.ConnectionRateLimit,
+		IdleTimeout:              c.IdleTimeout,
+		Reverse:                  c.Reverse,
+		WorkerFilter:             c.WorkerFilter,
+		EgressWorkerFilter:       c.EgressWorkerFilter,
+		IngressWorkerFilter:      c.IngressWorkerFilter,
+		DynamicCredentials:       c.DynamicCredentials,
+		StaticCredentials:        c.StaticCredentials,
+		ProtocolWorkerId:         c.ProtocolWorkerId,
 	}
 	if err := s.validateNewSession(ctx); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -220,22 +258,29 @@ func AllocSession() Session {
 // Clone creates a clone of the Session
 func (s *Session) Clone() any {
 	clone := &Session{
-		PublicId:            s.PublicId,
-		UserId:              s.UserId,
-		HostId:              s.HostId,
-		TargetId:            s.TargetId,
-		HostSetId:           s.HostSetId,
-		AuthTokenId:         s.AuthTokenId,
-		ProjectId:           s.ProjectId,
-		TerminationReason:   s.TerminationReason,
-		Version:             s.Version,
-		Endpoint:            s.Endpoint,
-		ConnectionLimit:     s.ConnectionLimit,
-		WorkerFilter:        s.WorkerFilter,
-		EgressWorkerFilter:  s.EgressWorkerFilter,
-		IngressWorkerFilter: s.IngressWorkerFilter,
-		KeyId:               s.KeyId,
-		ProtocolWorkerId:    s.ProtocolWorkerId,
+		PublicId:                 s.PublicId,
+		UserId:                   s.UserId,
+		HostId:                   s.HostId,
+		TargetId:                 s.TargetId,
+		HostSetId:                s.HostSetId,
+		AuthTokenId:              s.AuthTokenId,
+		ProjectId:                s.ProjectId,
+		TerminationReason:        s.TerminationReason,
+		Version:                  s.Version,
+		Endpoint:                 s.Endpoint,
+		ConnectionLimit:          s.ConnectionLimit,
+		TargetBandwidthLimitUp:   s.TargetBandwidthLimitUp,
+		TargetBandwidthLimitDown: s.TargetBandwidthLimitDown,
+		BandwidthLimitUp:         s.BandwidthLimitUp,
+		BandwidthLimitDown:       s.BandwidthLimitDown,
+		ConnectionRateLimit:      s.ConnectionRateLimit,
+		IdleTimeout:              s.IdleTimeout,
+		Reverse:                  s.Reverse,
+		WorkerFilter:             s.WorkerFilter,
+		EgressWorkerFilter:       s.EgressWorkerFilter,
+		IngressWorkerFilter:      s.IngressWorkerFilter,
+		KeyId:                    s.KeyId,
+		ProtocolWorkerId:         s.ProtocolWorkerId,
 	}
 	if len(s.States) > 0 {
 		clone.States = make([]*State, 0, len(s.States))
@@ -347,6 +392,20 @@ func (s *Session) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType
 			return errors.New(ctx, errors.InvalidParameter, op, "expiration time is immutable")
 		case contains(opts.WithFieldMaskPaths, "ConnectionLimit"):
 			return errors.New(ctx, errors.InvalidParameter, op, "connection limit is immutable")
+		case contains(opts.WithFieldMaskPaths, "TargetBandwidthLimitUp"):
+			return errors.New(ctx, errors.InvalidParameter, op, "target bandwidth limit up is immutable")
+		case contains(opts.WithFieldMaskPaths, "TargetBandwidthLimitDown"):
+			return errors.New(ctx, errors.InvalidParameter, op, "target bandwidth limit down is immutable")
+		case contains(opts.WithFieldMaskPaths, "BandwidthLimitUp"):
+			return errors.New(ctx, errors.InvalidParameter, op, "bandwidth limit up is immutable")
+		case contains(opts.WithFieldMaskPaths, "BandwidthLimitDown"):
+			return errors.New(ctx, errors.InvalidParameter, op, "bandwidth limit down is immutable")
+		case contains(opts.WithFieldMaskPaths, "ConnectionRateLimit"):
+			return errors.New(ctx, errors.InvalidParameter, op, "connection rate limit is immutable")
+		case contains(opts.WithFieldMaskPaths, "IdleTimeout"):
+			return errors.New(ctx, errors.InvalidParameter, op, "idle timeout is immutable")
+		case contains(opts.WithFieldMaskPaths, "Reverse"):
+			return errors.New(ctx, errors.InvalidParameter, op, "reverse is immutable")
 		case contains(opts.WithFieldMaskPaths, "WorkerFilter"):
 			return errors.New(ctx, errors.InvalidParameter, op, "worker filter is immutable")
 		case contains(opts.WithFieldMaskPaths, "EgressWorkerFilter"):
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultShadowTableName = "session_shadow"
+)
+
+// ShadowStatus of a session shadow
+type ShadowStatus string
+
+const (
+	ShadowPending  ShadowStatus = "pending"
+	ShadowApproved ShadowStatus = "approved"
+)
+
+// String representation of the shadow's status
+func (s ShadowStatus) String() string {
+	return string(s)
+}
+
+// Shadow is a user attached to an active session as a shadow. A shadow
+// receives the data proxied for one of the session's connections and, if it is
+// a read-write shadow, can write to it. Read-only shadows are approved when
+// they are created; read-write shadows must be approved before they can attach.
+type Shadow struct {
+	// PublicId is used to access the shadow via an API
+	PublicId string `json:"public_id,omitempty" gorm:"primary_key"`
+	// SessionId of the session being shadowed
+	SessionId string `json:"session_id,omitempty" gorm:"default:null"`
+	// UserId of the user shadowing the session
+	UserId string `json:"user_id,omitempty" gorm:"default:null"`
+	// ReadWrite is true if the shadow can write to the shadowed connection
+	ReadWrite bool `json:"read_write,omitempty" gorm:"default:null"`
+	// Status of the shadow
+	Status ShadowStatus `json:"status,omitempty" gorm:"default:null"`
+	// ApproverId of the user who approved a read-write shadow
+	ApproverId string `json:"approver_id,omitempty" gorm:"default:null"`
+	// TokenHash is the sha256 of the token presented by the shadow when
+	// attaching
+	TokenHash []byte `json:"token_hash,omitempty" gorm:"default:null"`
+	// ConnectionId of the connection the shadow most recently attached to
+	ConnectionId string `json:"connection_id,omitempty" gorm:"default:null"`
+	// AttachTime is when the shadow most recently attached to a connection
+	AttachTime *timestamp.Timestamp `json:"attach_time,omitempty" gorm:"default:null"`
+	// CreateTime from the RDBMS
+	CreateTime *timestamp.Timestamp `json:"create_time,omitempty" gorm:"default:current_timestamp"`
+	// UpdateTime from the RDBMS
+	UpdateTime *timestamp.Timestamp `json:"update_time,omitempty" gorm:"default:current_timestamp"`
+	// Version of the shadow
+	Version uint32 `json:"version,omitempty" gorm:"default:null"`
+
+	// Token is only set when the shadow is created and is never stored
+	Token string `gorm:"-"`
+	// WorkerAddress is the address of the worker handling the connection to
+	// shadow. It is only set when the shadow is created.
+	WorkerAddress string `gorm:"-"`
+
+	tableName string `gorm:"-"`
+}
+
+var (
+	_ Cloneable       = (*Shadow)(nil)
+	_ db.VetForWriter = (*Shadow)(nil)
+)
+
+// NewShadow creates a new in memory shadow of the session for the user. No
+// options are currently supported.
+func NewShadow(ctx context.Context, sessionId, userId string, readWrite bool, _ ...Option) (*Shadow, error) {
+	const op = "session.NewShadow"
+	s := Shadow{
+		SessionId: sessionId,
+		UserId:    userId,
+		ReadWrite: readWrite,
+		Status:    ShadowApproved,
+	}
+	if readWrite {
+		s.Status = ShadowPending
+	}
+	if err := s.validateNewShadow(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &s, nil
+}
+
+// AllocShadow will allocate a Shadow.
+func AllocShadow() Shadow {
+	return Shadow{}
+}
+
+// GetPublicId returns the public id of the shadow.
+func (s *Shadow) GetPublicId() string {
+	return s.PublicId
+}
+
+// Clone creates a clone of the Shadow.
+func (s *Shadow) Clone() any {
+	clone := &Shadow{
+		PublicId:      s.PublicId,
+		SessionId:     s.SessionId,
+		UserId:        s.UserId,
+		ReadWrite:     s.ReadWrite,
+		Status:        s.Status,
+		ApproverId:    s.ApproverId,
+		ConnectionId:  s.ConnectionId,
+		Version:       s.Version,
+		Token:         s.Token,
+		WorkerAddress: s.WorkerAddress,
+	}
+	if s.TokenHash != nil {
+		clone.TokenHash = make([]byte, len(s.TokenHash))
+		copy(clone.TokenHash, s.TokenHash)
+	}
+	if s.AttachTime != nil {
+		clone.AttachTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: s.AttachTime.Timestamp.Seconds,
+				Nanos:   s.AttachTime.Timestamp.Nanos,
+			},
+		}
+	}
+	if s.CreateTime != nil {
+		clone.CreateTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: s.CreateTime.Timestamp.Seconds,
+				Nanos:   s.CreateTime.Timestamp.Nanos,
+			},
+		}
+	}
+	if s.UpdateTime != nil {
+		clone.UpdateTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: s.UpdateTime.Timestamp.Seconds,
+				Nanos:   s.UpdateTime.Timestamp.Nanos,
+			},
+		}
+	}
+	return clone
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the shadow
+// before it's written.
+func (s *Shadow) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, opt ...db.Option) error {
+	const op = "session.(Shadow).VetForWrite"
+	opts := db.GetOpts(opt...)
+	if s.PublicId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	switch opType {
+	case db.CreateOp:
+		if err := s.validateNewShadow(ctx); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if len(s.TokenHash) == 0 {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing token hash")
+		}
+	case db.UpdateOp:
+		switch {
+		case contains(opts.WithFieldMaskPaths, "PublicId"):
+			return errors.New(ctx, errors.InvalidParameter, op, "public id is immutable")
+		case contains(opts.WithFieldMaskPaths, "SessionId"):
+			return errors.New(ctx, errors.InvalidParameter, op, "session id is immutable")
+		case contains(opts.WithFieldMaskPaths, "UserId"):
+			return errors.New(ctx, errors.InvalidParameter, op, "user id is immutable")
+		case contains(opts.WithFieldMaskPaths, "ReadWrite"):
+			return errors.New(ctx, errors.InvalidParameter, op, "read write is immutable")
+		case contains(opts.WithFieldMaskPaths, "TokenHash"):
+			return errors.New(ctx, errors.InvalidParameter, op, "token hash is immutable")
+		case contains(opts.WithFieldMaskPaths, "CreateTime"):
+			return errors.New(ctx, errors.InvalidParameter, op, "create time is immutable")
+		case contains(opts.WithFieldMaskPaths, "UpdateTime"):
+			return errors.New(ctx, errors.InvalidParameter, op, "update time is immutable")
+		}
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (s *Shadow) TableName() string {
+	if s.tableName != "" {
+		return s.tableName
+	}
+	return defaultShadowTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (s *Shadow) SetTableName(n string) {
+	s.tableName = n
+}
+
+// validateNewShadow checks everything but the shadow's PublicId and TokenHash
+func (s *Shadow) validateNewShadow(ctx context.Context) error {
+	const op = "session.(Shadow).validateNewShadow"
+	if s.SessionId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	if s.UserId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	switch {
+	case s.ReadWrite && s.Status != ShadowPending:
+		return errors.New(ctx, errors.InvalidParameter, op, "new read-write shadow must be pending")
+	case !s.ReadWrite && s.Status != ShadowApproved:
+		return errors.New(ctx, errors.InvalidParameter, op, "new read-only shadow must be approved")
+	}
+	if s.ApproverId != "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "approver id is not empty")
+	}
+	if s.ConnectionId != "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "connection id is not empty")
+	}
+	return nil
+}
+
+// hashShadowToken returns the hash of a shadow token which is stored in place
+// of the token itself.
+func hashShadowToken(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
@@ -14,15 +14,22 @@ type StaticCredential struct {
 	CredentialPurpose  string `json:"credential_purpose,omitempty" gorm:"primary_key"`
 	CredentialStaticId string `json:"credential_id,omitempty" gorm:"default:null"`
 
+	// ExclusiveCheckout is true when the credential must be checked out by
+	// the session and can not be held by any other session at the same time.
+	ExclusiveCheckout bool `json:"-" gorm:"-"`
+
 	tableName string `gorm:"-"`
 }
 
 // NewStaticCredential creates a new in memory Credential representing the
 // relationship between session a credential and the purpose of the credential.
-func NewStaticCredential(id string, purpose cred.Purpose) *StaticCredential {
+// Supports the WithExclusiveCheckout option.
+func NewStaticCredential(id string, purpose cred.Purpose, opt ...Option) *StaticCredential {
+	opts := getOpts(opt...)
 	return &StaticCredential{
 		CredentialStaticId: id,
 		CredentialPurpose:  string(purpose),
+		ExclusiveCheckout:  opts.withExclusiveCheckout,
 	}
 }
 
@@ -31,6 +38,7 @@ func (c *StaticCredential) clone() *StaticCredential {
 		SessionId:          c.SessionId,
 		CredentialPurpose:  c.CredentialPurpose,
 		CredentialStaticId: c.CredentialStaticId,
+		ExclusiveCheckout:  c.ExclusiveCheckout,
 	}
 }
 
@@ -27,6 +27,7 @@ type CredentialSource interface {
 	CredentialPurpose() credential.Purpose
 	TargetId() string
 	Type() CredentialSourceType
+	ExclusiveCheckout() bool
 }
 
 // CredentialSources contains slices of credential publicIds
@@ -34,6 +35,10 @@ type CredentialSource interface {
 type CredentialSources struct {
 	BrokeredCredentialIds            []string
 	InjectedApplicationCredentialIds []string
+	// ExclusiveCheckoutCredentialIds are the ids of static credentials in
+	// BrokeredCredentialIds or InjectedApplicationCredentialIds that can only
+	// be held by one session at a time.
+	ExclusiveCheckoutCredentialIds []string
 }
 
 // A TargetCredentialSource represents the relationship between a target and a
@@ -76,6 +81,12 @@ func (ts *TargetCredentialSource) Type() CredentialSourceType {
 	return CredentialSourceType(ts.GetType())
 }
 
+// ExclusiveCheckout returns true if the credential source can only be held by
+// one session at a time.
+func (ts *TargetCredentialSource) ExclusiveCheckout() bool {
+	return ts.GetExclusiveCheckout()
+}
+
 // credentialSourceView provides a common way to return credential sources regardless of their
 // underlying type (library or static).
 type credentialSourceView struct {
@@ -27,33 +27,41 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	WithName                   string
-	WithDescription            string
-	WithDefaultPort            uint32
-	WithDefaultClientPort      uint32
-	WithLimit                  int
-	WithProjectId              string
-	WithProjectIds             []string
-	WithProjectName            string
-	WithUserId                 string
-	WithType                   globals.Subtype
-	WithHostSources            []string
-	WithCredentialLibraries    []*CredentialLibrary
-	WithStaticCredentials      []*StaticCredential
-	WithSessionMaxSeconds      uint32
-	WithSessionConnectionLimit int32
-	WithPermissions            []perms.Permission
-	WithPublicId               string
-	WithWorkerFilter           string
-	WithTestWorkerFilter       string
-	WithEgressWorkerFilter     string
-	WithIngressWorkerFilter    string
-	WithTargetIds              []string
-	WithAddress                string
-	WithStorageBucketId        string
-	WithEnableSessionRecording bool
-	WithNetResolver            intglobals.NetIpResolver
-	WithStartPageAfterItem     pagination.Item
+	WithName                       string
+	WithDescription                string
+	WithDefaultPort                uint32
+	WithDefaultClientPort          uint32
+	WithLimit                      int
+	WithProjectId                  string
+	WithProjectIds                 []string
+	WithProjectName                string
+	WithUserId                     string
+	WithType                       globals.Subtype
+	WithHostSources                []string
+	WithCredentialLibraries        []*CredentialLibrary
+	WithStaticCredentials          []*StaticCredential
+	WithSessionMaxSeconds          uint32
+	WithSessionConnectionLimit     int32
+	WithPermissions                []perms.Permission
+	WithPublicId                   string
+	WithWorkerFilter               string
+	WithTestWorkerFilter           string
+	WithEgressWorkerFilter         string
+	WithIngressWorkerFilter        string
+	WithTargetIds                  []string
+	WithAddress                    string
+	WithStorageBucketId            string
+	WithEnableSessionRecording     bool
+	WithApprovalRequired           bool
+	WithWorkerSelection            string
+	WithBandwidthLimitUp           int64
+	WithBandwidthLimitDown         int64
+	WithSessionBandwidthLimitUp    int64
+	WithSessionBandwidthLimitDown  int64
+	WithSessionConnectionRateLimit int32
+	WithIdleTimeout                uint32
+	WithNetResolver                intglobals.NetIpResolver
+	WithStartPageAfterItem         pagination.Item
 }
 
 func getDefaultOptions() options {
@@ -254,6 +262,71 @@ func WithEnableSessionRecording(enable bool) Option {
 	}
 }
 
+// WithApprovalRequired provides an option to require approval of an access
+// request before sessions to the target are authorized
+func WithApprovalRequired(required bool) Option {
+	return func(o *options) {
+		o.WithApprovalRequired = required
+	}
+}
+
+// WithWorkerSelectionStrategy provides an option to set the strategy used to
+// order the workers that can handle a session to the target
+func WithWorkerSelectionStrategy(strategy string) Option {
+	return func(o *options) {
+		o.WithWorkerSelection = strategy
+	}
+}
+
+// WithBandwidthLimitUp provides an option to set the maximum bytes per second
+// sent from clients to the target across all of its sessions on a worker
+func WithBandwidthLimitUp(limit int64) Option {
+	return func(o *options) {
+		o.WithBandwidthLimitUp = limit
+	}
+}
+
+// WithBandwidthLimitDown provides an option to set the maximum bytes per
+// second sent from the target to clients across all of its sessions on a
+// worker
+func WithBandwidthLimitDown(limit int64) Option {
+	return func(o *options) {
+		o.WithBandwidthLimitDown = limit
+	}
+}
+
+// WithSessionBandwidthLimitUp provides an option to set the maximum bytes per
+// second sent from the client to the target in a single session
+func WithSessionBandwidthLimitUp(limit int64) Option {
+	return func(o *options) {
+		o.WithSessionBandwidthLimitUp = limit
+	}
+}
+
+// WithSessionBandwidthLimitDown provides an option to set the maximum bytes
+// per second sent from the target to the client in a single session
+func WithSessionBandwidthLimitDown(limit int64) Option {
+	return func(o *options) {
+		o.WithSessionBandwidthLimitDown = limit
+	}
+}
+
+// WithSessionConnectionRateLimit provides an option to set the maximum number
+// of new connections per minute in a single session
+func WithSessionConnectionRateLimit(limit int32) Option {
+	return func(o *options) {
+		o.WithSessionConnectionRateLimit = limit
+	}
+}
+
+// WithIdleTimeout provides an option to set the number of seconds of
+// inactivity after which a connection to the target is closed
+func WithIdleTimeout(timeout uint32) Option {
+	return func(o *options) {
+		o.WithIdleTimeout = timeout
+	}
+}
+
 // WithStorageBucketId provides an option to set a storage bucket on a target
 func WithStorageBucketId(id string) Option {
 	return func(o *options) {
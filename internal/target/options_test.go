@@ -250,6 +250,62 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.WithEnableSessionRecording = true
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithWorkerSelectionStrategy", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithWorkerSelectionStrategy("least_connections"))
+		testOpts := getDefaultOptions()
+		testOpts.WithWorkerSelection = "least_connections"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithBandwidthLimitUp", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithBandwidthLimitUp(1000))
+		testOpts := getDefaultOptions()
+		testOpts.WithBandwidthLimitUp = 1000
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithBandwidthLimitDown", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithBandwidthLimitDown(2000))
+		testOpts := getDefaultOptions()
+		testOpts.WithBandwidthLimitDown = 2000
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSessionBandwidthLimitUp", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithSessionBandwidthLimitUp(100))
+		testOpts := getDefaultOptions()
+		testOpts.WithSessionBandwidthLimitUp = 100
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSessionBandwidthLimitDown", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithSessionBandwidthLimitDown(200))
+		testOpts := getDefaultOptions()
+		testOpts.WithSessionBandwidthLimitDown = 200
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSessionConnectionRateLimit", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithSessionConnectionRateLimit(10))
+		testOpts := getDefaultOptions()
+		testOpts.WithSessionConnectionRateLimit = 10
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithIdleTimeout", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithIdleTimeout(600))
+		testOpts := getDefaultOptions()
+		testOpts.WithIdleTimeout = 600
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithApprovalRequired", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithApprovalRequired(true))
+		testOpts := getDefaultOptions()
+		testOpts.WithApprovalRequired = true
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithStartPageAfterItem", func(t *testing.T) {
 		assert := assert.New(t)
 		updateTime := time.Now()
@@ -60,7 +60,7 @@ select public_id, project_id from target
 `
 
 	estimateCountTargets = `
-select sum(reltuples::bigint) as estimate from pg_class where oid in ('target_tcp'::regclass, 'target_ssh'::regclass)
+select sum(reltuples::bigint) as estimate from pg_class where oid in ('target_tcp'::regclass, 'target_ssh'::regclass, 'target_udp'::regclass)
 `
 
 	listTargetsTemplate = `
@@ -98,7 +98,15 @@ final as (
          default_client_port,
          null as storage_bucket_id,
          false as enable_session_recording,
-         'tcp' as type
+         'tcp' as type,
+         approval_required,
+         worker_selection_strategy,
+         bandwidth_limit_up,
+         bandwidth_limit_down,
+         session_bandwidth_limit_up,
+         session_bandwidth_limit_down,
+         session_connection_rate_limit,
+         idle_timeout
     from tcp_targets
    union
   select public_id,
@@ -117,7 +125,15 @@ final as (
          default_client_port,
          storage_bucket_id,
          enable_session_recording,
-         'ssh' as type
+         'ssh' as type,
+         approval_required,
+         worker_selection_strategy,
+         bandwidth_limit_up,
+         bandwidth_limit_down,
+         session_bandwidth_limit_up,
+         session_bandwidth_limit_down,
+         session_connection_rate_limit,
+         idle_timeout
     from ssh_targets
 )
   select *
@@ -161,7 +177,15 @@ final as (
          default_client_port,
          null as storage_bucket_id,
          false as enable_session_recording,
-         'tcp' as type
+         'tcp' as type,
+         approval_required,
+         worker_selection_strategy,
+         bandwidth_limit_up,
+         bandwidth_limit_down,
+         session_bandwidth_limit_up,
+         session_bandwidth_limit_down,
+         session_connection_rate_limit,
+         idle_timeout
     from tcp_targets
    union
   select public_id,
@@ -180,7 +204,15 @@ final as (
          default_client_port,
          storage_bucket_id,
          enable_session_recording,
-         'ssh' as type
+         'ssh' as type,
+         approval_required,
+         worker_selection_strategy,
+         bandwidth_limit_up,
+         bandwidth_limit_down,
+         session_bandwidth_limit_up,
+         session_bandwidth_limit_down,
+         session_connection_rate_limit,
+         idle_timeout
     from ssh_targets
 )
   select *
@@ -224,7 +256,15 @@ final as (
          default_client_port,
          null as storage_bucket_id,
          false as enable_session_recording,
-         'tcp' as type
+         'tcp' as type,
+         approval_required,
+         worker_selection_strategy,
+         bandwidth_limit_up,
+         bandwidth_limit_down,
+         session_bandwidth_limit_up,
+         session_bandwidth_limit_down,
+         session_connection_rate_limit,
+         idle_timeout
     from tcp_targets
    union
   select public_id,
@@ -243,7 +283,15 @@ final as (
          default_client_port,
          storage_bucket_id,
          enable_session_recording,
-         'ssh' as type
+         'ssh' as type,
+         approval_required,
+         worker_selection_strategy,
+         bandwidth_limit_up,
+         bandwidth_limit_down,
+         session_bandwidth_limit_up,
+         session_bandwidth_limit_down,
+         session_connection_rate_limit,
+         idle_timeout
     from ssh_targets
 )
   select *
@@ -288,7 +336,15 @@ final as (
          default_client_port,
          null as storage_bucket_id,
          false as enable_session_recording,
-         'tcp' as type
+         'tcp' as type,
+         approval_required,
+         worker_selection_strategy,
+         bandwidth_limit_up,
+         bandwidth_limit_down,
+         session_bandwidth_limit_up,
+         session_bandwidth_limit_down,
+         session_connection_rate_limit,
+         idle_timeout
     from tcp_targets
    union
   select public_id,
@@ -307,7 +363,15 @@ final as (
          default_client_port,
          storage_bucket_id,
          enable_session_recording,
-         'ssh' as type
+         'ssh' as type,
+         approval_required,
+         worker_selection_strategy,
+         bandwidth_limit_up,
+         bandwidth_limit_down,
+         session_bandwidth_limit_up,
+         session_bandwidth_limit_down,
+         session_connection_rate_limit,
+         idle_timeout
     from ssh_targets
 )
   select *
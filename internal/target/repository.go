@@ -640,6 +640,14 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 			addressEndpoint = target.GetAddress()
 		case strings.EqualFold("storagebucketid", f):
 		case strings.EqualFold("enablesessionrecording", f):
+		case strings.EqualFold("approvalrequired", f):
+		case strings.EqualFold("workerselectionstrategy", f):
+		case strings.EqualFold("bandwidthlimitup", f):
+		case strings.EqualFold("bandwidthlimitdown", f):
+		case strings.EqualFold("sessionbandwidthlimitup", f):
+		case strings.EqualFold("sessionbandwidthlimitdown", f):
+		case strings.EqualFold("sessionconnectionratelimit", f):
+		case strings.EqualFold("idletimeout", f):
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -648,21 +656,33 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
 		map[string]any{
-			"Name":                   target.GetName(),
-			"Description":            target.GetDescription(),
-			"DefaultPort":            target.GetDefaultPort(),
-			"DefaultClientPort":      target.GetDefaultClientPort(),
-			"SessionMaxSeconds":      target.GetSessionMaxSeconds(),
-			"SessionConnectionLimit": target.GetSessionConnectionLimit(),
-			"WorkerFilter":           target.GetWorkerFilter(),
-			"EgressWorkerFilter":     target.GetEgressWorkerFilter(),
-			"IngressWorkerFilter":    target.GetIngressWorkerFilter(),
-			"Address":                target.GetAddress(),
-			"StorageBucketId":        target.GetStorageBucketId(),
-			"EnableSessionRecording": target.GetEnableSessionRecording(),
+			"Name":                       target.GetName(),
+			"Description":                target.GetDescription(),
+			"DefaultPort":                target.GetDefaultPort(),
+			"DefaultClientPort":          target.GetDefaultClientPort(),
+			"SessionMaxSeconds":          target.GetSessionMaxSeconds(),
+			"SessionConnectionLimit":     target.GetSessionConnectionLimit(),
+			"WorkerFilter":               target.GetWorkerFilter(),
+			"EgressWorkerFilter":         target.GetEgressWorkerFilter(),
+			"IngressWorkerFilter":        target.GetIngressWorkerFilter(),
+			"Address":                    target.GetAddress(),
+			"StorageBucketId":            target.GetStorageBucketId(),
+			"EnableSessionRecording":     target.GetEnableSessionRecording(),
+			"ApprovalRequired":           target.GetApprovalRequired(),
+			"WorkerSelectionStrategy":    target.GetWorkerSelectionStrategy(),
+			"BandwidthLimitUp":           target.GetBandwidthLimitUp(),
+			"BandwidthLimitDown":         target.GetBandwidthLimitDown(),
+			"SessionBandwidthLimitUp":    target.GetSessionBandwidthLimitUp(),
+			"SessionBandwidthLimitDown":  target.GetSessionBandwidthLimitDown(),
+			"SessionConnectionRateLimit": target.GetSessionConnectionRateLimit(),
+			"IdleTimeout":                target.GetIdleTimeout(),
 		},
 		fieldMaskPaths,
-		[]string{"SessionMaxSeconds", "SessionConnectionLimit", "EnableSessionRecording"},
+		[]string{
+			"SessionMaxSeconds", "SessionConnectionLimit", "EnableSessionRecording", "ApprovalRequired",
+			"BandwidthLimitUp", "BandwidthLimitDown", "SessionBandwidthLimitUp", "SessionBandwidthLimitDown",
+			"SessionConnectionRateLimit", "IdleTimeout",
+		},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
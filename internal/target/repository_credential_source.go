@@ -289,7 +289,12 @@ func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId st
 		delStaticCred = append(delStaticCred, delS...)
 	}
 
-	if len(addCredLibs)+len(delCredLibs)+len(addStaticCred)+len(delStaticCred) == 0 {
+	updStaticCred, err := r.exclusiveCheckoutChanges(ctx, targetId, addStaticCred, delStaticCred, ids.ExclusiveCheckoutCredentialIds)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	if len(addCredLibs)+len(delCredLibs)+len(addStaticCred)+len(delStaticCred)+len(updStaticCred) == 0 {
 		// Nothing needs to be changed, return early
 		hostSets, err := fetchHostSources(ctx, r.reader, targetId)
 		if err != nil {
@@ -428,6 +433,20 @@ func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId st
 				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_DELETE.String())
 			}
 
+			// update exclusive checkout of existing static credentials
+			for _, c := range updStaticCred {
+				var updMsg oplog.Message
+				rowsUpdated, err := w.Update(ctx, c, []string{"ExclusiveCheckout"}, nil, db.NewOplogMsg(&updMsg))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update target static credential"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("target static credential updated %d rows, expected 1", rowsUpdated))
+				}
+				rowsAffected += rowsUpdated
+				msgs = append(msgs, &updMsg)
+			}
+
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, targetTicket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
 			}
@@ -523,6 +542,52 @@ func (r *Repository) changes(ctx context.Context, targetId string, ids []string,
 	return addCredLib, delCredLib, addStaticCred, delStaticCred, nil
 }
 
+// exclusiveCheckoutChanges sets the exclusive checkout flag on the static
+// credentials being added to the target and returns the static credentials
+// already attached to the target whose flag must be updated. An error is
+// returned if an exclusive checkout id is not a static credential which will
+// be attached to the target.
+func (r *Repository) exclusiveCheckoutChanges(ctx context.Context, targetId string, addStaticCred, delStaticCred []*StaticCredential, exclusiveIds []string) ([]*StaticCredential, error) {
+	const op = "target.(Repository).exclusiveCheckoutChanges"
+	exclusive := make(map[string]bool, len(exclusiveIds))
+	for _, id := range exclusiveIds {
+		exclusive[id] = true
+	}
+	staticIds := make(map[string]bool, len(addStaticCred))
+	for _, c := range addStaticCred {
+		c.ExclusiveCheckout = exclusive[c.CredentialId]
+		staticIds[c.CredentialId] = true
+	}
+
+	deleted := make(map[string]bool, len(delStaticCred))
+	for _, c := range delStaticCred {
+		deleted[c.CredentialId+c.CredentialPurpose] = true
+	}
+	var current []*StaticCredential
+	if err := r.reader.SearchWhere(ctx, &current, "target_id = ?", []any{targetId}); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("can't retrieve target static credentials"))
+	}
+	var updStaticCred []*StaticCredential
+	for _, c := range current {
+		if deleted[c.CredentialId+c.CredentialPurpose] {
+			continue
+		}
+		staticIds[c.CredentialId] = true
+		if c.ExclusiveCheckout != exclusive[c.CredentialId] {
+			upd := c.clone()
+			upd.ExclusiveCheckout = exclusive[c.CredentialId]
+			updStaticCred = append(updStaticCred, upd)
+		}
+	}
+
+	for _, id := range exclusiveIds {
+		if !staticIds[id] {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("exclusive checkout credential %s is not a static credential attached to the target", id))
+		}
+	}
+	return updStaticCred, nil
+}
+
 func fetchCredentialSources(ctx context.Context, r db.Reader, targetId string) ([]CredentialSource, error) {
 	const op = "target.fetchCredentialSources"
 	var sources []*TargetCredentialSource
@@ -567,6 +632,14 @@ func (r *Repository) createSources(ctx context.Context, tId string, tSubtype glo
 		credTypeById[cv.GetPublicId()] = CredentialSourceType(cv.GetType())
 	}
 
+	exclusive := make(map[string]bool, len(credSources.ExclusiveCheckoutCredentialIds))
+	for _, id := range credSources.ExclusiveCheckoutCredentialIds {
+		if credTypeById[id] != StaticCredentialSourceType {
+			return nil, nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("exclusive checkout credential %s is not a static credential attached to the target", id))
+		}
+		exclusive[id] = true
+	}
+
 	credLibs := make([]*CredentialLibrary, 0, totalCreds)
 	staticCred := make([]*StaticCredential, 0, totalCreds)
 	byPurpose := map[credential.Purpose][]string{
@@ -587,6 +660,7 @@ func (r *Repository) createSources(ctx context.Context, tId string, tSubtype glo
 				if err != nil {
 					return nil, nil, errors.Wrap(ctx, err, op)
 				}
+				cred.ExclusiveCheckout = exclusive[id]
 				staticCred = append(staticCred, cred)
 			}
 		}
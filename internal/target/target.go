@@ -40,6 +40,14 @@ type Target interface {
 	GetCredentialSources() []CredentialSource
 	GetStorageBucketId() string
 	GetEnableSessionRecording() bool
+	GetApprovalRequired() bool
+	GetWorkerSelectionStrategy() string
+	GetBandwidthLimitUp() int64
+	GetBandwidthLimitDown() int64
+	GetSessionBandwidthLimitUp() int64
+	GetSessionBandwidthLimitDown() int64
+	GetSessionConnectionRateLimit() int32
+	GetIdleTimeout() uint32
 	Clone() Target
 	SetPublicId(context.Context, string) error
 	SetProjectId(string)
@@ -60,6 +68,14 @@ type Target interface {
 	SetCredentialSources([]CredentialSource)
 	SetStorageBucketId(string)
 	SetEnableSessionRecording(bool)
+	SetApprovalRequired(bool)
+	SetWorkerSelectionStrategy(string)
+	SetBandwidthLimitUp(int64)
+	SetBandwidthLimitDown(int64)
+	SetSessionBandwidthLimitUp(int64)
+	SetSessionBandwidthLimitDown(int64)
+	SetSessionConnectionRateLimit(int32)
+	SetIdleTimeout(uint32)
 	Oplog(op oplog.OpType) oplog.Metadata
 }
 
@@ -187,6 +203,14 @@ func (t *targetView) targetSubtype(ctx context.Context, address string) (Target,
 	tt.SetCredentialSources(t.CredentialSources)
 	tt.SetEnableSessionRecording(t.EnableSessionRecording)
 	tt.SetStorageBucketId(t.StorageBucketId)
+	tt.SetApprovalRequired(t.ApprovalRequired)
+	tt.SetWorkerSelectionStrategy(t.WorkerSelectionStrategy)
+	tt.SetBandwidthLimitUp(t.BandwidthLimitUp)
+	tt.SetBandwidthLimitDown(t.BandwidthLimitDown)
+	tt.SetSessionBandwidthLimitUp(t.SessionBandwidthLimitUp)
+	tt.SetSessionBandwidthLimitDown(t.SessionBandwidthLimitDown)
+	tt.SetSessionConnectionRateLimit(t.SessionConnectionRateLimit)
+	tt.SetIdleTimeout(t.IdleTimeout)
 	return tt, nil
 }
 
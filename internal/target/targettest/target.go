@@ -239,6 +239,38 @@ func (t *Target) SetCredentialSources(sources []target.CredentialSource) {
 	t.CredentialSources = sources
 }
 
+func (t *Target) SetApprovalRequired(required bool) {
+	t.ApprovalRequired = required
+}
+
+func (t *Target) SetWorkerSelectionStrategy(strategy string) {
+	t.WorkerSelectionStrategy = strategy
+}
+
+func (t *Target) SetBandwidthLimitUp(limit int64) {
+	t.BandwidthLimitUp = limit
+}
+
+func (t *Target) SetBandwidthLimitDown(limit int64) {
+	t.BandwidthLimitDown = limit
+}
+
+func (t *Target) SetSessionBandwidthLimitUp(limit int64) {
+	t.SessionBandwidthLimitUp = limit
+}
+
+func (t *Target) SetSessionBandwidthLimitDown(limit int64) {
+	t.SessionBandwidthLimitDown = limit
+}
+
+func (t *Target) SetSessionConnectionRateLimit(limit int32) {
+	t.SessionConnectionRateLimit = limit
+}
+
+func (t *Target) SetIdleTimeout(timeout uint32) {
+	t.IdleTimeout = timeout
+}
+
 func (t *Target) SetEnableSessionRecording(_ bool) {}
 
 func (t *Target) SetStorageBucketId(_ string) {}
@@ -318,16 +350,24 @@ func New(ctx context.Context, projectId string, opt ...target.Option) (target.Ta
 	}
 	t := &Target{
 		Target: &store.Target{
-			ProjectId:              projectId,
-			Name:                   opts.WithName,
-			Description:            opts.WithDescription,
-			DefaultPort:            opts.WithDefaultPort,
-			DefaultClientPort:      opts.WithDefaultClientPort,
-			SessionConnectionLimit: opts.WithSessionConnectionLimit,
-			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
-			WorkerFilter:           opts.WithWorkerFilter,
-			EgressWorkerFilter:     opts.WithEgressWorkerFilter,
-			IngressWorkerFilter:    opts.WithIngressWorkerFilter,
+			ProjectId:                  projectId,
+			Name:                       opts.WithName,
+			Description:                opts.WithDescription,
+			DefaultPort:                opts.WithDefaultPort,
+			DefaultClientPort:          opts.WithDefaultClientPort,
+			SessionConnectionLimit:     opts.WithSessionConnectionLimit,
+			SessionMaxSeconds:          opts.WithSessionMaxSeconds,
+			WorkerFilter:               opts.WithWorkerFilter,
+			EgressWorkerFilter:         opts.WithEgressWorkerFilter,
+			IngressWorkerFilter:        opts.WithIngressWorkerFilter,
+			ApprovalRequired:           opts.WithApprovalRequired,
+			WorkerSelectionStrategy:    opts.WithWorkerSelection,
+			BandwidthLimitUp:           opts.WithBandwidthLimitUp,
+			BandwidthLimitDown:         opts.WithBandwidthLimitDown,
+			SessionBandwidthLimitUp:    opts.WithSessionBandwidthLimitUp,
+			SessionBandwidthLimitDown:  opts.WithSessionBandwidthLimitDown,
+			SessionConnectionRateLimit: opts.WithSessionConnectionRateLimit,
+			IdleTimeout:                opts.WithIdleTimeout,
 		},
 		Address: opts.WithAddress,
 	}
@@ -828,3 +828,84 @@ func TestRepository_SetTargetCredentialSources(t *testing.T) {
 		assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error %s", err.Error())
 	})
 }
+
+func TestRepository_ExclusiveCheckoutCredentialSources(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := target.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(err)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	storeVault := vault.TestCredentialStores(t, conn, wrapper, proj.GetPublicId(), 1)[0]
+	lib := vault.TestCredentialLibraries(t, conn, wrapper, storeVault.GetPublicId(), 1)[0]
+	storeStatic := static.TestCredentialStores(t, conn, wrapper, proj.GetPublicId(), 1)[0]
+	creds := static.TestUsernamePasswordCredentials(t, conn, wrapper, "u", "p", storeStatic.GetPublicId(), proj.GetPublicId(), 3)
+	cred1, cred2, cred3 := creds[0], creds[1], creds[2]
+
+	tar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "exclusive-checkout")
+
+	exclusiveBySource := func(sources []target.CredentialSource) map[string]bool {
+		ret := make(map[string]bool, len(sources))
+		for _, cs := range sources {
+			ret[cs.Id()] = cs.ExclusiveCheckout()
+		}
+		return ret
+	}
+
+	// Exclusive checkout is only allowed for static credentials in the request
+	_, err = repo.AddTargetCredentialSources(ctx, tar.GetPublicId(), tar.GetVersion(), target.CredentialSources{
+		BrokeredCredentialIds:          []string{lib.GetPublicId()},
+		ExclusiveCheckoutCredentialIds: []string{lib.GetPublicId()},
+	})
+	require.Error(err)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %s", err.Error())
+	_, err = repo.AddTargetCredentialSources(ctx, tar.GetPublicId(), tar.GetVersion(), target.CredentialSources{
+		BrokeredCredentialIds:          []string{cred1.GetPublicId()},
+		ExclusiveCheckoutCredentialIds: []string{cred2.GetPublicId()},
+	})
+	require.Error(err)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %s", err.Error())
+
+	got, err := repo.AddTargetCredentialSources(ctx, tar.GetPublicId(), tar.GetVersion(), target.CredentialSources{
+		BrokeredCredentialIds:          []string{lib.GetPublicId(), cred1.GetPublicId(), cred2.GetPublicId()},
+		ExclusiveCheckoutCredentialIds: []string{cred1.GetPublicId()},
+	})
+	require.NoError(err)
+	assert.Equal(map[string]bool{
+		lib.GetPublicId():   false,
+		cred1.GetPublicId(): true,
+		cred2.GetPublicId(): false,
+	}, exclusiveBySource(got.GetCredentialSources()))
+
+	// Set updates the exclusive checkout of existing static credentials
+	_, _, affectedRows, err := repo.SetTargetCredentialSources(ctx, tar.GetPublicId(), got.GetVersion(), target.CredentialSources{
+		BrokeredCredentialIds:          []string{lib.GetPublicId(), cred1.GetPublicId(), cred2.GetPublicId(), cred3.GetPublicId()},
+		ExclusiveCheckoutCredentialIds: []string{cred2.GetPublicId(), cred3.GetPublicId()},
+	})
+	require.NoError(err)
+	assert.Equal(3, affectedRows)
+
+	found, err := repo.LookupTarget(ctx, tar.GetPublicId())
+	require.NoError(err)
+	assert.Equal(got.GetVersion()+1, found.GetVersion())
+	assert.Equal(map[string]bool{
+		lib.GetPublicId():   false,
+		cred1.GetPublicId(): false,
+		cred2.GetPublicId(): true,
+		cred3.GetPublicId(): true,
+	}, exclusiveBySource(found.GetCredentialSources()))
+
+	_, _, _, err = repo.SetTargetCredentialSources(ctx, tar.GetPublicId(), found.GetVersion(), target.CredentialSources{
+		BrokeredCredentialIds:          []string{lib.GetPublicId()},
+		ExclusiveCheckoutCredentialIds: []string{cred1.GetPublicId()},
+	})
+	require.Error(err)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %s", err.Error())
+}
@@ -74,6 +74,18 @@ const (
 	AddGrantScopes                     Type = 60
 	SetGrantScopes                     Type = 61
 	RemoveGrantScopes                  Type = 62
+	Approve                            Type = 63
+	ExplainPermissions                 Type = 64
+	ListResolvableAliases              Type = 65
+	Shadow                             Type = 66
+	Drain                              Type = 67
+	ReverseConnect                     Type = 68
+	GenerateScimToken                  Type = 69
+	RevokeScimToken                    Type = 70
+	ListLeases                         Type = 71
+	RenewLease                         Type = 72
+	RevokeLease                        Type = 73
+	CheckIn                            Type = 74
 
 	// When adding new actions, be sure to update:
 	//
@@ -144,6 +156,18 @@ var Map = map[string]Type{
 	AddGrantScopes.String():                     AddGrantScopes,
 	SetGrantScopes.String():                     SetGrantScopes,
 	RemoveGrantScopes.String():                  RemoveGrantScopes,
+	Approve.String():                            Approve,
+	ExplainPermissions.String():                 ExplainPermissions,
+	ListResolvableAliases.String():              ListResolvableAliases,
+	Shadow.String():                             Shadow,
+	Drain.String():                              Drain,
+	ReverseConnect.String():                     ReverseConnect,
+	GenerateScimToken.String():                  GenerateScimToken,
+	RevokeScimToken.String():                    RevokeScimToken,
+	ListLeases.String():                         ListLeases,
+	RenewLease.String():                         RenewLease,
+	RevokeLease.String():                        RevokeLease,
+	CheckIn.String():                            CheckIn,
 }
 
 var DeprecatedMap = map[string]Type{
@@ -220,6 +244,18 @@ func (a Type) String() string {
 		"add-grant-scopes",
 		"set-grant-scopes",
 		"remove-grant-scopes",
+		"approve",
+		"explain-permissions",
+		"list-resolvable-aliases",
+		"shadow",
+		"drain",
+		"reverse-connect",
+		"generate-scim-token",
+		"revoke-scim-token",
+		"list-leases",
+		"renew-lease",
+		"revoke-lease",
+		"check-in",
 	}[a]
 }
 
@@ -138,6 +138,54 @@ func TestAction(t *testing.T) {
 			action: Download,
 			want:   "download",
 		},
+		{
+			action: Approve,
+			want:   "approve",
+		},
+		{
+			action: ExplainPermissions,
+			want:   "explain-permissions",
+		},
+		{
+			action: ListResolvableAliases,
+			want:   "list-resolvable-aliases",
+		},
+		{
+			action: Shadow,
+			want:   "shadow",
+		},
+		{
+			action: Drain,
+			want:   "drain",
+		},
+		{
+			action: ReverseConnect,
+			want:   "reverse-connect",
+		},
+		{
+			action: GenerateScimToken,
+			want:   "generate-scim-token",
+		},
+		{
+			action: RevokeScimToken,
+			want:   "revoke-scim-token",
+		},
+		{
+			action: ListLeases,
+			want:   "list-leases",
+		},
+		{
+			action: RenewLease,
+			want:   "renew-lease",
+		},
+		{
+			action: RevokeLease,
+			want:   "revoke-lease",
+		},
+		{
+			action: CheckIn,
+			want:   "check-in",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
@@ -127,6 +127,11 @@ func Test_Resource(t *testing.T) {
 			want:         Policy,
 			topLevelType: true,
 		},
+		{
+			typeString:   "alias",
+			want:         Alias,
+			topLevelType: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.typeString, func(t *testing.T) {
@@ -51,6 +51,7 @@ var page = &Page{
 func main() {
 	page.Resources = append(page.Resources,
 		account,
+		alias,
 		authMethod,
 		authToken,
 		group,
@@ -329,6 +330,28 @@ var account = &Resource{
 	},
 }
 
+var alias = &Resource{
+	Type:   "Alias",
+	Scopes: []string{"Global"},
+	Endpoints: []*Endpoint{
+		{
+			Path: "/aliases",
+			Params: map[string]string{
+				"Type": "alias",
+			},
+			Actions: clActions("an alias"),
+		},
+		{
+			Path: "/aliases/<id>",
+			Params: map[string]string{
+				"ID":   "<id>",
+				"Type": "alias",
+			},
+			Actions: rudActions("an alias", false),
+		},
+	},
+}
+
 var authMethod = &Resource{
 	Type:   "Auth Method",
 	Scopes: iamScopes,
@@ -355,6 +378,20 @@ var authMethod = &Resource{
 						"ids=<id>;actions=authenticate",
 					},
 				},
+				&Action{
+					Name:        "generate-scim-token",
+					Description: "Generate the token a SCIM client uses to provision users and groups into an auth method",
+					Examples: []string{
+						"ids=<id>;actions=generate-scim-token",
+					},
+				},
+				&Action{
+					Name:        "revoke-scim-token",
+					Description: "Revoke the SCIM token of an auth method",
+					Examples: []string{
+						"ids=<id>;actions=revoke-scim-token",
+					},
+				},
 			),
 		},
 	},
@@ -709,6 +746,20 @@ var session = &Resource{
 						"ids=*;type=session;actions=cancel:self",
 					},
 				},
+				{
+					Name:        "shadow",
+					Description: "Attach to an active session as a read-only or read-write shadow",
+					Examples: []string{
+						"ids=<id>;actions=shadow",
+					},
+				},
+				{
+					Name:        "approve",
+					Description: "Approve read-write shadows of a session not associated with the calling user",
+					Examples: []string{
+						"ids=<id>;actions=approve",
+					},
+				},
 			},
 		},
 	},
@@ -863,6 +914,20 @@ var target = &Resource{
 						"ids=<id>;actions=authorize-session",
 					},
 				},
+				&Action{
+					Name:        "approve",
+					Description: "List, approve, and deny access requests for a target",
+					Examples: []string{
+						"ids=<id>;actions=approve",
+					},
+				},
+				&Action{
+					Name:        "reverse-connect",
+					Description: "Authorize a reverse session via the target, forwarding connections made to a worker listener to the client",
+					Examples: []string{
+						"ids=<id>;actions=reverse-connect",
+					},
+				},
 			),
 		},
 	},
@@ -908,6 +973,20 @@ var user = &Resource{
 						"ids=<id>;actions=remove-accounts",
 					},
 				},
+				&Action{
+					Name:        "explain-permissions",
+					Description: "Explain whether a user is allowed to perform an action on a resource",
+					Examples: []string{
+						"ids=<id>;actions=explain-permissions",
+					},
+				},
+				&Action{
+					Name:        "list-resolvable-aliases",
+					Description: "List the aliases a user can use to authorize sessions; only a user's own aliases can be listed",
+					Examples: []string{
+						"ids=<id>;actions=list-resolvable-aliases",
+					},
+				},
 			),
 		},
 	},
@@ -950,6 +1029,13 @@ var worker = &Resource{
 			},
 			Actions: append(
 				rudActions("a worker", false),
+				&Action{
+					Name:        "drain",
+					Description: "Drain a worker, letting its existing connections finish before it shuts down",
+					Examples: []string{
+						"ids=<id>;actions=drain",
+					},
+				},
 			),
 		},
 	},
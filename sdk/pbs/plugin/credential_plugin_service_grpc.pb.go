@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: plugin/v1/credential_plugin_service.proto
+
+package plugin
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	CredentialPluginService_OnCreateCredentialStore_FullMethodName = "/plugin.v1.CredentialPluginService/OnCreateCredentialStore"
+	CredentialPluginService_OnUpdateCredentialStore_FullMethodName = "/plugin.v1.CredentialPluginService/OnUpdateCredentialStore"
+	CredentialPluginService_OnDeleteCredentialStore_FullMethodName = "/plugin.v1.CredentialPluginService/OnDeleteCredentialStore"
+	CredentialPluginService_IssueCredential_FullMethodName         = "/plugin.v1.CredentialPluginService/IssueCredential"
+	CredentialPluginService_RenewCredential_FullMethodName         = "/plugin.v1.CredentialPluginService/RenewCredential"
+	CredentialPluginService_RevokeCredential_FullMethodName        = "/plugin.v1.CredentialPluginService/RevokeCredential"
+)
+
+// CredentialPluginServiceClient is the client API for CredentialPluginService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CredentialPluginServiceClient interface {
+	// OnCreateCredentialStore is a hook that runs when a credential store is
+	// created.
+	OnCreateCredentialStore(ctx context.Context, in *OnCreateCredentialStoreRequest, opts ...grpc.CallOption) (*OnCreateCredentialStoreResponse, error)
+	// OnUpdateCredentialStore is a hook that runs when a credential store is
+	// updated.
+	OnUpdateCredentialStore(ctx context.Context, in *OnUpdateCredentialStoreRequest, opts ...grpc.CallOption) (*OnUpdateCredentialStoreResponse, error)
+	// OnDeleteCredentialStore is a hook that runs when a credential store is
+	// deleted. The plugin should revoke any credentials it issued for the
+	// store that are still valid.
+	OnDeleteCredentialStore(ctx context.Context, in *OnDeleteCredentialStoreRequest, opts ...grpc.CallOption) (*OnDeleteCredentialStoreResponse, error)
+	// IssueCredential issues a credential from a credential library for a
+	// session.
+	IssueCredential(ctx context.Context, in *IssueCredentialRequest, opts ...grpc.CallOption) (*IssueCredentialResponse, error)
+	// RenewCredential renews the lease of a credential issued by
+	// IssueCredential. It is only called for credentials that were issued as
+	// renewable.
+	RenewCredential(ctx context.Context, in *RenewCredentialRequest, opts ...grpc.CallOption) (*RenewCredentialResponse, error)
+	// RevokeCredential revokes a credential issued by IssueCredential. It is
+	// called when the session the credential was issued for is terminated.
+	RevokeCredential(ctx context.Context, in *RevokeCredentialRequest, opts ...grpc.CallOption) (*RevokeCredentialResponse, error)
+}
+
+type credentialPluginServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCredentialPluginServiceClient(cc grpc.ClientConnInterface) CredentialPluginServiceClient {
+	return &credentialPluginServiceClient{cc}
+}
+
+func (c *credentialPluginServiceClient) OnCreateCredentialStore(ctx context.Context, in *OnCreateCredentialStoreRequest, opts ...grpc.CallOption) (*OnCreateCredentialStoreResponse, error) {
+	out := new(OnCreateCredentialStoreResponse)
+	err := c.cc.Invoke(ctx, CredentialPluginService_OnCreateCredentialStore_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialPluginServiceClient) OnUpdateCredentialStore(ctx context.Context, in *OnUpdateCredentialStoreRequest, opts ...grpc.CallOption) (*OnUpdateCredentialStoreResponse, error) {
+	out := new(OnUpdateCredentialStoreResponse)
+	err := c.cc.Invoke(ctx, CredentialPluginService_OnUpdateCredentialStore_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialPluginServiceClient) OnDeleteCredentialStore(ctx context.Context, in *OnDeleteCredentialStoreRequest, opts ...grpc.CallOption) (*OnDeleteCredentialStoreResponse, error) {
+	out := new(OnDeleteCredentialStoreResponse)
+	err := c.cc.Invoke(ctx, CredentialPluginService_OnDeleteCredentialStore_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialPluginServiceClient) IssueCredential(ctx context.Context, in *IssueCredentialRequest, opts ...grpc.CallOption) (*IssueCredentialResponse, error) {
+	out := new(IssueCredentialResponse)
+	err := c.cc.Invoke(ctx, CredentialPluginService_IssueCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialPluginServiceClient) RenewCredential(ctx context.Context, in *RenewCredentialRequest, opts ...grpc.CallOption) (*RenewCredentialResponse, error) {
+	out := new(RenewCredentialResponse)
+	err := c.cc.Invoke(ctx, CredentialPluginService_RenewCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialPluginServiceClient) RevokeCredential(ctx context.Context, in *RevokeCredentialRequest, opts ...grpc.CallOption) (*RevokeCredentialResponse, error) {
+	out := new(RevokeCredentialResponse)
+	err := c.cc.Invoke(ctx, CredentialPluginService_RevokeCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CredentialPluginServiceServer is the server API for CredentialPluginService service.
+// All implementations must embed UnimplementedCredentialPluginServiceServer
+// for forward compatibility
+type CredentialPluginServiceServer interface {
+	// OnCreateCredentialStore is a hook that runs when a credential store is
+	// created.
+	OnCreateCredentialStore(context.Context, *OnCreateCredentialStoreRequest) (*OnCreateCredentialStoreResponse, error)
+	// OnUpdateCredentialStore is a hook that runs when a credential store is
+	// updated.
+	OnUpdateCredentialStore(context.Context, *OnUpdateCredentialStoreRequest) (*OnUpdateCredentialStoreResponse, error)
+	// OnDeleteCredentialStore is a hook that runs when a credential store is
+	// deleted. The plugin should revoke any credentials it issued for the
+	// store that are still valid.
+	OnDeleteCredentialStore(context.Context, *OnDeleteCredentialStoreRequest) (*OnDeleteCredentialStoreResponse, error)
+	// IssueCredential issues a credential from a credential library for a
+	// session.
+	IssueCredential(context.Context, *IssueCredentialRequest) (*IssueCredentialResponse, error)
+	// RenewCredential renews the lease of a credential issued by
+	// IssueCredential. It is only called for credentials that were issued as
+	// renewable.
+	RenewCredential(context.Context, *RenewCredentialRequest) (*RenewCredentialResponse, error)
+	// RevokeCredential revokes a credential issued by IssueCredential. It is
+	// called when the session the credential was issued for is terminated.
+	RevokeCredential(context.Context, *RevokeCredentialRequest) (*RevokeCredentialResponse, error)
+	mustEmbedUnimplementedCredentialPluginServiceServer()
+}
+
+// UnimplementedCredentialPluginServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCredentialPluginServiceServer struct {
+}
+
+func (UnimplementedCredentialPluginServiceServer) OnCreateCredentialStore(context.Context, *OnCreateCredentialStoreRequest) (*OnCreateCredentialStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnCreateCredentialStore not implemented")
+}
+func (UnimplementedCredentialPluginServiceServer) OnUpdateCredentialStore(context.Context, *OnUpdateCredentialStoreRequest) (*OnUpdateCredentialStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnUpdateCredentialStore not implemented")
+}
+func (UnimplementedCredentialPluginServiceServer) OnDeleteCredentialStore(context.Context, *OnDeleteCredentialStoreRequest) (*OnDeleteCredentialStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnDeleteCredentialStore not implemented")
+}
+func (UnimplementedCredentialPluginServiceServer) IssueCredential(context.Context, *IssueCredentialRequest) (*IssueCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueCredential not implemented")
+}
+func (UnimplementedCredentialPluginServiceServer) RenewCredential(context.Context, *RenewCredentialRequest) (*RenewCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewCredential not implemented")
+}
+func (UnimplementedCredentialPluginServiceServer) RevokeCredential(context.Context, *RevokeCredentialRequest) (*RevokeCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCredential not implemented")
+}
+func (UnimplementedCredentialPluginServiceServer) mustEmbedUnimplementedCredentialPluginServiceServer() {
+}
+
+// UnsafeCredentialPluginServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CredentialPluginServiceServer will
+// result in compilation errors.
+type UnsafeCredentialPluginServiceServer interface {
+	mustEmbedUnimplementedCredentialPluginServiceServer()
+}
+
+func RegisterCredentialPluginServiceServer(s grpc.ServiceRegistrar, srv CredentialPluginServiceServer) {
+	s.RegisterService(&CredentialPluginService_ServiceDesc, srv)
+}
+
+func _CredentialPluginService_OnCreateCredentialStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnCreateCredentialStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialPluginServiceServer).OnCreateCredentialStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialPluginService_OnCreateCredentialStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialPluginServiceServer).OnCreateCredentialStore(ctx, req.(*OnCreateCredentialStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialPluginService_OnUpdateCredentialStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnUpdateCredentialStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialPluginServiceServer).OnUpdateCredentialStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialPluginService_OnUpdateCredentialStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialPluginServiceServer).OnUpdateCredentialStore(ctx, req.(*OnUpdateCredentialStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialPluginService_OnDeleteCredentialStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnDeleteCredentialStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialPluginServiceServer).OnDeleteCredentialStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialPluginService_OnDeleteCredentialStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialPluginServiceServer).OnDeleteCredentialStore(ctx, req.(*OnDeleteCredentialStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialPluginService_IssueCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialPluginServiceServer).IssueCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialPluginService_IssueCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialPluginServiceServer).IssueCredential(ctx, req.(*IssueCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialPluginService_RenewCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialPluginServiceServer).RenewCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialPluginService_RenewCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialPluginServiceServer).RenewCredential(ctx, req.(*RenewCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialPluginService_RevokeCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialPluginServiceServer).RevokeCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialPluginService_RevokeCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialPluginServiceServer).RevokeCredential(ctx, req.(*RevokeCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CredentialPluginService_ServiceDesc is the grpc.ServiceDesc for CredentialPluginService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CredentialPluginService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "plugin.v1.CredentialPluginService",
+	HandlerType: (*CredentialPluginServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OnCreateCredentialStore",
+			Handler:    _CredentialPluginService_OnCreateCredentialStore_Handler,
+		},
+		{
+			MethodName: "OnUpdateCredentialStore",
+			Handler:    _CredentialPluginService_OnUpdateCredentialStore_Handler,
+		},
+		{
+			MethodName: "OnDeleteCredentialStore",
+			Handler:    _CredentialPluginService_OnDeleteCredentialStore_Handler,
+		},
+		{
+			MethodName: "IssueCredential",
+			Handler:    _CredentialPluginService_IssueCredential_Handler,
+		},
+		{
+			MethodName: "RenewCredential",
+			Handler:    _CredentialPluginService_RenewCredential_Handler,
+		},
+		{
+			MethodName: "RevokeCredential",
+			Handler:    _CredentialPluginService_RevokeCredential_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "plugin/v1/credential_plugin_service.proto",
+}
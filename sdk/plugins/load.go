@@ -49,6 +49,25 @@ func CreateStoragePlugin(ctx context.Context, pluginType string, opt ...Option)
 	return sp, cleanup, nil
 }
 
+// CreateCredentialPlugin takes in a type, parses the various options to look
+// for a plugin matching that name, and returns a credential plugin client, a
+// cleanup function to execute on shutdown of the enclosing program, and an
+// error.
+func CreateCredentialPlugin(ctx context.Context, pluginType string, opt ...Option) (pb.CredentialPluginServiceClient, func() error, error) {
+	raw, cleanup, err := createPlugin(ctx, pluginType, credentialServicePluginSetName, opt...)
+	if err != nil {
+		return nil, cleanup, err
+	}
+
+	var ok bool
+	cp, ok := raw.(pb.CredentialPluginServiceClient)
+	if !ok {
+		return nil, cleanup, fmt.Errorf("error converting rpc credential plugin of type %T to normal wrapper", raw)
+	}
+
+	return cp, cleanup, nil
+}
+
 func createPlugin(
 	ctx context.Context,
 	pluginType string,
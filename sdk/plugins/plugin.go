@@ -18,8 +18,9 @@ import (
 )
 
 const (
-	hostServicePluginSetName    = "host-plugin"
-	storageServicePluginSetName = "storage-plugin"
+	hostServicePluginSetName       = "host-plugin"
+	storageServicePluginSetName    = "storage-plugin"
+	credentialServicePluginSetName = "credential-plugin"
 )
 
 // HandshakeConfig is a shared config that can be used regardless of plugin, to
@@ -60,6 +61,13 @@ func ServePlugin(svc any, opt ...Option) error {
 		}
 		plugins[storageServicePluginSetName] = storageServiceServer
 	}
+	if credentialSvc, ok := svc.(pb.CredentialPluginServiceServer); ok {
+		credentialServiceServer, err := NewCredentialPluginServiceServer(credentialSvc)
+		if err != nil {
+			return err
+		}
+		plugins[credentialServicePluginSetName] = credentialServiceServer
+	}
 	if len(plugins) == 0 {
 		return errors.New("no valid plugin server provided")
 	}
@@ -87,6 +95,8 @@ func NewPluginClient(pluginPath, setName string, opt ...Option) (*plugin.Client,
 		set = plugin.PluginSet{hostServicePluginSetName: &hostPlugin{}}
 	case storageServicePluginSetName:
 		set = plugin.PluginSet{storageServicePluginSetName: &storagePlugin{}}
+	case credentialServicePluginSetName:
+		set = plugin.PluginSet{credentialServicePluginSetName: &credentialPlugin{}}
 	}
 
 	return plugin.NewClient(&plugin.ClientConfig{
@@ -150,3 +160,27 @@ func (p *storagePlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) er
 func (p *storagePlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (any, error) {
 	return pb.NewStoragePluginServiceClient(c), nil
 }
+
+type credentialPlugin struct {
+	plugin.Plugin
+
+	impl pb.CredentialPluginServiceServer
+}
+
+func NewCredentialPluginServiceServer(impl pb.CredentialPluginServiceServer) (*credentialPlugin, error) {
+	if impl == nil {
+		return nil, fmt.Errorf("empty underlying credential plugin passed in")
+	}
+	return &credentialPlugin{
+		impl: impl,
+	}, nil
+}
+
+func (p *credentialPlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	pb.RegisterCredentialPluginServiceServer(s, p.impl)
+	return nil
+}
+
+func (p *credentialPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (any, error) {
+	return pb.NewCredentialPluginServiceClient(c), nil
+}